@@ -0,0 +1,30 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentlog
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// InitAudit returns a logger for audit events which are written as JSON
+// to <name>.log in the current log directory, hence logmanager uploads
+// them to the controller with name as the source.
+func InitAudit(name string) (*log.Logger, error) {
+	filename := fmt.Sprintf("%s/%s.log", GetCurrentLogdir(), name)
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND,
+		0600)
+	if err != nil {
+		return nil, err
+	}
+	logger := log.New()
+	logger.SetOutput(f)
+	logger.SetFormatter(&log.JSONFormatter{
+		TimestampFormat: time.RFC3339Nano,
+	})
+	return logger, nil
+}
@@ -437,3 +437,51 @@ func CastLedBlinkCounter(in interface{}) types.LedBlinkCounter {
 	}
 	return output
 }
+
+func CastVaultStatus(in interface{}) types.VaultStatus {
+	b, err := json.Marshal(in)
+	if err != nil {
+		log.Fatal(err, "json Marshal in CastVaultStatus")
+	}
+	var output types.VaultStatus
+	if err := json.Unmarshal(b, &output); err != nil {
+		log.Fatal(err, "json Unmarshal in CastVaultStatus")
+	}
+	return output
+}
+
+func CastRemoteSessionConfig(in interface{}) types.RemoteSessionConfig {
+	b, err := json.Marshal(in)
+	if err != nil {
+		log.Fatal(err, "json Marshal in CastRemoteSessionConfig")
+	}
+	var output types.RemoteSessionConfig
+	if err := json.Unmarshal(b, &output); err != nil {
+		log.Fatal(err, "json Unmarshal in CastRemoteSessionConfig")
+	}
+	return output
+}
+
+func CastSshCAConfig(in interface{}) types.SshCAConfig {
+	b, err := json.Marshal(in)
+	if err != nil {
+		log.Fatal(err, "json Marshal in CastSshCAConfig")
+	}
+	var output types.SshCAConfig
+	if err := json.Unmarshal(b, &output); err != nil {
+		log.Fatal(err, "json Unmarshal in CastSshCAConfig")
+	}
+	return output
+}
+
+func CastDeviceCapacity(in interface{}) types.DeviceCapacity {
+	b, err := json.Marshal(in)
+	if err != nil {
+		log.Fatal(err, "json Marshal in CastDeviceCapacity")
+	}
+	var output types.DeviceCapacity
+	if err := json.Unmarshal(b, &output); err != nil {
+		log.Fatal(err, "json Unmarshal in CastDeviceCapacity")
+	}
+	return output
+}
@@ -24,6 +24,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/agentlog"
 	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
 	"github.com/zededa/eve/pkg/pillar/hardware"
 	"github.com/zededa/eve/pkg/pillar/pidfile"
 	"github.com/zededa/eve/pkg/pillar/pubsub"
@@ -56,6 +57,9 @@ var Version = "No version specified"
 //  device.cert.pem,
 //  device.key.pem		Device certificate/key created before this
 //  		     		client is started.
+//  tpm_in_use			The device key is in the TPM; no device.key.pem
+//  				Has the TPM handle if not the default one
+//  device.cert.pem.new etc	Written by renewCert before being renamed
 //  uuid			Written by getUuid operation
 //  hardwaremodel		Written by getUuid if server returns a hardwaremodel
 //  enterprise			Written by getUuid if server returns an enterprise
@@ -127,6 +131,7 @@ func Run() {
 		"selfRegister": false,
 		"ping":         false,
 		"getUuid":      false,
+		"renewCert":    false,
 	}
 	for _, op := range args {
 		if _, ok := operations[op]; ok {
@@ -255,12 +260,17 @@ func Run() {
 			log.Fatal(err)
 		}
 	}
-	if operations["getUuid"] ||
+	if operations["getUuid"] || operations["renewCert"] ||
 		(operations["ping"] && !forceOnboardingCert) {
-		// Load device cert
+		// Load device cert after any interrupted renewal is sorted out
+		zedcloud.CompleteDeviceCertInstall()
 		var err error
-		deviceCert, err = tls.LoadX509KeyPair(deviceCertName,
-			deviceKeyName)
+		if evetpm.IsTpmEnabled() {
+			deviceCert, err = zedcloud.GetClientCert()
+		} else {
+			deviceCert, err = tls.LoadX509KeyPair(deviceCertName,
+				deviceKeyName)
+		}
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -614,6 +624,34 @@ func Run() {
 		log.Debugf("Wrote name %s\n", name)
 	}
 
+	if operations["renewCert"] {
+		retryCount := 0
+		done := false
+		var delay time.Duration
+		for !done {
+			time.Sleep(delay)
+			err := zedcloud.RenewDeviceCert(zedcloudCtx,
+				serverNameAndPort, retryCount)
+			if err == nil {
+				done = true
+				continue
+			}
+			log.Errorf("renewCert failed: %s\n", err)
+			retryCount += 1
+			if maxRetries != 0 && retryCount > maxRetries {
+				log.Errorf("Exceeded %d retries for renewCert\n",
+					maxRetries)
+				os.Exit(1)
+			}
+			delay = 2 * (delay + time.Second)
+			if delay > maxDelay {
+				delay = maxDelay
+			}
+			log.Infof("Retrying renewCert in %d seconds\n",
+				delay/time.Second)
+		}
+	}
+
 	err = pub.Publish("global", zedcloud.GetCloudMetrics())
 	if err != nil {
 		log.Errorln(err)
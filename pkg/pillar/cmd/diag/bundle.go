@@ -0,0 +1,603 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Support bundle: collects the pubsub publications, logs, reboot reasons,
+// partition states, hypervisor and network state and the last protobuf
+// messages to and from the controller into one tarball with a
+// manifest.json describing each entry. Secrets are redacted. Optionally
+// uploads the tarball to a datastore using zedUpload.
+//   diag bundle [-o file] [-t s3|sftp|http|azure -u url -p path -U user -P password]
+
+package diag
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/agentlog"
+	"github.com/zededa/eve/pkg/pillar/hardware"
+	"github.com/zededa/eve/pkg/pillar/pubsub"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/zboot"
+	"github.com/zededa/eve/pkg/pillar/zedUpload"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
+	"github.com/zededa/eve/sdk/go/zconfig"
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+const (
+	checkpointDirname = "/persist/checkpoint"
+	// Only the end of larger log files is included
+	maxBundleLogSize = 4 * 1024 * 1024
+	// Larger publications are skipped
+	maxBundlePubSize = 1024 * 1024
+	commandTimeout   = 30 * time.Second
+	redactedValue    = "REDACTED"
+)
+
+// Directories with pubsub publications
+var pubsubDirnames = []string{
+	"/var/run",
+	"/var/tmp/zededa",
+	"/persist/status",
+	"/persist/config",
+}
+
+// Lower case substrings of field names with secrets. The cloud-init
+// user data of the app instances often has passwords and keys.
+var secretKeys = []string{
+	"password", "passwd", "apikey", "secret", "token", "privatekey",
+	"credential", "passphrase", "psk", "userdata", "cloudinit",
+}
+
+// Lower case field names with secrets which are too short to match as
+// substrings, e.g., the SIM PIN but not CPUsPinned
+var secretNames = []string{
+	"pin",
+}
+
+// Matches secrets in log lines such as Password:foo, "ApiKey": "foo" or
+// "PIN": "1234"
+var secretPattern = regexp.MustCompile(`(?i)((?:(?:password|passwd|apikey|secret|token|privatekey|credential|passphrase|psk|userdata|cloudinit)[a-z]*|\bpin)"?\s*[:=]\s*"?)([^"\s,}]+)`)
+
+type bundleEntry struct {
+	Name     string `json:"name"`
+	Source   string `json:"source"`
+	Size     int64  `json:"size"`
+	Redacted bool   `json:"redacted,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type rebootReason struct {
+	Reason string    `json:"reason"`
+	Time   time.Time `json:"time"`
+}
+
+type partitionInfo struct {
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Devname string `json:"devname"`
+	Version string `json:"version"`
+	Current bool   `json:"current"`
+}
+
+type bundleManifest struct {
+	Version       string                  `json:"version"`
+	Model         string                  `json:"model"`
+	Created       time.Time               `json:"created"`
+	Filename      string                  `json:"filename"`
+	RebootReasons map[string]rebootReason `json:"rebootReasons"`
+	Partitions    []partitionInfo         `json:"partitions"`
+	Entries       []bundleEntry           `json:"entries"`
+	Uploaded      string                  `json:"uploaded,omitempty"`
+	UploadError   string                  `json:"uploadError,omitempty"`
+}
+
+type bundleWriter struct {
+	tw       *tar.Writer
+	manifest *bundleManifest
+}
+
+func runBundle(args []string) {
+	flags := flag.NewFlagSet("bundle", flag.ExitOnError)
+	outputPtr := flags.String("o", "", "Output file")
+	transportPtr := flags.String("t", "", "Upload transport: s3, sftp, http or azure")
+	urlPtr := flags.String("u", "", "Upload URL, or region for s3")
+	pathPtr := flags.String("p", "", "Upload path, or bucket for s3")
+	userPtr := flags.String("U", "", "Upload user or access key")
+	passwordPtr := flags.String("P", "", "Upload password or secret key")
+	flags.Parse(args)
+
+	now := time.Now().UTC()
+	filename := *outputPtr
+	if filename == "" {
+		filename = fmt.Sprintf("/persist/diag-bundle-%s.tar.gz",
+			now.Format("20060102T150405Z"))
+	}
+	manifest, err := writeBundle(filename, now)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bundle failed: %s\n", err)
+		os.Exit(1)
+	}
+	if *transportPtr != "" {
+		auth := &zedUpload.AuthInput{
+			AuthType: *transportPtr,
+			Uname:    *userPtr,
+			Password: *passwordPtr,
+		}
+		err := uploadBundle(zedUpload.SyncTransportType(*transportPtr),
+			*urlPtr, *pathPtr, auth, filename)
+		if err != nil {
+			manifest.UploadError = err.Error()
+		} else {
+			manifest.Uploaded = fmt.Sprintf("%s %s/%s", *transportPtr,
+				*urlPtr, *pathPtr)
+		}
+	}
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", b)
+	if manifest.UploadError != "" {
+		os.Exit(1)
+	}
+}
+
+// writeBundle writes the tarball and returns its manifest
+func writeBundle(filename string, now time.Time) (*bundleManifest, error) {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+		0600)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(f)
+	bw := &bundleWriter{
+		tw: tar.NewWriter(gz),
+		manifest: &bundleManifest{
+			Version:       Version,
+			Model:         hardware.GetHardwareModel(),
+			Created:       now,
+			Filename:      filename,
+			RebootReasons: make(map[string]rebootReason),
+		},
+	}
+	bw.addRebootReasons()
+	bw.addPartitions()
+	bw.addPubsub()
+	bw.addLogs()
+	bw.addProtoMessages()
+	bw.addHypervisor()
+	bw.addNetwork()
+	bw.addFiles("dnsmasq", "/var/lib/misc/*.leases")
+
+	b, err := json.MarshalIndent(bw.manifest, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	bw.add("manifest.json", b)
+	if err := bw.tw.Close(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return bw.manifest, nil
+}
+
+// add writes an entry to the tarball without recording it in the manifest
+func (bw *bundleWriter) add(name string, b []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(b)),
+		ModTime: bw.manifest.Created,
+	}
+	if err := bw.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := bw.tw.Write(b)
+	return err
+}
+
+func (bw *bundleWriter) addEntry(name string, source string, b []byte,
+	redacted bool) {
+
+	entry := bundleEntry{
+		Name:     name,
+		Source:   source,
+		Size:     int64(len(b)),
+		Redacted: redacted,
+	}
+	if err := bw.add(name, b); err != nil {
+		log.Errorf("bundle: add %s failed: %s\n", name, err)
+		entry.Error = err.Error()
+	}
+	bw.manifest.Entries = append(bw.manifest.Entries, entry)
+}
+
+func (bw *bundleWriter) addError(name string, source string, err error) {
+	log.Warnf("bundle: %s from %s: %s\n", name, source, err)
+	bw.manifest.Entries = append(bw.manifest.Entries, bundleEntry{
+		Name:   name,
+		Source: source,
+		Error:  err.Error(),
+	})
+}
+
+func (bw *bundleWriter) addRebootReasons() {
+	reason, ts := agentlog.GetCurrentRebootReason()
+	bw.manifest.RebootReasons["current"] = rebootReason{reason, ts}
+	reason, ts = agentlog.GetOtherRebootReason()
+	bw.manifest.RebootReasons["other"] = rebootReason{reason, ts}
+	reason, ts = agentlog.GetCommonRebootReason()
+	bw.manifest.RebootReasons["common"] = rebootReason{reason, ts}
+}
+
+func (bw *bundleWriter) addPartitions() {
+	if !zboot.IsAvailable() {
+		return
+	}
+	for _, partName := range []string{"IMGA", "IMGB"} {
+		bw.manifest.Partitions = append(bw.manifest.Partitions,
+			partitionInfo{
+				Name:    partName,
+				State:   zboot.GetPartitionState(partName),
+				Devname: zboot.GetPartitionDevname(partName),
+				Version: zboot.GetShortVersion(partName),
+				Current: zboot.IsCurrentPartition(partName),
+			})
+	}
+}
+
+// addPubsub adds the JSON files in the pubsub directories
+func (bw *bundleWriter) addPubsub() {
+	for _, dirname := range pubsubDirnames {
+		filepath.Walk(dirname, func(path string, info os.FileInfo,
+			err error) error {
+
+			if err != nil {
+				return nil
+			}
+			if !info.Mode().IsRegular() ||
+				!strings.HasSuffix(path, ".json") {
+				return nil
+			}
+			name := "pubsub" + path
+			if info.Size() > maxBundlePubSize {
+				errStr := fmt.Sprintf("size %d exceeds %d",
+					info.Size(), maxBundlePubSize)
+				bw.addError(name, path, errors.New(errStr))
+				return nil
+			}
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				bw.addError(name, path, err)
+				return nil
+			}
+			b, redacted := redactJSON(b)
+			bw.addEntry(name, path, b, redacted)
+			return nil
+		})
+	}
+}
+
+// addLogs adds the end of the current log files of both partitions
+func (bw *bundleWriter) addLogs() {
+	logdirs := map[string]string{"current": agentlog.GetCurrentLogdir()}
+	if other := agentlog.GetOtherLogdir(); other != "" {
+		logdirs["other"] = other
+	}
+	for which, logdir := range logdirs {
+		filenames, _ := filepath.Glob(logdir + "/*.log")
+		for _, filename := range filenames {
+			name := fmt.Sprintf("logs/%s/%s", which,
+				filepath.Base(filename))
+			b, err := readTail(filename, maxBundleLogSize)
+			if err != nil {
+				bw.addError(name, filename, err)
+				continue
+			}
+			b, redacted := redactText(b)
+			bw.addEntry(name, filename, b, redacted)
+		}
+	}
+}
+
+// addProtoMessages adds the last messages saved by zedagent as JSON
+func (bw *bundleWriter) addProtoMessages() {
+	messages := map[string]proto.Message{
+		"lastconfig":     &zconfig.EdgeDevConfig{},
+		"lastmetrics":    &zmet.ZMetricMsg{},
+		"lastdeviceinfo": &zmet.ZInfoMsg{},
+		"lastappinfo":    &zmet.ZInfoMsg{},
+	}
+	for basename, msg := range messages {
+		filename := checkpointDirname + "/" + basename
+		name := "protobuf/" + basename + ".json"
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			bw.addError(name, filename, err)
+			continue
+		}
+		if err := proto.Unmarshal(b, msg); err != nil {
+			bw.addError(name, filename, err)
+			continue
+		}
+		marshaler := jsonpb.Marshaler{Indent: "  "}
+		var buf bytes.Buffer
+		if err := marshaler.Marshal(&buf, msg); err != nil {
+			bw.addError(name, filename, err)
+			continue
+		}
+		b, redacted := redactJSON(buf.Bytes())
+		bw.addEntry(name, filename, b, redacted)
+	}
+}
+
+func (bw *bundleWriter) addHypervisor() {
+	bw.addCommand("hypervisor/xl-info.txt", "xl", "info")
+	bw.addCommand("hypervisor/xl-list.txt", "xl", "list", "-l")
+}
+
+func (bw *bundleWriter) addNetwork() {
+	bw.addCommand("network/ip-link.txt", "ip", "-d", "link", "show")
+	bw.addCommand("network/ip-addr.txt", "ip", "addr", "show")
+	bw.addCommand("network/ip-rule.txt", "ip", "rule", "show")
+	bw.addCommand("network/ip6-rule.txt", "ip", "-6", "rule", "show")
+	bw.addCommand("network/ip-route.txt", "ip", "route", "show",
+		"table", "all")
+	bw.addCommand("network/ip6-route.txt", "ip", "-6", "route", "show",
+		"table", "all")
+	bw.addCommand("network/ipset.txt", "ipset", "list")
+	iptables := bw.addCommand("network/iptables.txt", "iptables-save", "-c")
+	ip6tables := bw.addCommand("network/ip6tables.txt", "ip6tables-save", "-c")
+
+	// Per bridge the neighbors, forwarding database and rules
+	bridges, _ := filepath.Glob("/sys/class/net/*/bridge")
+	for _, bridge := range bridges {
+		ifname := filepath.Base(filepath.Dir(bridge))
+		prefix := "network/bridge/" + ifname + "/"
+		bw.addCommand(prefix+"neigh.txt", "ip", "neigh", "show",
+			"dev", ifname)
+		bw.addCommand(prefix+"fdb.txt", "bridge", "fdb", "show",
+			"br", ifname)
+		bw.addEntry(prefix+"iptables.txt", "iptables-save",
+			grepLines(iptables, ifname), false)
+		bw.addEntry(prefix+"ip6tables.txt", "ip6tables-save",
+			grepLines(ip6tables, ifname), false)
+	}
+}
+
+// addCommand adds the output of the command and returns it
+func (bw *bundleWriter) addCommand(name string, command string,
+	args ...string) []byte {
+
+	source := strings.Join(append([]string{command}, args...), " ")
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, command, args...).CombinedOutput()
+	if err != nil {
+		if len(out) == 0 {
+			bw.addError(name, source, err)
+			return nil
+		}
+		out = append(out, []byte(fmt.Sprintf("\n%s: %s\n", source, err))...)
+	}
+	bw.addEntry(name, source, out, false)
+	return out
+}
+
+func (bw *bundleWriter) addFiles(dirname string, pattern string) {
+	filenames, _ := filepath.Glob(pattern)
+	for _, filename := range filenames {
+		name := dirname + "/" + filepath.Base(filename)
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			bw.addError(name, filename, err)
+			continue
+		}
+		bw.addEntry(name, filename, b, false)
+	}
+}
+
+func grepLines(b []byte, match string) []byte {
+	var out []byte
+	for _, line := range bytes.SplitAfter(b, []byte("\n")) {
+		if bytes.Contains(line, []byte(match)) {
+			out = append(out, line...)
+		}
+	}
+	return out
+}
+
+// readTail returns at most the last maxSize bytes of the file
+func readTail(filename string, maxSize int64) ([]byte, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxSize {
+		if _, err := f.Seek(info.Size()-maxSize, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	return ioutil.ReadAll(io.LimitReader(f, maxSize))
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range secretKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	for _, s := range secretNames {
+		if key == s {
+			return true
+		}
+	}
+	return false
+}
+
+// redactJSON replaces the values of fields with secrets. Falls back to
+// redactText if the content is not JSON.
+func redactJSON(b []byte) ([]byte, bool) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return redactText(b)
+	}
+	v, redacted := redactValue(v)
+	if !redacted {
+		return b, false
+	}
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return redactText(b)
+	}
+	return out, true
+}
+
+func redactValue(v interface{}) (interface{}, bool) {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSecretKey(key) {
+				if s, ok := val.(string); ok && s == "" {
+					continue
+				}
+				if val != nil {
+					v[key] = redactedValue
+					redacted = true
+				}
+				continue
+			}
+			val, r := redactValue(val)
+			v[key] = val
+			redacted = redacted || r
+		}
+	case []interface{}:
+		for i, val := range v {
+			val, r := redactValue(val)
+			v[i] = val
+			redacted = redacted || r
+		}
+	}
+	return v, redacted
+}
+
+func redactText(b []byte) ([]byte, bool) {
+	if !secretPattern.Match(b) {
+		return b, false
+	}
+	return secretPattern.ReplaceAll(b, []byte("${1}"+redactedValue)), true
+}
+
+// uploadBundle tries each management port until the upload succeeds
+func uploadBundle(trType zedUpload.SyncTransportType, serverURL string,
+	dpath string, auth *zedUpload.AuthInput, filename string) error {
+
+	var status types.DeviceNetworkStatus
+	dnsFilename := pubsub.PubDirName("nim") + "/DeviceNetworkStatus/global.json"
+	b, err := ioutil.ReadFile(dnsFilename)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &status); err != nil {
+		return err
+	}
+	dCtx, err := zedUpload.NewDronaCtx("diag", 0)
+	if err != nil {
+		return err
+	}
+	addrCount := types.CountLocalAddrAnyNoLinkLocal(status)
+	if addrCount == 0 {
+		return errors.New("No IP management port addresses for upload")
+	}
+	errStr := ""
+	for addrIndex := 0; addrIndex < addrCount; addrIndex++ {
+		ipSrc, err := types.GetLocalAddrAnyNoLinkLocal(status,
+			addrIndex, "")
+		if err != nil {
+			errStr = errStr + "\n" + err.Error()
+			continue
+		}
+		ifname := types.GetMgmtPortFromAddr(status, ipSrc)
+		err = uploadBundleFrom(dCtx, &status, trType, serverURL, dpath,
+			auth, ifname, ipSrc, filename)
+		if err == nil {
+			return nil
+		}
+		log.Errorf("uploadBundle: source IP %s failed: %s\n",
+			ipSrc.String(), err)
+		errStr = errStr + "\n" + err.Error()
+	}
+	return errors.New("Upload failed: " + errStr)
+}
+
+func uploadBundleFrom(dCtx *zedUpload.DronaCtx,
+	status *types.DeviceNetworkStatus, trType zedUpload.SyncTransportType,
+	serverURL string, dpath string, auth *zedUpload.AuthInput,
+	ifname string, ipSrc net.IP, filename string) error {
+
+	dEndPoint, err := dCtx.NewSyncerDest(trType, serverURL, dpath, auth)
+	if err != nil {
+		return err
+	}
+	proxyURL, err := zedcloud.LookupProxy(status, ifname, serverURL)
+	if err == nil && proxyURL != nil && trType != zedUpload.SyncSftpTr {
+		log.Infof("uploadBundle: Using proxy %s\n", proxyURL.String())
+		dEndPoint.WithSrcIpAndProxySelection(ipSrc, proxyURL)
+	} else {
+		dEndPoint.WithSrcIpSelection(ipSrc)
+	}
+	respChan := make(chan *zedUpload.DronaRequest)
+	req := dEndPoint.NewRequest(zedUpload.SyncOpUpload,
+		filepath.Base(filename), filename, 0, true, respChan)
+	if req == nil {
+		return errors.New("NewRequest failed")
+	}
+	req.Post()
+	for resp := range respChan {
+		if resp.IsDnUpdate() {
+			log.Infof("uploadBundle: progress %d/%d\n",
+				resp.GetAsize(), resp.GetOsize())
+			continue
+		}
+		if resp.IsError() {
+			_, err := resp.GetUpStatus()
+			return err
+		}
+		return nil
+	}
+	return errors.New("uploadBundle: no response")
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package diag
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+func TestRedactDevicePortConfig(t *testing.T) {
+	dpc := types.DevicePortConfig{
+		Key: "zedagent",
+		Ports: []types.NetworkPortConfig{
+			{
+				IfName: "wlan0",
+				IsMgmt: true,
+				Wifi: []types.WifiConfig{
+					{
+						SSID:      "office",
+						KeyScheme: types.WifiKeySchemeWpaPsk,
+						Password:  "wifisecret1",
+					},
+					{
+						SSID:      "corp",
+						KeyScheme: types.WifiKeySchemeWpaEap,
+						Identity:  "alice",
+						Password:  "wifisecret2",
+					},
+				},
+			},
+			{
+				IfName: "wwan0",
+				IsMgmt: true,
+				Cellular: &types.CellularConfig{
+					APN:      "internet",
+					PIN:      "4711",
+					Username: "bob",
+					Password: "cellsecret",
+				},
+			},
+		},
+	}
+	b, err := json.Marshal(dpc)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	out, redacted := redactJSON(b)
+	if !redacted {
+		t.Errorf("Not redacted: %s", out)
+	}
+	for _, secret := range []string{"wifisecret1", "wifisecret2", "cellsecret", "4711"} {
+		if strings.Contains(string(out), secret) {
+			t.Errorf("%s not redacted: %s", secret, out)
+		}
+	}
+	var result types.DevicePortConfig
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if result.Ports[1].Cellular.PIN != redactedValue ||
+		result.Ports[0].Wifi[0].Password != redactedValue {
+		t.Errorf("Not REDACTED: %+v", result)
+	}
+	// The rest is kept
+	if result.Ports[0].Wifi[1].Identity != "alice" ||
+		result.Ports[1].Cellular.APN != "internet" ||
+		result.Ports[1].Cellular.Username != "bob" {
+		t.Errorf("Too much redacted: %+v", result)
+	}
+}
+
+func TestRedactCloudInitUserData(t *testing.T) {
+	userData := "I2Nsb3VkLWNvbmZpZwpwYXNzd29yZDogc2VjcmV0Cg=="
+	config := types.AppInstanceConfig{
+		DisplayName:       "app1",
+		CloudInitUserData: userData,
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	out, redacted := redactJSON(b)
+	if !redacted || strings.Contains(string(out), userData) {
+		t.Errorf("Not redacted: %s", out)
+	}
+	var result types.AppInstanceConfig
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if result.CloudInitUserData != redactedValue ||
+		result.DisplayName != "app1" {
+		t.Errorf("Expected only CloudInitUserData redacted: %+v", result)
+	}
+	// As printed in a log line
+	line := "handleModify CloudInitUserData:" + userData + " Activate:true"
+	out, redacted = redactText([]byte(line))
+	if !redacted || strings.Contains(string(out), userData) {
+		t.Errorf("Not redacted: %s", out)
+	}
+}
+
+type TestRedactMatrix struct {
+	input    string
+	expected string
+	redacted bool
+}
+
+func TestRedactJSON(t *testing.T) {
+	testMatrix := map[string]TestRedactMatrix{
+		"Nothing to redact": {
+			input:    `{"Name":"eth0","CPUsPinned":true,"Pings":3}`,
+			expected: `{"Name":"eth0","CPUsPinned":true,"Pings":3}`,
+			redacted: false,
+		},
+		"Nested": {
+			input:    `{"a":[{"ApiKey":"k1","b":{"pin":"1234"}}]}`,
+			expected: `{"a":[{"ApiKey":"REDACTED","b":{"pin":"REDACTED"}}]}`,
+			redacted: true,
+		},
+		"Empty secret": {
+			input:    `{"Password":"","SecretKey":null}`,
+			expected: `{"Password":"","SecretKey":null}`,
+			redacted: false,
+		},
+		"Not JSON": {
+			input:    `Password:foo`,
+			expected: `Password:REDACTED`,
+			redacted: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		out, redacted := redactJSON([]byte(test.input))
+		// Compare without the indentation
+		var actual string
+		var v interface{}
+		if json.Unmarshal(out, &v) == nil {
+			b, _ := json.Marshal(v)
+			actual = string(b)
+			var e interface{}
+			json.Unmarshal([]byte(test.expected), &e)
+			b, _ = json.Marshal(e)
+			test.expected = string(b)
+		} else {
+			actual = string(out)
+		}
+		if actual != test.expected || redacted != test.redacted {
+			t.Errorf("Test Failed: %s: Expected %s %v, Actual: %s %v\n",
+				testname, test.expected, test.redacted,
+				actual, redacted)
+		}
+	}
+}
+
+func TestRedactText(t *testing.T) {
+	testMatrix := map[string]TestRedactMatrix{
+		"Password": {
+			input:    `level=info msg="login Password:hunter2 ok"`,
+			expected: `level=info msg="login Password:REDACTED ok"`,
+			redacted: true,
+		},
+		"Quoted JSON": {
+			input:    `{"ApiKey": "abc123", "Name": "x"}`,
+			expected: `{"ApiKey": "REDACTED", "Name": "x"}`,
+			redacted: true,
+		},
+		"SIM PIN": {
+			input:    `Cellular:{APN:internet PIN:4711}`,
+			expected: `Cellular:{APN:internet PIN:REDACTED}`,
+			redacted: true,
+		},
+		"Quoted PIN": {
+			input:    `"PIN":"4711"`,
+			expected: `"PIN":"REDACTED"`,
+			redacted: true,
+		},
+		"Pinned and ping": {
+			input:    `CPUsPinned:true ping:3 spin=1`,
+			expected: `CPUsPinned:true ping:3 spin=1`,
+			redacted: false,
+		},
+		"Psk with suffix": {
+			input:    `wpa_psk = secretvalue`,
+			expected: `wpa_psk = REDACTED`,
+			redacted: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		out, redacted := redactText([]byte(test.input))
+		if string(out) != test.expected || redacted != test.redacted {
+			t.Errorf("Test Failed: %s: Expected %s %v, Actual: %s %v\n",
+				testname, test.expected, test.redacted,
+				string(out), redacted)
+		}
+	}
+}
@@ -1,7 +1,8 @@
 // Copyright (c) 2018 Zededa, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
-// Utility to dump diagnostic information about connectivity
+// Utility to dump diagnostic information about connectivity, or with
+// the bundle command to collect a support bundle. See bundle.go.
 
 package diag
 
@@ -16,6 +17,7 @@ import (
 	"github.com/zededa/eve/pkg/pillar/agentlog"
 	"github.com/zededa/eve/pkg/pillar/cast"
 	"github.com/zededa/eve/pkg/pillar/devicenetwork"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
 	"github.com/zededa/eve/pkg/pillar/hardware"
 	"github.com/zededa/eve/pkg/pillar/pubsub"
 	"github.com/zededa/eve/pkg/pillar/types"
@@ -109,6 +111,17 @@ func Run() {
 		log.SetOutput(multi)
 	}
 
+	if flag.NArg() > 0 {
+		switch flag.Arg(0) {
+		case "bundle":
+			runBundle(flag.Args()[1:])
+		default:
+			fmt.Printf("Unknown command %s\n", flag.Arg(0))
+			os.Exit(1)
+		}
+		return
+	}
+
 	ctx := diagContext{
 		forever:     *foreverPtr,
 		pacContents: *pacContentsPtr,
@@ -130,9 +143,9 @@ func Run() {
 		FailureFunc:         zedcloud.ZedCloudFailure,
 		SuccessFunc:         zedcloud.ZedCloudSuccess,
 	}
-	if fileExists(deviceCertName) && fileExists(deviceKeyName) {
-		cert, err := tls.LoadX509KeyPair(deviceCertName,
-			deviceKeyName)
+	if fileExists(deviceCertName) &&
+		(fileExists(deviceKeyName) || evetpm.IsTpmEnabled()) {
+		cert, err := zedcloud.GetClientCert()
 		if err != nil {
 			log.Fatal(err)
 		}
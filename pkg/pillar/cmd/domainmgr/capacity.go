@@ -0,0 +1,364 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Admission control. Before a domain is created its memory and CPUs are
+// checked against what the device has minus what dom0, EVE and Xen need and
+// what has been allocated to the other domains. A domain which does not fit
+// is not created; it is marked with AdmissionFailed and retried by its
+// handler goroutine. What is allocated is published as DeviceCapacity.
+
+package domainmgr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+)
+
+// Determine what the device has. If xl fails the memory is not checked
+func capacityInit(ctx *domainContext) {
+	ctx.allocations = make(map[string]types.DomainAllocation)
+	total, ncpu, err := xlInfo()
+	if err != nil {
+		log.Errorf("capacityInit: %s\n", err)
+	}
+	dom0, err := xlDom0Memory()
+	if err != nil {
+		log.Errorf("capacityInit: %s\n", err)
+	}
+	ctx.totalMemory = total
+	ctx.dom0Memory = dom0
+	ctx.ncpu = ncpu
+	log.Infof("capacityInit: total %d MB dom0 %d MB %d CPUs\n",
+		total, dom0, ncpu)
+}
+
+// The resources which the domain needs
+func configToAllocation(config types.DomainConfig,
+	status types.DomainStatus) types.DomainAllocation {
+
+	memory := config.Memory
+	if config.MaxMem > memory {
+		memory = config.MaxMem
+	}
+	vCpus := config.VCpus
+	if vCpus == 0 {
+		vCpus = 1
+	}
+	return types.DomainAllocation{
+		UUIDandVersion: config.UUIDandVersion,
+		DisplayName:    config.DisplayName,
+		DomainName:     status.DomainName,
+		Memory:         uint64((memory + 1023) / 1024),
+		VCpus:          vCpus,
+		CPUs:           config.CPUs,
+	}
+}
+
+// Check that the domain fits and record the allocation. Calling it again
+// for an admitted domain is a no-op.
+func admitDomain(ctx *domainContext, config types.DomainConfig,
+	status types.DomainStatus) error {
+
+	alloc := configToAllocation(config, status)
+	ctx.capacityLock.Lock()
+	old, ok := ctx.allocations[status.Key()]
+	if ok && old.Admitted {
+		ctx.capacityLock.Unlock()
+		return nil
+	}
+	err := checkAllocation(ctx, status.Key(), alloc)
+	if err != nil {
+		alloc.Error = err.Error()
+	} else {
+		alloc.Admitted = true
+	}
+	ctx.allocations[status.Key()] = alloc
+	ctx.capacityLock.Unlock()
+
+	if err != nil {
+		log.Warnf("admitDomain(%s) not admitted: %s\n", status.Key(), err)
+	} else {
+		log.Infof("admitDomain(%s) admitted %d MB %d vCPUs CPUs <%s>\n",
+			status.Key(), alloc.Memory, alloc.VCpus, alloc.CPUs)
+	}
+	publishDeviceCapacity(ctx)
+	return err
+}
+
+// Called with capacityLock held
+func checkAllocation(ctx *domainContext, key string,
+	alloc types.DomainAllocation) error {
+
+	if ctx.ncpu != 0 && alloc.VCpus > ctx.ncpu {
+		errStr := fmt.Sprintf("needs %d vCPUs but the device has %d CPUs",
+			alloc.VCpus, ctx.ncpu)
+		return errors.New(errStr)
+	}
+	cpus, err := types.ParseCPUSet(alloc.CPUs)
+	if err != nil {
+		return err
+	}
+	for _, cpu := range cpus {
+		if ctx.ncpu != 0 && cpu >= ctx.ncpu {
+			errStr := fmt.Sprintf("pinned to CPU %d but the device has %d CPUs",
+				cpu, ctx.ncpu)
+			return errors.New(errStr)
+		}
+	}
+	if ctx.totalMemory != 0 {
+		available := availableMemory(ctx)
+		allocated := allocatedMemory(ctx, key)
+		if allocated+alloc.Memory > available {
+			free := uint64(0)
+			if available > allocated {
+				free = available - allocated
+			}
+			errStr := fmt.Sprintf("needs %d MB memory but only %d MB of %d MB for apps is free",
+				alloc.Memory, free, available)
+			return errors.New(errStr)
+		}
+	}
+	if !ctx.exclusivePinning {
+		return nil
+	}
+	pinned := pinnedCPUs(ctx, key)
+	if len(cpus) == 0 {
+		if ctx.ncpu != 0 && len(pinned) >= ctx.ncpu {
+			return errors.New("all CPUs are pinned by other apps")
+		}
+		return nil
+	}
+	for _, cpu := range cpus {
+		if owner, ok := pinned[cpu]; ok {
+			errStr := fmt.Sprintf("CPU %d is pinned by %s",
+				cpu, owner)
+			return errors.New(errStr)
+		}
+	}
+	return nil
+}
+
+func availableMemory(ctx *domainContext) uint64 {
+	reserved := ctx.dom0Memory + ctx.memoryReserve
+	if ctx.totalMemory < reserved {
+		return 0
+	}
+	return ctx.totalMemory - reserved
+}
+
+// Excluding the domain with key
+func allocatedMemory(ctx *domainContext, key string) uint64 {
+	var allocated uint64
+	for k, alloc := range ctx.allocations {
+		if k != key && alloc.Admitted {
+			allocated += alloc.Memory
+		}
+	}
+	return allocated
+}
+
+// The CPUs pinned by admitted domains other than key and their names
+func pinnedCPUs(ctx *domainContext, key string) map[int]string {
+	pinned := make(map[int]string)
+	for k, alloc := range ctx.allocations {
+		if k == key || !alloc.Admitted {
+			continue
+		}
+		cpus, _ := types.ParseCPUSet(alloc.CPUs)
+		for _, cpu := range cpus {
+			pinned[cpu] = alloc.DisplayName
+		}
+	}
+	return pinned
+}
+
+// Called when the domain is halted or deleted
+func releaseDomain(ctx *domainContext, key string) {
+	ctx.capacityLock.Lock()
+	_, ok := ctx.allocations[key]
+	delete(ctx.allocations, key)
+	ctx.capacityLock.Unlock()
+	if !ok {
+		return
+	}
+	log.Infof("releaseDomain(%s)\n", key)
+	publishDeviceCapacity(ctx)
+	repinUnpinned(ctx)
+}
+
+// With exclusive pinning the domains which are not pinned run on the CPUs
+// which are not pinned by any domain.
+func repinUnpinned(ctx *domainContext) {
+	ctx.capacityLock.Lock()
+	cpus := "all"
+	if ctx.exclusivePinning && ctx.ncpu != 0 {
+		pinned := pinnedCPUs(ctx, "")
+		var free []int
+		for cpu := 0; cpu < ctx.ncpu; cpu++ {
+			if _, ok := pinned[cpu]; !ok {
+				free = append(free, cpu)
+			}
+		}
+		if len(free) != 0 {
+			cpus = types.FormatCPUSet(free)
+		}
+	}
+	var domainNames []string
+	for _, alloc := range ctx.allocations {
+		if alloc.Admitted && alloc.CPUs == "" {
+			domainNames = append(domainNames, alloc.DomainName)
+		}
+	}
+	ctx.capacityLock.Unlock()
+
+	for _, domainName := range domainNames {
+		// Fails if the domain is not running yet; doActivateTail
+		// calls us again
+		xlVcpuPin(domainName, cpus)
+	}
+}
+
+func publishDeviceCapacity(ctx *domainContext) {
+	ctx.capacityLock.Lock()
+	capacity := types.DeviceCapacity{
+		TotalMemory:      ctx.totalMemory,
+		ReservedMemory:   ctx.totalMemory - availableMemory(ctx),
+		AllocatedMemory:  allocatedMemory(ctx, ""),
+		Ncpu:             ctx.ncpu,
+		ExclusivePinning: ctx.exclusivePinning,
+	}
+	var pinned []int
+	for cpu := range pinnedCPUs(ctx, "") {
+		pinned = append(pinned, cpu)
+	}
+	capacity.PinnedCPUs = types.FormatCPUSet(pinned)
+	for _, alloc := range ctx.allocations {
+		capacity.Allocations = append(capacity.Allocations, alloc)
+	}
+	ctx.capacityLock.Unlock()
+
+	sort.Slice(capacity.Allocations, func(i, j int) bool {
+		return capacity.Allocations[i].DisplayName <
+			capacity.Allocations[j].DisplayName
+	})
+	ctx.pubDeviceCapacity.Publish(capacity.Key(), capacity)
+}
+
+// Returns total_memory in MBytes and nr_cpus
+func xlInfo() (uint64, int, error) {
+	info, err := xlInfoFields()
+	if err != nil {
+		return 0, 0, err
+	}
+	total, _ := strconv.ParseUint(info["total_memory"], 10, 64)
+	ncpu, _ := strconv.Atoi(info["nr_cpus"])
+	return total, ncpu, nil
+}
+
+// Returns the "name : value" lines of xl info
+func xlInfoFields() (map[string]string, error) {
+	cmd := "xl"
+	args := []string{
+		"info",
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xl info failed: %s", string(stdoutStderr))
+		return nil, errors.New(errStr)
+	}
+	info := make(map[string]string)
+	for _, line := range strings.Split(string(stdoutStderr), "\n") {
+		fields := strings.SplitN(line, ":", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		info[strings.TrimSpace(fields[0])] = strings.TrimSpace(fields[1])
+	}
+	return info, nil
+}
+
+// Returns the memory of dom0 in MBytes
+func xlDom0Memory() (uint64, error) {
+	cmd := "xl"
+	args := []string{
+		"list",
+		"0",
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xl list failed: %s", string(stdoutStderr))
+		return 0, errors.New(errStr)
+	}
+	// Name ID Mem VCPUs State Time(s)
+	lines := strings.Split(strings.TrimSpace(string(stdoutStderr)), "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 3 {
+		errStr := fmt.Sprintf("xl list unexpected output: %s",
+			string(stdoutStderr))
+		return 0, errors.New(errStr)
+	}
+	return strconv.ParseUint(fields[2], 10, 64)
+}
+
+func xlVcpuPin(domainName string, cpus string) error {
+	log.Infof("xlVcpuPin %s %s\n", domainName, cpus)
+	cmd := "xl"
+	args := []string{
+		"vcpu-pin",
+		domainName,
+		"all",
+		cpus,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl vcpu-pin failed ", err)
+		log.Errorln("xl vcpu-pin output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl vcpu-pin failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlVcpuPin done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+// The vCPUs of a running domain were changed
+func resizeAllocation(ctx *domainContext, key string, vCpus int) {
+	ctx.capacityLock.Lock()
+	alloc, ok := ctx.allocations[key]
+	if ok {
+		alloc.VCpus = vCpus
+		ctx.allocations[key] = alloc
+	}
+	ctx.capacityLock.Unlock()
+	if ok {
+		publishDeviceCapacity(ctx)
+	}
+}
+
+// From GlobalConfig. Applies to domains which are admitted from now on
+func updateCapacityConfig(ctx *domainContext, memoryReserve uint64,
+	exclusivePinning bool) {
+
+	ctx.capacityLock.Lock()
+	changed := memoryReserve != ctx.memoryReserve ||
+		exclusivePinning != ctx.exclusivePinning
+	pinningChanged := exclusivePinning != ctx.exclusivePinning
+	ctx.memoryReserve = memoryReserve
+	ctx.exclusivePinning = exclusivePinning
+	ctx.capacityLock.Unlock()
+	if !changed {
+		return
+	}
+	log.Infof("updateCapacityConfig: reserve %d MB exclusive pinning %t\n",
+		memoryReserve, exclusivePinning)
+	publishDeviceCapacity(ctx)
+	if pinningChanged {
+		repinUnpinned(ctx)
+	}
+}
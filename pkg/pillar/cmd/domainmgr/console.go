@@ -0,0 +1,410 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// The serial console of each domain. Once the domain is running we open
+// its console pty and copy the output to a capture file per app instance
+// in consoleDirname which is rotated at maxConsoleLogSize, and to any
+// attached clients. Clients connect to types.ConsoleSocket and send one
+// request line:
+//	attach <app>		interactive console
+//	tail <app> <kbytes>	the last output from the capture files
+// where app is the UUID, the DisplayName or the DomainName.
+
+package domainmgr
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"golang.org/x/sys/unix"
+)
+
+// Variable so that tests can use a temporary directory
+var consoleDirname = persistDir + "/console"
+
+const (
+	maxConsoleLogSize = 1024 * 1024
+	// Clients which do not keep up are dropped
+	consoleWriteTimeout = 5 * time.Second
+	maxConsoleRequest   = 256
+)
+
+type appConsole struct {
+	key     string
+	tty     *os.File
+	mutex   sync.Mutex // For the below
+	logFile *os.File
+	logSize int64
+	clients map[net.Conn]bool // Set to nil when the console is gone
+}
+
+func consoleInit(ctx *domainContext) {
+	ctx.consoles = make(map[string]*appConsole)
+	if err := os.MkdirAll(consoleDirname, 0700); err != nil {
+		log.Fatal(err)
+	}
+	os.Remove(types.ConsoleSocket)
+	listener, err := net.Listen("unix", types.ConsoleSocket)
+	if err != nil {
+		log.Errorf("consoleInit: %s\n", err)
+		return
+	}
+	if err := os.Chmod(types.ConsoleSocket, 0600); err != nil {
+		log.Errorf("consoleInit: %s\n", err)
+	}
+	go consoleServer(ctx, listener)
+}
+
+func consoleServer(ctx *domainContext, listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Errorf("consoleServer: %s\n", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		go consoleRequest(ctx, conn)
+	}
+}
+
+func consoleRequest(ctx *domainContext, conn net.Conn) {
+	reader := bufio.NewReaderSize(conn, maxConsoleRequest)
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	line, err := reader.ReadString('\n')
+	conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		conn.Close()
+		return
+	}
+	fields := strings.Fields(line)
+	switch {
+	case len(fields) == 2 && fields[0] == "attach":
+		err = consoleAttach(ctx, fields[1], conn, reader)
+	case len(fields) == 3 && fields[0] == "tail":
+		var kbytes int
+		kbytes, err = strconv.Atoi(fields[2])
+		if err == nil {
+			err = consoleTail(ctx, fields[1], kbytes, conn)
+		}
+	default:
+		err = errors.New("expected attach <app> or tail <app> <kbytes>")
+	}
+	if err != nil {
+		log.Warnf("consoleRequest %s: %s\n", strings.TrimSpace(line), err)
+		fmt.Fprintf(conn, "error: %s\n", err)
+	}
+	conn.Close()
+}
+
+// Returns the UUID of the app instance
+func consoleLookup(ctx *domainContext, app string) (string, error) {
+	if id, err := uuid.FromString(app); err == nil {
+		return id.String(), nil
+	}
+	for key, st := range ctx.pubDomainStatus.GetAll() {
+		status := cast.CastDomainStatus(st)
+		if status.DisplayName == app || status.DomainName == app {
+			return key, nil
+		}
+	}
+	errStr := fmt.Sprintf("unknown app %s", app)
+	return "", errors.New(errStr)
+}
+
+// Runs until either side closes
+func consoleAttach(ctx *domainContext, app string, conn net.Conn,
+	reader io.Reader) error {
+
+	key, err := consoleLookup(ctx, app)
+	if err != nil {
+		return err
+	}
+	ctx.consoleLock.Lock()
+	ac := ctx.consoles[key]
+	ctx.consoleLock.Unlock()
+	if ac == nil {
+		return errors.New("app is not running")
+	}
+	ac.mutex.Lock()
+	if ac.clients == nil {
+		ac.mutex.Unlock()
+		return errors.New("app is not running")
+	}
+	ac.clients[conn] = true
+	ac.mutex.Unlock()
+	log.Infof("consoleAttach(%s)\n", key)
+	// Input goes to the console until the client or the console closes
+	_, err = io.Copy(ac.tty, reader)
+	ac.mutex.Lock()
+	delete(ac.clients, conn)
+	ac.mutex.Unlock()
+	log.Infof("consoleAttach(%s) done: %v\n", key, err)
+	return nil
+}
+
+func consoleTail(ctx *domainContext, app string, kbytes int,
+	conn net.Conn) error {
+
+	key, err := consoleLookup(ctx, app)
+	if err != nil {
+		return err
+	}
+	if kbytes <= 0 || kbytes > 2*maxConsoleLogSize/1024 {
+		errStr := fmt.Sprintf("kbytes must be 1 to %d",
+			2*maxConsoleLogSize/1024)
+		return errors.New(errStr)
+	}
+	// Hold the lock against rotation if the console is active
+	ctx.consoleLock.Lock()
+	ac := ctx.consoles[key]
+	ctx.consoleLock.Unlock()
+	if ac != nil {
+		ac.mutex.Lock()
+	}
+	data, err := readConsoleTail(consoleFilename(key), int64(kbytes)*1024)
+	if ac != nil {
+		ac.mutex.Unlock()
+	}
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(data)
+	return err
+}
+
+// The last size bytes of filename.1 followed by filename
+func readConsoleTail(filename string, size int64) ([]byte, error) {
+	var data []byte
+	for _, fn := range []string{filename + ".1", filename} {
+		b, err := readFileTail(fn, size)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		data = append(data, b...)
+	}
+	if int64(len(data)) > size {
+		data = data[int64(len(data))-size:]
+	}
+	return data, nil
+}
+
+func readFileTail(filename string, size int64) ([]byte, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := info.Size() - size
+	if offset < 0 {
+		offset = 0
+	}
+	data := make([]byte, info.Size()-offset)
+	n, err := f.ReadAt(data, offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func consoleFilename(key string) string {
+	return consoleDirname + "/" + key + ".log"
+}
+
+// Called once the domain is running. HVM domains have the serial port
+// in addition to the PV console; we use the serial port.
+func startConsole(ctx *domainContext, status *types.DomainStatus) {
+	key := status.Key()
+	stopConsole(ctx, key)
+	var ttyName string
+	var err error
+	if status.VirtualizationMode == types.HVM {
+		ttyName, err = xenstoreRead(status.DomainId, "serial/0/tty")
+	}
+	if ttyName == "" {
+		ttyName, err = xenstoreRead(status.DomainId, "console/tty")
+	}
+	if err != nil {
+		log.Errorf("startConsole(%s) no console: %s\n", key, err)
+		return
+	}
+	tty, err := os.OpenFile(ttyName, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		log.Errorf("startConsole(%s) failed: %s\n", key, err)
+		return
+	}
+	log.Infof("startConsole(%s) %s\n", key, ttyName)
+	addConsole(ctx, key, tty)
+}
+
+// addConsole starts capturing the output of the tty and relaying it to
+// and from the clients
+func addConsole(ctx *domainContext, key string, tty *os.File) {
+	// The client's terminal does the echo and line editing
+	if err := setRaw(tty); err != nil {
+		log.Errorf("startConsole(%s) raw mode failed: %s\n", key, err)
+	}
+	ac := &appConsole{
+		key:     key,
+		tty:     tty,
+		clients: make(map[net.Conn]bool),
+	}
+	if err := ac.openLog(); err != nil {
+		log.Errorf("startConsole(%s) capture failed: %s\n", key, err)
+	}
+	ctx.consoleLock.Lock()
+	ctx.consoles[key] = ac
+	ctx.consoleLock.Unlock()
+	go ac.run()
+}
+
+// setRaw passes the bytes through unchanged in both directions like
+// cfmakeraw; no echo, line buffering, signals or CR/NL translation.
+// Does not use tty.Fd() since that would make the reads blocking and
+// stopConsole could not interrupt them.
+func setRaw(tty *os.File) error {
+	rawConn, err := tty.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var rawErr error
+	err = rawConn.Control(func(fd uintptr) {
+		rawErr = setRawFd(int(fd))
+	})
+	if err != nil {
+		return err
+	}
+	return rawErr
+}
+
+func setRawFd(fd int) error {
+	termios, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return err
+	}
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK |
+		unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG |
+		unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	return unix.IoctlSetTermios(fd, unix.TCSETS, termios)
+}
+
+func stopConsole(ctx *domainContext, key string) {
+	ctx.consoleLock.Lock()
+	ac := ctx.consoles[key]
+	delete(ctx.consoles, key)
+	ctx.consoleLock.Unlock()
+	if ac == nil {
+		return
+	}
+	log.Infof("stopConsole(%s)\n", key)
+	// Makes run return
+	ac.tty.Close()
+}
+
+// Called when the app instance is deleted
+func deleteConsoleLog(key string) {
+	filename := consoleFilename(key)
+	for _, fn := range []string{filename, filename + ".1"} {
+		if err := os.Remove(fn); err != nil && !os.IsNotExist(err) {
+			log.Errorln(err)
+		}
+	}
+}
+
+func (ac *appConsole) run() {
+	buf := make([]byte, 4096)
+	for {
+		n, err := ac.tty.Read(buf)
+		if n > 0 {
+			ac.output(buf[:n])
+		}
+		if err != nil {
+			log.Infof("appConsole(%s) done: %s\n", ac.key, err)
+			break
+		}
+	}
+	ac.mutex.Lock()
+	for conn := range ac.clients {
+		conn.Close()
+	}
+	ac.clients = nil
+	if ac.logFile != nil {
+		ac.logFile.Close()
+		ac.logFile = nil
+	}
+	ac.mutex.Unlock()
+}
+
+func (ac *appConsole) output(data []byte) {
+	ac.mutex.Lock()
+	defer ac.mutex.Unlock()
+	if ac.logFile != nil {
+		if ac.logSize+int64(len(data)) > maxConsoleLogSize {
+			ac.rotateLog()
+		}
+		if ac.logFile != nil {
+			n, _ := ac.logFile.Write(data)
+			ac.logSize += int64(n)
+		}
+	}
+	for conn := range ac.clients {
+		conn.SetWriteDeadline(time.Now().Add(consoleWriteTimeout))
+		if _, err := conn.Write(data); err != nil {
+			log.Warnf("appConsole(%s) dropping client: %s\n",
+				ac.key, err)
+			conn.Close()
+			delete(ac.clients, conn)
+		}
+	}
+}
+
+func (ac *appConsole) openLog() error {
+	filename := consoleFilename(ac.key)
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND,
+		0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	ac.logFile = f
+	ac.logSize = info.Size()
+	return nil
+}
+
+// Called with the mutex held
+func (ac *appConsole) rotateLog() {
+	filename := consoleFilename(ac.key)
+	ac.logFile.Close()
+	ac.logFile = nil
+	if err := os.Rename(filename, filename+".1"); err != nil {
+		log.Errorf("appConsole(%s) rotate failed: %s\n", ac.key, err)
+	}
+	if err := ac.openLog(); err != nil {
+		log.Errorf("appConsole(%s) capture failed: %s\n", ac.key, err)
+	}
+}
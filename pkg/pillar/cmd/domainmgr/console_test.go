@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// openPty returns the master, which is the domain side like with
+// xenconsoled, and the slave which domainmgr opens
+func openPty(t *testing.T) (*os.File, *os.File) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		t.Skipf("No pty: %s", err)
+	}
+	fd := int(master.Fd())
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		master.Close()
+		t.Skipf("No pty: %s", err)
+	}
+	n, err := unix.IoctlGetInt(fd, unix.TIOCGPTN)
+	if err != nil {
+		master.Close()
+		t.Skipf("No pty: %s", err)
+	}
+	slave, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", n),
+		os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		t.Skipf("No pty: %s", err)
+	}
+	return master, slave
+}
+
+// readFull reads len(expected) bytes with a timeout
+func readFull(t *testing.T, r io.Reader, expected string) {
+	buf := make([]byte, len(expected))
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.ReadFull(r, buf)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Read: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timeout reading %q", expected)
+	}
+	if string(buf) != expected {
+		t.Errorf("Expected %q, Actual: %q", expected, buf)
+	}
+}
+
+func TestConsoleAttach(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "console")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirname)
+	savedDirname := consoleDirname
+	consoleDirname = dirname
+	defer func() { consoleDirname = savedDirname }()
+
+	master, slave := openPty(t)
+	defer master.Close()
+	ctx := &domainContext{consoles: make(map[string]*appConsole)}
+	key := "4a144db0-6b63-405a-b884-7760042023b1"
+	addConsole(ctx, key, slave)
+
+	// The master has the termios of the slave. slave.Fd() would make
+	// its reads blocking hence stopConsole could not interrupt them.
+	termios, err := unix.IoctlGetTermios(int(master.Fd()), unix.TCGETS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if termios.Lflag&(unix.ECHO|unix.ICANON|unix.ISIG) != 0 ||
+		termios.Oflag&unix.OPOST != 0 {
+		t.Errorf("Not raw: lflag %x oflag %x", termios.Lflag,
+			termios.Oflag)
+	}
+
+	client, server := net.Pipe()
+	attached := make(chan error, 1)
+	go func() {
+		attached <- consoleAttach(ctx, key, server, server)
+	}()
+	// Wait for the client to be added
+	ac := ctx.consoles[key]
+	for i := 0; ; i++ {
+		ac.mutex.Lock()
+		n := len(ac.clients)
+		ac.mutex.Unlock()
+		if n == 1 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("Client not attached")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Input goes to the domain unchanged; no line buffering, no
+	// CR translation and ^C is not a signal
+	input := "ls\r\x03\x7f"
+	if _, err := client.Write([]byte(input)); err != nil {
+		t.Fatal(err)
+	}
+	readFull(t, master, input)
+
+	// Output goes to the client unchanged and without echo of the input
+	output := "login: \n"
+	if _, err := master.Write([]byte(output)); err != nil {
+		t.Fatal(err)
+	}
+	readFull(t, client, output)
+
+	// Stopping the console ends the attach
+	stopConsole(ctx, key)
+	select {
+	case err := <-attached:
+		if err != nil {
+			t.Errorf("consoleAttach: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("consoleAttach did not return")
+	}
+	client.Close()
+
+	data, err := readConsoleTail(consoleFilename(key), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != output {
+		t.Errorf("Expected capture %q, Actual: %q", output, data)
+	}
+}
@@ -84,6 +84,33 @@ type domainContext struct {
 	pubAssignableAdapters  *pubsub.Publication
 	usbAccess              bool
 	createSema             sema.Semaphore
+	// The USB devices in dom0 and which domain has claimed each of them
+	// by port path. Used by the handler goroutines hence the lock.
+	usbLock    sync.Mutex
+	usbDevices []types.UsbDevice
+	usbClaims  map[string]uuid.UUID
+	usbNotify  map[string]chan struct{}
+	// The handler goroutines waiting for adapters to hot-plug are
+	// notified when adapters are released
+	ioNotifyLock sync.Mutex
+	ioNotify     map[string]chan struct{}
+	// Admission control. What the device has in MBytes and CPUs, and
+	// what is allocated to each domain. Used by the handler goroutines.
+	pubDeviceCapacity *pubsub.Publication
+	capacityLock      sync.Mutex
+	totalMemory       uint64
+	dom0Memory        uint64
+	memoryReserve     uint64
+	ncpu              int
+	exclusivePinning  bool
+	allocations       map[string]types.DomainAllocation
+	// Auto ballooning. The threshold in MBytes and whether the free
+	// memory is below it. Also under capacityLock.
+	autoBalloonFree uint64
+	memoryPressure  bool
+	// The serial consoles of the running domains by UUID
+	consoleLock sync.Mutex
+	consoles    map[string]*appConsole
 }
 
 func (ctx *domainContext) publishAssignableAdapters() {
@@ -168,7 +195,10 @@ func Run() {
 		}
 	}
 
-	domainCtx := domainContext{usbAccess: true}
+	domainCtx := domainContext{
+		usbAccess:     true,
+		memoryReserve: uint64(types.GlobalConfigDefaults.MemoryReserve),
+	}
 	// Allow only one concurrent xl create
 	domainCtx.createSema = sema.Create(1)
 	domainCtx.createSema.P(1)
@@ -198,6 +228,14 @@ func Run() {
 	domainCtx.pubAssignableAdapters = pubAssignableAdapters
 	pubAssignableAdapters.ClearRestarted()
 
+	pubDeviceCapacity, err := pubsub.Publish(agentName,
+		types.DeviceCapacity{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.pubDeviceCapacity = pubDeviceCapacity
+	pubDeviceCapacity.ClearRestarted()
+
 	// Look for global config such as log levels
 	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
 		false, &domainCtx)
@@ -280,6 +318,15 @@ func Run() {
 	}
 	log.Infof("Have %d assignable adapters\n", len(aa.IoBundleList))
 
+	usbInit(&domainCtx)
+	publishUsbDevices(&domainCtx)
+	ioNotifyInit(&domainCtx)
+	capacityInit(&domainCtx)
+	publishDeviceCapacity(&domainCtx)
+	go memoryMonitor(&domainCtx)
+	consoleInit(&domainCtx)
+	usbTicker := time.NewTicker(usbScanInterval)
+
 	// Subscribe to DomainConfig from zedmanager
 	subDomainConfig, err := pubsub.Subscribe("zedmanager",
 		types.DomainConfig{}, false, &domainCtx)
@@ -313,6 +360,9 @@ func Run() {
 		case <-gc.C:
 			gcObjects(&domainCtx, rwImgDirname)
 
+		case <-usbTicker.C:
+			usbScan(&domainCtx)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -583,6 +633,11 @@ func runHandler(ctx *domainContext, key string, c <-chan interface{}) {
 	min := max * 0.3
 	ticker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
+	usbNotify := usbNotifyAdd(ctx, key)
+	ioNotify := ioNotifyAdd(ctx, key)
+	healthTicker := time.NewTicker(healthInterval)
+	var ps probeState
+	var bs balloonState
 
 	closed := false
 	for !closed {
@@ -609,10 +664,36 @@ func runHandler(ctx *domainContext, key string, c <-chan interface{}) {
 			status := lookupDomainStatus(ctx, key)
 			if status != nil {
 				verifyStatus(ctx, status)
+				checkCrashed(ctx, status)
 				maybeRetryBoot(ctx, status)
+				maybeRetryAdmission(ctx, status)
+				maybeRetryIoAdapters(ctx, status)
+				// Retry any failed attach
+				updateUsbDevices(ctx, status)
+				maybeAutoBalloon(ctx, status, &bs)
+			}
+		case <-ioNotify:
+			log.Debugf("runHandler(%s) adapters released\n", key)
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				maybeRetryIoAdapters(ctx, status)
+			}
+		case <-usbNotify:
+			log.Debugf("runHandler(%s) USB change\n", key)
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				updateUsbDevices(ctx, status)
+			}
+		case <-healthTicker.C:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				checkHealth(ctx, status, &ps)
 			}
 		}
 	}
+	healthTicker.Stop()
+	usbNotifyDelete(ctx, key)
+	ioNotifyDelete(ctx, key)
 	log.Infof("runHandler(%s) DONE\n", key)
 }
 
@@ -627,6 +708,10 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			log.Warnln(errStr)
 			status.Activated = false
 			status.State = types.HALTED
+			releaseUsbDevices(ctx, status)
+			stopConsole(ctx, status.Key())
+			status.DomainId = 0
+			domainHalted(ctx, status)
 		}
 		status.DomainId = 0
 		publishDomainStatus(ctx, status)
@@ -640,16 +725,36 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			status.Activated = true
 			status.State = types.RUNNING
 			publishDomainStatus(ctx, status)
+			startConsole(ctx, status)
 		} else if domainId != status.DomainId {
 			// XXX shutdown + create?
 			log.Warnf("verifyDomain(%s) domainId changed from %d to %d\n",
 				status.Key(), status.DomainId, domainId)
 			status.DomainId = domainId
 			publishDomainStatus(ctx, status)
+			// Rebooted hence a new console
+			startConsole(ctx, status)
+			reapplyResources(ctx, status)
 		}
 	}
 }
 
+// Some other domain might have released memory or CPUs
+func maybeRetryAdmission(ctx *domainContext, status *types.DomainStatus) {
+
+	if !status.AdmissionFailed {
+		return
+	}
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate {
+		return
+	}
+	log.Infof("maybeRetryAdmission(%s) after %s at %v\n",
+		status.Key(), status.LastErr, status.LastErrTime)
+	doActivate(ctx, *config, status)
+	publishDomainStatus(ctx, status)
+}
+
 func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 
 	if !status.BootFailed {
@@ -747,6 +852,7 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 	}
 	status.DiskStatusList = make([]types.DiskStatus,
 		len(config.DiskConfigList))
+	usbConfigToStatus(*config, &status)
 	publishDomainStatus(ctx, &status)
 	log.Infof("handleCreate(%v) set domainName %s for %s\n",
 		config.UUIDandVersion, status.DomainName,
@@ -927,8 +1033,24 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		status.IoAdapterList = config.IoAdapterList
 	}
 
+	if err := admitDomain(ctx, config, *status); err != nil {
+		status.PendingAdd = false
+		status.LastErr = fmt.Sprintf("%v", err)
+		status.LastErrTime = time.Now()
+		status.AdmissionFailed = true
+		publishDomainStatus(ctx, status)
+		return
+	}
+	if status.AdmissionFailed {
+		status.AdmissionFailed = false
+		status.LastErr = ""
+		status.LastErrTime = time.Time{}
+	}
+
 	// Assign any I/O devices
 	doAssignIoAdaptersToDomain(ctx, config, status)
+	// USB devices are attached once the domain is running
+	usbConfigToStatus(config, status)
 
 	// Do we need to copy any rw files? Preserve ones are copied upon
 	// creation
@@ -1003,6 +1125,7 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 	status.Activated = true
 	status.BootTime = time.Now()
 	status.State = types.BOOTING
+	initResources(ctx, status)
 	publishDomainStatus(ctx, status)
 
 	// Disable offloads for all vifs
@@ -1030,6 +1153,9 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 	if err == nil && domainId != status.DomainId {
 		status.DomainId = domainId
 	}
+	updateUsbDevices(ctx, status)
+	repinUnpinned(ctx)
+	startConsole(ctx, status)
 	log.Infof("doActivateTail(%v) done for %s\n",
 		status.UUIDandVersion, status.DisplayName)
 }
@@ -1141,6 +1267,12 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus) {
 		}
 	}
 	pciUnassign(ctx, status, false)
+	releaseUsbDevices(ctx, status)
+	stopConsole(ctx, status.Key())
+	releaseDomain(ctx, status.Key())
+	status.AdmissionFailed = false
+	status.Health.PendingRestart = false
+	clearResources(status)
 
 	log.Infof("doInactivate(%v) done for %s\n",
 		status.UUIDandVersion, status.DisplayName)
@@ -1260,33 +1392,44 @@ func configAdapters(ctx *domainContext, config types.DomainConfig) error {
 	defer ctx.publishAssignableAdapters()
 
 	for _, adapter := range config.IoAdapterList {
-		log.Debugf("configAdapters processing adapter %d %s\n",
-			adapter.Type, adapter.Name)
-		// Lookup to make sure adapter exists on this device
-		ib := types.LookupIoBundle(ctx.assignableAdapters, adapter.Type, adapter.Name)
-		if ib == nil {
-			return errors.New(fmt.Sprintf("Unknown adapter %d %s\n",
-				adapter.Type, adapter.Name))
-		}
-		if ib.UsedByUUID != nilUUID {
-			return errors.New(fmt.Sprintf("Adapter %d %s used by %s\n",
-				adapter.Type, adapter.Name, ib.UsedByUUID))
-		}
-		for _, m := range ib.Members {
-			if isPort(ctx, m) {
-				return errors.New(fmt.Sprintf("Adapter %d %s member %s is (part of) a zedrouter port\n",
-					adapter.Type, adapter.Name, m))
-			}
+		if err := reserveAdapter(ctx, config, adapter); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if ib.Lookup && ib.MPciShort == nil {
-			log.Fatalf("configAdapters lookup missing: %d %s for %s\n",
-				adapter.Type, adapter.Name, config.DisplayName)
+// Check and reserve one adapter. Also used when adding an adapter to a
+// running domain
+func reserveAdapter(ctx *domainContext, config types.DomainConfig,
+	adapter types.IoAdapter) error {
+
+	log.Debugf("reserveAdapter processing adapter %d %s\n",
+		adapter.Type, adapter.Name)
+	// Lookup to make sure adapter exists on this device
+	ib := types.LookupIoBundle(ctx.assignableAdapters, adapter.Type, adapter.Name)
+	if ib == nil {
+		return errors.New(fmt.Sprintf("Unknown adapter %d %s\n",
+			adapter.Type, adapter.Name))
+	}
+	if ib.UsedByUUID != nilUUID {
+		return errors.New(fmt.Sprintf("Adapter %d %s used by %s\n",
+			adapter.Type, adapter.Name, ib.UsedByUUID))
+	}
+	for _, m := range ib.Members {
+		if isPort(ctx, m) {
+			return errors.New(fmt.Sprintf("Adapter %d %s member %s is (part of) a zedrouter port\n",
+				adapter.Type, adapter.Name, m))
 		}
-		log.Debugf("configAdapters setting uuid %s for adapter %d %s\n",
-			config.Key(), adapter.Type, adapter.Name)
-		ib.UsedByUUID = config.UUIDandVersion.UUID
 	}
+
+	if ib.Lookup && ib.MPciShort == nil {
+		log.Fatalf("reserveAdapter lookup missing: %d %s for %s\n",
+			adapter.Type, adapter.Name, config.DisplayName)
+	}
+	log.Debugf("reserveAdapter setting uuid %s for adapter %d %s\n",
+		config.Key(), adapter.Type, adapter.Name)
+	ib.UsedByUUID = config.UUIDandVersion.UUID
 	return nil
 }
 
@@ -1487,6 +1630,21 @@ func configToXencfg(config types.DomainConfig, status types.DomainStatus,
 			file.WriteString(fmt.Sprintf("%s\n", ib.XenCfg))
 		}
 	}
+	// A USB controller for the USB devices which are attached later
+	if len(config.UsbDeviceList) > maxUsbPorts {
+		errStr := fmt.Sprintf("Too many USB devices %d; max %d",
+			len(config.UsbDeviceList), maxUsbPorts)
+		return errors.New(errStr)
+	}
+	if len(config.UsbDeviceList) != 0 {
+		file.WriteString(fmt.Sprintf("usbctrl = ['version=2,ports=%d']\n",
+			len(config.UsbDeviceList)))
+	}
+	if config.RestartPolicy.Mode != types.RestartNever {
+		// Keep a crashed domain so that checkCrashed can tell it
+		// apart from one which halted
+		file.WriteString("on_crash = \"preserve\"\n")
+	}
 	if len(pciAssignments) != 0 {
 		log.Debugf("PCI assignments %v\n", pciAssignments)
 		cfg := fmt.Sprintf("pci = [ ")
@@ -1535,6 +1693,17 @@ func handleModify(ctx *domainContext, key string,
 	status.PendingModify = true
 	publishDomainStatus(ctx, status)
 
+	// Adapters are reserved again if the domain is not running and
+	// hot-plugged if it is running
+	adaptersChanged := !cmp.Equal(config.IoAdapterList, status.IoAdapterList)
+	if adaptersChanged {
+		if !status.Activated {
+			reserveIoAdapters(ctx, *config, status)
+		} else if config.Activate {
+			updateIoAdapters(ctx, *config, status)
+		}
+	}
+
 	changed := false
 	if config.Activate && !status.Activated {
 		// AppNum could have changed if we did not already Activate
@@ -1576,12 +1745,19 @@ func handleModify(ctx *domainContext, key string,
 			changed = true
 		}
 	}
+	// When halting the domain
+	if !status.Activated && !status.AdaptersFailed &&
+		!cmp.Equal(config.IoAdapterList, status.IoAdapterList) {
+		reserveIoAdapters(ctx, *config, status)
+	}
+	if adaptersChanged {
+		changed = true
+	}
+	// Memory and vCPUs up to the limits of the running domain
+	if config.Activate && status.Activated {
+		resizeDomain(ctx, *config, status)
+	}
 	if changed {
-		// XXX could we also have changes in the IoBundle?
-		// Need to update the UsedByUUID if so since we reserved
-		// the IoBundle in handleCreate before activating.
-		// XXX currently those reservations are only changed
-		// in handleDelete
 		status.PendingModify = false
 		publishDomainStatus(ctx, status)
 		log.Infof("handleModify(%v) DONE for %s\n",
@@ -1669,6 +1845,10 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 	// XXX zedagent might assume that the setting to nil arrives before
 	// the delete of the DomainStatus. Check
 	cleanupAdapters(ctx, status.IoAdapterList, status.UUIDandVersion.UUID)
+	ioNotifyAll(ctx)
+	releaseDomain(ctx, status.Key())
+	stopConsole(ctx, status.Key())
+	deleteConsoleLog(status.Key())
 
 	publishDomainStatus(ctx, status)
 
@@ -2025,6 +2205,9 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 			ctx.usbAccess = gcp.UsbAccess
 			updateUsbAccess(ctx)
 		}
+		updateCapacityConfig(ctx, uint64(gcp.MemoryReserve),
+			gcp.ExclusiveCPUPinning)
+		updateAutoBalloonConfig(ctx, uint64(gcp.AutoBalloonFreeMemory))
 	}
 	log.Infof("handleGlobalConfigModify done for %s\n", key)
 }
@@ -2173,6 +2356,7 @@ func handleAAModify(ctxArg interface{}, config types.AssignableAdapters,
 	}
 	status.Initialized = true
 	ctx.publishAssignableAdapters()
+	ioNotifyAll(ctx)
 	log.Infof("handleAAModify() done\n")
 }
 
@@ -0,0 +1,341 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Health probes and restart policies. The handler goroutine of each domain
+// probes the app and checks whether the domain crashed or halted. Based on
+// the RestartPolicy in the DomainConfig it then restarts the domain after a
+// backoff which doubles for each consecutive restart.
+
+package domainmgr
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+)
+
+const (
+	healthInterval = 5 * time.Second
+	// Also the time a domain has to run for the backoff to start over
+	maxRestartBackoff = 600 * time.Second
+	heartbeatKey      = "data/eve-heartbeat"
+)
+
+// What the handler goroutine keeps between probes
+type probeState struct {
+	bootTime      time.Time
+	lastProbe     time.Time
+	lastHeartbeat string
+}
+
+// Called from the handler goroutine every healthInterval
+func checkHealth(ctx *domainContext, status *types.DomainStatus,
+	ps *probeState) {
+
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate {
+		return
+	}
+	if status.Health.PendingRestart {
+		maybeRestart(ctx, *config, status)
+		return
+	}
+	maybeProbe(ctx, *config, status, ps)
+}
+
+// Called from the handler goroutine after verifyStatus. With a restart
+// policy a crashed domain is preserved by xen so we can tell.
+func checkCrashed(ctx *domainContext, status *types.DomainStatus) {
+	if !status.Activated || status.DomainId == 0 ||
+		status.Health.PendingRestart {
+		return
+	}
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate ||
+		config.RestartPolicy.Mode == types.RestartNever {
+		return
+	}
+	crashed, err := xlDomainCrashed(status.DomainName)
+	if err != nil || !crashed {
+		return
+	}
+	log.Warnf("checkCrashed(%s) domain %d crashed\n",
+		status.Key(), status.DomainId)
+	if err := xlDestroy(status.DomainName, status.DomainId); err != nil {
+		log.Errorf("checkCrashed(%s) destroy failed: %s\n",
+			status.Key(), err)
+	}
+	scheduleRestart(ctx, *config, status, true, "crashed")
+}
+
+// Called from verifyStatus when the domain went away without us halting it
+func domainHalted(ctx *domainContext, status *types.DomainStatus) {
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate {
+		return
+	}
+	scheduleRestart(ctx, *config, status, false, "halted by guest")
+}
+
+// Called when the domain is no longer running or is unhealthy. failed
+// is false if the guest halted itself.
+func scheduleRestart(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus, failed bool, reason string) {
+
+	policy := config.RestartPolicy
+	switch policy.Mode {
+	case types.RestartNever:
+		return
+	case types.RestartOnFailure:
+		if !failed {
+			log.Infof("scheduleRestart(%s) %s; no restart\n",
+				status.Key(), reason)
+			return
+		}
+	}
+	health := &status.Health
+	if health.PendingRestart {
+		return
+	}
+	backoff, ok := restartBackoff(policy, health, status.BootTime,
+		time.Now())
+	if !ok {
+		errStr := fmt.Sprintf("%s; not restarted after %d attempts",
+			reason, health.Attempts)
+		log.Errorf("scheduleRestart(%s) %s\n", status.Key(), errStr)
+		status.LastErr = errStr
+		status.LastErrTime = time.Now()
+		publishDomainStatus(ctx, status)
+		return
+	}
+	log.Warnf("scheduleRestart(%s) %s; restart in %v\n",
+		status.Key(), reason, backoff)
+	health.PendingRestart = true
+	health.LastRestartReason = reason
+	health.NextRestartTime = time.Now().Add(backoff)
+	publishDomainStatus(ctx, status)
+}
+
+// restartBackoff returns the delay before the next restart, or false if
+// the policy allows no more attempts. The attempts start over if the
+// domain ran for maxRestartBackoff since it was booted.
+func restartBackoff(policy types.RestartPolicy, health *types.HealthStatus,
+	bootTime time.Time, now time.Time) (time.Duration, bool) {
+
+	if !bootTime.IsZero() && now.Sub(bootTime) > maxRestartBackoff {
+		health.Attempts = 0
+	}
+	if policy.MaxAttempts != 0 && health.Attempts >= policy.MaxAttempts {
+		return 0, false
+	}
+	return policy.RestartBackoff(health.Attempts, maxRestartBackoff), true
+}
+
+func maybeRestart(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) {
+
+	health := &status.Health
+	if time.Now().Before(health.NextRestartTime) {
+		return
+	}
+	log.Infof("maybeRestart(%s) after %s\n", status.Key(),
+		health.LastRestartReason)
+	health.PendingRestart = false
+	health.NextRestartTime = time.Time{}
+	health.Attempts++
+	health.RestartCount++
+	health.LastRestartTime = time.Now()
+	setHealthState(health, types.HealthUnknown)
+	health.LastProbeError = ""
+	health.ConsecutiveFailures = 0
+
+	// Release what it has like for a halt
+	doInactivate(ctx, status)
+	if status.Activated {
+		log.Errorf("maybeRestart(%s) failed to halt: %s\n",
+			status.Key(), status.LastErr)
+		publishDomainStatus(ctx, status)
+		return
+	}
+	doActivate(ctx, config, status)
+	publishDomainStatus(ctx, status)
+	log.Infof("maybeRestart(%s) done\n", status.Key())
+}
+
+func maybeProbe(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus, ps *probeState) {
+
+	health := &status.Health
+	if config.HealthProbe.Type == types.ProbeNone {
+		if health.State != types.HealthUnknown {
+			setHealthState(health, types.HealthUnknown)
+			health.LastProbeError = ""
+			health.ConsecutiveFailures = 0
+			publishDomainStatus(ctx, status)
+		}
+		return
+	}
+	if !status.Activated || status.State != types.RUNNING {
+		return
+	}
+	if ps.bootTime != status.BootTime {
+		// Rebooted; start over
+		*ps = probeState{bootTime: status.BootTime}
+	}
+	probe := config.HealthProbe.WithDefaults()
+	now := time.Now()
+	if now.Sub(status.BootTime) < time.Duration(probe.InitialDelay)*time.Second {
+		return
+	}
+	if now.Sub(ps.lastProbe) < time.Duration(probe.Interval)*time.Second {
+		return
+	}
+	ps.lastProbe = now
+	err := runProbe(probe, config.ProbeIPAddr, status, ps)
+	if err == nil {
+		if health.State != types.HealthHealthy ||
+			health.ConsecutiveFailures != 0 {
+			log.Infof("maybeProbe(%s) healthy\n", status.Key())
+			setHealthState(health, types.HealthHealthy)
+			health.LastProbeError = ""
+			health.ConsecutiveFailures = 0
+			publishDomainStatus(ctx, status)
+		}
+		return
+	}
+	log.Warnf("maybeProbe(%s) failed: %s\n", status.Key(), err)
+	if health.State == types.HealthUnhealthy {
+		// Avoid publishing the same failure over and over
+		if health.LastProbeError != err.Error() {
+			health.LastProbeError = err.Error()
+			publishDomainStatus(ctx, status)
+		}
+		return
+	}
+	health.LastProbeError = err.Error()
+	health.ConsecutiveFailures++
+	if health.ConsecutiveFailures < probe.FailureThreshold {
+		publishDomainStatus(ctx, status)
+		return
+	}
+	log.Errorf("maybeProbe(%s) unhealthy after %d failures\n",
+		status.Key(), health.ConsecutiveFailures)
+	setHealthState(health, types.HealthUnhealthy)
+	publishDomainStatus(ctx, status)
+	scheduleRestart(ctx, config, status, true,
+		"health probe failed: "+err.Error())
+}
+
+func setHealthState(health *types.HealthStatus, state types.HealthState) {
+	if health.State != state {
+		health.State = state
+		health.StateTime = time.Now()
+	}
+}
+
+func runProbe(probe types.HealthProbe, ipAddr string,
+	status *types.DomainStatus, ps *probeState) error {
+
+	timeout := time.Duration(probe.Timeout) * time.Second
+	switch probe.Type {
+	case types.ProbeTCP, types.ProbeHTTP:
+		if ipAddr == "" {
+			return errors.New("no IP address for the probe")
+		}
+		hostPort := net.JoinHostPort(ipAddr,
+			strconv.Itoa(int(probe.Port)))
+		if probe.Type == types.ProbeTCP {
+			conn, err := net.DialTimeout("tcp", hostPort, timeout)
+			if err != nil {
+				return err
+			}
+			conn.Close()
+			return nil
+		}
+		return probeHTTP("http://"+hostPort+probe.Path, timeout)
+	case types.ProbeHeartbeat:
+		value, err := xenstoreRead(status.DomainId, heartbeatKey)
+		if err != nil {
+			return err
+		}
+		if value == ps.lastHeartbeat {
+			errStr := fmt.Sprintf("no heartbeat in %d seconds",
+				probe.Interval)
+			return errors.New(errStr)
+		}
+		ps.lastHeartbeat = value
+		return nil
+	default:
+		errStr := fmt.Sprintf("unknown probe type %d", probe.Type)
+		return errors.New(errStr)
+	}
+}
+
+// Any 2xx or 3xx response is healthy. The app is on a local network
+// instance hence no proxy.
+func probeHTTP(url string, timeout time.Duration) error {
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DisableKeepAlives: true},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		errStr := fmt.Sprintf("GET %s returned %s", url, resp.Status)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// The state flags of xl list are rbpscd; c is crashed
+func xlDomainCrashed(domainName string) (bool, error) {
+	cmd := "xl"
+	args := []string{
+		"list",
+		domainName,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xl list failed: %s", string(stdoutStderr))
+		return false, errors.New(errStr)
+	}
+	// Name ID Mem VCPUs State Time(s)
+	lines := strings.Split(strings.TrimSpace(string(stdoutStderr)), "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 5 {
+		errStr := fmt.Sprintf("xl list unexpected output: %s",
+			string(stdoutStderr))
+		return false, errors.New(errStr)
+	}
+	return strings.Contains(fields[4], "c"), nil
+}
+
+func xenstoreRead(domainId int, key string) (string, error) {
+	path := fmt.Sprintf("/local/domain/%d/%s", domainId, key)
+	cmd := "xenstore"
+	args := []string{
+		"read",
+		path,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xenstore read %s failed: %s",
+			path, strings.TrimSpace(string(stdoutStderr)))
+		return "", errors.New(errStr)
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+type TestRestartBackoffMatrix struct {
+	policy           types.RestartPolicy
+	attempts         uint32
+	running          time.Duration // Since boot; zero if not booted
+	expectedBackoff  time.Duration
+	expectedOk       bool
+	expectedAttempts uint32
+}
+
+func TestRestartBackoff(t *testing.T) {
+	now := time.Now()
+	testMatrix := map[string]TestRestartBackoffMatrix{
+		"First": {
+			policy:           types.RestartPolicy{Mode: types.RestartAlways},
+			attempts:         0,
+			running:          time.Second,
+			expectedBackoff:  10 * time.Second,
+			expectedOk:       true,
+			expectedAttempts: 0,
+		},
+		"Doubled": {
+			policy:           types.RestartPolicy{Mode: types.RestartAlways, Backoff: 5},
+			attempts:         3,
+			running:          time.Minute,
+			expectedBackoff:  40 * time.Second,
+			expectedOk:       true,
+			expectedAttempts: 3,
+		},
+		"Not booted": {
+			policy:           types.RestartPolicy{Mode: types.RestartOnFailure, Backoff: 5},
+			attempts:         2,
+			running:          0,
+			expectedBackoff:  20 * time.Second,
+			expectedOk:       true,
+			expectedAttempts: 2,
+		},
+		"Ran long enough": {
+			policy:           types.RestartPolicy{Mode: types.RestartAlways, Backoff: 5},
+			attempts:         3,
+			running:          maxRestartBackoff + time.Second,
+			expectedBackoff:  5 * time.Second,
+			expectedOk:       true,
+			expectedAttempts: 0,
+		},
+		"Max attempts": {
+			policy:           types.RestartPolicy{Mode: types.RestartAlways, MaxAttempts: 3},
+			attempts:         3,
+			running:          time.Minute,
+			expectedOk:       false,
+			expectedAttempts: 3,
+		},
+		"Max attempts after running long enough": {
+			policy:           types.RestartPolicy{Mode: types.RestartAlways, MaxAttempts: 3},
+			attempts:         3,
+			running:          maxRestartBackoff + time.Second,
+			expectedBackoff:  10 * time.Second,
+			expectedOk:       true,
+			expectedAttempts: 0,
+		},
+		"Capped": {
+			policy:           types.RestartPolicy{Mode: types.RestartAlways, Backoff: 60},
+			attempts:         8,
+			running:          time.Minute,
+			expectedBackoff:  maxRestartBackoff,
+			expectedOk:       true,
+			expectedAttempts: 8,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		health := types.HealthStatus{Attempts: test.attempts}
+		var bootTime time.Time
+		if test.running != 0 {
+			bootTime = now.Add(-test.running)
+		}
+		backoff, ok := restartBackoff(test.policy, &health, bootTime, now)
+		if backoff != test.expectedBackoff || ok != test.expectedOk ||
+			health.Attempts != test.expectedAttempts {
+			t.Errorf("Test Failed: %s: Expected %v %v %d, Actual: %v %v %d\n",
+				testname, test.expectedBackoff, test.expectedOk,
+				test.expectedAttempts, backoff, ok, health.Attempts)
+		}
+	}
+}
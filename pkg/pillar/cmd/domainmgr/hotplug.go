@@ -0,0 +1,354 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Changes to the IoAdapterList of a domain. If the domain is not running
+// the reservations are updated. If it is running the PCI devices of the
+// removed and added adapters are detached and attached using xl. If that
+// is not possible, e.g., the adapter is only a XenCfg template or xl fails,
+// the domain is restarted with the new adapters. If the new adapters can
+// not be reserved the hot-plug is retried when adapters are released.
+
+package domainmgr
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+)
+
+// Release the old adapters and reserve the new ones for a domain which
+// is not running. Sets AdaptersFailed if they can not all be reserved,
+// in which case doActivate will retry.
+func reserveIoAdapters(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) {
+
+	log.Infof("reserveIoAdapters(%s) from %v to %v\n", status.Key(),
+		status.IoAdapterList, config.IoAdapterList)
+	myUUID := config.UUIDandVersion.UUID
+	cleanupAdapters(ctx, status.IoAdapterList, myUUID)
+	cleanupAdapters(ctx, config.IoAdapterList, myUUID)
+	if err := configAdapters(ctx, config); err != nil {
+		log.Errorf("Failed to reserve adapters for %v: %s\n",
+			config, err)
+		cleanupAdapters(ctx, config.IoAdapterList, myUUID)
+		ctx.publishAssignableAdapters()
+		status.LastErr = fmt.Sprintf("%v", err)
+		status.LastErrTime = time.Now()
+		status.AdaptersFailed = true
+		status.IoAdapterList = nil
+	} else {
+		status.AdaptersFailed = false
+		status.IoAdapterList = config.IoAdapterList
+	}
+	publishDomainStatus(ctx, status)
+}
+
+// Hot-plug and hot-unplug for a running domain. If the new adapters can
+// not be reserved the domain keeps running with the old ones and
+// AdaptersPending is set.
+func updateIoAdapters(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) {
+
+	log.Infof("updateIoAdapters(%s) from %v to %v\n", status.Key(),
+		status.IoAdapterList, config.IoAdapterList)
+	myUUID := config.UUIDandVersion.UUID
+	added := ioAdapterDiff(config.IoAdapterList, status.IoAdapterList)
+	removed := ioAdapterDiff(status.IoAdapterList, config.IoAdapterList)
+
+	// Reserve the new ones first so that a failure changes nothing
+	for i, adapter := range added {
+		if err := reserveAdapter(ctx, config, adapter); err != nil {
+			log.Errorf("updateIoAdapters(%s) failed: %s\n",
+				status.Key(), err)
+			cleanupAdapters(ctx, added[:i], myUUID)
+			ctx.publishAssignableAdapters()
+			status.LastErr = fmt.Sprintf("%v", err)
+			status.LastErrTime = time.Now()
+			status.AdaptersPending = true
+			publishDomainStatus(ctx, status)
+			return
+		}
+	}
+	ctx.publishAssignableAdapters()
+	status.AdaptersPending = false
+
+	if !canHotplug(ctx, added) || !canHotplug(ctx, removed) {
+		log.Infof("updateIoAdapters(%s) can not hot-plug; restarting\n",
+			status.Key())
+		restartForIoAdapters(ctx, config, status)
+		return
+	}
+	for i, adapter := range removed {
+		if err := hotUnplugAdapter(ctx, status, adapter); err != nil {
+			log.Errorf("updateIoAdapters(%s) restarting: %s\n",
+				status.Key(), err)
+			// Put back the ones we already removed
+			for _, adapter := range removed[:i] {
+				hotplugAdapter(ctx, status, adapter)
+			}
+			restartForIoAdapters(ctx, config, status)
+			return
+		}
+	}
+	for i, adapter := range added {
+		if err := hotplugAdapter(ctx, status, adapter); err != nil {
+			log.Errorf("updateIoAdapters(%s) restarting: %s\n",
+				status.Key(), err)
+			for _, adapter := range added[:i] {
+				hotUnplugAdapter(ctx, status, adapter)
+			}
+			restartForIoAdapters(ctx, config, status)
+			return
+		}
+	}
+	// Release the removed ones; like pciUnassign
+	for _, adapter := range removed {
+		releaseAdapter(ctx, status, adapter)
+	}
+	checkIoBundleAll(ctx)
+	ctx.publishAssignableAdapters()
+	if len(removed) != 0 {
+		ioNotifyAll(ctx)
+	}
+	status.IoAdapterList = config.IoAdapterList
+	publishDomainStatus(ctx, status)
+	log.Infof("updateIoAdapters(%s) done\n", status.Key())
+}
+
+// Called from the handler goroutine when adapters are released and by
+// its timer
+func maybeRetryIoAdapters(ctx *domainContext, status *types.DomainStatus) {
+
+	if !status.AdaptersPending {
+		return
+	}
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate || !status.Activated {
+		// handleModify reserves them when it is activated
+		status.AdaptersPending = false
+		publishDomainStatus(ctx, status)
+		return
+	}
+	log.Infof("maybeRetryIoAdapters(%s) after %s at %v\n",
+		status.Key(), status.LastErr, status.LastErrTime)
+	updateIoAdapters(ctx, *config, status)
+}
+
+func ioNotifyInit(ctx *domainContext) {
+	ctx.ioNotifyLock.Lock()
+	defer ctx.ioNotifyLock.Unlock()
+	ctx.ioNotify = make(map[string]chan struct{})
+}
+
+// Returns the channel on which the goroutine for the domain is notified
+func ioNotifyAdd(ctx *domainContext, key string) <-chan struct{} {
+	ctx.ioNotifyLock.Lock()
+	defer ctx.ioNotifyLock.Unlock()
+	c := make(chan struct{}, 1)
+	ctx.ioNotify[key] = c
+	return c
+}
+
+func ioNotifyDelete(ctx *domainContext, key string) {
+	ctx.ioNotifyLock.Lock()
+	defer ctx.ioNotifyLock.Unlock()
+	delete(ctx.ioNotify, key)
+}
+
+// Notify all the domains that adapters might have been released
+func ioNotifyAll(ctx *domainContext) {
+	ctx.ioNotifyLock.Lock()
+	defer ctx.ioNotifyLock.Unlock()
+	for _, c := range ctx.ioNotify {
+		// Drop if there is already a notification pending
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// The fallback is to halt and boot with the new adapters
+func restartForIoAdapters(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) {
+
+	doInactivate(ctx, status)
+	if status.Activated {
+		// Failed to halt; keep the old adapters
+		cleanupAdapters(ctx, ioAdapterDiff(config.IoAdapterList,
+			status.IoAdapterList), config.UUIDandVersion.UUID)
+		ctx.publishAssignableAdapters()
+		return
+	}
+	reserveIoAdapters(ctx, config, status)
+	doActivate(ctx, config, status)
+}
+
+// Returns the adapters in list which are not in other
+func ioAdapterDiff(list []types.IoAdapter, other []types.IoAdapter) []types.IoAdapter {
+	var diff []types.IoAdapter
+	for _, adapter := range list {
+		found := false
+		for _, o := range other {
+			if o == adapter {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, adapter)
+		}
+	}
+	return diff
+}
+
+// Adapters which are a XenCfg template, e.g., serial ports, need a restart
+func canHotplug(ctx *domainContext, adapters []types.IoAdapter) bool {
+	for _, adapter := range adapters {
+		ib := types.LookupIoBundle(ctx.assignableAdapters,
+			adapter.Type, adapter.Name)
+		if ib == nil {
+			return false
+		}
+		if len(ioBundlePciShort(ib)) == 0 {
+			log.Infof("canHotplug: not PCI: %d %s\n",
+				adapter.Type, adapter.Name)
+			return false
+		}
+	}
+	return true
+}
+
+func ioBundlePciShort(ib *types.IoBundle) []string {
+	if ib.Lookup {
+		return ib.MPciShort
+	}
+	if ib.PciShort != "" {
+		return []string{ib.PciShort}
+	}
+	return nil
+}
+
+func hotplugAdapter(ctx *domainContext, status *types.DomainStatus,
+	adapter types.IoAdapter) error {
+
+	ib := types.LookupIoBundle(ctx.assignableAdapters, adapter.Type,
+		adapter.Name)
+	if ib == nil {
+		errStr := fmt.Sprintf("hotplugAdapter IoBundle disappeared %d %s",
+			adapter.Type, adapter.Name)
+		return errors.New(errStr)
+	}
+	// Like doAssignIoAdaptersToDomain
+	if ib.Type == types.IoUSB && ctx.usbAccess && !ib.IsPCIBack {
+		log.Infof("Assigning %s (%s %s) to %s\n",
+			ib.Name, ib.PciLong, ib.PciShort, status.DomainName)
+		if err := pciAssignableAdd(ib.PciLong); err != nil {
+			return err
+		}
+		ib.IsPCIBack = true
+	}
+	shorts := ioBundlePciShort(ib)
+	for i, short := range shorts {
+		if err := xlPciAttach(status.DomainName, short); err != nil {
+			for _, short := range shorts[:i] {
+				xlPciDetach(status.DomainName, short)
+			}
+			if ib.Type == types.IoUSB && ctx.usbAccess {
+				if err := pciAssignableRemove(ib.PciLong); err == nil {
+					ib.IsPCIBack = false
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func hotUnplugAdapter(ctx *domainContext, status *types.DomainStatus,
+	adapter types.IoAdapter) error {
+
+	ib := types.LookupIoBundle(ctx.assignableAdapters, adapter.Type,
+		adapter.Name)
+	if ib == nil {
+		errStr := fmt.Sprintf("hotUnplugAdapter IoBundle disappeared %d %s",
+			adapter.Type, adapter.Name)
+		return errors.New(errStr)
+	}
+	shorts := ioBundlePciShort(ib)
+	for i, short := range shorts {
+		if err := xlPciDetach(status.DomainName, short); err != nil {
+			for _, short := range shorts[:i] {
+				xlPciAttach(status.DomainName, short)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Clear UsedByUUID and give USB controllers back to dom0 if usbAccess
+func releaseAdapter(ctx *domainContext, status *types.DomainStatus,
+	adapter types.IoAdapter) {
+
+	ib := types.LookupIoBundle(ctx.assignableAdapters, adapter.Type,
+		adapter.Name)
+	if ib == nil || ib.UsedByUUID != status.UUIDandVersion.UUID {
+		return
+	}
+	if ib.Type == types.IoUSB && ctx.usbAccess && ib.IsPCIBack {
+		log.Infof("Removing %s (%s %s) from %s\n",
+			ib.Name, ib.PciLong, ib.PciShort, status.DomainName)
+		if err := pciAssignableRemove(ib.PciLong); err != nil {
+			status.LastErr = fmt.Sprintf("%v", err)
+			status.LastErrTime = time.Now()
+		} else {
+			ib.IsPCIBack = false
+		}
+	}
+	log.Infof("releaseAdapter clearing uuid for adapter %d %s\n",
+		adapter.Type, adapter.Name)
+	ib.UsedByUUID = nilUUID
+}
+
+func xlPciAttach(domainName string, pciShort string) error {
+	log.Infof("xlPciAttach %s %s\n", domainName, pciShort)
+	cmd := "xl"
+	args := []string{
+		"pci-attach",
+		domainName,
+		pciShort,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl pci-attach failed ", err)
+		log.Errorln("xl pci-attach output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl pci-attach failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlPciAttach done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+func xlPciDetach(domainName string, pciShort string) error {
+	log.Infof("xlPciDetach %s %s\n", domainName, pciShort)
+	cmd := "xl"
+	args := []string{
+		"pci-detach",
+		domainName,
+		pciShort,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl pci-detach failed ", err)
+		log.Errorln("xl pci-detach output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl pci-detach failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlPciDetach done. Result %s\n", string(stdoutStderr))
+	return nil
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Online resizing. The memory of a running domain is changed by the balloon
+// driver up to the maxmem the domain was created with, and its vCPUs are
+// brought online or offline up to its maxcpus. In addition, when the free
+// memory of the host drops below AutoBalloonFreeMemory from GlobalConfig,
+// idle domains which have a MaxMem are ballooned down until there is
+// enough free memory again or they are busy.
+
+package domainmgr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+)
+
+const (
+	minBalloonMemory    = 256 * 1024 // kbytes
+	idleCPUPercent      = 5          // Of one CPU
+	memoryCheckInterval = 30 * time.Second
+)
+
+// What the handler goroutine keeps between the samples of the CPU time
+type balloonState struct {
+	domainId   int
+	cpuTime    float64 // Seconds
+	sampleTime time.Time
+}
+
+// Called once the domain is running to record what it was created with
+func initResources(ctx *domainContext, status *types.DomainStatus) {
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil {
+		return
+	}
+	status.MemoryTarget = config.Memory
+	status.MaxMemory = config.Memory
+	if config.MaxMem > status.MaxMemory {
+		status.MaxMemory = config.MaxMem
+	}
+	vCpus := config.VCpus
+	if vCpus == 0 {
+		vCpus = 1
+	}
+	status.VCpus = vCpus
+	status.MaxVCpus = vCpus
+	if config.MaxCpus > status.MaxVCpus {
+		status.MaxVCpus = config.MaxCpus
+	}
+	status.AutoBallooned = false
+}
+
+func clearResources(status *types.DomainStatus) {
+	status.MemoryTarget = 0
+	status.MaxMemory = 0
+	status.VCpus = 0
+	status.MaxVCpus = 0
+	status.AutoBallooned = false
+}
+
+// Apply any change to Memory and VCpus to the running domain. An auto
+// ballooned domain gets the new Memory when it is restored.
+func resizeDomain(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) {
+
+	if status.MaxMemory == 0 {
+		return
+	}
+	changed := false
+	if config.Memory != status.MemoryTarget && !status.AutoBallooned {
+		if config.Memory > status.MaxMemory {
+			errStr := fmt.Sprintf("resizeDomain(%s) memory %d kbytes above maxmem %d kbytes; needs a restart",
+				status.Key(), config.Memory, status.MaxMemory)
+			log.Errorln(errStr)
+			status.LastErr = errStr
+			status.LastErrTime = time.Now()
+		} else if err := xlMemSet(status.DomainName, config.Memory); err != nil {
+			status.LastErr = fmt.Sprintf("%v", err)
+			status.LastErrTime = time.Now()
+		} else {
+			log.Infof("resizeDomain(%s) memory from %d to %d kbytes\n",
+				status.Key(), status.MemoryTarget, config.Memory)
+			status.MemoryTarget = config.Memory
+		}
+		changed = true
+	}
+	vCpus := config.VCpus
+	if vCpus == 0 {
+		vCpus = 1
+	}
+	if vCpus != status.VCpus {
+		if vCpus > status.MaxVCpus {
+			errStr := fmt.Sprintf("resizeDomain(%s) %d vCPUs above maxcpus %d; needs a restart",
+				status.Key(), vCpus, status.MaxVCpus)
+			log.Errorln(errStr)
+			status.LastErr = errStr
+			status.LastErrTime = time.Now()
+		} else if ctx.ncpu != 0 && vCpus > ctx.ncpu {
+			errStr := fmt.Sprintf("resizeDomain(%s) %d vCPUs but the device has %d CPUs",
+				status.Key(), vCpus, ctx.ncpu)
+			log.Errorln(errStr)
+			status.LastErr = errStr
+			status.LastErrTime = time.Now()
+		} else if err := xlVcpuSet(status.DomainName, vCpus); err != nil {
+			status.LastErr = fmt.Sprintf("%v", err)
+			status.LastErrTime = time.Now()
+		} else {
+			log.Infof("resizeDomain(%s) vCPUs from %d to %d\n",
+				status.Key(), status.VCpus, vCpus)
+			status.VCpus = vCpus
+			resizeAllocation(ctx, status.Key(), vCpus)
+		}
+		changed = true
+	}
+	if changed {
+		publishDomainStatus(ctx, status)
+	}
+}
+
+// After a reboot xen creates the domain from its xen cfg hence we apply
+// what it had again
+func reapplyResources(ctx *domainContext, status *types.DomainStatus) {
+	if status.MaxMemory == 0 {
+		return
+	}
+	log.Infof("reapplyResources(%s) %d kbytes %d vCPUs\n",
+		status.Key(), status.MemoryTarget, status.VCpus)
+	xlMemSet(status.DomainName, status.MemoryTarget)
+	xlVcpuSet(status.DomainName, status.VCpus)
+}
+
+// From GlobalConfig. Zero disables auto ballooning
+func updateAutoBalloonConfig(ctx *domainContext, freeMemory uint64) {
+	ctx.capacityLock.Lock()
+	if freeMemory != ctx.autoBalloonFree {
+		log.Infof("updateAutoBalloonConfig: free memory %d MB\n",
+			freeMemory)
+	}
+	ctx.autoBalloonFree = freeMemory
+	if freeMemory == 0 {
+		ctx.memoryPressure = false
+	}
+	ctx.capacityLock.Unlock()
+}
+
+// Goroutine which checks the free memory of the host. Memory pressure
+// starts when the free memory is below the threshold and ends when it is
+// twice the threshold so that domains are not ballooned up and down.
+func memoryMonitor(ctx *domainContext) {
+	ticker := time.NewTicker(memoryCheckInterval)
+	for range ticker.C {
+		ctx.capacityLock.Lock()
+		threshold := ctx.autoBalloonFree
+		ctx.capacityLock.Unlock()
+		if threshold == 0 {
+			continue
+		}
+		info, err := xlInfoFields()
+		if err != nil {
+			log.Errorf("memoryMonitor: %s\n", err)
+			continue
+		}
+		free, err := strconv.ParseUint(info["free_memory"], 10, 64)
+		if err != nil {
+			log.Errorf("memoryMonitor: bad free_memory %s\n",
+				info["free_memory"])
+			continue
+		}
+		ctx.capacityLock.Lock()
+		pressure := ctx.memoryPressure
+		if free < threshold {
+			pressure = true
+		} else if free >= 2*threshold {
+			pressure = false
+		}
+		if pressure != ctx.memoryPressure {
+			log.Infof("memoryMonitor: memory pressure %t with %d MB free\n",
+				pressure, free)
+		}
+		ctx.memoryPressure = pressure
+		ctx.capacityLock.Unlock()
+	}
+}
+
+// Called from the handler goroutine. Balloons the domain down if it is
+// idle under memory pressure, and back up to its Memory when it is busy or
+// the pressure is gone.
+func maybeAutoBalloon(ctx *domainContext, status *types.DomainStatus,
+	bs *balloonState) {
+
+	if !status.Activated || status.DomainId == 0 || status.MaxMemory == 0 {
+		*bs = balloonState{}
+		return
+	}
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate || config.MaxMem == 0 {
+		return
+	}
+	ctx.capacityLock.Lock()
+	enabled := ctx.autoBalloonFree != 0
+	pressure := ctx.memoryPressure
+	ctx.capacityLock.Unlock()
+	if !enabled && !status.AutoBallooned {
+		return
+	}
+	cpuTime, err := xlCpuTime(status.DomainName)
+	if err != nil {
+		log.Errorf("maybeAutoBalloon(%s): %s\n", status.Key(), err)
+		return
+	}
+	now := time.Now()
+	idle := false
+	if bs.domainId == status.DomainId && !bs.sampleTime.IsZero() {
+		elapsed := now.Sub(bs.sampleTime).Seconds()
+		idle = elapsed > 0 &&
+			cpuTime-bs.cpuTime < elapsed*idleCPUPercent/100
+	}
+	bs.domainId = status.DomainId
+	bs.cpuTime = cpuTime
+	bs.sampleTime = now
+
+	if status.AutoBallooned {
+		if pressure && idle {
+			return
+		}
+		log.Infof("maybeAutoBalloon(%s) restoring %d kbytes\n",
+			status.Key(), config.Memory)
+		if err := xlMemSet(status.DomainName, config.Memory); err != nil {
+			return
+		}
+		status.MemoryTarget = config.Memory
+		status.AutoBallooned = false
+		publishDomainStatus(ctx, status)
+		return
+	}
+	if !pressure || !idle {
+		return
+	}
+	target := config.Memory / 2
+	if target < minBalloonMemory {
+		target = minBalloonMemory
+	}
+	if target >= status.MemoryTarget {
+		return
+	}
+	log.Infof("maybeAutoBalloon(%s) idle; from %d to %d kbytes\n",
+		status.Key(), status.MemoryTarget, target)
+	if err := xlMemSet(status.DomainName, target); err != nil {
+		return
+	}
+	status.MemoryTarget = target
+	status.AutoBallooned = true
+	publishDomainStatus(ctx, status)
+}
+
+// Memory in kbytes; xl takes MBytes
+func xlMemSet(domainName string, memory int) error {
+	mbytes := (memory + 1023) / 1024
+	log.Infof("xlMemSet %s %d\n", domainName, mbytes)
+	cmd := "xl"
+	args := []string{
+		"mem-set",
+		domainName,
+		fmt.Sprintf("%dm", mbytes),
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl mem-set failed ", err)
+		log.Errorln("xl mem-set output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl mem-set failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlMemSet done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+func xlVcpuSet(domainName string, vCpus int) error {
+	log.Infof("xlVcpuSet %s %d\n", domainName, vCpus)
+	cmd := "xl"
+	args := []string{
+		"vcpu-set",
+		domainName,
+		strconv.Itoa(vCpus),
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl vcpu-set failed ", err)
+		log.Errorln("xl vcpu-set output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl vcpu-set failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlVcpuSet done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+// Returns the CPU time of the domain in seconds
+func xlCpuTime(domainName string) (float64, error) {
+	cmd := "xl"
+	args := []string{
+		"list",
+		domainName,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xl list failed: %s", string(stdoutStderr))
+		return 0, errors.New(errStr)
+	}
+	// Name ID Mem VCPUs State Time(s)
+	lines := strings.Split(strings.TrimSpace(string(stdoutStderr)), "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 6 {
+		errStr := fmt.Sprintf("xl list unexpected output: %s",
+			string(stdoutStderr))
+		return 0, errors.New(errStr)
+	}
+	return strconv.ParseFloat(fields[len(fields)-1], 64)
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Passthrough of individual USB devices. The main loop periodically scans
+// the USB devices in dom0 and notifies the goroutine of each domain when
+// there is a change. That goroutine then attaches any matching device which
+// appeared and detaches the ones which disappeared.
+// A device is claimed by at most one domain; the claims are keyed by the
+// port path of the device.
+
+package domainmgr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/hardware"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+)
+
+const (
+	usbScanInterval = 5 * time.Second
+	// Limit of xl for the ports of a USB controller
+	maxUsbPorts = 31
+)
+
+func usbInit(ctx *domainContext) {
+	ctx.usbClaims = make(map[string]uuid.UUID)
+	ctx.usbNotify = make(map[string]chan struct{})
+	ctx.usbDevices = hardware.GetUsbDevices()
+	log.Infof("usbInit found %v\n", ctx.usbDevices)
+}
+
+// Called from the main loop. Notify all the domains if there is a change
+func usbScan(ctx *domainContext) {
+	devices := hardware.GetUsbDevices()
+
+	ctx.usbLock.Lock()
+	if cmp.Equal(devices, ctx.usbDevices) {
+		ctx.usbLock.Unlock()
+		return
+	}
+	log.Infof("usbScan: changed from %v to %v\n", ctx.usbDevices, devices)
+	ctx.usbDevices = devices
+	for _, c := range ctx.usbNotify {
+		// Drop if there is already a notification pending
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+	ctx.usbLock.Unlock()
+	publishUsbDevices(ctx)
+}
+
+// Report the present devices and who is using them in AssignableAdapters
+func publishUsbDevices(ctx *domainContext) {
+	ctx.usbLock.Lock()
+	var devices []types.UsbDevice
+	for _, dev := range ctx.usbDevices {
+		dev.UsedByUUID = ctx.usbClaims[dev.PortPath]
+		devices = append(devices, dev)
+	}
+	ctx.usbLock.Unlock()
+	ctx.assignableAdapters.UsbDeviceList = devices
+	ctx.publishAssignableAdapters()
+}
+
+// Returns the channel on which the goroutine for the domain is notified
+func usbNotifyAdd(ctx *domainContext, key string) <-chan struct{} {
+	ctx.usbLock.Lock()
+	defer ctx.usbLock.Unlock()
+	c := make(chan struct{}, 1)
+	ctx.usbNotify[key] = c
+	return c
+}
+
+func usbNotifyDelete(ctx *domainContext, key string) {
+	ctx.usbLock.Lock()
+	defer ctx.usbLock.Unlock()
+	delete(ctx.usbNotify, key)
+}
+
+// Set up UsbDeviceList in the status before the domain is created.
+// The port on the controller of the domU is fixed per entry.
+func usbConfigToStatus(config types.DomainConfig, status *types.DomainStatus) {
+	status.UsbDeviceList = make([]types.UsbDeviceStatus,
+		len(config.UsbDeviceList))
+	for i, match := range config.UsbDeviceList {
+		status.UsbDeviceList[i] = types.UsbDeviceStatus{
+			Match: match,
+			Port:  i + 1,
+		}
+	}
+}
+
+// Attach and detach to make the domain match the present devices.
+// Publishes the DomainStatus if there is a change.
+func updateUsbDevices(ctx *domainContext, status *types.DomainStatus) {
+	if len(status.UsbDeviceList) == 0 {
+		return
+	}
+	if !status.Activated || status.DomainId == 0 {
+		return
+	}
+	log.Debugf("updateUsbDevices(%s)\n", status.Key())
+	myUUID := status.UUIDandVersion.UUID
+	changed := false
+	for i := range status.UsbDeviceList {
+		us := &status.UsbDeviceList[i]
+		old := *us
+
+		// Detach if the attached device went away or was replugged
+		if us.Attached && !usbDevicePresent(ctx, us.Device) {
+			log.Infof("updateUsbDevices(%s) %s gone\n",
+				status.Key(), us.Device)
+			// xl fails if qemu already removed it; ignore
+			xlUsbdevDetach(status.DomainName, us.Port)
+			releaseUsbDevice(ctx, myUUID, us.Device.PortPath)
+			us.Attached = false
+		}
+		if !us.Attached {
+			dev, err := claimUsbDevice(ctx, myUUID, us.Match)
+			us.Present = dev != nil
+			if dev != nil {
+				us.Device = *dev
+				err = xlUsbdevAttach(status.DomainName, *dev,
+					us.Port)
+				if err != nil {
+					releaseUsbDevice(ctx, myUUID, dev.PortPath)
+				} else {
+					log.Infof("updateUsbDevices(%s) attached %s\n",
+						status.Key(), dev)
+					us.Attached = true
+				}
+			} else {
+				us.Device = types.UsbDevice{}
+				if err == nil && !ctx.usbAccess {
+					err = errors.New("USB access is disabled for dom0")
+				}
+			}
+			if err != nil {
+				us.Error = err.Error()
+			} else {
+				us.Error = ""
+			}
+		}
+		if !cmp.Equal(old, *us) {
+			changed = true
+		}
+	}
+	if changed {
+		publishDomainStatus(ctx, status)
+		publishUsbDevices(ctx)
+	}
+}
+
+func usbDevicePresent(ctx *domainContext, dev types.UsbDevice) bool {
+	ctx.usbLock.Lock()
+	defer ctx.usbLock.Unlock()
+	for _, d := range ctx.usbDevices {
+		if d == dev {
+			return true
+		}
+	}
+	return false
+}
+
+// Find and claim the first present device which matches and is not used by
+// some other domain. Returns nil if there is none; the error is set if a
+// matching device is used by another domain.
+func claimUsbDevice(ctx *domainContext, myUUID uuid.UUID,
+	match types.UsbDeviceMatch) (*types.UsbDevice, error) {
+
+	ctx.usbLock.Lock()
+	defer ctx.usbLock.Unlock()
+	var err error
+	for _, dev := range ctx.usbDevices {
+		if !match.Matches(dev) {
+			continue
+		}
+		if owner, ok := ctx.usbClaims[dev.PortPath]; ok {
+			// Could be ours for another entry
+			if owner != myUUID {
+				errStr := fmt.Sprintf("%s is used by %s",
+					dev, owner)
+				err = errors.New(errStr)
+			}
+			continue
+		}
+		ctx.usbClaims[dev.PortPath] = myUUID
+		return &dev, nil
+	}
+	return nil, err
+}
+
+func releaseUsbDevice(ctx *domainContext, myUUID uuid.UUID, portPath string) {
+	ctx.usbLock.Lock()
+	defer ctx.usbLock.Unlock()
+	if owner, ok := ctx.usbClaims[portPath]; ok && owner == myUUID {
+		delete(ctx.usbClaims, portPath)
+	}
+}
+
+// Called when the domain is gone
+func releaseUsbDevices(ctx *domainContext, status *types.DomainStatus) {
+	if len(status.UsbDeviceList) == 0 {
+		return
+	}
+	myUUID := status.UUIDandVersion.UUID
+	for i := range status.UsbDeviceList {
+		us := &status.UsbDeviceList[i]
+		if us.Attached {
+			releaseUsbDevice(ctx, myUUID, us.Device.PortPath)
+			us.Attached = false
+		}
+	}
+	publishUsbDevices(ctx)
+}
+
+func xlUsbdevAttach(domainName string, dev types.UsbDevice, port int) error {
+	log.Infof("xlUsbdevAttach %s %s port %d\n", domainName, dev, port)
+	cmd := "xl"
+	args := []string{
+		"usbdev-attach",
+		domainName,
+		"hostbus=" + strconv.Itoa(dev.Busnum),
+		"hostaddr=" + strconv.Itoa(dev.Devnum),
+		"controller=0",
+		"port=" + strconv.Itoa(port),
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl usbdev-attach failed ", err)
+		log.Errorln("xl usbdev-attach output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl usbdev-attach failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlUsbdevAttach done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+func xlUsbdevDetach(domainName string, port int) error {
+	log.Infof("xlUsbdevDetach %s port %d\n", domainName, port)
+	cmd := "xl"
+	args := []string{
+		"usbdev-detach",
+		domainName,
+		"0",
+		strconv.Itoa(port),
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl usbdev-detach failed ", err)
+		log.Errorln("xl usbdev-detach output ", string(stdoutStderr))
+		return errors.New(fmt.Sprintf("xl usbdev-detach failed: %s\n",
+			string(stdoutStderr)))
+	}
+	log.Infof("xlUsbdevDetach done. Result %s\n", string(stdoutStderr))
+	return nil
+}
@@ -14,6 +14,9 @@
 //pause of 200ms.
 //After end of each event we will take
 //pause of 1200ms...
+//The blink counter, the usable addresses, the base OS updates and the
+//app instance errors determine the LedState, and the LED profile for the
+//model determines what each LED does in that state. See profile.go.
 
 package ledmanager
 
@@ -28,9 +31,7 @@ import (
 	"github.com/zededa/eve/pkg/pillar/pidfile"
 	"github.com/zededa/eve/pkg/pillar/pubsub"
 	"github.com/zededa/eve/pkg/pillar/types"
-	"io/ioutil"
 	"os"
-	"os/exec"
 	"time"
 )
 
@@ -41,64 +42,27 @@ const (
 
 // State passed to handlers
 type ledManagerContext struct {
-	countChange            chan int
+	leds                   map[string]chan ledUpdate
+	profile                ledProfile
 	ledCounter             int // Supress work and logging if no change
 	subGlobalConfig        *pubsub.Subscription
 	subLedBlinkCounter     *pubsub.Subscription
 	subDeviceNetworkStatus *pubsub.Subscription
+	subBaseOsStatus        *pubsub.Subscription
+	subAppInstanceStatus   *pubsub.Subscription
 	deviceNetworkStatus    types.DeviceNetworkStatus
 	usableAddressCount     int
 	derivedLedCounter      int // Based on ledCounter + usableAddressCount
+	updateInProgress       bool
+	appFailure             bool
+	ledState               types.LedState
+	initialized            bool // LEDs have been set
 }
 
-type Blink200msFunc func()
-type BlinkInitFunc func()
-
-type modelToFuncs struct {
-	model     string
-	initFunc  BlinkInitFunc
-	blinkFunc Blink200msFunc
-}
-
-// XXX introduce wildcard matching on model names? Just a default at the end
-var mToF = []modelToFuncs{
-	{
-		model:     "Supermicro.SYS-E100-9APP",
-		blinkFunc: ExecuteDDCmd},
-	{
-		model:     "Supermicro.SYS-E100-9S",
-		blinkFunc: ExecuteDDCmd},
-	{
-		model:     "Supermicro.SYS-E50-9AP",
-		blinkFunc: ExecuteDDCmd},
-	{ // XXX temporary fix for old BIOS
-		model:     "Supermicro.Super Server",
-		blinkFunc: ExecuteDDCmd},
-	{
-		model:     "Supermicro.SYS-E300-8D",
-		blinkFunc: ExecuteDDCmd},
-	{
-		model:     "Supermicro.SYS-E300-9A-4CN10P",
-		blinkFunc: ExecuteDDCmd},
-	{
-		model:     "Supermicro.SYS-5018D-FN8T",
-		blinkFunc: ExecuteDDCmd},
-	{
-		model:     "hisilicon,hi6220-hikey.hisilicon,hi6220.",
-		initFunc:  InitWifiLedCmd,
-		blinkFunc: ExecuteWifiLedCmd},
-	{
-		model:     "hisilicon,hikey.hisilicon,hi6220.",
-		initFunc:  InitWifiLedCmd,
-		blinkFunc: ExecuteWifiLedCmd},
-	{
-		model: "QEMU.Standard PC (i440FX + PIIX, 1996)",
-		// No dd disk light blinking on QEMU
-	},
-	// Last in table as a default
-	{
-		model:     "",
-		blinkFunc: ExecuteDDCmd},
+// ledUpdate is sent to the goroutine for an LED
+type ledUpdate struct {
+	action  ledAction
+	counter int
 }
 
 var debug bool
@@ -142,30 +106,21 @@ func Run() {
 	model := hardware.GetHardwareModel()
 	log.Infof("Got HardwareModel %s\n", model)
 
-	var blinkFunc Blink200msFunc
-	var initFunc BlinkInitFunc
-	for _, m := range mToF {
-		if m.model == model {
-			blinkFunc = m.blinkFunc
-			initFunc = m.initFunc
-			break
-		}
-		if m.model == "" {
-			log.Infof("No blink function for %s\n", model)
-			blinkFunc = m.blinkFunc
-			initFunc = m.initFunc
-			break
-		}
-	}
-
-	if initFunc != nil {
-		initFunc()
-	}
-
 	// Any state needed by handler functions
 	ctx := ledManagerContext{}
-	ctx.countChange = make(chan int)
-	go TriggerBlinkOnDevice(ctx.countChange, blinkFunc)
+	ctx.profile = loadLedProfile(model)
+	ctx.leds = make(map[string]chan ledUpdate)
+	for _, led := range ctx.profile.LEDs {
+		driver := newLedDriver(led)
+		if err := driver.init(); err != nil {
+			log.Errorf("LED %s init failed: %s\n", led.Name, err)
+			continue
+		}
+		// Buffered so that updateLeds does not wait for a blink cycle
+		updates := make(chan ledUpdate, 1)
+		ctx.leds[led.Name] = updates
+		go runLed(led.Name, driver, updates)
+	}
 
 	subLedBlinkCounter, err := pubsub.Subscribe("", types.LedBlinkCounter{},
 		false, &ctx)
@@ -187,6 +142,28 @@ func Run() {
 	ctx.subDeviceNetworkStatus = subDeviceNetworkStatus
 	subDeviceNetworkStatus.Activate()
 
+	// For update in progress
+	subBaseOsStatus, err := pubsub.Subscribe("baseosmgr",
+		types.BaseOsStatus{}, false, &ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subBaseOsStatus.ModifyHandler = handleBaseOsStatusModify
+	subBaseOsStatus.DeleteHandler = handleBaseOsStatusDelete
+	ctx.subBaseOsStatus = subBaseOsStatus
+	subBaseOsStatus.Activate()
+
+	// For app failures
+	subAppInstanceStatus, err := pubsub.Subscribe("zedmanager",
+		types.AppInstanceStatus{}, false, &ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subAppInstanceStatus.ModifyHandler = handleAppInstanceStatusModify
+	subAppInstanceStatus.DeleteHandler = handleAppInstanceStatusDelete
+	ctx.subAppInstanceStatus = subAppInstanceStatus
+	subAppInstanceStatus.Activate()
+
 	// Look for global config such as log levels
 	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
 		false, &ctx)
@@ -209,6 +186,12 @@ func Run() {
 		case change := <-subLedBlinkCounter.C:
 			subLedBlinkCounter.ProcessChange(change)
 
+		case change := <-subBaseOsStatus.C:
+			subBaseOsStatus.ProcessChange(change)
+
+		case change := <-subAppInstanceStatus.C:
+			subAppInstanceStatus.ProcessChange(change)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -234,7 +217,7 @@ func handleLedBlinkModify(ctxArg interface{}, key string,
 		ctx.usableAddressCount)
 	log.Infof("counter %d usableAddr %d, derived %d\n",
 		ctx.ledCounter, ctx.usableAddressCount, ctx.derivedLedCounter)
-	ctx.countChange <- ctx.derivedLedCounter
+	updateLeds(ctx)
 	log.Infof("handleLedBlinkModify done for %s\n", key)
 }
 
@@ -254,76 +237,164 @@ func handleLedBlinkDelete(ctxArg interface{}, key string,
 		ctx.usableAddressCount)
 	log.Infof("counter %d usableAddr %d, derived %d\n",
 		ctx.ledCounter, ctx.usableAddressCount, ctx.derivedLedCounter)
-	ctx.countChange <- ctx.derivedLedCounter
+	updateLeds(ctx)
 	log.Infof("handleLedBlinkDelete done for %s\n", key)
 }
 
-func TriggerBlinkOnDevice(countChange chan int, blinkFunc Blink200msFunc) {
-	var counter int
-	for {
+// updateLeds determines the state and tells each LED what to do if the
+// state or the counter changed
+func updateLeds(ctx *ledManagerContext) {
+	state := types.DeriveLedState(ctx.derivedLedCounter,
+		ctx.updateInProgress, ctx.appFailure)
+	if state != ctx.ledState {
+		log.Infof("LED state changed from %s to %s\n", ctx.ledState, state)
+	}
+	ctx.ledState = state
+	actions := ctx.profile.actionsForState(state)
+	for name, updates := range ctx.leds {
+		action, ok := actions[name]
+		if !ok {
+			action = ledAction{Led: name, Mode: ledModeOff}
+		}
+		update := ledUpdate{action: action, counter: ctx.derivedLedCounter}
+		// Replace any update which has not been picked up
 		select {
-		case counter = <-countChange:
-			log.Debugf("Received counter update: %d\n",
-				counter)
+		case <-updates:
 		default:
-			log.Debugf("Unchanged counter: %d\n", counter)
 		}
-		log.Debugln("Number of times LED will blink: ", counter)
-		for i := 0; i < counter; i++ {
-			if blinkFunc != nil {
-				blinkFunc()
-			}
-			time.Sleep(200 * time.Millisecond)
+		updates <- update
+	}
+	ctx.initialized = true
+}
+
+// runLed applies the updates to one LED. When blinking the LED blinks
+// count times with a pause of 200ms after each blink and 1200ms after
+// each sequence.
+func runLed(name string, driver ledDriver, updates chan ledUpdate) {
+	var update ledUpdate
+	apply := func(u ledUpdate) {
+		log.Debugf("LED %s: %s count %d counter %d\n", name,
+			u.action.Mode, u.action.Count, u.counter)
+		update = u
+		switch update.action.Mode {
+		case ledModeOn:
+			driver.set(true)
+		case ledModeOff:
+			driver.set(false)
+		}
+	}
+	apply(<-updates)
+	for {
+		count := 0
+		switch update.action.Mode {
+		case ledModeBlink:
+			count = update.action.Count
+		case ledModeCounter:
+			count = update.counter
+		default:
+			// Steady; wait for a change
+			apply(<-updates)
+			continue
+		}
+		log.Debugf("Number of times LED %s will blink: %d\n", name, count)
+		for i := 0; i < count; i++ {
+			driver.blink()
+			time.Sleep(blinkTime)
 		}
 		time.Sleep(1200 * time.Millisecond)
+		select {
+		case u := <-updates:
+			apply(u)
+		default:
+		}
 	}
 }
 
-func DummyCmd() {
-	time.Sleep(200 * time.Millisecond)
+func handleBaseOsStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*ledManagerContext)
+	log.Infof("handleBaseOsStatusModify for %s\n", key)
+	updateBaseOsState(ctx)
+	log.Infof("handleBaseOsStatusModify done for %s\n", key)
 }
 
-// Should be tuned so that the LED lights up for 200ms
-// Disable cache since there might be a filesystem on the device
-func ExecuteDDCmd() {
-	cmd := exec.Command("dd", "if=/dev/sda", "of=/dev/null", "bs=4M", "count=22", "iflag=nocache")
-	stdout, err := cmd.Output()
-	if err != nil {
-		log.Errorln("dd error: ", err)
+func handleBaseOsStatusDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*ledManagerContext)
+	log.Infof("handleBaseOsStatusDelete for %s\n", key)
+	updateBaseOsState(ctx)
+	log.Infof("handleBaseOsStatusDelete done for %s\n", key)
+}
+
+// updateBaseOsState checks if any base OS is being downloaded or verified,
+// or is the new image being tested
+func updateBaseOsState(ctx *ledManagerContext) {
+	inProgress := false
+	items := ctx.subBaseOsStatus.GetAll()
+	for _, item := range items {
+		status := cast.CastBaseOsStatus(item)
+		if status.Error != "" {
+			continue
+		}
+		if status.State >= types.DOWNLOAD_STARTED &&
+			status.State < types.INSTALLED {
+			inProgress = true
+			break
+		}
+		if status.Activated && status.PartitionState == "inprogress" &&
+			!status.TestComplete {
+			inProgress = true
+			break
+		}
+	}
+	if inProgress == ctx.updateInProgress {
 		return
 	}
-	log.Debugf("ddinfo: %s\n", stdout)
+	log.Infof("updateBaseOsState: update in progress %v\n", inProgress)
+	ctx.updateInProgress = inProgress
+	if ctx.initialized {
+		updateLeds(ctx)
+	}
 }
 
-const (
-	ledFilename        = "/sys/class/leds/wifi_active"
-	triggerFilename    = ledFilename + "/trigger"
-	brightnessFilename = ledFilename + "/brightness"
-)
+func handleAppInstanceStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}) {
 
-// Disable existimg trigger
-// Write "none\n" to /sys/class/leds/wifi_active/trigger
-func InitWifiLedCmd() {
-	log.Infof("InitWifiLedCmd\n")
-	b := []byte("none")
-	err := ioutil.WriteFile(triggerFilename, b, 0644)
-	if err != nil {
-		log.Fatal(err, triggerFilename)
-	}
+	ctx := ctxArg.(*ledManagerContext)
+	log.Debugf("handleAppInstanceStatusModify for %s\n", key)
+	updateAppState(ctx)
+	log.Debugf("handleAppInstanceStatusModify done for %s\n", key)
 }
 
-// Enable the Wifi led for 200ms
-func ExecuteWifiLedCmd() {
-	b := []byte("1")
-	err := ioutil.WriteFile(brightnessFilename, b, 0644)
-	if err != nil {
-		log.Fatal(err, brightnessFilename)
+func handleAppInstanceStatusDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*ledManagerContext)
+	log.Infof("handleAppInstanceStatusDelete for %s\n", key)
+	updateAppState(ctx)
+	log.Infof("handleAppInstanceStatusDelete done for %s\n", key)
+}
+
+// updateAppState checks if any app instance has an error
+func updateAppState(ctx *ledManagerContext) {
+	failure := false
+	items := ctx.subAppInstanceStatus.GetAll()
+	for _, item := range items {
+		status := cast.CastAppInstanceStatus(item)
+		if status.Error != "" {
+			failure = true
+			break
+		}
 	}
-	time.Sleep(200 * time.Millisecond)
-	b = []byte("0")
-	err = ioutil.WriteFile(brightnessFilename, b, 0644)
-	if err != nil {
-		log.Fatal(err, brightnessFilename)
+	if failure == ctx.appFailure {
+		return
+	}
+	log.Infof("updateAppState: app failure %v\n", failure)
+	ctx.appFailure = failure
+	if ctx.initialized {
+		updateLeds(ctx)
 	}
 }
 
@@ -354,7 +425,7 @@ func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
 			ctx.usableAddressCount)
 		log.Infof("counter %d usableAddr %d, derived %d\n",
 			ctx.ledCounter, ctx.usableAddressCount, ctx.derivedLedCounter)
-		ctx.countChange <- ctx.derivedLedCounter
+		updateLeds(ctx)
 	}
 	log.Infof("handleDNSModify done for %s\n", key)
 }
@@ -377,7 +448,7 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 			ctx.usableAddressCount)
 		log.Infof("counter %d usableAddr %d, derived %d\n",
 			ctx.ledCounter, ctx.usableAddressCount, ctx.derivedLedCounter)
-		ctx.countChange <- ctx.derivedLedCounter
+		updateLeds(ctx)
 	}
 	log.Infof("handleDNSDelete done for %s\n", key)
 }
@@ -0,0 +1,301 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Per-model LED profiles. A profile lists the LEDs of the model and what
+// each of them shows for each LedState. The profile is read from
+//   /config/LedProfiles/<model>.json
+//   /var/tmp/zededa/LedProfiles/<model>.json
+//   /var/tmp/zededa/LedProfiles/default.json
+// whichever exists first. Example:
+// {
+//   "LEDs": [
+//     { "Name": "green", "Type": "sysfs", "Path": "/sys/class/leds/green:status" },
+//     { "Name": "red", "Type": "gpio", "Gpio": 17, "ActiveLow": true }
+//   ],
+//   "States": {
+//     "online": [ { "Led": "green", "Mode": "on" }, { "Led": "red", "Mode": "off" } ],
+//     "app-failure": [ { "Led": "green", "Mode": "on" }, { "Led": "red", "Mode": "blink", "Count": 2 } ]
+//   }
+// }
+// States without an entry blink the first LED using the LED counter.
+
+package ledmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+const (
+	ledProfileOverrideDirname = "/config/LedProfiles"
+	ledProfileDirname         = "/var/tmp/zededa/LedProfiles"
+	gpioDirname               = "/sys/class/gpio"
+	blinkTime                 = 200 * time.Millisecond
+)
+
+// Types of LEDs
+const (
+	// Blink the disk activity LED by reading the disk
+	ledTypeDisk  = "disk"
+	ledTypeSysfs = "sysfs"
+	ledTypeGpio  = "gpio"
+)
+
+// Modes for an LED in a state
+const (
+	ledModeOff   = "off"
+	ledModeOn    = "on"
+	ledModeBlink = "blink"
+	// Blink the LED counter times as was done before profiles
+	ledModeCounter = "counter"
+)
+
+type ledConfig struct {
+	Name      string
+	Type      string
+	Path      string // For sysfs; /sys/class/leds/<name>
+	Gpio      int    // For gpio
+	ActiveLow bool   // For gpio
+}
+
+type ledAction struct {
+	Led   string
+	Mode  string
+	Count int // For blink
+}
+
+type ledProfile struct {
+	LEDs   []ledConfig
+	States map[string][]ledAction
+}
+
+// The default if there is no file for the model nor a default file
+var defaultProfile = ledProfile{
+	LEDs: []ledConfig{{Name: "disk", Type: ledTypeDisk}},
+}
+
+// loadLedProfile returns the profile for the model
+func loadLedProfile(model string) ledProfile {
+	filenames := []string{
+		fmt.Sprintf("%s/%s.json", ledProfileOverrideDirname, model),
+		fmt.Sprintf("%s/%s.json", ledProfileDirname, model),
+		fmt.Sprintf("%s/default.json", ledProfileDirname),
+	}
+	for _, filename := range filenames {
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				log.Errorf("loadLedProfile: %s\n", err)
+			}
+			continue
+		}
+		var profile ledProfile
+		if err := json.Unmarshal(b, &profile); err != nil {
+			log.Errorf("loadLedProfile: %s: %s\n", filename, err)
+			continue
+		}
+		if err := checkLedProfile(profile); err != nil {
+			log.Errorf("loadLedProfile: %s: %s\n", filename, err)
+			continue
+		}
+		log.Infof("loadLedProfile: using %s\n", filename)
+		return profile
+	}
+	log.Infof("No LED profile for %s\n", model)
+	return defaultProfile
+}
+
+func checkLedProfile(profile ledProfile) error {
+	names := make(map[string]bool)
+	for _, led := range profile.LEDs {
+		if names[led.Name] {
+			errStr := fmt.Sprintf("duplicate LED %s", led.Name)
+			return errors.New(errStr)
+		}
+		names[led.Name] = true
+		switch led.Type {
+		case ledTypeDisk, ledTypeGpio:
+		case ledTypeSysfs:
+			if led.Path == "" {
+				errStr := fmt.Sprintf("no Path for LED %s", led.Name)
+				return errors.New(errStr)
+			}
+		default:
+			errStr := fmt.Sprintf("unknown Type %s for LED %s",
+				led.Type, led.Name)
+			return errors.New(errStr)
+		}
+	}
+	for state, actions := range profile.States {
+		for _, action := range actions {
+			if !names[action.Led] {
+				errStr := fmt.Sprintf("unknown LED %s in state %s",
+					action.Led, state)
+				return errors.New(errStr)
+			}
+			switch action.Mode {
+			case ledModeOff, ledModeOn, ledModeBlink, ledModeCounter:
+			default:
+				errStr := fmt.Sprintf("unknown Mode %s in state %s",
+					action.Mode, state)
+				return errors.New(errStr)
+			}
+		}
+	}
+	return nil
+}
+
+// actionsForState returns the action for each LED in the profile
+func (profile ledProfile) actionsForState(state types.LedState) map[string]ledAction {
+	actions := make(map[string]ledAction)
+	stateActions, ok := profile.States[state.String()]
+	if !ok {
+		if len(profile.LEDs) != 0 {
+			name := profile.LEDs[0].Name
+			actions[name] = ledAction{Led: name, Mode: ledModeCounter}
+		}
+		return actions
+	}
+	for _, action := range stateActions {
+		actions[action.Led] = action
+	}
+	return actions
+}
+
+// ledDriver controls one LED
+type ledDriver interface {
+	init() error
+	// set turns the LED on or off if supported
+	set(on bool)
+	// blink turns the LED on for about 200ms
+	blink()
+}
+
+func newLedDriver(led ledConfig) ledDriver {
+	switch led.Type {
+	case ledTypeSysfs:
+		return &sysfsLed{path: led.Path}
+	case ledTypeGpio:
+		return &gpioLed{gpio: led.Gpio, activeLow: led.ActiveLow}
+	default:
+		return &diskLed{}
+	}
+}
+
+type diskLed struct{}
+
+func (d *diskLed) init() error {
+	return nil
+}
+
+func (d *diskLed) set(on bool) {
+	// Can not be on or off; only blinked
+}
+
+// Should be tuned so that the LED lights up for 200ms
+// Disable cache since there might be a filesystem on the device
+func (d *diskLed) blink() {
+	cmd := exec.Command("dd", "if=/dev/sda", "of=/dev/null", "bs=4M", "count=22", "iflag=nocache")
+	stdout, err := cmd.Output()
+	if err != nil {
+		log.Errorln("dd error: ", err)
+		return
+	}
+	log.Debugf("ddinfo: %s\n", stdout)
+}
+
+// sysfsLed is an LED in the Linux LED class
+type sysfsLed struct {
+	path string
+	max  string
+}
+
+// Disable the existing trigger and use the maximum brightness for on
+func (s *sysfsLed) init() error {
+	if err := ioutil.WriteFile(s.path+"/trigger", []byte("none"),
+		0644); err != nil {
+		return err
+	}
+	s.max = "1"
+	b, err := ioutil.ReadFile(s.path + "/max_brightness")
+	if err == nil {
+		max := strings.TrimSpace(string(b))
+		if _, err := strconv.Atoi(max); err == nil {
+			s.max = max
+		}
+	}
+	return nil
+}
+
+func (s *sysfsLed) set(on bool) {
+	value := "0"
+	if on {
+		value = s.max
+	}
+	filename := s.path + "/brightness"
+	if err := ioutil.WriteFile(filename, []byte(value), 0644); err != nil {
+		log.Errorf("sysfsLed: %s\n", err)
+	}
+}
+
+func (s *sysfsLed) blink() {
+	s.set(true)
+	time.Sleep(blinkTime)
+	s.set(false)
+}
+
+// gpioLed is an LED on a GPIO using the sysfs GPIO interface
+type gpioLed struct {
+	gpio      int
+	activeLow bool
+}
+
+func (g *gpioLed) path() string {
+	return fmt.Sprintf("%s/gpio%d", gpioDirname, g.gpio)
+}
+
+func (g *gpioLed) init() error {
+	if _, err := os.Stat(g.path()); err != nil {
+		err := ioutil.WriteFile(gpioDirname+"/export",
+			[]byte(strconv.Itoa(g.gpio)), 0200)
+		if err != nil {
+			return err
+		}
+	}
+	activeLow := "0"
+	if g.activeLow {
+		activeLow = "1"
+	}
+	if err := ioutil.WriteFile(g.path()+"/active_low", []byte(activeLow),
+		0644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(g.path()+"/direction", []byte("out"), 0644)
+}
+
+func (g *gpioLed) set(on bool) {
+	value := "0"
+	if on {
+		value = "1"
+	}
+	filename := g.path() + "/value"
+	if err := ioutil.WriteFile(filename, []byte(value), 0644); err != nil {
+		log.Errorf("gpioLed: %s\n", err)
+	}
+}
+
+func (g *gpioLed) blink() {
+	g.set(true)
+	time.Sleep(blinkTime)
+	g.set(false)
+}
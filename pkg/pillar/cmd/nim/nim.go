@@ -37,6 +37,8 @@ const (
 	tmpDirname  = "/var/tmp/zededa"
 	DNCDirname  = tmpDirname + "/DeviceNetworkConfig"
 	DPCOverride = tmpDirname + "/DevicePortConfig/override.json"
+	// How often we check the state of WiFi and cellular ports
+	wirelessPollInterval = 30 * time.Second
 )
 
 type nimContext struct {
@@ -46,6 +48,8 @@ type nimContext struct {
 	globalConfig      *types.GlobalConfig
 	sshAccess         bool
 	sshAuthorizedKeys string
+	sshCAConfig       types.SshCAConfig
+	sshPortOpen       bool
 	allowAppVnc       bool
 
 	subNetworkInstanceStatus *pubsub.Subscription
@@ -192,6 +196,16 @@ func Run() {
 	nimCtx.subGlobalConfig = subGlobalConfig
 	subGlobalConfig.Activate()
 
+	// Look for the ssh user CA config from zedagent
+	subSshCAConfig, err := pubsub.SubscribePersistent("zedagent",
+		types.SshCAConfig{}, false, &nimCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subSshCAConfig.ModifyHandler = handleSshCAConfigModify
+	subSshCAConfig.DeleteHandler = handleSshCAConfigDelete
+	subSshCAConfig.Activate()
+
 	nimCtx.ManufacturerModel = model
 	nimCtx.DeviceNetworkConfig = &types.DeviceNetworkConfig{}
 	nimCtx.DevicePortConfig = &types.DevicePortConfig{}
@@ -309,6 +323,9 @@ func Run() {
 	geoTimer := flextimer.NewRangeTicker(time.Duration(geoMin),
 		time.Duration(geoMax))
 
+	// Timer for tracking the state of WiFi and cellular ports
+	wirelessTimer := time.NewTicker(wirelessPollInterval)
+
 	dnc := &nimCtx.DeviceNetworkContext
 	// TIme we wait for DHCP to get an address before giving up
 	dnc.DPCTestDuration = nimCtx.globalConfig.NetworkTestDuration
@@ -361,6 +378,9 @@ func Run() {
 		case change := <-subGlobalConfig.C:
 			subGlobalConfig.ProcessChange(change)
 
+		case change := <-subSshCAConfig.C:
+			subSshCAConfig.ProcessChange(change)
+
 		case change := <-subDeviceNetworkConfig.C:
 			subDeviceNetworkConfig.ProcessChange(change)
 
@@ -406,6 +426,15 @@ func Run() {
 				publishDeviceNetworkStatus(&nimCtx)
 			}
 
+		case <-wirelessTimer.C:
+			change := devicenetwork.UpdateWifiStatus(nimCtx.DeviceNetworkStatus)
+			if devicenetwork.UpdateCellularStatus(nimCtx.DeviceNetworkStatus) {
+				change = true
+			}
+			if change {
+				publishDeviceNetworkStatus(&nimCtx)
+			}
+
 		case _, ok := <-dnc.Pending.PendTimer.C:
 			if !ok {
 				log.Infof("Device port test timer stopped?")
@@ -463,6 +492,9 @@ func Run() {
 		case change := <-subGlobalConfig.C:
 			subGlobalConfig.ProcessChange(change)
 
+		case change := <-subSshCAConfig.C:
+			subSshCAConfig.ProcessChange(change)
+
 		case change := <-subDeviceNetworkConfig.C:
 			subDeviceNetworkConfig.ProcessChange(change)
 
@@ -511,6 +543,15 @@ func Run() {
 				publishDeviceNetworkStatus(&nimCtx)
 			}
 
+		case <-wirelessTimer.C:
+			change := devicenetwork.UpdateWifiStatus(nimCtx.DeviceNetworkStatus)
+			if devicenetwork.UpdateCellularStatus(nimCtx.DeviceNetworkStatus) {
+				change = true
+			}
+			if change {
+				publishDeviceNetworkStatus(&nimCtx)
+			}
+
 		case _, ok := <-dnc.Pending.PendTimer.C:
 			if !ok {
 				log.Infof("Device port test timer stopped?")
@@ -588,8 +629,28 @@ func updateFilteredFallback(ctx *nimContext) {
 	}
 }
 
+// updateTestResults records the results of the staged connectivity test
+// in the current DevicePortConfig and publishes if the outcome changed
+func updateTestResults(ctx *devicenetwork.DeviceNetworkContext,
+	results []types.PortTestResult) {
+
+	if ctx.NextDPCIndex < 0 ||
+		ctx.NextDPCIndex >= len(ctx.DevicePortConfigList.PortConfigList) {
+		return
+	}
+	cur := &ctx.DevicePortConfigList.PortConfigList[ctx.NextDPCIndex]
+	changed := devicenetwork.TestResultsChanged(cur.TestResults, results)
+	cur.TestResults = results
+	if changed && ctx.PubDevicePortConfigList != nil {
+		log.Infof("updateTestResults: publishing for %s\n", cur.Key)
+		ctx.PubDevicePortConfigList.Publish("global",
+			*ctx.DevicePortConfigList)
+	}
+}
+
 func tryDeviceConnectivityToCloud(ctx *devicenetwork.DeviceNetworkContext) bool {
-	err := devicenetwork.VerifyDeviceNetworkStatus(*ctx.DeviceNetworkStatus, 1)
+	results, err := devicenetwork.VerifyDeviceNetworkStatus(*ctx.DeviceNetworkStatus, 1)
+	updateTestResults(ctx, results)
 	if err == nil {
 		log.Infof("tryDeviceConnectivityToCloud: Device cloud connectivity test passed.")
 		if ctx.NextDPCIndex < len(ctx.DevicePortConfigList.PortConfigList) {
@@ -661,11 +722,12 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 		}
 		if gcp.SshAccess != ctx.sshAccess || first {
 			ctx.sshAccess = gcp.SshAccess
-			iptables.UpdateSshAccess(ctx.sshAccess, first)
+			updateSshAccess(ctx, first)
 		}
 		if gcp.SshAuthorizedKeys != ctx.sshAuthorizedKeys || first {
 			ctx.sshAuthorizedKeys = gcp.SshAuthorizedKeys
-			ssh.UpdateSshAuthorizedKeys(ctx.sshAuthorizedKeys)
+			ssh.UpdateSshAuthorizedKeys(ctx.sshAuthorizedKeys,
+				ctx.sshCAConfig)
 		}
 		if gcp.AllowAppVnc != ctx.allowAppVnc || first {
 			ctx.allowAppVnc = gcp.AllowAppVnc
@@ -720,12 +782,54 @@ func handleGlobalConfigSynchronized(ctxArg interface{}, done bool) {
 	if done {
 		first := !ctx.GCInitialized
 		if first {
-			iptables.UpdateSshAccess(ctx.sshAccess, first)
+			updateSshAccess(ctx, first)
 		}
 		ctx.GCInitialized = true
 	}
 }
 
+// updateSshAccess opens port 22 if ssh is enabled in the global config
+// or if certificates from a user CA are accepted
+func updateSshAccess(ctx *nimContext, first bool) {
+	enable := ctx.sshAccess || ssh.CAEnabled(ctx.sshCAConfig)
+	if enable != ctx.sshPortOpen || first {
+		ctx.sshPortOpen = enable
+		iptables.UpdateSshAccess(enable, first)
+	}
+}
+
+func handleSshCAConfigModify(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*nimContext)
+	config := cast.CastSshCAConfig(configArg)
+	log.Infof("handleSshCAConfigModify for %s\n", key)
+	if cmp.Equal(ctx.sshCAConfig, config) {
+		log.Infof("handleSshCAConfigModify: no change\n")
+		return
+	}
+	ctx.sshCAConfig = config
+	ssh.UpdateSshAuthorizedKeys(ctx.sshAuthorizedKeys, ctx.sshCAConfig)
+	// Port handled when GlobalConfig is initialized
+	if ctx.GCInitialized {
+		updateSshAccess(ctx, false)
+	}
+	log.Infof("handleSshCAConfigModify done for %s\n", key)
+}
+
+func handleSshCAConfigDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*nimContext)
+	log.Infof("handleSshCAConfigDelete for %s\n", key)
+	ctx.sshCAConfig = types.SshCAConfig{}
+	ssh.UpdateSshAuthorizedKeys(ctx.sshAuthorizedKeys, ctx.sshCAConfig)
+	if ctx.GCInitialized {
+		updateSshAccess(ctx, false)
+	}
+	log.Infof("handleSshCAConfigDelete done for %s\n", key)
+}
+
 func handleNetworkInstanceModify(ctxArg interface{}, key string, statusArg interface{}) {
 
 	log.Infof("handleNetworkInstanceStatusModify(%s)\n", key)
@@ -0,0 +1,369 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Forced command for ssh logins using a certificate from the user CA.
+// Enforces the role of the principal and records the login, the command
+// and its outcome in the sshaudit log which logmanager uploads.
+//   sshsession -r <role> -u <principal> -s "$SSH_CONNECTION" -- "$SSH_ORIGINAL_COMMAND"
+
+package sshsession
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/agentlog"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+const (
+	auditLogName = "sshaudit"
+	shellPath    = "/bin/sh"
+	// Anything the shell would interpret
+	shellMetaChars = ";&|<>()$`\\\"'*?[]{}~!#\n"
+)
+
+// debugCommand is what the debug role may pass to a command. Only
+// options which do not take a file are allowed, and the operands are
+// paths which must be in allowedPaths unless operands is set otherwise.
+type debugCommand struct {
+	options      []string // E.g. "-l" and "--human"
+	valueOptions []string // Followed by a value which is not a file
+	operands     operandKind
+}
+
+type operandKind int
+
+const (
+	operandPaths   operandKind = iota // Zero or more paths
+	operandNone                       // No operands allowed
+	operandAny                        // Not used as files, e.g. ps aux
+	operandPattern                    // A pattern, unless -e, then paths
+)
+
+// Read-only commands the debug role can run. dmesg can only be given
+// options which do not change the ring buffer and date none since it can
+// set the clock. Recursive options are not allowed.
+var debugCommands = map[string]debugCommand{
+	"cat": {
+		options: []string{"-A", "-b", "-E", "-n", "-s", "-T", "-v"},
+	},
+	"date": {operands: operandNone},
+	"df": {
+		options: []string{"-a", "-h", "-i", "-k", "-T"},
+	},
+	"dmesg": {
+		options: []string{"-T", "--ctime", "-H", "--human", "-k",
+			"--kernel", "-w", "--follow", "-x", "--decode"},
+		operands: operandNone,
+	},
+	"du": {
+		options:      []string{"-a", "-c", "-h", "-k", "-s", "-x"},
+		valueOptions: []string{"-d", "--max-depth"},
+	},
+	"free": {
+		options:  []string{"-b", "-g", "-h", "-k", "-m", "-t"},
+		operands: operandNone,
+	},
+	"grep": {
+		options: []string{"-c", "-E", "-F", "-h", "-H", "-i", "-l", "-L",
+			"-n", "-o", "-q", "-s", "-v", "-w", "-x"},
+		valueOptions: []string{"-A", "-B", "-C", "-e", "-m"},
+		operands:     operandPattern,
+	},
+	"head": {
+		options:      []string{"-q", "-v"},
+		valueOptions: []string{"-c", "-n"},
+	},
+	"ls": {
+		options: []string{"-1", "-a", "-A", "-d", "-F", "-h", "-i", "-l",
+			"-n", "-r", "-S", "-t"},
+	},
+	"lspci": {
+		options:  []string{"-D", "-k", "-n", "-t", "-v"},
+		operands: operandNone,
+	},
+	"lsusb": {
+		options:  []string{"-t", "-v"},
+		operands: operandNone,
+	},
+	"netstat": {
+		options: []string{"-a", "-e", "-i", "-l", "-n", "-p", "-r", "-s",
+			"-t", "-u", "-w", "-x"},
+		operands: operandNone,
+	},
+	"ps": {
+		options:      []string{"-a", "-e", "-f", "-l", "-w", "-x"},
+		valueOptions: []string{"-o"},
+		operands:     operandAny,
+	},
+	"tail": {
+		options:      []string{"-f", "-F", "-q", "-v"},
+		valueOptions: []string{"-c", "-n"},
+	},
+	"uname": {
+		options:  []string{"-a", "-m", "-n", "-r", "-s", "-v"},
+		operands: operandNone,
+	},
+	"uptime": {operands: operandNone},
+}
+
+// The debug role can only read below these after symlinks are resolved
+var allowedPaths = []string{"/persist/log", "/run", "/var/run", "/proc"}
+
+// Refused even if below allowedPaths since they have keys or passwords
+var deniedPaths = []string{"/config", "/persist/certs", "/run/wlan",
+	"/run/wwan", "/run/wpa_supplicant"}
+
+// Files in /proc which give access to memory or the environment
+var deniedNames = []string{"environ", "kcore", "mem", "map_files"}
+
+// Set from Makefile
+var Version = "No version specified"
+
+func Run() {
+	versionPtr := flag.Bool("v", false, "Version")
+	rolePtr := flag.String("r", "", "Role of the principal")
+	principalPtr := flag.String("u", "", "Principal")
+	connectionPtr := flag.String("s", "", "SSH_CONNECTION")
+	flag.Parse()
+	if *versionPtr {
+		fmt.Printf("%s: %s\n", os.Args[0], Version)
+		return
+	}
+	command := strings.Join(flag.Args(), " ")
+
+	auditLog, err := agentlog.InitAudit(auditLogName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sshsession: %s\n", err)
+		os.Exit(1)
+	}
+	fields := log.Fields{
+		"principal": *principalPtr,
+		"role":      *rolePtr,
+		"command":   command,
+	}
+	// SSH_CONNECTION is "client-ip client-port server-ip server-port"
+	if conn := strings.Fields(*connectionPtr); len(conn) >= 2 {
+		fields["client"] = conn[0] + ":" + conn[1]
+	}
+	entry := auditLog.WithFields(fields)
+
+	args, err := checkCommand(*rolePtr, command)
+	if err != nil {
+		entry.WithField("event", "denied").Warn(err.Error())
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+	entry.WithField("event", "login").Info("")
+	start := time.Now()
+	exitCode := runCommand(args)
+	entry.WithFields(log.Fields{
+		"event":    "end",
+		"exitCode": exitCode,
+		"duration": time.Since(start).String(),
+	}).Info("")
+	os.Exit(exitCode)
+}
+
+// checkCommand returns the arguments to execute for the command if the
+// role allows it
+func checkCommand(role string, command string) ([]string, error) {
+	switch role {
+	case types.SshRoleRoot.String():
+		if command == "" {
+			return []string{shellPath, "-l"}, nil
+		}
+		return []string{shellPath, "-c", command}, nil
+
+	case types.SshRoleDebug.String():
+		if command == "" {
+			return nil, errors.New("Interactive shell not allowed for debug role")
+		}
+		if strings.ContainsAny(command, shellMetaChars) {
+			return nil, errors.New("Shell syntax not allowed for debug role")
+		}
+		args := strings.Fields(command)
+		debugCmd, ok := debugCommands[args[0]]
+		if !ok {
+			errStr := fmt.Sprintf("Command %s not allowed for debug role",
+				args[0])
+			return nil, errors.New(errStr)
+		}
+		if err := checkArgs(args[0], debugCmd, args[1:]); err != nil {
+			return nil, err
+		}
+		return args, nil
+
+	default:
+		errStr := fmt.Sprintf("Unknown role <%s>", role)
+		return nil, errors.New(errStr)
+	}
+}
+
+// checkArgs checks the options and operands of a command for the debug
+// role
+func checkArgs(name string, debugCmd debugCommand, args []string) error {
+	var operands []string
+	hasPattern := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			operands = append(operands, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			operands = append(operands, arg)
+			continue
+		}
+		var option string
+		var hasValue bool
+		if strings.HasPrefix(arg, "--") {
+			option = arg
+			if n := strings.Index(arg, "="); n != -1 {
+				option, hasValue = arg[:n], true
+			}
+			if contains(debugCmd.options, option) && !hasValue {
+				continue
+			}
+		} else {
+			// A cluster of single letter options, where an option
+			// with a value takes the rest of the cluster
+			option = ""
+			for j := 1; j < len(arg); j++ {
+				letter := "-" + arg[j:j+1]
+				if contains(debugCmd.options, letter) {
+					continue
+				}
+				option = letter
+				hasValue = j+1 < len(arg)
+				break
+			}
+			if option == "" {
+				continue
+			}
+		}
+		if !contains(debugCmd.valueOptions, option) {
+			errStr := fmt.Sprintf("%s option %s not allowed for debug role",
+				name, option)
+			return errors.New(errStr)
+		}
+		if !hasValue {
+			// The value is the next argument
+			i++
+			if i == len(args) {
+				errStr := fmt.Sprintf("%s option %s needs a value",
+					name, option)
+				return errors.New(errStr)
+			}
+		}
+		if option == "-e" {
+			hasPattern = true
+		}
+	}
+	switch debugCmd.operands {
+	case operandNone:
+		if len(operands) != 0 {
+			errStr := fmt.Sprintf("%s arguments not allowed for debug role",
+				name)
+			return errors.New(errStr)
+		}
+		return nil
+	case operandAny:
+		return nil
+	case operandPattern:
+		if !hasPattern && len(operands) != 0 {
+			operands = operands[1:]
+		}
+	}
+	for _, operand := range operands {
+		if err := checkPath(operand); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPath resolves any symlinks in path and checks that the result is
+// below allowedPaths and not denied. Relative paths are from / where the
+// command runs.
+func checkPath(path string) error {
+	if path == "-" {
+		// stdin
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join("/", path)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		errStr := fmt.Sprintf("Path %s not allowed for debug role: %v",
+			path, err)
+		return errors.New(errStr)
+	}
+	if !isBelow(resolved, allowedPaths) || isBelow(resolved, deniedPaths) {
+		errStr := fmt.Sprintf("Path %s not allowed for debug role", path)
+		return errors.New(errStr)
+	}
+	if strings.HasPrefix(resolved, "/proc/") {
+		for _, elem := range strings.Split(resolved, "/") {
+			if contains(deniedNames, elem) {
+				errStr := fmt.Sprintf("Path %s not allowed for debug role",
+					path)
+				return errors.New(errStr)
+			}
+		}
+	}
+	return nil
+}
+
+// isBelow returns true if path is one of dirs or below one of them
+func isBelow(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		if path == dir || strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, elem := range list {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
+
+// runCommand runs the command with the stdio of the session and returns
+// its exit code
+func runCommand(args []string) int {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Dir = "/"
+	err := cmd.Run()
+	if err == nil {
+		return 0
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			if status.Signaled() {
+				return 128 + int(status.Signal())
+			}
+			return status.ExitStatus()
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%s\n", err)
+	return 127
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sshsession
+
+import (
+	"reflect"
+	"testing"
+)
+
+type TestCheckCommandMatrix struct {
+	role     string
+	command  string
+	expected []string // nil if refused
+}
+
+func TestCheckCommand(t *testing.T) {
+	testMatrix := map[string]TestCheckCommandMatrix{
+		"Root shell": {
+			role:     "root",
+			command:  "",
+			expected: []string{"/bin/sh", "-l"},
+		},
+		"Root command": {
+			role:     "root",
+			command:  "cat /config/device.key.pem | head",
+			expected: []string{"/bin/sh", "-c", "cat /config/device.key.pem | head"},
+		},
+		"Unknown role": {
+			role:    "admin",
+			command: "ls",
+		},
+		"Debug shell": {
+			role:    "debug",
+			command: "",
+		},
+		"Debug not in list": {
+			role:    "debug",
+			command: "rm /proc/uptime",
+		},
+		"Allowed without arguments": {
+			role:     "debug",
+			command:  "uptime",
+			expected: []string{"uptime"},
+		},
+		"Allowed options": {
+			role:     "debug",
+			command:  "ps -ef",
+			expected: []string{"ps", "-ef"},
+		},
+		"Allowed file": {
+			role:     "debug",
+			command:  "cat -n /proc/uptime",
+			expected: []string{"cat", "-n", "/proc/uptime"},
+		},
+		"Allowed symlink": {
+			role:     "debug",
+			command:  "tail -n 5 /proc/self/status",
+			expected: []string{"tail", "-n", "5", "/proc/self/status"},
+		},
+		"Value in cluster": {
+			role:     "debug",
+			command:  "head -qn5 /proc/uptime /proc/loadavg",
+			expected: []string{"head", "-qn5", "/proc/uptime", "/proc/loadavg"},
+		},
+		"Grep pattern": {
+			role:     "debug",
+			command:  "grep -i -A 2 error /proc/uptime",
+			expected: []string{"grep", "-i", "-A", "2", "error", "/proc/uptime"},
+		},
+		"Grep pattern is a path": {
+			role:    "debug",
+			command: "grep -e error /config/device.cert.pem",
+		},
+		"Relative path": {
+			role:     "debug",
+			command:  "ls proc/self",
+			expected: []string{"ls", "proc/self"},
+		},
+		"Config": {
+			role:    "debug",
+			command: "cat /config/device.key.pem",
+		},
+		"Outside the allowed paths": {
+			role:    "debug",
+			command: "cat /etc/passwd",
+		},
+		"Dot dot": {
+			role:    "debug",
+			command: "cat /proc/../etc/passwd",
+		},
+		"Symlink out of proc": {
+			role:    "debug",
+			command: "cat /proc/self/root/etc/passwd",
+		},
+		"Environment": {
+			role:    "debug",
+			command: "cat /proc/self/environ",
+		},
+		"Memory": {
+			role:    "debug",
+			command: "head -c 100 /proc/self/mem",
+		},
+		"Pipe": {
+			role:    "debug",
+			command: "cat /proc/uptime | sh",
+		},
+		"Command substitution": {
+			role:    "debug",
+			command: "cat $(echo /config/device.key.pem)",
+		},
+		"Redirect": {
+			role:    "debug",
+			command: "date > /proc/uptime",
+		},
+		"Semicolon": {
+			role:    "debug",
+			command: "uptime; reboot",
+		},
+		"Wildcard": {
+			role:    "debug",
+			command: "cat /proc/*/environ",
+		},
+		"Quotes": {
+			role:    "debug",
+			command: "grep 'a b' /proc/uptime",
+		},
+		"Grep pattern file": {
+			role:    "debug",
+			command: "grep -f /config/device.key.pem /proc/uptime",
+		},
+		"Grep pattern file in cluster": {
+			role:    "debug",
+			command: "grep -if/config/device.key.pem /proc/uptime",
+		},
+		"Grep recursive": {
+			role:    "debug",
+			command: "grep -r key",
+		},
+		"Long option with file": {
+			role:    "debug",
+			command: "du --files0-from=/config/names",
+		},
+		"Option value missing": {
+			role:    "debug",
+			command: "tail -n",
+		},
+		"Operand after double dash": {
+			role:    "debug",
+			command: "cat -- /config/device.key.pem",
+		},
+		"Date can not be set": {
+			role:    "debug",
+			command: "date -s 2019-01-01",
+		},
+		"Date operand": {
+			role:    "debug",
+			command: "date 010100002019",
+		},
+		"Dmesg clear": {
+			role:    "debug",
+			command: "dmesg -C",
+		},
+		"Dmesg allowed": {
+			role:     "debug",
+			command:  "dmesg -T --kernel",
+			expected: []string{"dmesg", "-T", "--kernel"},
+		},
+		"Lspci file": {
+			role:    "debug",
+			command: "lspci -F /config/device.key.pem",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		args, err := checkCommand(test.role, test.command)
+		if test.expected == nil {
+			if err == nil {
+				t.Errorf("Test Failed: %s: Expected refused, Actual: %v\n",
+					testname, args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %s\n",
+				testname, test.expected, err)
+		} else if !reflect.DeepEqual(args, test.expected) {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, args)
+		}
+	}
+}
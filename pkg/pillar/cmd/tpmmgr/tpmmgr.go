@@ -1,45 +1,544 @@
 // Copyright (c) 2018-2019 Zededa, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
+// Manage the TPM. "tpmmgr genKey" is run by device-steps.sh to create the
+// device key in the TPM and a self-signed device certificate, and
+// "tpmmgr setupVault" to unlock the encrypted vault on /persist before the
+// other agents start. Otherwise tpmmgr runs as an agent which measures the
+// EVE version and configuration into the PCRs once per boot, answers the
+// attestation challenges from the controller, and escrows or recovers the
+// vault key.
+
 package tpmmgr
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-tpm/tpm2"
+	uuid "github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/agentlog"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
+	"github.com/zededa/eve/pkg/pillar/pidfile"
+	"github.com/zededa/eve/pkg/pillar/pubsub"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/vault"
+	"github.com/zededa/eve/pkg/pillar/zboot"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
+	"github.com/zededa/eve/sdk/go/zmet"
 )
 
 const (
-	TpmDevicePath = "/dev/tpm0"
+	agentName       = "tpmmgr"
+	identityDirname = "/config"
+	serverFilename  = identityDirname + "/server"
+	uuidFileName    = identityDirname + "/uuid"
+	deviceCertName  = identityDirname + "/device.cert.pem"
+	attestApi       = "api/v1/edgedevice/attest"
+
+	// Attest again once a day, and retry sooner after failures
+	attestInterval      = 24 * time.Hour
+	attestRetryInterval = 5 * time.Minute
 )
 
-func testOpen() error {
-	rw, err := tpm2.OpenTPM(TpmDevicePath)
-	if err != nil {
-		return err
-	}
-	defer rw.Close()
-	return nil
+// The configuration files which are measured into evetpm.PcrEveConfig.
+// The vault key is sealed to that PCR hence these must exist before the
+// vault is set up on first boot, which excludes device.cert.pem. The
+// latter is tied to the TPM by its key.
+var measuredConfigFiles = []string{
+	"server",
+	"root-certificate.pem",
+	"onboard.cert.pem",
+}
+
+// Set from Makefile
+var Version = "No version specified"
+
+type tpmmgrContext struct {
+	subGlobalConfig        *pubsub.Subscription
+	subDeviceNetworkStatus *pubsub.Subscription
+	deviceNetworkStatus    *types.DeviceNetworkStatus
+	usableAddressCount     int
+	serverName             string
+	zedcloudCtx            zedcloud.ZedCloudContext
+	iteration              int
+	pubVaultStatus         *pubsub.Publication
+	vaultStatus            types.VaultStatus
 }
 
+var debug = false
+var debugOverride bool // From command line arg
+
 func Run() {
+	versionPtr := flag.Bool("v", false, "Version")
+	debugPtr := flag.Bool("d", false, "Debug flag")
 	curpartPtr := flag.String("c", "", "Current partition")
 	flag.Parse()
-
+	debug = *debugPtr
+	debugOverride = debug
+	if debugOverride {
+		log.SetLevel(log.DebugLevel)
+	} else {
+		log.SetLevel(log.InfoLevel)
+	}
 	curpart := *curpartPtr
-
-	log.SetLevel(log.DebugLevel)
+	if *versionPtr {
+		fmt.Printf("%s: %s\n", os.Args[0], Version)
+		return
+	}
 
 	// Sending json log format to stdout
-	logf, err := agentlog.Init("tpmmgr", curpart)
+	logf, err := agentlog.Init(agentName, curpart)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer logf.Close()
 
-	err = testOpen()
+	if flag.NArg() != 0 {
+		switch flag.Arg(0) {
+		case "genKey":
+			if err := genKey(); err != nil {
+				log.Fatal(err)
+			}
+		case "setupVault":
+			if err := setupVault(); err != nil {
+				log.Fatal(err)
+			}
+		default:
+			log.Fatalf("Unknown command %s\n", flag.Arg(0))
+		}
+		return
+	}
+
+	if err := pidfile.CheckAndCreatePidfile(agentName); err != nil {
+		log.Fatal(err)
+	}
+	log.Infof("Starting %s\n", agentName)
+
+	// Run a periodic timer so we always update StillRunning
+	stillRunning := time.NewTicker(25 * time.Second)
+	agentlog.StillRunning(agentName)
+
+	ctx := tpmmgrContext{
+		deviceNetworkStatus: &types.DeviceNetworkStatus{},
+	}
+
+	// Republish what setupVault determined at boot
+	pubVaultStatus, err := pubsub.Publish(agentName, types.VaultStatus{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubVaultStatus = pubVaultStatus
+	if item, err := pubVaultStatus.Get(vault.VaultName); err == nil {
+		ctx.vaultStatus = cast.CastVaultStatus(item)
+		pubVaultStatus.Publish(vault.VaultName, ctx.vaultStatus)
+	}
+
+	// Look for global config such as log levels
+	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
+		false, &ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subGlobalConfig.ModifyHandler = handleGlobalConfigModify
+	subGlobalConfig.DeleteHandler = handleGlobalConfigDelete
+	ctx.subGlobalConfig = subGlobalConfig
+	subGlobalConfig.Activate()
+
+	subDeviceNetworkStatus, err := pubsub.Subscribe("nim",
+		types.DeviceNetworkStatus{}, false, &ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subDeviceNetworkStatus.ModifyHandler = handleDNSModify
+	subDeviceNetworkStatus.DeleteHandler = handleDNSDelete
+	ctx.subDeviceNetworkStatus = subDeviceNetworkStatus
+	subDeviceNetworkStatus.Activate()
+
+	// Without a TPM key there is nothing to attest but we keep running
+	// for the watchdog
+	attestTimer := time.NewTimer(attestRetryInterval)
+	attestTimer.Stop()
+	if !evetpm.IsTpmEnabled() {
+		log.Infof("TPM not in use\n")
+	} else {
+		measure()
+		if err := initAttest(&ctx); err != nil {
+			log.Errorf("Attestation disabled: %s\n", err)
+		} else {
+			attestTimer.Reset(time.Second)
+		}
+	}
+
+	for {
+		select {
+		case change := <-subGlobalConfig.C:
+			subGlobalConfig.ProcessChange(change)
+
+		case change := <-subDeviceNetworkStatus.C:
+			subDeviceNetworkStatus.ProcessChange(change)
+
+		case <-attestTimer.C:
+			if ctx.usableAddressCount == 0 {
+				log.Infof("attest: waiting for IP addresses\n")
+				attestTimer.Reset(attestRetryInterval)
+				break
+			}
+			accepted, err := attest(&ctx)
+			if err != nil {
+				log.Errorf("attest failed: %s\n", err)
+				attestTimer.Reset(attestRetryInterval)
+				break
+			}
+			if accepted {
+				if err := handleVaultKey(&ctx); err != nil {
+					log.Errorf("vault key: %s\n", err)
+					attestTimer.Reset(attestRetryInterval)
+					break
+				}
+			}
+			attestTimer.Reset(attestInterval)
+
+		case <-stillRunning.C:
+			agentlog.StillRunning(agentName)
+		}
+	}
+}
+
+// genKey creates the device key in the TPM and the device certificate
+func genKey() error {
+	if !evetpm.IsTpmPresent() {
+		return errors.New("genKey: no TPM")
+	}
+	if err := evetpm.CreateDeviceKey(); err != nil {
+		return err
+	}
+	if err := evetpm.CreateDeviceCert(deviceCertName); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(evetpm.TpmInUseFile, []byte{}, 0644); err != nil {
+		return err
+	}
+	log.Infof("genKey: created device key and %s\n", deviceCertName)
+	return nil
+}
+
+// setupVault unlocks or creates the vault and publishes its status for
+// the agent to pick up. The configuration is measured first since the
+// key is sealed to it.
+func setupVault() error {
+	provider := vault.GetKeyProvider()
+	if _, ok := provider.(*vault.TpmKeyProvider); ok {
+		measure()
+	}
+	status := vault.Setup(provider)
+	pub, err := pubsub.Publish(agentName, types.VaultStatus{})
+	if err != nil {
+		return err
+	}
+	log.Infof("setupVault: %s %s\n", status.Name, status.State)
+	return pub.Publish(status.Name, status)
+}
+
+// measure extends the PCRs with the EVE version and configuration unless
+// that was already done since boot
+func measure() {
+	if len(evetpm.GetMeasurements()) != 0 {
+		log.Infof("measure: already done\n")
+		return
+	}
+	version := zboot.GetShortVersion(zboot.GetCurrentPartition())
+	if err := evetpm.ExtendPCR(evetpm.PcrEveVersion, version,
+		[]byte(version)); err != nil {
+		log.Errorf("measure: %s\n", err)
+	}
+	for _, name := range measuredConfigFiles {
+		filename := filepath.Join(identityDirname, name)
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			log.Infof("measure: skipping %s\n", err)
+			continue
+		}
+		if err := evetpm.ExtendPCR(evetpm.PcrEveConfig, filename,
+			content); err != nil {
+			log.Errorf("measure: %s\n", err)
+		}
+	}
+}
+
+func initAttest(ctx *tpmmgrContext) error {
+	b, err := ioutil.ReadFile(serverFilename)
+	if err != nil {
+		return err
+	}
+	ctx.serverName = strings.Split(strings.TrimSpace(string(b)), ":")[0]
+	tlsConfig, err := zedcloud.GetTlsConfig(ctx.serverName, nil)
+	if err != nil {
+		return err
+	}
+	ctx.zedcloudCtx = zedcloud.ZedCloudContext{
+		DeviceNetworkStatus: ctx.deviceNetworkStatus,
+		TlsConfig:           tlsConfig,
+		FailureFunc:         zedcloud.ZedCloudFailure,
+		SuccessFunc:         zedcloud.ZedCloudSuccess,
+	}
+	// The UUID is only for tracing hence not required
+	if b, err := ioutil.ReadFile(uuidFileName); err == nil {
+		devUUID, err := uuid.FromString(strings.TrimSpace(string(b)))
+		if err == nil {
+			ctx.zedcloudCtx.DevUUID = devUUID
+		}
+	}
+	return nil
+}
+
+// attest asks the controller for a nonce and sends a quote covering it.
+// Returns true if the controller accepted the quote.
+func attest(ctx *tpmmgrContext) (bool, error) {
+	resp, err := sendAttestReq(ctx, &zmet.ZAttestReq{
+		ReqType: zmet.ZAttestReqType_ATTEST_REQ_NONCE,
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.RespType != zmet.ZAttestRespType_ATTEST_RESP_NONCE ||
+		resp.Nonce == nil || len(resp.Nonce.Nonce) == 0 {
+		errStr := fmt.Sprintf("attest: no nonce in response %v", resp)
+		return false, errors.New(errStr)
+	}
+	quote, err := encodeQuote(resp.Nonce.Nonce)
+	if err != nil {
+		return false, err
+	}
+	resp, err = sendAttestReq(ctx, &zmet.ZAttestReq{
+		ReqType: zmet.ZAttestReqType_ATTEST_REQ_QUOTE,
+		Quote:   quote,
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.RespType != zmet.ZAttestRespType_ATTEST_RESP_QUOTE_RESP ||
+		resp.QuoteResp == nil {
+		errStr := fmt.Sprintf("attest: no quote response in %v", resp)
+		return false, errors.New(errStr)
+	}
+	switch resp.QuoteResp.Response {
+	case zmet.ZAttestResponseCode_ATTEST_RESPONSE_SUCCESS:
+		log.Infof("attest: controller accepted the quote\n")
+		return true, nil
+	default:
+		// Retrying will not change the PCRs
+		log.Errorf("attest: controller rejected the quote: %s\n",
+			resp.QuoteResp.Response)
+		return false, nil
+	}
+}
+
+// handleVaultKey escrows the vault key with the controller, or recovers
+// it if it could not be unsealed. Called after the controller accepted
+// a quote.
+func handleVaultKey(ctx *tpmmgrContext) error {
+	status := ctx.vaultStatus
+	switch {
+	case status.State == types.VaultStateUnlocked && !status.Escrowed:
+		return escrowVaultKey(ctx)
+	case status.State == types.VaultStateLocked &&
+		status.KeyDescriptor != "":
+		return recoverVaultKey(ctx)
+	}
+	return nil
+}
+
+func escrowVaultKey(ctx *tpmmgrContext) error {
+	key, err := vault.GetKeyProvider().RetrieveKey()
+	if err != nil {
+		return err
+	}
+	resp, err := sendAttestReq(ctx, &zmet.ZAttestReq{
+		ReqType: zmet.ZAttestReqType_ATTEST_REQ_ESCROW_KEY,
+		VaultKey: &zmet.ZAttestVaultKey{
+			Key:           key,
+			KeyDescriptor: vault.KeyDescriptor(key),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.RespType != zmet.ZAttestRespType_ATTEST_RESP_ESCROW_RESP ||
+		resp.EscrowResp == nil ||
+		resp.EscrowResp.Response != zmet.ZAttestResponseCode_ATTEST_RESPONSE_SUCCESS {
+		errStr := fmt.Sprintf("escrowVaultKey: not accepted: %v", resp)
+		return errors.New(errStr)
+	}
+	if err := vault.SetEscrowed(ctx.vaultStatus.KeyDescriptor); err != nil {
+		return err
+	}
+	log.Infof("escrowVaultKey: done\n")
+	ctx.vaultStatus.Escrowed = true
+	ctx.pubVaultStatus.Publish(ctx.vaultStatus.Name, ctx.vaultStatus)
+	return nil
+}
+
+// recoverVaultKey gets the key from the controller and reseals it to the
+// current PCR values. The vault is unlocked on the next boot.
+func recoverVaultKey(ctx *tpmmgrContext) error {
+	descriptor, err := hex.DecodeString(ctx.vaultStatus.KeyDescriptor)
+	if err != nil {
+		return err
+	}
+	resp, err := sendAttestReq(ctx, &zmet.ZAttestReq{
+		ReqType: zmet.ZAttestReqType_ATTEST_REQ_RECOVER_KEY,
+		VaultKey: &zmet.ZAttestVaultKey{
+			KeyDescriptor: descriptor,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.RespType != zmet.ZAttestRespType_ATTEST_RESP_RECOVER_RESP ||
+		resp.RecoverResp == nil ||
+		resp.RecoverResp.Response != zmet.ZAttestResponseCode_ATTEST_RESPONSE_SUCCESS ||
+		resp.RecoverResp.VaultKey == nil {
+		errStr := fmt.Sprintf("recoverVaultKey: not returned: %v", resp)
+		return errors.New(errStr)
+	}
+	if err := vault.Recover(vault.GetKeyProvider(),
+		resp.RecoverResp.VaultKey.Key); err != nil {
+		return err
+	}
+	log.Infof("recoverVaultKey: resealed; reboot to unlock\n")
+	ctx.vaultStatus.State = types.VaultStateRecovered
+	ctx.vaultStatus.Error = ""
+	ctx.vaultStatus.ErrorTime = time.Time{}
+	ctx.pubVaultStatus.Publish(ctx.vaultStatus.Name, ctx.vaultStatus)
+	return nil
+}
+
+func encodeQuote(nonce []byte) (*zmet.ZAttestQuote, error) {
+	quote, err := evetpm.GetQuote(nonce)
+	if err != nil {
+		return nil, err
+	}
+	akPublic, akSignature, err := evetpm.GetQuoteKeyPublic()
+	if err != nil {
+		return nil, err
+	}
+	res := &zmet.ZAttestQuote{
+		AttestData:         quote.AttestData,
+		Signature:          quote.Signature,
+		AttestKeyPublic:    akPublic,
+		AttestKeySignature: akSignature,
+		FirmwareEventLog:   evetpm.GetFirmwareEventLog(),
+	}
+	for _, index := range evetpm.QuotePCRs {
+		value, ok := quote.PCRs[index]
+		if !ok {
+			continue
+		}
+		res.PcrValues = append(res.PcrValues, &zmet.TpmPCRValue{
+			Index:    uint32(index),
+			HashAlgo: uint32(tpm2.AlgSHA256),
+			Value:    value,
+		})
+	}
+	for _, m := range evetpm.GetMeasurements() {
+		res.Measurements = append(res.Measurements, &zmet.TpmMeasurement{
+			PcrIndex:    uint32(m.PcrIndex),
+			Description: m.Description,
+			Digest:      m.Digest,
+		})
+	}
+	return res, nil
+}
+
+func sendAttestReq(ctx *tpmmgrContext, req *zmet.ZAttestReq) (*zmet.ZAttestResponse, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	url := ctx.serverName + "/" + attestApi
+	ctx.iteration++
+	const return400 = false
+	_, contents, err := zedcloud.SendOnAllIntf(ctx.zedcloudCtx, url,
+		int64(len(data)), bytes.NewBuffer(data), ctx.iteration, return400)
 	if err != nil {
-		log.Errorln("TPM access test failed.")
+		return nil, err
+	}
+	resp := &zmet.ZAttestResponse{}
+	if err := proto.Unmarshal(contents, resp); err != nil {
+		errStr := fmt.Sprintf("sendAttestReq: Unmarshal failed: %s",
+			err)
+		return nil, errors.New(errStr)
+	}
+	return resp, nil
+}
+
+func handleGlobalConfigModify(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*tpmmgrContext)
+	if key != "global" {
+		log.Infof("handleGlobalConfigModify: ignoring %s\n", key)
+		return
+	}
+	log.Infof("handleGlobalConfigModify for %s\n", key)
+	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
+		debugOverride)
+	log.Infof("handleGlobalConfigModify done for %s\n", key)
+}
+
+func handleGlobalConfigDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*tpmmgrContext)
+	if key != "global" {
+		log.Infof("handleGlobalConfigDelete: ignoring %s\n", key)
+		return
+	}
+	log.Infof("handleGlobalConfigDelete for %s\n", key)
+	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
+		debugOverride)
+	log.Infof("handleGlobalConfigDelete done for %s\n", key)
+}
+
+func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
+
+	status := cast.CastDeviceNetworkStatus(statusArg)
+	ctx := ctxArg.(*tpmmgrContext)
+	if key != "global" {
+		log.Infof("handleDNSModify: ignoring %s\n", key)
+		return
+	}
+	if status.Testing {
+		log.Infof("handleDNSModify ignoring Testing\n")
+		return
+	}
+	log.Infof("handleDNSModify for %s\n", key)
+	*ctx.deviceNetworkStatus = status
+	ctx.usableAddressCount = types.CountLocalAddrAnyNoLinkLocal(status)
+	log.Infof("handleDNSModify done for %s\n", key)
+}
+
+func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
+
+	ctx := ctxArg.(*tpmmgrContext)
+	if key != "global" {
+		log.Infof("handleDNSDelete: ignoring %s\n", key)
+		return
 	}
+	log.Infof("handleDNSDelete for %s\n", key)
+	*ctx.deviceNetworkStatus = types.DeviceNetworkStatus{}
+	ctx.usableAddressCount = 0
+	log.Infof("handleDNSDelete done for %s\n", key)
 }
@@ -0,0 +1,259 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// SFTP server for file transfer sessions. The requests are served by
+// fileHandler so that every file which is opened, and every change to the
+// file system, is recorded with its path in the audit log.
+
+package wstunnelclient
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/sftp"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// runFileTransfer runs an SFTP server on the session
+func runFileTransfer(rs *remoteSession, stream *wsStream) error {
+	h := &fileHandler{
+		config:   rs.config,
+		root:     "/",
+		readOnly: rs.config.ReadOnly,
+	}
+	server := sftp.NewRequestServer(stream, h.handlers())
+	go func() {
+		<-rs.done
+		server.Close()
+	}()
+	err := server.Serve()
+	if err == io.EOF {
+		err = nil
+	}
+	return err
+}
+
+// fileHandler implements the sftp request handlers on the file system
+// under root
+type fileHandler struct {
+	config   types.RemoteSessionConfig
+	root     string
+	readOnly bool
+}
+
+func (h *fileHandler) handlers() sftp.Handlers {
+	return sftp.Handlers{
+		FileGet:  h,
+		FilePut:  h,
+		FileCmd:  h,
+		FileList: h,
+	}
+}
+
+// localPath maps the cleaned absolute path of the request to root
+func (h *fileHandler) localPath(p string) string {
+	return filepath.Join(h.root, filepath.Clean("/"+p))
+}
+
+// audit records the request; failures are warnings
+func (h *fileHandler) audit(event string, p string, err error) {
+	entry := audit(h.config, event).WithField("path", p)
+	if err != nil {
+		entry.Warn(err.Error())
+	} else {
+		entry.Info("")
+	}
+}
+
+// Fileread opens a file for reading
+func (h *fileHandler) Fileread(r *sftp.Request) (io.ReaderAt, error) {
+	f, err := os.Open(h.localPath(r.Filepath))
+	h.audit("read", r.Filepath, err)
+	if err != nil {
+		return nil, err
+	}
+	return &auditFile{handler: h, path: r.Filepath, file: f}, nil
+}
+
+// Filewrite opens a file for writing
+func (h *fileHandler) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	if h.readOnly {
+		h.audit("write", r.Filepath, os.ErrPermission)
+		return nil, os.ErrPermission
+	}
+	pflags := r.Pflags()
+	flags := os.O_WRONLY
+	if pflags.Read {
+		flags = os.O_RDWR
+	}
+	if pflags.Creat {
+		flags |= os.O_CREATE
+	}
+	if pflags.Trunc {
+		flags |= os.O_TRUNC
+	}
+	if pflags.Excl {
+		flags |= os.O_EXCL
+	}
+	// O_APPEND is left out since WriteAt fails with it; the client
+	// passes the offset anyhow
+	f, err := os.OpenFile(h.localPath(r.Filepath), flags, 0644)
+	h.audit("write", r.Filepath, err)
+	if err != nil {
+		return nil, err
+	}
+	return &auditFile{handler: h, path: r.Filepath, file: f}, nil
+}
+
+// Filecmd handles the requests which change the file system
+func (h *fileHandler) Filecmd(r *sftp.Request) error {
+	event := strings.ToLower(r.Method)
+	p := r.Filepath
+	if r.Target != "" {
+		p = r.Filepath + " -> " + r.Target
+	}
+	if h.readOnly {
+		h.audit(event, p, os.ErrPermission)
+		return os.ErrPermission
+	}
+	local := h.localPath(r.Filepath)
+	var err error
+	switch r.Method {
+	case "Setstat":
+		err = h.setstat(local, r)
+	case "Rename":
+		err = os.Rename(local, h.localPath(r.Target))
+	case "Rmdir", "Remove":
+		err = os.Remove(local)
+	case "Mkdir":
+		err = os.Mkdir(local, 0755)
+	case "Symlink":
+		// The link at Target points at Filepath
+		err = os.Symlink(r.Filepath, h.localPath(r.Target))
+	default:
+		err = os.ErrInvalid
+	}
+	h.audit(event, p, err)
+	return err
+}
+
+func (h *fileHandler) setstat(local string, r *sftp.Request) error {
+	flags := r.AttrFlags()
+	attrs := r.Attributes()
+	if attrs == nil {
+		return os.ErrInvalid
+	}
+	if flags.Size {
+		if err := os.Truncate(local, int64(attrs.Size)); err != nil {
+			return err
+		}
+	}
+	if flags.Permissions {
+		if err := os.Chmod(local, attrs.FileMode().Perm()); err != nil {
+			return err
+		}
+	}
+	if flags.Acmodtime {
+		atime := time.Unix(int64(attrs.Atime), 0)
+		mtime := time.Unix(int64(attrs.Mtime), 0)
+		if err := os.Chtimes(local, atime, mtime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Filelist handles directory listings, stat and readlink. Only the
+// listings are recorded.
+func (h *fileHandler) Filelist(r *sftp.Request) (sftp.ListerAt, error) {
+	local := h.localPath(r.Filepath)
+	switch r.Method {
+	case "List":
+		files, err := ioutil.ReadDir(local)
+		h.audit("list", r.Filepath, err)
+		if err != nil {
+			return nil, err
+		}
+		return listerAt(files), nil
+	case "Stat":
+		fi, err := os.Stat(local)
+		if err != nil {
+			return nil, err
+		}
+		return listerAt([]os.FileInfo{fi}), nil
+	case "Readlink":
+		target, err := os.Readlink(local)
+		if err != nil {
+			return nil, err
+		}
+		return listerAt([]os.FileInfo{linkInfo(target)}), nil
+	}
+	return nil, os.ErrInvalid
+}
+
+type listerAt []os.FileInfo
+
+func (l listerAt) ListAt(ls []os.FileInfo, offset int64) (int, error) {
+	if offset >= int64(len(l)) {
+		return 0, io.EOF
+	}
+	n := copy(ls, l[offset:])
+	if n < len(ls) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// linkInfo carries the target of a symlink as the name
+type linkInfo string
+
+func (l linkInfo) Name() string       { return string(l) }
+func (l linkInfo) Size() int64        { return 0 }
+func (l linkInfo) Mode() os.FileMode  { return os.ModeSymlink | 0777 }
+func (l linkInfo) ModTime() time.Time { return time.Time{} }
+func (l linkInfo) IsDir() bool        { return false }
+func (l linkInfo) Sys() interface{}   { return nil }
+
+// auditFile counts the bytes transferred and records them when the
+// client closes the file
+type auditFile struct {
+	bytesIn  uint64 // First for 64-bit alignment
+	bytesOut uint64
+	handler  *fileHandler
+	path     string
+	file     *os.File
+}
+
+func (f *auditFile) ReadAt(p []byte, off int64) (int, error) {
+	n, err := f.file.ReadAt(p, off)
+	atomic.AddUint64(&f.bytesOut, uint64(n))
+	return n, err
+}
+
+func (f *auditFile) WriteAt(p []byte, off int64) (int, error) {
+	n, err := f.file.WriteAt(p, off)
+	atomic.AddUint64(&f.bytesIn, uint64(n))
+	return n, err
+}
+
+func (f *auditFile) Close() error {
+	err := f.file.Close()
+	entry := audit(f.handler.config, "close").WithFields(log.Fields{
+		"path":     f.path,
+		"bytesIn":  atomic.LoadUint64(&f.bytesIn),
+		"bytesOut": atomic.LoadUint64(&f.bytesOut),
+	})
+	if err != nil {
+		entry.Warn(err.Error())
+	} else {
+		entry.Info("")
+	}
+	return err
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package wstunnelclient
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/sftp"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// auditHook collects the audit log entries
+type auditHook struct {
+	mutex   sync.Mutex
+	entries []*log.Entry
+}
+
+func (h *auditHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h *auditHook) Fire(entry *log.Entry) error {
+	h.mutex.Lock()
+	h.entries = append(h.entries, entry)
+	h.mutex.Unlock()
+	return nil
+}
+
+// find returns the entry for the event and path
+func (h *auditHook) find(event string, path string) *log.Entry {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for _, entry := range h.entries {
+		if entry.Data["event"] == event && entry.Data["path"] == path {
+			return entry
+		}
+	}
+	return nil
+}
+
+// startFileTransfer runs the SFTP server on a websocket like a session
+// does and returns a client for it and the server side stream
+func startFileTransfer(t *testing.T, root string,
+	readOnly bool) (*sftp.Client, func() *wsStream, func()) {
+
+	config := types.RemoteSessionConfig{
+		User:     "tester",
+		Type:     types.RemoteSessionFileTransfer,
+		ReadOnly: readOnly,
+	}
+	streamChan := make(chan *wsStream, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			ws, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				t.Errorf("Upgrade: %s", err)
+				return
+			}
+			stream := newWSStream(ws)
+			streamChan <- stream
+			h := &fileHandler{config: config, root: root,
+				readOnly: readOnly}
+			sftp.NewRequestServer(stream, h.handlers()).Serve()
+		}))
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		server.Close()
+		t.Fatalf("Dial: %s", err)
+	}
+	stream := newWSStream(ws)
+	client, err := sftp.NewClientPipe(stream, stream)
+	if err != nil {
+		server.Close()
+		t.Fatalf("NewClientPipe: %s", err)
+	}
+	var serverStream *wsStream
+	getStream := func() *wsStream {
+		if serverStream == nil {
+			serverStream = <-streamChan
+		}
+		return serverStream
+	}
+	return client, getStream, func() {
+		client.Close()
+		server.Close()
+	}
+}
+
+func TestFileTransfer(t *testing.T) {
+	hook := &auditHook{}
+	auditLog = log.New()
+	auditLog.Out = ioutil.Discard
+	auditLog.AddHook(hook)
+	defer func() { auditLog = nil }()
+
+	root, err := ioutil.TempDir("", "filetransfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	if err := ioutil.WriteFile(filepath.Join(root, "existing"),
+		[]byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client, getStream, stop := startFileTransfer(t, root, false)
+	defer stop()
+
+	f, err := client.Open("/existing")
+	if err != nil {
+		t.Fatalf("Open: %s", err)
+	}
+	content, err := ioutil.ReadAll(f)
+	f.Close()
+	if err != nil || string(content) != "hello" {
+		t.Errorf("Read: %q %v", content, err)
+	}
+
+	f, err = client.Create("/new")
+	if err != nil {
+		t.Fatalf("Create: %s", err)
+	}
+	if _, err := f.Write([]byte("uploaded")); err != nil {
+		t.Errorf("Write: %s", err)
+	}
+	f.Close()
+	if err := client.Rename("/new", "/renamed"); err != nil {
+		t.Errorf("Rename: %s", err)
+	}
+	content, err = ioutil.ReadFile(filepath.Join(root, "renamed"))
+	if err != nil || string(content) != "uploaded" {
+		t.Errorf("Uploaded file: %q %v", content, err)
+	}
+	if err := client.Remove("/existing"); err != nil {
+		t.Errorf("Remove: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "existing")); !os.IsNotExist(err) {
+		t.Errorf("Removed file still exists: %v", err)
+	}
+	// Paths can not escape the root
+	if _, err := client.Open("/../../etc/passwd"); err == nil {
+		t.Errorf("Opened a file outside of the root")
+	}
+
+	expected := []struct {
+		event string
+		path  string
+	}{
+		{"read", "/existing"},
+		{"close", "/existing"},
+		{"write", "/new"},
+		{"close", "/new"},
+		{"rename", "/new -> /renamed"},
+		{"remove", "/existing"},
+	}
+	for _, e := range expected {
+		entry := hook.find(e.event, e.path)
+		if entry == nil {
+			t.Errorf("No audit of %s %s", e.event, e.path)
+			continue
+		}
+		if entry.Data["user"] != "tester" {
+			t.Errorf("Audit of %s %s without the user: %v", e.event,
+				e.path, entry.Data)
+		}
+	}
+	if entry := hook.find("close", "/new"); entry != nil &&
+		entry.Data["bytesIn"] != uint64(len("uploaded")) {
+		t.Errorf("Expected %d bytes in, Actual: %v\n", len("uploaded"),
+			entry.Data["bytesIn"])
+	}
+	stream := getStream()
+	if atomic.LoadUint64(&stream.bytesIn) == 0 ||
+		atomic.LoadUint64(&stream.bytesOut) == 0 {
+		t.Errorf("Stream bytes not counted: in %d out %d",
+			atomic.LoadUint64(&stream.bytesIn),
+			atomic.LoadUint64(&stream.bytesOut))
+	}
+}
+
+func TestFileTransferReadOnly(t *testing.T) {
+	hook := &auditHook{}
+	auditLog = log.New()
+	auditLog.Out = ioutil.Discard
+	auditLog.AddHook(hook)
+	defer func() { auditLog = nil }()
+
+	root, err := ioutil.TempDir("", "filetransfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	if err := ioutil.WriteFile(filepath.Join(root, "existing"),
+		[]byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client, _, stop := startFileTransfer(t, root, true)
+	defer stop()
+
+	if _, err := client.Create("/new"); err == nil {
+		t.Errorf("Create succeeded on a read-only session")
+	}
+	if err := client.Remove("/existing"); err == nil {
+		t.Errorf("Remove succeeded on a read-only session")
+	}
+	if err := client.Rename("/existing", "/renamed"); err == nil {
+		t.Errorf("Rename succeeded on a read-only session")
+	}
+	if _, err := os.Stat(filepath.Join(root, "existing")); err != nil {
+		t.Errorf("Read-only session changed the file: %s", err)
+	}
+	for _, path := range []string{"/new", "/existing"} {
+		event := "write"
+		if path == "/existing" {
+			event = "remove"
+		}
+		entry := hook.find(event, path)
+		if entry == nil || entry.Level != log.WarnLevel {
+			t.Errorf("Denied %s %s not audited as a warning: %v",
+				event, path, entry)
+		}
+	}
+}
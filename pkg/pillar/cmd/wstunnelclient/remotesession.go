@@ -0,0 +1,399 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Remote sessions to the device itself. The controller authorizes a
+// session for a user by adding it to the device config; we then open a
+// websocket for the session to the tunnel server which connects it to the
+// user. Each session runs until it expires, is removed from the config,
+// or either end closes it. The sessions are recorded in an audit log
+// which logmanager uploads.
+
+package wstunnelclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Picked up by logmanager as the source
+	auditLogName = "remoteaudit"
+	// Retry interval for connecting a session to the tunnel server
+	sessionRetryInterval = 10 * time.Second
+	shellPath            = "/bin/sh"
+	maxAuditLineLen      = 1024
+)
+
+var auditLog *log.Logger
+
+func audit(config types.RemoteSessionConfig, event string) *log.Entry {
+	fields := log.Fields{
+		"session": config.ID,
+		"user":    config.User,
+		"type":    config.Type.String(),
+		"event":   event,
+	}
+	if config.Type == types.RemoteSessionPortForward {
+		fields["port"] = config.Port
+	}
+	if config.Type == types.RemoteSessionAppConsole {
+		fields["app"] = config.AppInstance
+	}
+	if auditLog == nil {
+		return log.WithFields(fields)
+	}
+	return auditLog.WithFields(fields)
+}
+
+type remoteSession struct {
+	config types.RemoteSessionConfig
+	done   chan struct{}
+	once   sync.Once
+	mutex  sync.Mutex
+	stream *wsStream
+}
+
+// stop ends the session; safe to call more than once and from any
+// goroutine
+func (rs *remoteSession) stop(reason string) {
+	rs.once.Do(func() {
+		log.Infof("Stopping remote session %s: %s\n",
+			rs.config.ID, reason)
+		audit(rs.config, "stop").Info(reason)
+		close(rs.done)
+		rs.mutex.Lock()
+		if rs.stream != nil {
+			rs.stream.Close()
+		}
+		rs.mutex.Unlock()
+	})
+}
+
+// startRemoteSession connects the session and runs it in a goroutine.
+// The session is sent on doneChan when it has ended.
+func startRemoteSession(ctx *wstunnelclientContext,
+	config types.RemoteSessionConfig, doneChan chan<- *remoteSession) *remoteSession {
+
+	rs := &remoteSession{
+		config: config,
+		done:   make(chan struct{}),
+	}
+	tunnel := ctx.wstunnelclient
+	expiry := time.AfterFunc(time.Until(config.Expires), func() {
+		rs.stop("expired")
+	})
+	go func() {
+		defer func() {
+			expiry.Stop()
+			doneChan <- rs
+		}()
+		ws := rs.connect(tunnel)
+		if ws == nil {
+			return
+		}
+		stream := newWSStream(ws)
+		rs.mutex.Lock()
+		select {
+		case <-rs.done:
+			rs.mutex.Unlock()
+			ws.Close()
+			return
+		default:
+		}
+		rs.stream = stream
+		rs.mutex.Unlock()
+
+		audit(config, "start").Infof("expires %v", config.Expires)
+		var err error
+		switch config.Type {
+		case types.RemoteSessionShell:
+			err = runShell(rs, stream)
+		case types.RemoteSessionPortForward:
+			err = runPortForward(rs, stream)
+		case types.RemoteSessionFileTransfer:
+			err = runFileTransfer(rs, stream)
+		case types.RemoteSessionAppConsole:
+			err = runAppConsole(rs, stream)
+		default:
+			err = fmt.Errorf("unsupported type %s", config.Type)
+		}
+		rs.stop("ended")
+		entry := audit(config, "end").WithFields(log.Fields{
+			"bytesIn":  atomic.LoadUint64(&stream.bytesIn),
+			"bytesOut": atomic.LoadUint64(&stream.bytesOut),
+		})
+		if err != nil {
+			entry.Warn(err.Error())
+		} else {
+			entry.Info("")
+		}
+	}()
+	return rs
+}
+
+// connect retries until the tunnel server accepts the session or the
+// session is stopped
+func (rs *remoteSession) connect(tunnel *zedcloud.WSTunnelClient) *websocket.Conn {
+
+	for {
+		ws, err := tunnel.DialSession(rs.config.ID)
+		if err == nil {
+			return ws
+		}
+		log.Errorf("Remote session %s connect failed: %s\n",
+			rs.config.ID, err)
+		select {
+		case <-rs.done:
+			return nil
+		case <-time.After(sessionRetryInterval):
+		}
+	}
+}
+
+// wsStream presents the binary messages of a websocket as a byte stream.
+// Text messages carry control messages for the session. The byte counts
+// are updated and read from different goroutines hence atomic.
+type wsStream struct {
+	bytesIn     uint64 // First for 64-bit alignment
+	bytesOut    uint64
+	ws          *websocket.Conn
+	reader      io.Reader
+	writeMutex  sync.Mutex
+	controlFunc func(msg []byte)
+}
+
+func newWSStream(ws *websocket.Conn) *wsStream {
+	return &wsStream{ws: ws}
+}
+
+func (s *wsStream) Read(p []byte) (int, error) {
+	for {
+		if s.reader != nil {
+			n, err := s.reader.Read(p)
+			if err == io.EOF {
+				s.reader = nil
+				if n == 0 {
+					continue
+				}
+				err = nil
+			}
+			atomic.AddUint64(&s.bytesIn, uint64(n))
+			return n, err
+		}
+		messageType, reader, err := s.ws.NextReader()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+		switch messageType {
+		case websocket.BinaryMessage:
+			s.reader = reader
+		case websocket.TextMessage:
+			msg := make([]byte, 4096)
+			n, _ := io.ReadFull(reader, msg)
+			if s.controlFunc != nil {
+				s.controlFunc(msg[:n])
+			}
+		}
+	}
+}
+
+func (s *wsStream) Write(p []byte) (int, error) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	s.ws.SetWriteDeadline(time.Now().Add(time.Minute))
+	if err := s.ws.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	atomic.AddUint64(&s.bytesOut, uint64(len(p)))
+	return len(p), nil
+}
+
+func (s *wsStream) Close() error {
+	s.writeMutex.Lock()
+	s.ws.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(time.Second))
+	s.writeMutex.Unlock()
+	return s.ws.Close()
+}
+
+// windowSize is the control message for a shell session
+type windowSize struct {
+	Rows uint16 `json:"rows"`
+	Cols uint16 `json:"cols"`
+}
+
+// runShell runs an interactive shell on a pty. The input is recorded
+// line by line in the audit log.
+func runShell(rs *remoteSession, stream *wsStream) error {
+	master, slaveName, err := openPty()
+	if err != nil {
+		return err
+	}
+	defer master.Close()
+	slave, err := os.OpenFile(slaveName, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(shellPath, "-l")
+	cmd.Env = []string{"TERM=xterm", "HOME=/root",
+		"PATH=/usr/sbin:/usr/bin:/sbin:/bin"}
+	cmd.Dir = "/"
+	cmd.Stdin = slave
+	cmd.Stdout = slave
+	cmd.Stderr = slave
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	err = cmd.Start()
+	slave.Close()
+	if err != nil {
+		return err
+	}
+	stream.controlFunc = func(msg []byte) {
+		var ws windowSize
+		if err := json.Unmarshal(msg, &ws); err != nil {
+			log.Warnf("runShell: bad control message: %s\n", err)
+			return
+		}
+		unix.IoctlSetWinsize(int(master.Fd()), unix.TIOCSWINSZ,
+			&unix.Winsize{Row: ws.Rows, Col: ws.Cols})
+	}
+	go func() {
+		io.Copy(stream, master)
+		rs.stop("shell output closed")
+	}()
+	go func() {
+		io.Copy(master, &auditReader{config: rs.config, r: stream})
+		rs.stop("user closed")
+	}()
+	<-rs.done
+	// Kill the process group started by Setsid
+	syscall.Kill(-cmd.Process.Pid, syscall.SIGHUP)
+	err = cmd.Wait()
+	if _, ok := err.(*exec.ExitError); ok {
+		// Expected after SIGHUP
+		err = nil
+	}
+	return err
+}
+
+// auditReader records what the user types, a line at a time
+type auditReader struct {
+	config types.RemoteSessionConfig
+	r      io.Reader
+	line   []byte
+}
+
+func (a *auditReader) Read(p []byte) (int, error) {
+	n, err := a.r.Read(p)
+	for _, c := range p[:n] {
+		if c == '\r' || c == '\n' {
+			a.flush()
+			continue
+		}
+		if len(a.line) < maxAuditLineLen {
+			a.line = append(a.line, c)
+		}
+	}
+	if err != nil {
+		a.flush()
+	}
+	return n, err
+}
+
+func (a *auditReader) flush() {
+	if len(a.line) == 0 {
+		return
+	}
+	audit(a.config, "input").Info(string(a.line))
+	a.line = a.line[:0]
+}
+
+// openPty returns the master and the name of the slave of a new pty
+func openPty() (*os.File, string, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	var n uint32
+	if err := ptyIoctl(master, unix.TIOCGPTN, unsafe.Pointer(&n)); err != nil {
+		master.Close()
+		return nil, "", err
+	}
+	var unlock int32
+	if err := ptyIoctl(master, unix.TIOCSPTLCK, unsafe.Pointer(&unlock)); err != nil {
+		master.Close()
+		return nil, "", err
+	}
+	return master, fmt.Sprintf("/dev/pts/%d", n), nil
+}
+
+func ptyIoctl(f *os.File, req uintptr, arg unsafe.Pointer) error {
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), req, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// runPortForward connects the session to the port on localhost. Only
+// localhost is allowed so that the device is not used as a relay.
+func runPortForward(rs *remoteSession, stream *wsStream) error {
+	addr := fmt.Sprintf("127.0.0.1:%d", rs.config.Port)
+	conn, err := net.DialTimeout("tcp", addr, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	go func() {
+		io.Copy(stream, conn)
+		rs.stop("local connection closed")
+	}()
+	go func() {
+		io.Copy(conn, stream)
+		rs.stop("user closed")
+	}()
+	<-rs.done
+	return conn.Close()
+}
+
+// runAppConsole attaches the session to the serial console of the app
+// instance which domainmgr serves. The input is recorded like for a shell.
+func runAppConsole(rs *remoteSession, stream *wsStream) error {
+	conn, err := net.DialTimeout("unix", types.ConsoleSocket,
+		10*time.Second)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(conn, "attach %s\n",
+		rs.config.AppInstance); err != nil {
+		conn.Close()
+		return err
+	}
+	go func() {
+		io.Copy(stream, conn)
+		rs.stop("console closed")
+	}()
+	go func() {
+		io.Copy(conn, &auditReader{config: rs.config, r: stream})
+		rs.stop("user closed")
+	}()
+	<-rs.done
+	return conn.Close()
+}
@@ -40,12 +40,16 @@ type DNSContext struct {
 }
 
 type wstunnelclientContext struct {
-	subGlobalConfig      *pubsub.Subscription
-	subAppInstanceConfig *pubsub.Subscription
-	serverName           string
-	wstunnelclient       *zedcloud.WSTunnelClient
-	dnsContext           *DNSContext
+	subGlobalConfig        *pubsub.Subscription
+	subAppInstanceConfig   *pubsub.Subscription
+	subRemoteSessionConfig *pubsub.Subscription
+	serverName             string
+	wstunnelclient         *zedcloud.WSTunnelClient
+	dnsContext             *DNSContext
 	// XXX add any output from scanAIConfigs()?
+	sessions        map[string]*remoteSession
+	endedSessions   map[string]types.RemoteSessionConfig // Not restarted unless changed
+	sessionDoneChan chan *remoteSession
 }
 
 var debug = false
@@ -76,6 +80,10 @@ func Run() {
 	if err := pidfile.CheckAndCreatePidfile(agentName); err != nil {
 		log.Fatal(err)
 	}
+	auditLog, err = agentlog.InitAudit(auditLogName)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	log.Infof("Starting %s\n", agentName)
 
@@ -87,7 +95,11 @@ func Run() {
 		deviceNetworkStatus: &types.DeviceNetworkStatus{},
 	}
 
-	wscCtx := wstunnelclientContext{}
+	wscCtx := wstunnelclientContext{
+		sessions:        make(map[string]*remoteSession),
+		endedSessions:   make(map[string]types.RemoteSessionConfig),
+		sessionDoneChan: make(chan *remoteSession),
+	}
 
 	// Look for global config such as log levels
 	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
@@ -121,6 +133,16 @@ func Run() {
 	subAppInstanceConfig.DeleteHandler = handleAppInstanceConfigDelete
 	wscCtx.subAppInstanceConfig = subAppInstanceConfig
 
+	// Look for RemoteSessionConfig from zedagent
+	subRemoteSessionConfig, err := pubsub.Subscribe("zedagent",
+		types.RemoteSessionConfig{}, false, &wscCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subRemoteSessionConfig.ModifyHandler = handleRemoteSessionConfigModify
+	subRemoteSessionConfig.DeleteHandler = handleRemoteSessionConfigDelete
+	wscCtx.subRemoteSessionConfig = subRemoteSessionConfig
+
 	//get server name
 	bytes, err := ioutil.ReadFile(serverFilename)
 	if err != nil {
@@ -129,6 +151,7 @@ func Run() {
 	strTrim := strings.TrimSpace(string(bytes))
 	wscCtx.serverName = strings.Split(strTrim, ":")[0]
 	subAppInstanceConfig.Activate()
+	subRemoteSessionConfig.Activate()
 
 	wscCtx.dnsContext = &DNSctx
 	// Wait for knowledge about IP addresses. XXX needed?
@@ -143,6 +166,7 @@ func Run() {
 		}
 	}
 
+	sessionTicker := time.NewTicker(time.Minute)
 	for {
 		select {
 		case change := <-subGlobalConfig.C:
@@ -154,6 +178,17 @@ func Run() {
 		case change := <-subAppInstanceConfig.C:
 			subAppInstanceConfig.ProcessChange(change)
 
+		case change := <-subRemoteSessionConfig.C:
+			subRemoteSessionConfig.ProcessChange(change)
+
+		case rs := <-wscCtx.sessionDoneChan:
+			handleRemoteSessionDone(&wscCtx, rs)
+
+		case <-sessionTicker.C:
+			// Retry the tunnel and drop expired sessions
+			scanAIConfigs(&wscCtx)
+			scanRemoteSessions(&wscCtx)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -267,10 +302,20 @@ func scanAIConfigs(ctx *wstunnelclientContext) {
 			config.DisplayName, config.RemoteConsole)
 		isTunnelRequired = config.RemoteConsole || isTunnelRequired
 	}
+	// Remote sessions to the device itself also use the tunnel
+	for _, c := range ctx.subRemoteSessionConfig.GetAll() {
+		config := cast.CastRemoteSessionConfig(c)
+		if _, ended := ctx.endedSessions[config.Key()]; !ended && !config.Expired() {
+			isTunnelRequired = true
+		}
+	}
 	log.Infof("Tunnel check status after checking app-instance configs: %t\n",
 		isTunnelRequired)
 
 	if !isTunnelRequired {
+		for _, rs := range ctx.sessions {
+			rs.stop("tunnel no longer required")
+		}
 		if ctx.wstunnelclient != nil {
 			ctx.wstunnelclient.Stop()
 			ctx.wstunnelclient = nil
@@ -328,3 +373,72 @@ func scanAIConfigs(ctx *wstunnelclientContext) {
 		log.Infof("Could not connect to %s using intf %s\n", destURL, ifname)
 	}
 }
+
+func handleRemoteSessionConfigModify(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Infof("handleRemoteSessionConfigModify for %s\n", key)
+	ctx := ctxArg.(*wstunnelclientContext)
+	config := cast.CastRemoteSessionConfig(configArg)
+	if rs, ok := ctx.sessions[key]; ok && rs.config != config {
+		// Restarted below with the new config
+		rs.stop("config changed")
+		delete(ctx.sessions, key)
+	}
+	if ended, ok := ctx.endedSessions[key]; ok && ended != config {
+		delete(ctx.endedSessions, key)
+	}
+	scanAIConfigs(ctx)
+	scanRemoteSessions(ctx)
+	log.Infof("handleRemoteSessionConfigModify done for %s\n", key)
+}
+
+func handleRemoteSessionConfigDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Infof("handleRemoteSessionConfigDelete for %s\n", key)
+	ctx := ctxArg.(*wstunnelclientContext)
+	if rs, ok := ctx.sessions[key]; ok {
+		rs.stop("removed by controller")
+		delete(ctx.sessions, key)
+	}
+	delete(ctx.endedSessions, key)
+	scanAIConfigs(ctx)
+	log.Infof("handleRemoteSessionConfigDelete done for %s\n", key)
+}
+
+// scanRemoteSessions starts the sessions which are authorized and not
+// yet running or ended. Needs the tunnel which scanAIConfigs sets up.
+func scanRemoteSessions(ctx *wstunnelclientContext) {
+
+	if ctx.wstunnelclient == nil {
+		return
+	}
+	for key, c := range ctx.subRemoteSessionConfig.GetAll() {
+		config := cast.CastRemoteSessionConfig(c)
+		if _, ok := ctx.sessions[key]; ok {
+			continue
+		}
+		if _, ended := ctx.endedSessions[key]; ended || config.Expired() {
+			continue
+		}
+		log.Infof("Starting remote session %s for %s type %s\n",
+			key, config.User, config.Type)
+		ctx.sessions[key] = startRemoteSession(ctx, config,
+			ctx.sessionDoneChan)
+	}
+}
+
+// handleRemoteSessionDone records that the session ended hence it is not
+// restarted unless the controller changes it
+func handleRemoteSessionDone(ctx *wstunnelclientContext, rs *remoteSession) {
+
+	key := rs.config.Key()
+	if ctx.sessions[key] != rs {
+		// Replaced or removed
+		return
+	}
+	log.Infof("Remote session %s ended\n", key)
+	delete(ctx.sessions, key)
+	ctx.endedSessions[key] = rs.config
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Renewal of the device certificate, either when it is about to expire or
+// when requested by the controller using the renewCert counter.
+
+package zedagent
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/pubsub"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
+	"github.com/zededa/eve/sdk/go/zconfig"
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+const (
+	renewCertConfigFilename = configDir + "/renewCertConfig"
+	// Renew when the certificate expires within this time
+	certRenewBefore   = 30 * 24 * time.Hour
+	certCheckInterval = 24 * time.Hour
+)
+
+// Buffered so that a request is not lost while a renewal is in progress
+var renewCertChan = make(chan struct{}, 1)
+
+type certRenewResult struct {
+	time time.Time
+	err  error
+}
+
+var renewCertPrevConfigHash []byte
+
+// scheduleCertRenewal requests a renewal when the counter changes. The
+// first time the counter is seen it is recorded without a renewal.
+func scheduleCertRenewal(renewCert *zconfig.DeviceOpsCmd) {
+	if renewCert == nil {
+		return
+	}
+	configHash := computeConfigSha(renewCert)
+	same := bytes.Equal(configHash, renewCertPrevConfigHash)
+	renewCertPrevConfigHash = configHash
+	if same {
+		return
+	}
+	renewCertConfig := &zconfig.DeviceOpsCmd{}
+	b, readErr := ioutil.ReadFile(renewCertConfigFilename)
+	if readErr == nil {
+		if err := json.Unmarshal(b, renewCertConfig); err != nil {
+			log.Errorf("scheduleCertRenewal: %s\n", err)
+		} else if renewCertConfig.Counter == renewCert.Counter {
+			return
+		}
+	}
+	b, err := json.Marshal(renewCert)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := pubsub.WriteRename(renewCertConfigFilename, b); err != nil {
+		log.Errorf("scheduleCertRenewal: failed %s\n", err)
+	}
+	if os.IsNotExist(readErr) {
+		log.Infof("scheduleCertRenewal: initial counter %d\n",
+			renewCert.Counter)
+		return
+	}
+	log.Infof("scheduleCertRenewal: old %d new %d\n",
+		renewCertConfig.Counter, renewCert.Counter)
+	select {
+	case renewCertChan <- struct{}{}:
+	default:
+		log.Infof("scheduleCertRenewal: already pending\n")
+	}
+}
+
+// certRenewalTask renews the certificate when requested or when it is
+// about to expire and reports the outcome on resultChan
+func certRenewalTask(resultChan chan<- certRenewResult) {
+	ticker := time.NewTicker(certCheckInterval)
+	iteration := 0
+	renew := certExpiresSoon()
+	for {
+		if renew {
+			err := zedcloud.RenewDeviceCert(zedcloudCtx, serverName,
+				iteration)
+			if err != nil {
+				log.Errorf("certRenewalTask: %s\n", err)
+			}
+			iteration++
+			resultChan <- certRenewResult{time: time.Now(), err: err}
+		}
+		select {
+		case <-renewCertChan:
+			renew = true
+		case <-ticker.C:
+			renew = certExpiresSoon()
+		}
+	}
+}
+
+func certExpiresSoon() bool {
+	cert, err := zedcloud.GetDeviceCert()
+	if err != nil {
+		log.Errorf("certExpiresSoon: %s\n", err)
+		return false
+	}
+	if time.Until(cert.NotAfter) > certRenewBefore {
+		return false
+	}
+	log.Warnf("certExpiresSoon: device certificate expires %v\n",
+		cert.NotAfter)
+	return true
+}
+
+func handleCertRenewResult(ctx *zedagentContext, result certRenewResult) {
+	if result.err != nil {
+		ctx.certRenewError = result.err.Error()
+		ctx.certRenewErrorTime = result.time
+	} else {
+		ctx.certRenewTime = result.time
+		ctx.certRenewError = ""
+		ctx.certRenewErrorTime = time.Time{}
+	}
+	ctx.TriggerDeviceInfo = true
+}
+
+func encodeDeviceCert(ctx *zedagentContext) *zmet.ZInfoDeviceCert {
+	cert, err := zedcloud.GetDeviceCert()
+	if err != nil {
+		log.Errorf("encodeDeviceCert: %s\n", err)
+		return nil
+	}
+	info := new(zmet.ZInfoDeviceCert)
+	info.NotBefore, _ = ptypes.TimestampProto(cert.NotBefore)
+	info.NotAfter, _ = ptypes.TimestampProto(cert.NotAfter)
+	info.SerialNumber = cert.SerialNumber.Text(16)
+	if !ctx.certRenewTime.IsZero() {
+		info.LastRenewTime, _ = ptypes.TimestampProto(ctx.certRenewTime)
+	}
+	info.LastRenewError = ctx.certRenewError
+	if !ctx.certRenewErrorTime.IsZero() {
+		info.LastRenewErrorTime, _ = ptypes.TimestampProto(ctx.certRenewErrorTime)
+	}
+	return info
+}
@@ -28,6 +28,7 @@ import (
 var configApi string = "api/v1/edgedevice/config"
 var statusApi string = "api/v1/edgedevice/info"
 var metricsApi string = "api/v1/edgedevice/metrics"
+var metricsBatchApi string = "api/v1/edgedevice/metrics/batch"
 
 // This is set once at init time and not changed
 var serverName string
@@ -58,6 +59,8 @@ type getconfigContext struct {
 	pubBaseOsConfig             *pubsub.Publication
 	pubDatastoreConfig          *pubsub.Publication
 	pubNetworkInstanceConfig    *pubsub.Publication
+	pubRemoteSessionConfig      *pubsub.Publication
+	pubSshCAConfig              *pubsub.Publication
 	rebootFlag                  bool
 }
 
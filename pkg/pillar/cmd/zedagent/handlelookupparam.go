@@ -9,12 +9,14 @@ package zedagent
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/sha256"
-	"crypto/tls"
+	"encoding/asn1"
 	"encoding/base64"
 	"fmt"
+	"math/big"
 	"net"
 	"os"
 	"os/exec"
@@ -24,13 +26,14 @@ import (
 
 	"github.com/eriknordmark/ipinfo"
 	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
 	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
 	"github.com/zededa/eve/sdk/go/zconfig"
 )
 
 const (
 	deviceCertName          = identityDirname + "/device.cert.pem"
-	deviceKeyName           = identityDirname + "/device.key.pem"
 	infraFileName           = identityDirname + "/infra"
 	tmpDirname              = "/var/tmp/zededa"
 	zedserverConfigFileName = tmpDirname + "/zedserverconfig"
@@ -50,6 +53,7 @@ type DeviceLispConfig struct {
 //  device.cert.pem,
 //  device.key.pem		Device certificate/key created before this
 //  		     		client is started.
+//  tpm_in_use			The device key is in the TPM; no device.key.pem
 //  infra			If this file exists assume zedcontrol and do not
 //  				create ACLs
 //  root-certificate.pem	Root CA cert(s)
@@ -131,8 +135,7 @@ func handleLookupParam(getconfigCtx *getconfigContext,
 	log.Infof("handleLookupParam: updated lispInfo %v\n", lispInfo)
 
 	// Load device cert
-	deviceCert, err := tls.LoadX509KeyPair(deviceCertName,
-		deviceKeyName)
+	deviceCert, err := zedcloud.GetClientCert()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -180,24 +183,38 @@ func handleLookupParam(getconfigCtx *getconfigContext,
 	log.Debugf("base64 hash %s\n",
 		base64.StdEncoding.EncodeToString(hash))
 	var signature string
-	switch deviceCert.PrivateKey.(type) {
+	var r, s *big.Int
+	switch key := deviceCert.PrivateKey.(type) {
 	default:
 		log.Fatal("Private Key RSA type not supported")
 	case *ecdsa.PrivateKey:
-		key := deviceCert.PrivateKey.(*ecdsa.PrivateKey)
-		r, s, err := ecdsa.Sign(rand.Reader, key, hash)
+		r, s, err = ecdsa.Sign(rand.Reader, key, hash)
 		if err != nil {
 			log.Fatal("ecdsa.Sign: ", err)
 		}
-		log.Debugf("r.bytes %d s.bytes %d\n", len(r.Bytes()),
-			len(s.Bytes()))
-		sigres := r.Bytes()
-		sigres = append(sigres, s.Bytes()...)
-		signature = base64.StdEncoding.EncodeToString(sigres)
-		log.Debugf("sigres (len %d): % x\n",
-			len(sigres), sigres)
-		log.Debugln("signature:", signature)
+	case *evetpm.TpmSigner:
+		// The TPM returns the ASN.1 encoding
+		var sig []byte
+		sig, err = key.Sign(rand.Reader, hash, crypto.SHA256)
+		if err != nil {
+			log.Fatal("TpmSigner.Sign: ", err)
+		}
+		var rs struct {
+			R, S *big.Int
+		}
+		if _, err = asn1.Unmarshal(sig, &rs); err != nil {
+			log.Fatal("asn1.Unmarshal: ", err)
+		}
+		r, s = rs.R, rs.S
 	}
+	log.Debugf("r.bytes %d s.bytes %d\n", len(r.Bytes()),
+		len(s.Bytes()))
+	sigres := r.Bytes()
+	sigres = append(sigres, s.Bytes()...)
+	signature = base64.StdEncoding.EncodeToString(sigres)
+	log.Debugf("sigres (len %d): % x\n",
+		len(sigres), sigres)
+	log.Debugln("signature:", signature)
 	log.Debugf("MapServers %+v\n", lispConfig.MapServers)
 	log.Debugf("Lisp IID %d\n", lispConfig.LispInstance)
 	log.Debugf("EID %s\n", lispConfig.EID)
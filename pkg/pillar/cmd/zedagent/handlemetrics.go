@@ -21,12 +21,14 @@ import (
 	"github.com/eriknordmark/netlink"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/google/go-cmp/cmp"
 	"github.com/shirou/gopsutil/disk"
 	"github.com/shirou/gopsutil/host"
 	psutilnet "github.com/shirou/gopsutil/net"
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/agentlog"
 	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/devicenetwork"
 	"github.com/zededa/eve/pkg/pillar/diskmetrics"
 	"github.com/zededa/eve/pkg/pillar/flextimer"
 	"github.com/zededa/eve/pkg/pillar/hardware"
@@ -45,6 +47,7 @@ var reportPaths = []string{"/", "/config", persistPath}
 var appPersistPaths = []string{"/persist/img", "/persist/downloads/appImg.obj"}
 
 func publishMetrics(ctx *zedagentContext, iteration int) {
+	updateMetricsExport(ctx)
 	cpuMemoryStat := ExecuteXentopCmd()
 	if cpuMemoryStat == nil {
 		return
@@ -140,6 +143,7 @@ func handleDomainStatusModify(ctxArg interface{}, key string,
 		domainStatus = make(map[string]types.DomainStatus)
 	}
 	// Detect if any changes relevant to the device status report
+	usbChanged := false
 	old := lookupDomainStatus(ctx, key)
 	if old != nil {
 		log.Infof("handleDomainStatusModify change for %s domainname %s\n",
@@ -147,6 +151,7 @@ func handleDomainStatusModify(ctxArg interface{}, key string,
 		if ioAdapterListChanged(*old, status) {
 			ctx.TriggerDeviceInfo = true
 		}
+		usbChanged = !cmp.Equal(old.UsbDeviceList, status.UsbDeviceList)
 	} else {
 		log.Infof("handleDomainStatusModify add for %s domainname %s\n",
 			key, status.DomainName)
@@ -173,6 +178,15 @@ func handleDomainStatusModify(ctxArg interface{}, key string,
 	appDiskAndNameList[status.DomainName] = diskList
 	log.Debugf("handleDomainStatusModify appIntf %s %v\n",
 		status.DomainName, interfaceList)
+	// USB devices come and go without a change to the AppInstanceStatus
+	if usbChanged {
+		aiStatus := lookupAppInstanceStatus(ctx, key)
+		if aiStatus != nil {
+			PublishAppInfoToZedCloud(ctx, key, aiStatus,
+				ctx.assignableAdapters, ctx.iteration)
+			ctx.iteration += 1
+		}
+	}
 	log.Debugf("handleDomainStatusModify done for %s\n", key)
 }
 
@@ -441,6 +455,9 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 	*ReportZmetric = zmet.ZmetricTypes_ZmDevice
 
 	ReportMetrics.AtTimeStamp = ptypes.TimestampNow()
+	samples := takeSamples(ctx)
+	ReportDeviceMetric.CpuPercent = samples.deviceCpu.encode()
+	ReportDeviceMetric.MemoryUsedMB = samples.deviceMemory.encode()
 
 	info, err := host.Info()
 	if err != nil {
@@ -520,6 +537,10 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 		networkDetails.RxAclDrops = metric.RxAclDrops
 		networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
 		networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
+		if p := types.GetPort(*deviceNetworkStatus, port); p != nil {
+			networkDetails.VlanId = uint32(p.Vlan.VlanID)
+		}
+		networkDetails.Vlans = encodeVlanMetrics(metric.VlanMetrics, false)
 		ReportDeviceMetric.Network = append(ReportDeviceMetric.Network,
 			networkDetails)
 	}
@@ -648,15 +669,16 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 		ReportDeviceMetric.Disk = append(ReportDeviceMetric.Disk, &metric)
 	}
 
-	// Note that these are associated with the device and not with a
-	// device name like ppp0 or wwan0
-	lte := readLTEMetrics()
-	for _, i := range lte {
-		item := new(zmet.MetricItem)
-		item.Key = i.Key
-		item.Type = zmet.MetricItemType(i.Type)
-		setMetricAnyValue(item, i.Value)
-		ReportDeviceMetric.MetricItems = append(ReportDeviceMetric.MetricItems, item)
+	for _, port := range deviceNetworkStatus.Ports {
+		if port.Cellular == nil {
+			continue
+		}
+		signal, ok := devicenetwork.ReadCellularSignal()
+		if !ok {
+			continue
+		}
+		ReportDeviceMetric.Cellular = append(ReportDeviceMetric.Cellular,
+			encodeCellularMetric(port.Name, port.IfName, signal))
 	}
 
 	cpuTotal, usedMemory, availableMemory, usedMemoryPercent := lookupCpuMemoryStat(cpuMemoryStat, "Domain-0")
@@ -708,6 +730,16 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 		ReportAppMetric.Memory.UsedPercentage = usedMemoryPercent
 		availableMemoryPercent := 100.0 - usedMemoryPercent
 		ReportAppMetric.Memory.AvailPercentage = availableMemoryPercent
+		ReportAppMetric.CpuPercent = samples.domainCpu[aiStatus.DomainName].encode()
+		ReportAppMetric.MemoryUsedMB = samples.domainMemory[aiStatus.DomainName].encode()
+		if aiStatus.Activated {
+			ds := lookupDomainStatus(ctx, aiStatus.Key())
+			if ds != nil && ds.MaxMemory != 0 {
+				ReportAppMetric.Balloon = encodeBalloonMetric(*ds,
+					lookupDomainMemory(cpuMemoryStat,
+						aiStatus.DomainName))
+			}
+		}
 
 		appInterfaceList := ReadAppInterfaceList(aiStatus.DomainName)
 		log.Debugf("ReportMetrics: domainName %s ifs %v\n",
@@ -747,6 +779,9 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 				networkDetails.RxAclDrops = metric.RxAclDrops
 				networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
+				// Bridge VLAN counters are always swapped
+				networkDetails.Vlans = encodeVlanMetrics(
+					metric.VlanMetrics, true)
 			} else {
 				// Note that the packets received on bu* and bo* where sent
 				// by the domU and vice versa, hence we swap here
@@ -762,9 +797,16 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 				networkDetails.RxAclDrops = metric.TxAclDrops
 				networkDetails.TxAclRateLimitDrops = metric.RxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.TxAclRateLimitDrops
+				networkDetails.Vlans = encodeVlanMetrics(
+					metric.VlanMetrics, true)
 			}
 			ReportAppMetric.Network = append(ReportAppMetric.Network,
 				networkDetails)
+			if metric.QosMetric != nil {
+				ReportAppMetric.Qos = append(ReportAppMetric.Qos,
+					encodeQosMetric(name, metric.IfName,
+						*metric.QosMetric))
+			}
 		}
 
 		appDiskList := ReadAppDiskList(aiStatus.DomainName)
@@ -785,8 +827,14 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 
 	createNetworkInstanceMetrics(ctx, ReportMetrics)
 
+	setLatestMetrics(ctx, ReportMetrics)
 	log.Debugf("PublishMetricsToZedCloud sending %s\n", ReportMetrics)
-	SendMetricsProtobuf(ReportMetrics, iteration)
+	if SendMetricsProtobuf(ReportMetrics, iteration) {
+		sendBufferedMetrics(metricsBufferDirname, iteration,
+			sendMetricsBatch)
+	} else {
+		bufferMetrics(metricsBufferDirname, ReportMetrics)
+	}
 }
 
 func getDiskInfo(diskfile string, appDiskDetails *zmet.AppDiskMetric) error {
@@ -1070,6 +1118,26 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 		ReportDeviceInfo.AssignableAdapters = append(ReportDeviceInfo.AssignableAdapters,
 			reportAA)
 	}
+	// Report the USB devices which can be assigned by UsbDeviceMatch
+	for _, dev := range aa.UsbDeviceList {
+		reportAA := new(zmet.ZioBundle)
+		reportAA.Type = zmet.ZioType_ZioUSBDevice
+		reportAA.Name = dev.PortPath
+		if dev.UsedByUUID != nilUUID {
+			reportAA.UsedByAppUUID = dev.UsedByUUID.String()
+		}
+		reportAA.UsbDevice = &zmet.ZioUsbDevice{
+			VendorId:  dev.VendorID,
+			ProductId: dev.ProductID,
+			Serial:    dev.Serial,
+			PortPath:  dev.PortPath,
+			Product:   dev.Product,
+			Present:   true,
+			Attached:  dev.UsedByUUID != nilUUID,
+		}
+		ReportDeviceInfo.AssignableAdapters = append(ReportDeviceInfo.AssignableAdapters,
+			reportAA)
+	}
 
 	info, err := host.Info()
 	if err != nil {
@@ -1089,21 +1157,6 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 		ReportDeviceInfo.HostName = hostname
 	}
 
-	// Note that these are associated with the device and not with a
-	// device name like ppp0 or wwan0
-	lte := readLTEInfo()
-	lteNets := readLTENetworks()
-	if lteNets != nil {
-		lte = append(lte, lteNets...)
-	}
-	for _, i := range lte {
-		item := new(zmet.MetricItem)
-		item.Key = i.Key
-		item.Type = zmet.MetricItemType(i.Type)
-		setMetricAnyValue(item, i.Value)
-		ReportDeviceInfo.MetricItems = append(ReportDeviceInfo.MetricItems, item)
-	}
-
 	ReportDeviceInfo.LastRebootReason = ctx.rebootReason
 	if !ctx.rebootTime.IsZero() {
 		rebootTime, _ := ptypes.TimestampProto(ctx.rebootTime)
@@ -1113,6 +1166,9 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 	ReportDeviceInfo.SystemAdapter = encodeSystemAdapterInfo(ctx.devicePortConfigList)
 
 	ReportDeviceInfo.RestartCounter = ctx.restartCounter
+	ReportDeviceInfo.DataSecAtRest = encodeDataSecAtRest(ctx.vaultStatus)
+	ReportDeviceInfo.DeviceCert = encodeDeviceCert(ctx)
+	ReportDeviceInfo.Capacity = encodeDeviceCapacity(ctx.deviceCapacity)
 
 	ReportInfo.InfoContent = new(zmet.ZInfoMsg_Dinfo)
 	if x, ok := ReportInfo.GetInfoContent().(*zmet.ZInfoMsg_Dinfo); ok {
@@ -1198,45 +1254,6 @@ func addUserSwInfo(ctx *zedagentContext, swInfo *zmet.ZInfoDevSW) {
 	}
 }
 
-func setMetricAnyValue(item *zmet.MetricItem, val interface{}) {
-	switch t := val.(type) {
-	case uint32:
-		u := val.(uint32)
-		item.MetricItemValue = new(zmet.MetricItem_Uint32Value)
-		if x, ok := item.GetMetricItemValue().(*zmet.MetricItem_Uint32Value); ok {
-			x.Uint32Value = u
-		}
-	case uint64:
-		u := val.(uint64)
-		item.MetricItemValue = new(zmet.MetricItem_Uint64Value)
-		if x, ok := item.GetMetricItemValue().(*zmet.MetricItem_Uint64Value); ok {
-			x.Uint64Value = u
-		}
-	case bool:
-		b := val.(bool)
-		item.MetricItemValue = new(zmet.MetricItem_BoolValue)
-		if x, ok := item.GetMetricItemValue().(*zmet.MetricItem_BoolValue); ok {
-			x.BoolValue = b
-		}
-	case float32:
-		f := val.(float32)
-		item.MetricItemValue = new(zmet.MetricItem_FloatValue)
-		if x, ok := item.GetMetricItemValue().(*zmet.MetricItem_FloatValue); ok {
-			x.FloatValue = f
-		}
-
-	case string:
-		s := val.(string)
-		item.MetricItemValue = new(zmet.MetricItem_StringValue)
-		if x, ok := item.GetMetricItemValue().(*zmet.MetricItem_StringValue); ok {
-			x.StringValue = s
-		}
-
-	default:
-		log.Errorf("setMetricAnyValue unknown %T\n", t)
-	}
-}
-
 var nilIPInfo = ipinfo.IPInfo{}
 
 func getNetInfo(interfaceDetail psutilnet.InterfaceStat,
@@ -1351,10 +1368,28 @@ func encodeSystemAdapterInfo(dpcl types.DevicePortConfigList) *zmet.SystemAdapte
 			dps.LastSucceeded = ts
 		}
 		dps.LastError = dpc.LastError
+		for _, result := range dpc.TestResults {
+			dps.TestResults = append(dps.TestResults,
+				encodePortTestResult(result))
+		}
 
 		dps.Ports = make([]*zmet.DevicePort, len(dpc.Ports))
 		for j, p := range dpc.Ports {
 			dps.Ports[j] = encodeNetworkPortConfig(&p)
+			if i != dpcl.CurrentIndex {
+				continue
+			}
+			// The state is only known for the one in use
+			ps := deviceNetworkStatus.GetPortByIfName(p.IfName)
+			if ps == nil {
+				continue
+			}
+			if ps.Wifi != nil {
+				dps.Ports[j].Wifi = encodeWifiStatus(*ps.Wifi)
+			}
+			if ps.Cellular != nil {
+				dps.Ports[j].Cellular = encodeCellularStatus(*ps.Cellular)
+			}
 		}
 		info.Status[i] = dps
 	}
@@ -1362,12 +1397,159 @@ func encodeSystemAdapterInfo(dpcl types.DevicePortConfigList) *zmet.SystemAdapte
 	return info
 }
 
+func encodePortTestResult(result types.PortTestResult) *zmet.ZPortTestResult {
+	res := new(zmet.ZPortTestResult)
+	res.Ifname = result.IfName
+	res.TestTime, _ = ptypes.TimestampProto(result.TestTime)
+	res.Succeeded = result.Succeeded()
+	for _, stage := range result.Stages {
+		res.Stages = append(res.Stages, &zmet.ZConnectivityStageResult{
+			Stage:      zmet.ZConnectivityStage(stage.Stage),
+			DurationMs: uint32(stage.Duration / time.Millisecond),
+			ErrorCode:  zmet.ZConnectivityError(stage.ErrorCode),
+			Error:      stage.Error,
+		})
+	}
+	return res
+}
+
+func encodeWifiStatus(ws types.WifiStatus) *zmet.ZInfoWifi {
+	return &zmet.ZInfoWifi{
+		Ssid:         ws.SSID,
+		Bssid:        ws.BSSID,
+		Associated:   ws.Associated(),
+		WpaState:     ws.WpaState,
+		SignalDbm:    ws.SignalDbm,
+		FrequencyMhz: ws.Frequency,
+	}
+}
+
+func encodeCellularStatus(cs types.CellularStatus) *zmet.ZInfoCellular {
+	info := &zmet.ZInfoCellular{
+		Connected:    cs.Connected,
+		Registration: cs.Registration,
+		Operator:     cs.Operator,
+		Mcc:          cs.MCC,
+		Mnc:          cs.MNC,
+		Roaming:      cs.Roaming,
+		Rat:          cs.RAT,
+	}
+	for _, n := range cs.VisibleNetworks {
+		info.VisibleNetworks = append(info.VisibleNetworks,
+			&zmet.ZCellularNetwork{
+				Mcc:         n.MCC,
+				Mnc:         n.MNC,
+				Description: n.Description,
+				Status:      n.Status,
+			})
+	}
+	return info
+}
+
+func encodeCellularMetric(name string, ifname string,
+	signal types.CellularSignal) *zmet.CellularMetric {
+
+	return &zmet.CellularMetric{
+		IName:  name,
+		IfName: ifname,
+		Rat:    signal.RAT,
+		Rssi:   signal.RSSI,
+		Rsrq:   signal.RSRQ,
+		Rsrp:   signal.RSRP,
+		Snr:    signal.SNR,
+		Ecio:   signal.ECIO,
+	}
+}
+
+// Returns the memory of the domain in MBytes
+func lookupDomainMemory(cpuMemoryStat [][]string, domainname string) uint32 {
+
+	for _, stat := range cpuMemoryStat {
+		if len(stat) <= 5 {
+			continue
+		}
+		if strings.TrimSpace(stat[1]) != domainname {
+			continue
+		}
+		// This is in kbytes
+		memory, err := strconv.ParseUint(stat[5], 10, 0)
+		if err != nil {
+			log.Errorf("ParseUint(%s) failed: %s", stat[5], err)
+			return 0
+		}
+		return uint32(RoundFromKbytesToMbytes(memory))
+	}
+	return 0
+}
+
+// encodeBalloonMetric; the target and limits are in kbytes in DomainStatus
+func encodeBalloonMetric(ds types.DomainStatus,
+	actualMem uint32) *zmet.AppBalloonMetric {
+
+	return &zmet.AppBalloonMetric{
+		TargetMem:     uint32(RoundFromKbytesToMbytes(uint64(ds.MemoryTarget))),
+		ActualMem:     actualMem,
+		MaxMem:        uint32(RoundFromKbytesToMbytes(uint64(ds.MaxMemory))),
+		Vcpus:         uint32(ds.VCpus),
+		MaxVcpus:      uint32(ds.MaxVCpus),
+		AutoBallooned: ds.AutoBallooned,
+	}
+}
+
+// encodeQosMetric; the QosMetric is already from the app's perspective
+func encodeQosMetric(name string, ifname string,
+	qm types.QosMetric) *zmet.AppQosMetric {
+
+	return &zmet.AppQosMetric{
+		IName:               name,
+		LocalName:           ifname,
+		EgressRate:          qm.EgressRate,
+		IngressRate:         qm.IngressRate,
+		EgressBytes:         qm.EgressBytes,
+		EgressPkts:          qm.EgressPkts,
+		EgressDrops:         qm.EgressDrops,
+		IngressBytes:        qm.IngressBytes,
+		IngressPkts:         qm.IngressPkts,
+		IngressDrops:        qm.IngressDrops,
+		IngressOverlimits:   qm.IngressOverlimits,
+		IngressBacklogBytes: qm.IngressBacklogBytes,
+		IngressBacklogPkts:  qm.IngressBacklogPkts,
+	}
+}
+
+// encodeVlanMetrics swaps rx and tx if the counters are from the
+// perspective of the bridge and not the domU
+func encodeVlanMetrics(vms []types.VlanMetric, swap bool) []*zmet.VlanMetric {
+	var vlans []*zmet.VlanMetric
+	for _, vm := range vms {
+		vlan := new(zmet.VlanMetric)
+		vlan.VlanId = uint32(vm.VlanID)
+		if swap {
+			vlan.TxBytes = vm.RxBytes
+			vlan.RxBytes = vm.TxBytes
+			vlan.TxPkts = vm.RxPkts
+			vlan.RxPkts = vm.TxPkts
+		} else {
+			vlan.TxBytes = vm.TxBytes
+			vlan.RxBytes = vm.RxBytes
+			vlan.TxPkts = vm.TxPkts
+			vlan.RxPkts = vm.RxPkts
+		}
+		vlans = append(vlans, vlan)
+	}
+	return vlans
+}
+
 func encodeNetworkPortConfig(npc *types.NetworkPortConfig) *zmet.DevicePort {
 	dp := new(zmet.DevicePort)
 	dp.Ifname = npc.IfName
 	dp.Name = npc.Name
 	dp.IsMgmt = npc.IsMgmt
 	dp.Free = npc.Free
+	if npc.IsVlan() {
+		dp.VlanParent = npc.Vlan.ParentIfName
+		dp.VlanId = uint32(npc.Vlan.VlanID)
+	}
 	// DhcpConfig
 	dp.DhcpType = uint32(npc.Dhcp)
 	dp.Subnet = npc.AddrSubnet
@@ -1462,6 +1644,8 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			bootTime, _ := ptypes.TimestampProto(aiStatus.BootTime)
 			ReportAppInfo.BootTime = bootTime
 		}
+		ReportAppInfo.Health = encodeAppHealth(aiStatus.Health)
+		ReportAppInfo.WaitingFor = aiStatus.WaitingFor
 
 		for _, ib := range ds.IoAdapterList {
 			reportAA := new(zmet.ZioBundle)
@@ -1475,6 +1659,31 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			ReportAppInfo.AssignedAdapters = append(ReportAppInfo.AssignedAdapters,
 				reportAA)
 		}
+		for _, us := range ds.UsbDeviceList {
+			reportAA := new(zmet.ZioBundle)
+			reportAA.Type = zmet.ZioType_ZioUSBDevice
+			reportAA.Name = us.Match.Name
+			reportAA.UsedByAppUUID = ds.Key()
+			reportAA.UsbDevice = &zmet.ZioUsbDevice{
+				VendorId:  us.Match.VendorID,
+				ProductId: us.Match.ProductID,
+				Serial:    us.Match.Serial,
+				PortPath:  us.Match.PortPath,
+				Present:   us.Present,
+				Attached:  us.Attached,
+				Error:     us.Error,
+			}
+			// Report what was found
+			if us.Present {
+				reportAA.UsbDevice.VendorId = us.Device.VendorID
+				reportAA.UsbDevice.ProductId = us.Device.ProductID
+				reportAA.UsbDevice.Serial = us.Device.Serial
+				reportAA.UsbDevice.PortPath = us.Device.PortPath
+				reportAA.UsbDevice.Product = us.Device.Product
+			}
+			ReportAppInfo.AssignedAdapters = append(ReportAppInfo.AssignedAdapters,
+				reportAA)
+		}
 		// Get vifs assigned to the application
 		// Mostly reporting the UP status
 		// We extract the appIP from the dnsmasq assignment
@@ -1488,10 +1697,9 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 					continue
 				}
 				networkInfo := getNetInfo(interfaceDetail, false)
-				ip, macAddr := getAppIP(ctx, aiStatus,
+				ips, macAddr := getAppIP(ctx, aiStatus,
 					ifname)
-				networkInfo.IPAddrs = make([]string, 1)
-				networkInfo.IPAddrs[0] = *proto.String(ip)
+				networkInfo.IPAddrs = ips
 				networkInfo.MacAddr = *proto.String(macAddr)
 				name := appIfnameToName(aiStatus, ifname)
 				log.Debugf("app %s/%s localName %s devName %s\n",
@@ -1565,8 +1773,9 @@ func SendProtobuf(url string, buf *bytes.Buffer, size int64,
 // Try all (first free, then rest) until it gets through.
 // Each iteration we try a different port for load spreading.
 // For each port we try all its local IP addresses until we get a success.
+// Returns true if the metrics were sent
 func SendMetricsProtobuf(ReportMetrics *zmet.ZMetricMsg,
-	iteration int) {
+	iteration int) bool {
 	data, err := proto.Marshal(ReportMetrics)
 	if err != nil {
 		log.Fatal("SendInfoProtobufStr proto marshaling error: ", err)
@@ -1581,10 +1790,11 @@ func SendMetricsProtobuf(ReportMetrics *zmet.ZMetricMsg,
 	if err != nil {
 		// Hopefully next timeout will be more successful
 		log.Errorf("SendMetricsProtobuf failed: %s\n", err)
-		return
+		return false
 	} else {
 		writeSentMetricsProtoMessage(data)
 	}
+	return true
 }
 
 // Return an array of names like "sda", "sdb1"
@@ -1658,19 +1868,24 @@ func getDefaultRouters(ifname string) []string {
 }
 
 // Use the ifname/vifname to find the overlay or underlay status
-// and from there the (ip, mac) addresses for the app
+// and from there the (ips, mac) addresses for the app. An underlay
+// has an IPv6 address after the IPv4 one if the network instance has IPv6.
 func getAppIP(ctx *zedagentContext, aiStatus *types.AppInstanceStatus,
-	vifname string) (string, string) {
+	vifname string) ([]string, string) {
 
 	log.Debugf("getAppIP(%s, %s)\n", aiStatus.Key(), vifname)
 	for _, ulStatus := range aiStatus.UnderlayNetworks {
 		if ulStatus.Vif != vifname {
 			continue
 		}
-		log.Debugf("getAppIP(%s, %s) found underlay %s mac %s\n",
-			aiStatus.Key(), vifname,
-			ulStatus.AssignedIPAddr, ulStatus.Mac)
-		return ulStatus.AssignedIPAddr, ulStatus.Mac
+		log.Debugf("getAppIP(%s, %s) found underlay %s/%s mac %s\n",
+			aiStatus.Key(), vifname, ulStatus.AssignedIPAddr,
+			ulStatus.AssignedIPv6Addr, ulStatus.Mac)
+		ips := []string{ulStatus.AssignedIPAddr}
+		if ulStatus.AssignedIPv6Addr != "" {
+			ips = append(ips, ulStatus.AssignedIPv6Addr)
+		}
+		return ips, ulStatus.Mac
 	}
 	for _, olStatus := range aiStatus.OverlayNetworks {
 		if olStatus.Vif != vifname {
@@ -1679,7 +1894,88 @@ func getAppIP(ctx *zedagentContext, aiStatus *types.AppInstanceStatus,
 		log.Debugf("getAppIP(%s, %s) found overlay %s mac %s\n",
 			aiStatus.Key(), vifname,
 			olStatus.EID.String(), olStatus.Mac)
-		return olStatus.EID.String(), olStatus.Mac
+		return []string{olStatus.EID.String()}, olStatus.Mac
+	}
+	return []string{""}, ""
+}
+
+func encodeDataSecAtRest(status types.VaultStatus) *zmet.DataSecAtRest {
+	if status.Name == "" {
+		return nil
+	}
+	info := new(zmet.DataSecAtRest)
+	switch status.State {
+	case types.VaultStateUnlocked:
+		info.State = zmet.DataSecAtRestState_DATASEC_AT_REST_UNLOCKED
+	case types.VaultStateLocked:
+		info.State = zmet.DataSecAtRestState_DATASEC_AT_REST_LOCKED
+	case types.VaultStateRecovered:
+		info.State = zmet.DataSecAtRestState_DATASEC_AT_REST_RECOVERED
+	case types.VaultStateError:
+		info.State = zmet.DataSecAtRestState_DATASEC_AT_REST_ERROR
+	default:
+		info.State = zmet.DataSecAtRestState_DATASEC_AT_REST_UNKNOWN
 	}
-	return "", ""
+	info.KeyProvider = status.KeyProvider
+	for _, pcr := range status.PCRs {
+		info.Pcrs = append(info.Pcrs, uint32(pcr))
+	}
+	info.KeyEscrowed = status.Escrowed
+	info.LastError = status.Error
+	if !status.ErrorTime.IsZero() {
+		errTime, _ := ptypes.TimestampProto(status.ErrorTime)
+		info.LastErrorTime = errTime
+	}
+	return info
+}
+
+func encodeDeviceCapacity(capacity types.DeviceCapacity) *zmet.ZInfoCapacity {
+	if capacity.TotalMemory == 0 && capacity.Ncpu == 0 {
+		return nil
+	}
+	info := new(zmet.ZInfoCapacity)
+	info.TotalMemory = capacity.TotalMemory
+	info.ReservedMemory = capacity.ReservedMemory
+	info.AllocatedMemory = capacity.AllocatedMemory
+	info.Ncpu = uint32(capacity.Ncpu)
+	info.PinnedCpus = capacity.PinnedCPUs
+	info.ExclusivePinning = capacity.ExclusivePinning
+	for _, alloc := range capacity.Allocations {
+		info.Allocations = append(info.Allocations,
+			&zmet.ZInfoAppAllocation{
+				Uuid:     alloc.UUIDandVersion.UUID.String(),
+				Name:     alloc.DisplayName,
+				Memory:   alloc.Memory,
+				Vcpus:    uint32(alloc.VCpus),
+				Cpus:     alloc.CPUs,
+				Admitted: alloc.Admitted,
+				Error:    alloc.Error,
+			})
+	}
+	return info
+}
+
+func encodeAppHealth(health types.HealthStatus) *zmet.ZInfoAppHealth {
+	info := new(zmet.ZInfoAppHealth)
+	switch health.State {
+	case types.HealthHealthy:
+		info.State = zmet.ZAppHealthState_APP_HEALTH_HEALTHY
+	case types.HealthUnhealthy:
+		info.State = zmet.ZAppHealthState_APP_HEALTH_UNHEALTHY
+	default:
+		info.State = zmet.ZAppHealthState_APP_HEALTH_UNKNOWN
+	}
+	if !health.StateTime.IsZero() {
+		stateTime, _ := ptypes.TimestampProto(health.StateTime)
+		info.StateTime = stateTime
+	}
+	info.LastProbeError = health.LastProbeError
+	info.ConsecutiveFailures = health.ConsecutiveFailures
+	info.RestartCount = health.RestartCount
+	if !health.LastRestartTime.IsZero() {
+		restartTime, _ := ptypes.TimestampProto(health.LastRestartTime)
+		info.LastRestartTime = restartTime
+	}
+	info.LastRestartReason = health.LastRestartReason
+	return info
 }
@@ -87,10 +87,19 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 		info.BridgeName = status.BridgeName
 		info.BridgeIPAddr = status.BridgeIPAddr
 
+		if status.HasIPv6() {
+			info.BridgeIPv6Addr = status.BridgeIPv6Addr
+			info.Ipv6Subnet = status.IPv6Subnet.String()
+		}
+
 		for mac, ip := range status.IPAssignments {
 			assignment := new(zmet.ZmetIPAssignmentEntry)
 			assignment.MacAddress = mac
 			assignment.IpAddress = append(assignment.IpAddress, ip.String())
+			if ip6, ok := status.IPv6Assignments[mac]; ok {
+				assignment.IpAddress = append(assignment.IpAddress,
+					ip6.String())
+			}
 			info.IpAssignments = append(info.IpAssignments,
 				assignment)
 		}
@@ -106,6 +115,20 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 		}
 		info.Ipv4Eid = status.Ipv4Eid
 
+		for _, u := range status.Uplinks {
+			ui := new(zmet.ZInfoUplink)
+			ui.IfName = u.IfName
+			ui.Name = u.Name
+			ui.Up = u.Up
+			ui.Active = u.Active
+			ui.Weight = u.Weight
+			ui.Failures = u.Failures
+			if !u.LastChange.IsZero() {
+				ui.LastChange, _ = ptypes.TimestampProto(u.LastChange)
+			}
+			info.Uplinks = append(info.Uplinks, ui)
+		}
+
 		// For now we just send an empty lispInfo to indicate deletion to cloud.
 		// It can't be omitted since protobuf requires something to satisfy
 		// the oneof.
@@ -289,10 +312,39 @@ func protoEncodeNetworkInstanceMetricProto(status types.NetworkInstanceMetrics)
 	default:
 		protoEncodeGenericInstanceMetric(status, metric)
 	}
+	protoEncodeDnsInstanceMetric(status, metric)
 
 	return metric
 }
 
+func protoEncodeDnsInstanceMetric(status types.NetworkInstanceMetrics,
+	metric *zmet.ZMetricNetworkInstance) {
+
+	for _, m := range status.AppDnsMetrics {
+		appDns := &zmet.ZMetricAppDns{
+			AppID:   m.AppID.String(),
+			AppIP:   m.AppIP.String(),
+			Queries: m.Queries,
+			Blocked: m.Blocked,
+			Failed:  m.Failed,
+		}
+		metric.AppDnsStats = append(metric.AppDnsStats, appDns)
+	}
+	for _, q := range status.DnsQueries {
+		queryTime, _ := ptypes.TimestampProto(q.Time)
+		query := &zmet.ZMetricDnsQuery{
+			QueryTime: queryTime,
+			AppID:     q.AppID.String(),
+			ClientIP:  q.ClientIP.String(),
+			Name:      q.Name,
+			QType:     q.QType,
+			Answers:   q.Answers,
+			Blocked:   q.Blocked,
+		}
+		metric.DnsQueries = append(metric.DnsQueries, query)
+	}
+}
+
 func protoEncodeGenericInstanceMetric(status types.NetworkInstanceMetrics,
 	metric *zmet.ZMetricNetworkInstance) {
 	networkStats := new(zmet.ZMetricNetworkStats)
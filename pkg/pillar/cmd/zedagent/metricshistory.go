@@ -0,0 +1,275 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Metric history. The CPU and memory of the device and of each domain are
+// sampled every MetricSampleInterval and sent with the metrics as the
+// min, max and average over the MetricInterval. Metrics which could not be
+// sent are kept in /persist/metrics, up to maxBufferedMetrics, and are sent
+// oldest first in batches of ZMetricMsgBatch once a metrics message gets
+// through to the controller again.
+
+package zedagent
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+const (
+	metricsBufferDirname = persistPath + "/metrics"
+	maxBufferedMetrics   = 1440 // A day with the default MetricInterval
+	metricsBatchSize     = 60
+)
+
+// Min, max and average of the samples
+type aggregate struct {
+	min   float64
+	max   float64
+	sum   float64
+	count uint32
+}
+
+func (a *aggregate) add(value float64) {
+	if a.count == 0 || value < a.min {
+		a.min = value
+	}
+	if a.count == 0 || value > a.max {
+		a.max = value
+	}
+	a.sum += value
+	a.count++
+}
+
+// Returns nil if there are no samples
+func (a *aggregate) encode() *zmet.MetricAggregate {
+	if a == nil || a.count == 0 {
+		return nil
+	}
+	return &zmet.MetricAggregate{
+		Min:     a.min,
+		Max:     a.max,
+		Avg:     a.sum / float64(a.count),
+		Samples: a.count,
+	}
+}
+
+// The samples since the metrics were last published
+type metricSamples struct {
+	deviceCpu    aggregate
+	deviceMemory aggregate
+	domainCpu    map[string]*aggregate // By domain name
+	domainMemory map[string]*aggregate
+}
+
+// Used by the sample and metrics goroutines hence the lock
+type metricSampler struct {
+	lock    sync.Mutex
+	samples metricSamples
+}
+
+// Goroutine which samples until zedagent exits. Picks up changes to
+// MetricSampleInterval after the next sample.
+func metricsSampleTask(ctx *zedagentContext) {
+	log.Infoln("starting metrics sample task")
+	interval := time.Duration(globalConfig.MetricSampleInterval) * time.Second
+	ticker := time.NewTicker(interval)
+	for {
+		<-ticker.C
+		sampleMetrics(ctx)
+		newInterval := time.Duration(globalConfig.MetricSampleInterval) * time.Second
+		if newInterval != interval {
+			log.Infof("metricsSampleTask: interval change to %v\n",
+				newInterval)
+			ticker.Stop()
+			interval = newInterval
+			ticker = time.NewTicker(interval)
+		}
+	}
+}
+
+// The CPU is in percent of one CPU as reported by xentop
+func sampleMetrics(ctx *zedagentContext) {
+	cpuMemoryStat := ExecuteXentopCmd()
+	if cpuMemoryStat == nil {
+		return
+	}
+	var deviceCpu, deviceMemory float64
+	cpu := make(map[string]float64)
+	memory := make(map[string]float64)
+	for _, stat := range cpuMemoryStat {
+		if len(stat) <= 5 {
+			continue
+		}
+		domainName := strings.TrimSpace(stat[1])
+		if domainName == "" {
+			continue
+		}
+		cpuPercent, err := strconv.ParseFloat(stat[4], 64)
+		if err != nil {
+			continue
+		}
+		// This is in kbytes
+		mem, err := strconv.ParseUint(stat[5], 10, 64)
+		if err != nil {
+			continue
+		}
+		memoryMB := float64(RoundFromKbytesToMbytes(mem))
+		cpu[domainName] = cpuPercent
+		memory[domainName] = memoryMB
+		deviceCpu += cpuPercent
+		deviceMemory += memoryMB
+	}
+	if len(cpu) == 0 {
+		return
+	}
+	sampler := &ctx.metricSampler
+	sampler.lock.Lock()
+	defer sampler.lock.Unlock()
+	s := &sampler.samples
+	if s.domainCpu == nil {
+		s.domainCpu = make(map[string]*aggregate)
+		s.domainMemory = make(map[string]*aggregate)
+	}
+	s.deviceCpu.add(deviceCpu)
+	s.deviceMemory.add(deviceMemory)
+	for domainName, value := range cpu {
+		if _, ok := s.domainCpu[domainName]; !ok {
+			s.domainCpu[domainName] = new(aggregate)
+			s.domainMemory[domainName] = new(aggregate)
+		}
+		s.domainCpu[domainName].add(value)
+		s.domainMemory[domainName].add(memory[domainName])
+	}
+}
+
+// Returns the samples and starts over
+func takeSamples(ctx *zedagentContext) metricSamples {
+	sampler := &ctx.metricSampler
+	sampler.lock.Lock()
+	defer sampler.lock.Unlock()
+	samples := sampler.samples
+	sampler.samples = metricSamples{}
+	return samples
+}
+
+// Save metrics which could not be sent in dirname, normally
+// metricsBufferDirname. Drops the oldest beyond maxBufferedMetrics.
+func bufferMetrics(dirname string, metrics *zmet.ZMetricMsg) {
+	data, err := proto.Marshal(metrics)
+	if err != nil {
+		log.Errorf("bufferMetrics: marshal failed: %s\n", err)
+		return
+	}
+	if err := os.MkdirAll(dirname, 0700); err != nil {
+		log.Errorf("bufferMetrics: %s\n", err)
+		return
+	}
+	filename := fmt.Sprintf("%s/%d.pb", dirname, time.Now().UnixNano())
+	if err := ioutil.WriteFile(filename, data, 0600); err != nil {
+		log.Errorf("bufferMetrics: %s\n", err)
+		return
+	}
+	filenames := bufferedMetrics(dirname)
+	for len(filenames) > maxBufferedMetrics {
+		log.Warnf("bufferMetrics: dropping %s\n", filenames[0])
+		os.Remove(filenames[0])
+		filenames = filenames[1:]
+	}
+	log.Infof("bufferMetrics: %d buffered\n", len(filenames))
+}
+
+// Returns the files in dirname oldest first
+func bufferedMetrics(dirname string) []string {
+	files, err := ioutil.ReadDir(dirname)
+	if err != nil {
+		return nil
+	}
+	var filenames []string
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".pb") {
+			continue
+		}
+		filenames = append(filenames, dirname+"/"+file.Name())
+	}
+	return filenames
+}
+
+// Called once metrics could be sent. Sends the metrics buffered in dirname
+// in batches using send, normally sendMetricsBatch, until one fails.
+func sendBufferedMetrics(dirname string, iteration int,
+	send func(*zmet.ZMetricMsgBatch, int) bool) {
+
+	filenames := bufferedMetrics(dirname)
+	if len(filenames) == 0 {
+		return
+	}
+	log.Infof("sendBufferedMetrics: %d buffered\n", len(filenames))
+	for len(filenames) != 0 {
+		count := len(filenames)
+		if count > metricsBatchSize {
+			count = metricsBatchSize
+		}
+		batch := &zmet.ZMetricMsgBatch{DevID: zcdevUUID.String()}
+		for _, filename := range filenames[:count] {
+			metrics, err := readBufferedMetrics(filename)
+			if err != nil {
+				log.Errorf("sendBufferedMetrics: dropping %s: %s\n",
+					filename, err)
+				continue
+			}
+			batch.Metrics = append(batch.Metrics, metrics)
+		}
+		if len(batch.Metrics) != 0 &&
+			!send(batch, iteration) {
+			return
+		}
+		for _, filename := range filenames[:count] {
+			os.Remove(filename)
+		}
+		filenames = filenames[count:]
+	}
+	log.Infof("sendBufferedMetrics: done\n")
+}
+
+func readBufferedMetrics(filename string) (*zmet.ZMetricMsg, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	metrics := new(zmet.ZMetricMsg)
+	if err := proto.Unmarshal(data, metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func sendMetricsBatch(batch *zmet.ZMetricMsgBatch, iteration int) bool {
+	data, err := proto.Marshal(batch)
+	if err != nil {
+		log.Errorf("sendMetricsBatch marshal failed: %s\n", err)
+		return false
+	}
+	buf := bytes.NewBuffer(data)
+	size := int64(proto.Size(batch))
+	metricsBatchUrl := serverName + "/" + metricsBatchApi
+	const return400 = false
+	_, _, err = zedcloud.SendOnAllIntf(zedcloudCtx, metricsBatchUrl,
+		size, buf, iteration, return400)
+	if err != nil {
+		log.Errorf("sendMetricsBatch failed: %s\n", err)
+		return false
+	}
+	log.Infof("sendMetricsBatch sent %d\n", len(batch.Metrics))
+	return true
+}
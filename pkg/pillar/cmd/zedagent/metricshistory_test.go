@@ -0,0 +1,187 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+type TestAggregateMatrix struct {
+	samples  []float64
+	expected *zmet.MetricAggregate
+}
+
+func TestAggregate(t *testing.T) {
+	testMatrix := map[string]TestAggregateMatrix{
+		"No samples": {
+			samples:  nil,
+			expected: nil,
+		},
+		"One": {
+			samples:  []float64{42},
+			expected: &zmet.MetricAggregate{Min: 42, Max: 42, Avg: 42, Samples: 1},
+		},
+		"Several": {
+			samples:  []float64{10, 2.5, 30, 17.5},
+			expected: &zmet.MetricAggregate{Min: 2.5, Max: 30, Avg: 15, Samples: 4},
+		},
+		"Zero and negative": {
+			samples:  []float64{0, -4, 4},
+			expected: &zmet.MetricAggregate{Min: -4, Max: 4, Avg: 0, Samples: 3},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var a aggregate
+		for _, value := range test.samples {
+			a.add(value)
+		}
+		actual := a.encode()
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Test Failed: %s: Expected %+v, Actual: %+v\n",
+				testname, test.expected, actual)
+		}
+	}
+	var a *aggregate
+	if a.encode() != nil {
+		t.Errorf("Test Failed: nil aggregate encoded\n")
+	}
+}
+
+// Buffers count metrics with DevID set to their number
+func testBufferMetrics(t *testing.T, dirname string, count int) {
+	for i := 0; i < count; i++ {
+		bufferMetrics(dirname, &zmet.ZMetricMsg{DevID: fmt.Sprint(i)})
+	}
+	if n := len(bufferedMetrics(dirname)); n != count {
+		t.Fatalf("Expected %d buffered, Actual: %d", count, n)
+	}
+}
+
+func TestBufferMetricsDropsOldest(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirname)
+
+	// The names are UnixNano hence these are older than any new one
+	for i := 0; i < maxBufferedMetrics; i++ {
+		filename := fmt.Sprintf("%s/%d.pb", dirname, 1000000000000000000+i)
+		if err := ioutil.WriteFile(filename, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	oldest := bufferedMetrics(dirname)[0]
+	bufferMetrics(dirname, &zmet.ZMetricMsg{DevID: "newest"})
+
+	filenames := bufferedMetrics(dirname)
+	if len(filenames) != maxBufferedMetrics {
+		t.Errorf("Expected %d buffered, Actual: %d\n",
+			maxBufferedMetrics, len(filenames))
+	}
+	if _, err := os.Stat(oldest); err == nil {
+		t.Errorf("Oldest %s not dropped\n", oldest)
+	}
+	newest, err := readBufferedMetrics(filenames[len(filenames)-1])
+	if err != nil || newest.DevID != "newest" {
+		t.Errorf("Expected newest, Actual: %v %v\n", newest, err)
+	}
+}
+
+type TestSendBufferedMetricsMatrix struct {
+	buffered int
+	failAt   int // The batch which fails; zero if none
+	expected []int
+	left     int
+}
+
+func TestSendBufferedMetrics(t *testing.T) {
+	testMatrix := map[string]TestSendBufferedMetricsMatrix{
+		"All sent": {
+			buffered: metricsBatchSize + 5,
+			expected: []int{metricsBatchSize, 5},
+			left:     0,
+		},
+		"First batch fails": {
+			buffered: metricsBatchSize + 5,
+			failAt:   1,
+			expected: []int{metricsBatchSize},
+			left:     metricsBatchSize + 5,
+		},
+		"Second batch fails": {
+			buffered: 2*metricsBatchSize + 5,
+			failAt:   2,
+			expected: []int{metricsBatchSize, metricsBatchSize},
+			left:     metricsBatchSize + 5,
+		},
+		"Nothing buffered": {
+			buffered: 0,
+			expected: nil,
+			left:     0,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dirname, err := ioutil.TempDir("", "metrics")
+		if err != nil {
+			t.Fatal(err)
+		}
+		testBufferMetrics(t, dirname, test.buffered)
+		var sent []int
+		next := 0
+		send := func(batch *zmet.ZMetricMsgBatch, iteration int) bool {
+			sent = append(sent, len(batch.Metrics))
+			if len(sent) == test.failAt {
+				return false
+			}
+			// Oldest first
+			for _, metrics := range batch.Metrics {
+				if metrics.DevID != fmt.Sprint(next) {
+					t.Errorf("Test Failed: %s: Expected %d, Actual: %s\n",
+						testname, next, metrics.DevID)
+				}
+				next++
+			}
+			return true
+		}
+		sendBufferedMetrics(dirname, 0, send)
+		left := len(bufferedMetrics(dirname))
+		if !reflect.DeepEqual(sent, test.expected) || left != test.left {
+			t.Errorf("Test Failed: %s: Expected %v %d, Actual: %v %d\n",
+				testname, test.expected, test.left, sent, left)
+		}
+		os.RemoveAll(dirname)
+	}
+}
+
+func TestSendBufferedMetricsBadFile(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirname)
+
+	testBufferMetrics(t, dirname, 2)
+	bad := dirname + "/1000000000000000000.pb"
+	if err := ioutil.WriteFile(bad, []byte("not a protobuf"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var sent int
+	sendBufferedMetrics(dirname, 0,
+		func(batch *zmet.ZMetricMsgBatch, iteration int) bool {
+			sent += len(batch.Metrics)
+			return true
+		})
+	if sent != 2 || len(bufferedMetrics(dirname)) != 0 {
+		t.Errorf("Expected 2 sent and none left, Actual: %d %v\n",
+			sent, bufferedMetrics(dirname))
+	}
+}
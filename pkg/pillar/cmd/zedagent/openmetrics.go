@@ -0,0 +1,502 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Local OpenMetrics endpoint. The metrics which are sent to the controller
+// are also served on http://<address>:<metrics.local.port>/metrics for
+// on-prem monitoring such as Prometheus. What is served is the last
+// ZMetricMsg hence it is updated every MetricInterval. The metric names
+// and labels are:
+//	eve_device_*: dom0 CPU and memory, the ports, disks and filesystems
+//	eve_zedcloud_*: per port and per URL counters of the controller traffic
+//	eve_app_*: per app instance with app_id and app_name labels
+//	eve_network_instance_*: per network instance with network_id and
+//	network_name labels
+
+package zedagent
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+const (
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	mbyte                  = 1024 * 1024
+)
+
+// Called when the GlobalConfig changes and before the metrics are
+// published, since the address of a network instance or port can change.
+// Restarts the server if what it listens on has changed.
+func updateMetricsExport(ctx *zedagentContext) {
+	port := globalConfig.MetricsExportPort
+	listen := globalConfig.MetricsExportListen
+	allow, _ := types.ParseMetricsExportAllow(globalConfig.MetricsExportAllow)
+
+	addr := ""
+	if port != 0 {
+		ipAddr, err := metricsExportAddr(ctx, listen)
+		if err != nil {
+			log.Errorf("updateMetricsExport: %s\n", err)
+		} else {
+			addr = net.JoinHostPort(ipAddr, strconv.Itoa(int(port)))
+		}
+	}
+	ctx.metricsExportLock.Lock()
+	defer ctx.metricsExportLock.Unlock()
+	ctx.metricsExportAllow = allow
+	if addr == ctx.metricsExportAddr {
+		return
+	}
+	if ctx.metricsExportServer != nil {
+		log.Infof("updateMetricsExport: stopping on %s\n",
+			ctx.metricsExportAddr)
+		ctx.metricsExportServer.Close()
+		ctx.metricsExportServer = nil
+		ctx.metricsExportAddr = ""
+	}
+	if addr == "" {
+		return
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Errorf("updateMetricsExport: listen on %s failed: %s\n",
+			addr, err)
+		return
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		serveMetrics(ctx, w, r)
+	})
+	server := &http.Server{
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+	ctx.metricsExportServer = server
+	ctx.metricsExportAddr = addr
+	log.Infof("updateMetricsExport: serving on %s\n", addr)
+	go func() {
+		err := server.Serve(listener)
+		log.Infof("metrics export on %s done: %s\n", addr, err)
+	}()
+}
+
+// The address of the network instance with the name or UUID, or of the
+// port with the name. Empty for all addresses.
+func metricsExportAddr(ctx *zedagentContext, listen string) (string, error) {
+	if listen == "" {
+		return "", nil
+	}
+	for _, st := range ctx.subNetworkInstanceStatus.GetAll() {
+		status := cast.CastNetworkInstanceStatus(st)
+		if status.Key() != listen && status.DisplayName != listen {
+			continue
+		}
+		if status.BridgeIPAddr == "" {
+			errStr := fmt.Sprintf("network instance %s has no address",
+				listen)
+			return "", errors.New(errStr)
+		}
+		return status.BridgeIPAddr, nil
+	}
+	if types.GetPort(*deviceNetworkStatus, listen) == nil {
+		errStr := fmt.Sprintf("no network instance or port %s", listen)
+		return "", errors.New(errStr)
+	}
+	ip, err := types.GetLocalAddrAnyNoLinkLocal(*deviceNetworkStatus, 0,
+		listen)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
+
+// Called by PublishMetricsToZedCloud
+func setLatestMetrics(ctx *zedagentContext, metrics *zmet.ZMetricMsg) {
+	ctx.metricsExportLock.Lock()
+	ctx.latestMetrics = metrics
+	ctx.metricsExportLock.Unlock()
+}
+
+func serveMetrics(ctx *zedagentContext, w http.ResponseWriter,
+	r *http.Request) {
+
+	ctx.metricsExportLock.Lock()
+	allow := ctx.metricsExportAllow
+	metrics := ctx.latestMetrics
+	ctx.metricsExportLock.Unlock()
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	ip := net.ParseIP(host)
+	if err != nil || ip == nil || !clientAllowed(ip, allow) {
+		log.Warnf("serveMetrics: denied %s\n", r.RemoteAddr)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var buf bytes.Buffer
+	ms := newMetricSet()
+	if metrics != nil {
+		encodeOpenMetrics(ms, metrics)
+	}
+	ms.write(&buf)
+	w.Header().Set("Content-Type", openMetricsContentType)
+	w.Write(buf.Bytes())
+}
+
+// Without an allow list only localhost
+func clientAllowed(ip net.IP, allow []*net.IPNet) bool {
+	if len(allow) == 0 {
+		return ip.IsLoopback()
+	}
+	for _, ipNet := range allow {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// A metric family with its samples. OpenMetrics needs the samples of a
+// family together hence we collect them before writing.
+type metricFamily struct {
+	name    string
+	typ     string // "gauge" or "counter"
+	help    string
+	samples []metricSample
+}
+
+type metricSample struct {
+	labels []string // Name and value pairs
+	value  float64
+}
+
+type metricSet struct {
+	families []*metricFamily
+	byName   map[string]*metricFamily
+}
+
+func newMetricSet() *metricSet {
+	return &metricSet{byName: make(map[string]*metricFamily)}
+}
+
+func (ms *metricSet) add(name string, typ string, help string,
+	value float64, labels ...string) {
+
+	f, ok := ms.byName[name]
+	if !ok {
+		f = &metricFamily{name: name, typ: typ, help: help}
+		ms.byName[name] = f
+		ms.families = append(ms.families, f)
+	}
+	f.samples = append(f.samples, metricSample{labels: labels, value: value})
+}
+
+func (ms *metricSet) gauge(name string, help string, value float64,
+	labels ...string) {
+	ms.add(name, "gauge", help, value, labels...)
+}
+
+// The sample name gets the _total suffix
+func (ms *metricSet) counter(name string, help string, value float64,
+	labels ...string) {
+	ms.add(name, "counter", help, value, labels...)
+}
+
+func (ms *metricSet) write(buf *bytes.Buffer) {
+	for _, f := range ms.families {
+		fmt.Fprintf(buf, "# TYPE %s %s\n", f.name, f.typ)
+		fmt.Fprintf(buf, "# HELP %s %s\n", f.name, f.help)
+		sampleName := f.name
+		if f.typ == "counter" {
+			sampleName += "_total"
+		}
+		for _, s := range f.samples {
+			buf.WriteString(sampleName)
+			if len(s.labels) != 0 {
+				buf.WriteString("{")
+				for i := 0; i+1 < len(s.labels); i += 2 {
+					if i != 0 {
+						buf.WriteString(",")
+					}
+					fmt.Fprintf(buf, "%s=\"%s\"", s.labels[i],
+						escapeLabelValue(s.labels[i+1]))
+				}
+				buf.WriteString("}")
+			}
+			fmt.Fprintf(buf, " %s\n",
+				strconv.FormatFloat(s.value, 'f', -1, 64))
+		}
+	}
+	buf.WriteString("# EOF\n")
+}
+
+func escapeLabelValue(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	return strings.Replace(value, "\n", `\n`, -1)
+}
+
+func encodeOpenMetrics(ms *metricSet, metrics *zmet.ZMetricMsg) {
+	if dm := metrics.GetDm(); dm != nil {
+		encodeDeviceOpenMetrics(ms, dm)
+	}
+	for _, am := range metrics.Am {
+		encodeAppOpenMetrics(ms, am)
+	}
+	for _, nm := range metrics.Nm {
+		encodeNetworkInstanceOpenMetrics(ms, nm)
+	}
+	if t, err := ptypes.Timestamp(metrics.AtTimeStamp); err == nil {
+		ms.gauge("eve_metrics_timestamp_seconds",
+			"When the metrics were collected", float64(t.Unix()))
+	}
+}
+
+func encodeDeviceOpenMetrics(ms *metricSet, dm *zmet.DeviceMetric) {
+	if cpu := dm.CpuMetric; cpu != nil {
+		if cpu.UpTime != nil {
+			ms.gauge("eve_device_uptime_seconds",
+				"Time since the device booted",
+				float64(cpu.UpTime.Seconds))
+		}
+		ms.counter("eve_device_cpu_seconds",
+			"CPU time used by dom0", float64(cpu.Total))
+	}
+	if mem := dm.Memory; mem != nil {
+		ms.gauge("eve_device_memory_used_bytes",
+			"Memory used on the device", float64(mem.UsedMem)*mbyte)
+		ms.gauge("eve_device_memory_available_bytes",
+			"Memory available on the device", float64(mem.AvailMem)*mbyte)
+	}
+	if mem := dm.SystemServicesMemoryMB; mem != nil {
+		ms.gauge("eve_device_system_memory_used_bytes",
+			"Memory used by dom0", float64(mem.UsedMem)*mbyte)
+		ms.gauge("eve_device_system_memory_available_bytes",
+			"Memory available to dom0", float64(mem.AvailMem)*mbyte)
+	}
+	ms.gauge("eve_device_runtime_storage_overhead_bytes",
+		"Space used in /persist by EVE",
+		float64(dm.RuntimeStorageOverheadMB)*mbyte)
+	ms.gauge("eve_device_app_runtime_storage_bytes",
+		"Space used in /persist by app instances",
+		float64(dm.AppRunTimeStorageMB)*mbyte)
+	for _, nm := range dm.Network {
+		encodeNetworkOpenMetrics(ms, "eve_device_network", nm,
+			"port", nm.IName, "ifname", nm.LocalName)
+	}
+	for _, d := range dm.Disk {
+		if d.MountPath != "" {
+			ms.gauge("eve_device_filesystem_size_bytes",
+				"Size of the filesystem",
+				float64(d.Total)*mbyte, "path", d.MountPath)
+			ms.gauge("eve_device_filesystem_used_bytes",
+				"Space used in the filesystem",
+				float64(d.Used)*mbyte, "path", d.MountPath)
+			ms.gauge("eve_device_filesystem_free_bytes",
+				"Space free in the filesystem",
+				float64(d.Free)*mbyte, "path", d.MountPath)
+			continue
+		}
+		ms.gauge("eve_device_disk_size_bytes",
+			"Size of the disk, partition or downloaded image",
+			float64(d.Total)*mbyte, "disk", d.Disk)
+		if d.ReadCount == 0 && d.WriteCount == 0 {
+			continue
+		}
+		ms.counter("eve_device_disk_read_bytes",
+			"Bytes read from the disk",
+			float64(d.ReadBytes)*mbyte, "disk", d.Disk)
+		ms.counter("eve_device_disk_written_bytes",
+			"Bytes written to the disk",
+			float64(d.WriteBytes)*mbyte, "disk", d.Disk)
+		ms.counter("eve_device_disk_reads",
+			"Reads from the disk", float64(d.ReadCount), "disk", d.Disk)
+		ms.counter("eve_device_disk_writes",
+			"Writes to the disk", float64(d.WriteCount), "disk", d.Disk)
+	}
+	for _, c := range dm.Cellular {
+		labels := []string{"port", c.IName, "ifname", c.IfName}
+		ms.gauge("eve_device_cellular_rssi_dbm",
+			"Received signal strength", float64(c.Rssi), labels...)
+		ms.gauge("eve_device_cellular_rsrq_db",
+			"Reference signal received quality",
+			float64(c.Rsrq), labels...)
+		ms.gauge("eve_device_cellular_rsrp_dbm",
+			"Reference signal received power",
+			float64(c.Rsrp), labels...)
+	}
+	for _, zm := range dm.Zedcloud {
+		encodeZedcloudOpenMetrics(ms, zm)
+	}
+}
+
+func encodeZedcloudOpenMetrics(ms *metricSet, zm *zmet.ZedcloudMetric) {
+	ms.counter("eve_zedcloud_failures",
+		"Failed requests to the controller", float64(zm.Failures),
+		"ifname", zm.IfName)
+	ms.counter("eve_zedcloud_successes",
+		"Successful requests to the controller", float64(zm.Success),
+		"ifname", zm.IfName)
+	if t, err := ptypes.Timestamp(zm.LastFailure); err == nil {
+		ms.gauge("eve_zedcloud_last_failure_timestamp_seconds",
+			"When a request last failed", float64(t.Unix()),
+			"ifname", zm.IfName)
+	}
+	if t, err := ptypes.Timestamp(zm.LastSuccess); err == nil {
+		ms.gauge("eve_zedcloud_last_success_timestamp_seconds",
+			"When a request last succeeded", float64(t.Unix()),
+			"ifname", zm.IfName)
+	}
+	for _, um := range zm.UrlMetrics {
+		labels := []string{"ifname", zm.IfName, "url", um.Url}
+		ms.counter("eve_zedcloud_url_tried_messages",
+			"Messages tried to send", float64(um.TryMsgCount), labels...)
+		ms.counter("eve_zedcloud_url_tried_bytes",
+			"Bytes tried to send", float64(um.TryByteCount), labels...)
+		ms.counter("eve_zedcloud_url_sent_messages",
+			"Messages sent", float64(um.SentMsgCount), labels...)
+		ms.counter("eve_zedcloud_url_sent_bytes",
+			"Bytes sent", float64(um.SentByteCount), labels...)
+		ms.counter("eve_zedcloud_url_received_messages",
+			"Messages received", float64(um.RecvMsgCount), labels...)
+		ms.counter("eve_zedcloud_url_received_bytes",
+			"Bytes received", float64(um.RecvByteCount), labels...)
+	}
+}
+
+// For the ports and the interfaces of the app instances
+func encodeNetworkOpenMetrics(ms *metricSet, prefix string,
+	nm *zmet.NetworkMetric, labels ...string) {
+
+	ms.counter(prefix+"_transmit_bytes", "Bytes transmitted",
+		float64(nm.TxBytes), labels...)
+	ms.counter(prefix+"_receive_bytes", "Bytes received",
+		float64(nm.RxBytes), labels...)
+	ms.counter(prefix+"_transmit_packets", "Packets transmitted",
+		float64(nm.TxPkts), labels...)
+	ms.counter(prefix+"_receive_packets", "Packets received",
+		float64(nm.RxPkts), labels...)
+	ms.counter(prefix+"_transmit_drops", "Transmitted packets dropped",
+		float64(nm.TxDrops), labels...)
+	ms.counter(prefix+"_receive_drops", "Received packets dropped",
+		float64(nm.RxDrops), labels...)
+	ms.counter(prefix+"_transmit_errors", "Transmit errors",
+		float64(nm.TxErrors), labels...)
+	ms.counter(prefix+"_receive_errors", "Receive errors",
+		float64(nm.RxErrors), labels...)
+	ms.counter(prefix+"_transmit_acl_drops",
+		"Transmitted packets dropped by ACLs",
+		float64(nm.TxAclDrops), labels...)
+	ms.counter(prefix+"_receive_acl_drops",
+		"Received packets dropped by ACLs",
+		float64(nm.RxAclDrops), labels...)
+}
+
+func encodeAppOpenMetrics(ms *metricSet, am *zmet.AppMetric) {
+	app := []string{"app_id", am.AppID, "app_name", am.AppName}
+	if cpu := am.Cpu; cpu != nil {
+		if cpu.UpTime != nil {
+			ms.gauge("eve_app_uptime_seconds",
+				"Time since the app instance booted",
+				float64(cpu.UpTime.Seconds), app...)
+		}
+		ms.counter("eve_app_cpu_seconds",
+			"CPU time used by the app instance",
+			float64(cpu.Total), app...)
+	}
+	if mem := am.Memory; mem != nil {
+		ms.gauge("eve_app_memory_used_bytes",
+			"Memory used by the app instance",
+			float64(mem.UsedMem)*mbyte, app...)
+		ms.gauge("eve_app_memory_available_bytes",
+			"Memory available to the app instance",
+			float64(mem.AvailMem)*mbyte, app...)
+	}
+	if b := am.Balloon; b != nil {
+		ms.gauge("eve_app_memory_target_bytes",
+			"Balloon target of the app instance",
+			float64(b.TargetMem)*mbyte, app...)
+		ms.gauge("eve_app_memory_max_bytes",
+			"Maximum memory of the app instance",
+			float64(b.MaxMem)*mbyte, app...)
+		ms.gauge("eve_app_vcpus", "Online vCPUs of the app instance",
+			float64(b.Vcpus), app...)
+		ms.gauge("eve_app_vcpus_max",
+			"Maximum vCPUs of the app instance",
+			float64(b.MaxVcpus), app...)
+	}
+	for _, nm := range am.Network {
+		labels := append([]string{}, app...)
+		labels = append(labels, "interface", nm.IName,
+			"ifname", nm.LocalName)
+		encodeNetworkOpenMetrics(ms, "eve_app_network", nm, labels...)
+	}
+	for _, d := range am.Disk {
+		labels := append([]string{}, app...)
+		labels = append(labels, "disk", d.Disk)
+		ms.gauge("eve_app_disk_provisioned_bytes",
+			"Virtual size of the disk", float64(d.Provisioned)*mbyte,
+			labels...)
+		ms.gauge("eve_app_disk_used_bytes",
+			"Space used by the disk", float64(d.Used)*mbyte,
+			labels...)
+	}
+}
+
+func encodeNetworkInstanceOpenMetrics(ms *metricSet,
+	nm *zmet.ZMetricNetworkInstance) {
+
+	ni := []string{"network_id", nm.NetworkID,
+		"network_name", nm.Displayname}
+	if stats := nm.NetworkStats; stats != nil {
+		if rx := stats.Rx; rx != nil {
+			ms.counter("eve_network_instance_receive_bytes",
+				"Bytes received", float64(rx.TotalBytes), ni...)
+			ms.counter("eve_network_instance_receive_packets",
+				"Packets received", float64(rx.TotalPackets), ni...)
+			ms.counter("eve_network_instance_receive_drops",
+				"Received packets dropped", float64(rx.Drops), ni...)
+			ms.counter("eve_network_instance_receive_errors",
+				"Receive errors", float64(rx.Errors), ni...)
+		}
+		if tx := stats.Tx; tx != nil {
+			ms.counter("eve_network_instance_transmit_bytes",
+				"Bytes transmitted", float64(tx.TotalBytes), ni...)
+			ms.counter("eve_network_instance_transmit_packets",
+				"Packets transmitted", float64(tx.TotalPackets), ni...)
+			ms.counter("eve_network_instance_transmit_drops",
+				"Transmitted packets dropped", float64(tx.Drops), ni...)
+			ms.counter("eve_network_instance_transmit_errors",
+				"Transmit errors", float64(tx.Errors), ni...)
+		}
+	}
+	for _, dns := range nm.AppDnsStats {
+		labels := append([]string{}, ni...)
+		labels = append(labels, "app_id", dns.AppID, "app_ip", dns.AppIP)
+		ms.counter("eve_network_instance_dns_queries",
+			"DNS queries from the app instance",
+			float64(dns.Queries), labels...)
+		ms.counter("eve_network_instance_dns_blocked",
+			"DNS queries of the app instance which were blocked",
+			float64(dns.Blocked), labels...)
+		ms.counter("eve_network_instance_dns_failed",
+			"DNS queries of the app instance which failed",
+			float64(dns.Failed), labels...)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+func TestMetricSetWrite(t *testing.T) {
+	ms := newMetricSet()
+	ms.gauge("eve_a", "A gauge", 1.5, "name", "x")
+	ms.counter("eve_b", "A counter", 42)
+	// Samples of a family stay together even if added later
+	ms.gauge("eve_a", "A gauge", 2, "name", "y\"\\\nz")
+	var buf bytes.Buffer
+	ms.write(&buf)
+	expected := `# TYPE eve_a gauge
+# HELP eve_a A gauge
+eve_a{name="x"} 1.5
+eve_a{name="y\"\\\nz"} 2
+# TYPE eve_b counter
+# HELP eve_b A counter
+eve_b_total 42
+# EOF
+`
+	if buf.String() != expected {
+		t.Errorf("Expected %q, Actual: %q\n", expected, buf.String())
+	}
+}
+
+func TestEncodeOpenMetrics(t *testing.T) {
+	metrics := &zmet.ZMetricMsg{
+		AtTimeStamp: &timestamp.Timestamp{Seconds: 1500000000},
+		MetricContent: &zmet.ZMetricMsg_Dm{Dm: &zmet.DeviceMetric{
+			Memory: &zmet.MemoryMetric{UsedMem: 2, AvailMem: 6},
+		}},
+		Am: []*zmet.AppMetric{{
+			AppID:   "6e4c0a10-5d3a-4a5b-9b3c-1a2b3c4d5e6f",
+			AppName: "app1",
+			Cpu:     &zmet.AppCpuMetric{Total: 7},
+		}},
+	}
+	ms := newMetricSet()
+	encodeOpenMetrics(ms, metrics)
+	var buf bytes.Buffer
+	ms.write(&buf)
+	out := buf.String()
+	for _, line := range []string{
+		"eve_device_memory_used_bytes 2097152\n",
+		"eve_device_memory_available_bytes 6291456\n",
+		"# TYPE eve_app_cpu_seconds counter\n",
+		`eve_app_cpu_seconds_total{app_id="6e4c0a10-5d3a-4a5b-9b3c-1a2b3c4d5e6f",app_name="app1"} 7` + "\n",
+		"eve_metrics_timestamp_seconds 1500000000\n",
+	} {
+		if !bytes.Contains([]byte(out), []byte(line)) {
+			t.Errorf("Missing %q in %s", line, out)
+		}
+	}
+}
+
+type TestServeMetricsMatrix struct {
+	allow      string
+	remoteAddr string
+	method     string
+	expected   int
+}
+
+func TestServeMetrics(t *testing.T) {
+	testMatrix := map[string]TestServeMetricsMatrix{
+		"Localhost without allow list": {
+			remoteAddr: "127.0.0.1:4000",
+			method:     http.MethodGet,
+			expected:   http.StatusOK,
+		},
+		"Remote without allow list": {
+			remoteAddr: "10.1.0.5:4000",
+			method:     http.MethodGet,
+			expected:   http.StatusForbidden,
+		},
+		"Remote in allow list": {
+			allow:      "10.1.0.0/24",
+			remoteAddr: "10.1.0.5:4000",
+			method:     http.MethodGet,
+			expected:   http.StatusOK,
+		},
+		"Remote outside allow list": {
+			allow:      "10.1.0.0/24,192.168.1.1",
+			remoteAddr: "10.2.0.5:4000",
+			method:     http.MethodGet,
+			expected:   http.StatusForbidden,
+		},
+		"Post": {
+			allow:      "10.1.0.5",
+			remoteAddr: "10.1.0.5:4000",
+			method:     http.MethodPost,
+			expected:   http.StatusMethodNotAllowed,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		allow, err := types.ParseMetricsExportAllow(test.allow)
+		if err != nil {
+			t.Fatalf("ParseMetricsExportAllow: %s", err)
+		}
+		ctx := &zedagentContext{metricsExportAllow: allow}
+		r := httptest.NewRequest(test.method, "/metrics", nil)
+		r.RemoteAddr = test.remoteAddr
+		w := httptest.NewRecorder()
+		serveMetrics(ctx, w, r)
+		if w.Code != test.expected {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, w.Code)
+		}
+		if w.Code == http.StatusOK && w.Body.String() != "# EOF\n" {
+			t.Errorf("Test Failed: %s: unexpected body %q", testname,
+				w.Body.String())
+		}
+	}
+}
+
+func TestParseMetricsExportAllow(t *testing.T) {
+	allow, err := types.ParseMetricsExportAllow(" 10.1.0.0/24, 10.2.0.5 ,fd00::1")
+	if err != nil {
+		t.Fatalf("ParseMetricsExportAllow: %s", err)
+	}
+	expected := []string{"10.1.0.0/24", "10.2.0.5/32", "fd00::1/128"}
+	if len(allow) != len(expected) {
+		t.Fatalf("Expected %v, Actual: %v\n", expected, allow)
+	}
+	for i, ipNet := range allow {
+		if ipNet.String() != expected[i] {
+			t.Errorf("Expected %s, Actual: %s\n", expected[i], ipNet)
+		}
+	}
+	if !clientAllowed(net.ParseIP("10.2.0.5"), allow) ||
+		clientAllowed(net.ParseIP("10.2.0.6"), allow) {
+		t.Errorf("clientAllowed does not match the allow list")
+	}
+	if _, err := types.ParseMetricsExportAllow("10.1.0.0/24,host"); err == nil {
+		t.Errorf("ParseMetricsExportAllow accepted a host name")
+	}
+}
@@ -6,7 +6,9 @@ package zedagent
 import (
 	"bytes"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"hash"
@@ -20,13 +22,14 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/agentlog"
 	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/devicenetwork"
 	"github.com/zededa/eve/pkg/pillar/pubsub"
-	"github.com/zededa/eve/pkg/pillar/ssh"
 	"github.com/zededa/eve/pkg/pillar/types"
 	"github.com/zededa/eve/pkg/pillar/zboot"
 	"github.com/zededa/eve/sdk/go/zconfig"
@@ -86,6 +89,8 @@ func parseConfig(config *zconfig.EdgeDevConfig, getconfigCtx *getconfigContext,
 
 	parseNetworkInstanceConfig(config, getconfigCtx)
 	parseAppInstanceConfig(config, getconfigCtx)
+	parseRemoteSessionConfig(config, getconfigCtx)
+	parseSshCAConfig(config, getconfigCtx)
 
 	return false
 }
@@ -309,6 +314,156 @@ func parseDnsNameToIpList(
 	config.DnsNameToIPList = nameToIPs
 }
 
+func parseDnsPolicy(
+	apiConfigEntry *zconfig.NetworkInstanceConfig,
+	config *types.NetworkInstanceConfig) {
+
+	policy := apiConfigEntry.GetDnsPolicy()
+	if policy == nil {
+		return
+	}
+	config.DnsPolicy = types.DnsPolicy{
+		LogQueries: policy.LogQueries,
+	}
+	for _, domain := range policy.BlockedDomains {
+		domain = strings.Trim(strings.TrimSpace(domain), ".")
+		if domain == "" {
+			log.Errorf("Empty blocked domain in %s ignored\n",
+				config.DisplayName)
+			continue
+		}
+		config.DnsPolicy.BlockedDomains = append(
+			config.DnsPolicy.BlockedDomains, domain)
+	}
+	for _, domain := range policy.AllowedDomains {
+		domain = strings.Trim(strings.TrimSpace(domain), ".")
+		if domain == "" {
+			log.Errorf("Empty allowed domain in %s ignored\n",
+				config.DisplayName)
+			continue
+		}
+		config.DnsPolicy.AllowedDomains = append(
+			config.DnsPolicy.AllowedDomains, domain)
+	}
+}
+
+// parseIPv6Config uses the subnet and gateway from ip6 if set. Otherwise
+// zedrouter derives them from the IPv6Mode.
+func parseIPv6Config(
+	apiConfigEntry *zconfig.NetworkInstanceConfig,
+	config *types.NetworkInstanceConfig) error {
+
+	config.IPv6Mode = types.IPv6Mode(apiConfigEntry.Ip6Mode)
+	switch config.IPv6Mode {
+	case types.IPv6ModeNone:
+		return nil
+	case types.IPv6ModeNAT66, types.IPv6ModeRouted:
+		// Do nothing
+	default:
+		mode := config.IPv6Mode
+		config.IPv6Mode = types.IPv6ModeNone
+		return errors.New(fmt.Sprintf("parseIPv6Config: unknown IPv6Mode %d",
+			mode))
+	}
+	ipspec := apiConfigEntry.GetIp6()
+	if ipspec == nil {
+		return nil
+	}
+	if s := ipspec.GetSubnet(); s != "" {
+		_, subnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return errors.New(fmt.Sprintf("parseIPv6Config: bad subnet %s: %s",
+				s, err))
+		}
+		if ones, bits := subnet.Mask.Size(); ones != 64 || bits != 128 {
+			return errors.New(fmt.Sprintf("parseIPv6Config: subnet %s is not an IPv6 /64",
+				s))
+		}
+		config.Subnet6 = *subnet
+	}
+	if g := ipspec.GetGateway(); g != "" {
+		config.Gateway6 = net.ParseIP(g)
+		if config.Gateway6 == nil || config.Gateway6.To4() != nil {
+			config.Gateway6 = nil
+			return errors.New(fmt.Sprintf("parseIPv6Config: bad gateway IP %s",
+				g))
+		}
+	}
+	return nil
+}
+
+// parseVlanTrunk ignores bad and duplicate VLAN IDs
+func parseVlanTrunk(key string, vids []uint32) []uint16 {
+
+	var vlanTrunk []uint16
+	seen := make(map[uint32]bool)
+	for _, vid := range vids {
+		if vid == 0 || vid > 4094 {
+			log.Errorf("parseVlanTrunk: %s bad VLAN ID %d ignored\n",
+				key, vid)
+			continue
+		}
+		if seen[vid] {
+			continue
+		}
+		seen[vid] = true
+		vlanTrunk = append(vlanTrunk, uint16(vid))
+	}
+	return vlanTrunk
+}
+
+// parseUplinkPolicy fills in the defaults for the probing and ignores
+// zero weights
+func parseUplinkPolicy(apiConfigEntry *zconfig.NetworkInstanceConfig,
+	config *types.NetworkInstanceConfig) error {
+
+	config.UplinkPolicy = types.UplinkPolicy{
+		ProbeInterval: 15,
+		ProbeFailures: 3,
+	}
+	policy := apiConfigEntry.GetUplinkPolicy()
+	if policy == nil {
+		return nil
+	}
+	config.UplinkPolicy.Mode = types.UplinkMode(policy.Mode)
+	switch config.UplinkPolicy.Mode {
+	case types.UplinkModeFailover, types.UplinkModeWeighted,
+		types.UplinkModeFlowHash:
+		// Do nothing
+	default:
+		mode := config.UplinkPolicy.Mode
+		config.UplinkPolicy.Mode = types.UplinkModeFailover
+		return errors.New(fmt.Sprintf("parseUplinkPolicy: unknown mode %d",
+			mode))
+	}
+	for _, w := range policy.GetWeights() {
+		if w.Port == "" || w.Weight == 0 ||
+			w.Weight > types.MaxUplinkWeight {
+			log.Errorf("parseUplinkPolicy: %s bad weight %d for %s ignored\n",
+				config.Key(), w.Weight, w.Port)
+			continue
+		}
+		if config.UplinkPolicy.Weights == nil {
+			config.UplinkPolicy.Weights = make(map[string]uint32)
+		}
+		config.UplinkPolicy.Weights[w.Port] = w.Weight
+	}
+	if policy.ProbeInterval != 0 {
+		config.UplinkPolicy.ProbeInterval = policy.ProbeInterval
+	}
+	if policy.ProbeFailures != 0 {
+		config.UplinkPolicy.ProbeFailures = policy.ProbeFailures
+	}
+	if a := policy.GetProbeAddr(); a != "" {
+		config.UplinkPolicy.ProbeAddr = net.ParseIP(a)
+		if config.UplinkPolicy.ProbeAddr == nil {
+			return errors.New(fmt.Sprintf("parseUplinkPolicy: bad probeAddr %s",
+				a))
+		}
+	}
+	return nil
+}
+
 func publishNetworkInstanceConfig(ctx *getconfigContext,
 	networkInstances []*zconfig.NetworkInstanceConfig) {
 
@@ -377,6 +532,9 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 					types.AddressTypeNone)
 				networkInstanceConfig.IpType = types.AddressTypeNone
 			}
+			networkInstanceConfig.VlanTrunk = parseVlanTrunk(
+				networkInstanceConfig.Key(),
+				apiConfigEntry.GetVlanTrunk())
 			ctx.pubNetworkInstanceConfig.Publish(networkInstanceConfig.UUID.String(),
 				&networkInstanceConfig)
 
@@ -428,6 +586,26 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 
 			parseDnsNameToIpList(apiConfigEntry,
 				&networkInstanceConfig)
+
+			parseDnsPolicy(apiConfigEntry,
+				&networkInstanceConfig)
+
+			if networkInstanceConfig.Type == types.NetworkInstanceTypeLocal {
+				err := parseIPv6Config(apiConfigEntry,
+					&networkInstanceConfig)
+				if err != nil {
+					log.Errorf("Network instance %s %s: %s\n",
+						networkInstanceConfig.UUID.String(),
+						networkInstanceConfig.DisplayName, err)
+				}
+				err = parseUplinkPolicy(apiConfigEntry,
+					&networkInstanceConfig)
+				if err != nil {
+					log.Errorf("Network instance %s %s: %s\n",
+						networkInstanceConfig.UUID.String(),
+						networkInstanceConfig.DisplayName, err)
+				}
+			}
 		}
 
 		ctx.pubNetworkInstanceConfig.Publish(networkInstanceConfig.UUID.String(),
@@ -550,6 +728,7 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.FixedResources.Memory = int(cfgApp.Fixedresources.Memory)
 		appInstance.FixedResources.RootDev = cfgApp.Fixedresources.Rootdev
 		appInstance.FixedResources.VCpus = int(cfgApp.Fixedresources.Vcpus)
+		appInstance.FixedResources.MaxCpus = int(cfgApp.Fixedresources.Maxcpus)
 		appInstance.FixedResources.VirtualizationMode = types.VmMode(cfgApp.Fixedresources.VirtualizationMode)
 		appInstance.FixedResources.EnableVnc = cfgApp.Fixedresources.EnableVnc
 		appInstance.FixedResources.VncDisplay = cfgApp.Fixedresources.VncDisplay
@@ -566,14 +745,22 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 
 		// I/O adapters
 		appInstance.IoAdapterList = nil
+		appInstance.UsbDeviceList = nil
 		for _, adapter := range cfgApp.Adapters {
 			log.Debugf("Processing adapter type %d name %s\n",
 				adapter.Type, adapter.Name)
+			if adapter.Type == zconfig.ZCioType_ZCioUSBDevice {
+				parseUsbDevice(&appInstance, adapter)
+				continue
+			}
 			appInstance.IoAdapterList = append(appInstance.IoAdapterList,
 				types.IoAdapter{Type: types.IoType(adapter.Type),
 					Name: adapter.Name})
 		}
 		log.Infof("Got adapters %v\n", appInstance.IoAdapterList)
+		if len(appInstance.UsbDeviceList) != 0 {
+			log.Infof("Got USB devices %v\n", appInstance.UsbDeviceList)
+		}
 
 		cmd := cfgApp.GetRestart()
 		if cmd != nil {
@@ -593,6 +780,9 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 
 		appInstance.CloudInitUserData = userData
 		appInstance.RemoteConsole = cfgApp.GetRemoteConsole()
+		parseRestartPolicy(&appInstance, cfgApp)
+		parseMetaData(&appInstance, cfgApp)
+		parseDependencies(&appInstance, cfgApp)
 		// get the certs for image sha verification
 		certInstance := getCertObjects(appInstance.UUIDandVersion,
 			appInstance.ConfigSha256, appInstance.StorageConfigList)
@@ -607,6 +797,155 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 	}
 }
 
+// A USB device is matched on any combination of vendor and product ID,
+// serial and port path; at least one has to be set.
+func parseUsbDevice(appInstance *types.AppInstanceConfig,
+	adapter *zconfig.Adapter) {
+
+	usbDevice := adapter.GetUsbDevice()
+	match := types.UsbDeviceMatch{Name: adapter.Name}
+	if usbDevice != nil {
+		match.VendorID = usbDevice.VendorId
+		match.ProductID = usbDevice.ProductId
+		match.Serial = usbDevice.Serial
+		match.PortPath = usbDevice.PortPath
+	}
+	if match.IsEmpty() {
+		errStr := fmt.Sprintf("USB device %s has nothing to match on",
+			adapter.Name)
+		log.Errorln(errStr)
+		appInstance.Errors = append(appInstance.Errors, errStr)
+		return
+	}
+	appInstance.UsbDeviceList = append(appInstance.UsbDeviceList, match)
+}
+
+// The metadata is served as files hence the keys can not contain a slash
+func parseMetaData(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig) {
+
+	switch cfgApp.MetaDataType {
+	case zconfig.MetaDataType_METADATA_DRIVE_AND_SERVICE:
+		appInstance.MetaDataType = types.MetaDataDriveAndService
+	case zconfig.MetaDataType_METADATA_SERVICE:
+		appInstance.MetaDataType = types.MetaDataService
+	case zconfig.MetaDataType_METADATA_DRIVE:
+		appInstance.MetaDataType = types.MetaDataDrive
+	default:
+		errStr := fmt.Sprintf("unknown metaDataType %v",
+			cfgApp.MetaDataType)
+		log.Errorln(errStr)
+		appInstance.Errors = append(appInstance.Errors, errStr)
+		return
+	}
+	items := cfgApp.GetMetaData()
+	if len(items) == 0 {
+		return
+	}
+	appInstance.MetaData = make(map[string]string)
+	for _, item := range items {
+		if item.Key == "" || strings.Contains(item.Key, "/") {
+			errStr := fmt.Sprintf("bad metaData key <%s>", item.Key)
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			continue
+		}
+		appInstance.MetaData[item.Key] = item.Value
+	}
+}
+
+// The dependencies and boot group. Whether the app instances depended on
+// exist is checked by zedmanager since they can come and go.
+func parseDependencies(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig) {
+
+	appInstance.BootGroup = cfgApp.GetBootGroup()
+	appInstance.BootDelay = cfgApp.GetBootDelay()
+	for _, dep := range cfgApp.GetDependencies() {
+		id, err := uuid.FromString(dep.Uuid)
+		if err != nil {
+			errStr := fmt.Sprintf("Dependency has bad UUID %s: %s",
+				dep.Uuid, err)
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			continue
+		}
+		if uuid.Equal(id, appInstance.UUIDandVersion.UUID) {
+			errStr := "Dependency on itself"
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			continue
+		}
+		var cond types.DependencyCondition
+		switch dep.Condition {
+		case zconfig.DependencyCondition_DEPENDENCY_RUNNING:
+			cond = types.DependencyRunning
+		case zconfig.DependencyCondition_DEPENDENCY_HEALTHY:
+			cond = types.DependencyHealthy
+		default:
+			errStr := fmt.Sprintf("Dependency on %s has unknown condition %v",
+				id, dep.Condition)
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			continue
+		}
+		appInstance.Dependencies = append(appInstance.Dependencies,
+			types.AppDependency{UUID: id, Condition: cond})
+	}
+	if len(appInstance.Dependencies) != 0 || appInstance.BootGroup != 0 {
+		log.Infof("Got dependencies %+v boot group %d delay %d\n",
+			appInstance.Dependencies, appInstance.BootGroup,
+			appInstance.BootDelay)
+	}
+}
+
+// The restart policy and health probe. A probe which can not work is
+// reported as an error.
+func parseRestartPolicy(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig) {
+
+	policy := cfgApp.GetRestartPolicy()
+	if policy != nil {
+		appInstance.RestartPolicy = types.RestartPolicy{
+			Mode:        types.RestartMode(policy.Mode),
+			MaxAttempts: policy.MaxAttempts,
+			Backoff:     policy.Backoff,
+		}
+	}
+	probe := cfgApp.GetHealthProbe()
+	if probe == nil {
+		return
+	}
+	hp := types.HealthProbe{
+		Type:             types.ProbeType(probe.Type),
+		Port:             uint16(probe.Port),
+		Path:             probe.Path,
+		Interval:         probe.Interval,
+		Timeout:          probe.Timeout,
+		FailureThreshold: probe.FailureThreshold,
+		InitialDelay:     probe.InitialDelay,
+	}
+	switch hp.Type {
+	case types.ProbeTCP, types.ProbeHTTP:
+		if probe.Port == 0 || probe.Port > 65535 {
+			errStr := fmt.Sprintf("Health probe has invalid port %d",
+				probe.Port)
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			return
+		}
+		if len(appInstance.UnderlayNetworkList) == 0 {
+			errStr := "Health probe needs a network"
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			return
+		}
+	}
+	appInstance.HealthProbe = hp
+	log.Infof("Got restart policy %+v health probe %+v\n",
+		appInstance.RestartPolicy, appInstance.HealthProbe)
+}
+
 var systemAdaptersPrevConfigHash []byte
 
 func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
@@ -674,6 +1013,17 @@ func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
 		port.IsMgmt = isUplink
 		port.Free = isFreeUplink
 
+		if err := parseSWAdapterParams(sysAdapter, &port); err != nil {
+			log.Errorf("parseSystemAdapterConfig: Port %s: %s - ignored\n",
+				sysAdapter.Name, err)
+			continue
+		}
+		if err := parseWirelessConfig(sysAdapter, &port); err != nil {
+			log.Errorf("parseSystemAdapterConfig: Port %s: %s - ignored\n",
+				sysAdapter.Name, err)
+			continue
+		}
+
 		port.Dhcp = types.DT_NONE
 		// XXX temporary hack: if static IP 0.0.0.0 we log and
 		// Dhcp = DT_NONE. Remove once zedcloud can send Dhcp = None
@@ -771,6 +1121,216 @@ func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
 	log.Infof("parseSystemAdapterConfig: Done")
 }
 
+// parseSWAdapterParams handles the software adapters such as a VLAN
+// sub-interface of a physical port
+func parseSWAdapterParams(sysAdapter *zconfig.SystemAdapter,
+	port *types.NetworkPortConfig) error {
+
+	params := sysAdapter.GetAllocDetails()
+	if params == nil {
+		return nil
+	}
+	switch params.AType {
+	case zconfig.SWAdapterType_IGNORE:
+		return nil
+	case zconfig.SWAdapterType_VLAN:
+		if params.UnderlayInterface == "" {
+			errStr := fmt.Sprintf("VLAN %d without underlayInterface",
+				params.VlanId)
+			return errors.New(errStr)
+		}
+		if params.VlanId == 0 || params.VlanId > 4094 {
+			errStr := fmt.Sprintf("Bad VLAN ID %d", params.VlanId)
+			return errors.New(errStr)
+		}
+		if params.UnderlayInterface == sysAdapter.Name {
+			errStr := fmt.Sprintf("VLAN %d has same name as underlayInterface %s",
+				params.VlanId, params.UnderlayInterface)
+			return errors.New(errStr)
+		}
+		port.Vlan.ParentIfName = params.UnderlayInterface
+		port.Vlan.VlanID = uint16(params.VlanId)
+		return nil
+	default:
+		errStr := fmt.Sprintf("Unsupported adapter type %s",
+			params.AType.String())
+		return errors.New(errStr)
+	}
+}
+
+// parseWirelessConfig sets the list of SSIDs for a WiFi port and the
+// modem configuration for a cellular port.
+// The WiFi strings end up in the wpa_supplicant configuration hence we
+// reject anything which can not be represented there.
+func parseWirelessConfig(sysAdapter *zconfig.SystemAdapter,
+	port *types.NetworkPortConfig) error {
+
+	wireless := sysAdapter.GetWirelessCfg()
+	if wireless == nil || wireless.Type == zconfig.WirelessType_TypeNOOP {
+		// Older controllers do not send anything for the modem
+		if devicenetwork.IsCellularIfname(sysAdapter.Name) {
+			port.Cellular = &types.CellularConfig{
+				APN: devicenetwork.DefaultCellularAPN,
+			}
+		}
+		return nil
+	}
+	switch wireless.Type {
+	case zconfig.WirelessType_Cellular:
+		if len(wireless.CellularCfg) == 0 {
+			return errors.New("Cellular without any configuration")
+		}
+		if len(wireless.CellularCfg) > 1 {
+			log.Warnf("parseWirelessConfig: Port %s: using the first of %d cellular configurations\n",
+				sysAdapter.Name, len(wireless.CellularCfg))
+		}
+		cellular, err := parseCellularConfig(wireless.CellularCfg[0])
+		if err != nil {
+			return err
+		}
+		port.Cellular = cellular
+		return nil
+	case zconfig.WirelessType_WiFi:
+		if len(wireless.WifiCfg) == 0 {
+			return errors.New("WiFi without any SSID")
+		}
+		for _, wifi := range wireless.WifiCfg {
+			if len(wifi.WifiSSID) == 0 || len(wifi.WifiSSID) > 32 {
+				errStr := fmt.Sprintf("Bad WiFi SSID length %d",
+					len(wifi.WifiSSID))
+				return errors.New(errStr)
+			}
+			wc := types.WifiConfig{
+				SSID:     wifi.WifiSSID,
+				Priority: wifi.Priority,
+			}
+			switch wifi.KeyScheme {
+			case zconfig.WiFiKeyScheme_SchemeNOOP:
+				wc.KeyScheme = types.WifiKeySchemeNone
+			case zconfig.WiFiKeyScheme_WPAPSK:
+				if len(wifi.Password) < 8 || len(wifi.Password) > 63 {
+					errStr := fmt.Sprintf("SSID %s: WPA passphrase must be 8 to 63 characters",
+						wifi.WifiSSID)
+					return errors.New(errStr)
+				}
+				wc.KeyScheme = types.WifiKeySchemeWpaPsk
+				wc.Password = wifi.Password
+			case zconfig.WiFiKeyScheme_WPAEAP:
+				if wifi.Identity == "" {
+					errStr := fmt.Sprintf("SSID %s: WPA-EAP without identity",
+						wifi.WifiSSID)
+					return errors.New(errStr)
+				}
+				if wifi.CaCert == "" && wifi.ServerName == "" {
+					errStr := fmt.Sprintf("SSID %s: WPA-EAP without CA certificate or server name",
+						wifi.WifiSSID)
+					return errors.New(errStr)
+				}
+				if wifi.CaCert != "" {
+					if err := checkCACert(wifi.CaCert); err != nil {
+						errStr := fmt.Sprintf("SSID %s: %s",
+							wifi.WifiSSID, err)
+						return errors.New(errStr)
+					}
+				}
+				wc.KeyScheme = types.WifiKeySchemeWpaEap
+				wc.Identity = wifi.Identity
+				wc.Password = wifi.Password
+				wc.CACert = wifi.CaCert
+				wc.ServerName = wifi.ServerName
+			default:
+				errStr := fmt.Sprintf("SSID %s: unsupported key scheme %s",
+					wifi.WifiSSID, wifi.KeyScheme.String())
+				return errors.New(errStr)
+			}
+			if strings.ContainsAny(wc.Identity+wc.Password+wc.ServerName, "\"\n") {
+				errStr := fmt.Sprintf("SSID %s: quote or newline in credentials",
+					wifi.WifiSSID)
+				return errors.New(errStr)
+			}
+			port.Wifi = append(port.Wifi, wc)
+		}
+		return nil
+	default:
+		errStr := fmt.Sprintf("Unsupported wireless type %s",
+			wireless.Type.String())
+		return errors.New(errStr)
+	}
+}
+
+// checkCACert returns an error unless there is at least one certificate
+// in the PEM
+func checkCACert(caCert string) error {
+	rest := []byte(caCert)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			errStr := fmt.Sprintf("bad CA certificate: %s", err)
+			return errors.New(errStr)
+		}
+		found = true
+	}
+	if !found {
+		return errors.New("no CA certificate in PEM")
+	}
+	return nil
+}
+
+func parseCellularConfig(cfg *zconfig.CellularConfig) (*types.CellularConfig, error) {
+
+	cellular := &types.CellularConfig{
+		APN:            cfg.APN,
+		PIN:            cfg.Pin,
+		Username:       cfg.Username,
+		Password:       cfg.Password,
+		RoamingAllowed: cfg.RoamingAllowed,
+	}
+	switch cfg.AuthProtocol {
+	case zconfig.CellularAuthProtocol_CellularAuthNone:
+		cellular.AuthProtocol = types.CellularAuthNone
+	case zconfig.CellularAuthProtocol_CellularAuthPAP:
+		cellular.AuthProtocol = types.CellularAuthPAP
+	case zconfig.CellularAuthProtocol_CellularAuthCHAP:
+		cellular.AuthProtocol = types.CellularAuthCHAP
+	case zconfig.CellularAuthProtocol_CellularAuthPAPandCHAP:
+		cellular.AuthProtocol = types.CellularAuthPAPandCHAP
+	default:
+		errStr := fmt.Sprintf("Unsupported cellular auth protocol %s",
+			cfg.AuthProtocol.String())
+		return nil, errors.New(errStr)
+	}
+	if cellular.AuthProtocol != types.CellularAuthNone &&
+		cellular.Username == "" {
+		return nil, errors.New("Cellular authentication without username")
+	}
+	for _, rat := range cfg.PreferredRats {
+		switch rat {
+		case zconfig.CellularRAT_RATGSM:
+			cellular.PreferredRATs = append(cellular.PreferredRATs,
+				types.CellularRATGSM)
+		case zconfig.CellularRAT_RATUMTS:
+			cellular.PreferredRATs = append(cellular.PreferredRATs,
+				types.CellularRATUMTS)
+		case zconfig.CellularRAT_RATLTE:
+			cellular.PreferredRATs = append(cellular.PreferredRATs,
+				types.CellularRATLTE)
+		default:
+			errStr := fmt.Sprintf("Unsupported cellular RAT %s",
+				rat.String())
+			return nil, errors.New(errStr)
+		}
+	}
+	return cellular, nil
+}
+
 func lookupDatastore(datastores []*zconfig.DatastoreConfig,
 	dsid string) *zconfig.DatastoreConfig {
 
@@ -836,6 +1396,140 @@ func publishDatastoreConfig(ctx *getconfigContext,
 	}
 }
 
+var remoteSessionPrevConfigHash []byte
+
+func parseRemoteSessionConfig(config *zconfig.EdgeDevConfig,
+	getconfigCtx *getconfigContext) {
+
+	sessions := config.GetRemoteSessions()
+	h := sha256.New()
+	for _, rs := range sessions {
+		computeConfigElementSha(h, rs)
+	}
+	configHash := h.Sum(nil)
+	same := bytes.Equal(configHash, remoteSessionPrevConfigHash)
+	remoteSessionPrevConfigHash = configHash
+	if same {
+		log.Debugf("parseRemoteSessionConfig: remote session sha is unchanged: % x\n",
+			configHash)
+		return
+	}
+	log.Infof("parseRemoteSessionConfig: Applying updated remote session config % x: %v\n",
+		configHash, sessions)
+	pub := getconfigCtx.pubRemoteSessionConfig
+	wanted := make(map[string]bool)
+	for _, rs := range sessions {
+		session, err := parseRemoteSession(rs)
+		if err != nil {
+			log.Errorf("parseRemoteSessionConfig: ignored %v: %s\n",
+				rs, err)
+			continue
+		}
+		wanted[session.Key()] = true
+		pub.Publish(session.Key(), session)
+	}
+	for key := range pub.GetAll() {
+		if !wanted[key] {
+			log.Infof("parseRemoteSessionConfig: unpublishing %s\n",
+				key)
+			pub.Unpublish(key)
+		}
+	}
+}
+
+func parseRemoteSession(rs *zconfig.RemoteSession) (types.RemoteSessionConfig, error) {
+	session := types.RemoteSessionConfig{
+		ID:       rs.Id,
+		User:     rs.User,
+		ReadOnly: rs.ReadOnly,
+	}
+	// The id is used as a pubsub key and in the tunnel URL
+	if rs.Id == "" || strings.IndexFunc(rs.Id, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' ||
+			r >= '0' && r <= '9' || r == '-' || r == '_')
+	}) != -1 {
+		errStr := fmt.Sprintf("bad session id <%s>", rs.Id)
+		return session, errors.New(errStr)
+	}
+	switch rs.Type {
+	case zconfig.RemoteSessionType_REMOTE_SESSION_SHELL:
+		session.Type = types.RemoteSessionShell
+	case zconfig.RemoteSessionType_REMOTE_SESSION_PORT_FORWARD:
+		session.Type = types.RemoteSessionPortForward
+		if rs.Port == 0 || rs.Port > 65535 {
+			errStr := fmt.Sprintf("bad port %d", rs.Port)
+			return session, errors.New(errStr)
+		}
+		session.Port = uint16(rs.Port)
+	case zconfig.RemoteSessionType_REMOTE_SESSION_FILE_TRANSFER:
+		session.Type = types.RemoteSessionFileTransfer
+	case zconfig.RemoteSessionType_REMOTE_SESSION_APP_CONSOLE:
+		session.Type = types.RemoteSessionAppConsole
+		id, err := uuid.FromString(rs.AppInstance)
+		if err != nil {
+			errStr := fmt.Sprintf("bad appInstance <%s>: %s",
+				rs.AppInstance, err)
+			return session, errors.New(errStr)
+		}
+		session.AppInstance = id.String()
+	default:
+		errStr := fmt.Sprintf("unsupported type %v", rs.Type)
+		return session, errors.New(errStr)
+	}
+	// Sessions are always time-limited
+	if rs.Expires == nil {
+		return session, errors.New("no expiry")
+	}
+	expires, err := ptypes.Timestamp(rs.Expires)
+	if err != nil {
+		return session, err
+	}
+	session.Expires = expires
+	return session, nil
+}
+
+var sshCAPrevConfigHash []byte
+
+func parseSshCAConfig(config *zconfig.EdgeDevConfig,
+	getconfigCtx *getconfigContext) {
+
+	cfg := config.GetSshCA()
+	if cfg == nil {
+		cfg = &zconfig.SshCAConfig{}
+	}
+	configHash := computeConfigSha(cfg)
+	same := bytes.Equal(configHash, sshCAPrevConfigHash)
+	sshCAPrevConfigHash = configHash
+	if same {
+		log.Debugf("parseSshCAConfig: ssh CA sha is unchanged: % x\n",
+			configHash)
+		return
+	}
+	log.Infof("parseSshCAConfig: Applying updated ssh CA config %v\n",
+		cfg)
+	sshCA := types.SshCAConfig{
+		UserCAKeys:        cfg.GetUserCaKeys(),
+		RevokedPrincipals: cfg.GetRevokedPrincipals(),
+		RevokedKeys:       cfg.GetRevokedKeys(),
+		RevokedSerials:    cfg.GetRevokedSerials(),
+	}
+	for _, p := range cfg.GetPrincipals() {
+		principal := types.SshPrincipal{Name: p.Name}
+		switch p.Role {
+		case zconfig.SshRole_SSH_ROLE_DEBUG:
+			principal.Role = types.SshRoleDebug
+		case zconfig.SshRole_SSH_ROLE_ROOT:
+			principal.Role = types.SshRoleRoot
+		default:
+			log.Errorf("parseSshCAConfig: ignored %s with role %v\n",
+				p.Name, p.Role)
+			continue
+		}
+		sshCA.Principals = append(sshCA.Principals, principal)
+	}
+	getconfigCtx.pubSshCAConfig.Publish(sshCA.Key(), sshCA)
+}
+
 func parseStorageConfigList(objType string,
 	storageList []types.StorageConfig, drives []*zconfig.Drive) {
 
@@ -1295,6 +1989,26 @@ func parseUnderlayNetworkConfigEntry(
 		}
 		ulCfg.ACLs[aclIdx] = *aclCfg
 	}
+	if qos := intfEnt.GetQos(); qos != nil {
+		ulCfg.Qos = types.AppQos{
+			EgressRate:   qos.EgressRate,
+			EgressBurst:  qos.EgressBurst,
+			IngressRate:  qos.IngressRate,
+			IngressBurst: qos.IngressBurst,
+			Priority:     types.QosPriority(qos.Priority),
+		}
+		switch ulCfg.Qos.Priority {
+		case types.QosPriorityNormal, types.QosPriorityHigh,
+			types.QosPriorityLow:
+			// Do nothing
+		default:
+			log.Errorf("App %s-%s: unknown QoS priority %d ignored\n",
+				cfgApp.Displayname, cfgApp.Uuidandversion.Uuid,
+				ulCfg.Qos.Priority)
+			ulCfg.Qos.Priority = types.QosPriorityNormal
+		}
+	}
+	ulCfg.VlanTrunk = parseVlanTrunk(ulCfg.Name, intfEnt.GetVlanTrunk())
 	return ulCfg
 }
 
@@ -1481,6 +2195,15 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 			}
 			newGlobalConfig.MetricInterval = uint32(i64)
 
+		case "timer.metric.sample":
+			i64, err := strconv.ParseInt(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad int value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.MetricSampleInterval = uint32(i64)
+
 		case "timer.reboot.no.network":
 			i64, err := strconv.ParseInt(item.Value, 10, 32)
 			if err != nil {
@@ -1598,6 +2321,53 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 			}
 			newGlobalConfig.AllowAppVnc = newBool
 
+		case "app.memory.reserve":
+			i64, err := strconv.ParseInt(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad int value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.MemoryReserve = uint32(i64)
+
+		case "app.cpu.pinning.exclusive":
+			newBool, err := strconv.ParseBool(item.Value)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad bool value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.ExclusiveCPUPinning = newBool
+
+		case "app.memory.autoballoon.free":
+			i64, err := strconv.ParseInt(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad int value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.AutoBalloonFreeMemory = uint32(i64)
+
+		case "metrics.local.port":
+			i64, err := strconv.ParseInt(item.Value, 10, 32)
+			if err != nil || i64 < 0 || i64 > 65535 {
+				log.Errorf("parseConfigItems: bad port value %s for %s: %v\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.MetricsExportPort = uint32(i64)
+
+		case "metrics.local.listen":
+			newGlobalConfig.MetricsExportListen = item.Value
+
+		case "metrics.local.allow":
+			if _, err := types.ParseMetricsExportAllow(item.Value); err != nil {
+				log.Errorf("parseConfigItems: bad value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.MetricsExportAllow = item.Value
+
 		case "timer.use.config.checkpoint":
 			i64, err := strconv.ParseInt(item.Value, 10, 32)
 			if err != nil {
@@ -1710,12 +2480,17 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 				globalConfig.MetricInterval)
 			updateMetricsTimer(ctx.metricsTickerHandle)
 		}
+		if globalConfig.MetricsExportPort != oldGlobalConfig.MetricsExportPort ||
+			globalConfig.MetricsExportListen != oldGlobalConfig.MetricsExportListen ||
+			globalConfig.MetricsExportAllow != oldGlobalConfig.MetricsExportAllow {
+			updateMetricsExport(ctx.zedagentCtx)
+		}
+		// nim updates the authorized keys together with the ssh CA
 		if globalConfig.SshAuthorizedKeys != oldGlobalConfig.SshAuthorizedKeys {
 			log.Infof("parseConfigItems: %v change from %v to %v",
 				"SshAuthorizedKeys",
 				oldGlobalConfig.SshAuthorizedKeys,
 				globalConfig.SshAuthorizedKeys)
-			ssh.UpdateSshAuthorizedKeys(globalConfig.SshAuthorizedKeys)
 		}
 		err := pubsub.PublishToDir("/persist/config/", "global",
 			&globalConfig)
@@ -1865,6 +2640,7 @@ func parseOpCmds(config *zconfig.EdgeDevConfig,
 	getconfigCtx *getconfigContext) bool {
 
 	scheduleBackup(config.GetBackup())
+	scheduleCertRenewal(config.GetRenewCert())
 	return scheduleReboot(config.GetReboot(), getconfigCtx)
 }
 
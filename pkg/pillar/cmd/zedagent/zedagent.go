@@ -26,8 +26,11 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -38,6 +41,7 @@ import (
 	"github.com/zededa/eve/pkg/pillar/pubsub"
 	"github.com/zededa/eve/pkg/pillar/types"
 	"github.com/zededa/eve/pkg/pillar/zedcloud"
+	"github.com/zededa/eve/sdk/go/zmet"
 )
 
 const (
@@ -106,6 +110,21 @@ type zedagentContext struct {
 	subDevicePortConfigList   *pubsub.Subscription
 	devicePortConfigList      types.DevicePortConfigList
 	remainingTestTime         time.Duration
+	subVaultStatus            *pubsub.Subscription
+	vaultStatus               types.VaultStatus
+	subDeviceCapacity         *pubsub.Subscription
+	deviceCapacity            types.DeviceCapacity
+	certRenewTime             time.Time
+	certRenewError            string
+	certRenewErrorTime        time.Time
+	// The local OpenMetrics endpoint; used by the config and metrics
+	// goroutines and the HTTP server hence the lock
+	metricsExportLock   sync.Mutex
+	metricsExportServer *http.Server
+	metricsExportAddr   string
+	metricsExportAllow  []*net.IPNet
+	latestMetrics       *zmet.ZMetricMsg
+	metricSampler       metricSampler
 }
 
 var debug = false
@@ -310,6 +329,23 @@ func Run() {
 	getconfigCtx.pubDatastoreConfig = pubDatastoreConfig
 	pubDatastoreConfig.ClearRestarted()
 
+	pubRemoteSessionConfig, err := pubsub.Publish(agentName,
+		types.RemoteSessionConfig{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubRemoteSessionConfig = pubRemoteSessionConfig
+	pubRemoteSessionConfig.ClearRestarted()
+
+	// Persistent so that ssh access works after a reboot without
+	// connectivity to the controller
+	pubSshCAConfig, err := pubsub.PublishPersistent(agentName,
+		types.SshCAConfig{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubSshCAConfig = pubSshCAConfig
+
 	// Look for global config such as log levels
 	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
 		false, &zedagentCtx)
@@ -461,6 +497,26 @@ func Run() {
 	zedagentCtx.subDevicePortConfigList = subDevicePortConfigList
 	subDevicePortConfigList.Activate()
 
+	subVaultStatus, err := pubsub.Subscribe("tpmmgr",
+		types.VaultStatus{}, false, &zedagentCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subVaultStatus.ModifyHandler = handleVaultStatusModify
+	subVaultStatus.DeleteHandler = handleVaultStatusDelete
+	zedagentCtx.subVaultStatus = subVaultStatus
+	subVaultStatus.Activate()
+
+	subDeviceCapacity, err := pubsub.Subscribe("domainmgr",
+		types.DeviceCapacity{}, false, &zedagentCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subDeviceCapacity.ModifyHandler = handleDeviceCapacityModify
+	subDeviceCapacity.DeleteHandler = handleDeviceCapacityDelete
+	zedagentCtx.subDeviceCapacity = subDeviceCapacity
+	subDeviceCapacity.Activate()
+
 	// Read the GlobalConfig first
 	// Wait for initial GlobalConfig
 	for !zedagentCtx.GCInitialized {
@@ -585,6 +641,7 @@ func Run() {
 	// start the metrics reporting task
 	handleChannel := make(chan interface{})
 	go metricsTimerTask(&zedagentCtx, handleChannel)
+	go metricsSampleTask(&zedagentCtx)
 	metricsTickerHandle := <-handleChannel
 	getconfigCtx.metricsTickerHandle = metricsTickerHandle
 
@@ -647,6 +704,9 @@ func Run() {
 	// XXX close handleChannels?
 	getconfigCtx.configTickerHandle = configTickerHandle
 
+	certRenewResultChan := make(chan certRenewResult)
+	go certRenewalTask(certRenewResultChan)
+
 	for {
 		select {
 		case change := <-subZbootStatus.C:
@@ -747,6 +807,15 @@ func Run() {
 		case change := <-subDevicePortConfigList.C:
 			subDevicePortConfigList.ProcessChange(change)
 
+		case change := <-subVaultStatus.C:
+			subVaultStatus.ProcessChange(change)
+
+		case change := <-subDeviceCapacity.C:
+			subDeviceCapacity.ProcessChange(change)
+
+		case result := <-certRenewResultChan:
+			handleCertRenewResult(&zedagentCtx, result)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -966,6 +1035,57 @@ func handleDPCLDelete(ctxArg interface{}, key string, statusArg interface{}) {
 	ctx.TriggerDeviceInfo = true
 }
 
+func handleVaultStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	status := cast.CastVaultStatus(statusArg)
+	ctx := ctxArg.(*zedagentContext)
+	if cmp.Equal(ctx.vaultStatus, status) {
+		log.Infof("handleVaultStatusModify no change\n")
+		return
+	}
+	log.Infof("handleVaultStatusModify for %s: %s\n", key, status.State)
+	ctx.vaultStatus = status
+	ctx.TriggerDeviceInfo = true
+}
+
+func handleVaultStatusDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zedagentContext)
+	log.Infof("handleVaultStatusDelete for %s\n", key)
+	ctx.vaultStatus = types.VaultStatus{}
+	ctx.TriggerDeviceInfo = true
+}
+
+func handleDeviceCapacityModify(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	status := cast.CastDeviceCapacity(statusArg)
+	ctx := ctxArg.(*zedagentContext)
+	if key != "global" {
+		log.Infof("handleDeviceCapacityModify: ignoring %s\n", key)
+		return
+	}
+	if cmp.Equal(ctx.deviceCapacity, status) {
+		log.Infof("handleDeviceCapacityModify no change\n")
+		return
+	}
+	log.Infof("handleDeviceCapacityModify allocated %d of %d MB\n",
+		status.AllocatedMemory, status.TotalMemory)
+	ctx.deviceCapacity = status
+	ctx.TriggerDeviceInfo = true
+}
+
+func handleDeviceCapacityDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zedagentContext)
+	log.Infof("handleDeviceCapacityDelete for %s\n", key)
+	ctx.deviceCapacity = types.DeviceCapacity{}
+	ctx.TriggerDeviceInfo = true
+}
+
 // base os status event handlers
 // Report BaseOsStatus to zedcloud
 
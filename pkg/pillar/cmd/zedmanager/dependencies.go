@@ -0,0 +1,244 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// App instance dependencies and boot groups. An app instance is activated
+// once the app instances it depends on are running, or healthy, and the
+// app instances with Activate in lower boot groups are running and have
+// been for its BootDelay. An app instance which is halted or deleted
+// first waits for the app instances which depend on it, and which are
+// halted or deleted as well, to halt.
+
+package zedmanager
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/pubsub"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// How often the app instances which wait are checked, for the boot delays
+const waitingCheckInterval = 5 * time.Second
+
+// The app instance configs and statuses by key. A snapshot taken from
+// pubsub so that the checks below do not depend on the context.
+type appInstances struct {
+	configs  map[string]types.AppInstanceConfig
+	statuses map[string]types.AppInstanceStatus
+}
+
+func getAppInstances(ctx *zedmanagerContext) appInstances {
+	apps := appInstances{
+		configs:  make(map[string]types.AppInstanceConfig),
+		statuses: make(map[string]types.AppInstanceStatus),
+	}
+	for key, c := range ctx.subAppInstanceConfig.GetAll() {
+		apps.configs[key] = cast.CastAppInstanceConfig(c)
+	}
+	for key, st := range ctx.pubAppInstanceStatus.GetAll() {
+		apps.statuses[key] = cast.CastAppInstanceStatus(st)
+	}
+	return apps
+}
+
+// Returns why the app instance can not be activated yet; empty if it can.
+// A dependency cycle is also reported as an error in the status.
+// Returns true if the status changed.
+func waitingToActivate(ctx *zedmanagerContext,
+	config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) (string, bool) {
+
+	if len(config.Dependencies) == 0 && config.BootGroup == 0 {
+		c := setDependencyCycleError(status, false)
+		return "", c
+	}
+	// Until zedagent has passed on all of the config we do not know
+	// all of the lower boot groups
+	if !ctx.configRestarted {
+		return "waiting for the config", false
+	}
+	apps := getAppInstances(ctx)
+	c := setDependencyCycleError(status, dependencyCycle(config, apps))
+	return checkDependencies(config, apps, time.Now()), c
+}
+
+// Returns why the app instance has to wait for its dependencies or the
+// lower boot groups; empty if it does not
+func checkDependencies(config types.AppInstanceConfig, apps appInstances,
+	now time.Time) string {
+
+	if dependencyCycle(config, apps) {
+		return "waiting for a dependency cycle to be removed"
+	}
+	for _, dep := range config.Dependencies {
+		status, ok := apps.statuses[dep.UUID.String()]
+		if !ok {
+			return fmt.Sprintf("waiting for dependency %s", dep.UUID)
+		}
+		if !appRunning(&status) {
+			return fmt.Sprintf("waiting for dependency %s to be running",
+				status.DisplayName)
+		}
+		if dep.Condition == types.DependencyHealthy &&
+			status.Health.State != types.HealthHealthy {
+			return fmt.Sprintf("waiting for dependency %s to be healthy",
+				status.DisplayName)
+		}
+	}
+	if config.BootGroup == 0 {
+		return ""
+	}
+	var lastBootTime time.Time
+	for key, other := range apps.configs {
+		if !other.Activate || other.BootGroup == 0 ||
+			other.BootGroup >= config.BootGroup {
+			continue
+		}
+		status, ok := apps.statuses[key]
+		if !ok || !appRunning(&status) {
+			return fmt.Sprintf("waiting for boot group %d",
+				other.BootGroup)
+		}
+		if status.BootTime.After(lastBootTime) {
+			lastBootTime = status.BootTime
+		}
+	}
+	delay := time.Duration(config.BootDelay) * time.Second
+	if !lastBootTime.IsZero() && now.Sub(lastBootTime) < delay {
+		return fmt.Sprintf("waiting %v after the lower boot groups",
+			delay)
+	}
+	return ""
+}
+
+func appRunning(status *types.AppInstanceStatus) bool {
+	return status.Activated && status.State == types.RUNNING
+}
+
+// Returns true if the app instance depends on itself through the app
+// instances it depends on
+func dependencyCycle(config types.AppInstanceConfig, apps appInstances) bool {
+
+	visited := make(map[string]bool)
+	var visit func(key string) bool
+	visit = func(key string) bool {
+		if key == config.Key() {
+			return true
+		}
+		if visited[key] {
+			return false
+		}
+		visited[key] = true
+		c, ok := apps.configs[key]
+		if !ok {
+			return false
+		}
+		for _, dep := range c.Dependencies {
+			if visit(dep.UUID.String()) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, dep := range config.Dependencies {
+		if visit(dep.UUID.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// The ErrorSource in AppInstanceStatus of a dependency cycle
+var dependencyCycleSource = pubsub.TypeToName(types.AppDependency{})
+
+// Sets or clears the error for a dependency cycle. The cycle is a config
+// error hence it is logged when it is found rather than on every check.
+// Returns true if the status changed.
+func setDependencyCycleError(status *types.AppInstanceStatus,
+	cycle bool) bool {
+
+	if !cycle {
+		if status.ErrorSource != dependencyCycleSource {
+			return false
+		}
+		log.Infof("%s dependency cycle removed\n", status.Key())
+		status.Error = ""
+		status.ErrorSource = ""
+		status.ErrorTime = time.Time{}
+		return true
+	}
+	if status.ErrorSource == dependencyCycleSource {
+		return false
+	}
+	errStr := fmt.Sprintf("%s depends on itself through its dependencies",
+		status.DisplayName)
+	log.Errorf("%s: %s\n", status.Key(), errStr)
+	status.Error = errStr
+	status.ErrorSource = dependencyCycleSource
+	status.ErrorTime = time.Now()
+	return true
+}
+
+// Returns why the app instance can not be halted yet; empty if it can.
+// Only waits for the app instances depending on it which are also halted
+// or deleted; those which stay activated do not hold it up.
+func waitingToHalt(ctx *zedmanagerContext,
+	status *types.AppInstanceStatus) string {
+
+	items := ctx.pubAppInstanceStatus.GetAll()
+	for key, st := range items {
+		other := cast.CastAppInstanceStatus(st)
+		if key == status.Key() || !other.DependsOn(*status) {
+			continue
+		}
+		// A cycle; halt in any order
+		if status.DependsOn(other) {
+			continue
+		}
+		if !other.Activated && !other.ActivateInprogress {
+			continue
+		}
+		config := lookupAppInstanceConfig(ctx, key)
+		if config != nil && config.Activate {
+			continue
+		}
+		return fmt.Sprintf("waiting for %s to halt", other.DisplayName)
+	}
+	return ""
+}
+
+// Returns true if WaitingFor changed
+func setWaitingFor(status *types.AppInstanceStatus, waitingFor string) bool {
+	if status.WaitingFor == waitingFor {
+		return false
+	}
+	if waitingFor == "" {
+		log.Infof("%s done waiting after %v\n", status.Key(),
+			time.Since(status.WaitingSince))
+		status.WaitingSince = time.Time{}
+	} else {
+		log.Infof("%s %s\n", status.Key(), waitingFor)
+		if status.WaitingFor == "" {
+			status.WaitingSince = time.Now()
+		}
+	}
+	status.WaitingFor = waitingFor
+	return true
+}
+
+// Check the app instances which wait. Called when any app instance might
+// have changed state, and every waitingCheckInterval for the boot delays.
+func updateWaitingApps(ctx *zedmanagerContext) {
+	items := ctx.pubAppInstanceStatus.GetAll()
+	for key, st := range items {
+		status := cast.CastAppInstanceStatus(st)
+		if status.WaitingFor == "" {
+			continue
+		}
+		log.Debugf("updateWaitingApps: %s %s\n", key, status.WaitingFor)
+		updateAIStatusUUID(ctx, key)
+	}
+}
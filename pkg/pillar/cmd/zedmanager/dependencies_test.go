@@ -0,0 +1,304 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+var (
+	testUUID1 = uuid.FromStringOrNil("4a144db0-6b63-405a-b884-7760042023b1")
+	testUUID2 = uuid.FromStringOrNil("8f3b3c1e-2a5d-4c1f-9e62-0d4b7a1c5e21")
+	testUUID3 = uuid.FromStringOrNil("c2a1f5d4-7b3e-4e8a-a6f0-3d9c1b2e4f57")
+	testUUID4 = uuid.FromStringOrNil("e7d6c5b4-a3f2-4e1d-8c0b-9a8f7e6d5c43")
+)
+
+func testConfig(id uuid.UUID, bootGroup uint32, bootDelay uint32,
+	deps ...types.AppDependency) types.AppInstanceConfig {
+
+	return types.AppInstanceConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: id},
+		DisplayName:    id.String()[:8],
+		Activate:       true,
+		Dependencies:   deps,
+		BootGroup:      bootGroup,
+		BootDelay:      bootDelay,
+	}
+}
+
+func testStatus(id uuid.UUID, running bool, health types.HealthState,
+	bootTime time.Time) types.AppInstanceStatus {
+
+	status := types.AppInstanceStatus{
+		UUIDandVersion: types.UUIDandVersion{UUID: id},
+		DisplayName:    id.String()[:8],
+		BootTime:       bootTime,
+	}
+	if running {
+		status.Activated = true
+		status.State = types.RUNNING
+	}
+	status.Health.State = health
+	return status
+}
+
+func testApps(configs []types.AppInstanceConfig,
+	statuses []types.AppInstanceStatus) appInstances {
+
+	apps := appInstances{
+		configs:  make(map[string]types.AppInstanceConfig),
+		statuses: make(map[string]types.AppInstanceStatus),
+	}
+	for _, c := range configs {
+		apps.configs[c.Key()] = c
+	}
+	for _, s := range statuses {
+		apps.statuses[s.Key()] = s
+	}
+	return apps
+}
+
+func dependsOn(id uuid.UUID) types.AppDependency {
+	return types.AppDependency{UUID: id, Condition: types.DependencyRunning}
+}
+
+type TestDependencyCycleMatrix struct {
+	configs  []types.AppInstanceConfig
+	expected bool
+}
+
+func TestDependencyCycle(t *testing.T) {
+	testMatrix := map[string]TestDependencyCycleMatrix{
+		"No dependencies": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0),
+			},
+			expected: false,
+		},
+		"Self": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID1)),
+			},
+			expected: true,
+		},
+		"Three": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2)),
+				testConfig(testUUID2, 0, 0, dependsOn(testUUID3)),
+				testConfig(testUUID3, 0, 0, dependsOn(testUUID1)),
+			},
+			expected: true,
+		},
+		"Cycle not through it": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2)),
+				testConfig(testUUID2, 0, 0, dependsOn(testUUID3)),
+				testConfig(testUUID3, 0, 0, dependsOn(testUUID2)),
+			},
+			expected: false,
+		},
+		"Chain": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2),
+					dependsOn(testUUID3)),
+				testConfig(testUUID2, 0, 0, dependsOn(testUUID3)),
+				testConfig(testUUID3, 0, 0),
+			},
+			expected: false,
+		},
+		"Missing": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2)),
+				testConfig(testUUID2, 0, 0, dependsOn(testUUID4)),
+			},
+			expected: false,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		apps := testApps(test.configs, nil)
+		actual := dependencyCycle(test.configs[0], apps)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, actual)
+		}
+	}
+}
+
+type TestCheckDependenciesMatrix struct {
+	configs  []types.AppInstanceConfig
+	statuses []types.AppInstanceStatus
+	expected string
+}
+
+func TestCheckDependencies(t *testing.T) {
+	now := time.Now()
+	longAgo := now.Add(-time.Hour)
+	recently := now.Add(-10 * time.Second)
+	healthy := types.HealthHealthy
+	unknown := types.HealthUnknown
+	testMatrix := map[string]TestCheckDependenciesMatrix{
+		"Cycle": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID1)),
+			},
+			expected: "waiting for a dependency cycle to be removed",
+		},
+		"Missing dependency": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2)),
+			},
+			expected: "waiting for dependency " + testUUID2.String(),
+		},
+		"Dependency not running": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2)),
+				testConfig(testUUID2, 0, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, false, unknown, time.Time{}),
+			},
+			expected: "waiting for dependency 8f3b3c1e to be running",
+		},
+		"Dependency running": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, dependsOn(testUUID2)),
+				testConfig(testUUID2, 0, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, true, unknown, recently),
+			},
+			expected: "",
+		},
+		"Dependency not healthy": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, types.AppDependency{
+					UUID:      testUUID2,
+					Condition: types.DependencyHealthy}),
+				testConfig(testUUID2, 0, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, true, unknown, recently),
+			},
+			expected: "waiting for dependency 8f3b3c1e to be healthy",
+		},
+		"Dependency healthy": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 0, 0, types.AppDependency{
+					UUID:      testUUID2,
+					Condition: types.DependencyHealthy}),
+				testConfig(testUUID2, 0, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, true, healthy, recently),
+			},
+			expected: "",
+		},
+		"Lowest boot group": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 1, 30),
+				testConfig(testUUID2, 2, 0),
+			},
+			expected: "",
+		},
+		"Lower boot group not running": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 2, 0),
+				testConfig(testUUID2, 1, 0),
+				testConfig(testUUID3, 0, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, false, unknown, time.Time{}),
+				testStatus(testUUID3, false, unknown, time.Time{}),
+			},
+			expected: "waiting for boot group 1",
+		},
+		"Lower boot group not activated": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 2, 0),
+				func() types.AppInstanceConfig {
+					c := testConfig(testUUID2, 1, 0)
+					c.Activate = false
+					return c
+				}(),
+			},
+			expected: "",
+		},
+		"Boot delay": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 3, 30),
+				testConfig(testUUID2, 1, 0),
+				testConfig(testUUID3, 2, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, true, unknown, longAgo),
+				testStatus(testUUID3, true, unknown, recently),
+			},
+			expected: "waiting 30s after the lower boot groups",
+		},
+		"Boot delay passed": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 3, 5),
+				testConfig(testUUID2, 1, 0),
+				testConfig(testUUID3, 2, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID2, true, unknown, longAgo),
+				testStatus(testUUID3, true, unknown, recently),
+			},
+			expected: "",
+		},
+		"Higher boot group ignored": {
+			configs: []types.AppInstanceConfig{
+				testConfig(testUUID1, 1, 30),
+				testConfig(testUUID2, 1, 0),
+				testConfig(testUUID3, 2, 0),
+			},
+			statuses: []types.AppInstanceStatus{
+				testStatus(testUUID3, true, unknown, recently),
+			},
+			expected: "",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		apps := testApps(test.configs, test.statuses)
+		actual := checkDependencies(test.configs[0], apps, now)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected %q, Actual: %q\n",
+				testname, test.expected, actual)
+		}
+	}
+}
+
+func TestSetDependencyCycleError(t *testing.T) {
+	status := testStatus(testUUID1, false, types.HealthUnknown, time.Time{})
+	if setDependencyCycleError(&status, false) {
+		t.Errorf("Changed without a cycle")
+	}
+	if !setDependencyCycleError(&status, true) || status.Error == "" ||
+		status.ErrorSource != dependencyCycleSource {
+		t.Errorf("Cycle not reported: %+v", status)
+	}
+	errorTime := status.ErrorTime
+	// Checked again while waiting; reported only once
+	if setDependencyCycleError(&status, true) ||
+		status.ErrorTime != errorTime {
+		t.Errorf("Cycle reported again")
+	}
+	if !setDependencyCycleError(&status, false) || status.Error != "" ||
+		status.ErrorSource != "" {
+		t.Errorf("Cycle error not cleared: %+v", status)
+	}
+	// Other errors are left alone
+	status.Error = "other"
+	status.ErrorSource = "DomainStatus"
+	if setDependencyCycleError(&status, false) || status.Error != "other" {
+		t.Errorf("Cleared another error: %+v", status)
+	}
+}
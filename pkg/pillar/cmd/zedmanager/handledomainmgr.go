@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/google/go-cmp/cmp"
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/cast"
 	"github.com/zededa/eve/pkg/pillar/types"
@@ -38,6 +39,23 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		if m.Activate != aiConfig.Activate {
 			log.Infof("Domain config: Activate changed %s\n", key)
 			changed = true
+		} else if !cmp.Equal(m.IoAdapterList, aiConfig.IoAdapterList) {
+			// domainmgr hot-plugs or restarts as needed
+			log.Infof("Domain config: IoAdapterList changed %s\n",
+				key)
+			changed = true
+		} else if !cmp.Equal(m.VmConfig, aiConfig.FixedResources) &&
+			aiConfig.FixedResources.OnlineResizable(m.VmConfig) {
+			// domainmgr resizes the running domain
+			log.Infof("Domain config: memory or vCPUs changed %s\n",
+				key)
+			changed = true
+		} else if m.RestartPolicy != aiConfig.RestartPolicy ||
+			m.HealthProbe != aiConfig.HealthProbe ||
+			m.ProbeIPAddr != probeIPAddr(ns) {
+			log.Infof("Domain config: restart policy or health probe changed %s\n",
+				key)
+			changed = true
 		} else {
 			log.Infof("Domain config already exists for %s\n", key)
 		}
@@ -55,13 +73,19 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 	}
 
 	dc := types.DomainConfig{
-		UUIDandVersion:    aiConfig.UUIDandVersion,
-		DisplayName:       aiConfig.DisplayName,
-		Activate:          aiConfig.Activate,
-		AppNum:            AppNum,
-		VmConfig:          aiConfig.FixedResources,
-		IoAdapterList:     aiConfig.IoAdapterList,
-		CloudInitUserData: aiConfig.CloudInitUserData,
+		UUIDandVersion: aiConfig.UUIDandVersion,
+		DisplayName:    aiConfig.DisplayName,
+		Activate:       aiConfig.Activate,
+		AppNum:         AppNum,
+		VmConfig:       aiConfig.FixedResources,
+		IoAdapterList:  aiConfig.IoAdapterList,
+		UsbDeviceList:  aiConfig.UsbDeviceList,
+		RestartPolicy:  aiConfig.RestartPolicy,
+		HealthProbe:    aiConfig.HealthProbe,
+		ProbeIPAddr:    probeIPAddr(ns),
+	}
+	if aiConfig.MetaDataType.WantDrive() {
+		dc.CloudInitUserData = aiConfig.CloudInitUserData
 	}
 
 	// Determine number of "disk" targets in list
@@ -137,6 +161,19 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 	return nil
 }
 
+// The health probe goes to the first underlay network with an IP address
+func probeIPAddr(ns *types.AppNetworkStatus) string {
+	if ns == nil {
+		return ""
+	}
+	for _, ul := range ns.UnderlayNetworkList {
+		if ul.AssignedIPAddr != "" {
+			return ul.AssignedIPAddr
+		}
+	}
+	return ""
+}
+
 func lookupDomainConfig(ctx *zedmanagerContext, key string) *types.DomainConfig {
 
 	pub := ctx.pubDomainConfig
@@ -207,6 +244,7 @@ func handleDomainStatusModify(ctxArg interface{}, key string,
 	// Record DomainStatus.State even if Pending() to capture HALTING
 
 	updateAIStatusUUID(ctx, status.Key())
+	updateWaitingApps(ctx)
 	log.Infof("handleDomainStatusModify done for %s\n", key)
 }
 
@@ -216,6 +254,7 @@ func handleDomainStatusDelete(ctxArg interface{}, key string,
 	log.Infof("handleDomainStatusDelete for %s\n", key)
 	ctx := ctxArg.(*zedmanagerContext)
 	removeAIStatusUUID(ctx, key)
+	updateWaitingApps(ctx)
 	log.Infof("handleDomainStatusDelete done for %s\n", key)
 }
 
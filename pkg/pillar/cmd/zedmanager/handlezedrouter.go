@@ -20,6 +20,7 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 		displayName)
 
 	changed := false
+	probePort := healthProbePort(aiConfig.HealthProbe)
 	m := lookupAppNetworkConfig(ctx, key)
 	if m != nil {
 		log.Infof("appNetwork config already exists for %s\n", key)
@@ -38,6 +39,13 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 				m.Activate, aiConfig.Activate)
 			changed = true
 		}
+		// zedrouter serves the new metadata without a restart
+		if m.MetaDataType != aiConfig.MetaDataType ||
+			m.CloudInitUserData != aiConfig.CloudInitUserData ||
+			!reflect.DeepEqual(m.MetaData, aiConfig.MetaData) {
+			log.Infof("MaybeAddAppNetworkConfig metadata changed\n")
+			changed = true
+		}
 		for i, new := range aiConfig.OverlayNetworkList {
 			old := m.OverlayNetworkList[i]
 			if !reflect.DeepEqual(new.ACLs, old.ACLs) {
@@ -55,6 +63,24 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 				changed = true
 				break
 			}
+			if new.Qos != old.Qos {
+				log.Infof("Under Qos changed from %v to %v\n",
+					old.Qos, new.Qos)
+				changed = true
+				break
+			}
+			if !reflect.DeepEqual(new.VlanTrunk, old.VlanTrunk) {
+				log.Infof("Under VlanTrunk changed from %v to %v\n",
+					old.VlanTrunk, new.VlanTrunk)
+				changed = true
+				break
+			}
+			if probePort != old.ProbePort {
+				log.Infof("Under ProbePort changed from %d to %d\n",
+					old.ProbePort, probePort)
+				changed = true
+				break
+			}
 		}
 	} else {
 		log.Debugf("appNetwork config add for %s\n", key)
@@ -66,6 +92,10 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 			DisplayName:    aiConfig.DisplayName,
 			IsZedmanager:   false,
 			Activate:       aiConfig.Activate,
+
+			MetaDataType:      aiConfig.MetaDataType,
+			CloudInitUserData: aiConfig.CloudInitUserData,
+			MetaData:          aiConfig.MetaData,
 		}
 		nc.OverlayNetworkList = make([]types.OverlayNetworkConfig,
 			len(aiStatus.EIDList))
@@ -86,12 +116,24 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 		for i, ulc := range aiConfig.UnderlayNetworkList {
 			ul := &nc.UnderlayNetworkList[i]
 			*ul = ulc
+			ul.ProbePort = probePort
 		}
 		publishAppNetworkConfig(ctx, &nc)
 	}
 	log.Infof("MaybeAddAppNetworkConfig done for %s\n", key)
 }
 
+// The port zedrouter needs to allow the replies from, since domainmgr
+// probes the app from the bridge IP address
+func healthProbePort(probe types.HealthProbe) uint16 {
+	switch probe.Type {
+	case types.ProbeTCP, types.ProbeHTTP:
+		return probe.Port
+	default:
+		return 0
+	}
+}
+
 func lookupAppNetworkConfig(ctx *zedmanagerContext, key string) *types.AppNetworkConfig {
 
 	pub := ctx.pubAppNetworkConfig
@@ -6,6 +6,7 @@ package zedmanager
 import (
 	"errors"
 	"fmt"
+	"github.com/google/go-cmp/cmp"
 	"github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/cast"
@@ -82,6 +83,18 @@ func removeAIStatusUUID(ctx *zedmanagerContext, uuidStr string) {
 func removeAIStatus(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
 	uuidStr := status.Key()
 	uninstall := (status.PurgeInprogress != types.BRING_DOWN)
+	if uninstall {
+		waitingFor := ""
+		if status.Activated || status.ActivateInprogress {
+			waitingFor = waitingToHalt(ctx, status)
+		}
+		if setWaitingFor(status, waitingFor) {
+			publishAppInstanceStatus(ctx, status)
+		}
+		if waitingFor != "" {
+			return
+		}
+	}
 	changed, done := doRemove(ctx, uuidStr, status, uninstall)
 	if changed {
 		log.Infof("removeAIStatus status change for %s\n",
@@ -204,9 +217,19 @@ func doUpdate(ctx *zedmanagerContext, uuidStr string,
 
 	if !config.Activate {
 		if status.Activated || status.ActivateInprogress {
-			c := doInactivateHalt(ctx, uuidStr, config, status)
+			waitingFor := waitingToHalt(ctx, status)
+			c := setWaitingFor(status, waitingFor)
+			changed = changed || c
+			if waitingFor != "" {
+				return changed
+			}
+			c = doInactivateHalt(ctx, uuidStr, config, status)
 			changed = changed || c
 		} else {
+			c := setWaitingFor(status, "")
+			changed = changed || c
+			c = setDependencyCycleError(status, false)
+			changed = changed || c
 			// If we have a !ReadOnly disk this will create a copy
 			err := MaybeAddDomainConfig(ctx, config, nil)
 			if err != nil {
@@ -223,6 +246,15 @@ func doUpdate(ctx *zedmanagerContext, uuidStr string,
 		return changed
 	}
 	log.Infof("Have config.Activate for %s\n", uuidStr)
+	if !status.Activated && !status.ActivateInprogress {
+		waitingFor, c := waitingToActivate(ctx, config, status)
+		changed = changed || c
+		c = setWaitingFor(status, waitingFor)
+		changed = changed || c
+		if waitingFor != "" {
+			return changed
+		}
+	}
 	c = doActivate(ctx, uuidStr, config, status)
 	changed = changed || c
 	log.Infof("doUpdate done for %s\n", uuidStr)
@@ -769,6 +801,10 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if !cmp.Equal(status.Health, ds.Health) {
+		status.Health = ds.Health
+		changed = true
+	}
 	// Are we doing a restart?
 	if status.RestartInprogress == types.BRING_DOWN {
 		dc := lookupDomainConfig(ctx, config.Key())
@@ -1231,6 +1267,10 @@ func doInactivateHalt(ctx *zedmanagerContext, uuidStr string,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if !cmp.Equal(status.Health, ds.Health) {
+		status.Health = ds.Health
+		changed = true
+	}
 	if ds.State != status.State {
 		switch status.State {
 		case types.RESTARTING, types.PURGING:
@@ -95,6 +95,9 @@ func Run() {
 	stillRunning := time.NewTicker(25 * time.Second)
 	agentlog.StillRunning(agentName)
 
+	// Check the app instances waiting for dependencies and boot groups
+	waitingTimer := time.NewTicker(waitingCheckInterval)
+
 	// Any state needed by handler functions
 	ctx := zedmanagerContext{}
 
@@ -319,6 +322,9 @@ func Run() {
 		case change := <-subDeviceNetworkStatus.C:
 			subDeviceNetworkStatus.ProcessChange(change)
 
+		case <-waitingTimer.C:
+			updateWaitingApps(&ctx)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -342,6 +348,7 @@ func handleConfigRestart(ctxArg interface{}, done bool) {
 		if ctx.verifierRestarted {
 			ctx.pubEIDConfig.SignalRestarted()
 		}
+		updateWaitingApps(ctx)
 	}
 }
 
@@ -415,6 +422,7 @@ func handleAppInstanceConfigModify(ctxArg interface{}, key string, configArg int
 	} else {
 		handleModify(ctx, key, config, status)
 	}
+	updateWaitingApps(ctx)
 	log.Infof("handleAppInstanceConfigModify(%s) done\n", key)
 }
 
@@ -429,6 +437,7 @@ func handleAppInstanceConfigDelete(ctxArg interface{}, key string,
 		return
 	}
 	handleDelete(ctx, key, status)
+	updateWaitingApps(ctx)
 	log.Infof("handleAppInstanceConfigDelete(%s) done\n", key)
 }
 
@@ -480,8 +489,11 @@ func handleCreate(ctx *zedmanagerContext, key string,
 		OverlayNetworkList:  config.OverlayNetworkList,
 		UnderlayNetworkList: config.UnderlayNetworkList,
 		IoAdapterList:       config.IoAdapterList,
+		UsbDeviceList:       config.UsbDeviceList,
 		RestartCmd:          config.RestartCmd,
 		PurgeCmd:            config.PurgeCmd,
+		Dependencies:        config.Dependencies,
+		BootGroup:           config.BootGroup,
 	}
 
 	// Do we have a PurgeCmd counter from before the reboot?
@@ -621,6 +633,9 @@ func handleModify(ctx *zedmanagerContext, key string,
 	status.OverlayNetworkList = config.OverlayNetworkList
 	status.UnderlayNetworkList = config.UnderlayNetworkList
 	status.IoAdapterList = config.IoAdapterList
+	status.UsbDeviceList = config.UsbDeviceList
+	status.Dependencies = config.Dependencies
+	status.BootGroup = config.BootGroup
 	publishAppInstanceStatus(ctx, status)
 	log.Infof("handleModify done for %s\n", config.DisplayName)
 }
@@ -753,15 +768,24 @@ func quantifyChanges(config types.AppInstanceConfig,
 			}
 		}
 	}
+	// IoAdapterList changes are passed to domainmgr in the DomainConfig
 	if !cmp.Equal(config.IoAdapterList, status.IoAdapterList) {
-		log.Infof("quantifyChanges IoAdapterList changed: %v\n",
+		log.Infof("quantifyChanges FYI IoAdapterList changed: %v\n",
 			cmp.Diff(config.IoAdapterList, status.IoAdapterList))
-		needPurge = true
+	}
+	// The USB controller of the domU is sized when it boots
+	if !cmp.Equal(config.UsbDeviceList, status.UsbDeviceList) {
+		log.Infof("quantifyChanges UsbDeviceList changed: %v\n",
+			cmp.Diff(config.UsbDeviceList, status.UsbDeviceList))
+		needRestart = true
 	}
 	if !cmp.Equal(config.FixedResources, status.FixedResources) {
 		log.Infof("quantifyChanges FixedResources changed: %v\n",
 			cmp.Diff(config.FixedResources, status.FixedResources))
-		needRestart = true
+		// Memory and vCPUs within the limits are changed by domainmgr
+		if !config.FixedResources.OnlineResizable(status.FixedResources) {
+			needRestart = true
+		}
 	}
 	log.Infof("quantifyChanges for %s %s returns %v, %v\n",
 		config.Key(), config.DisplayName, needPurge, needRestart)
@@ -21,6 +21,19 @@ import (
 type IptablesRuleList []IptablesRule
 type IptablesRule []string
 
+// metricsExportConfig is the part of the GlobalConfig for the OpenMetrics
+// endpoint of zedagent. Apps on the network instance selected by listen,
+// or on all of them if listen is empty, can reach it on the bridge IP
+// address if their address is in the allow list.
+type metricsExportConfig struct {
+	port       uint32 // Zero if not enabled
+	listen     string // Network instance or port
+	allow      string // Comma-separated CIDRs
+	bridgeName string // Of the network instance selected by listen
+}
+
+var metricsExport metricsExportConfig
+
 // Go through the list of ACEs and create dnsmasq ipset configuration
 // lines required for host matches
 func compileAceIpsets(ACLs []types.ACE) []string {
@@ -216,13 +229,13 @@ func compileOldAppInstanceIpsets(ctx *zedrouterContext,
 // then concat all the rules and pass to applyACLrules
 // Note that only bridgeName is set with ifMgmt
 func createACLConfiglet(bridgeName string, vifName string, isMgmt bool,
-	ACLs []types.ACE, bridgeIP string, appIP string) error {
+	ACLs []types.ACE, bridgeIP string, appIP string, probePort uint16) error {
 
-	log.Infof("createACLConfiglet: ifname %s, vifName %s, ACLs %v, IP %s/%s\n",
-		bridgeName, vifName, ACLs, bridgeIP, appIP)
+	log.Infof("createACLConfiglet: ifname %s, vifName %s, ACLs %v, IP %s/%s, probe port %d\n",
+		bridgeName, vifName, ACLs, bridgeIP, appIP, probePort)
 	ipVer := determineIpVer(isMgmt, bridgeIP)
 	rules, err := aclToRules(bridgeName, vifName, ACLs, ipVer,
-		bridgeIP, appIP)
+		bridgeIP, appIP, probePort)
 	if err != nil {
 		return err
 	}
@@ -308,12 +321,13 @@ func applyACLRules(rules IptablesRuleList, bridgeName string, vifName string,
 }
 
 // Returns a list of iptables commands, witout the initial "-A FORWARD"
+// A non-zero probePort is the port of the health probe of the app
 func aclToRules(bridgeName string, vifName string, ACLs []types.ACE, ipVer int,
-	bridgeIP string, appIP string) (IptablesRuleList, error) {
+	bridgeIP string, appIP string, probePort uint16) (IptablesRuleList, error) {
 
 	rulesList := IptablesRuleList{}
-	log.Debugf("aclToRules(%s, %s, %v, %d, %s, %s\n",
-		bridgeName, vifName, ACLs, ipVer, bridgeIP, appIP)
+	log.Debugf("aclToRules(%s, %s, %v, %d, %s, %s, %d\n",
+		bridgeName, vifName, ACLs, ipVer, bridgeIP, appIP, probePort)
 
 	// XXX should we check isMgmt instead of bridgeIP?
 	if ipVer == 6 && bridgeIP != "" {
@@ -376,6 +390,21 @@ func aclToRules(bridgeName string, vifName string, ACLs []types.ACE, ipVer int,
 		rule4 = []string{"-i", bridgeName, "-s", bridgeIP,
 			"-p", "tcp", "--sport", "domain", "-j", "ACCEPT"}
 		rulesList = append(rulesList, rule1, rule2, rule3, rule4)
+		// The metadata service
+		rule1 = []string{"-i", bridgeName, "-d", metadataIPAddr,
+			"-p", "tcp", "--dport", metadataPort, "-j", "ACCEPT"}
+		rule2 = []string{"-i", bridgeName, "-s", metadataIPAddr,
+			"-p", "tcp", "--sport", metadataPort, "-j", "ACCEPT"}
+		rulesList = append(rulesList, rule1, rule2)
+		rulesList = append(rulesList,
+			metricsExportRules(metricsExport, bridgeName, bridgeIP)...)
+		// The replies to the health probe from domainmgr
+		if probePort != 0 {
+			portStr := strconv.Itoa(int(probePort))
+			rule1 = []string{"-i", bridgeName, "-d", bridgeIP,
+				"-p", "tcp", "--sport", portStr, "-j", "ACCEPT"}
+			rulesList = append(rulesList, rule1)
+		}
 	}
 	for _, ace := range ACLs {
 		rules, err := aceToRules(bridgeName, vifName, ace, ipVer,
@@ -388,6 +417,101 @@ func aclToRules(bridgeName string, vifName string, ACLs []types.ACE, ipVer int,
 	return rulesList, nil
 }
 
+// The OpenMetrics endpoint on the bridge IP address. Without an allow
+// list zedagent only serves localhost hence there are no rules.
+func metricsExportRules(config metricsExportConfig, bridgeName string,
+	bridgeIP string) IptablesRuleList {
+
+	if config.port == 0 {
+		return nil
+	}
+	if config.listen != "" && bridgeName != config.bridgeName {
+		return nil
+	}
+	allow, err := types.ParseMetricsExportAllow(config.allow)
+	if err != nil {
+		log.Errorf("metricsExportRules: %s\n", err)
+		return nil
+	}
+	portStr := strconv.Itoa(int(config.port))
+	rulesList := IptablesRuleList{}
+	for _, ipNet := range allow {
+		if ipNet.IP.To4() == nil {
+			continue
+		}
+		rule1 := []string{"-i", bridgeName, "-s", ipNet.String(),
+			"-d", bridgeIP, "-p", "tcp", "--dport", portStr,
+			"-j", "ACCEPT"}
+		rule2 := []string{"-i", bridgeName, "-s", bridgeIP,
+			"-d", ipNet.String(), "-p", "tcp", "--sport", portStr,
+			"-j", "ACCEPT"}
+		rulesList = append(rulesList, rule1, rule2)
+	}
+	return rulesList
+}
+
+// newMetricsExportConfig finds the bridge of the network instance with
+// the name or UUID in listen. If listen is a port there is none.
+func newMetricsExportConfig(ctx *zedrouterContext, port uint32,
+	listen string, allow string) metricsExportConfig {
+
+	config := metricsExportConfig{
+		port:   port,
+		listen: listen,
+		allow:  allow,
+	}
+	if listen == "" {
+		return config
+	}
+	for _, status := range ctx.networkInstanceStatusMap {
+		if status.Key() == listen || status.DisplayName == listen {
+			config.bridgeName = status.BridgeName
+			break
+		}
+	}
+	return config
+}
+
+// updateMetricsExportACLs replaces the rules for the old config of the
+// OpenMetrics endpoint with the ones for the new config for all the app
+// instances on Local network instances
+func updateMetricsExportACLs(ctx *zedrouterContext,
+	config metricsExportConfig) {
+
+	if config == metricsExport {
+		return
+	}
+	log.Infof("updateMetricsExportACLs: %+v to %+v\n",
+		metricsExport, config)
+	oldConfig := metricsExport
+	metricsExport = config
+	pub := ctx.pubAppNetworkStatus
+	items := pub.GetAll()
+	for _, st := range items {
+		status := cast.CastAppNetworkStatus(st)
+		for _, ulStatus := range status.UnderlayNetworkList {
+			if ulStatus.Vif == "" || ulStatus.BridgeIPAddr == "" ||
+				ulStatus.AssignedIPAddr == "" {
+				continue
+			}
+			ipVer := determineIpVer(false, ulStatus.BridgeIPAddr)
+			if ipVer != 4 {
+				continue
+			}
+			oldRules := metricsExportRules(oldConfig, ulStatus.Bridge,
+				ulStatus.BridgeIPAddr)
+			newRules := metricsExportRules(config, ulStatus.Bridge,
+				ulStatus.BridgeIPAddr)
+			err := applyACLUpdate(false, ipVer, ulStatus.Vif,
+				ulStatus.AssignedIPAddr, oldRules, newRules)
+			if err != nil {
+				log.Errorf("updateMetricsExportACLs(%s) failed: %s\n",
+					status.Key(), err)
+			}
+		}
+	}
+}
+
 func aclDropRules(bridgeName, vifName string) (IptablesRuleList, error) {
 
 	log.Debugf("aclDropRules: bridgeName %s, vifName %s\n",
@@ -497,6 +621,12 @@ func aceToRules(bridgeName string, vifName string, ace types.ACE, ipVer int, bri
 		log.Errorln(errStr)
 		return nil, errors.New(errStr)
 	}
+	// An ACE for an address in the other family does not apply to the
+	// ip6tables (resp. iptables) rules for a dual-stack network instance
+	if ip != "" && ipVerOfIPorCIDR(ip) != ipVer {
+		log.Debugf("aceToRules: skip %s for ipVer %d\n", ip, ipVer)
+		return rulesList, nil
+	}
 
 	if ip != "" {
 		outArgs = append(outArgs, "-d", ip)
@@ -570,6 +700,10 @@ func aceToRules(bridgeName string, vifName string, ace types.ACE, ipVer int, bri
 			}
 			targetPort := fmt.Sprintf("%d", action.TargetPort)
 			target := fmt.Sprintf("%s:%d", appIP, action.TargetPort)
+			if ipVer == 6 {
+				target = fmt.Sprintf("[%s]:%d", appIP,
+					action.TargetPort)
+			}
 			// These rules should only apply on the uplink
 			// interfaces but for now we just compare the protocol
 			// and port number.
@@ -647,6 +781,18 @@ func isIPorCIDR(str string) bool {
 	return err == nil
 }
 
+// Returns 4 or 6 for an IP address or CIDR; 4 if it can't be parsed
+func ipVerOfIPorCIDR(str string) int {
+	ip := net.ParseIP(str)
+	if ip == nil {
+		ip, _, _ = net.ParseCIDR(str)
+	}
+	if ip != nil && ip.To4() == nil {
+		return 6
+	}
+	return 4
+}
+
 // Determine which rules to skip and what prefix/table to use
 // We append a '+' to the vifname to handle PV/qemu which for some
 // reason have a second <vifname>-emu bridge interface.
@@ -680,7 +826,10 @@ func rulePrefix(operation string, isMgmt bool, ipVer int, vifName string,
 		// since packets are forwarded from lispers.net interface after
 		// decap.
 		// Note that the counter parsing code assumes this.
-		if rule[0] == "-i" {
+		if rule[0] == "PREROUTING" || rule[0] == "POSTROUTING" {
+			// NAT verbatim rule for NAT66
+			prefix = []string{"-t", "nat", operation}
+		} else if rule[0] == "-i" {
 			prefix = []string{"-t", "raw", operation, "PREROUTING",
 				"-m", "physdev", "--physdev-in", vifName}
 		} else if rule[0] == "-o" {
@@ -772,19 +921,20 @@ func diffIpsets(newIpsets, oldIpsets []string) ([]string, []string, bool) {
 
 func updateACLConfiglet(bridgeName string, vifName string, isMgmt bool,
 	oldACLs []types.ACE, newACLs []types.ACE, bridgeIP string,
-	appIP string) error {
+	appIP string, oldProbePort uint16, newProbePort uint16) error {
 
-	log.Infof("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s, oldACLs %v newACLs %v\n",
-		bridgeName, vifName, appIP, oldACLs, newACLs)
+	log.Infof("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s, oldACLs %v newACLs %v, probe port %d to %d\n",
+		bridgeName, vifName, appIP, oldACLs, newACLs,
+		oldProbePort, newProbePort)
 
 	ipVer := determineIpVer(isMgmt, bridgeIP)
 	oldRules, err := aclToRules(bridgeName, vifName, oldACLs, ipVer,
-		bridgeIP, appIP)
+		bridgeIP, appIP, oldProbePort)
 	if err != nil {
 		return err
 	}
 	newRules, err := aclToRules(bridgeName, vifName, newACLs, ipVer,
-		bridgeIP, appIP)
+		bridgeIP, appIP, newProbePort)
 	if err != nil {
 		return err
 	}
@@ -855,14 +1005,14 @@ func applyACLUpdate(isMgmt bool, ipVer int, vifName string, appIP string,
 }
 
 func deleteACLConfiglet(bridgeName string, vifName string, isMgmt bool,
-	ACLs []types.ACE, bridgeIP string, appIP string) error {
+	ACLs []types.ACE, bridgeIP string, appIP string, probePort uint16) error {
 
 	log.Infof("deleteACLConfiglet: ifname %s vifName %s ACLs %v\n",
 		bridgeName, vifName, ACLs)
 
 	ipVer := determineIpVer(isMgmt, bridgeIP)
 	rules, err := aclToRules(bridgeName, vifName, ACLs, ipVer,
-		bridgeIP, appIP)
+		bridgeIP, appIP, probePort)
 	if err != nil {
 		return err
 	}
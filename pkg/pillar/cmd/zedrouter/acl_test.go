@@ -0,0 +1,109 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/satori/go.uuid"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+type TestMetricsExportRulesMatrix struct {
+	config     metricsExportConfig
+	bridgeName string
+	expected   IptablesRuleList
+}
+
+func TestMetricsExportRules(t *testing.T) {
+	allowRules := IptablesRuleList{
+		{"-i", "bn1", "-s", "10.1.0.0/24", "-d", "10.1.0.1",
+			"-p", "tcp", "--dport", "9100", "-j", "ACCEPT"},
+		{"-i", "bn1", "-s", "10.1.0.1", "-d", "10.1.0.0/24",
+			"-p", "tcp", "--sport", "9100", "-j", "ACCEPT"},
+		{"-i", "bn1", "-s", "10.1.0.5/32", "-d", "10.1.0.1",
+			"-p", "tcp", "--dport", "9100", "-j", "ACCEPT"},
+		{"-i", "bn1", "-s", "10.1.0.1", "-d", "10.1.0.5/32",
+			"-p", "tcp", "--sport", "9100", "-j", "ACCEPT"},
+	}
+	testMatrix := map[string]TestMetricsExportRulesMatrix{
+		"Disabled": {
+			config:     metricsExportConfig{allow: "10.1.0.0/24"},
+			bridgeName: "bn1",
+		},
+		"Localhost only": {
+			config:     metricsExportConfig{port: 9100},
+			bridgeName: "bn1",
+		},
+		"All network instances": {
+			config: metricsExportConfig{port: 9100,
+				allow: "10.1.0.0/24, 10.1.0.5,fd00::/64"},
+			bridgeName: "bn1",
+			expected:   allowRules,
+		},
+		"Selected network instance": {
+			config: metricsExportConfig{port: 9100, listen: "local1",
+				allow: "10.1.0.0/24,10.1.0.5", bridgeName: "bn1"},
+			bridgeName: "bn1",
+			expected:   allowRules,
+		},
+		"Other network instance": {
+			config: metricsExportConfig{port: 9100, listen: "local2",
+				allow: "10.1.0.0/24", bridgeName: "bn2"},
+			bridgeName: "bn1",
+		},
+		"Listen on a port": {
+			config: metricsExportConfig{port: 9100, listen: "eth0",
+				allow: "10.1.0.0/24"},
+			bridgeName: "bn1",
+		},
+		"Bad allow list": {
+			config: metricsExportConfig{port: 9100,
+				allow: "10.1.0.0/24,bad"},
+			bridgeName: "bn1",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		rules := metricsExportRules(test.config, test.bridgeName,
+			"10.1.0.1")
+		if len(rules) == 0 && len(test.expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(rules, test.expected) {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, rules)
+		}
+	}
+}
+
+func TestNewMetricsExportConfig(t *testing.T) {
+	ctx := &zedrouterContext{
+		networkInstanceStatusMap: make(map[uuid.UUID]*types.NetworkInstanceStatus),
+	}
+	status := &types.NetworkInstanceStatus{}
+	status.UUID = uuid.FromStringOrNil("6e4c0a10-5d3a-4a5b-9b3c-1a2b3c4d5e6f")
+	status.DisplayName = "local1"
+	status.BridgeName = "bn3"
+	ctx.networkInstanceStatusMap[status.UUID] = status
+
+	config := newMetricsExportConfig(ctx, 9100, "local1", "10.1.0.0/24")
+	if config.bridgeName != "bn3" {
+		t.Errorf("Expected bridge bn3, Actual: %s\n", config.bridgeName)
+	}
+	config = newMetricsExportConfig(ctx, 9100, status.Key(), "10.1.0.0/24")
+	if config.bridgeName != "bn3" {
+		t.Errorf("Expected bridge bn3 by UUID, Actual: %s\n",
+			config.bridgeName)
+	}
+	config = newMetricsExportConfig(ctx, 9100, "eth0", "10.1.0.0/24")
+	if config.bridgeName != "" {
+		t.Errorf("Expected no bridge for a port, Actual: %s\n",
+			config.bridgeName)
+	}
+	if rules := metricsExportRules(config, "bn3", "10.1.0.1"); len(rules) != 0 {
+		t.Errorf("Expected no rules when listening on a port: %v", rules)
+	}
+}
@@ -29,7 +29,7 @@ const dnsmasqStatic = `
 # Automatically generated by zedrouter
 except-interface=lo
 bind-interfaces
-log-queries
+log-queries=extra
 log-dhcp
 no-hosts
 no-ping
@@ -58,13 +58,15 @@ func dnsmasqDhcpHostDir(bridgeName string) string {
 // createDnsmasqConfiglet
 // When we create a linux bridge we set this up
 // Also called when we need to update the ipsets
+// If bridgeIPv6 is set we also do router advertisements and DHCPv6 for
+// its subnet in addition to the IPv4 bridgeIPAddr.
 func createDnsmasqConfiglet(
 	bridgeName string, bridgeIPAddr string,
 	netconf *types.NetworkInstanceConfig, hostsDir string,
-	ipsets []string, Ipv4Eid bool) {
+	ipsets []string, Ipv4Eid bool, bridgeIPv6 *net.IPNet) {
 
-	log.Infof("createDnsmasqConfiglet(%s, %s) netconf %v, ipsets %v\n",
-		bridgeName, bridgeIPAddr, netconf, ipsets)
+	log.Infof("createDnsmasqConfiglet(%s, %s, %v) netconf %v, ipsets %v\n",
+		bridgeName, bridgeIPAddr, bridgeIPv6, netconf, ipsets)
 
 	cfgPathname := dnsmasqConfigPath(bridgeName)
 	// Delete if it exists
@@ -90,6 +92,18 @@ func createDnsmasqConfiglet(
 		file.WriteString(fmt.Sprintf("ipset=/%s/ipv4.%s,ipv6.%s\n",
 			ipset, ipset, ipset))
 	}
+	// Domains match the name itself and all subdomains. An address
+	// without an IP results in NXDOMAIN, and '#' as the server means
+	// the standard servers; the longest match wins.
+	for _, domain := range netconf.DnsPolicy.BlockedDomains {
+		file.WriteString(fmt.Sprintf("address=/%s/\n", domain))
+	}
+	if len(netconf.DnsPolicy.AllowedDomains) != 0 {
+		for _, domain := range netconf.DnsPolicy.AllowedDomains {
+			file.WriteString(fmt.Sprintf("server=/%s/#\n", domain))
+		}
+		file.WriteString("address=/#/\n")
+	}
 	file.WriteString(fmt.Sprintf("pid-file=/var/run/dnsmasq.%s.pid\n",
 		bridgeName))
 	file.WriteString(fmt.Sprintf("interface=%s\n", bridgeName))
@@ -216,6 +230,34 @@ func createDnsmasqConfiglet(
 		file.WriteString(fmt.Sprintf("dhcp-range=%s,static,%s,10m\n",
 			dhcpRange, ipv4Netmask))
 	}
+	if bridgeIPv6 != nil && !isIPv6 {
+		writeDnsmasqIPv6(file, bridgeName, bridgeIPv6, netconf)
+	}
+}
+
+// The applications get the SLAAC address from the router advertisement,
+// and the same address from DHCPv6 using the hosts added by
+// addhostDnsmasq. As for IPv4 a gateway of :: means that we do not
+// advertize ourselves as the default router.
+func writeDnsmasqIPv6(file *os.File, bridgeName string,
+	bridgeIPv6 *net.IPNet, netconf *types.NetworkInstanceConfig) {
+
+	prefix := bridgeIPv6.IP.Mask(bridgeIPv6.Mask)
+	prefixLen, _ := bridgeIPv6.Mask.Size()
+	file.WriteString(fmt.Sprintf("listen-address=%s\n",
+		bridgeIPv6.IP.String()))
+	file.WriteString("enable-ra\n")
+	if netconf.Gateway6 != nil && netconf.Gateway6.IsUnspecified() {
+		log.Infof("createDnsmasqConfiglet: no IPv6 router\n")
+		file.WriteString(fmt.Sprintf("ra-param=%s,600,0\n",
+			bridgeName))
+	}
+	if netconf.DomainName != "" {
+		file.WriteString(fmt.Sprintf("dhcp-option=option6:domain-search,%s\n",
+			netconf.DomainName))
+	}
+	file.WriteString(fmt.Sprintf("dhcp-range=%s,static,slaac,%d,10m\n",
+		prefix.String(), prefixLen))
 }
 
 func addhostDnsmasq(bridgeName string, appMac string, appIPAddr string,
@@ -352,9 +394,20 @@ func startDnsmasq(bridgeName string) {
 	ts := time.Now().Format(time.RFC3339Nano)
 	fmt.Fprintf(w, "%s Starting %s %v\n", ts, name, args)
 	cmd := exec.Command(name, args...)
-	cmd.Stderr = logf
+	// With -d dnsmasq echos its log, including the queries, to stderr
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		log.Fatalf("startDnsmasq StderrPipe failed: %s\n", err)
+	}
 	log.Infof("Calling command %s %v\n", name, args)
-	go cmd.Run()
+	if err := cmd.Start(); err != nil {
+		log.Errorf("startDnsmasq(%s) failed: %s\n", bridgeName, err)
+		return
+	}
+	go func() {
+		scanDnsmasqLog(bridgeName, stderr, logf)
+		cmd.Wait()
+	}()
 }
 
 //    pkill -u nobody -f dnsmasq.${BRIDGENAME}.conf
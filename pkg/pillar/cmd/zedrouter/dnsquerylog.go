@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Parse the log-queries=extra output from dnsmasq to count the DNS queries
+// per application and keep the most recent queries for each bridge
+
+package zedrouter
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// Number of recent queries we keep per bridge
+const maxDnsQueryLog = 256
+
+type dnsQuery struct {
+	id      string // dnsmasq query serial number
+	failed  bool
+	entry   types.DnsQueryEntry
+	counter *types.AppDnsMetric
+}
+
+type dnsQueryStats struct {
+	appStats map[string]*types.AppDnsMetric // Key is client IP
+	pending  map[string]*dnsQuery           // Key is query id
+	queries  []*dnsQuery
+}
+
+// The scanner goroutines update these while the main goroutine
+// reads them when publishing the metrics.
+var dnsStatsLock sync.Mutex
+var dnsStatsMap = make(map[string]*dnsQueryStats) // Key is bridgeName
+
+func lookupOrCreateDnsQueryStats(bridgeName string) *dnsQueryStats {
+	stats, ok := dnsStatsMap[bridgeName]
+	if !ok {
+		stats = &dnsQueryStats{
+			appStats: make(map[string]*types.AppDnsMetric),
+			pending:  make(map[string]*dnsQuery),
+		}
+		dnsStatsMap[bridgeName] = stats
+	}
+	return stats
+}
+
+func deleteDnsQueryStats(bridgeName string) {
+	dnsStatsLock.Lock()
+	delete(dnsStatsMap, bridgeName)
+	dnsStatsLock.Unlock()
+}
+
+// scanDnsmasqLog reads the stderr of dnsmasq until it exits, copying
+// each line to logf
+func scanDnsmasqLog(bridgeName string, r io.Reader, logf io.Writer) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		io.WriteString(logf, line+"\n")
+		parseDnsmasqLogLine(bridgeName, line, time.Now())
+	}
+	if err := scanner.Err(); err != nil {
+		log.Errorf("scanDnsmasqLog(%s) failed: %s\n", bridgeName, err)
+	}
+}
+
+// With log-queries=extra dnsmasq logs one of
+//
+//	dnsmasq: <id> <client>/<port> query[<type>] <name> from <client>
+//	dnsmasq: <id> <client>/<port> <source> <name> is <answer>
+//
+// where source is reply, cached, config, DHCP or a hosts file.
+// Lines which do not follow that format are ignored.
+func parseDnsmasqLogLine(bridgeName string, line string, now time.Time) {
+	i := strings.Index(line, ": ")
+	if i == -1 || !strings.HasPrefix(line, "dnsmasq") {
+		return
+	}
+	fields := strings.Fields(line[i+2:])
+	if len(fields) != 6 || fields[0] == "*" {
+		return
+	}
+	id, source, name, verb, dest := fields[0], fields[2], fields[3],
+		fields[4], fields[5]
+	j := strings.LastIndex(fields[1], "/")
+	if j == -1 {
+		return
+	}
+	clientIP := net.ParseIP(fields[1][:j])
+	if clientIP == nil {
+		return
+	}
+
+	dnsStatsLock.Lock()
+	defer dnsStatsLock.Unlock()
+	stats := lookupOrCreateDnsQueryStats(bridgeName)
+
+	if strings.HasPrefix(source, "query[") && verb == "from" {
+		qtype := strings.TrimSuffix(strings.TrimPrefix(source, "query["),
+			"]")
+		counter, ok := stats.appStats[clientIP.String()]
+		if !ok {
+			counter = &types.AppDnsMetric{AppIP: clientIP}
+			stats.appStats[clientIP.String()] = counter
+		}
+		counter.Queries++
+		q := &dnsQuery{
+			id: id,
+			entry: types.DnsQueryEntry{
+				Time:     now,
+				ClientIP: clientIP,
+				Name:     name,
+				QType:    qtype,
+			},
+			counter: counter,
+		}
+		stats.pending[id] = q
+		stats.queries = append(stats.queries, q)
+		if len(stats.queries) > maxDnsQueryLog {
+			delete(stats.pending, stats.queries[0].id)
+			stats.queries = stats.queries[1:]
+		}
+		return
+	}
+	if verb != "is" {
+		// forwarded etc
+		return
+	}
+	q, ok := stats.pending[id]
+	if !ok {
+		return
+	}
+	q.entry.Answers = append(q.entry.Answers, dest)
+	switch source {
+	case "config":
+		// We only configure address=/domain/ without an address
+		// hence anything from config is due to the DnsPolicy
+		if !q.entry.Blocked {
+			q.entry.Blocked = true
+			q.counter.Blocked++
+		}
+	case "reply":
+		if dest != "NXDOMAIN" && dest != "SERVFAIL" && dest != "REFUSED" {
+			break
+		}
+		if !q.failed {
+			q.failed = true
+			q.counter.Failed++
+		}
+	}
+}
+
+// getDnsMetrics returns the counters and, if LogQueries is set, the
+// recent queries, using the IPAssignments and Vifs to find the AppID
+func getDnsMetrics(status *types.NetworkInstanceStatus) (
+	[]types.AppDnsMetric, []types.DnsQueryEntry) {
+
+	appIDs := make(map[string]uuid.UUID)
+	for mac, ip := range status.IPAssignments {
+		for _, vif := range status.Vifs {
+			if vif.MacAddr == mac {
+				appIDs[ip.String()] = vif.AppID
+				break
+			}
+		}
+	}
+
+	dnsStatsLock.Lock()
+	defer dnsStatsLock.Unlock()
+	stats, ok := dnsStatsMap[status.BridgeName]
+	if !ok {
+		return nil, nil
+	}
+	var appMetrics []types.AppDnsMetric
+	for ipStr, counter := range stats.appStats {
+		m := *counter
+		m.AppID = appIDs[ipStr]
+		appMetrics = append(appMetrics, m)
+	}
+	if !status.DnsPolicy.LogQueries {
+		return appMetrics, nil
+	}
+	var queries []types.DnsQueryEntry
+	for _, q := range stats.queries {
+		entry := q.entry
+		entry.AppID = appIDs[entry.ClientIP.String()]
+		queries = append(queries, entry)
+	}
+	return appMetrics, queries
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+type TestParseDnsmasqLogMatrix struct {
+	lines           []string
+	expectedMetrics []types.AppDnsMetric
+	expectedQueries []types.DnsQueryEntry
+}
+
+func TestParseDnsmasqLogLine(t *testing.T) {
+	now := time.Now()
+	appIP := net.ParseIP("10.1.0.2")
+	otherIP := net.ParseIP("10.1.0.3")
+	testMatrix := map[string]TestParseDnsmasqLogMatrix{
+		"Reply": {
+			lines: []string{
+				"dnsmasq[1234]: 1 10.1.0.2/34567 query[A] example.com from 10.1.0.2",
+				"dnsmasq[1234]: 1 10.1.0.2/34567 forwarded example.com to 8.8.8.8",
+				"dnsmasq[1234]: 1 10.1.0.2/34567 reply example.com is 93.184.216.34",
+			},
+			expectedMetrics: []types.AppDnsMetric{
+				{AppIP: appIP, Queries: 1},
+			},
+			expectedQueries: []types.DnsQueryEntry{
+				{Time: now, ClientIP: appIP, Name: "example.com",
+					QType: "A", Answers: []string{"93.184.216.34"}},
+			},
+		},
+		"CNAME and addresses": {
+			lines: []string{
+				"dnsmasq: 2 10.1.0.2/34568 query[AAAA] www.example.org from 10.1.0.2",
+				"dnsmasq: 2 10.1.0.2/34568 forwarded www.example.org to 8.8.8.8",
+				"dnsmasq: 2 10.1.0.2/34568 reply www.example.org is <CNAME>",
+				"dnsmasq: 2 10.1.0.2/34568 reply example.org is 2606:2800:220:1::1",
+			},
+			expectedMetrics: []types.AppDnsMetric{
+				{AppIP: appIP, Queries: 1},
+			},
+			expectedQueries: []types.DnsQueryEntry{
+				{Time: now, ClientIP: appIP, Name: "www.example.org",
+					QType: "AAAA",
+					Answers: []string{"<CNAME>",
+						"2606:2800:220:1::1"}},
+			},
+		},
+		"Blocked": {
+			lines: []string{
+				"dnsmasq[1234]: 3 10.1.0.3/40000 query[A] ads.example.net from 10.1.0.3",
+				"dnsmasq[1234]: 3 10.1.0.3/40000 config ads.example.net is NXDOMAIN",
+			},
+			expectedMetrics: []types.AppDnsMetric{
+				{AppIP: otherIP, Queries: 1, Blocked: 1},
+			},
+			expectedQueries: []types.DnsQueryEntry{
+				{Time: now, ClientIP: otherIP, Name: "ads.example.net",
+					QType: "A", Answers: []string{"NXDOMAIN"},
+					Blocked: true},
+			},
+		},
+		"Failed": {
+			lines: []string{
+				"dnsmasq[1234]: 4 10.1.0.2/34569 query[A] nosuch.example.com from 10.1.0.2",
+				"dnsmasq[1234]: 4 10.1.0.2/34569 forwarded nosuch.example.com to 8.8.8.8",
+				"dnsmasq[1234]: 4 10.1.0.2/34569 reply nosuch.example.com is NXDOMAIN",
+				"dnsmasq[1234]: 5 10.1.0.2/34570 query[A] example.com from 10.1.0.2",
+				"dnsmasq[1234]: 5 10.1.0.2/34570 cached example.com is 93.184.216.34",
+			},
+			expectedMetrics: []types.AppDnsMetric{
+				{AppIP: appIP, Queries: 2, Failed: 1},
+			},
+			expectedQueries: []types.DnsQueryEntry{
+				{Time: now, ClientIP: appIP, Name: "nosuch.example.com",
+					QType: "A", Answers: []string{"NXDOMAIN"}},
+				{Time: now, ClientIP: appIP, Name: "example.com",
+					QType: "A", Answers: []string{"93.184.216.34"}},
+			},
+		},
+		"Malformed": {
+			lines: []string{
+				"dnsmasq[1234]: started, version 2.80 cachesize 150",
+				"dnsmasq[1234]: * 10.1.0.2/34571 query[A] example.com from 10.1.0.2",
+				"dnsmasq[1234]: 6 10.1.0.2 query[A] example.com from 10.1.0.2",
+				"dnsmasq[1234]: 6 foo/34571 query[A] example.com from 10.1.0.2",
+				"dnsmasq-dhcp[1234]: DHCPACK(bn1) 10.1.0.2 00:16:3e:00:01:02",
+				"not dnsmasq: 6 10.1.0.2/34571 query[A] example.com from 10.1.0.2",
+				"dnsmasq[1234]: 7 10.1.0.2/34572 reply unknown.com is 1.2.3.4",
+				"",
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		bridgeName := "bn-test"
+		deleteDnsQueryStats(bridgeName)
+		for _, line := range test.lines {
+			parseDnsmasqLogLine(bridgeName, line, now)
+		}
+		status := types.NetworkInstanceStatus{}
+		status.BridgeName = bridgeName
+		status.DnsPolicy.LogQueries = true
+		metrics, queries := getDnsMetrics(&status)
+		if !reflect.DeepEqual(metrics, test.expectedMetrics) ||
+			!reflect.DeepEqual(queries, test.expectedQueries) {
+			t.Errorf("Test Failed: %s: Expected %+v %+v, Actual: %+v %+v\n",
+				testname, test.expectedMetrics, test.expectedQueries,
+				metrics, queries)
+		}
+	}
+	deleteDnsQueryStats("bn-test")
+}
+
+func TestGetDnsMetrics(t *testing.T) {
+	now := time.Now()
+	bridgeName := "bn-metrics"
+	appID := uuid.FromStringOrNil("4a144db0-6b63-405a-b884-7760042023b1")
+	appIP := net.ParseIP("10.1.0.2")
+	defer deleteDnsQueryStats(bridgeName)
+
+	status := types.NetworkInstanceStatus{}
+	status.BridgeName = bridgeName
+	status.IPAssignments = map[string]net.IP{
+		"00:16:3e:00:01:02": appIP,
+	}
+	status.Vifs = []types.VifNameMac{
+		{Name: "nbu1x1", MacAddr: "00:16:3e:00:01:02", AppID: appID},
+	}
+
+	// Nothing parsed yet
+	metrics, queries := getDnsMetrics(&status)
+	if metrics != nil || queries != nil {
+		t.Errorf("Expected nothing, Actual: %+v %+v\n", metrics, queries)
+	}
+
+	parseDnsmasqLogLine(bridgeName,
+		"dnsmasq[1]: 1 10.1.0.2/1000 query[A] example.com from 10.1.0.2", now)
+	parseDnsmasqLogLine(bridgeName,
+		"dnsmasq[1]: 1 10.1.0.2/1000 reply example.com is 93.184.216.34", now)
+
+	// Without LogQueries only the counters
+	metrics, queries = getDnsMetrics(&status)
+	expected := []types.AppDnsMetric{
+		{AppID: appID, AppIP: appIP, Queries: 1},
+	}
+	if !reflect.DeepEqual(metrics, expected) || queries != nil {
+		t.Errorf("Expected %+v, Actual: %+v %+v\n", expected,
+			metrics, queries)
+	}
+
+	status.DnsPolicy.LogQueries = true
+	_, queries = getDnsMetrics(&status)
+	expectedQueries := []types.DnsQueryEntry{
+		{Time: now, AppID: appID, ClientIP: appIP, Name: "example.com",
+			QType: "A", Answers: []string{"93.184.216.34"}},
+	}
+	if !reflect.DeepEqual(queries, expectedQueries) {
+		t.Errorf("Expected %+v, Actual: %+v\n", expectedQueries, queries)
+	}
+
+	// Only the most recent queries are kept
+	for i := 0; i < maxDnsQueryLog+10; i++ {
+		parseDnsmasqLogLine(bridgeName,
+			"dnsmasq[1]: 2 10.1.0.2/1001 query[A] example.net from 10.1.0.2",
+			now)
+	}
+	metrics, queries = getDnsMetrics(&status)
+	if len(queries) != maxDnsQueryLog ||
+		queries[0].Name != "example.net" {
+		t.Errorf("Expected %d queries, Actual: %d\n", maxDnsQueryLog,
+			len(queries))
+	}
+	if metrics[0].Queries != maxDnsQueryLog+11 {
+		t.Errorf("Expected %d queries, Actual: %d\n", maxDnsQueryLog+11,
+			metrics[0].Queries)
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// The metadata service for the app instances on Local network instances.
+// Each network instance has an HTTP server on its bridge address to which
+// 169.254.169.254 port 80 is redirected. The app instance is identified by
+// the source address of the request. The paths are those of EC2 and
+// OpenStack so that cloud-init finds the user data, the metadata and the
+// network configuration:
+//	/latest/user-data, /latest/meta-data/<item>,
+//	/latest/meta-data/tags/instance/<key>
+//	/openstack/latest/user_data, /openstack/latest/meta_data.json,
+//	/openstack/latest/network_data.json
+
+package zedrouter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/iptables"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+const (
+	metadataIPAddr = "169.254.169.254"
+	metadataPort   = "80"
+)
+
+// What we serve to one app instance on one bridge
+type appMetadata struct {
+	appKey     string
+	instanceID string
+	hostname   string
+	name       string
+	mac        string
+	ipAddr     string
+	userData   []byte
+	metaData   map[string]string
+	networks   []appNetwork // All the underlays of the app instance
+}
+
+type appNetwork struct {
+	mac     string
+	ipAddr  string // Empty if not on a Local network instance
+	netmask string
+	gateway string // Only for the first Local network instance
+	dns     string
+}
+
+type metadataServer struct {
+	server       *http.Server
+	bridgeIPAddr string
+}
+
+func metadataKey(bridgeName string, ipAddr string) string {
+	return bridgeName + "/" + ipAddr
+}
+
+// Called when a Local network instance is activated
+func startMetadataServer(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	bridgeName := status.BridgeName
+	if _, ok := ctx.metadataServers[bridgeName]; ok {
+		return
+	}
+	if status.BridgeIPAddr == "" {
+		log.Errorf("startMetadataServer(%s) no bridge address\n",
+			bridgeName)
+		return
+	}
+	listener, err := net.Listen("tcp",
+		net.JoinHostPort(status.BridgeIPAddr, metadataPort))
+	if err != nil {
+		log.Errorf("startMetadataServer(%s) failed: %s\n",
+			bridgeName, err)
+		return
+	}
+	err = iptables.IptableCmd("-t", "nat", "-A", "PREROUTING",
+		"-i", bridgeName, "-d", metadataIPAddr, "-p", "tcp",
+		"--dport", metadataPort, "-j", "DNAT", "--to-destination",
+		net.JoinHostPort(status.BridgeIPAddr, metadataPort))
+	if err != nil {
+		log.Errorf("startMetadataServer(%s) failed: %s\n",
+			bridgeName, err)
+		listener.Close()
+		return
+	}
+	server := &http.Server{
+		Handler:      &metadataHandler{ctx: ctx, bridgeName: bridgeName},
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+	ctx.metadataServers[bridgeName] = metadataServer{
+		server:       server,
+		bridgeIPAddr: status.BridgeIPAddr,
+	}
+	log.Infof("startMetadataServer(%s) on %s\n", bridgeName,
+		status.BridgeIPAddr)
+	go func() {
+		err := server.Serve(listener)
+		log.Infof("metadata server %s done: %s\n", bridgeName, err)
+	}()
+}
+
+func stopMetadataServer(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	bridgeName := status.BridgeName
+	ms, ok := ctx.metadataServers[bridgeName]
+	if !ok {
+		return
+	}
+	log.Infof("stopMetadataServer(%s)\n", bridgeName)
+	delete(ctx.metadataServers, bridgeName)
+	err := iptables.IptableCmd("-t", "nat", "-D", "PREROUTING",
+		"-i", bridgeName, "-d", metadataIPAddr, "-p", "tcp",
+		"--dport", metadataPort, "-j", "DNAT", "--to-destination",
+		net.JoinHostPort(ms.bridgeIPAddr, metadataPort))
+	if err != nil {
+		log.Errorf("stopMetadataServer(%s) failed: %s\n",
+			bridgeName, err)
+	}
+	ms.server.Close()
+}
+
+// Called when the app network is activated or modified. Replaces what
+// we serve to the app instance.
+func updateAppMetadata(ctx *zedrouterContext, config types.AppNetworkConfig,
+	status *types.AppNetworkStatus) {
+
+	key := config.Key()
+	if !status.Activated || !config.MetaDataType.WantService() {
+		removeAppMetadata(ctx, key)
+		return
+	}
+	md := appMetadata{
+		appKey: key,
+		instanceID: fmt.Sprintf("%s/%s", key,
+			config.UUIDandVersion.Version),
+		hostname: key,
+		name:     config.DisplayName,
+		metaData: config.MetaData,
+	}
+	if config.CloudInitUserData != "" {
+		ud, err := base64.StdEncoding.DecodeString(config.CloudInitUserData)
+		if err != nil {
+			log.Errorf("updateAppMetadata(%s) bad user data: %s\n",
+				key, err)
+		} else {
+			md.userData = ud
+		}
+	}
+	// The bridges of the Local network instances we serve on
+	var bridges []appNetwork
+	var bridgeNames []string
+	haveGateway := false
+	for _, ulStatus := range status.UnderlayNetworkList {
+		network := appNetwork{mac: ulStatus.Mac}
+		netInstStatus := lookupNetworkInstanceStatus(ctx,
+			ulStatus.Network.String())
+		if netInstStatus != nil &&
+			netInstStatus.Type == types.NetworkInstanceTypeLocal &&
+			ulStatus.AssignedIPAddr != "" {
+
+			network.ipAddr = ulStatus.AssignedIPAddr
+			network.netmask = net.IP(netInstStatus.Subnet.Mask).String()
+			network.dns = ulStatus.BridgeIPAddr
+			if !haveGateway {
+				network.gateway = ulStatus.BridgeIPAddr
+				haveGateway = true
+			}
+			bridges = append(bridges, network)
+			bridgeNames = append(bridgeNames, ulStatus.Bridge)
+		}
+		md.networks = append(md.networks, network)
+	}
+	ctx.metadataLock.Lock()
+	defer ctx.metadataLock.Unlock()
+	removeAppMetadataLocked(ctx, key)
+	for i, network := range bridges {
+		entry := md
+		entry.mac = network.mac
+		entry.ipAddr = network.ipAddr
+		ctx.appMetadata[metadataKey(bridgeNames[i], network.ipAddr)] = entry
+	}
+	log.Infof("updateAppMetadata(%s) on %v\n", key, bridgeNames)
+}
+
+// Called when the app network is inactivated
+func removeAppMetadata(ctx *zedrouterContext, key string) {
+	ctx.metadataLock.Lock()
+	defer ctx.metadataLock.Unlock()
+	removeAppMetadataLocked(ctx, key)
+}
+
+func removeAppMetadataLocked(ctx *zedrouterContext, key string) {
+	for k, md := range ctx.appMetadata {
+		if md.appKey == key {
+			delete(ctx.appMetadata, k)
+		}
+	}
+}
+
+type metadataHandler struct {
+	ctx        *zedrouterContext
+	bridgeName string
+}
+
+func (h *metadataHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.ctx.metadataLock.Lock()
+	md, ok := h.ctx.appMetadata[metadataKey(h.bridgeName, host)]
+	h.ctx.metadataLock.Unlock()
+	if !ok {
+		log.Warnf("metadata request %s from unknown %s on %s\n",
+			r.URL.Path, host, h.bridgeName)
+		http.NotFound(w, r)
+		return
+	}
+	content, contentType := md.file(strings.TrimSuffix(r.URL.Path, "/"))
+	if content == nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(content)
+}
+
+// Returns nil if there is no such file
+func (md appMetadata) file(path string) ([]byte, string) {
+	const text = "text/plain"
+	const jsonType = "application/json"
+
+	switch path {
+	case "":
+		return listing("latest", "openstack"), text
+	case "/latest":
+		return listing("meta-data/", "user-data"), text
+	case "/latest/user-data", "/openstack/latest/user_data":
+		if md.userData == nil {
+			return nil, ""
+		}
+		return md.userData, "application/octet-stream"
+	case "/latest/meta-data":
+		return listing("hostname", "instance-id", "local-hostname",
+			"local-ipv4", "mac", "tags/"), text
+	case "/latest/meta-data/hostname", "/latest/meta-data/local-hostname":
+		return []byte(md.hostname), text
+	case "/latest/meta-data/instance-id":
+		return []byte(md.instanceID), text
+	case "/latest/meta-data/local-ipv4":
+		return []byte(md.ipAddr), text
+	case "/latest/meta-data/mac":
+		return []byte(md.mac), text
+	case "/latest/meta-data/tags":
+		return listing("instance/"), text
+	case "/latest/meta-data/tags/instance":
+		var keys []string
+		for k := range md.metaData {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return listing(keys...), text
+	case "/openstack":
+		return listing("latest"), text
+	case "/openstack/latest":
+		return listing("meta_data.json", "network_data.json",
+			"user_data"), text
+	case "/openstack/latest/meta_data.json":
+		return md.metaDataJSON(), jsonType
+	case "/openstack/latest/network_data.json":
+		return md.networkDataJSON(), jsonType
+	}
+	if strings.HasPrefix(path, "/latest/meta-data/tags/instance/") {
+		k := strings.TrimPrefix(path, "/latest/meta-data/tags/instance/")
+		if v, ok := md.metaData[k]; ok {
+			return []byte(v), text
+		}
+	}
+	return nil, ""
+}
+
+func listing(names ...string) []byte {
+	return []byte(strings.Join(names, "\n") + "\n")
+}
+
+func (md appMetadata) metaDataJSON() []byte {
+	meta := md.metaData
+	if meta == nil {
+		meta = map[string]string{}
+	}
+	out, _ := json.Marshal(struct {
+		UUID             string            `json:"uuid"`
+		Name             string            `json:"name"`
+		Hostname         string            `json:"hostname"`
+		AvailabilityZone string            `json:"availability_zone"`
+		Meta             map[string]string `json:"meta"`
+	}{
+		UUID:     md.appKey,
+		Name:     md.name,
+		Hostname: md.hostname,
+		Meta:     meta,
+	})
+	return out
+}
+
+// The underlays on Local network instances get their address and the
+// others DHCP
+func (md appMetadata) networkDataJSON() []byte {
+	type link struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Mac  string `json:"ethernet_mac_address"`
+	}
+	type route struct {
+		Network string `json:"network"`
+		Netmask string `json:"netmask"`
+		Gateway string `json:"gateway"`
+	}
+	type network struct {
+		ID        string  `json:"id"`
+		Link      string  `json:"link"`
+		Type      string  `json:"type"`
+		IPAddress string  `json:"ip_address,omitempty"`
+		Netmask   string  `json:"netmask,omitempty"`
+		Routes    []route `json:"routes,omitempty"`
+	}
+	type service struct {
+		Type    string `json:"type"`
+		Address string `json:"address"`
+	}
+	data := struct {
+		Links    []link    `json:"links"`
+		Networks []network `json:"networks"`
+		Services []service `json:"services"`
+	}{
+		Links:    []link{},
+		Networks: []network{},
+		Services: []service{},
+	}
+	dns := make(map[string]bool)
+	for i, an := range md.networks {
+		linkID := fmt.Sprintf("interface%d", i)
+		data.Links = append(data.Links,
+			link{ID: linkID, Type: "phy", Mac: an.mac})
+		n := network{
+			ID:   fmt.Sprintf("network%d", i),
+			Link: linkID,
+			Type: "ipv4_dhcp",
+		}
+		if an.ipAddr != "" {
+			n.Type = "ipv4"
+			n.IPAddress = an.ipAddr
+			n.Netmask = an.netmask
+			if an.gateway != "" {
+				n.Routes = []route{{Network: "0.0.0.0",
+					Netmask: "0.0.0.0", Gateway: an.gateway}}
+			}
+			if an.dns != "" && !dns[an.dns] {
+				dns[an.dns] = true
+				data.Services = append(data.Services,
+					service{Type: "dns", Address: an.dns})
+			}
+		}
+		data.Networks = append(data.Networks, n)
+	}
+	out, _ := json.Marshal(data)
+	return out
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -181,11 +182,18 @@ func doCreateBridge(bridgeName string, bridgeNum int,
 	}
 	attrs.HardwareAddr = hw
 	link = &netlink.Bridge{LinkAttrs: attrs}
+	if isVlanTrunk(status) {
+		vlanFiltering := true
+		link.VlanFiltering = &vlanFiltering
+	}
 	if err := netlink.LinkAdd(link); err != nil {
 		errStr := fmt.Sprintf("LinkAdd on %s failed: %s",
 			bridgeName, err)
 		return errors.New(errStr), ""
 	}
+	if isVlanTrunk(status) {
+		enableBridgeVlanStats(bridgeName)
+	}
 	//    ip link set ${bridgeName} up
 	if err := netlink.LinkSetUp(link); err != nil {
 		errStr := fmt.Sprintf("LinkSetUp on %s failed: %s",
@@ -339,7 +347,7 @@ func doBridgeAclsDelete(
 			err := deleteACLConfiglet(olStatus.Bridge,
 				olStatus.Vif, false, olStatus.ACLs,
 				olStatus.BridgeIPAddr,
-				olStatus.EID.String())
+				olStatus.EID.String(), 0)
 			if err != nil {
 				log.Errorf("doNetworkDelete ACL failed: %s\n",
 					err)
@@ -356,7 +364,8 @@ func doBridgeAclsDelete(
 				ulStatus.Name)
 			err := deleteACLConfiglet(ulStatus.Bridge,
 				ulStatus.Vif, false, ulStatus.ACLs,
-				ulStatus.BridgeIPAddr, ulStatus.AssignedIPAddr)
+				ulStatus.BridgeIPAddr, ulStatus.AssignedIPAddr,
+				ulStatus.ProbePort)
 			if err != nil {
 				log.Errorf("NetworkInstance DeleteACL failed: %s\n",
 					err)
@@ -400,8 +409,9 @@ func handleNetworkInstanceCreate(
 	status := types.NetworkInstanceStatus{
 		NetworkInstanceConfig: config,
 		NetworkInstanceInfo: types.NetworkInstanceInfo{
-			IPAssignments: make(map[string]net.IP),
-			VifMetricMap:  make(map[string]types.NetworkMetric),
+			IPAssignments:   make(map[string]net.IP),
+			IPv6Assignments: make(map[string]net.IP),
+			VifMetricMap:    make(map[string]types.NetworkMetric),
 		},
 	}
 
@@ -435,6 +445,8 @@ func handleNetworkInstanceCreate(
 	publishNetworkInstanceStatus(ctx, &status)
 	// Hooks for updating dependent objects
 	checkAndRecreateAppNetwork(ctx, config.UUID)
+	updateMetricsExportACLs(ctx, newMetricsExportConfig(ctx,
+		metricsExport.port, metricsExport.listen, metricsExport.allow))
 	log.Infof("handleNetworkInstanceCreate(%s) done\n", key)
 }
 
@@ -459,6 +471,8 @@ func handleNetworkInstanceDelete(ctxArg interface{}, key string,
 	pub.Unpublish(status.Key())
 
 	deleteNetworkInstanceMetrics(ctx, status.Key())
+	updateMetricsExportACLs(ctx, newMetricsExportConfig(ctx,
+		metricsExport.port, metricsExport.listen, metricsExport.allow))
 	log.Infof("handleNetworkInstanceDelete(%s) done\n", key)
 }
 
@@ -506,7 +520,7 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	if status.BridgeIPAddr != "" {
 		// XXX arbitrary name "router"!!
 		addToHostsConfiglet(hostsDirpath, "router",
-			[]string{status.BridgeIPAddr})
+			bridgeAddrs(&status.NetworkInstanceInfo))
 	}
 
 	// Start clean
@@ -516,7 +530,8 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	if status.BridgeIPAddr != "" {
 		createDnsmasqConfiglet(bridgeName,
 			status.BridgeIPAddr, &status.NetworkInstanceConfig,
-			hostsDirpath, status.BridgeIPSets, status.Ipv4Eid)
+			hostsDirpath, status.BridgeIPSets, status.Ipv4Eid,
+			getBridgeIPv6(&status.NetworkInstanceInfo))
 		startDnsmasq(bridgeName)
 	}
 
@@ -593,6 +608,57 @@ func doNetworkInstanceSanityCheck(
 		return errors.New(err)
 	}
 
+	if status.IPv6Mode != types.IPv6ModeNone {
+		if err := doNetworkInstanceIPv6SanityCheck(ctx, status); err != nil {
+			return err
+		}
+	}
+	if len(status.VlanTrunk) != 0 {
+		if status.Type != types.NetworkInstanceTypeSwitch {
+			return errors.New("VlanTrunk only supported for Switch network instance")
+		}
+		port := types.GetPort(*ctx.deviceNetworkStatus, status.Port)
+		if port != nil && port.Vlan.VlanID != 0 {
+			err := fmt.Sprintf("VlanTrunk not supported on VLAN port %s\n",
+				status.Port)
+			return errors.New(err)
+		}
+	}
+	return nil
+}
+
+func doNetworkInstanceIPv6SanityCheck(
+	ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	if status.Type != types.NetworkInstanceTypeLocal {
+		err := fmt.Sprintf("IPv6Mode %d only supported for Local network instance\n",
+			status.IPv6Mode)
+		return errors.New(err)
+	}
+	if status.IpType != types.AddressTypeIPV4 {
+		err := fmt.Sprintf("IPv6Mode %d requires IpType IPv4 not %d\n",
+			status.IPv6Mode, status.IpType)
+		return errors.New(err)
+	}
+	if status.Subnet6.IP == nil {
+		// Derived when the bridge is created
+		return nil
+	}
+	for _, iterStatusEntry := range ctx.networkInstanceStatusMap {
+		if status == iterStatusEntry || !iterStatusEntry.HasIPv6() {
+			continue
+		}
+		if iterStatusEntry.IPv6Subnet.Contains(status.Subnet6.IP) ||
+			status.Subnet6.Contains(iterStatusEntry.IPv6Subnet.IP) {
+			errStr := fmt.Sprintf("Subnet6(%s) overlaps with another "+
+				"network instance(%s-%s) IPv6 Subnet(%s)\n",
+				status.Subnet6.String(),
+				iterStatusEntry.DisplayName, iterStatusEntry.UUID,
+				iterStatusEntry.IPv6Subnet.String())
+			return errors.New(errStr)
+		}
+	}
 	return nil
 }
 
@@ -695,6 +761,41 @@ func doNetworkInstanceModify(ctx *zedrouterContext,
 		return
 	}
 
+	if !reflect.DeepEqual(config.VlanTrunk, status.VlanTrunk) {
+		status.SetError(
+			errors.New("Changing VlanTrunk in NetworkInstance is not yet supported"))
+		return
+	}
+
+	if config.IPv6Mode != status.IPv6Mode ||
+		!reflect.DeepEqual(config.Subnet6, status.Subnet6) ||
+		!config.Gateway6.Equal(status.Gateway6) {
+		status.SetError(
+			errors.New("Changing IPv6 in NetworkInstance is not yet supported"))
+		return
+	}
+
+	if !reflect.DeepEqual(config.DnsPolicy, status.DnsPolicy) {
+		log.Infof("doNetworkInstanceModify: DnsPolicy changed from %v to %v\n",
+			status.DnsPolicy, config.DnsPolicy)
+		status.DnsPolicy = config.DnsPolicy
+		if status.BridgeIPAddr != "" {
+			restartDnsmasq(status)
+		}
+	}
+
+	if !reflect.DeepEqual(config.UplinkPolicy, status.UplinkPolicy) {
+		log.Infof("doNetworkInstanceModify: UplinkPolicy changed from %v to %v\n",
+			status.UplinkPolicy, config.UplinkPolicy)
+		status.UplinkPolicy = config.UplinkPolicy
+		if status.Activated && status.Type == types.NetworkInstanceTypeLocal {
+			if status.UplinkPolicy.Mode == types.UplinkModeFlowHash {
+				enableMultipathFlowHash()
+			}
+			updateUplinks(ctx, status)
+		}
+	}
+
 	if config.Activate && !status.Activated {
 		err := doNetworkInstanceActivate(ctx, status)
 		if err != nil {
@@ -756,12 +857,12 @@ func restartDnsmasq(status *types.NetworkInstanceStatus) {
 	hostsDirpath := runDirname + "/hosts." + bridgeName
 	// XXX arbitrary name "router"!!
 	addToHostsConfiglet(hostsDirpath, "router",
-		[]string{status.BridgeIPAddr})
+		bridgeAddrs(&status.NetworkInstanceInfo))
 
 	// Use existing BridgeIPSets
 	createDnsmasqConfiglet(bridgeName, status.BridgeIPAddr,
 		&status.NetworkInstanceConfig, hostsDirpath, status.BridgeIPSets,
-		status.Ipv4Eid)
+		status.Ipv4Eid, getBridgeIPv6(&status.NetworkInstanceInfo))
 	startDnsmasq(bridgeName)
 }
 
@@ -853,6 +954,152 @@ func releaseIPv4FromNetworkInstance(ctx *zedrouterContext,
 	return nil
 }
 
+// Returns the SLAAC address the app with the mac will pick in the
+// IPv6 subnet of the network instance. We record it so that it can be
+// placed in the hosts file and in the ACLs.
+func lookupOrAllocateIPv6(
+	ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus,
+	mac net.HardwareAddr) (string, error) {
+
+	log.Infof("lookupOrAllocateIPv6(%s-%s): mac:%s\n",
+		status.DisplayName, status.Key(), mac.String())
+	if ip, ok := status.IPv6Assignments[mac.String()]; ok {
+		return ip.String(), nil
+	}
+	ip := types.SlaacAddr(status.IPv6Subnet, mac)
+	if ip == nil {
+		errStr := fmt.Sprintf("lookupOrAllocateIPv6(%s) no address for %s in %s",
+			status.Key(), mac.String(), status.IPv6Subnet.String())
+		return "", errors.New(errStr)
+	}
+	status.IPv6Assignments[mac.String()] = ip
+	publishNetworkInstanceStatus(ctx, status)
+	return ip.String(), nil
+}
+
+func releaseIPv6FromNetworkInstance(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus,
+	mac net.HardwareAddr) {
+
+	log.Infof("releaseIPv6(%s)\n", mac.String())
+	if _, ok := status.IPv6Assignments[mac.String()]; !ok {
+		log.Warnf("releaseIPv6: not found %s for %s\n",
+			mac.String(), status.Key())
+		return
+	}
+	delete(status.IPv6Assignments, mac.String())
+	publishNetworkInstanceStatus(ctx, status)
+}
+
+// getBridgeIPv6 returns the IPv6 address and prefix of the bridge,
+// or nil if the network instance does not have IPv6
+func getBridgeIPv6(info *types.NetworkInstanceInfo) *net.IPNet {
+	if !info.HasIPv6() {
+		return nil
+	}
+	return &net.IPNet{IP: net.ParseIP(info.BridgeIPv6Addr),
+		Mask: info.IPv6Subnet.Mask}
+}
+
+// bridgeAddrs returns the IPv4 and any IPv6 address of the bridge
+func bridgeAddrs(info *types.NetworkInstanceInfo) []string {
+	addrs := []string{info.BridgeIPAddr}
+	if info.HasIPv6() {
+		addrs = append(addrs, info.BridgeIPv6Addr)
+	}
+	return addrs
+}
+
+// setBridgeIPv6Addr determines the IPv6 subnet for a Local network
+// instance with an IPv6Mode and assigns the gateway to the bridge
+func setBridgeIPv6Addr(
+	ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus,
+	link netlink.Link) error {
+
+	if status.HasIPv6() {
+		return nil
+	}
+	subnet := status.Subnet6
+	if subnet.IP == nil {
+		var err error
+		subnet, err = deriveIPv6Subnet(ctx, status)
+		if err != nil {
+			return err
+		}
+	}
+	// An unspecified Gateway6 means we do not advertize a default
+	// router, but the bridge still needs an address
+	gateway := status.Gateway6
+	if gateway == nil || gateway.IsUnspecified() {
+		gateway = make(net.IP, net.IPv6len)
+		copy(gateway, subnet.IP.To16())
+		gateway[net.IPv6len-1] = 1
+	}
+	prefixLen, _ := subnet.Mask.Size()
+	ipAddr := gateway.String()
+	if err := doConfigureIpAddrOnInterface(ipAddr, prefixLen, link); err != nil {
+		log.Errorf("Failed to configure IPv6 Addr on Interface\n")
+		return err
+	}
+	status.BridgeIPv6Addr = ipAddr
+	status.IPv6Subnet = subnet
+	publishNetworkInstanceStatus(ctx, status)
+	log.Infof("setBridgeIPv6Addr(%s) %s in %s\n",
+		status.BridgeName, ipAddr, subnet.String())
+	return nil
+}
+
+// deriveIPv6Subnet picks a /64 when none is configured.
+// For NAT66 we use a ULA prefix based on the UUID of the network instance.
+// For routed we carve one /64 out of the prefix delegated to the port
+// using the bridgeNum.
+func deriveIPv6Subnet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) (net.IPNet, error) {
+
+	mask := net.CIDRMask(64, 128)
+	switch status.IPv6Mode {
+	case types.IPv6ModeNAT66:
+		ip := make(net.IP, net.IPv6len)
+		ip[0] = 0xfd
+		copy(ip[1:6], status.UUID[0:5])
+		ip[6] = byte(status.BridgeNum >> 8)
+		ip[7] = byte(status.BridgeNum)
+		return net.IPNet{IP: ip, Mask: mask}, nil
+	case types.IPv6ModeRouted:
+		for _, ifname := range adapterToIfNames(ctx, status.Port) {
+			port := ctx.deviceNetworkStatus.GetPortByIfName(ifname)
+			if port == nil || port.DelegatedPrefix.IP == nil {
+				continue
+			}
+			prefixLen, _ := port.DelegatedPrefix.Mask.Size()
+			if prefixLen > 64 {
+				continue
+			}
+			ip := make(net.IP, net.IPv6len)
+			copy(ip, port.DelegatedPrefix.IP.To16())
+			if prefixLen < 64 {
+				// Use bridgeNum as the subnet id within the prefix
+				subnetBits := uint(64 - prefixLen)
+				if subnetBits > 16 {
+					subnetBits = 16
+				}
+				id := uint16(status.BridgeNum) & uint16(1<<subnetBits-1)
+				ip[6] |= byte(id >> 8)
+				ip[7] |= byte(id)
+			}
+			return net.IPNet{IP: ip, Mask: mask}, nil
+		}
+		errStr := fmt.Sprintf("No delegated IPv6 prefix on port %s for %s",
+			status.Port, status.Key())
+		return net.IPNet{}, errors.New(errStr)
+	default:
+		errStr := fmt.Sprintf("IPv6Mode %d not supported", status.IPv6Mode)
+		return net.IPNet{}, errors.New(errStr)
+	}
+}
+
 func getPrefixLenForBridgeIP(
 	status *types.NetworkInstanceStatus) int {
 	var prefixLen int
@@ -1027,6 +1274,14 @@ func setBridgeIPAddr(
 		log.Infof("Restart Radvd\n")
 		restartRadvdWithNewConfig(status.BridgeName)
 	}
+	if status.Type == types.NetworkInstanceTypeLocal &&
+		status.IPv6Mode != types.IPv6ModeNone {
+		// Applications still get IPv4 if we can't do IPv6
+		if err := setBridgeIPv6Addr(ctx, status, link); err != nil {
+			log.Errorf("setBridgeIPv6Addr(%s) failed: %s\n",
+				status.Key(), err)
+		}
+	}
 	return nil
 }
 
@@ -1103,6 +1358,9 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 		}
 	case types.NetworkInstanceTypeLocal:
 		err = natActivate(ctx, status)
+		if err == nil {
+			startMetadataServer(ctx, status)
+		}
 	case types.NetworkInstanceTypeCloud:
 		err = vpnActivate(ctx, status)
 	case types.NetworkInstanceTypeMesh:
@@ -1169,6 +1427,7 @@ func doNetworkInstanceInactivate(
 
 	bridgeInactivateforNetworkInstance(ctx, status)
 	natInactivate(ctx, status)
+	stopMetadataServer(ctx, status)
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
 		vpnInactivate(ctx, status)
@@ -1202,6 +1461,7 @@ func doNetworkInstanceDelete(
 	doBridgeAclsDelete(ctx, status)
 	if status.BridgeName != "" {
 		stopDnsmasq(status.BridgeName, false, false)
+		deleteDnsQueryStats(status.BridgeName)
 
 		if status.IsIPv6() {
 			stopRadvd(status.BridgeName, true)
@@ -1266,6 +1526,7 @@ func createNetworkInstanceMetrics(ctx *zedrouterContext,
 
 	netMetrics.MetricList = []types.NetworkMetric{*netMetric}
 	niMetrics.NetworkMetrics = netMetrics
+	niMetrics.AppDnsMetrics, niMetrics.DnsQueries = getDnsMetrics(status)
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
 		if strongSwanVpnStatusGet(ctx, status, &niMetrics) {
@@ -1349,6 +1610,13 @@ func bridgeActivate(ctx *zedrouterContext,
 	}
 	log.Infof("bridgeActivate: added %s to bridge %s\n",
 		status.Port, status.BridgeName)
+	if isVlanTrunk(status) {
+		if err := addTrunkVlans(alink, status.VlanTrunk); err != nil {
+			return err
+		}
+		log.Infof("bridgeActivate: trunk vlans %v on %s\n",
+			status.VlanTrunk, status.Port)
+	}
 	return nil
 }
 
@@ -1436,15 +1704,34 @@ func natActivate(ctx *zedrouterContext,
 			log.Errorf("IptableCmd failed: %s", err)
 			return err
 		}
-		err = PbrRouteAddDefault(status.BridgeName, a)
+		if !status.HasIPv6() {
+			continue
+		}
+		if status.IPv6Mode == types.IPv6ModeNAT66 {
+			err = iptables.Ip6tableCmd("-t", "nat", "-A", "POSTROUTING",
+				"-o", a, "-s", status.IPv6Subnet.String(),
+				"-j", "MASQUERADE")
+			if err != nil {
+				log.Errorf("Ip6tableCmd failed: %s", err)
+				return err
+			}
+		}
+		err = PbrRouteAddDefaultIPv6(status.BridgeName, a)
 		if err != nil {
-			log.Errorf("PbrRouteAddDefault for Bridge(%s) and interface %s failed. "+
+			log.Errorf("PbrRouteAddDefaultIPv6 for Bridge(%s) and interface %s failed. "+
 				"Err: %s", status.BridgeName, a, err)
 			return err
 		}
 	}
+	// The IPv4 default route(s) depend on the UplinkPolicy
+	err := uplinkActivate(ctx, status)
+	if err != nil {
+		log.Errorf("uplinkActivate for Bridge(%s) failed. Err: %s",
+			status.BridgeName, err)
+		return err
+	}
 	// Add to Pbr table
-	err := PbrNATAdd(subnetStr)
+	err = PbrNATAdd(subnetStr)
 	if err != nil {
 		log.Errorf("PbrNATAdd failed for port %s - err = %s\n", status.Port, err)
 		return err
@@ -1498,11 +1785,23 @@ func natInactivate(ctx *zedrouterContext,
 		if err != nil {
 			log.Errorf("natInactivate: iptableCmd failed %s\n", err)
 		}
-		err = PbrRouteDeleteDefault(status.BridgeName, a)
+		if !status.HasIPv6() {
+			continue
+		}
+		if status.IPv6Mode == types.IPv6ModeNAT66 {
+			err = iptables.Ip6tableCmd("-t", "nat", "-D", "POSTROUTING",
+				"-o", a, "-s", status.IPv6Subnet.String(),
+				"-j", "MASQUERADE")
+			if err != nil {
+				log.Errorf("natInactivate: ip6tableCmd failed %s\n", err)
+			}
+		}
+		err = PbrRouteDeleteDefaultIPv6(status.BridgeName, a)
 		if err != nil {
-			log.Errorf("natInactivate: PbrRouteDeleteDefault failed %s\n", err)
+			log.Errorf("natInactivate: PbrRouteDeleteDefaultIPv6 failed %s\n", err)
 		}
 	}
+	uplinkInactivate(ctx, status)
 	// Remove from Pbr table
 	err := PbrNATDel(subnetStr)
 	if err != nil {
@@ -24,6 +24,10 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 	}
 	// Call iptables once to get counters
 	ac := iptables.FetchIprulesCounters()
+	// Ditto for the per VLAN counters
+	vlanMetrics := getVlanMetrics(ctx)
+	// and the tc counters for the vifs with an AppQos
+	qosMetrics := getQosMetrics(ctx)
 
 	for _, ni := range network {
 		metric := types.NetworkMetric{
@@ -37,6 +41,8 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 			TxErrors: ni.Errout,
 			RxErrors: ni.Errin,
 		}
+		metric.VlanMetrics = vlanMetrics[ni.Name]
+		metric.QosMetric = qosMetrics[ni.Name]
 		bridgeName := ni.Name
 		vifName := ""
 		inout := true
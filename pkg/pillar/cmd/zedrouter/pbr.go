@@ -35,6 +35,17 @@ func PbrInit(ctx *zedrouterContext) {
 
 // Add a default route for the bridgeName table to the specific port
 func PbrRouteAddDefault(bridgeName string, port string) error {
+	return pbrRouteAddDefault(bridgeName, port, getDefaultIPv4Route)
+}
+
+// Add an IPv6 default route for the bridgeName table to the specific port
+func PbrRouteAddDefaultIPv6(bridgeName string, port string) error {
+	return pbrRouteAddDefault(bridgeName, port, getDefaultIPv6Route)
+}
+
+func pbrRouteAddDefault(bridgeName string, port string,
+	getRoute func(ifindex int) *netlink.Route) error {
+
 	log.Infof("PbrRouteAddDefault(%s, %s)\n", bridgeName, port)
 
 	ifindex, err := devicenetwork.IfnameToIndex(port)
@@ -44,7 +55,7 @@ func PbrRouteAddDefault(bridgeName string, port string) error {
 		log.Errorln(errStr)
 		return errors.New(errStr)
 	}
-	rt := getDefaultIPv4Route(ifindex)
+	rt := getRoute(ifindex)
 	if rt == nil {
 		log.Warnf("PbrRouteAddDefault(%s, %s) no default route\n",
 			bridgeName, port)
@@ -92,6 +103,17 @@ func PbrRouteAddDefault(bridgeName string, port string) error {
 
 // Delete the default route for the bridgeName table to the specific port
 func PbrRouteDeleteDefault(bridgeName string, port string) error {
+	return pbrRouteDeleteDefault(bridgeName, port, getDefaultIPv4Route)
+}
+
+// Delete the IPv6 default route for the bridgeName table to the specific port
+func PbrRouteDeleteDefaultIPv6(bridgeName string, port string) error {
+	return pbrRouteDeleteDefault(bridgeName, port, getDefaultIPv6Route)
+}
+
+func pbrRouteDeleteDefault(bridgeName string, port string,
+	getRoute func(ifindex int) *netlink.Route) error {
+
 	log.Infof("PbrRouteDeleteDefault(%s, %s)\n", bridgeName, port)
 
 	ifindex, err := devicenetwork.IfnameToIndex(port)
@@ -101,7 +123,7 @@ func PbrRouteDeleteDefault(bridgeName string, port string) error {
 		log.Errorln(errStr)
 		return errors.New(errStr)
 	}
-	rt := getDefaultIPv4Route(ifindex)
+	rt := getRoute(ifindex)
 	if rt == nil {
 		log.Warnf("PbrRouteDeleteDefault(%s, %s) no default route\n",
 			bridgeName, port)
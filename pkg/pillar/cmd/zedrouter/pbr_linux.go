@@ -20,26 +20,36 @@ import (
 
 // Return the first default route for one interface. XXX or return all?
 func getDefaultIPv4Route(ifindex int) *netlink.Route {
+	return getDefaultRoute(ifindex, syscall.AF_INET)
+}
+
+// Return the first IPv6 default route for one interface.
+func getDefaultIPv6Route(ifindex int) *netlink.Route {
+	return getDefaultRoute(ifindex, syscall.AF_INET6)
+}
+
+func getDefaultRoute(ifindex int, family int) *netlink.Route {
 	table := syscall.RT_TABLE_MAIN
 	// Default route is nil Dst.
 	filter := netlink.Route{Table: table, LinkIndex: ifindex, Dst: nil}
 	fflags := netlink.RT_FILTER_TABLE
 	fflags |= netlink.RT_FILTER_OIF
 	fflags |= netlink.RT_FILTER_DST
-	log.Infof("getDefaultIPv4Route(%d) filter %v\n", ifindex, filter)
-	routes, err := netlink.RouteListFiltered(syscall.AF_INET,
+	log.Infof("getDefaultRoute(%d, %d) filter %v\n", ifindex, family,
+		filter)
+	routes, err := netlink.RouteListFiltered(family,
 		&filter, fflags)
 	if err != nil {
 		log.Fatalf("RouteList failed: %v\n", err)
 	}
-	log.Debugf("getDefaultIPv4Route(%d) - got %d matches\n",
-		ifindex, len(routes))
+	log.Debugf("getDefaultRoute(%d, %d) - got %d matches\n",
+		ifindex, family, len(routes))
 	for _, rt := range routes {
 		if rt.LinkIndex != ifindex {
 			continue
 		}
-		log.Debugf("getDefaultIPv4Route(%d) returning %v\n",
-			ifindex, rt)
+		log.Debugf("getDefaultRoute(%d, %d) returning %v\n",
+			ifindex, family, rt)
 		return &rt
 	}
 	return nil
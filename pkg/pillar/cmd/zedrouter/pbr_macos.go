@@ -17,6 +17,10 @@ func getDefaultIPv4Route(ifindex int) *netlink.Route {
 	return nil
 }
 
+func getDefaultIPv6Route(ifindex int) *netlink.Route {
+	return nil
+}
+
 func getDefaultRouteTable() int {
 	return 0
 }
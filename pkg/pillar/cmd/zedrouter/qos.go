@@ -0,0 +1,499 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Per app traffic shaping using tc on the vif for the underlay network.
+// Traffic sent to the app leaves the vif hence it is shaped by an htb
+// class on the root qdisc of the vif.
+// Traffic sent by the app arrives on the vif. It is redirected to an IFB
+// device per bridge which has an htb class per app with the rate and ceil
+// from the EgressRate and the htb prio from the Priority, hence the apps
+// on the same network instance are shaped together.
+// The priority also sets the IPv4 TOS field and the IPv6 traffic class of
+// the traffic sent by the app, which can be used by the upstream routers,
+// and the minor number of the class is chosen such that the skb priority
+// used for the classification selects the matching band in the
+// pfifo_fast qdisc of the port.
+// The vifs are created by domainmgr after we activate the app network,
+// hence we apply the AppQos when we see the vif being added to the bridge.
+// Only the x86_64 kernels have the tc classifiers and actions; elsewhere
+// the tc commands fail and are logged.
+
+package zedrouter
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/eriknordmark/netlink"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+)
+
+const (
+	// The root class of the IFB; the apps share this
+	qosIfbRate = "10gbit"
+	// Guaranteed to apps with a priority but no EgressRate
+	qosMinRate = "1mbit"
+)
+
+// vifQosState is what we applied to a vif. If the vif is recreated
+// it gets a new ifindex and we need to apply again.
+type vifQosState struct {
+	ifindex    int
+	bridgeName string
+	minor      uint16 // Of the class on the IFB; zero if none
+	qos        types.AppQos
+}
+
+// maybeQosVif applies the AppQos when a vif is added to a bridge
+func maybeQosVif(ctx *zedrouterContext, change netlink.LinkUpdate) {
+
+	ifname := change.Attrs().Name
+	if !strings.HasPrefix(ifname, "nbu") || change.Attrs().MasterIndex == 0 {
+		return
+	}
+	pub := ctx.pubAppNetworkStatus
+	for _, st := range pub.GetAll() {
+		status := cast.CastAppNetworkStatus(st)
+		for _, ulStatus := range status.UnderlayNetworkList {
+			if ulStatus.Vif != ifname {
+				continue
+			}
+			updateVifQos(ctx, ifname, ulStatus.Qos)
+			return
+		}
+	}
+}
+
+// updateVifQos is a no-op if the vif does not exist yet or is not on a
+// bridge, or if the qos has already been applied to it
+func updateVifQos(ctx *zedrouterContext, vifName string, qos types.AppQos) {
+
+	link, err := netlink.LinkByName(vifName)
+	if err != nil {
+		log.Debugf("updateVifQos(%s) no link: %s\n", vifName, err)
+		return
+	}
+	ifindex := link.Attrs().Index
+	state, ok := ctx.vifQosMap[vifName]
+	if ok && state.ifindex == ifindex && state.qos == qos {
+		return
+	}
+	if !ok && !qos.IsSet() {
+		return
+	}
+	log.Infof("updateVifQos(%s) %+v\n", vifName, qos)
+	if ok {
+		clearVifQos(vifName, state)
+		delete(ctx.vifQosMap, vifName)
+		maybeDeleteIfb(ctx, state.bridgeName)
+	} else {
+		clearVifQos(vifName, vifQosState{})
+	}
+	if !qos.IsSet() {
+		return
+	}
+	master, err := netlink.LinkByIndex(link.Attrs().MasterIndex)
+	if err != nil {
+		log.Debugf("updateVifQos(%s) not on a bridge: %s\n", vifName, err)
+		return
+	}
+	state = vifQosState{
+		ifindex:    ifindex,
+		bridgeName: master.Attrs().Name,
+		qos:        qos,
+	}
+	if needsIfb(qos) {
+		if err := createIfb(state.bridgeName); err != nil {
+			log.Errorf("updateVifQos(%s) failed: %s\n", vifName, err)
+			return
+		}
+		state.minor = qosClassMinor(usedQosClasses(ctx, state.bridgeName),
+			qos.Priority)
+	}
+	for _, args := range vifQosCommands(vifName, ifbName(state.bridgeName),
+		state.minor, qos) {
+		if err := tcCmd(true, args...); err != nil {
+			log.Errorf("updateVifQos(%s) failed: %s\n", vifName, err)
+			// Retry on next change
+			clearVifQos(vifName, state)
+			maybeDeleteIfb(ctx, state.bridgeName)
+			return
+		}
+	}
+	ctx.vifQosMap[vifName] = state
+}
+
+// deleteVifQos is called when the vif goes away, which removes its
+// qdiscs, but not the class on the IFB
+func deleteVifQos(ctx *zedrouterContext, vifName string) {
+	state, ok := ctx.vifQosMap[vifName]
+	if !ok {
+		return
+	}
+	delete(ctx.vifQosMap, vifName)
+	clearVifQos(vifName, state)
+	maybeDeleteIfb(ctx, state.bridgeName)
+}
+
+// clearVifQos removes the qdiscs hence any filters and classes, and the
+// class on the IFB
+func clearVifQos(vifName string, state vifQosState) {
+	// Errors are expected if they do not exist
+	tcCmd(false, "qdisc", "del", "dev", vifName, "root")
+	tcCmd(false, "qdisc", "del", "dev", vifName, "ingress")
+	if state.minor != 0 {
+		tcCmd(false, "class", "del", "dev", ifbName(state.bridgeName),
+			"classid", fmt.Sprintf("1:%x", state.minor))
+	}
+}
+
+// ifbName returns the IFB for the traffic from the apps on the bridge
+func ifbName(bridgeName string) string {
+	return "ifb-" + bridgeName
+}
+
+// needsIfb returns true if the traffic from the app is shaped
+func needsIfb(qos types.AppQos) bool {
+	return qos.EgressRate != 0 || qos.Priority != types.QosPriorityNormal
+}
+
+// createIfb is a no-op if the IFB for the bridge exists
+func createIfb(bridgeName string) error {
+	name := ifbName(bridgeName)
+	if _, err := netlink.LinkByName(name); err == nil {
+		return nil
+	}
+	log.Infof("createIfb(%s)\n", name)
+	link := &netlink.Ifb{LinkAttrs: netlink.LinkAttrs{Name: name}}
+	if err := netlink.LinkAdd(link); err != nil {
+		errStr := fmt.Sprintf("createIfb %s failed: %s", name, err)
+		return errors.New(errStr)
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		netlink.LinkDel(link)
+		errStr := fmt.Sprintf("createIfb %s up failed: %s", name, err)
+		return errors.New(errStr)
+	}
+	for _, args := range ifbCommands(name) {
+		if err := tcCmd(true, args...); err != nil {
+			netlink.LinkDel(link)
+			return err
+		}
+	}
+	return nil
+}
+
+// maybeDeleteIfb deletes the IFB for the bridge once no vif uses it
+func maybeDeleteIfb(ctx *zedrouterContext, bridgeName string) {
+	if len(usedQosClasses(ctx, bridgeName)) != 0 {
+		return
+	}
+	link, err := netlink.LinkByName(ifbName(bridgeName))
+	if err != nil {
+		return
+	}
+	log.Infof("maybeDeleteIfb(%s)\n", link.Attrs().Name)
+	if err := netlink.LinkDel(link); err != nil {
+		log.Errorf("maybeDeleteIfb(%s) failed: %s\n", link.Attrs().Name,
+			err)
+	}
+}
+
+// usedQosClasses returns the minor numbers of the classes on the IFB of
+// the bridge
+func usedQosClasses(ctx *zedrouterContext, bridgeName string) map[uint16]bool {
+	used := make(map[uint16]bool)
+	for _, state := range ctx.vifQosMap {
+		if state.bridgeName == bridgeName && state.minor != 0 {
+			used[state.minor] = true
+		}
+	}
+	return used
+}
+
+// qosClassMinor returns an unused minor number for the class of an app
+// on the IFB. The low four bits are the TC_PRIO for the priority since
+// the skb priority is set to the class and pfifo_fast uses those bits to
+// select the band.
+func qosClassMinor(used map[uint16]bool, priority types.QosPriority) uint16 {
+	var tcPrio uint16
+	switch priority {
+	case types.QosPriorityHigh:
+		tcPrio = 6 // TC_PRIO_INTERACTIVE
+	case types.QosPriorityLow:
+		tcPrio = 2 // TC_PRIO_BULK
+	default:
+		tcPrio = 0 // TC_PRIO_BESTEFFORT
+	}
+	// The upper bits are unique per vif; minor 1 is the root class
+	inUse := make(map[uint16]bool)
+	for minor := range used {
+		inUse[minor>>4] = true
+	}
+	n := uint16(1)
+	for inUse[n] {
+		n++
+	}
+	return n<<4 | tcPrio
+}
+
+// ifbCommands returns the tc commands for the qdisc and root class of a
+// new IFB
+func ifbCommands(ifbName string) [][]string {
+	return [][]string{
+		{"qdisc", "add", "dev", ifbName, "root", "handle", "1:", "htb"},
+		{"class", "add", "dev", ifbName, "parent", "1:", "classid", "1:1",
+			"htb", "rate", qosIfbRate},
+	}
+}
+
+// vifQosCommands returns the tc commands which apply the qos to the vif
+// and add the class for it with the minor number to the IFB
+func vifQosCommands(vifName string, ifbName string, minor uint16,
+	qos types.AppQos) [][]string {
+
+	var cmds [][]string
+	if qos.IngressRate != 0 {
+		rate := fmt.Sprintf("%dkbit", qos.IngressRate)
+		burst := qosBurst(qos.IngressRate, qos.IngressBurst)
+		cmds = append(cmds,
+			[]string{"qdisc", "add", "dev", vifName, "root",
+				"handle", "1:", "htb", "default", "1"},
+			[]string{"class", "add", "dev", vifName, "parent", "1:",
+				"classid", "1:1", "htb", "rate", rate, "burst", burst},
+			[]string{"qdisc", "add", "dev", vifName, "parent", "1:1",
+				"handle", "10:", "fq_codel"})
+	}
+	if minor == 0 {
+		return cmds
+	}
+	classid := fmt.Sprintf("1:%x", minor)
+	class := []string{"class", "add", "dev", ifbName, "parent", "1:1",
+		"classid", classid, "htb"}
+	if qos.EgressRate != 0 {
+		rate := fmt.Sprintf("%dkbit", qos.EgressRate)
+		burst := qosBurst(qos.EgressRate, qos.EgressBurst)
+		class = append(class, "rate", rate, "ceil", rate, "burst", burst)
+	} else {
+		class = append(class, "rate", qosMinRate, "ceil", qosIfbRate)
+	}
+	var prio, tos, tclass string
+	switch qos.Priority {
+	case types.QosPriorityHigh:
+		prio = "0"
+		tos = "0x10"      // IPTOS_LOWDELAY
+		tclass = "0x0100" // In the first 16 bits of the IPv6 header
+	case types.QosPriorityLow:
+		prio = "2"
+		tos = "0x08" // IPTOS_THROUGHPUT
+		tclass = "0x0080"
+	default:
+		prio = "1"
+	}
+	class = append(class, "prio", prio)
+	cmds = append(cmds, class,
+		[]string{"qdisc", "add", "dev", ifbName, "parent", classid,
+			"handle", fmt.Sprintf("%x:", minor), "fq_codel"},
+		[]string{"qdisc", "add", "dev", vifName, "handle", "ffff:",
+			"ingress"})
+
+	filter := []string{"filter", "add", "dev", vifName, "parent", "ffff:"}
+	match := []string{"u32", "match", "u32", "0", "0"}
+	redirect := []string{"action", "skbedit", "priority", classid,
+		"pipe", "action", "mirred", "egress", "redirect", "dev", ifbName}
+	if tos != "" {
+		// IPv4 and IPv6 with the priority; the ECN bits are retained
+		args := append(filter, "protocol", "ip", "prio", "1")
+		args = append(args, match...)
+		args = append(args, "action", "pedit", "ex", "munge", "ip", "tos",
+			"set", tos, "retain", "0xfc", "pipe", "action", "csum", "ip",
+			"pipe")
+		args = append(args, redirect...)
+		cmds = append(cmds, args)
+		args = append(filter, "protocol", "ipv6", "prio", "2")
+		args = append(args, match...)
+		args = append(args, "action", "pedit", "munge", "offset", "0",
+			"u16", "set", tclass, "retain", "0x0fc0", "pipe")
+		args = append(args, redirect...)
+		cmds = append(cmds, args)
+	}
+	// Everything else
+	args := append(filter, "protocol", "all", "prio", "3")
+	args = append(args, match...)
+	args = append(args, redirect...)
+	cmds = append(cmds, args)
+	return cmds
+}
+
+// qosBurst returns the configured kbytes or 10 ms at the rate, but at least
+// two full frames
+func qosBurst(rate uint32, burst uint32) string {
+	bytes := uint64(burst) * 1024
+	if bytes == 0 {
+		bytes = uint64(rate) * 1000 / 8 / 100
+	}
+	if bytes < 2*1514 {
+		bytes = 2 * 1514
+	}
+	return fmt.Sprintf("%db", bytes)
+}
+
+func tcCmd(dolog bool, args ...string) error {
+	var out []byte
+	var err error
+	if dolog {
+		out, err = wrap.Command("tc", args...).CombinedOutput()
+	} else {
+		out, err = exec.Command("tc", args...).CombinedOutput()
+	}
+	if err != nil {
+		errStr := fmt.Sprintf("tc command %s failed %s output %s",
+			args, err, out)
+		if dolog {
+			log.Errorln(errStr)
+		}
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// getQosMetrics returns the counters for the vifs with an AppQos
+func getQosMetrics(ctx *zedrouterContext) map[string]*types.QosMetric {
+
+	if len(ctx.vifQosMap) == 0 {
+		return nil
+	}
+	res := make(map[string]*types.QosMetric)
+	for vifName, state := range ctx.vifQosMap {
+		out, err := exec.Command("tc", "-s", "qdisc", "show", "dev",
+			vifName).Output()
+		if err != nil {
+			log.Errorf("getQosMetrics(%s): tc qdisc show failed: %s\n",
+				vifName, err)
+			continue
+		}
+		qm := parseQdiscStats(string(out))
+		qm.EgressRate = state.qos.EgressRate
+		qm.IngressRate = state.qos.IngressRate
+		if state.minor != 0 {
+			// The drops are in the class on the IFB
+			out, err := exec.Command("tc", "-s", "qdisc", "show", "dev",
+				ifbName(state.bridgeName), "parent",
+				fmt.Sprintf("1:%x", state.minor)).Output()
+			if err != nil {
+				log.Errorf("getQosMetrics(%s): tc qdisc show failed: %s\n",
+					vifName, err)
+			} else {
+				qm.EgressDrops = parseQdiscDrops(string(out))
+			}
+		}
+		res[vifName] = qm
+	}
+	return res
+}
+
+// parseQdiscDrops returns the drops from the output of
+// "tc -s qdisc show dev ${ifb} parent ${classid}" which has the leaf
+// qdisc of the class
+func parseQdiscDrops(out string) uint64 {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		// Sent 16390 bytes 121 pkt (dropped 3, overlimits 0 requeues 0)
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 7 && fields[0] == "Sent" {
+			drops, _ := strconv.ParseUint(
+				strings.TrimSuffix(fields[6], ","), 10, 64)
+			return drops
+		}
+	}
+	return 0
+}
+
+// parseQdiscStats parses the output from "tc -s qdisc show dev ${vif}"
+// which looks like
+//
+//	qdisc htb 1: root refcnt 2 r2q 10 default 0x1 direct_packets_stat 0
+//	 Sent 16390 bytes 121 pkt (dropped 0, overlimits 7 requeues 0)
+//	 backlog 0b 0p requeues 0
+//	qdisc fq_codel 10: parent 1:1 limit 10240p flows 1024 quantum 1514
+//	 Sent 16390 bytes 121 pkt (dropped 0, overlimits 0 requeues 0)
+//	 backlog 0b 0p requeues 0
+//	qdisc ingress ffff: parent ffff:fff1 ----------------
+//	 Sent 8250 bytes 87 pkt (dropped 3, overlimits 0 requeues 0)
+//	 backlog 0b 0p requeues 0
+//
+// The root qdisc has the traffic to the app and the ingress qdisc the
+// traffic from the app before it is redirected to the IFB.
+func parseQdiscStats(out string) *types.QosMetric {
+
+	qm := new(types.QosMetric)
+	// Which direction the current qdisc is for
+	egress := false
+	ingress := false
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "qdisc":
+			egress = len(fields) > 1 && fields[1] == "ingress"
+			ingress = len(fields) > 3 && fields[3] == "root"
+		case "Sent":
+			// Sent 16390 bytes 121 pkt (dropped 0, overlimits 7 requeues 0)
+			if len(fields) < 9 {
+				continue
+			}
+			bytes, _ := strconv.ParseUint(fields[1], 10, 64)
+			pkts, _ := strconv.ParseUint(fields[3], 10, 64)
+			drops, _ := strconv.ParseUint(
+				strings.TrimSuffix(fields[6], ","), 10, 64)
+			overlimits, _ := strconv.ParseUint(fields[8], 10, 64)
+			if egress {
+				qm.EgressBytes = bytes
+				qm.EgressPkts = pkts
+				qm.EgressDrops = drops
+			} else if ingress {
+				qm.IngressBytes = bytes
+				qm.IngressPkts = pkts
+				qm.IngressDrops = drops
+				qm.IngressOverlimits = overlimits
+			}
+		case "backlog":
+			// backlog 3028b 2p requeues 0
+			if !ingress || len(fields) < 3 {
+				continue
+			}
+			qm.IngressBacklogBytes = parseTcSize(fields[1])
+			qm.IngressBacklogPkts, _ = strconv.ParseUint(
+				strings.TrimSuffix(fields[2], "p"), 10, 64)
+		}
+	}
+	return qm
+}
+
+// parseTcSize handles e.g., 1514b, 10Kb and 2Mb
+func parseTcSize(s string) uint64 {
+	s = strings.TrimSuffix(s, "b")
+	mult := float64(1)
+	if strings.HasSuffix(s, "K") {
+		mult = 1024
+		s = strings.TrimSuffix(s, "K")
+	} else if strings.HasSuffix(s, "M") {
+		mult = 1024 * 1024
+		s = strings.TrimSuffix(s, "M")
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return uint64(f * mult)
+}
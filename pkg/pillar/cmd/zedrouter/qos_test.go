@@ -0,0 +1,180 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+type TestVifQosCommandsMatrix struct {
+	qos      types.AppQos
+	minor    uint16
+	expected []string
+}
+
+func TestVifQosCommands(t *testing.T) {
+	ingressCmds := []string{
+		"qdisc add dev nbu1x1 root handle 1: htb default 1",
+		"class add dev nbu1x1 parent 1: classid 1:1 htb rate 2000kbit burst 3028b",
+		"qdisc add dev nbu1x1 parent 1:1 handle 10: fq_codel",
+	}
+	testMatrix := map[string]TestVifQosCommandsMatrix{
+		"Nothing": {
+			qos:      types.AppQos{},
+			expected: nil,
+		},
+		"Ingress rate": {
+			qos:      types.AppQos{IngressRate: 2000},
+			expected: ingressCmds,
+		},
+		"Egress rate": {
+			qos:   types.AppQos{EgressRate: 8000, EgressBurst: 20},
+			minor: 0x10,
+			expected: []string{
+				"class add dev ifb-bn1 parent 1:1 classid 1:10 htb rate 8000kbit ceil 8000kbit burst 20480b prio 1",
+				"qdisc add dev ifb-bn1 parent 1:10 handle 10: fq_codel",
+				"qdisc add dev nbu1x1 handle ffff: ingress",
+				"filter add dev nbu1x1 parent ffff: protocol all prio 3 u32 match u32 0 0 action skbedit priority 1:10 pipe action mirred egress redirect dev ifb-bn1",
+			},
+		},
+		"High priority": {
+			qos:   types.AppQos{Priority: types.QosPriorityHigh},
+			minor: 0x26,
+			expected: []string{
+				"class add dev ifb-bn1 parent 1:1 classid 1:26 htb rate 1mbit ceil 10gbit prio 0",
+				"qdisc add dev ifb-bn1 parent 1:26 handle 26: fq_codel",
+				"qdisc add dev nbu1x1 handle ffff: ingress",
+				"filter add dev nbu1x1 parent ffff: protocol ip prio 1 u32 match u32 0 0 action pedit ex munge ip tos set 0x10 retain 0xfc pipe action csum ip pipe action skbedit priority 1:26 pipe action mirred egress redirect dev ifb-bn1",
+				"filter add dev nbu1x1 parent ffff: protocol ipv6 prio 2 u32 match u32 0 0 action pedit munge offset 0 u16 set 0x0100 retain 0x0fc0 pipe action skbedit priority 1:26 pipe action mirred egress redirect dev ifb-bn1",
+				"filter add dev nbu1x1 parent ffff: protocol all prio 3 u32 match u32 0 0 action skbedit priority 1:26 pipe action mirred egress redirect dev ifb-bn1",
+			},
+		},
+		"Low priority with rates": {
+			qos: types.AppQos{IngressRate: 2000, EgressRate: 400,
+				Priority: types.QosPriorityLow},
+			minor: 0x12,
+			expected: append(ingressCmds,
+				"class add dev ifb-bn1 parent 1:1 classid 1:12 htb rate 400kbit ceil 400kbit burst 3028b prio 2",
+				"qdisc add dev ifb-bn1 parent 1:12 handle 12: fq_codel",
+				"qdisc add dev nbu1x1 handle ffff: ingress",
+				"filter add dev nbu1x1 parent ffff: protocol ip prio 1 u32 match u32 0 0 action pedit ex munge ip tos set 0x08 retain 0xfc pipe action csum ip pipe action skbedit priority 1:12 pipe action mirred egress redirect dev ifb-bn1",
+				"filter add dev nbu1x1 parent ffff: protocol ipv6 prio 2 u32 match u32 0 0 action pedit munge offset 0 u16 set 0x0080 retain 0x0fc0 pipe action skbedit priority 1:12 pipe action mirred egress redirect dev ifb-bn1",
+				"filter add dev nbu1x1 parent ffff: protocol all prio 3 u32 match u32 0 0 action skbedit priority 1:12 pipe action mirred egress redirect dev ifb-bn1",
+			),
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var actual []string
+		for _, args := range vifQosCommands("nbu1x1", "ifb-bn1", test.minor,
+			test.qos) {
+			actual = append(actual, strings.Join(args, " "))
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Test Failed: %s: Expected %q, Actual: %q\n",
+				testname, test.expected, actual)
+		}
+		if needsIfb(test.qos) != (test.minor != 0) {
+			t.Errorf("Test Failed: %s: needsIfb %v\n", testname,
+				needsIfb(test.qos))
+		}
+	}
+}
+
+func TestIfbCommands(t *testing.T) {
+	expected := []string{
+		"qdisc add dev ifb-bn1 root handle 1: htb",
+		"class add dev ifb-bn1 parent 1: classid 1:1 htb rate 10gbit",
+	}
+	var actual []string
+	for _, args := range ifbCommands(ifbName("bn1")) {
+		actual = append(actual, strings.Join(args, " "))
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %q, Actual: %q\n", expected, actual)
+	}
+}
+
+type TestQosClassMinorMatrix struct {
+	used     []uint16
+	priority types.QosPriority
+	expected uint16
+}
+
+func TestQosClassMinor(t *testing.T) {
+	testMatrix := map[string]TestQosClassMinorMatrix{
+		"First normal": {
+			priority: types.QosPriorityNormal,
+			expected: 0x10,
+		},
+		"First high": {
+			priority: types.QosPriorityHigh,
+			expected: 0x16,
+		},
+		"Second low": {
+			used:     []uint16{0x16},
+			priority: types.QosPriorityLow,
+			expected: 0x22,
+		},
+		"Reuses a gap": {
+			used:     []uint16{0x10, 0x32},
+			priority: types.QosPriorityHigh,
+			expected: 0x26,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		used := make(map[uint16]bool)
+		for _, minor := range test.used {
+			used[minor] = true
+		}
+		actual := qosClassMinor(used, test.priority)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected 0x%x, Actual: 0x%x\n",
+				testname, test.expected, actual)
+		}
+	}
+}
+
+func TestParseQdiscStats(t *testing.T) {
+	out := `qdisc htb 1: root refcnt 2 r2q 10 default 0x1 direct_packets_stat 0
+ Sent 16390 bytes 121 pkt (dropped 1, overlimits 7 requeues 0)
+ backlog 3028b 2p requeues 0
+qdisc fq_codel 10: parent 1:1 limit 10240p flows 1024 quantum 1514
+ Sent 16390 bytes 121 pkt (dropped 1, overlimits 0 requeues 0)
+ backlog 3028b 2p requeues 0
+qdisc ingress ffff: parent ffff:fff1 ----------------
+ Sent 8250 bytes 87 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+`
+	expected := types.QosMetric{
+		EgressBytes:         8250,
+		EgressPkts:          87,
+		IngressBytes:        16390,
+		IngressPkts:         121,
+		IngressDrops:        1,
+		IngressOverlimits:   7,
+		IngressBacklogBytes: 3028,
+		IngressBacklogPkts:  2,
+	}
+	actual := parseQdiscStats(out)
+	if *actual != expected {
+		t.Errorf("Expected %+v, Actual: %+v\n", expected, *actual)
+	}
+
+	leaf := `qdisc fq_codel 10: parent 1:10 limit 10240p flows 1024 quantum 1514
+ Sent 6000 bytes 60 pkt (dropped 3, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+`
+	if drops := parseQdiscDrops(leaf); drops != 3 {
+		t.Errorf("Expected 3 drops, Actual: %d\n", drops)
+	}
+	if drops := parseQdiscDrops(""); drops != 0 {
+		t.Errorf("Expected no drops, Actual: %d\n", drops)
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Use of multiple ports by a Local network instance. When the Port is
+// e.g., "uplink" the IfNameList has several ports and the UplinkPolicy
+// determines whether we fail over between them or load balance across
+// them using a multipath default route in the table for the bridge.
+// A port is up when it has a default route and, if there is a ProbeAddr,
+// the ProbeAddr answers a ping sent out that port.
+
+package zedrouter
+
+import (
+	"io/ioutil"
+	"net"
+	"os/exec"
+	"time"
+
+	"github.com/eriknordmark/netlink"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/devicenetwork"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// How often we check whether it is time to probe
+const uplinkProbeTick = 5 * time.Second
+
+// uplinkState is the part of the state which is not published
+type uplinkState struct {
+	route     *netlink.Route // What we installed in the bridge table
+	lastProbe time.Time
+	probing   int // Outstanding probes
+}
+
+type uplinkProbeResult struct {
+	key     string
+	ifname  string
+	success bool
+}
+
+// uplinkActivate sets up the Uplinks from the IfNameList and installs
+// the default route
+func uplinkActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Infof("uplinkActivate(%s) %v\n", status.DisplayName,
+		status.IfNameList)
+	ctx.uplinkStateMap[status.Key()] = &uplinkState{}
+	status.Uplinks = nil
+	now := time.Now()
+	for _, ifname := range status.IfNameList {
+		u := types.UplinkStatus{
+			IfName:     ifname,
+			LastChange: now,
+		}
+		if port := types.GetPort(*ctx.deviceNetworkStatus, ifname); port != nil {
+			u.Name = port.Name
+			u.Free = port.Free
+		}
+		status.Uplinks = append(status.Uplinks, u)
+	}
+	if status.UplinkPolicy.Mode == types.UplinkModeFlowHash {
+		enableMultipathFlowHash()
+	}
+	updateUplinks(ctx, status)
+	return nil
+}
+
+func uplinkInactivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Infof("uplinkInactivate(%s)\n", status.DisplayName)
+	state := ctx.uplinkStateMap[status.Key()]
+	if state != nil && state.route != nil {
+		if err := netlink.RouteDel(state.route); err != nil {
+			log.Errorf("uplinkInactivate(%s) RouteDel %v failed: %s\n",
+				status.DisplayName, state.route, err)
+		}
+	}
+	delete(ctx.uplinkStateMap, status.Key())
+	status.Uplinks = nil
+}
+
+// updateUplinks determines which ports are up and which should be used,
+// and installs the resulting default route.
+// Returns true if the Uplinks changed.
+func updateUplinks(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) bool {
+
+	state := ctx.uplinkStateMap[status.Key()]
+	if state == nil {
+		return false
+	}
+	policy := status.UplinkPolicy
+	changed := false
+	now := time.Now()
+	for i := range status.Uplinks {
+		u := &status.Uplinks[i]
+		weight := uplinkWeight(policy, *u)
+		if u.Weight != weight {
+			u.Weight = weight
+			changed = true
+		}
+		up := getUplinkDefaultRoute(u.IfName) != nil
+		if policy.ProbeAddr != nil && u.Failures >= policy.ProbeFailures {
+			up = false
+		}
+		if u.Up != up {
+			log.Infof("updateUplinks(%s) %s up changed to %t\n",
+				status.DisplayName, u.IfName, up)
+			u.Up = up
+			u.LastChange = now
+			changed = true
+		}
+	}
+	if selectUplinks(policy, status.Uplinks) {
+		changed = true
+	}
+	installUplinkRoute(ctx, status, state)
+	return changed
+}
+
+// uplinkWeight looks for the port name or ifname in the Weights
+func uplinkWeight(policy types.UplinkPolicy, u types.UplinkStatus) uint32 {
+	if w, ok := policy.Weights[u.IfName]; ok {
+		return w
+	}
+	if w, ok := policy.Weights[u.Name]; ok && u.Name != "" {
+		return w
+	}
+	return 1
+}
+
+// selectUplinks sets Active. For failover the first Free one which is up
+// is used, and if none of those the first one which is up.
+// Returns true if any Active changed.
+func selectUplinks(policy types.UplinkPolicy,
+	uplinks []types.UplinkStatus) bool {
+
+	active := make([]bool, len(uplinks))
+	switch policy.Mode {
+	case types.UplinkModeWeighted, types.UplinkModeFlowHash:
+		for i, u := range uplinks {
+			active[i] = u.Up
+		}
+	default:
+		selected := -1
+		for i, u := range uplinks {
+			if !u.Up {
+				continue
+			}
+			if u.Free {
+				selected = i
+				break
+			}
+			if selected == -1 {
+				selected = i
+			}
+		}
+		if selected != -1 {
+			active[selected] = true
+		}
+	}
+	changed := false
+	for i := range uplinks {
+		if uplinks[i].Active != active[i] {
+			uplinks[i].Active = active[i]
+			changed = true
+		}
+	}
+	return changed
+}
+
+// installUplinkRoute replaces the default route in the table for the
+// bridge if it differs from what we installed
+func installUplinkRoute(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, state *uplinkState) {
+
+	link, err := netlink.LinkByName(status.BridgeName)
+	if err != nil {
+		log.Errorf("installUplinkRoute(%s) LinkByName(%s) failed: %s\n",
+			status.DisplayName, status.BridgeName, err)
+		return
+	}
+	rt := makeUplinkRoute(status.Uplinks, FreeTable+link.Attrs().Index)
+	if rt == nil && state.route == nil {
+		return
+	}
+	if rt != nil && state.route != nil && rt.Equal(*state.route) {
+		return
+	}
+	if state.route != nil {
+		log.Infof("installUplinkRoute(%s) deleting %v\n",
+			status.DisplayName, state.route)
+		// The kernel might already have removed it when the port
+		// went down
+		if err := netlink.RouteDel(state.route); err != nil {
+			log.Warnf("installUplinkRoute(%s) RouteDel %v failed: %s\n",
+				status.DisplayName, state.route, err)
+		}
+		state.route = nil
+	}
+	if rt == nil {
+		log.Warnf("installUplinkRoute(%s) no usable port\n",
+			status.DisplayName)
+		return
+	}
+	log.Infof("installUplinkRoute(%s) adding %v\n", status.DisplayName, rt)
+	if err := netlink.RouteAdd(rt); err != nil {
+		log.Errorf("installUplinkRoute(%s) RouteAdd %v failed: %s\n",
+			status.DisplayName, rt, err)
+		return
+	}
+	state.route = rt
+}
+
+// makeUplinkRoute returns a copy of the default route of the port if
+// there is one Active, or a multipath route if there are several.
+// The weight of a nexthop is Hops+1.
+func makeUplinkRoute(uplinks []types.UplinkStatus, table int) *netlink.Route {
+
+	var last *netlink.Route
+	var nexthops []*netlink.NexthopInfo
+	for _, u := range uplinks {
+		if !u.Active {
+			continue
+		}
+		rt := getUplinkDefaultRoute(u.IfName)
+		if rt == nil {
+			continue
+		}
+		last = rt
+		nexthops = append(nexthops, &netlink.NexthopInfo{
+			LinkIndex: rt.LinkIndex,
+			Gw:        rt.Gw,
+			Hops:      int(u.Weight) - 1,
+		})
+	}
+	switch len(nexthops) {
+	case 0:
+		return nil
+	case 1:
+		myrt := *last
+		myrt.Table = table
+		// Clear any RTNH_F_LINKDOWN etc flags since add doesn't like them
+		myrt.Flags = 0
+		return &myrt
+	}
+	// Need an explicit Dst for netlink to determine the family
+	_, dst, _ := net.ParseCIDR("0.0.0.0/0")
+	return &netlink.Route{
+		Dst:       dst,
+		Table:     table,
+		MultiPath: nexthops,
+	}
+}
+
+func getUplinkDefaultRoute(ifname string) *netlink.Route {
+	ifindex, err := devicenetwork.IfnameToIndex(ifname)
+	if err != nil {
+		return nil
+	}
+	return getDefaultIPv4Route(ifindex)
+}
+
+// enableMultipathFlowHash makes the kernel include the L4 ports in the
+// multipath hash. Note that this is a global setting hence it applies to
+// all network instances once one has UplinkModeFlowHash.
+func enableMultipathFlowHash() {
+	filename := "/proc/sys/net/ipv4/fib_multipath_hash_policy"
+	if err := ioutil.WriteFile(filename, []byte("1"), 0644); err != nil {
+		log.Errorf("enableMultipathFlowHash failed: %s\n", err)
+	}
+}
+
+// uplinkRouteChange re-evaluates the Uplinks when a default route
+// is added or removed in the main table
+func uplinkRouteChange(ctx *zedrouterContext, change netlink.RouteUpdate) {
+
+	rt := change.Route
+	if rt.Table != getDefaultRouteTable() || rt.Dst != nil {
+		return
+	}
+	if len(ctx.uplinkStateMap) == 0 {
+		return
+	}
+	log.Debugf("uplinkRouteChange %v\n", rt)
+	updateUplinksAll(ctx)
+}
+
+// uplinkPortsChange refreshes the port names and Free of the Uplinks
+// and re-evaluates them when the DeviceNetworkStatus changes
+func uplinkPortsChange(ctx *zedrouterContext) {
+
+	pub := ctx.pubNetworkInstanceStatus
+	for _, st := range pub.GetAll() {
+		status := cast.CastNetworkInstanceStatus(st)
+		if _, ok := ctx.uplinkStateMap[status.Key()]; !ok {
+			continue
+		}
+		changed := false
+		for i := range status.Uplinks {
+			u := &status.Uplinks[i]
+			port := types.GetPort(*ctx.deviceNetworkStatus, u.IfName)
+			if port == nil {
+				continue
+			}
+			if u.Name != port.Name || u.Free != port.Free {
+				log.Infof("uplinkPortsChange(%s) %s name %s free %t\n",
+					status.DisplayName, u.IfName, port.Name,
+					port.Free)
+				u.Name = port.Name
+				u.Free = port.Free
+				changed = true
+			}
+		}
+		if updateUplinks(ctx, &status) {
+			changed = true
+		}
+		if changed {
+			publishNetworkInstanceStatus(ctx, &status)
+		}
+	}
+}
+
+func updateUplinksAll(ctx *zedrouterContext) {
+
+	pub := ctx.pubNetworkInstanceStatus
+	for _, st := range pub.GetAll() {
+		status := cast.CastNetworkInstanceStatus(st)
+		if _, ok := ctx.uplinkStateMap[status.Key()]; !ok {
+			continue
+		}
+		if updateUplinks(ctx, &status) {
+			publishNetworkInstanceStatus(ctx, &status)
+		}
+	}
+}
+
+// uplinkProbeTimer starts the probes for the network instances which have
+// a ProbeAddr and are due. The results are returned on uplinkProbeResults
+func uplinkProbeTimer(ctx *zedrouterContext) {
+
+	pub := ctx.pubNetworkInstanceStatus
+	for _, st := range pub.GetAll() {
+		status := cast.CastNetworkInstanceStatus(st)
+		state := ctx.uplinkStateMap[status.Key()]
+		if state == nil || status.UplinkPolicy.ProbeAddr == nil {
+			continue
+		}
+		interval := time.Duration(status.UplinkPolicy.ProbeInterval) *
+			time.Second
+		if state.probing != 0 || time.Since(state.lastProbe) < interval {
+			continue
+		}
+		state.lastProbe = time.Now()
+		addr := status.UplinkPolicy.ProbeAddr.String()
+		for _, u := range status.Uplinks {
+			if getUplinkDefaultRoute(u.IfName) == nil {
+				continue
+			}
+			state.probing++
+			go uplinkProbe(ctx.uplinkProbeResults, status.Key(),
+				u.IfName, addr)
+		}
+	}
+}
+
+// uplinkProbe runs in a separate goroutine
+func uplinkProbe(results chan<- uplinkProbeResult, key string,
+	ifname string, addr string) {
+
+	err := exec.Command("ping", "-c", "1", "-W", "2", "-I", ifname,
+		addr).Run()
+	if err != nil {
+		log.Debugf("uplinkProbe(%s) %s failed: %s\n", ifname, addr, err)
+	}
+	results <- uplinkProbeResult{key: key, ifname: ifname, success: err == nil}
+}
+
+func handleUplinkProbeResult(ctx *zedrouterContext, res uplinkProbeResult) {
+
+	state := ctx.uplinkStateMap[res.key]
+	if state == nil {
+		// Inactivated while probing
+		return
+	}
+	if state.probing > 0 {
+		state.probing--
+	}
+	status := lookupNetworkInstanceStatus(ctx, res.key)
+	if status == nil {
+		return
+	}
+	changed := false
+	for i := range status.Uplinks {
+		u := &status.Uplinks[i]
+		if u.IfName != res.ifname {
+			continue
+		}
+		if res.success {
+			if u.Failures != 0 {
+				u.Failures = 0
+				changed = true
+			}
+		} else {
+			u.Failures++
+			changed = true
+			log.Warnf("handleUplinkProbeResult(%s) %s failures %d\n",
+				status.DisplayName, u.IfName, u.Failures)
+		}
+	}
+	if updateUplinks(ctx, status) {
+		changed = true
+	}
+	if changed {
+		publishNetworkInstanceStatus(ctx, status)
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// 802.1Q VLAN trunks for switch network instances. The bridge does
+// VLAN filtering and the port carries the VlanTrunk VLANs tagged. The VIF
+// of an app carries tagged the ones of those which are in the VlanTrunk of
+// its UnderlayNetworkConfig. Untagged traffic uses the default PVID 1.
+
+package zedrouter
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/eriknordmark/netlink"
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/cast"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+func isVlanTrunk(status *types.NetworkInstanceStatus) bool {
+	return status.Type == types.NetworkInstanceTypeSwitch &&
+		len(status.VlanTrunk) != 0
+}
+
+// enableBridgeVlanStats makes the kernel count per VLAN and per port
+// so that we can report them in the metrics.
+// Needs to be done before any VLANs are added to the ports.
+func enableBridgeVlanStats(bridgeName string) {
+	for _, file := range []string{"vlan_stats_enabled", "vlan_stats_per_port"} {
+		filename := "/sys/class/net/" + bridgeName + "/bridge/" + file
+		if err := ioutil.WriteFile(filename, []byte("1"), 0644); err != nil {
+			// Older kernels do not have per port stats
+			log.Warnf("enableBridgeVlanStats(%s) %s\n", bridgeName, err)
+		}
+	}
+}
+
+// vifTrunkState is what has been applied to a VIF. A new VIF with the
+// same name has a different ifindex.
+type vifTrunkState struct {
+	ifindex int
+	vlans   []uint16
+}
+
+// maybeTrunkVif adds the VLANs to a VIF when it is added to a bridge
+// for a network instance with a VlanTrunk.
+// The VIFs are added to the bridge by the hypervisor hence we
+// do this based on the link change.
+func maybeTrunkVif(ctx *zedrouterContext, change netlink.LinkUpdate) {
+
+	ifname := change.Attrs().Name
+	if !strings.HasPrefix(ifname, "nbu") || change.Attrs().MasterIndex == 0 {
+		return
+	}
+	pub := ctx.pubAppNetworkStatus
+	for _, st := range pub.GetAll() {
+		status := cast.CastAppNetworkStatus(st)
+		for _, ulStatus := range status.UnderlayNetworkList {
+			if ulStatus.Vif != ifname {
+				continue
+			}
+			updateVifTrunk(ctx, ifname, ulStatus.Network,
+				ulStatus.VlanTrunk)
+			return
+		}
+	}
+}
+
+// updateVifTrunk is a no-op if the vif does not exist yet or is not on a
+// bridge, or if the VLANs have already been added to it
+func updateVifTrunk(ctx *zedrouterContext, vifName string,
+	network uuid.UUID, vlanTrunk []uint16) {
+
+	link, err := netlink.LinkByName(vifName)
+	if err != nil {
+		log.Debugf("updateVifTrunk(%s) no link: %s\n", vifName, err)
+		return
+	}
+	if link.Attrs().MasterIndex == 0 {
+		return
+	}
+	status := lookupNetworkInstanceStatus(ctx, network.String())
+	if status == nil || !isVlanTrunk(status) {
+		return
+	}
+	vlans := trunkVlans(vifName, status.VlanTrunk, vlanTrunk)
+	ifindex := link.Attrs().Index
+	var applied []uint16
+	if state, ok := ctx.vifTrunkMap[vifName]; ok && state.ifindex == ifindex {
+		applied = state.vlans
+	}
+	add := vlanDiff(vlans, applied)
+	del := vlanDiff(applied, vlans)
+	if len(add) == 0 && len(del) == 0 {
+		return
+	}
+	log.Infof("updateVifTrunk(%s) bridge %s vlans %v add %v delete %v\n",
+		vifName, status.BridgeName, vlans, add, del)
+	if err := delTrunkVlans(link, del); err != nil {
+		log.Errorf("updateVifTrunk(%s) failed: %s\n", vifName, err)
+	}
+	if err := addTrunkVlans(link, add); err != nil {
+		log.Errorf("updateVifTrunk(%s) failed: %s\n", vifName, err)
+	}
+	ctx.vifTrunkMap[vifName] = vifTrunkState{ifindex: ifindex, vlans: vlans}
+}
+
+// deleteVifTrunk forgets the VIF; the VLANs go away with it
+func deleteVifTrunk(ctx *zedrouterContext, vifName string) {
+	delete(ctx.vifTrunkMap, vifName)
+}
+
+// trunkVlans returns the VLANs the app asked for which the network
+// instance trunks
+func trunkVlans(vifName string, niVlans []uint16, appVlans []uint16) []uint16 {
+	var vlans []uint16
+	for _, vid := range appVlans {
+		if !containsVlan(niVlans, vid) {
+			log.Errorf("trunkVlans(%s) VLAN %d not in network instance trunk %v\n",
+				vifName, vid, niVlans)
+			continue
+		}
+		vlans = append(vlans, vid)
+	}
+	return vlans
+}
+
+// vlanDiff returns the VLANs in a which are not in b
+func vlanDiff(a []uint16, b []uint16) []uint16 {
+	var diff []uint16
+	for _, vid := range a {
+		if !containsVlan(b, vid) {
+			diff = append(diff, vid)
+		}
+	}
+	return diff
+}
+
+func containsVlan(vlans []uint16, vid uint16) bool {
+	for _, v := range vlans {
+		if v == vid {
+			return true
+		}
+	}
+	return false
+}
+
+// getVlanMetrics returns the per VLAN counters for all bridge ports if
+// there is at least one network instance with a VlanTrunk
+func getVlanMetrics(ctx *zedrouterContext) map[string][]types.VlanMetric {
+
+	haveTrunk := false
+	for _, status := range ctx.networkInstanceStatusMap {
+		if isVlanTrunk(status) {
+			haveTrunk = true
+			break
+		}
+	}
+	if !haveTrunk {
+		return nil
+	}
+	out, err := exec.Command("bridge", "-s", "vlan", "show").Output()
+	if err != nil {
+		log.Errorf("getVlanMetrics: bridge vlan show failed: %s\n", err)
+		return nil
+	}
+	return parseBridgeVlanStats(string(out))
+}
+
+// parseBridgeVlanStats parses the output from "bridge -s vlan show"
+// which looks like
+//
+//	port              vlan-id
+//	eth1              1 PVID Egress Untagged
+//	                    RX: 1402 bytes 11 packets
+//	                    TX: 0 bytes 0 packets
+//	                  100
+//	                    RX: 0 bytes 0 packets
+//	                    TX: 0 bytes 0 packets
+//
+// We skip the default PVID 1 since that is the untagged traffic.
+func parseBridgeVlanStats(out string) map[string][]types.VlanMetric {
+
+	res := make(map[string][]types.VlanMetric)
+	port := ""
+	var vm *types.VlanMetric
+	flush := func() {
+		if vm != nil && port != "" && vm.VlanID != 1 {
+			res[port] = append(res[port], *vm)
+		}
+		vm = nil
+	}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] == "port" {
+			continue
+		}
+		if fields[0] == "RX:" || fields[0] == "TX:" {
+			if vm == nil || len(fields) < 4 {
+				continue
+			}
+			bytes, _ := strconv.ParseUint(fields[1], 10, 64)
+			pkts, _ := strconv.ParseUint(fields[3], 10, 64)
+			if fields[0] == "RX:" {
+				vm.RxBytes = bytes
+				vm.RxPkts = pkts
+			} else {
+				vm.TxBytes = bytes
+				vm.TxPkts = pkts
+			}
+			continue
+		}
+		if line[0] != ' ' && line[0] != '\t' {
+			// New port
+			flush()
+			port = fields[0]
+			fields = fields[1:]
+			if len(fields) == 0 {
+				continue
+			}
+		}
+		vid, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			continue
+		}
+		flush()
+		vm = &types.VlanMetric{VlanID: uint16(vid)}
+	}
+	flush()
+	return res
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// This file is built only for linux
+// +build linux
+
+package zedrouter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/eriknordmark/netlink"
+)
+
+// addTrunkVlans adds the VLANs tagged to a bridge port
+//
+//	bridge vlan add dev ${ifname} vid ${vid} master
+func addTrunkVlans(link netlink.Link, vlans []uint16) error {
+	for _, vid := range vlans {
+		err := netlink.BridgeVlanAdd(link, vid, false, false, false, true)
+		if err != nil {
+			errStr := fmt.Sprintf("BridgeVlanAdd %s vid %d failed: %s",
+				link.Attrs().Name, vid, err)
+			return errors.New(errStr)
+		}
+	}
+	return nil
+}
+
+// delTrunkVlans removes the VLANs from a bridge port
+//
+//	bridge vlan del dev ${ifname} vid ${vid} master
+func delTrunkVlans(link netlink.Link, vlans []uint16) error {
+	for _, vid := range vlans {
+		err := netlink.BridgeVlanDel(link, vid, false, false, false, true)
+		if err != nil {
+			errStr := fmt.Sprintf("BridgeVlanDel %s vid %d failed: %s",
+				link.Attrs().Name, vid, err)
+			return errors.New(errStr)
+		}
+	}
+	return nil
+}
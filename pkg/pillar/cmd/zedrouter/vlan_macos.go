@@ -0,0 +1,21 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+//
+// Stub file to allow compilation of vlan.go to go thru on macos.
+// We don't need the actual functionality to work
+// +build darwin
+
+package zedrouter
+
+import (
+	"github.com/eriknordmark/netlink"
+)
+
+func addTrunkVlans(link netlink.Link, vlans []uint16) error {
+	return nil
+}
+
+func delTrunkVlans(link netlink.Link, vlans []uint16) error {
+	return nil
+}
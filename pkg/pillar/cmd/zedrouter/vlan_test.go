@@ -0,0 +1,102 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+func TestParseBridgeVlanStats(t *testing.T) {
+	out := `port              vlan-id
+eth1              1 PVID Egress Untagged
+                    RX: 1402 bytes 11 packets
+                    TX: 0 bytes 0 packets
+                  100
+                    RX: 2000 bytes 20 packets
+                    TX: 3000 bytes 30 packets
+                  200
+                    RX: 0 bytes 0 packets
+                    TX: 10 bytes 1 packets
+bn1               1 PVID Egress Untagged
+                    RX: 0 bytes 0 packets
+                    TX: 0 bytes 0 packets
+nbu1x1            1 PVID Egress Untagged
+                    RX: 5 bytes 1 packets
+                    TX: 6 bytes 1 packets
+                  100
+                    RX: 300 bytes 3 packets
+                    TX: 400 bytes 4 packets
+`
+	expected := map[string][]types.VlanMetric{
+		"eth1": {
+			{VlanID: 100, RxBytes: 2000, RxPkts: 20, TxBytes: 3000, TxPkts: 30},
+			{VlanID: 200, TxBytes: 10, TxPkts: 1},
+		},
+		"nbu1x1": {
+			{VlanID: 100, RxBytes: 300, RxPkts: 3, TxBytes: 400, TxPkts: 4},
+		},
+	}
+	res := parseBridgeVlanStats(out)
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected %+v, Actual: %+v\n", expected, res)
+	}
+	if res := parseBridgeVlanStats(""); len(res) != 0 {
+		t.Errorf("Expected nothing for empty output, Actual: %+v\n", res)
+	}
+}
+
+type TestTrunkVlansMatrix struct {
+	niVlans  []uint16
+	appVlans []uint16
+	applied  []uint16
+	add      []uint16
+	del      []uint16
+}
+
+func TestTrunkVlans(t *testing.T) {
+	testMatrix := map[string]TestTrunkVlansMatrix{
+		"App without trunk": {
+			niVlans: []uint16{100, 200},
+		},
+		"New VIF": {
+			niVlans:  []uint16{100, 200},
+			appVlans: []uint16{200},
+			add:      []uint16{200},
+		},
+		"Not in network instance": {
+			niVlans:  []uint16{100, 200},
+			appVlans: []uint16{100, 300},
+			add:      []uint16{100},
+		},
+		"Already applied": {
+			niVlans:  []uint16{100, 200},
+			appVlans: []uint16{100, 200},
+			applied:  []uint16{100, 200},
+		},
+		"Changed": {
+			niVlans:  []uint16{100, 200, 300},
+			appVlans: []uint16{200, 300},
+			applied:  []uint16{100, 200},
+			add:      []uint16{300},
+			del:      []uint16{100},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		vlans := trunkVlans("nbu1x1", test.niVlans, test.appVlans)
+		add := vlanDiff(vlans, test.applied)
+		del := vlanDiff(test.applied, vlans)
+		if !reflect.DeepEqual(add, test.add) {
+			t.Errorf("Test Failed: %s: Expected add %v, Actual: %v\n",
+				testname, test.add, add)
+		}
+		if !reflect.DeepEqual(del, test.del) {
+			t.Errorf("Test Failed: %s: Expected delete %v, Actual: %v\n",
+				testname, test.del, del)
+		}
+	}
+}
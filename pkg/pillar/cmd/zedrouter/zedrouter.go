@@ -17,6 +17,7 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/eriknordmark/netlink"
@@ -73,6 +74,21 @@ type zedrouterContext struct {
 	pubNetworkInstanceStatus  *pubsub.Publication
 	pubNetworkInstanceMetrics *pubsub.Publication
 	networkInstanceStatusMap  map[uuid.UUID]*types.NetworkInstanceStatus
+
+	// Multiple ports for Local network instances
+	uplinkStateMap     map[string]*uplinkState
+	uplinkProbeResults chan uplinkProbeResult
+
+	// AppQos applied to vifs
+	vifQosMap map[string]vifQosState
+	// VLANs added to the VIFs of the apps
+	vifTrunkMap map[string]vifTrunkState
+
+	// Metadata service on Local network instances. The servers by
+	// bridge name, and what they serve by bridge name and app IP.
+	metadataServers map[string]metadataServer
+	metadataLock    sync.Mutex
+	appMetadata     map[string]appMetadata
 }
 
 var debug = false
@@ -140,6 +156,12 @@ func Run() {
 	}
 	zedrouterCtx.networkInstanceStatusMap =
 		make(map[uuid.UUID]*types.NetworkInstanceStatus)
+	zedrouterCtx.uplinkStateMap = make(map[string]*uplinkState)
+	zedrouterCtx.uplinkProbeResults = make(chan uplinkProbeResult, 10)
+	zedrouterCtx.vifQosMap = make(map[string]vifQosState)
+	zedrouterCtx.vifTrunkMap = make(map[string]vifTrunkState)
+	zedrouterCtx.metadataServers = make(map[string]metadataServer)
+	zedrouterCtx.appMetadata = make(map[string]appMetadata)
 
 	subDeviceNetworkStatus, err := pubsub.Subscribe("nim",
 		types.DeviceNetworkStatus{}, false, &zedrouterCtx)
@@ -300,6 +322,10 @@ func Run() {
 	publishTimer := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
 
+	// Check whether it is time to probe the ports used by
+	// Local network instances
+	uplinkTimer := time.NewTicker(uplinkProbeTick)
+
 	updateLispConfiglets(&zedrouterCtx, zedrouterCtx.legacyDataPlane)
 
 	setFreeMgmtPorts(types.GetMgmtPortsFree(*zedrouterCtx.deviceNetworkStatus, 0))
@@ -382,6 +408,8 @@ func Run() {
 			}
 			ifname := PbrLinkChange(zedrouterCtx.deviceNetworkStatus,
 				change)
+			maybeTrunkVif(&zedrouterCtx, change)
+			maybeQosVif(&zedrouterCtx, change)
 			if ifname != "" &&
 				!types.IsMgmtPort(*zedrouterCtx.deviceNetworkStatus,
 					ifname) {
@@ -398,6 +426,13 @@ func Run() {
 				break
 			}
 			PbrRouteChange(zedrouterCtx.deviceNetworkStatus, change)
+			uplinkRouteChange(&zedrouterCtx, change)
+
+		case <-uplinkTimer.C:
+			uplinkProbeTimer(&zedrouterCtx)
+
+		case res := <-zedrouterCtx.uplinkProbeResults:
+			handleUplinkProbeResult(&zedrouterCtx, res)
 
 		case <-publishTimer.C:
 			log.Debugln("publishTimer at", time.Now())
@@ -440,6 +475,7 @@ func maybeHandleDNS(ctx *zedrouterContext) {
 
 	setFreeMgmtPorts(types.GetMgmtPortsFree(*ctx.deviceNetworkStatus, 0))
 	// XXX do a NatInactivate/NatActivate if management ports changed?
+	uplinkPortsChange(ctx)
 }
 
 func handleRestart(ctxArg interface{}, done bool) {
@@ -984,6 +1020,7 @@ func doActivate(ctx *zedrouterContext, config types.AppNetworkConfig,
 	appNetworkDoActivateAllUnderlayNetworks(ctx, config, status, ipsets)
 
 	status.Activated = true
+	updateAppMetadata(ctx, config, status)
 	publishAppNetworkStatus(ctx, status)
 	log.Infof("doActivate done for %s\n", config.DisplayName)
 }
@@ -1126,10 +1163,28 @@ func appNetworkDoActivateUnderlayNetwork(
 	ulStatus.BridgeIPAddr = bridgeIPAddr
 	// XXX appIPAddr is "" if bridge service
 	ulStatus.AssignedIPAddr = appIPAddr
+
+	var appIPv6Addr string
+	if networkInstanceInfo.HasIPv6() {
+		mac, _ := net.ParseMAC(appMac)
+		appIPv6Addr, err = lookupOrAllocateIPv6(ctx, netInstStatus,
+			mac)
+		if err != nil {
+			addError(ctx, status, "lookupOrAllocateIPv6", err)
+		}
+		log.Infof("bridgeIPv6Addr %s appIPv6Addr %s\n",
+			networkInstanceInfo.BridgeIPv6Addr, appIPv6Addr)
+		ulStatus.BridgeIPv6Addr = networkInstanceInfo.BridgeIPv6Addr
+		ulStatus.AssignedIPv6Addr = appIPv6Addr
+	}
+
 	hostsDirpath := runDirname + "/hosts." + bridgeName
 	if appIPAddr != "" {
-		addToHostsConfiglet(hostsDirpath, config.DisplayName,
-			[]string{appIPAddr})
+		addrs := []string{appIPAddr}
+		if appIPv6Addr != "" {
+			addrs = append(addrs, appIPv6Addr)
+		}
+		addToHostsConfiglet(hostsDirpath, config.DisplayName, addrs)
 	}
 
 	// Default ipset
@@ -1139,10 +1194,21 @@ func appNetworkDoActivateUnderlayNetwork(
 
 	// Set up ACLs
 	err = createACLConfiglet(bridgeName, vifName, false,
-		ulConfig.ACLs, bridgeIPAddr, appIPAddr)
+		ulConfig.ACLs, bridgeIPAddr, appIPAddr, ulConfig.ProbePort)
 	if err != nil {
 		addError(ctx, status, "createACL", err)
 	}
+	if appIPv6Addr != "" {
+		// Same ACLs using ip6tables
+		err = createACLConfiglet(bridgeName, vifName, false,
+			ulConfig.ACLs, ulStatus.BridgeIPv6Addr, appIPv6Addr, 0)
+		if err != nil {
+			addError(ctx, status, "createACL IPv6", err)
+		}
+	}
+	// In case the vif already exists
+	updateVifQos(ctx, vifName, ulConfig.Qos)
+	updateVifTrunk(ctx, vifName, ulConfig.Network, ulConfig.VlanTrunk)
 
 	if appIPAddr != "" {
 		// XXX clobber any IPv6 EID entry since same name
@@ -1150,6 +1216,10 @@ func appNetworkDoActivateUnderlayNetwork(
 		addhostDnsmasq(bridgeName, appMac, appIPAddr,
 			config.UUIDandVersion.UUID.String())
 	}
+	if appIPv6Addr != "" {
+		addhostDnsmasq(bridgeName, appMac, appIPv6Addr,
+			config.UUIDandVersion.UUID.String())
+	}
 
 	// Look for added or deleted ipsets
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
@@ -1159,7 +1229,7 @@ func appNetworkDoActivateUnderlayNetwork(
 		stopDnsmasq(bridgeName, true, false)
 		createDnsmasqConfiglet(bridgeName,
 			ulStatus.BridgeIPAddr, netInstConfig, hostsDirpath,
-			newIpsets, false)
+			newIpsets, false, getBridgeIPv6(networkInstanceInfo))
 		startDnsmasq(bridgeName)
 	}
 	networkInstanceInfo.AddVif(vifName, appMac,
@@ -1326,7 +1396,7 @@ func appNetworkDoActivateOverlayNetwork(
 
 	// Set up ACLs
 	err = createACLConfiglet(bridgeName, vifName, false,
-		olConfig.ACLs, olStatus.BridgeIPAddr, EID.String())
+		olConfig.ACLs, olStatus.BridgeIPAddr, EID.String(), 0)
 	if err != nil {
 		addError(ctx, status, "createACL", err)
 	}
@@ -1342,7 +1412,7 @@ func appNetworkDoActivateOverlayNetwork(
 		stopDnsmasq(bridgeName, true, false)
 		createDnsmasqConfiglet(bridgeName,
 			olStatus.BridgeIPAddr, netInstConfig, hostsDirpath,
-			newIpsets, netInstStatus.Ipv4Eid)
+			newIpsets, netInstStatus.Ipv4Eid, nil)
 		startDnsmasq(bridgeName)
 	}
 	netInstStatus.AddVif(vifName, appMac,
@@ -1581,7 +1651,7 @@ func doActivateAppInstanceWithMgmtLisp(
 
 	// Set up ACLs
 	err = createACLConfiglet(olIfname, olIfname, true, olConfig.ACLs,
-		"", "")
+		"", "", 0)
 	if err != nil {
 		addError(ctx, status, "createACL", err)
 	}
@@ -1834,6 +1904,7 @@ func doAppNetworkConfigModify(ctx *zedrouterContext, key string,
 		// in duplicate (but harmless) rules.
 		doActivate(ctx, config, status)
 	}
+	updateAppMetadata(ctx, config, status)
 
 	status.PendingModify = false
 	publishAppNetworkStatus(ctx, status)
@@ -1946,10 +2017,20 @@ func doAppNetworkModifyUnderlayNetwork(
 	// XXX Could ulStatus.Vif not be set? Means we didn't add
 	err := updateACLConfiglet(bridgeName, ulStatus.Vif, false,
 		ulStatus.ACLs, ulConfig.ACLs, ulStatus.BridgeIPAddr,
-		appIPAddr)
+		appIPAddr, ulStatus.ProbePort, ulConfig.ProbePort)
 	if err != nil {
 		addError(ctx, status, "updateACL", err)
 	}
+	if ulStatus.AssignedIPv6Addr != "" {
+		err := updateACLConfiglet(bridgeName, ulStatus.Vif, false,
+			ulStatus.ACLs, ulConfig.ACLs, ulStatus.BridgeIPv6Addr,
+			ulStatus.AssignedIPv6Addr, 0, 0)
+		if err != nil {
+			addError(ctx, status, "updateACL IPv6", err)
+		}
+	}
+	updateVifQos(ctx, ulStatus.Vif, ulConfig.Qos)
+	updateVifTrunk(ctx, ulStatus.Vif, ulConfig.Network, ulConfig.VlanTrunk)
 
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
 		netstatus.BridgeIPSets)
@@ -1959,7 +2040,8 @@ func doAppNetworkModifyUnderlayNetwork(
 		stopDnsmasq(bridgeName, true, false)
 		createDnsmasqConfiglet(bridgeName,
 			ulStatus.BridgeIPAddr, netconfig, hostsDirpath,
-			newIpsets, false)
+			newIpsets, false,
+			getBridgeIPv6(&netstatus.NetworkInstanceInfo))
 		startDnsmasq(bridgeName)
 	}
 	netstatus.BridgeIPSets = newIpsets
@@ -2020,7 +2102,7 @@ func doAppNetworkModifyOverlayNetwork(
 	// XXX Could olStatus.Vif not be set? Means we didn't add
 	err := updateACLConfiglet(bridgeName, olStatus.Vif, false,
 		olStatus.ACLs, olConfig.ACLs, olStatus.BridgeIPAddr,
-		olConfig.EID.String())
+		olConfig.EID.String(), 0, 0)
 	if err != nil {
 		addError(ctx, status, "updateACL", err)
 	}
@@ -2034,7 +2116,7 @@ func doAppNetworkModifyOverlayNetwork(
 		stopDnsmasq(bridgeName, true, false)
 		createDnsmasqConfiglet(bridgeName,
 			olStatus.BridgeIPAddr, netconfig, hostsDirpath,
-			newIpsets, netstatus.Ipv4Eid)
+			newIpsets, netstatus.Ipv4Eid, nil)
 		startDnsmasq(bridgeName)
 	}
 	netstatus.BridgeIPSets = newIpsets
@@ -2073,7 +2155,7 @@ func handleAppNetworkWithMgmtLispModify(ctx *zedrouterContext,
 
 	// Update ACLs
 	err := updateACLConfiglet(olIfname, olIfname, true, olStatus.ACLs,
-		olConfig.ACLs, "", "")
+		olConfig.ACLs, "", "", 0, 0)
 	if err != nil {
 		addError(ctx, status, "updateACL", err)
 	}
@@ -2148,6 +2230,7 @@ func doInactivateAppNetwork(ctx *zedrouterContext,
 
 	// Delete everything in underlay
 	appNetworkDoInactivateAllUnderlayNetworks(ctx, status, ipsets)
+	removeAppMetadata(ctx, status.Key())
 
 	status.Activated = false
 	publishAppNetworkStatus(ctx, status)
@@ -2208,6 +2291,9 @@ func appNetworkDoInactivateUnderlayNetwork(
 			// XXX publish error?
 			addError(ctx, status, "releaseIPv4", err)
 		}
+		if ulStatus.AssignedIPv6Addr != "" {
+			releaseIPv6FromNetworkInstance(ctx, netstatus, mac)
+		}
 	}
 
 	appIPAddr := ulStatus.AssignedIPAddr
@@ -2215,14 +2301,28 @@ func appNetworkDoInactivateUnderlayNetwork(
 		removehostDnsmasq(bridgeName, ulStatus.Mac,
 			appIPAddr)
 	}
+	appIPv6Addr := ulStatus.AssignedIPv6Addr
+	if appIPv6Addr != "" {
+		removehostDnsmasq(bridgeName, ulStatus.Mac,
+			appIPv6Addr)
+	}
 
 	// XXX Could ulStatus.Vif not be set? Means we didn't add
 	if ulStatus.Vif != "" {
 		err := deleteACLConfiglet(bridgeName, ulStatus.Vif, false,
-			ulStatus.ACLs, ulStatus.BridgeIPAddr, appIPAddr)
+			ulStatus.ACLs, ulStatus.BridgeIPAddr, appIPAddr,
+			ulStatus.ProbePort)
 		if err != nil {
 			addError(ctx, status, "deleteACL", err)
 		}
+		if appIPv6Addr != "" {
+			err := deleteACLConfiglet(bridgeName, ulStatus.Vif,
+				false, ulStatus.ACLs, ulStatus.BridgeIPv6Addr,
+				appIPv6Addr, 0)
+			if err != nil {
+				addError(ctx, status, "deleteACL IPv6", err)
+			}
+		}
 	} else {
 		log.Warnf("doInactivate(%s): no vifName for bridge %s for %s\n",
 			status.UUIDandVersion, bridgeName,
@@ -2241,10 +2341,13 @@ func appNetworkDoInactivateUnderlayNetwork(
 		stopDnsmasq(bridgeName, true, false)
 		createDnsmasqConfiglet(bridgeName,
 			ulStatus.BridgeIPAddr, netconfig, hostsDirpath,
-			newIpsets, false)
+			newIpsets, false,
+			getBridgeIPv6(&netstatus.NetworkInstanceInfo))
 		startDnsmasq(bridgeName)
 	}
 	netstatus.RemoveVif(ulStatus.Vif)
+	deleteVifQos(ctx, ulStatus.Vif)
+	deleteVifTrunk(ctx, ulStatus.Vif)
 	netstatus.BridgeIPSets = newIpsets
 	log.Infof("set BridgeIPSets to %v for %s", newIpsets, netstatus.Key())
 	maybeRemoveStaleIpsets(staleIpsets)
@@ -2307,7 +2410,7 @@ func appNetworkDoInactivateOverlayNetwork(
 	if olStatus.Vif != "" {
 		err := deleteACLConfiglet(bridgeName, olStatus.Vif, false,
 			olStatus.ACLs, olStatus.BridgeIPAddr,
-			olStatus.EID.String())
+			olStatus.EID.String(), 0)
 		if err != nil {
 			addError(ctx, status, "deleteACL", err)
 		}
@@ -2331,7 +2434,7 @@ func appNetworkDoInactivateOverlayNetwork(
 		stopDnsmasq(bridgeName, true, false)
 		createDnsmasqConfiglet(bridgeName,
 			olStatus.BridgeIPAddr, netconfig, hostsDirpath,
-			newIpsets, netstatus.Ipv4Eid)
+			newIpsets, netstatus.Ipv4Eid, nil)
 		startDnsmasq(bridgeName)
 	}
 	netstatus.RemoveVif(olStatus.Vif)
@@ -2485,7 +2588,7 @@ func doInactivateAppNetworkWithMgmtLisp(
 
 	// Delete ACLs
 	err = deleteACLConfiglet(olIfname, olIfname, true, olStatus.ACLs,
-		"", "")
+		"", "", 0)
 	if err != nil {
 		addError(ctx, status, "deleteACL", err)
 	}
@@ -2536,8 +2639,14 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 		return
 	}
 	log.Infof("handleGlobalConfigModify for %s\n", key)
-	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
+	var gcp *types.GlobalConfig
+	debug, gcp = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
 		debugOverride)
+	if gcp != nil {
+		updateMetricsExportACLs(ctx, newMetricsExportConfig(ctx,
+			gcp.MetricsExportPort, gcp.MetricsExportListen,
+			gcp.MetricsExportAllow))
+	}
 	log.Infof("handleGlobalConfigModify done for %s\n", key)
 }
 
@@ -2552,6 +2661,7 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 	log.Infof("handleGlobalConfigDelete for %s\n", key)
 	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
 		debugOverride)
+	updateMetricsExportACLs(ctx, metricsExportConfig{})
 	log.Infof("handleGlobalConfigDelete done for %s\n", key)
 }
 
@@ -0,0 +1,461 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Staged connectivity test of the management ports. Each stage is timed
+// and a failure is categorized so that the controller can show exactly
+// which step broke. This complements zedcloud.VerifyAllIntf which only
+// tells whether the controller is reachable.
+
+package devicenetwork
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/eriknordmark/netlink"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/zedcloud"
+)
+
+// Timeout for each of the network stages
+const connTestTimeout = 10 * time.Second
+
+// The ports are tested in parallel and all of the tests have to complete
+// within connTestDeadline since nim waits for the results
+const connTestDeadline = 20 * time.Second
+
+type portTest struct {
+	status   *types.DeviceNetworkStatus
+	port     *types.NetworkPortStatus
+	result   types.PortTestResult
+	deadline time.Time
+	src      net.IP
+	conn     net.Conn
+}
+
+// testPortsConnectivity runs the stages on all of the management ports.
+// If serverNameAndPort is empty only the local stages are run.
+func testPortsConnectivity(status types.DeviceNetworkStatus,
+	serverNameAndPort string, tlsConfig *tls.Config) []types.PortTestResult {
+
+	var ports []*types.NetworkPortStatus
+	for _, ifname := range types.GetMgmtPortsAny(status, 0) {
+		port := status.GetPortByIfName(ifname)
+		if port != nil {
+			ports = append(ports, port)
+		}
+	}
+	deadline := time.Now().Add(connTestDeadline)
+	results := make([]types.PortTestResult, len(ports))
+	var wg sync.WaitGroup
+	for i, port := range ports {
+		wg.Add(1)
+		go func(i int, port *types.NetworkPortStatus) {
+			defer wg.Done()
+			results[i] = testPortConnectivity(&status, port,
+				serverNameAndPort, tlsConfig, deadline)
+		}(i, port)
+	}
+	wg.Wait()
+	for _, result := range results {
+		if failed := result.FailedStage(); failed != nil {
+			log.Warnf("testPortsConnectivity(%s) failed at %s: %s\n",
+				result.IfName, failed.Stage, failed.Error)
+		} else {
+			log.Infof("testPortsConnectivity(%s) passed %d stages\n",
+				result.IfName, len(result.Stages))
+		}
+	}
+	return results
+}
+
+// TestResultsChanged ignores the timing so that we only republish when
+// a port starts or stops failing, or fails in a different way
+func TestResultsChanged(old, new []types.PortTestResult) bool {
+	if len(old) != len(new) {
+		return true
+	}
+	for i := range old {
+		if old[i].IfName != new[i].IfName ||
+			len(old[i].Stages) != len(new[i].Stages) {
+			return true
+		}
+		for j := range old[i].Stages {
+			o, n := old[i].Stages[j], new[i].Stages[j]
+			if o.Stage != n.Stage || o.ErrorCode != n.ErrorCode {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func testPortConnectivity(status *types.DeviceNetworkStatus,
+	port *types.NetworkPortStatus, serverNameAndPort string,
+	tlsConfig *tls.Config, deadline time.Time) types.PortTestResult {
+
+	t := &portTest{
+		status: status,
+		port:   port,
+		result: types.PortTestResult{
+			IfName:   port.IfName,
+			TestTime: time.Now(),
+		},
+		deadline: deadline,
+	}
+	defer func() {
+		if t.conn != nil {
+			t.conn.Close()
+		}
+	}()
+	if !t.run(types.StageCarrier, t.testCarrier) ||
+		!t.run(types.StageAddress, t.testAddress) ||
+		!t.run(types.StageDefaultRoute, t.testDefaultRoute) {
+		return t.result
+	}
+	if serverNameAndPort == "" {
+		return t.result
+	}
+	server := serverNameAndPort
+	if !strings.Contains(server, ":") {
+		server += ":443"
+	}
+	serverName := strings.Split(server, ":")[0]
+	testURL := "https://" + serverNameAndPort + "/api/v1/edgedevice/ping"
+
+	// Where we connect to; the controller or the proxy
+	var proxyURL *url.URL
+	dest := server
+	if !IsProxyConfigEmpty(port.ProxyConfig) {
+		ok := t.run(types.StageProxy, func() (types.ConnectivityError, error) {
+			var err error
+			proxyURL, err = zedcloud.LookupProxy(status, port.IfName,
+				testURL)
+			if err != nil {
+				return types.ConnErrProxy, err
+			}
+			if proxyURL != nil && proxyURL.Scheme != "http" {
+				errStr := fmt.Sprintf("unsupported proxy scheme %s",
+					proxyURL.Scheme)
+				return types.ConnErrProxy, errors.New(errStr)
+			}
+			return types.ConnErrNone, nil
+		})
+		if !ok {
+			return t.result
+		}
+		if proxyURL != nil {
+			dest = proxyURL.Host
+			if proxyURL.Port() == "" {
+				dest += ":80"
+			}
+		}
+	}
+	var addrs []net.IP
+	destHost, destPort, _ := net.SplitHostPort(dest)
+	if ip := net.ParseIP(destHost); ip != nil {
+		addrs = []net.IP{ip}
+	} else {
+		ok := t.run(types.StageDNS, func() (types.ConnectivityError, error) {
+			var code types.ConnectivityError
+			var err error
+			addrs, code, err = t.resolve(destHost)
+			return code, err
+		})
+		if !ok {
+			return t.result
+		}
+	}
+	if !t.run(types.StageTCPConnect, func() (types.ConnectivityError, error) {
+		return t.connect(addrs, destPort, proxyURL, server)
+	}) {
+		return t.result
+	}
+	if tlsConfig == nil {
+		return t.result
+	}
+	var tlsConn *tls.Conn
+	if !t.run(types.StageTLS, func() (types.ConnectivityError, error) {
+		cfg := tlsConfig.Clone()
+		cfg.ServerName = serverName
+		tlsConn = tls.Client(t.conn, cfg)
+		tlsConn.SetDeadline(t.stageDeadline())
+		if err := tlsConn.Handshake(); err != nil {
+			return classifyTLSError(err), err
+		}
+		t.conn = tlsConn
+		return types.ConnErrNone, nil
+	}) {
+		return t.result
+	}
+	t.run(types.StageHTTP, func() (types.ConnectivityError, error) {
+		req, err := http.NewRequest("GET", testURL, nil)
+		if err != nil {
+			return types.ConnErrOther, err
+		}
+		tlsConn.SetDeadline(t.stageDeadline())
+		if err := req.Write(tlsConn); err != nil {
+			return classifyNetError(err), err
+		}
+		resp, err := http.ReadResponse(bufio.NewReader(tlsConn), req)
+		if err != nil {
+			return classifyNetError(err), err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			errStr := fmt.Sprintf("status code %d %s", resp.StatusCode,
+				http.StatusText(resp.StatusCode))
+			return types.ConnErrHTTPStatus, errors.New(errStr)
+		}
+		return types.ConnErrNone, nil
+	})
+	return t.result
+}
+
+// stageDeadline is when the current stage has to be done
+func (t *portTest) stageDeadline() time.Time {
+	deadline := time.Now().Add(connTestTimeout)
+	if t.deadline.Before(deadline) {
+		return t.deadline
+	}
+	return deadline
+}
+
+// run records the result of the stage and returns true if it succeeded
+func (t *portTest) run(stage types.ConnectivityStage,
+	f func() (types.ConnectivityError, error)) bool {
+
+	start := time.Now()
+	var code types.ConnectivityError
+	var err error
+	if start.Before(t.deadline) {
+		code, err = f()
+	} else {
+		code, err = types.ConnErrTimeout,
+			errors.New("connectivity test deadline exceeded")
+	}
+	sr := types.StageResult{
+		Stage:     stage,
+		Duration:  time.Since(start),
+		ErrorCode: code,
+	}
+	if err != nil {
+		if sr.ErrorCode == types.ConnErrNone {
+			sr.ErrorCode = types.ConnErrOther
+		}
+		sr.Error = err.Error()
+	}
+	log.Debugf("portTest(%s) %s: %+v\n", t.port.IfName, stage, sr)
+	t.result.Stages = append(t.result.Stages, sr)
+	return sr.ErrorCode == types.ConnErrNone
+}
+
+func (t *portTest) testCarrier() (types.ConnectivityError, error) {
+	link, err := netlink.LinkByName(t.port.IfName)
+	if err != nil {
+		return types.ConnErrNoCarrier, err
+	}
+	attrs := link.Attrs()
+	if attrs.Flags&net.FlagUp == 0 {
+		return types.ConnErrNoCarrier, errors.New("link is down")
+	}
+	switch attrs.OperState {
+	case netlink.OperDown, netlink.OperLowerLayerDown,
+		netlink.OperNotPresent:
+		errStr := fmt.Sprintf("no carrier; state %s", attrs.OperState)
+		return types.ConnErrNoCarrier, errors.New(errStr)
+	}
+	return types.ConnErrNone, nil
+}
+
+func (t *portTest) testAddress() (types.ConnectivityError, error) {
+	ifname := t.port.IfName
+	if types.CountLocalAddrAnyNoLinkLocalIf(*t.status, ifname) == 0 {
+		return types.ConnErrNoAddress, errors.New("no IP address")
+	}
+	src, err := types.GetLocalAddrAnyNoLinkLocal(*t.status, 0, ifname)
+	if err != nil {
+		return types.ConnErrNoAddress, err
+	}
+	t.src = src
+	return types.ConnErrNone, nil
+}
+
+func (t *portTest) testDefaultRoute() (types.ConnectivityError, error) {
+	ifindex, err := IfnameToIndex(t.port.IfName)
+	if err != nil {
+		return types.ConnErrNoRoute, err
+	}
+	filter := netlink.Route{LinkIndex: ifindex}
+	routes, err := netlink.RouteListFiltered(netlink.FAMILY_ALL, &filter,
+		netlink.RT_FILTER_OIF)
+	if err != nil {
+		return types.ConnErrNoRoute, err
+	}
+	for _, rt := range routes {
+		if rt.Dst == nil {
+			return types.ConnErrNone, nil
+		}
+	}
+	return types.ConnErrNoRoute, errors.New("no default route")
+}
+
+// resolve uses the DNS servers of the port
+func (t *portTest) resolve(host string) ([]net.IP, types.ConnectivityError, error) {
+	servers := t.port.DnsServers
+	if len(servers) == 0 {
+		return nil, types.ConnErrDNSFailure, errors.New("no DNS servers")
+	}
+	next := 0
+	resolver := net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			server := servers[next%len(servers)]
+			next++
+			d := net.Dialer{LocalAddr: &net.UDPAddr{IP: t.src}}
+			return d.DialContext(ctx, "udp",
+				net.JoinHostPort(server.String(), "53"))
+		},
+	}
+	ctx, cancel := context.WithDeadline(context.Background(),
+		t.stageDeadline())
+	defer cancel()
+	ipAddrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok {
+			if dnsErr.IsTimeout {
+				return nil, types.ConnErrTimeout, err
+			}
+			if strings.Contains(dnsErr.Err, "no such host") {
+				return nil, types.ConnErrDNSNotFound, err
+			}
+		}
+		return nil, types.ConnErrDNSFailure, err
+	}
+	var addrs []net.IP
+	for _, ia := range ipAddrs {
+		addrs = append(addrs, ia.IP)
+	}
+	return addrs, types.ConnErrNone, nil
+}
+
+// connect tries the addresses in turn. With a proxy we also have it
+// connect to the server.
+func (t *portTest) connect(addrs []net.IP, port string, proxyURL *url.URL,
+	server string) (types.ConnectivityError, error) {
+
+	code := types.ConnErrOther
+	err := errors.New("no addresses")
+	d := net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: t.src},
+		Deadline:  t.stageDeadline(),
+	}
+	for _, addr := range addrs {
+		var conn net.Conn
+		conn, err = d.Dial("tcp", net.JoinHostPort(addr.String(), port))
+		if err != nil {
+			code = classifyNetError(err)
+			continue
+		}
+		t.conn = conn
+		if proxyURL == nil {
+			return types.ConnErrNone, nil
+		}
+		return proxyConnect(conn, proxyURL, server, t.stageDeadline())
+	}
+	return code, err
+}
+
+// proxyConnect sends an HTTP CONNECT to the proxy
+func proxyConnect(conn net.Conn, proxyURL *url.URL, server string,
+	deadline time.Time) (types.ConnectivityError, error) {
+
+	req := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: server},
+		Host:   server,
+		Header: make(http.Header),
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		auth := base64.StdEncoding.EncodeToString(
+			[]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	conn.SetDeadline(deadline)
+	if err := req.Write(conn); err != nil {
+		return classifyNetError(err), err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return classifyNetError(err), err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		errStr := fmt.Sprintf("proxy CONNECT returned %d %s",
+			resp.StatusCode, http.StatusText(resp.StatusCode))
+		return types.ConnErrProxy, errors.New(errStr)
+	}
+	return types.ConnErrNone, nil
+}
+
+func classifyNetError(err error) types.ConnectivityError {
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return types.ConnErrTimeout
+	}
+	opErr, ok := err.(*net.OpError)
+	if !ok {
+		return types.ConnErrOther
+	}
+	sysErr, ok := opErr.Err.(*os.SyscallError)
+	if !ok {
+		return types.ConnErrOther
+	}
+	switch sysErr.Err {
+	case syscall.ECONNREFUSED:
+		return types.ConnErrRefused
+	case syscall.ENETUNREACH, syscall.EHOSTUNREACH:
+		return types.ConnErrUnreachable
+	case syscall.ETIMEDOUT:
+		return types.ConnErrTimeout
+	}
+	return types.ConnErrOther
+}
+
+// classifyTLSError looks at any wrapped errors since newer Go versions
+// wrap the x509 errors
+func classifyTLSError(err error) types.ConnectivityError {
+	for e := err; e != nil; {
+		switch e.(type) {
+		case x509.UnknownAuthorityError:
+			return types.ConnErrCertUnknownAuthority
+		case x509.HostnameError:
+			return types.ConnErrCertHostname
+		case x509.CertificateInvalidError:
+			return types.ConnErrCertInvalid
+		}
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	if code := classifyNetError(err); code != types.ConnErrOther {
+		return code
+	}
+	return types.ConnErrTLS
+}
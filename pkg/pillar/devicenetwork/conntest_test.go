@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devicenetwork
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+func TestPortTestDeadline(t *testing.T) {
+	port := &types.NetworkPortStatus{IfName: "eth0"}
+	pt := &portTest{
+		port:     port,
+		deadline: time.Now().Add(time.Second),
+	}
+	if pt.stageDeadline().After(pt.deadline) {
+		t.Errorf("stageDeadline %v is after the deadline %v",
+			pt.stageDeadline(), pt.deadline)
+	}
+	if !pt.run(types.StageCarrier, func() (types.ConnectivityError, error) {
+		return types.ConnErrNone, nil
+	}) {
+		t.Errorf("Stage failed before the deadline")
+	}
+	pt.deadline = time.Now().Add(-time.Second)
+	called := false
+	if pt.run(types.StageAddress, func() (types.ConnectivityError, error) {
+		called = true
+		return types.ConnErrNone, nil
+	}) {
+		t.Errorf("Stage succeeded after the deadline")
+	}
+	if called {
+		t.Errorf("Stage ran after the deadline")
+	}
+	if len(pt.result.Stages) != 2 ||
+		pt.result.Stages[1].ErrorCode != types.ConnErrTimeout {
+		t.Errorf("Unexpected stages %+v", pt.result.Stages)
+	}
+	pt.deadline = time.Now().Add(time.Second)
+	if pt.run(types.StageDNS, func() (types.ConnectivityError, error) {
+		return types.ConnErrNone, errors.New("failed")
+	}) {
+		t.Errorf("Stage succeeded with an error")
+	}
+	if pt.result.Stages[2].ErrorCode != types.ConnErrOther {
+		t.Errorf("Expected %v, Actual: %v\n", types.ConnErrOther,
+			pt.result.Stages[2].ErrorCode)
+	}
+}
+
+// The ports are tested in parallel but the results are in port order
+func TestPortsConnectivityOrder(t *testing.T) {
+	status := types.DeviceNetworkStatus{
+		Version: types.DPCIsMgmt,
+		Ports: []types.NetworkPortStatus{
+			{IfName: "nonexistent0", IsMgmt: true},
+			{IfName: "nonexistent1", IsMgmt: true},
+			{IfName: "nonexistent2", IsMgmt: false},
+			{IfName: "nonexistent3", IsMgmt: true},
+		},
+	}
+	results := testPortsConnectivity(status, "", nil)
+	expected := []string{"nonexistent0", "nonexistent1", "nonexistent3"}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results, Actual: %d\n", len(expected),
+			len(results))
+	}
+	for i, result := range results {
+		if result.IfName != expected[i] {
+			t.Errorf("Result %d: Expected %s, Actual: %s\n", i,
+				expected[i], result.IfName)
+		}
+		failed := result.FailedStage()
+		if failed == nil || failed.Stage != types.StageCarrier {
+			t.Errorf("%s: expected a carrier failure, got %+v",
+				result.IfName, result.Stages)
+		}
+	}
+}
@@ -30,7 +30,14 @@ func MakeDevicePortConfig(globalConfig types.DeviceNetworkConfig) types.DevicePo
 
 func LastResortDevicePortConfig(ports []string) types.DevicePortConfig {
 
-	config := makeDevicePortConfig(ports, ports)
+	// All except cellular modems are assumed to be free
+	var free []string
+	for _, u := range ports {
+		if !IsCellularIfname(u) {
+			free = append(free, u)
+		}
+	}
+	config := makeDevicePortConfig(ports, free)
 	// Set to higher than all zero but lower than the hardware model derived one above
 	config.TimePriority = time.Unix(0, 0)
 	return config
@@ -52,6 +59,11 @@ func makeDevicePortConfig(ports []string, free []string) types.DevicePortConfig
 		config.Ports[ix].IsMgmt = true
 		config.Ports[ix].Name = config.Ports[ix].IfName
 		config.Ports[ix].Dhcp = types.DT_CLIENT
+		if IsCellularIfname(u) {
+			config.Ports[ix].Cellular = &types.CellularConfig{
+				APN: DefaultCellularAPN,
+			}
+		}
 	}
 	return config
 }
@@ -68,8 +80,9 @@ func IsProxyConfigEmpty(proxyConfig types.ProxyConfig) bool {
 }
 
 // Check if device can talk to outside world via atleast one of the free uplinks
+// Also returns the per port results of the staged connectivity test
 func VerifyDeviceNetworkStatus(status types.DeviceNetworkStatus,
-	retryCount int) error {
+	retryCount int) ([]types.PortTestResult, error) {
 
 	log.Infof("VerifyDeviceNetworkStatus() %d\n", retryCount)
 	// Check if it is 1970 in which case we declare success since
@@ -77,7 +90,7 @@ func VerifyDeviceNetworkStatus(status types.DeviceNetworkStatus,
 	// forward.
 	if time.Now().Year() == 1970 {
 		log.Infof("VerifyDeviceNetworkStatus skip due to 1970")
-		return nil
+		return nil, nil
 	}
 
 	serverFileName := "/config/server"
@@ -105,7 +118,8 @@ func VerifyDeviceNetworkStatus(status types.DeviceNetworkStatus,
 		if err != nil {
 			errStr := "Onboarding certificate cannot be found"
 			log.Infof("VerifyDeviceNetworkStatus: %s\n", errStr)
-			return errors.New(errStr)
+			return testPortsConnectivity(status, "", nil),
+				errors.New(errStr)
 		}
 		clientCert := &onboardingCert
 		tlsConfig, err = zedcloud.GetTlsConfig(serverName, clientCert)
@@ -113,7 +127,8 @@ func VerifyDeviceNetworkStatus(status types.DeviceNetworkStatus,
 			errStr := "TLS configuration for talking to Zedcloud cannot be found"
 
 			log.Infof("VerifyDeviceNetworkStatus: %s\n", errStr)
-			return errors.New(errStr)
+			return testPortsConnectivity(status, "", nil),
+				errors.New(errStr)
 		}
 	}
 	zedcloudCtx.TlsConfig = tlsConfig
@@ -122,23 +137,32 @@ func VerifyDeviceNetworkStatus(status types.DeviceNetworkStatus,
 		if err != nil {
 			errStr := fmt.Sprintf("GetNetworkProxy failed %s", err)
 			log.Errorf("VerifyDeviceNetworkStatus: %s\n", errStr)
-			return errors.New(errStr)
+			return testPortsConnectivity(status, "", nil),
+				errors.New(errStr)
 		}
 	}
+	// The staged tests run while VerifyAllIntf is trying the controller
+	// so that they add little to how long nim waits
+	resultsChan := make(chan []types.PortTestResult, 1)
+	go func() {
+		resultsChan <- testPortsConnectivity(status, serverNameAndPort,
+			tlsConfig)
+	}()
 	cloudReachable, err := zedcloud.VerifyAllIntf(zedcloudCtx, testUrl, retryCount, 1)
+	results := <-resultsChan
 	if err != nil {
 		log.Errorf("VerifyDeviceNetworkStatus: VerifyAllIntf failed %s\n",
 			err)
-		return err
+		return results, err
 	}
 
 	if cloudReachable {
 		log.Infof("Uplink test SUCCESS to URL: %s", testUrl)
-		return nil
+		return results, nil
 	}
 	errStr := fmt.Sprintf("Uplink test FAIL to URL: %s", testUrl)
 	log.Errorf("VerifyDeviceNetworkStatus: %s\n", errStr)
-	return errors.New(errStr)
+	return results, errors.New(errStr)
 }
 
 // Calculate local IP addresses to make a types.DeviceNetworkStatus
@@ -155,6 +179,7 @@ func MakeDeviceNetworkStatus(globalConfig types.DevicePortConfig, oldStatus type
 		globalStatus.Ports[ix].Name = u.Name
 		globalStatus.Ports[ix].IsMgmt = u.IsMgmt
 		globalStatus.Ports[ix].Free = u.Free
+		globalStatus.Ports[ix].Vlan = u.Vlan
 		globalStatus.Ports[ix].ProxyConfig = u.ProxyConfig
 		// Set fields from the config...
 		globalStatus.Ports[ix].Dhcp = u.Dhcp
@@ -166,6 +191,12 @@ func MakeDeviceNetworkStatus(globalConfig types.DevicePortConfig, oldStatus type
 		globalStatus.Ports[ix].DomainName = u.DomainName
 		globalStatus.Ports[ix].NtpServer = u.NtpServer
 		globalStatus.Ports[ix].DnsServers = u.DnsServers
+		if u.IsWifi() {
+			globalStatus.Ports[ix].Wifi = getWifiStatus(u.IfName)
+		}
+		if u.IsCellular() {
+			globalStatus.Ports[ix].Cellular = getCellularStatus(u.IfName)
+		}
 		ifindex, err := IfnameToIndex(u.IfName)
 		if err != nil {
 			errStr := fmt.Sprintf("Port %s does not exist - ignored",
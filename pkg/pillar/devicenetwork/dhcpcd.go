@@ -1,12 +1,13 @@
 // Copyright (c) 2018 Zededa, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
-// Manage dhcpcd for ports including static
-// XXX wwan0? Skip for now
+// Manage dhcpcd for ports including static.
+// The wwan container sets the address on cellular ports.
 
 package devicenetwork
 
 import (
+	"errors"
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/agentlog"
@@ -22,6 +23,13 @@ import (
 	"time"
 )
 
+const (
+	// The configuration common to all ports
+	dhcpcdConfigFile = "/dhcpcd.conf"
+	// The configuration for each DHCP client port
+	dhcpcdConfigDir = "/run/dhcpcd-conf"
+)
+
 // Start/modify/delete dhcpcd per interface
 func UpdateDhcpClient(newConfig, oldConfig types.DevicePortConfig) {
 
@@ -65,15 +73,14 @@ func doDhcpClientActivate(nuc types.NetworkPortConfig) {
 	log.Infof("doDhcpClientActivate(%s) dhcp %v addr %s gateway %s\n",
 		nuc.IfName, nuc.Dhcp, nuc.AddrSubnet,
 		nuc.Gateway.String())
-	// XXX skipping wwan0
-	if nuc.IfName == "wwan0" {
+	if nuc.IsCellular() || IsCellularIfname(nuc.IfName) {
 		log.Infof("doDhcpClientActivate: skipping %s\n",
 			nuc.IfName)
 		return
 	}
 
 	// Check the ifname exists
-	_, err := IfnameToIndex(nuc.IfName)
+	ifindex, err := IfnameToIndex(nuc.IfName)
 	if err != nil {
 		log.Warnf("doDhcpClientActivate(%s) failed %s", nuc.IfName, err)
 		return
@@ -90,7 +97,12 @@ func doDhcpClientActivate(nuc types.NetworkPortConfig) {
 			time.Sleep(10 * time.Second)
 		}
 		log.Infof("dhcpcd %s not running", nuc.IfName)
-		extras := []string{"-f", "/dhcpcd.conf", "--nobackground",
+		configFile, err := writeDhcpcdConfig(nuc.IfName, ifindex)
+		if err != nil {
+			log.Errorf("doDhcpClientActivate: %s\n", err)
+			configFile = dhcpcdConfigFile
+		}
+		extras := []string{"-f", configFile, "--nobackground",
 			"-d", "--noipv4ll"}
 		if nuc.Gateway != nil && nuc.Gateway.String() == "0.0.0.0" {
 			extras = append(extras, "--nogateway")
@@ -138,7 +150,7 @@ func doDhcpClientActivate(nuc types.NetworkPortConfig) {
 		log.Infof("dhcpcd %s not running", nuc.IfName)
 		args := []string{fmt.Sprintf("ip_address=%s", nuc.AddrSubnet)}
 
-		extras := []string{"-f", "/dhcpcd.conf", "--nobackground",
+		extras := []string{"-f", dhcpcdConfigFile, "--nobackground",
 			"-d"}
 		if nuc.Gateway == nil || nuc.Gateway.String() == "0.0.0.0" {
 			extras = append(extras, "--nogateway")
@@ -182,8 +194,7 @@ func doDhcpClientInactivate(nuc types.NetworkPortConfig) {
 	log.Infof("doDhcpClientInactivate(%s) dhcp %v addr %s gateway %s\n",
 		nuc.IfName, nuc.Dhcp, nuc.AddrSubnet,
 		nuc.Gateway.String())
-	// XXX skipping wwan0
-	if nuc.IfName == "wwan0" {
+	if nuc.IsCellular() || IsCellularIfname(nuc.IfName) {
 		log.Infof("doDhcpClientInactivate: skipping %s\n",
 			nuc.IfName)
 		return
@@ -203,12 +214,53 @@ func doDhcpClientInactivate(nuc types.NetworkPortConfig) {
 			time.Sleep(10 * time.Second)
 		}
 		log.Infof("dhcpcd %s gone", nuc.IfName)
+		os.Remove(dhcpcdConfigFilename(nuc.IfName))
 	default:
 		log.Errorf("doDhcpClientInactivate: unsupported dhcp %v\n",
 			nuc.Dhcp)
 	}
 }
 
+// dhcpcdConfig returns the configuration for a DHCP client port; the
+// common configuration plus a block for the port which requests an IPv6
+// address and a prefix for the network instances. The prefix is not
+// assigned to any interface; zedrouter does that for the bridges.
+// The ifindex is the IAID since it has to be unique per port.
+func dhcpcdConfig(common []byte, ifname string, ifindex int) string {
+
+	var b strings.Builder
+	b.Write(common)
+	fmt.Fprintf(&b, "\ninterface %s\n", ifname)
+	fmt.Fprintf(&b, "ia_na %d\n", ifindex)
+	fmt.Fprintf(&b, "ia_pd %d -\n", ifindex)
+	return b.String()
+}
+
+// writeDhcpcdConfig writes the configuration for the port and returns
+// the file name
+func writeDhcpcdConfig(ifname string, ifindex int) (string, error) {
+
+	common, err := ioutil.ReadFile(dhcpcdConfigFile)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dhcpcdConfigDir, 0755); err != nil {
+		return "", err
+	}
+	filename := dhcpcdConfigFilename(ifname)
+	content := dhcpcdConfig(common, ifname, ifindex)
+	if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+		errStr := fmt.Sprintf("writeDhcpcdConfig(%s) failed: %s",
+			ifname, err)
+		return "", errors.New(errStr)
+	}
+	return filename, nil
+}
+
+func dhcpcdConfigFilename(ifname string) string {
+	return fmt.Sprintf("%s/%s.conf", dhcpcdConfigDir, ifname)
+}
+
 func dhcpcdCmd(op string, extras []string, ifname string, dolog bool) bool {
 	name := "dhcpcd"
 	args := append([]string{op}, extras...)
@@ -0,0 +1,22 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devicenetwork
+
+import (
+	"testing"
+)
+
+func TestDhcpcdConfig(t *testing.T) {
+	common := []byte("hostname\nwaitip 4\n")
+	expected := "hostname\nwaitip 4\n" +
+		"\ninterface eth1\nia_na 3\nia_pd 3 -\n"
+	config := dhcpcdConfig(common, "eth1", 3)
+	if config != expected {
+		t.Errorf("Expected %q, Actual: %q\n", expected, config)
+	}
+	// Each port requests its own IAs
+	if dhcpcdConfig(common, "eth2", 4) == config {
+		t.Errorf("Same configuration for two ports")
+	}
+}
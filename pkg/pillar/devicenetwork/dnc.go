@@ -265,6 +265,9 @@ func VerifyPending(pending *DPCPending,
 
 	if !reflect.DeepEqual(pending.PendDPC.Ports, pending.OldDPC.Ports) {
 		log.Infof("VerifyPending: DPC changed. update DhcpClient.\n")
+		UpdateVlanPorts(pending.PendDPC, pending.OldDPC)
+		UpdateWifiPorts(pending.PendDPC, pending.OldDPC)
+		UpdateCellularPorts(pending.PendDPC, pending.OldDPC)
 		UpdateDhcpClient(pending.PendDPC, pending.OldDPC)
 		pending.OldDPC = pending.PendDPC
 	}
@@ -291,6 +294,8 @@ func VerifyPending(pending *DPCPending,
 				errStr, pending.PendDNS)
 			pending.PendDPC.LastFailed = time.Now()
 			pending.PendDPC.LastError = errStr
+			pending.PendDPC.TestResults = testPortsConnectivity(
+				pending.PendDNS, "", nil)
 			return DPC_FAIL
 		}
 	}
@@ -298,7 +303,8 @@ func VerifyPending(pending *DPCPending,
 	pending.TestCount = MaxDPCRetestCount
 
 	// We want connectivity to zedcloud via atleast one Management port.
-	err := VerifyDeviceNetworkStatus(pending.PendDNS, 1)
+	results, err := VerifyDeviceNetworkStatus(pending.PendDNS, 1)
+	pending.PendDPC.TestResults = results
 	status := DPC_FAIL
 	if err == nil {
 		pending.PendDPC.LastSucceeded = time.Now()
@@ -645,6 +651,9 @@ func (ctx *DeviceNetworkContext) doApplyDevicePortConfig(delete bool) {
 	if !reflect.DeepEqual(*ctx.DevicePortConfig, portConfig) {
 		log.Infof("doApplyDevicePortConfig: DevicePortConfig changed. " +
 			"update DhcpClient.\n")
+		UpdateVlanPorts(portConfig, *ctx.DevicePortConfig)
+		UpdateWifiPorts(portConfig, *ctx.DevicePortConfig)
+		UpdateCellularPorts(portConfig, *ctx.DevicePortConfig)
 		UpdateDhcpClient(portConfig, *ctx.DevicePortConfig)
 		*ctx.DevicePortConfig = portConfig
 	} else {
@@ -753,6 +762,7 @@ func updatePortConfig(ctx *DeviceNetworkContext, oldConfig *types.DevicePortConf
 	portConfig.LastFailed = oldConfig.LastFailed
 	portConfig.LastError = oldConfig.LastError
 	portConfig.LastSucceeded = oldConfig.LastSucceeded
+	portConfig.TestResults = oldConfig.TestResults
 	log.Infof("updatePortConfig: diff time remove+add  %+v\n",
 		portConfig)
 	removePortConfig(ctx, *oldConfig)
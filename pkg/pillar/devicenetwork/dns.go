@@ -28,6 +28,7 @@ func GetDhcpInfo(us *types.NetworkPortStatus) error {
 	if us.Dhcp != types.DT_CLIENT {
 		return nil
 	}
+	us.DelegatedPrefix = getDelegatedPrefix(us.IfName)
 	// XXX get error -1 unless we have -4
 	// XXX add IPv6 support
 	log.Infof("Calling dhcpcd -U -4 %s\n", us.IfName)
@@ -107,6 +108,48 @@ func GetDhcpInfo(us *types.NetworkPortStatus) error {
 	return nil
 }
 
+// Get the first prefix delegated by DHCPv6 to the interface if any
+// dhcpcd -U -6 eth0 | grep ia_pd
+// dhcp6_ia_pd1_prefix1=2001:db8:1200::
+// dhcp6_ia_pd1_prefix1_length=56
+func getDelegatedPrefix(ifname string) net.IPNet {
+	var prefix net.IPNet
+
+	cmd := wrap.Command("dhcpcd", "-U", "-6", ifname)
+	stdoutStderr, err := cmd.CombinedOutput()
+	if err != nil {
+		// No DHCPv6 lease
+		log.Debugf("dhcpcd -U -6 failed %s: %s\n",
+			string(stdoutStderr), err)
+		return prefix
+	}
+	var ip net.IP
+	masklen := 0
+	for _, line := range strings.Split(string(stdoutStderr), "\n") {
+		items := strings.Split(line, "=")
+		if len(items) != 2 {
+			continue
+		}
+		switch items[0] {
+		case "dhcp6_ia_pd1_prefix1":
+			ip = net.ParseIP(trimQuotes(items[1]))
+			if ip == nil {
+				log.Errorf("Failed to parse %s\n", items[1])
+			}
+		case "dhcp6_ia_pd1_prefix1_length":
+			masklen, err = strconv.Atoi(trimQuotes(items[1]))
+			if err != nil {
+				log.Errorf("Failed to parse masklen %s\n", items[1])
+			}
+		}
+	}
+	if ip != nil && masklen != 0 {
+		prefix = net.IPNet{IP: ip, Mask: net.CIDRMask(masklen, 128)}
+		log.Infof("getDelegatedPrefix(%s) %s\n", ifname, prefix.String())
+	}
+	return prefix
+}
+
 // Remove single or double qoutes
 func trimQuotes(str string) string {
 	if len(str) < 2 {
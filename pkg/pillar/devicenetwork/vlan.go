@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Manage the 802.1Q VLAN sub-interfaces which are ports in the
+// DevicePortConfig. They need to exist before we run dhcpcd on them.
+
+package devicenetwork
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/eriknordmark/netlink"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// Create/modify/delete VLAN sub-interfaces
+func UpdateVlanPorts(newConfig, oldConfig types.DevicePortConfig) {
+
+	// Look for deletes and changes from oldConfig to newConfig
+	for _, oldU := range oldConfig.Ports {
+		if !oldU.IsVlan() {
+			continue
+		}
+		newU := lookupOnIfname(newConfig, oldU.IfName)
+		if newU == nil || newU.Vlan != oldU.Vlan {
+			log.Infof("UpdateVlanPorts: deleted %s\n", oldU.IfName)
+			deleteVlanPort(oldU)
+		}
+	}
+	// Make sure the new ones exist
+	for _, newU := range newConfig.Ports {
+		if !newU.IsVlan() {
+			continue
+		}
+		if err := createVlanPort(newU); err != nil {
+			log.Errorf("UpdateVlanPorts: %s\n", err)
+		}
+	}
+}
+
+// createVlanPort is a no-op if the sub-interface exists.
+// Note that the parent might not exist yet e.g., if it is in pciback
+// in which case we will retry when the DevicePortConfig is tested
+// again.
+func createVlanPort(port types.NetworkPortConfig) error {
+
+	log.Infof("createVlanPort(%s) parent %s vlan %d\n",
+		port.IfName, port.Vlan.ParentIfName, port.Vlan.VlanID)
+	parent, err := netlink.LinkByName(port.Vlan.ParentIfName)
+	if err != nil {
+		errStr := fmt.Sprintf("createVlanPort(%s) parent %s: %s",
+			port.IfName, port.Vlan.ParentIfName, err)
+		return errors.New(errStr)
+	}
+	parentIndex := parent.Attrs().Index
+	link, _ := netlink.LinkByName(port.IfName)
+	if link != nil {
+		vlan, ok := link.(*netlink.Vlan)
+		if !ok || vlan.ParentIndex != parentIndex ||
+			vlan.VlanId != int(port.Vlan.VlanID) {
+			errStr := fmt.Sprintf("createVlanPort(%s) name in use by %s link",
+				port.IfName, link.Type())
+			return errors.New(errStr)
+		}
+		log.Infof("createVlanPort(%s) already exists\n", port.IfName)
+	} else {
+		attrs := netlink.NewLinkAttrs()
+		attrs.Name = port.IfName
+		attrs.ParentIndex = parentIndex
+		link = &netlink.Vlan{LinkAttrs: attrs,
+			VlanId: int(port.Vlan.VlanID)}
+		//    ip link add link ${parent} name ${ifname} type vlan id ${vid}
+		if err := netlink.LinkAdd(link); err != nil {
+			errStr := fmt.Sprintf("LinkAdd on %s failed: %s",
+				port.IfName, err)
+			return errors.New(errStr)
+		}
+	}
+	// The sub-interface can only be up if the parent is up
+	if err := netlink.LinkSetUp(parent); err != nil {
+		errStr := fmt.Sprintf("LinkSetUp on %s failed: %s",
+			port.Vlan.ParentIfName, err)
+		return errors.New(errStr)
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		errStr := fmt.Sprintf("LinkSetUp on %s failed: %s",
+			port.IfName, err)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+func deleteVlanPort(port types.NetworkPortConfig) {
+
+	log.Infof("deleteVlanPort(%s)\n", port.IfName)
+	link, _ := netlink.LinkByName(port.IfName)
+	if link == nil {
+		return
+	}
+	if _, ok := link.(*netlink.Vlan); !ok {
+		log.Warnf("deleteVlanPort(%s) not a vlan but %s\n",
+			port.IfName, link.Type())
+		return
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		log.Errorf("LinkDel on %s failed: %s\n", port.IfName, err)
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Manage the WiFi ports in the DevicePortConfig. wpa_supplicant runs in the
+// wlan container; we write a configuration file per port in wifiConfigDir
+// which makes the wlan container start wpa_supplicant on the port, and we
+// use the wpa_supplicant control interface to have it reload or terminate
+// and to retrieve the association state.
+
+package devicenetwork
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+const (
+	wifiConfigDir = "/run/wlan"
+	wpaCtrlDir    = "/run/wpa_supplicant"
+	// Report a signal change if it is at least this many dBm
+	wifiSignalDelta = 5
+)
+
+// Create/modify/delete the wpa_supplicant configuration for WiFi ports
+func UpdateWifiPorts(newConfig, oldConfig types.DevicePortConfig) {
+
+	// Look for deletes from oldConfig to newConfig
+	for _, oldU := range oldConfig.Ports {
+		if !oldU.IsWifi() {
+			continue
+		}
+		newU := lookupOnIfname(newConfig, oldU.IfName)
+		if newU == nil || !newU.IsWifi() {
+			log.Infof("UpdateWifiPorts: deleted %s\n", oldU.IfName)
+			deleteWifiPort(oldU.IfName)
+		}
+	}
+	// Write the new ones
+	for _, newU := range newConfig.Ports {
+		if !newU.IsWifi() {
+			continue
+		}
+		if err := updateWifiPort(newU); err != nil {
+			log.Errorf("UpdateWifiPorts: %s\n", err)
+		}
+	}
+}
+
+// updateWifiPort is a no-op if the configuration file is unchanged
+func updateWifiPort(port types.NetworkPortConfig) error {
+
+	if err := os.MkdirAll(wifiConfigDir, 0700); err != nil {
+		return err
+	}
+	filename := wifiConfigFilename(port.IfName)
+	content := []byte(wpaSupplicantConfig(port))
+	old, err := ioutil.ReadFile(filename)
+	if err == nil && bytes.Equal(old, content) {
+		log.Debugf("updateWifiPort(%s) no change\n", port.IfName)
+		return nil
+	}
+	log.Infof("updateWifiPort(%s) writing %s for SSIDs %v\n",
+		port.IfName, filename, wifiSSIDs(port))
+	// Write and rename so the wlan container never sees a partial file
+	tmpname := filename + ".tmp"
+	if err := ioutil.WriteFile(tmpname, content, 0600); err != nil {
+		errStr := fmt.Sprintf("updateWifiPort(%s) write failed: %s",
+			port.IfName, err)
+		return errors.New(errStr)
+	}
+	if err := os.Rename(tmpname, filename); err != nil {
+		errStr := fmt.Sprintf("updateWifiPort(%s) rename failed: %s",
+			port.IfName, err)
+		return errors.New(errStr)
+	}
+	// If wpa_supplicant is not yet running the wlan container will
+	// start it with the new file
+	if _, err := wpaCtrlRequest(port.IfName, "RECONFIGURE"); err != nil {
+		log.Infof("updateWifiPort(%s) RECONFIGURE: %s\n", port.IfName, err)
+	}
+	return nil
+}
+
+func deleteWifiPort(ifname string) {
+
+	log.Infof("deleteWifiPort(%s)\n", ifname)
+	// Remove the file first so the wlan container does not restart it
+	filename := wifiConfigFilename(ifname)
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		log.Errorf("deleteWifiPort(%s) remove failed: %s\n", ifname, err)
+	}
+	if _, err := wpaCtrlRequest(ifname, "TERMINATE"); err != nil {
+		log.Warnf("deleteWifiPort(%s) TERMINATE: %s\n", ifname, err)
+	}
+}
+
+func wifiConfigFilename(ifname string) string {
+	return fmt.Sprintf("%s/%s.conf", wifiConfigDir, ifname)
+}
+
+// wpaSupplicantConfig returns the configuration file content.
+// SSIDs which can not be used are left out, e.g., WPA-EAP without a CA
+// certificate or server name since the server could not be verified.
+func wpaSupplicantConfig(port types.NetworkPortConfig) string {
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "ctrl_interface=%s\n", wpaCtrlDir)
+	fmt.Fprintf(&b, "update_config=0\n")
+	for i, wifi := range port.Wifi {
+		network, err := wpaNetworkConfig(i, wifi)
+		if err != nil {
+			log.Errorf("wpaSupplicantConfig(%s) skipping SSID %s: %s\n",
+				port.IfName, wifi.SSID, err)
+			continue
+		}
+		b.WriteString(network)
+	}
+	return b.String()
+}
+
+// wpaNetworkConfig returns the network block for the SSID, preceded by
+// the blob with the CA certificate if any. The SSID is hex encoded and
+// the other strings are hex encoded unless they can be quoted.
+func wpaNetworkConfig(index int, wifi types.WifiConfig) (string, error) {
+
+	var b strings.Builder
+	var settings []string
+	switch wifi.KeyScheme {
+	case types.WifiKeySchemeWpaPsk:
+		// A passphrase is 8 to 63 printable characters. wpa_supplicant
+		// takes everything up to the last quote hence any quotes in it
+		// are fine.
+		if len(wifi.Password) < 8 || len(wifi.Password) > 63 ||
+			!isPrintable(wifi.Password) {
+			return "", errors.New("WPA passphrase must be 8 to 63 printable characters")
+		}
+		settings = append(settings, "key_mgmt=WPA-PSK", "proto=RSN",
+			fmt.Sprintf("psk=\"%s\"", wifi.Password))
+	case types.WifiKeySchemeWpaEap:
+		if wifi.CACert == "" && wifi.ServerName == "" {
+			return "", errors.New("WPA-EAP without CA certificate or server name")
+		}
+		settings = append(settings, "key_mgmt=WPA-EAP", "proto=RSN",
+			"eap=PEAP",
+			"identity="+wpaString(wifi.Identity),
+			"password="+wpaString(wifi.Password),
+			"phase2=\"auth=MSCHAPV2\"")
+		if wifi.CACert != "" {
+			blob, err := caCertBlob(wifi.CACert)
+			if err != nil {
+				return "", err
+			}
+			name := fmt.Sprintf("ca%d", index)
+			fmt.Fprintf(&b, "\nblob-base64-%s={\n%s}\n", name, blob)
+			settings = append(settings,
+				fmt.Sprintf("ca_cert=\"blob://%s\"", name))
+		}
+		if wifi.ServerName != "" {
+			settings = append(settings,
+				"domain_suffix_match="+wpaString(wifi.ServerName))
+		}
+	default:
+		settings = append(settings, "key_mgmt=NONE")
+	}
+	fmt.Fprintf(&b, "\nnetwork={\n")
+	fmt.Fprintf(&b, "\tssid=%s\n", hex.EncodeToString([]byte(wifi.SSID)))
+	// Find it even if it does not broadcast the SSID
+	fmt.Fprintf(&b, "\tscan_ssid=1\n")
+	fmt.Fprintf(&b, "\tpriority=%d\n", wifi.Priority)
+	for _, setting := range settings {
+		fmt.Fprintf(&b, "\t%s\n", setting)
+	}
+	fmt.Fprintf(&b, "}\n")
+	return b.String(), nil
+}
+
+// wpaString returns s quoted, or hex encoded if it has a quote or
+// characters which are not printable
+func wpaString(s string) string {
+	if isPrintable(s) && !strings.Contains(s, "\"") {
+		return "\"" + s + "\""
+	}
+	return hex.EncodeToString([]byte(s))
+}
+
+// isPrintable returns true if s only has printable ASCII characters
+func isPrintable(s string) bool {
+	for _, c := range []byte(s) {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// caCertBlob returns the certificates in the PEM, base64 encoded in
+// lines for a wpa_supplicant blob
+func caCertBlob(caCert string) (string, error) {
+	var certs []byte
+	rest := []byte(caCert)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			certs = append(certs, pem.EncodeToMemory(block)...)
+		}
+	}
+	if len(certs) == 0 {
+		return "", errors.New("no CA certificate in PEM")
+	}
+	encoded := base64.StdEncoding.EncodeToString(certs)
+	var b strings.Builder
+	for len(encoded) > 64 {
+		b.WriteString(encoded[:64] + "\n")
+		encoded = encoded[64:]
+	}
+	b.WriteString(encoded + "\n")
+	return b.String(), nil
+}
+
+// wpaCtrlRequest sends a command to the wpa_supplicant control interface
+// for the port and returns the reply. This is what wpa_cli does.
+func wpaCtrlRequest(ifname string, cmd string) (string, error) {
+
+	remote := &net.UnixAddr{Name: wpaCtrlDir + "/" + ifname,
+		Net: "unixgram"}
+	if _, err := os.Stat(remote.Name); err != nil {
+		return "", err
+	}
+	// We need a named local socket for the reply
+	local := &net.UnixAddr{
+		Name: fmt.Sprintf("%s/nim_%d_%s", wpaCtrlDir, os.Getpid(), ifname),
+		Net:  "unixgram"}
+	os.Remove(local.Name)
+	conn, err := net.DialUnix("unixgram", local, remote)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(local.Name)
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(2 * time.Second))
+	if _, err := conn.Write([]byte(cmd)); err != nil {
+		return "", err
+	}
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	reply := string(buf[:n])
+	if strings.HasPrefix(reply, "FAIL") {
+		errStr := fmt.Sprintf("wpa_supplicant %s on %s: %s",
+			cmd, ifname, strings.TrimSpace(reply))
+		return reply, errors.New(errStr)
+	}
+	return reply, nil
+}
+
+// parseWpaReply parses the key=value lines in the reply to STATUS and
+// SIGNAL_POLL
+func parseWpaReply(reply string) map[string]string {
+	res := make(map[string]string)
+	for _, line := range strings.Split(reply, "\n") {
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) == 2 {
+			res[kv[0]] = kv[1]
+		}
+	}
+	return res
+}
+
+// getWifiStatus returns an empty WifiStatus if wpa_supplicant is not
+// running on the port
+func getWifiStatus(ifname string) *types.WifiStatus {
+
+	ws := new(types.WifiStatus)
+	reply, err := wpaCtrlRequest(ifname, "STATUS")
+	if err != nil {
+		log.Debugf("getWifiStatus(%s) STATUS: %s\n", ifname, err)
+		return ws
+	}
+	status := parseWpaReply(reply)
+	ws.WpaState = status["wpa_state"]
+	ws.BSSID = status["bssid"]
+	ws.SSID = status["ssid"]
+	if f, err := strconv.ParseUint(status["freq"], 10, 32); err == nil {
+		ws.Frequency = uint32(f)
+	}
+	if !ws.Associated() {
+		return ws
+	}
+	reply, err = wpaCtrlRequest(ifname, "SIGNAL_POLL")
+	if err != nil {
+		log.Warnf("getWifiStatus(%s) SIGNAL_POLL: %s\n", ifname, err)
+		return ws
+	}
+	signal := parseWpaReply(reply)
+	if rssi, err := strconv.ParseInt(signal["RSSI"], 10, 32); err == nil {
+		ws.SignalDbm = int32(rssi)
+	}
+	return ws
+}
+
+// UpdateWifiStatus refreshes the WifiStatus of the WiFi ports.
+// Returns true if the association changed or the signal changed enough
+// to be worth reporting.
+func UpdateWifiStatus(status *types.DeviceNetworkStatus) bool {
+
+	changed := false
+	for ix := range status.Ports {
+		port := &status.Ports[ix]
+		if port.Wifi == nil {
+			continue
+		}
+		ws := getWifiStatus(port.IfName)
+		if wifiStatusChanged(*port.Wifi, *ws) {
+			log.Infof("UpdateWifiStatus(%s) changed from %+v to %+v\n",
+				port.IfName, *port.Wifi, *ws)
+			port.Wifi = ws
+			changed = true
+		}
+	}
+	return changed
+}
+
+func wifiStatusChanged(old, new types.WifiStatus) bool {
+	if old.WpaState != new.WpaState || old.SSID != new.SSID ||
+		old.BSSID != new.BSSID || old.Frequency != new.Frequency {
+		return true
+	}
+	delta := old.SignalDbm - new.SignalDbm
+	return delta >= wifiSignalDelta || delta <= -wifiSignalDelta
+}
+
+// wifiSSIDs returns the configured SSIDs in priority order for logging
+func wifiSSIDs(port types.NetworkPortConfig) []string {
+	wifi := make([]types.WifiConfig, len(port.Wifi))
+	copy(wifi, port.Wifi)
+	sort.SliceStable(wifi, func(i, j int) bool {
+		return wifi[i].Priority > wifi[j].Priority
+	})
+	var ssids []string
+	for _, w := range wifi {
+		ssids = append(ssids, w.SSID)
+	}
+	return ssids
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devicenetwork
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+// testCACert returns a self-signed CA certificate in PEM format
+func testCACert(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template,
+		&key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE",
+		Bytes: der}))
+}
+
+type TestWpaSupplicantConfigMatrix struct {
+	wifi     types.WifiConfig
+	expected string // The network block; empty if left out
+}
+
+func TestWpaSupplicantConfig(t *testing.T) {
+	header := "ctrl_interface=/run/wpa_supplicant\nupdate_config=0\n"
+	testMatrix := map[string]TestWpaSupplicantConfigMatrix{
+		"Open": {
+			wifi: types.WifiConfig{SSID: "cafe", Priority: 1},
+			expected: "\nnetwork={\n\tssid=63616665\n\tscan_ssid=1\n" +
+				"\tpriority=1\n\tkey_mgmt=NONE\n}\n",
+		},
+		"PSK": {
+			wifi: types.WifiConfig{SSID: "office",
+				KeyScheme: types.WifiKeySchemeWpaPsk,
+				Password:  "passphrase", Priority: 10},
+			expected: "\nnetwork={\n\tssid=6f6666696365\n\tscan_ssid=1\n" +
+				"\tpriority=10\n\tkey_mgmt=WPA-PSK\n\tproto=RSN\n" +
+				"\tpsk=\"passphrase\"\n}\n",
+		},
+		"PSK with quotes and spaces": {
+			wifi: types.WifiConfig{SSID: "my \"wifi\"\n",
+				KeyScheme: types.WifiKeySchemeWpaPsk,
+				Password:  "pass \"phrase\" #1"},
+			expected: "\nnetwork={\n\tssid=6d79202277696669220a\n" +
+				"\tscan_ssid=1\n\tpriority=0\n\tkey_mgmt=WPA-PSK\n" +
+				"\tproto=RSN\n\tpsk=\"pass \"phrase\" #1\"\n}\n",
+		},
+		"PSK too short": {
+			wifi: types.WifiConfig{SSID: "office",
+				KeyScheme: types.WifiKeySchemeWpaPsk,
+				Password:  "short"},
+		},
+		"PSK with newline": {
+			wifi: types.WifiConfig{SSID: "office",
+				KeyScheme: types.WifiKeySchemeWpaPsk,
+				Password:  "passphrase\nnetwork={"},
+		},
+		"PEAP with server name": {
+			wifi: types.WifiConfig{SSID: "corp",
+				KeyScheme:  types.WifiKeySchemeWpaEap,
+				Identity:   "device1",
+				Password:   "secret123",
+				ServerName: "radius.example.com"},
+			expected: "\nnetwork={\n\tssid=636f7270\n\tscan_ssid=1\n" +
+				"\tpriority=0\n\tkey_mgmt=WPA-EAP\n\tproto=RSN\n" +
+				"\teap=PEAP\n\tidentity=\"device1\"\n" +
+				"\tpassword=\"secret123\"\n" +
+				"\tphase2=\"auth=MSCHAPV2\"\n" +
+				"\tdomain_suffix_match=\"radius.example.com\"\n}\n",
+		},
+		"PEAP credentials hex encoded": {
+			wifi: types.WifiConfig{SSID: "corp",
+				KeyScheme:  types.WifiKeySchemeWpaEap,
+				Identity:   "dom\"ain\\dev",
+				Password:   "sec\nret\"}",
+				ServerName: "radius.example.com"},
+			expected: "\nnetwork={\n\tssid=636f7270\n\tscan_ssid=1\n" +
+				"\tpriority=0\n\tkey_mgmt=WPA-EAP\n\tproto=RSN\n" +
+				"\teap=PEAP\n\tidentity=646f6d2261696e5c646576\n" +
+				"\tpassword=7365630a726574227d\n" +
+				"\tphase2=\"auth=MSCHAPV2\"\n" +
+				"\tdomain_suffix_match=\"radius.example.com\"\n}\n",
+		},
+		"PEAP without verification": {
+			wifi: types.WifiConfig{SSID: "corp",
+				KeyScheme: types.WifiKeySchemeWpaEap,
+				Identity:  "device1",
+				Password:  "secret123"},
+		},
+		"PEAP with a bad CA certificate": {
+			wifi: types.WifiConfig{SSID: "corp",
+				KeyScheme: types.WifiKeySchemeWpaEap,
+				Identity:  "device1",
+				Password:  "secret123",
+				CACert:    "not a certificate"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		port := types.NetworkPortConfig{IfName: "wlan0",
+			Wifi: []types.WifiConfig{test.wifi}}
+		actual := wpaSupplicantConfig(port)
+		if actual != header+test.expected {
+			t.Errorf("Test Failed: %s: Expected %q, Actual: %q\n",
+				testname, header+test.expected, actual)
+		}
+	}
+}
+
+func TestWpaSupplicantConfigCACert(t *testing.T) {
+	caCert := testCACert(t)
+	port := types.NetworkPortConfig{IfName: "wlan0",
+		Wifi: []types.WifiConfig{
+			{SSID: "office", KeyScheme: types.WifiKeySchemeWpaPsk,
+				Password: "passphrase"},
+			{SSID: "corp", KeyScheme: types.WifiKeySchemeWpaEap,
+				Identity: "device1", Password: "secret123",
+				CACert: "Some text\n" + caCert},
+		}}
+	actual := wpaSupplicantConfig(port)
+	start := strings.Index(actual, "\nblob-base64-ca1={\n")
+	end := strings.Index(actual, "}\n\nnetwork={\n\tssid=636f7270\n")
+	if start == -1 || end == -1 || start > end {
+		t.Fatalf("No blob before the network: %s", actual)
+	}
+	if !strings.Contains(actual, "\tca_cert=\"blob://ca1\"\n") {
+		t.Errorf("No ca_cert: %s", actual)
+	}
+	if strings.Contains(actual, "domain_suffix_match") {
+		t.Errorf("Unexpected domain_suffix_match: %s", actual)
+	}
+	lines := strings.Split(actual[start+len("\nblob-base64-ca1={\n"):end],
+		"\n")
+	for _, line := range lines {
+		if len(line) > 64 {
+			t.Errorf("Blob line too long: %s", line)
+		}
+	}
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(lines, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the certificate is kept
+	if string(blob) != caCert {
+		t.Errorf("Expected %s, Actual: %s", caCert, blob)
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Manage the cellular modem port in the DevicePortConfig. The modem is
+// driven by wwan-init.sh in the wwan container which connects using
+// wwanConfigFile and disconnects when the file is removed. The wwan
+// container publishes the uqmi output as json files in wwanDir which we
+// parse here. It currently supports a single modem.
+
+package devicenetwork
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+const (
+	wwanDir            = "/run/wwan"
+	wwanConfigFile     = wwanDir + "/config.json"
+	wwanServingFile    = wwanDir + "/serving-system.json"
+	wwanSignalFile     = wwanDir + "/signal-info.json"
+	wwanDataStatusFile = wwanDir + "/data-status.json"
+	wwanNetworksFile   = wwanDir + "/networks-info.json"
+	// What wwan-init.sh used when it had no configuration
+	DefaultCellularAPN = "internetd.gdsp"
+)
+
+// wwanConfig is what wwan-init.sh reads from wwanConfigFile using jq.
+// The strings are uqmi arguments.
+type wwanConfig struct {
+	IfName       string
+	APN          string
+	PIN          string
+	AuthType     string // none, pap, chap or both
+	Username     string
+	Password     string
+	Roaming      string // any or off
+	NetworkModes string // E.g., lte,umts or all
+}
+
+// IsCellularIfname is used when the DevicePortConfig comes from the
+// hardware model where we only have the names
+func IsCellularIfname(ifname string) bool {
+	return strings.HasPrefix(ifname, "wwan")
+}
+
+// Connect/disconnect the modem
+func UpdateCellularPorts(newConfig, oldConfig types.DevicePortConfig) {
+
+	var port *types.NetworkPortConfig
+	for i := range newConfig.Ports {
+		if !newConfig.Ports[i].IsCellular() {
+			continue
+		}
+		if port != nil {
+			log.Errorf("UpdateCellularPorts: only one cellular port supported; ignoring %s\n",
+				newConfig.Ports[i].IfName)
+			continue
+		}
+		port = &newConfig.Ports[i]
+	}
+	if port == nil {
+		if _, err := os.Stat(wwanConfigFile); err == nil {
+			log.Infof("UpdateCellularPorts: disconnecting\n")
+			if err := os.Remove(wwanConfigFile); err != nil {
+				log.Errorf("UpdateCellularPorts: %s\n", err)
+			}
+		}
+		return
+	}
+	if err := writeWwanConfig(*port); err != nil {
+		log.Errorf("UpdateCellularPorts: %s\n", err)
+	}
+}
+
+// writeWwanConfig is a no-op if the file is unchanged
+func writeWwanConfig(port types.NetworkPortConfig) error {
+
+	cc := port.Cellular
+	config := wwanConfig{
+		IfName:       port.IfName,
+		APN:          cc.APN,
+		PIN:          cc.PIN,
+		Username:     cc.Username,
+		Password:     cc.Password,
+		Roaming:      "off",
+		NetworkModes: "all",
+	}
+	switch cc.AuthProtocol {
+	case types.CellularAuthPAP:
+		config.AuthType = "pap"
+	case types.CellularAuthCHAP:
+		config.AuthType = "chap"
+	case types.CellularAuthPAPandCHAP:
+		config.AuthType = "both"
+	default:
+		config.AuthType = "none"
+	}
+	if cc.RoamingAllowed {
+		config.Roaming = "any"
+	}
+	if len(cc.PreferredRATs) != 0 {
+		config.NetworkModes = strings.Join(cc.PreferredRATs, ",")
+	}
+	content, err := json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return err
+	}
+	old, err := ioutil.ReadFile(wwanConfigFile)
+	if err == nil && bytes.Equal(old, content) {
+		log.Debugf("writeWwanConfig(%s) no change\n", port.IfName)
+		return nil
+	}
+	log.Infof("writeWwanConfig(%s) APN %s roaming %s modes %s\n",
+		port.IfName, config.APN, config.Roaming, config.NetworkModes)
+	if err := os.MkdirAll(wwanDir, 0755); err != nil {
+		return err
+	}
+	// Write and rename so the wwan container never sees a partial file
+	tmpname := wwanConfigFile + ".tmp"
+	if err := ioutil.WriteFile(tmpname, content, 0600); err != nil {
+		errStr := fmt.Sprintf("writeWwanConfig(%s) write failed: %s",
+			port.IfName, err)
+		return errors.New(errStr)
+	}
+	if err := os.Rename(tmpname, wwanConfigFile); err != nil {
+		errStr := fmt.Sprintf("writeWwanConfig(%s) rename failed: %s",
+			port.IfName, err)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// readWwanFile returns false if the file does not exist or can not
+// be parsed
+func readWwanFile(filename string, res interface{}) bool {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("readWwanFile: %s\n", err)
+		}
+		return false
+	}
+	if err := json.Unmarshal(b, res); err != nil {
+		log.Errorf("readWwanFile %s: %s\n", filename, err)
+		return false
+	}
+	return true
+}
+
+// wwanIfName returns the port the wwan container is configured for
+func wwanIfName() string {
+	var config wwanConfig
+	if !readWwanFile(wwanConfigFile, &config) {
+		return ""
+	}
+	return config.IfName
+}
+
+// getCellularStatus returns an empty CellularStatus if the wwan
+// container has not reported anything for the port
+func getCellularStatus(ifname string) *types.CellularStatus {
+
+	cs := new(types.CellularStatus)
+	if wwanIfName() != ifname {
+		return cs
+	}
+	// From uqmi --get-serving-system
+	var serving struct {
+		Registration    string `json:"registration"`
+		PlmnMcc         uint32 `json:"plmn_mcc"`
+		PlmnMnc         uint32 `json:"plmn_mnc"`
+		PlmnDescription string `json:"plmn_description"`
+		Roaming         bool   `json:"roaming"`
+	}
+	if readWwanFile(wwanServingFile, &serving) {
+		cs.Registration = serving.Registration
+		cs.MCC = serving.PlmnMcc
+		cs.MNC = serving.PlmnMnc
+		cs.Operator = serving.PlmnDescription
+		cs.Roaming = serving.Roaming
+	}
+	// From uqmi --get-data-status
+	var dataStatus string
+	if readWwanFile(wwanDataStatusFile, &dataStatus) {
+		cs.Connected = dataStatus == "connected"
+	}
+	if signal, ok := ReadCellularSignal(); ok {
+		cs.RAT = signal.RAT
+	}
+	// From uqmi --network-scan
+	var networks struct {
+		NetworkInfo []struct {
+			Mcc         uint32   `json:"mcc"`
+			Mnc         uint32   `json:"mnc"`
+			Description string   `json:"description"`
+			Status      []string `json:"status"`
+		} `json:"network_info"`
+	}
+	if readWwanFile(wwanNetworksFile, &networks) {
+		for _, n := range networks.NetworkInfo {
+			cs.VisibleNetworks = append(cs.VisibleNetworks,
+				types.CellularNetwork{
+					MCC:         n.Mcc,
+					MNC:         n.Mnc,
+					Description: n.Description,
+					Status:      n.Status,
+				})
+		}
+	}
+	return cs
+}
+
+// ReadCellularSignal returns the last signal reported by the modem
+func ReadCellularSignal() (types.CellularSignal, bool) {
+
+	// From uqmi --get-signal-info
+	var signal struct {
+		Type   string  `json:"type"`
+		Rssi   int32   `json:"rssi"`
+		Rsrq   int32   `json:"rsrq"`
+		Rsrp   int32   `json:"rsrp"`
+		Snr    float32 `json:"snr"`
+		Ecio   float32 `json:"ecio"`
+		Signal int32   `json:"signal"` // For gsm
+	}
+	if !readWwanFile(wwanSignalFile, &signal) {
+		return types.CellularSignal{}, false
+	}
+	res := types.CellularSignal{
+		RAT:  signal.Type,
+		RSSI: signal.Rssi,
+		RSRQ: signal.Rsrq,
+		RSRP: signal.Rsrp,
+		SNR:  signal.Snr,
+		ECIO: signal.Ecio,
+	}
+	if res.RSSI == 0 {
+		res.RSSI = signal.Signal
+	}
+	return res, true
+}
+
+// UpdateCellularStatus refreshes the CellularStatus of the cellular port.
+// Returns true if it changed.
+func UpdateCellularStatus(status *types.DeviceNetworkStatus) bool {
+
+	changed := false
+	for ix := range status.Ports {
+		port := &status.Ports[ix]
+		if port.Cellular == nil {
+			continue
+		}
+		cs := getCellularStatus(port.IfName)
+		if cellularStatusChanged(*port.Cellular, *cs) {
+			log.Infof("UpdateCellularStatus(%s) changed to connected %t registration %s operator %s rat %s\n",
+				port.IfName, cs.Connected, cs.Registration,
+				cs.Operator, cs.RAT)
+			port.Cellular = cs
+			changed = true
+		}
+	}
+	return changed
+}
+
+func cellularStatusChanged(old, new types.CellularStatus) bool {
+	if old.Connected != new.Connected ||
+		old.Registration != new.Registration ||
+		old.Operator != new.Operator || old.MCC != new.MCC ||
+		old.MNC != new.MNC || old.Roaming != new.Roaming ||
+		old.RAT != new.RAT ||
+		len(old.VisibleNetworks) != len(new.VisibleNetworks) {
+		return true
+	}
+	for i := range old.VisibleNetworks {
+		o, n := old.VisibleNetworks[i], new.VisibleNetworks[i]
+		if o.MCC != n.MCC || o.MNC != n.MNC ||
+			o.Description != n.Description ||
+			strings.Join(o.Status, ",") != strings.Join(n.Status, ",") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Sealing of secrets such as the /persist vault key to the PCRs. The
+// sealed blob is stored outside of the TPM and can only be unsealed by
+// this TPM when the PCRs have the same values as when it was sealed.
+
+package evetpm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/go-tpm/tpm2"
+	log "github.com/sirupsen/logrus"
+)
+
+// SealPCRs are the PCRs a secret is sealed to; firmware, boot loader
+// configuration and the EVE configuration. PcrEveVersion is excluded so
+// that an EVE update does not require the recovery key.
+var SealPCRs = []int{0, 1, 2, 3, 4, 6, 7, PcrEveConfig}
+
+// sealedBlob is what SealKey returns
+type sealedBlob struct {
+	PCRs    []int
+	Public  []byte
+	Private []byte
+}
+
+// The storage root key is a primary key which the TPM derives from the
+// owner seed hence it is recreated rather than persisted
+var storageKeyTemplate = tpm2.Public{
+	Type:       tpm2.AlgRSA,
+	NameAlg:    tpm2.AlgSHA256,
+	Attributes: tpm2.FlagStorageDefault | tpm2.FlagNoDA,
+	RSAParameters: &tpm2.RSAParams{
+		Symmetric: &tpm2.SymScheme{
+			Alg:     tpm2.AlgAES,
+			KeyBits: 128,
+			Mode:    tpm2.AlgCFB,
+		},
+		KeyBits:    2048,
+		ModulusRaw: make([]byte, 256),
+	},
+}
+
+// SealKey seals key to the current values of SealPCRs
+func SealKey(key []byte) ([]byte, error) {
+	rw, err := openTpm()
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	srkHdl, _, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner,
+		tpm2.PCRSelection{}, "", "", storageKeyTemplate)
+	if err != nil {
+		errStr := fmt.Sprintf("SealKey CreatePrimary failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	defer tpm2.FlushContext(rw, srkHdl)
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256, PCRs: SealPCRs}
+	policy, err := pcrPolicyDigest(rw, sel)
+	if err != nil {
+		return nil, err
+	}
+	private, public, err := tpm2.Seal(rw, srkHdl, "", "", policy, key)
+	if err != nil {
+		errStr := fmt.Sprintf("SealKey Seal failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	log.Infof("SealKey: sealed to PCRs %v\n", SealPCRs)
+	return json.Marshal(sealedBlob{
+		PCRs:    SealPCRs,
+		Public:  public,
+		Private: private,
+	})
+}
+
+// UnsealKey returns the key sealed by SealKey. Fails if the PCRs have
+// changed since.
+func UnsealKey(blob []byte) ([]byte, error) {
+	var sealed sealedBlob
+	if err := json.Unmarshal(blob, &sealed); err != nil {
+		errStr := fmt.Sprintf("UnsealKey: bad blob: %v", err)
+		return nil, errors.New(errStr)
+	}
+	rw, err := openTpm()
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	srkHdl, _, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner,
+		tpm2.PCRSelection{}, "", "", storageKeyTemplate)
+	if err != nil {
+		errStr := fmt.Sprintf("UnsealKey CreatePrimary failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	defer tpm2.FlushContext(rw, srkHdl)
+	objHdl, _, err := tpm2.Load(rw, srkHdl, "", sealed.Public,
+		sealed.Private)
+	if err != nil {
+		errStr := fmt.Sprintf("UnsealKey Load failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	defer tpm2.FlushContext(rw, objHdl)
+	sessHdl, _, err := tpm2.StartAuthSession(rw, tpm2.HandleNull,
+		tpm2.HandleNull, make([]byte, 16), nil, tpm2.SessionPolicy,
+		tpm2.AlgNull, tpm2.AlgSHA256)
+	if err != nil {
+		errStr := fmt.Sprintf("UnsealKey StartAuthSession failed: %v",
+			err)
+		return nil, errors.New(errStr)
+	}
+	defer tpm2.FlushContext(rw, sessHdl)
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256, PCRs: sealed.PCRs}
+	if err := tpm2.PolicyPCR(rw, sessHdl, nil, sel); err != nil {
+		errStr := fmt.Sprintf("UnsealKey PolicyPCR failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	key, err := tpm2.UnsealWithSession(rw, sessHdl, objHdl, "")
+	if err != nil {
+		errStr := fmt.Sprintf("UnsealKey failed; PCRs %v changed? %v",
+			sealed.PCRs, err)
+		return nil, errors.New(errStr)
+	}
+	return key, nil
+}
+
+// pcrPolicyDigest returns the policy digest for the current PCR values
+// using a trial session
+func pcrPolicyDigest(rw io.ReadWriter, sel tpm2.PCRSelection) ([]byte, error) {
+	sessHdl, _, err := tpm2.StartAuthSession(rw, tpm2.HandleNull,
+		tpm2.HandleNull, make([]byte, 16), nil, tpm2.SessionTrial,
+		tpm2.AlgNull, tpm2.AlgSHA256)
+	if err != nil {
+		errStr := fmt.Sprintf("StartAuthSession failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	defer tpm2.FlushContext(rw, sessHdl)
+	if err := tpm2.PolicyPCR(rw, sessHdl, nil, sel); err != nil {
+		errStr := fmt.Sprintf("PolicyPCR failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	return tpm2.PolicyGetDigest(rw, sessHdl)
+}
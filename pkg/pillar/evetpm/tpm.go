@@ -0,0 +1,568 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Device identity and attestation using the TPM. The device key is created
+// in and never leaves the TPM; TpmSigner makes it usable as the private
+// key of the device certificate. A separate restricted attestation key is
+// used to quote the PCRs, and the measurements EVE itself extends into
+// the PCRs are recorded in a log since the PCRs are reset on boot.
+
+package evetpm
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// TpmDevicePath is only usable by one process at a time hence we
+	// prefer the kernel resource manager
+	TpmDevicePath   = "/dev/tpm0"
+	TpmResMgrPath   = "/dev/tpmrm0"
+	TpmDeviceKeyHdl = tpmutil.Handle(0x817FFFFF)
+	TpmQuoteKeyHdl  = tpmutil.Handle(0x81000003)
+	// TpmNextDeviceKeyHdl is used when renewing the device certificate
+	// hence the device key alternates between the two handles
+	TpmNextDeviceKeyHdl = tpmutil.Handle(0x817FFFFE)
+
+	// TpmInUseFile indicates that the device key is in the TPM as
+	// opposed to in /config/device.key.pem. It contains the handle of
+	// the device key in hex if not TpmDeviceKeyHdl.
+	TpmInUseFile = "/config/tpm_in_use"
+
+	// The PCRs into which EVE extends its own measurements
+	PcrEveVersion = 13
+	PcrEveConfig  = 14
+
+	firmwareEventLogFile = "/sys/kernel/security/tpm0/binary_bios_measurements"
+
+	// Lifetime of the self-signed device certificate
+	deviceCertLifetime = 20 * 365 * 24 * time.Hour
+
+	// How many times GetQuote retries when a PCR is extended between
+	// the quote and the reading of the PCRs
+	quoteAttempts = 3
+)
+
+// QuotePCRs are the PCRs included in a quote; firmware, boot loader and
+// the ones used by EVE
+var QuotePCRs = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, PcrEveVersion, PcrEveConfig}
+
+// tpmPaths is in order of preference. Tests point it at a simulator.
+var tpmPaths = []string{TpmResMgrPath, TpmDevicePath}
+
+// measurementLogFile is in /var/run since the PCRs are reset on boot
+var measurementLogFile = "/var/run/tpmmgr/measurements.json"
+
+// Measurement is an entry in the log of what EVE extended into the PCRs
+type Measurement struct {
+	PcrIndex    int
+	Description string
+	Digest      []byte
+}
+
+// Quote is what the controller needs to verify the state of the device
+type Quote struct {
+	AttestData []byte // TPMS_ATTEST
+	Signature  []byte // ASN.1 ECDSA
+	PCRs       map[int][]byte
+}
+
+// IsTpmPresent returns true if there is a TPM device
+func IsTpmPresent() bool {
+	for _, path := range tpmPaths {
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTpmEnabled returns true if the device key is in the TPM
+func IsTpmEnabled() bool {
+	if _, err := os.Stat(TpmInUseFile); err != nil {
+		return false
+	}
+	return IsTpmPresent()
+}
+
+func openTpm() (io.ReadWriteCloser, error) {
+	var lastErr error
+	for _, path := range tpmPaths {
+		if _, err := os.Stat(path); err != nil {
+			lastErr = err
+			continue
+		}
+		rw, err := tpm2.OpenTPM(path)
+		if err == nil {
+			return rw, nil
+		}
+		lastErr = err
+	}
+	errStr := fmt.Sprintf("openTpm failed: %v", lastErr)
+	return nil, errors.New(errStr)
+}
+
+// The device key is used for TLS hence must be an unrestricted signing
+// key, and the scheme is picked when signing
+var deviceKeyTemplate = tpm2.Public{
+	Type:    tpm2.AlgECC,
+	NameAlg: tpm2.AlgSHA256,
+	Attributes: tpm2.FlagSign | tpm2.FlagFixedTPM | tpm2.FlagFixedParent |
+		tpm2.FlagSensitiveDataOrigin | tpm2.FlagUserWithAuth |
+		tpm2.FlagNoDA,
+	ECCParameters: &tpm2.ECCParams{
+		Sign:    &tpm2.SigScheme{Alg: tpm2.AlgNull},
+		CurveID: tpm2.CurveNISTP256,
+	},
+}
+
+// The quote key is restricted hence the TPM will only use it to sign
+// data it generated itself
+var quoteKeyTemplate = tpm2.Public{
+	Type:       tpm2.AlgECC,
+	NameAlg:    tpm2.AlgSHA256,
+	Attributes: tpm2.FlagSignerDefault | tpm2.FlagNoDA,
+	ECCParameters: &tpm2.ECCParams{
+		Sign: &tpm2.SigScheme{
+			Alg:  tpm2.AlgECDSA,
+			Hash: tpm2.AlgSHA256,
+		},
+		CurveID: tpm2.CurveNISTP256,
+	},
+}
+
+// GetDeviceKeyHandle returns the handle of the device key
+func GetDeviceKeyHandle() tpmutil.Handle {
+	b, err := ioutil.ReadFile(TpmInUseFile)
+	if err != nil || len(strings.TrimSpace(string(b))) == 0 {
+		return TpmDeviceKeyHdl
+	}
+	handle, err := strconv.ParseUint(strings.TrimSpace(string(b)), 0, 32)
+	if err != nil {
+		log.Errorf("GetDeviceKeyHandle: bad %s: %s\n", TpmInUseFile, err)
+		return TpmDeviceKeyHdl
+	}
+	return tpmutil.Handle(handle)
+}
+
+// CreateNextDeviceKey creates a new device key at the handle which is
+// not in use. The caller switches to it once it has a certificate for it
+// by writing the handle to TpmInUseFile.
+func CreateNextDeviceKey() (*TpmSigner, error) {
+	handle := TpmNextDeviceKeyHdl
+	if GetDeviceKeyHandle() == TpmNextDeviceKeyHdl {
+		handle = TpmDeviceKeyHdl
+	}
+	rw, err := openTpm()
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	// Primary keys are derived from the template hence a random unique
+	// field is needed to get a different key
+	template := deviceKeyTemplate
+	eccParams := *deviceKeyTemplate.ECCParameters
+	unique := make([]byte, 64)
+	if _, err := rand.Read(unique); err != nil {
+		return nil, err
+	}
+	eccParams.Point = tpm2.ECPoint{
+		X: new(big.Int).SetBytes(unique[:32]),
+		Y: new(big.Int).SetBytes(unique[32:]),
+	}
+	template.ECCParameters = &eccParams
+	if err := createKey(rw, handle, template); err != nil {
+		return nil, err
+	}
+	pub, _, err := readPublicKey(rw, handle)
+	if err != nil {
+		return nil, err
+	}
+	return &TpmSigner{handle: handle, publicKey: pub}, nil
+}
+
+// CreateDeviceKey replaces any existing device and quote keys
+func CreateDeviceKey() error {
+	rw, err := openTpm()
+	if err != nil {
+		return err
+	}
+	defer rw.Close()
+	if err := createKey(rw, TpmDeviceKeyHdl, deviceKeyTemplate); err != nil {
+		return err
+	}
+	return createKey(rw, TpmQuoteKeyHdl, quoteKeyTemplate)
+}
+
+// createKey creates a primary key in the owner hierarchy and makes it
+// persistent at handle
+func createKey(rw io.ReadWriter, handle tpmutil.Handle,
+	template tpm2.Public) error {
+
+	// Ignore the error if there is no existing key
+	if err := tpm2.EvictControl(rw, "", tpm2.HandleOwner, handle,
+		handle); err == nil {
+		log.Infof("createKey: removed existing key at 0x%x\n", handle)
+	}
+	keyHdl, _, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner,
+		tpm2.PCRSelection{}, "", "", template)
+	if err != nil {
+		errStr := fmt.Sprintf("createKey 0x%x CreatePrimary failed: %v",
+			handle, err)
+		return errors.New(errStr)
+	}
+	defer tpm2.FlushContext(rw, keyHdl)
+	if err := tpm2.EvictControl(rw, "", tpm2.HandleOwner, keyHdl,
+		handle); err != nil {
+		errStr := fmt.Sprintf("createKey 0x%x EvictControl failed: %v",
+			handle, err)
+		return errors.New(errStr)
+	}
+	log.Infof("createKey: created key at 0x%x\n", handle)
+	return nil
+}
+
+// readPublicKey returns the public part of a persistent key
+func readPublicKey(rw io.ReadWriter, handle tpmutil.Handle) (*ecdsa.PublicKey, []byte, error) {
+	pub, _, _, err := tpm2.ReadPublic(rw, handle)
+	if err != nil {
+		errStr := fmt.Sprintf("ReadPublic 0x%x failed: %v", handle, err)
+		return nil, nil, errors.New(errStr)
+	}
+	if pub.Type != tpm2.AlgECC || pub.ECCParameters == nil ||
+		pub.ECCParameters.CurveID != tpm2.CurveNISTP256 {
+		errStr := fmt.Sprintf("Key 0x%x is not ECC P256", handle)
+		return nil, nil, errors.New(errStr)
+	}
+	encoded, err := pub.Encode()
+	if err != nil {
+		return nil, nil, err
+	}
+	key := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     pub.ECCParameters.Point.X,
+		Y:     pub.ECCParameters.Point.Y,
+	}
+	return key, encoded, nil
+}
+
+// TpmSigner implements crypto.Signer using a key in the TPM
+type TpmSigner struct {
+	handle    tpmutil.Handle
+	publicKey *ecdsa.PublicKey
+}
+
+// NewDeviceSigner returns a signer for the device key
+func NewDeviceSigner() (*TpmSigner, error) {
+	rw, err := openTpm()
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	handle := GetDeviceKeyHandle()
+	pub, _, err := readPublicKey(rw, handle)
+	if err != nil {
+		return nil, err
+	}
+	return &TpmSigner{handle: handle, publicKey: pub}, nil
+}
+
+// Handle returns the TPM handle of the key
+func (signer *TpmSigner) Handle() tpmutil.Handle {
+	return signer.handle
+}
+
+// Public is part of crypto.Signer
+func (signer *TpmSigner) Public() crypto.PublicKey {
+	return signer.publicKey
+}
+
+// Sign is part of crypto.Signer. The TPM is opened for each signature
+// so that agents do not keep it open.
+func (signer *TpmSigner) Sign(rand io.Reader, digest []byte,
+	opts crypto.SignerOpts) ([]byte, error) {
+
+	var hashAlg tpm2.Algorithm
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		hashAlg = tpm2.AlgSHA256
+	case crypto.SHA384:
+		hashAlg = tpm2.AlgSHA384
+	default:
+		errStr := fmt.Sprintf("TpmSigner: unsupported hash %v",
+			opts.HashFunc())
+		return nil, errors.New(errStr)
+	}
+	rw, err := openTpm()
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	sig, err := tpm2.Sign(rw, signer.handle, "", digest,
+		&tpm2.SigScheme{Alg: tpm2.AlgECDSA, Hash: hashAlg})
+	if err != nil {
+		errStr := fmt.Sprintf("TpmSigner: Sign failed: %v", err)
+		return nil, errors.New(errStr)
+	}
+	return encodeSignature(sig)
+}
+
+// encodeSignature returns the ASN.1 encoding which crypto/ecdsa and
+// crypto/tls use
+func encodeSignature(sig *tpm2.Signature) ([]byte, error) {
+	if sig.ECC == nil {
+		errStr := fmt.Sprintf("Unexpected signature algorithm 0x%x",
+			sig.Alg)
+		return nil, errors.New(errStr)
+	}
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{sig.ECC.R, sig.ECC.S})
+}
+
+// CreateDeviceCert writes a self-signed certificate for the device key
+// in PEM format. The subject matches what generate-self-signed.sh uses.
+func CreateDeviceCert(certFile string) error {
+	signer, err := NewDeviceSigner()
+	if err != nil {
+		return err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+	notBefore := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Country:      []string{"US"},
+			Province:     []string{"California"},
+			Locality:     []string{"Santa Clara"},
+			Organization: []string{"Zededa, Inc"},
+			CommonName:   "device",
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(deviceCertLifetime),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template,
+		signer.Public(), signer)
+	if err != nil {
+		errStr := fmt.Sprintf("CreateDeviceCert failed: %v", err)
+		return errors.New(errStr)
+	}
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return ioutil.WriteFile(certFile, certPem, 0644)
+}
+
+// ExtendPCR extends the SHA256 of data into the PCR and records it in
+// the measurement log
+func ExtendPCR(pcr int, description string, data []byte) error {
+	digest := sha256.Sum256(data)
+	rw, err := openTpm()
+	if err != nil {
+		return err
+	}
+	defer rw.Close()
+	if err := tpm2.PCRExtend(rw, tpmutil.Handle(pcr), tpm2.AlgSHA256,
+		digest[:], ""); err != nil {
+		errStr := fmt.Sprintf("ExtendPCR %d failed: %v", pcr, err)
+		return errors.New(errStr)
+	}
+	log.Infof("ExtendPCR %d with %s\n", pcr, description)
+	measurements := GetMeasurements()
+	measurements = append(measurements, Measurement{
+		PcrIndex:    pcr,
+		Description: description,
+		Digest:      digest[:],
+	})
+	b, err := json.Marshal(measurements)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(measurementLogFile), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(measurementLogFile, b, 0644)
+}
+
+// GetMeasurements returns what has been extended since boot
+func GetMeasurements() []Measurement {
+	var measurements []Measurement
+	b, err := ioutil.ReadFile(measurementLogFile)
+	if err != nil {
+		return measurements
+	}
+	if err := json.Unmarshal(b, &measurements); err != nil {
+		log.Errorf("GetMeasurements: %s\n", err)
+	}
+	return measurements
+}
+
+// GetFirmwareEventLog returns the TCG binary event log, if any
+func GetFirmwareEventLog() []byte {
+	b, err := ioutil.ReadFile(firmwareEventLogFile)
+	if err != nil {
+		log.Debugf("GetFirmwareEventLog: %s\n", err)
+		return nil
+	}
+	return b
+}
+
+// GetQuote returns a quote of QuotePCRs which covers the nonce. The
+// PCRs can not be read in the same command as the quote hence they are
+// read after it and checked against the digest in the attestation data;
+// if a PCR was extended in between the quote is retried.
+func GetQuote(nonce []byte) (*Quote, error) {
+	rw, err := openTpm()
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256, PCRs: QuotePCRs}
+	for attempt := 1; ; attempt++ {
+		attestData, sig, err := tpm2.Quote(rw, TpmQuoteKeyHdl, "", "",
+			nonce, sel, tpm2.AlgNull)
+		if err != nil {
+			errStr := fmt.Sprintf("GetQuote failed: %v", err)
+			return nil, errors.New(errStr)
+		}
+		pcrs, err := readPCRs(rw, QuotePCRs)
+		if err != nil {
+			return nil, err
+		}
+		err = checkQuotePCRs(attestData, pcrs)
+		if err != nil {
+			if attempt < quoteAttempts {
+				log.Warnf("GetQuote attempt %d: %s\n", attempt, err)
+				continue
+			}
+			errStr := fmt.Sprintf("GetQuote failed after %d attempts: %v",
+				attempt, err)
+			return nil, errors.New(errStr)
+		}
+		signature, err := encodeSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+		quote := &Quote{
+			AttestData: attestData,
+			Signature:  signature,
+			PCRs:       pcrs,
+		}
+		return quote, nil
+	}
+}
+
+// readPCRs returns the SHA256 bank values of the PCRs
+func readPCRs(rw io.ReadWriter, indexes []int) (map[int][]byte, error) {
+	values := make(map[int][]byte)
+	// TPMs return at most 8 PCRs per read
+	for i := 0; i < len(indexes); i += 8 {
+		end := i + 8
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		pcrs, err := tpm2.ReadPCRs(rw, tpm2.PCRSelection{
+			Hash: tpm2.AlgSHA256,
+			PCRs: indexes[i:end],
+		})
+		if err != nil {
+			errStr := fmt.Sprintf("ReadPCRs failed: %v", err)
+			return nil, errors.New(errStr)
+		}
+		for index, value := range pcrs {
+			values[index] = value
+		}
+	}
+	return values, nil
+}
+
+// checkQuotePCRs verifies that the PCR values are the ones which were
+// quoted, i.e., that their digest, in the order of the PCR selection in
+// the attestation data, is the quoted PCR digest
+func checkQuotePCRs(attestData []byte, pcrs map[int][]byte) error {
+	attest, err := tpm2.DecodeAttestationData(attestData)
+	if err != nil {
+		errStr := fmt.Sprintf("DecodeAttestationData failed: %v", err)
+		return errors.New(errStr)
+	}
+	info := attest.AttestedQuoteInfo
+	if info == nil {
+		return errors.New("attestation data is not a quote")
+	}
+	if info.PCRSelection.Hash != tpm2.AlgSHA256 {
+		errStr := fmt.Sprintf("unexpected PCR bank %v",
+			info.PCRSelection.Hash)
+		return errors.New(errStr)
+	}
+	hasher := sha256.New()
+	for _, index := range info.PCRSelection.PCRs {
+		value, ok := pcrs[index]
+		if !ok {
+			errStr := fmt.Sprintf("PCR %d is quoted but not read", index)
+			return errors.New(errStr)
+		}
+		hasher.Write(value)
+	}
+	if len(pcrs) != len(info.PCRSelection.PCRs) {
+		errStr := fmt.Sprintf("%d PCRs read but %d quoted", len(pcrs),
+			len(info.PCRSelection.PCRs))
+		return errors.New(errStr)
+	}
+	if !bytes.Equal(hasher.Sum(nil), info.PCRDigest) {
+		return errors.New("PCRs changed after the quote")
+	}
+	return nil
+}
+
+// GetQuoteKeyPublic returns the TPMT_PUBLIC of the quote key and a
+// signature of its SHA256 using the device key. The latter lets the
+// controller tie the quote key to the device certificate.
+func GetQuoteKeyPublic() ([]byte, []byte, error) {
+	rw, err := openTpm()
+	if err != nil {
+		return nil, nil, err
+	}
+	_, encoded, err := readPublicKey(rw, TpmQuoteKeyHdl)
+	rw.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, err := NewDeviceSigner()
+	if err != nil {
+		return nil, nil, err
+	}
+	digest := sha256.Sum256(encoded)
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encoded, sig, nil
+}
@@ -0,0 +1,303 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// The tests which need a TPM run against the swtpm software TPM simulator
+// and are skipped if it is not installed.
+
+package evetpm
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-tpm/tpm2"
+	log "github.com/sirupsen/logrus"
+)
+
+// startSimulator points the package at a fresh simulator and returns a
+// function to stop it
+func startSimulator(t *testing.T) func() {
+	if _, err := exec.LookPath("swtpm"); err != nil {
+		t.Skip("swtpm not installed")
+	}
+	dir, err := ioutil.TempDir("", "evetpm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sock := filepath.Join(dir, "tpm.sock")
+	cmd := exec.Command("swtpm", "socket", "--tpm2",
+		"--server", "type=unixio,path="+sock,
+		"--ctrl", "type=unixio,path="+filepath.Join(dir, "ctrl.sock"),
+		"--tpmstate", "dir="+dir,
+		"--flags", "not-need-init,startup-clear")
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i++ {
+		if _, err := os.Stat(sock); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	savedPaths, savedLog := tpmPaths, measurementLogFile
+	tpmPaths = []string{sock}
+	measurementLogFile = filepath.Join(dir, "measurements.json")
+	return func() {
+		tpmPaths, measurementLogFile = savedPaths, savedLog
+		cmd.Process.Kill()
+		cmd.Wait()
+		os.RemoveAll(dir)
+	}
+}
+
+func verifySignature(pub *ecdsa.PublicKey, digest []byte, sig []byte) bool {
+	var rs struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(sig, &rs); err != nil {
+		return false
+	}
+	return ecdsa.Verify(pub, digest, rs.R, rs.S)
+}
+
+func TestDeviceKey(t *testing.T) {
+	log.Infof("TestDeviceKey: START\n")
+	defer startSimulator(t)()
+
+	if err := CreateDeviceKey(); err != nil {
+		t.Fatalf("CreateDeviceKey: %s", err)
+	}
+	signer, err := NewDeviceSigner()
+	if err != nil {
+		t.Fatalf("NewDeviceSigner: %s", err)
+	}
+	pub := signer.Public().(*ecdsa.PublicKey)
+	for _, hash := range []crypto.Hash{crypto.SHA256, crypto.SHA384} {
+		h := hash.New()
+		h.Write([]byte("hello"))
+		digest := h.Sum(nil)
+		sig, err := signer.Sign(rand.Reader, digest, hash)
+		if err != nil {
+			t.Fatalf("Sign %v: %s", hash, err)
+		}
+		if !verifySignature(pub, digest, sig) {
+			t.Errorf("Sign %v: signature does not verify", hash)
+		}
+	}
+
+	certFile := measurementLogFile + ".cert.pem"
+	if err := CreateDeviceCert(certFile); err != nil {
+		t.Fatalf("CreateDeviceCert: %s", err)
+	}
+	certPem, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(certPem)
+	if block == nil {
+		t.Fatalf("No PEM in %s", certFile)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %s", err)
+	}
+	if err := cert.CheckSignatureFrom(cert); err != nil {
+		t.Errorf("Certificate is not self-signed: %s", err)
+	}
+	certPub := cert.PublicKey.(*ecdsa.PublicKey)
+	if certPub.X.Cmp(pub.X) != 0 || certPub.Y.Cmp(pub.Y) != 0 {
+		t.Errorf("Certificate has the wrong public key")
+	}
+	log.Infof("TestDeviceKey: DONE\n")
+}
+
+func TestQuote(t *testing.T) {
+	log.Infof("TestQuote: START\n")
+	defer startSimulator(t)()
+
+	if err := CreateDeviceKey(); err != nil {
+		t.Fatalf("CreateDeviceKey: %s", err)
+	}
+	if err := ExtendPCR(PcrEveVersion, "version", []byte("1.2.3")); err != nil {
+		t.Fatalf("ExtendPCR: %s", err)
+	}
+	measurements := GetMeasurements()
+	if len(measurements) != 1 || measurements[0].PcrIndex != PcrEveVersion {
+		t.Errorf("GetMeasurements: unexpected %+v", measurements)
+	}
+
+	nonce := []byte("0123456789abcdef")
+	quote, err := GetQuote(nonce)
+	if err != nil {
+		t.Fatalf("GetQuote: %s", err)
+	}
+	if len(quote.PCRs) != len(QuotePCRs) {
+		t.Errorf("Expected %d PCRs, got %d", len(QuotePCRs),
+			len(quote.PCRs))
+	}
+	// PCR = SHA256(zeros || SHA256(data)) after one extend
+	digest := sha256.Sum256([]byte("1.2.3"))
+	expected := sha256.Sum256(append(make([]byte, sha256.Size), digest[:]...))
+	if !bytes.Equal(quote.PCRs[PcrEveVersion], expected[:]) {
+		t.Errorf("Unexpected PCR %d value %x", PcrEveVersion,
+			quote.PCRs[PcrEveVersion])
+	}
+
+	attest, err := tpm2.DecodeAttestationData(quote.AttestData)
+	if err != nil {
+		t.Fatalf("DecodeAttestationData: %s", err)
+	}
+	if !bytes.Equal(attest.ExtraData, nonce) {
+		t.Errorf("Quote does not cover the nonce")
+	}
+	var pcrValues []byte
+	for _, index := range QuotePCRs {
+		pcrValues = append(pcrValues, quote.PCRs[index]...)
+	}
+	pcrDigest := sha256.Sum256(pcrValues)
+	if attest.AttestedQuoteInfo == nil ||
+		!bytes.Equal(attest.AttestedQuoteInfo.PCRDigest, pcrDigest[:]) {
+		t.Errorf("Quote PCR digest does not match the PCR values")
+	}
+
+	akPublic, akSignature, err := GetQuoteKeyPublic()
+	if err != nil {
+		t.Fatalf("GetQuoteKeyPublic: %s", err)
+	}
+	public, err := tpm2.DecodePublic(akPublic)
+	if err != nil {
+		t.Fatalf("DecodePublic: %s", err)
+	}
+	akPub := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     public.ECCParameters.Point.X,
+		Y:     public.ECCParameters.Point.Y,
+	}
+	attestDigest := sha256.Sum256(quote.AttestData)
+	if !verifySignature(akPub, attestDigest[:], quote.Signature) {
+		t.Errorf("Quote signature does not verify")
+	}
+	signer, err := NewDeviceSigner()
+	if err != nil {
+		t.Fatalf("NewDeviceSigner: %s", err)
+	}
+	akDigest := sha256.Sum256(akPublic)
+	if !verifySignature(signer.Public().(*ecdsa.PublicKey), akDigest[:],
+		akSignature) {
+		t.Errorf("Quote key signature does not verify")
+	}
+	log.Infof("TestQuote: DONE\n")
+}
+
+func TestSealKey(t *testing.T) {
+	log.Infof("TestSealKey: START\n")
+	defer startSimulator(t)()
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	blob, err := SealKey(key)
+	if err != nil {
+		t.Fatalf("SealKey: %s", err)
+	}
+	unsealed, err := UnsealKey(blob)
+	if err != nil {
+		t.Fatalf("UnsealKey: %s", err)
+	}
+	if !bytes.Equal(key, unsealed) {
+		t.Errorf("UnsealKey returned a different key")
+	}
+	// Changing a sealed PCR must prevent unsealing
+	if err := ExtendPCR(PcrEveConfig, "config", []byte("changed")); err != nil {
+		t.Fatalf("ExtendPCR: %s", err)
+	}
+	if _, err := UnsealKey(blob); err == nil {
+		t.Errorf("UnsealKey succeeded after the PCR changed")
+	}
+	log.Infof("TestSealKey: DONE\n")
+}
+
+// quoteAttestData returns a TPMS_ATTEST of a quote of the SHA256 bank
+func quoteAttestData(pcrs []int, pcrDigest []byte) []byte {
+	var buf bytes.Buffer
+	u16 := func(v uint16) { binary.Write(&buf, binary.BigEndian, v) }
+	binary.Write(&buf, binary.BigEndian, uint32(0xff544347)) // magic
+	u16(uint16(tpm2.TagAttestQuote))
+	u16(0) // qualifiedSigner
+	nonce := []byte("nonce")
+	u16(uint16(len(nonce)))
+	buf.Write(nonce)
+	// clock, resetCount, restartCount, safe and firmwareVersion
+	buf.Write(make([]byte, 8+4+4+1+8))
+	binary.Write(&buf, binary.BigEndian, uint32(1))
+	u16(uint16(tpm2.AlgSHA256))
+	selection := make([]byte, 3)
+	for _, index := range pcrs {
+		selection[index/8] |= 1 << uint(index%8)
+	}
+	buf.WriteByte(byte(len(selection)))
+	buf.Write(selection)
+	u16(uint16(len(pcrDigest)))
+	buf.Write(pcrDigest)
+	return buf.Bytes()
+}
+
+type TestCheckQuotePCRsMatrix struct {
+	pcrs        map[int][]byte
+	expectError bool
+}
+
+func TestCheckQuotePCRs(t *testing.T) {
+	quoted := []int{0, 7, 13}
+	value := func(b byte) []byte { return bytes.Repeat([]byte{b}, sha256.Size) }
+	var concat []byte
+	for _, index := range quoted {
+		concat = append(concat, value(byte(index))...)
+	}
+	digest := sha256.Sum256(concat)
+	attestData := quoteAttestData(quoted, digest[:])
+
+	testMatrix := map[string]TestCheckQuotePCRsMatrix{
+		"Quoted values": {
+			pcrs: map[int][]byte{0: value(0), 7: value(7), 13: value(13)},
+		},
+		"Extended after the quote": {
+			pcrs:        map[int][]byte{0: value(0), 7: value(7), 13: value(14)},
+			expectError: true,
+		},
+		"Missing PCR": {
+			pcrs:        map[int][]byte{0: value(0), 7: value(7)},
+			expectError: true,
+		},
+		"Extra PCR": {
+			pcrs: map[int][]byte{0: value(0), 7: value(7), 13: value(13),
+				14: value(14)},
+			expectError: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		err := checkQuotePCRs(attestData, test.pcrs)
+		if (err != nil) != test.expectError {
+			t.Errorf("Test Failed: %s: Expected error %v, Actual: %v\n",
+				testname, test.expectError, err)
+		}
+	}
+	if err := checkQuotePCRs(attestData[:20], nil); err == nil {
+		t.Errorf("checkQuotePCRs succeeded on truncated attestation data")
+	}
+}
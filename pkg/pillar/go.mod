@@ -33,6 +33,7 @@ require (
 	github.com/zededa/eve/sdk/go v0.0.0-00010101000000-000000000000
 	golang.org/x/crypto v0.0.0-20190404164418-38d8ce5564a5
 	golang.org/x/net v0.0.0-20190419010253-1f3472d942ba
+	golang.org/x/sys v0.0.0-20190418153312-f0ce4c0180be
 	google.golang.org/api v0.3.2 // indirect
 	google.golang.org/genproto v0.0.0-20190404172233-64821d5d2107 // indirect
 )
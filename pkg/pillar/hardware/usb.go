@@ -0,0 +1,86 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Implements GetUsbDevices() which lists the USB devices which are
+// currently plugged in, excluding hubs.
+
+package hardware
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+const (
+	usbDevicesDirname = "/sys/bus/usb/devices"
+	usbClassHub       = "09"
+)
+
+// GetUsbDevices returns the USB devices known to dom0 sorted by port path
+func GetUsbDevices() []types.UsbDevice {
+	return readUsbDevices(usbDevicesDirname)
+}
+
+// The entries are the root hubs "usbN", the devices "<bus>-<port>[.<port>]*"
+// and the interfaces "<device>:<config>.<interface>"
+func readUsbDevices(dirname string) []types.UsbDevice {
+	var devices []types.UsbDevice
+
+	locations, err := ioutil.ReadDir(dirname)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("readUsbDevices: %s\n", err)
+		}
+		return devices
+	}
+	for _, location := range locations {
+		portPath := location.Name()
+		if strings.HasPrefix(portPath, "usb") ||
+			strings.Contains(portPath, ":") {
+			continue
+		}
+		dir := filepath.Join(dirname, portPath)
+		if readUsbAttr(dir, "bDeviceClass") == usbClassHub {
+			continue
+		}
+		busnum, err := strconv.Atoi(readUsbAttr(dir, "busnum"))
+		if err != nil {
+			log.Warnf("readUsbDevices: %s no busnum: %s\n", portPath, err)
+			continue
+		}
+		devnum, err := strconv.Atoi(readUsbAttr(dir, "devnum"))
+		if err != nil {
+			log.Warnf("readUsbDevices: %s no devnum: %s\n", portPath, err)
+			continue
+		}
+		devices = append(devices, types.UsbDevice{
+			Busnum:    busnum,
+			Devnum:    devnum,
+			PortPath:  portPath,
+			VendorID:  readUsbAttr(dir, "idVendor"),
+			ProductID: readUsbAttr(dir, "idProduct"),
+			Serial:    readUsbAttr(dir, "serial"),
+			Product:   readUsbAttr(dir, "product"),
+		})
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].PortPath < devices[j].PortPath
+	})
+	return devices
+}
+
+// Returns "" if the attribute does not exist e.g., no serial
+func readUsbAttr(dir string, attr string) string {
+	b, err := ioutil.ReadFile(filepath.Join(dir, attr))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hardware
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zededa/eve/pkg/pillar/types"
+)
+
+func TestReadUsbDevices(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "usbdevices")
+	if err != nil {
+		t.Fatalf("TempDir: %s\n", err)
+	}
+	defer os.RemoveAll(dirname)
+
+	entries := map[string]map[string]string{
+		"usb1": {"busnum": "1", "devnum": "1", "bDeviceClass": "09"},
+		"1-1":  {"busnum": "1", "devnum": "2", "bDeviceClass": "09"},
+		"1-1.2": {"busnum": "1", "devnum": "5", "bDeviceClass": "00",
+			"idVendor": "0403", "idProduct": "6001",
+			"serial": "A50285BI\n", "product": "FT232R USB UART\n"},
+		"1-1.2:1.0": {"bInterfaceClass": "ff"},
+		"2-1": {"busnum": "2", "devnum": "3", "bDeviceClass": "00",
+			"idVendor": "046d", "idProduct": "c31c"},
+	}
+	for name, attrs := range entries {
+		dir := filepath.Join(dirname, name)
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatalf("Mkdir: %s\n", err)
+		}
+		for attr, value := range attrs {
+			err := ioutil.WriteFile(filepath.Join(dir, attr),
+				[]byte(value), 0644)
+			if err != nil {
+				t.Fatalf("WriteFile: %s\n", err)
+			}
+		}
+	}
+	expected := []types.UsbDevice{
+		{Busnum: 1, Devnum: 5, PortPath: "1-1.2", VendorID: "0403",
+			ProductID: "6001", Serial: "A50285BI",
+			Product: "FT232R USB UART"},
+		{Busnum: 2, Devnum: 3, PortPath: "2-1", VendorID: "046d",
+			ProductID: "c31c"},
+	}
+	actual := readUsbDevices(dirname)
+	if !cmp.Equal(expected, actual) {
+		t.Errorf("Test Failed: %v\n", cmp.Diff(expected, actual))
+	}
+}
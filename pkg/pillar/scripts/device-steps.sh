@@ -14,7 +14,7 @@ LISPDIR=/opt/zededa/lisp
 LOGDIRA=$PERSISTDIR/IMGA/log
 LOGDIRB=$PERSISTDIR/IMGB/log
 AGENTS0="logmanager ledmanager nim"
-AGENTS1="zedmanager zedrouter domainmgr downloader verifier identitymgr zedagent lisp-ztr baseosmgr wstunnelclient"
+AGENTS1="zedmanager zedrouter domainmgr downloader verifier identitymgr zedagent lisp-ztr baseosmgr wstunnelclient tpmmgr"
 AGENTS="$AGENTS0 $AGENTS1"
 
 PATH=$BINDIR:$PATH
@@ -162,17 +162,18 @@ if P3=$(zboot partdev P3) && [ -n "$P3" ]; then
             # Try mounting below
         fi
     fi
-    if ! mount -t ext3 -o dirsync,noatime "$P3" $PERSISTDIR; then
+    # The encrypt feature is needed for the vault and requires the
+    # ext4 driver
+    if ! tune2fs -O encrypt "$P3"; then
+        echo "$(date -Ins -u) tune2fs encrypt on $P3 failed: $?"
+    fi
+    if ! mount -t ext4 -o dirsync,noatime "$P3" $PERSISTDIR; then
         echo "$(date -Ins -u) mount $P3 failed: $?"
     fi
 else
     echo "$(date -Ins -u) No separate $PERSISTDIR partition"
 fi
 
-echo "$(date -Ins -u) Current downloaded files:"
-ls -lt $PERSISTDIR/downloads/*/*
-echo
-
 # Copy any GlobalConfig from /config
 dir=$CONFIGDIR/GlobalConfig
 for f in "$dir"/*.json; do
@@ -199,6 +200,17 @@ if [ ! -d $LOGDIRB ]; then
     mkdir -p $LOGDIRB
 fi
 
+# Unlock the encrypted vault and mount its directories over
+# $PERSISTDIR/img, downloads and status before any agent uses them
+echo "$(date -Ins -u) Setting up the vault"
+if ! $BINDIR/tpmmgr -c $CURPART setupVault; then
+    echo "$(date -Ins -u) setupVault failed: $?"
+fi
+
+echo "$(date -Ins -u) Current downloaded files:"
+ls -lt $PERSISTDIR/downloads/*/*
+echo
+
 if [ ! -d $PERSISTDIR/log ]; then
     echo "$(date -Ins -u) Creating $PERSISTDIR/log"
     mkdir $PERSISTDIR/log
@@ -334,11 +346,16 @@ if [ -f /var/run/watchdog.pid ]; then
 fi
 /usr/sbin/watchdog -c $TMPDIR/watchdogclient.conf -F -s &
 
-if ! [ -f $CONFIGDIR/device.cert.pem ] || ! [ -f $CONFIGDIR/device.key.pem ]; then
+if ! [ -f $CONFIGDIR/device.cert.pem ] || { ! [ -f $CONFIGDIR/device.key.pem ] && ! [ -f $CONFIGDIR/tpm_in_use ]; }; then
     echo "$(date -Ins -u) Generating a device key pair and self-signed cert (using TPM/TEE if available)"
     touch $CONFIGDIR/self-register-pending
     sync
-    $BINDIR/generate-device.sh $CONFIGDIR/device
+    if [ -c /dev/tpm0 ] && $BINDIR/tpmmgr -c $CURPART genKey; then
+        echo "$(date -Ins -u) Generated a device key in the TPM"
+    else
+        rm -f $CONFIGDIR/tpm_in_use
+        $BINDIR/generate-device.sh $CONFIGDIR/device
+    fi
     # Reduce chance that we register with controller and crash before
     # the filesystem has persisted /config/device.cert.* and
     # self-register-pending
@@ -428,8 +445,20 @@ if [ ! -d $LISPDIR ]; then
     exit 1
 fi
 
-# Need a key for device-to-device map-requests
-cp -p $CONFIGDIR/device.key.pem $LISPDIR/lisp-sig.pem
+# Need a key for device-to-device map-requests. lispers.net reads it from
+# a file hence when the device key is in the TPM we keep a separate
+# software key in /config for signing map-requests.
+if [ -f $CONFIGDIR/device.key.pem ]; then
+    cp -p $CONFIGDIR/device.key.pem $LISPDIR/lisp-sig.pem
+else
+    if [ ! -f $CONFIGDIR/lisp.key.pem ]; then
+        echo "$(date -Ins -u) Generating a LISP signing key"
+        (umask 077 && openssl ecparam -genkey -noout -name prime256v1 -out $CONFIGDIR/lisp.key.pem.tmp) &&
+            mv $CONFIGDIR/lisp.key.pem.tmp $CONFIGDIR/lisp.key.pem
+        sync
+    fi
+    cp -p $CONFIGDIR/lisp.key.pem $LISPDIR/lisp-sig.pem
+fi
 
 # Setup default amount of space for images
 # Half of /persist by default! Convert to kbytes
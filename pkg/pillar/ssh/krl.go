@@ -0,0 +1,185 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Key revocation list for sshd in the format of PROTOCOL.krl in OpenSSH.
+// sshd_config has RevokedKeys set to revokedKeysFile hence the file has to
+// exist, or sshd refuses all keys; an empty KRL revokes nothing.
+// Revoked keys can be public keys, which any sshd supports, or SHA256
+// fingerprints, which need OpenSSH 7.9 or later.
+
+package ssh
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+const (
+	revokedKeysFile = runDir + "/ssh_revoked_keys.krl"
+
+	krlMagic                    = 0x5353484b524c0a00
+	krlFormatVersion            = 1
+	krlSectionCertificates      = 1
+	krlSectionExplicitKey       = 2
+	krlSectionFingerprintSHA256 = 5
+	krlSectionCertSerialList    = 0x20
+)
+
+// krlBytes returns the KRL with the revoked keys and certificate serials
+// of the CA config. Invalid keys, fingerprints and CA keys are skipped.
+func krlBytes(caConfig types.SshCAConfig, generated time.Time) []byte {
+	var buf bytes.Buffer
+	putUint64(&buf, krlMagic)
+	putUint32(&buf, krlFormatVersion)
+	putUint64(&buf, uint64(generated.Unix())) // krl_version
+	putUint64(&buf, uint64(generated.Unix())) // generated_date
+	putUint64(&buf, 0)                        // flags
+	putString(&buf, nil)                      // reserved
+	putString(&buf, []byte("EVE"))            // comment
+
+	serials := sortedSerials(caConfig.RevokedSerials)
+	if len(serials) != 0 {
+		var list bytes.Buffer
+		for _, serial := range serials {
+			putUint64(&list, serial)
+		}
+		for _, caKey := range caConfig.UserCAKeys {
+			pub, _, _, _, err := cryptossh.ParseAuthorizedKey([]byte(caKey))
+			if err != nil {
+				log.Errorf("krlBytes: bad CA key %s: %s\n", caKey, err)
+				continue
+			}
+			var section bytes.Buffer
+			putString(&section, pub.Marshal())
+			putString(&section, nil) // reserved
+			section.WriteByte(krlSectionCertSerialList)
+			putString(&section, list.Bytes())
+			buf.WriteByte(krlSectionCertificates)
+			putString(&buf, section.Bytes())
+		}
+	}
+
+	blobs, hashes := revokedKeys(caConfig.RevokedKeys)
+	putBlobSection(&buf, krlSectionExplicitKey, blobs)
+	putBlobSection(&buf, krlSectionFingerprintSHA256, hashes)
+	return buf.Bytes()
+}
+
+func putBlobSection(buf *bytes.Buffer, sectionType byte, blobs [][]byte) {
+	if len(blobs) == 0 {
+		return
+	}
+	var section bytes.Buffer
+	for _, blob := range blobs {
+		putString(&section, blob)
+	}
+	buf.WriteByte(sectionType)
+	putString(buf, section.Bytes())
+}
+
+// The serials in increasing order without duplicates
+func sortedSerials(serials []uint64) []uint64 {
+	sorted := append([]uint64(nil), serials...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var unique []uint64
+	for i, serial := range sorted {
+		if i == 0 || serial != sorted[i-1] {
+			unique = append(unique, serial)
+		}
+	}
+	return unique
+}
+
+// revokedKeys splits the revoked keys into the public key blobs and the
+// hashes of the fingerprints, each in increasing order without duplicates
+func revokedKeys(keys []string) ([][]byte, [][]byte) {
+	var blobs, hashes [][]byte
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if !strings.HasPrefix(key, "SHA256:") {
+			pub, _, _, _, err := cryptossh.ParseAuthorizedKey([]byte(key))
+			if err != nil {
+				log.Errorf("revokedKeys: bad key <%s>: %s\n", key, err)
+				continue
+			}
+			blobs = append(blobs, pub.Marshal())
+			continue
+		}
+		hash, err := base64.RawStdEncoding.DecodeString(
+			strings.TrimRight(key[len("SHA256:"):], "="))
+		if err != nil || len(hash) != 32 {
+			log.Errorf("revokedKeys: bad fingerprint <%s>\n", key)
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+	return sortedBlobs(blobs), sortedBlobs(hashes)
+}
+
+func sortedBlobs(blobs [][]byte) [][]byte {
+	sort.Slice(blobs, func(i, j int) bool {
+		return bytes.Compare(blobs[i], blobs[j]) < 0
+	})
+	var unique [][]byte
+	for i, blob := range blobs {
+		if i == 0 || !bytes.Equal(blob, blobs[i-1]) {
+			unique = append(unique, blob)
+		}
+	}
+	return unique
+}
+
+func putUint32(buf *bytes.Buffer, value uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], value)
+	buf.Write(b[:])
+}
+
+func putUint64(buf *bytes.Buffer, value uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], value)
+	buf.Write(b[:])
+}
+
+func putString(buf *bytes.Buffer, value []byte) {
+	putUint32(buf, uint32(len(value)))
+	buf.Write(value)
+}
+
+// updateRevokedKeys writes the KRL for the CA config
+func updateRevokedKeys(caConfig types.SshCAConfig) {
+	tmpfile, err := ioutil.TempFile(runDir, "krl")
+	if err != nil {
+		log.Errorln("TempFile ", err)
+		return
+	}
+	defer tmpfile.Close()
+	defer os.Remove(tmpfile.Name())
+	tmpfile.Chmod(0600)
+
+	if _, err := tmpfile.Write(krlBytes(caConfig, time.Now())); err != nil {
+		log.Error(err)
+		return
+	}
+	tmpfile.Sync()
+	if err := tmpfile.Close(); err != nil {
+		log.Errorln("Close ", tmpfile.Name(), err)
+		return
+	}
+	if err := os.Rename(tmpfile.Name(), revokedKeysFile); err != nil {
+		log.Errorln("Rename ", tmpfile.Name(), revokedKeysFile, err)
+		return
+	}
+	log.Infof("updateRevokedKeys: %d keys %d serials\n",
+		len(caConfig.RevokedKeys), len(caConfig.RevokedSerials))
+}
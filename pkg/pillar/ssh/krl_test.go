@@ -0,0 +1,142 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zededa/eve/pkg/pillar/types"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+const (
+	testCAKey       = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKODV8x5fEtXofOuhlb3pG50R1dJOMs9isEiPt1VNpFP ca"
+	testFingerprint = "SHA256:D1X7WX1lAH6Rq8yNEXYz7JrTp4kGE5LwyrZMkaKSEsA"
+)
+
+// What is in a KRL
+type testKRL struct {
+	serials [][]uint64 // Per certificates section
+	keys    [][]byte
+	hashes  [][]byte
+}
+
+func getString(t *testing.T, data []byte) ([]byte, []byte) {
+	if len(data) < 4 {
+		t.Fatalf("short string")
+	}
+	n := binary.BigEndian.Uint32(data)
+	if uint32(len(data)-4) < n {
+		t.Fatalf("short string %d", n)
+	}
+	return data[4 : 4+n], data[4+n:]
+}
+
+func parseKRL(t *testing.T, data []byte) testKRL {
+	var krl testKRL
+	if binary.BigEndian.Uint64(data) != krlMagic {
+		t.Fatalf("bad magic")
+	}
+	// magic, version, krl_version, generated_date, flags
+	data = data[8+4+8+8+8:]
+	_, data = getString(t, data) // reserved
+	_, data = getString(t, data) // comment
+	for len(data) != 0 {
+		sectionType := data[0]
+		var section []byte
+		section, data = getString(t, data[1:])
+		switch sectionType {
+		case krlSectionCertificates:
+			_, section = getString(t, section) // ca_key
+			_, section = getString(t, section) // reserved
+			if section[0] != krlSectionCertSerialList {
+				t.Fatalf("bad cert section %d", section[0])
+			}
+			list, _ := getString(t, section[1:])
+			var serials []uint64
+			for ; len(list) != 0; list = list[8:] {
+				serials = append(serials, binary.BigEndian.Uint64(list))
+			}
+			krl.serials = append(krl.serials, serials)
+		case krlSectionExplicitKey:
+			for len(section) != 0 {
+				var key []byte
+				key, section = getString(t, section)
+				krl.keys = append(krl.keys, key)
+			}
+		case krlSectionFingerprintSHA256:
+			for len(section) != 0 {
+				var hash []byte
+				hash, section = getString(t, section)
+				krl.hashes = append(krl.hashes, hash)
+			}
+		default:
+			t.Fatalf("bad section %d", sectionType)
+		}
+	}
+	return krl
+}
+
+type TestKRLMatrix struct {
+	config   types.SshCAConfig
+	expected testKRL
+}
+
+func TestKRLBytes(t *testing.T) {
+	hash, _ := base64.RawStdEncoding.DecodeString(testFingerprint[len("SHA256:"):])
+	lowHash := bytes.Repeat([]byte{0}, 32)
+	lowFingerprint := "SHA256:" + base64.RawStdEncoding.EncodeToString(lowHash)
+	pub, _, _, _, _ := cryptossh.ParseAuthorizedKey([]byte(testCAKey))
+	testMatrix := map[string]TestKRLMatrix{
+		"Empty": {
+			config:   types.SshCAConfig{},
+			expected: testKRL{},
+		},
+		"Serials sorted": {
+			config: types.SshCAConfig{
+				UserCAKeys:     []string{testCAKey},
+				RevokedSerials: []uint64{42, 3, 42},
+			},
+			expected: testKRL{serials: [][]uint64{{3, 42}}},
+		},
+		"Serials per CA key": {
+			config: types.SshCAConfig{
+				UserCAKeys:     []string{testCAKey, "bad key", testCAKey},
+				RevokedSerials: []uint64{7},
+			},
+			expected: testKRL{serials: [][]uint64{{7}, {7}}},
+		},
+		"Fingerprints sorted": {
+			config: types.SshCAConfig{
+				RevokedKeys: []string{testFingerprint, "junk",
+					"SHA256:tooshort", lowFingerprint,
+					testFingerprint},
+			},
+			expected: testKRL{hashes: [][]byte{lowHash, hash}},
+		},
+		"Public keys": {
+			config: types.SshCAConfig{
+				RevokedKeys: []string{testCAKey, "ssh-rsa junk",
+					testCAKey, testFingerprint},
+			},
+			expected: testKRL{
+				keys:   [][]byte{pub.Marshal()},
+				hashes: [][]byte{hash},
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		actual := parseKRL(t, krlBytes(test.config, time.Now()))
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Test Failed: %s: Expected %+v, Actual: %+v\n",
+				testname, test.expected, actual)
+		}
+	}
+}
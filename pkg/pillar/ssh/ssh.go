@@ -5,8 +5,11 @@
 // At some point we may also consider extracting key material
 // from x509 certificates...
 //   bash -c 'ssh-keygen -f <(openssl x509 -in /config/onboard.cert.pem -pubkey -noout) -i -mPKCS8' >> /run/authorized_keys
-// ...or using CA signed ssh keys
-//  TrustedUserCAKeys /path/to/server_ca.pub
+// Certificates signed by a user CA from the controller are accepted
+// using a cert-authority line per CA key and principal. The forced command
+// runs sshsession which enforces the role of the principal and records
+// the session in the audit log. Revoked keys and certificates are in a
+// KRL, see krl.go.
 
 package ssh
 
@@ -19,6 +22,8 @@ import (
 	"strings"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	cryptossh "golang.org/x/crypto/ssh"
 )
 
 const (
@@ -29,11 +34,69 @@ const (
 
 	// XXX a bit of a hack to hard-code this here
 	sshCommand = `command="ctr --namespace services.linuxkit t exec ${TERM:+-t} --exec-id $(basename $(mktemp)) pillar ${TERM:+env TERM=\"$TERM\"} ${SSH_ORIGINAL_COMMAND:-sh}"`
+	// Takes the role and principal
+	sshSessionCommand = `command="ctr --namespace services.linuxkit t exec ${TERM:+-t} --exec-id $(basename $(mktemp)) pillar ${TERM:+env TERM=\"$TERM\"} /opt/zededa/bin/sshsession -r %s -u %s -s \"$SSH_CONNECTION\" -- \"$SSH_ORIGINAL_COMMAND\""`
 )
 
-func UpdateSshAuthorizedKeys(authorizedKeys string) {
+// CAEnabled returns true if certificates can be used to log in
+func CAEnabled(caConfig types.SshCAConfig) bool {
+	return len(caLines(caConfig)) != 0
+}
+
+// caLines returns the authorized_keys lines for the CA config. Invalid
+// keys and principals are skipped.
+func caLines(caConfig types.SshCAConfig) []string {
+	var lines []string
+	for _, caKey := range caConfig.UserCAKeys {
+		caKey = strings.TrimSpace(caKey)
+		if _, _, _, _, err := cryptossh.ParseAuthorizedKey([]byte(caKey)); err != nil {
+			log.Errorf("caLines: bad CA key %s: %s\n", caKey, err)
+			continue
+		}
+		for _, principal := range caConfig.Principals {
+			if !validPrincipal(principal.Name) {
+				log.Errorf("caLines: bad principal <%s>\n",
+					principal.Name)
+				continue
+			}
+			if caConfig.IsRevoked(principal.Name) {
+				log.Infof("caLines: %s is revoked\n", principal.Name)
+				continue
+			}
+			if principal.Role == types.SshRoleNone {
+				continue
+			}
+			command := fmt.Sprintf(sshSessionCommand,
+				principal.Role, principal.Name)
+			lines = append(lines,
+				fmt.Sprintf("cert-authority,principals=\"%s\",%s %s",
+					principal.Name, command, caKey))
+		}
+	}
+	return lines
+}
 
-	log.Infof("UpdateSshAuthorizedKeys: %s", authorizedKeys)
+// validPrincipal makes sure the name needs no quoting in authorized_keys
+// nor in the shell
+func validPrincipal(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' ||
+			r >= '0' && r <= '9' || r == '-' || r == '_' ||
+			r == '.' || r == '@') {
+			return false
+		}
+	}
+	return true
+}
+
+func UpdateSshAuthorizedKeys(authorizedKeys string, caConfig types.SshCAConfig) {
+
+	log.Infof("UpdateSshAuthorizedKeys: %s %+v", authorizedKeys, caConfig)
+	// Revoke before accepting new keys
+	updateRevokedKeys(caConfig)
 	tmpfile, err := ioutil.TempFile(runDir, "ak")
 	if err != nil {
 		log.Errorln("TempFile ", err)
@@ -82,6 +145,13 @@ func UpdateSshAuthorizedKeys(authorizedKeys string) {
 		}
 	}
 
+	for _, line := range caLines(caConfig) {
+		if _, err := tmpfile.WriteString(line + "\n"); err != nil {
+			log.Error(err)
+			return
+		}
+	}
+
 	tmpfile.Sync()
 	if err := tmpfile.Close(); err != nil {
 		log.Errorln("Close ", tmpfile.Name(), err)
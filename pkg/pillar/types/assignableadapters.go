@@ -20,6 +20,8 @@ import (
 type AssignableAdapters struct {
 	Initialized  bool
 	IoBundleList []IoBundle
+	// USB devices in dom0 which can be assigned using a UsbDeviceMatch
+	UsbDeviceList []UsbDevice
 }
 
 type IoBundle struct {
@@ -79,6 +81,8 @@ const (
 	IoUSB   IoType = 2
 	IoCom   IoType = 3
 	IoOther IoType = 255
+	// A single USB device; not in IoBundleList
+	IoUSBDevice IoType = 5
 )
 
 // Returns nil if not found
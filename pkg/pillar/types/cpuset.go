@@ -0,0 +1,68 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseCPUSet parses a list of CPUs such as "1,2" or "0-3,6" as used in
+// VmConfig.CPUs. Returns the CPUs sorted and without duplicates.
+func ParseCPUSet(str string) ([]int, error) {
+	set := make(map[int]bool)
+	for _, part := range strings.Split(str, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		first, last := part, part
+		if i := strings.Index(part, "-"); i != -1 {
+			first, last = part[:i], part[i+1:]
+		}
+		start, err := strconv.Atoi(first)
+		if err != nil || start < 0 {
+			errStr := fmt.Sprintf("bad CPU %s in %s", first, str)
+			return nil, errors.New(errStr)
+		}
+		end, err := strconv.Atoi(last)
+		if err != nil || end < start {
+			errStr := fmt.Sprintf("bad CPU range %s in %s", part, str)
+			return nil, errors.New(errStr)
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			set[cpu] = true
+		}
+	}
+	var cpus []int
+	for cpu := range set {
+		cpus = append(cpus, cpu)
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// FormatCPUSet is the inverse of ParseCPUSet using ranges where possible
+func FormatCPUSet(cpus []int) string {
+	sorted := append([]int{}, cpus...)
+	sort.Ints(sorted)
+	var parts []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[i] == sorted[j] {
+			parts = append(parts, strconv.Itoa(sorted[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", sorted[i],
+				sorted[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
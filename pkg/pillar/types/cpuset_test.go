@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type TestCPUSetMatrix struct {
+	cpuSet    string
+	expectErr bool
+	cpus      []int
+	formatted string
+}
+
+func TestParseCPUSet(t *testing.T) {
+	testMatrix := map[string]TestCPUSetMatrix{
+		"Empty": {
+			cpuSet:    "",
+			formatted: "",
+		},
+		"List": {
+			cpuSet:    "1,2",
+			cpus:      []int{1, 2},
+			formatted: "1-2",
+		},
+		"Range and duplicates": {
+			cpuSet:    "0-3, 6,2",
+			cpus:      []int{0, 1, 2, 3, 6},
+			formatted: "0-3,6",
+		},
+		"Bad range": {
+			cpuSet:    "3-1",
+			expectErr: true,
+		},
+		"Negative": {
+			cpuSet:    "-1",
+			expectErr: true,
+		},
+		"Not a number": {
+			cpuSet:    "a",
+			expectErr: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		cpus, err := ParseCPUSet(test.cpuSet)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("Test Failed: %s: expected error\n",
+					testname)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test Failed: %s: %s\n", testname, err)
+			continue
+		}
+		if !cmp.Equal(test.cpus, cpus) {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.cpus, cpus)
+		}
+		formatted := FormatCPUSet(cpus)
+		if formatted != test.formatted {
+			t.Errorf("Test Failed: %s: Expected %s, Actual: %s\n",
+				testname, test.formatted, formatted)
+		}
+	}
+}
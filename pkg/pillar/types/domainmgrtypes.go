@@ -4,8 +4,10 @@
 package types
 
 import (
-	log "github.com/sirupsen/logrus"
+	"reflect"
 	"time"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // The information XenManager needs to boot and halt domains
@@ -24,7 +26,11 @@ type DomainConfig struct {
 	DiskConfigList    []DiskConfig
 	VifList           []VifInfo
 	IoAdapterList     []IoAdapter
-	CloudInitUserData string // base64-encoded
+	UsbDeviceList     []UsbDeviceMatch // Attached when plugged in
+	CloudInitUserData string           // base64-encoded; for cidata disk
+	RestartPolicy     RestartPolicy
+	HealthProbe       HealthProbe
+	ProbeIPAddr       string // Of the first underlay network if any
 }
 
 func (config DomainConfig) Key() string {
@@ -71,6 +77,23 @@ type VmConfig struct {
 	VncPasswd          string
 }
 
+// OnlineResizable returns true if the only differences from old are in
+// Memory up to MaxMem and in VCpus up to MaxCpus, which domainmgr can
+// change for a running domain
+func (config VmConfig) OnlineResizable(old VmConfig) bool {
+	if config.Memory != old.Memory &&
+		(config.MaxMem == 0 || config.Memory > config.MaxMem) {
+		return false
+	}
+	if config.VCpus != old.VCpus &&
+		(config.MaxCpus == 0 || config.VCpus > config.MaxCpus) {
+		return false
+	}
+	config.Memory = old.Memory
+	config.VCpus = old.VCpus
+	return reflect.DeepEqual(config, old)
+}
+
 type VmMode uint8
 
 const (
@@ -94,6 +117,7 @@ type DomainStatus struct {
 	DiskStatusList     []DiskStatus
 	VifList            []VifInfo
 	IoAdapterList      []IoAdapter
+	UsbDeviceList      []UsbDeviceStatus
 	VirtualizationMode VmMode
 	EnableVnc          bool
 	VncDisplay         uint32
@@ -103,7 +127,22 @@ type DomainStatus struct {
 	LastErrTime        time.Time
 	BootFailed         bool
 	AdaptersFailed     bool
-}
+	AdaptersPending    bool // Hot-plug waiting for the adapters
+	AdmissionFailed    bool // Waiting for memory or CPUs
+	Health             HealthStatus
+	// What the running domain has; changed online up to the limits
+	MemoryTarget  int // kbytes; the balloon target
+	MaxMemory     int // kbytes
+	VCpus         int // Online vCPUs
+	MaxVCpus      int
+	AutoBallooned bool // MemoryTarget lowered due to memory pressure
+}
+
+// ConsoleSocket is where domainmgr serves the serial consoles of the
+// domains. A client sends "attach <app>\n" for an interactive console or
+// "tail <app> <kbytes>\n" for the last output, where app is the UUID or
+// the name of the app instance.
+const ConsoleSocket = "/var/run/domainmgr/console.sock"
 
 func (status DomainStatus) Key() string {
 	return status.UUIDandVersion.UUID.String()
@@ -180,3 +219,32 @@ type ImageStatus struct {
 func (status ImageStatus) Key() string {
 	return status.Filename
 }
+
+// DeviceCapacity is what domainmgr can allocate to app instances and what
+// it has allocated. Published with key "global".
+type DeviceCapacity struct {
+	TotalMemory      uint64 // In MBytes
+	ReservedMemory   uint64 // In MBytes for dom0, EVE and Xen
+	AllocatedMemory  uint64 // In MBytes to admitted domains
+	Ncpu             int
+	PinnedCPUs       string // E.g., "1-2,4"
+	ExclusivePinning bool
+	Allocations      []DomainAllocation
+}
+
+// DomainAllocation has the resources of a domain which has been admitted
+// or is waiting to be admitted
+type DomainAllocation struct {
+	UUIDandVersion UUIDandVersion
+	DisplayName    string
+	DomainName     string
+	Memory         uint64 // In MBytes; MaxMem if larger
+	VCpus          int
+	CPUs           string // Pinned CPUs if any
+	Admitted       bool
+	Error          string // Why not admitted
+}
+
+func (capacity DeviceCapacity) Key() string {
+	return "global"
+}
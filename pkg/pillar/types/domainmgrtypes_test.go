@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+)
+
+type TestOnlineResizableMatrix struct {
+	config   VmConfig
+	expected bool
+}
+
+func TestOnlineResizable(t *testing.T) {
+	old := VmConfig{
+		Kernel:  "/boot/kernel",
+		Memory:  512 * 1024,
+		MaxMem:  2048 * 1024,
+		VCpus:   1,
+		MaxCpus: 4,
+	}
+	testMatrix := map[string]TestOnlineResizableMatrix{
+		"No change": {
+			config:   old,
+			expected: true,
+		},
+		"Memory up to MaxMem": {
+			config: VmConfig{Kernel: "/boot/kernel",
+				Memory: 2048 * 1024, MaxMem: 2048 * 1024,
+				VCpus: 1, MaxCpus: 4},
+			expected: true,
+		},
+		"Memory above MaxMem": {
+			config: VmConfig{Kernel: "/boot/kernel",
+				Memory: 4096 * 1024, MaxMem: 2048 * 1024,
+				VCpus: 1, MaxCpus: 4},
+			expected: false,
+		},
+		"Memory without MaxMem": {
+			config: VmConfig{Kernel: "/boot/kernel",
+				Memory: 256 * 1024, VCpus: 1, MaxCpus: 4},
+			expected: false,
+		},
+		"VCpus up to MaxCpus": {
+			config: VmConfig{Kernel: "/boot/kernel",
+				Memory: 512 * 1024, MaxMem: 2048 * 1024,
+				VCpus: 4, MaxCpus: 4},
+			expected: true,
+		},
+		"VCpus above MaxCpus": {
+			config: VmConfig{Kernel: "/boot/kernel",
+				Memory: 512 * 1024, MaxMem: 2048 * 1024,
+				VCpus: 8, MaxCpus: 4},
+			expected: false,
+		},
+		"MaxMem changed": {
+			config: VmConfig{Kernel: "/boot/kernel",
+				Memory: 512 * 1024, MaxMem: 4096 * 1024,
+				VCpus: 1, MaxCpus: 4},
+			expected: false,
+		},
+		"Kernel changed": {
+			config: VmConfig{Kernel: "/boot/other",
+				Memory: 1024 * 1024, MaxMem: 2048 * 1024,
+				VCpus: 1, MaxCpus: 4},
+			expected: false,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		actual := test.config.OnlineResizable(old)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, actual)
+		}
+	}
+}
@@ -5,8 +5,12 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/zededa/eve/pkg/pillar/pubsub"
@@ -27,6 +31,7 @@ const (
 type GlobalConfig struct {
 	ConfigInterval          uint32 // Try get of device config
 	MetricInterval          uint32 // push metrics to cloud
+	MetricSampleInterval    uint32 // sample CPU and memory for min/max/avg
 	ResetIfCloudGoneTime    uint32 // reboot if no cloud connectivity
 	FallbackIfCloudGoneTime uint32 // ... and shorter during update
 	MintimeUpdateSuccess    uint32 // time before zedagent declares success
@@ -61,6 +66,17 @@ type GlobalConfig struct {
 	SshAccess         bool
 	SshAuthorizedKeys string
 
+	// Admission control of app instances
+	MemoryReserve       uint32 // MBytes kept for EVE and Xen beyond dom0
+	ExclusiveCPUPinning bool   // Pinned CPUs are not shared
+	// Balloon down idle apps when less memory is free; zero disables
+	AutoBalloonFreeMemory uint32 // MBytes
+
+	// Local OpenMetrics endpoint; zero port disables
+	MetricsExportPort   uint32
+	MetricsExportListen string // Network instance or port; empty for all
+	MetricsExportAllow  string // Client CIDRs; empty for localhost only
+
 	AllowAppVnc           bool
 	DefaultLogLevel       string
 	DefaultRemoteLogLevel string
@@ -90,6 +106,7 @@ type PerAgentSettings struct {
 var GlobalConfigDefaults = GlobalConfig{
 	ConfigInterval:          60,
 	MetricInterval:          60,
+	MetricSampleInterval:    10,
 	ResetIfCloudGoneTime:    7 * 24 * 3600,
 	FallbackIfCloudGoneTime: 300,
 	MintimeUpdateSuccess:    600,
@@ -109,10 +126,41 @@ var GlobalConfigDefaults = GlobalConfig{
 	VdiskGCTime:           3600,   // 1 hour
 	DownloadRetryTime:     600,    // 10 minutes
 	DomainBootRetryTime:   600,    // 10 minutes
+	MemoryReserve:         256,    // MBytes
 	DefaultLogLevel:       "info", // XXX Should we change to warning?
 	DefaultRemoteLogLevel: "info", // XXX Should we change to warning?
 }
 
+// ParseMetricsExportAllow returns the CIDRs from the comma-separated
+// MetricsExportAllow. A plain address is a /32 or /128.
+func ParseMetricsExportAllow(str string) ([]*net.IPNet, error) {
+	var allow []*net.IPNet
+	for _, part := range strings.Split(str, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if !strings.Contains(part, "/") {
+			ip := net.ParseIP(part)
+			if ip == nil {
+				errStr := fmt.Sprintf("bad address %s", part)
+				return nil, errors.New(errStr)
+			}
+			bits := 32
+			if ip.To4() == nil {
+				bits = 128
+			}
+			part = fmt.Sprintf("%s/%d", part, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(part)
+		if err != nil {
+			return nil, err
+		}
+		allow = append(allow, ipNet)
+	}
+	return allow, nil
+}
+
 // Check which values are set and which should come from defaults
 // Zero integers means to use default
 func ApplyGlobalConfig(newgc GlobalConfig) GlobalConfig {
@@ -123,6 +171,9 @@ func ApplyGlobalConfig(newgc GlobalConfig) GlobalConfig {
 	if newgc.MetricInterval == 0 {
 		newgc.MetricInterval = GlobalConfigDefaults.MetricInterval
 	}
+	if newgc.MetricSampleInterval == 0 {
+		newgc.MetricSampleInterval = GlobalConfigDefaults.MetricSampleInterval
+	}
 	if newgc.ResetIfCloudGoneTime == 0 {
 		newgc.ResetIfCloudGoneTime = GlobalConfigDefaults.ResetIfCloudGoneTime
 	}
@@ -164,6 +215,9 @@ func ApplyGlobalConfig(newgc GlobalConfig) GlobalConfig {
 	if newgc.DomainBootRetryTime == 0 {
 		newgc.DomainBootRetryTime = GlobalConfigDefaults.DomainBootRetryTime
 	}
+	if newgc.MemoryReserve == 0 {
+		newgc.MemoryReserve = GlobalConfigDefaults.MemoryReserve
+	}
 	if newgc.DefaultLogLevel == "" {
 		newgc.DefaultLogLevel = GlobalConfigDefaults.DefaultLogLevel
 	}
@@ -177,6 +231,7 @@ func ApplyGlobalConfig(newgc GlobalConfig) GlobalConfig {
 var GlobalConfigMinimums = GlobalConfig{
 	ConfigInterval:          5,
 	MetricInterval:          5,
+	MetricSampleInterval:    2,
 	ResetIfCloudGoneTime:    120,
 	FallbackIfCloudGoneTime: 60,
 	MintimeUpdateSuccess:    30,
@@ -206,6 +261,11 @@ func EnforceGlobalConfigMinimums(newgc GlobalConfig) GlobalConfig {
 			newgc.MetricInterval, GlobalConfigMinimums.MetricInterval)
 		newgc.MetricInterval = GlobalConfigMinimums.MetricInterval
 	}
+	if newgc.MetricSampleInterval < GlobalConfigMinimums.MetricSampleInterval {
+		log.Warnf("Enforce minimum MetricSampleInterval received %d; using %d",
+			newgc.MetricSampleInterval, GlobalConfigMinimums.MetricSampleInterval)
+		newgc.MetricSampleInterval = GlobalConfigMinimums.MetricSampleInterval
+	}
 	if newgc.ResetIfCloudGoneTime < GlobalConfigMinimums.ResetIfCloudGoneTime {
 		log.Warnf("Enforce minimum XXX received %d; using %d",
 			newgc.ResetIfCloudGoneTime, GlobalConfigMinimums.ResetIfCloudGoneTime)
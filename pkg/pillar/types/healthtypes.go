@@ -0,0 +1,127 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+)
+
+// RestartMode determines when domainmgr restarts a domain which is no
+// longer running or is unhealthy
+type RestartMode uint8
+
+const (
+	RestartNever     RestartMode = iota // Default
+	RestartOnFailure                    // Crashed or failed health probe
+	RestartAlways                       // Also when the guest halts
+)
+
+// RestartPolicy from the AppInstanceConfig
+type RestartPolicy struct {
+	Mode        RestartMode
+	MaxAttempts uint32 // Consecutive restarts; zero means no limit
+	Backoff     uint32 // Seconds before the first restart; doubled each time
+}
+
+type ProbeType uint8
+
+const (
+	ProbeNone      ProbeType = iota // Default
+	ProbeTCP                        // Connect to Port on the app IP
+	ProbeHTTP                       // GET Path on Port on the app IP
+	ProbeHeartbeat                  // Guest agent updates xenstore
+)
+
+// HealthProbe from the AppInstanceConfig. Zero values are replaced by
+// the defaults below.
+type HealthProbe struct {
+	Type             ProbeType
+	Port             uint16
+	Path             string // For HTTP
+	Interval         uint32 // Seconds
+	Timeout          uint32 // Seconds
+	FailureThreshold uint32 // Consecutive failures before unhealthy
+	InitialDelay     uint32 // Seconds after boot before the first probe
+}
+
+const (
+	DefaultProbeInterval         = 30
+	DefaultProbeTimeout          = 5
+	DefaultProbeFailureThreshold = 3
+	DefaultProbeInitialDelay     = 60
+	DefaultRestartBackoff        = 10
+)
+
+// WithDefaults returns the probe with defaults for the unset fields
+func (probe HealthProbe) WithDefaults() HealthProbe {
+	if probe.Interval == 0 {
+		probe.Interval = DefaultProbeInterval
+	}
+	if probe.Timeout == 0 {
+		probe.Timeout = DefaultProbeTimeout
+	}
+	if probe.FailureThreshold == 0 {
+		probe.FailureThreshold = DefaultProbeFailureThreshold
+	}
+	if probe.InitialDelay == 0 {
+		probe.InitialDelay = DefaultProbeInitialDelay
+	}
+	if probe.Type == ProbeHTTP && probe.Path == "" {
+		probe.Path = "/"
+	}
+	return probe
+}
+
+type HealthState uint8
+
+const (
+	HealthUnknown HealthState = iota // No probe or not probed yet
+	HealthHealthy
+	HealthUnhealthy
+)
+
+func (state HealthState) String() string {
+	switch state {
+	case HealthUnknown:
+		return "unknown"
+	case HealthHealthy:
+		return "healthy"
+	case HealthUnhealthy:
+		return "unhealthy"
+	default:
+		return "invalid"
+	}
+}
+
+// HealthStatus is maintained by domainmgr and passed on by zedmanager
+type HealthStatus struct {
+	State               HealthState
+	StateTime           time.Time // When State last changed
+	LastProbeError      string
+	ConsecutiveFailures uint32
+	RestartCount        uint32 // Total restarts due to the policy
+	Attempts            uint32 // Consecutive restarts for the backoff
+	LastRestartTime     time.Time
+	LastRestartReason   string
+	PendingRestart      bool
+	NextRestartTime     time.Time
+}
+
+// RestartBackoff returns the delay before the next restart given the
+// number of consecutive restarts, capped at max
+func (policy RestartPolicy) RestartBackoff(attempts uint32,
+	max time.Duration) time.Duration {
+
+	backoff := time.Duration(policy.Backoff) * time.Second
+	if backoff == 0 {
+		backoff = DefaultRestartBackoff * time.Second
+	}
+	for i := uint32(0); i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
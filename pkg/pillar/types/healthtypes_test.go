@@ -0,0 +1,54 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+type TestRestartBackoffMatrix struct {
+	policy   RestartPolicy
+	attempts uint32
+	expected time.Duration
+}
+
+func TestRestartBackoff(t *testing.T) {
+	max := 600 * time.Second
+	testMatrix := map[string]TestRestartBackoffMatrix{
+		"Default first": {
+			policy:   RestartPolicy{Mode: RestartAlways},
+			attempts: 0,
+			expected: 10 * time.Second,
+		},
+		"Default third": {
+			policy:   RestartPolicy{Mode: RestartAlways},
+			attempts: 2,
+			expected: 40 * time.Second,
+		},
+		"Configured": {
+			policy:   RestartPolicy{Mode: RestartOnFailure, Backoff: 30},
+			attempts: 1,
+			expected: 60 * time.Second,
+		},
+		"Capped": {
+			policy:   RestartPolicy{Mode: RestartOnFailure, Backoff: 30},
+			attempts: 10,
+			expected: max,
+		},
+		"Many attempts": {
+			policy:   RestartPolicy{Mode: RestartOnFailure, Backoff: 1},
+			attempts: 1000,
+			expected: max,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		actual := test.policy.RestartBackoff(test.attempts, max)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, actual)
+		}
+	}
+}
@@ -48,3 +48,72 @@ func DeriveLedCounter(ledCounter, usableAddressCount int) int {
 		return ledCounter
 	}
 }
+
+// LedState is the state of the device as shown using the LEDs
+type LedState uint8
+
+// In decreasing priority; the highest priority state which applies is shown
+const (
+	LedStateUnknown LedState = iota
+	// The device is downloading or testing a new image
+	LedStateUpdateInProgress
+	// No usable IP addresses
+	LedStateNoNetwork
+	// Addresses but no response from the controller
+	LedStateCloudUnreachable
+	// The controller responds but the device is not onboarded or has
+	// no config yet
+	LedStateOnboarding
+	// Onboarding failed e.g., the certificate is already in use
+	LedStateOnboardingFailed
+	// Some app instance has an error
+	LedStateAppFailure
+	// Config received from the controller and no errors
+	LedStateOnline
+)
+
+func (state LedState) String() string {
+	switch state {
+	case LedStateUpdateInProgress:
+		return "update-in-progress"
+	case LedStateNoNetwork:
+		return "no-network"
+	case LedStateCloudUnreachable:
+		return "cloud-unreachable"
+	case LedStateOnboarding:
+		return "onboarding"
+	case LedStateOnboardingFailed:
+		return "onboarding-failed"
+	case LedStateAppFailure:
+		return "app-failure"
+	case LedStateOnline:
+		return "online"
+	default:
+		return "unknown"
+	}
+}
+
+// DeriveLedState determines the state from the derived LED counter (see
+// DeriveLedCounter) and whether an update is in progress or some app
+// instance has failed.
+func DeriveLedState(derivedLedCounter int, updateInProgress bool,
+	appFailure bool) LedState {
+
+	if updateInProgress {
+		return LedStateUpdateInProgress
+	}
+	switch derivedLedCounter {
+	case 1, 11:
+		return LedStateNoNetwork
+	case 2, 12, 13:
+		return LedStateCloudUnreachable
+	case 3:
+		return LedStateOnboarding
+	case 10:
+		return LedStateOnboardingFailed
+	}
+	if appFailure {
+		return LedStateAppFailure
+	}
+	return LedStateOnline
+}
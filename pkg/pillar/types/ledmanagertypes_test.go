@@ -0,0 +1,67 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+)
+
+type TestDeriveLedStateMatrix struct {
+	ledCounter         int
+	usableAddressCount int
+	updateInProgress   bool
+	appFailure         bool
+	expectedState      LedState
+}
+
+func TestDeriveLedState(t *testing.T) {
+	testMatrix := map[string]TestDeriveLedStateMatrix{
+		"No addresses": {
+			ledCounter:    4,
+			expectedState: LedStateNoNetwork,
+		},
+		"No controller": {
+			ledCounter:         1,
+			usableAddressCount: 1,
+			expectedState:      LedStateCloudUnreachable,
+		},
+		"Not onboarded": {
+			ledCounter:         3,
+			usableAddressCount: 1,
+			appFailure:         true,
+			expectedState:      LedStateOnboarding,
+		},
+		"Onboarding failed": {
+			ledCounter:         10,
+			usableAddressCount: 1,
+			expectedState:      LedStateOnboardingFailed,
+		},
+		"Update": {
+			ledCounter:       1,
+			updateInProgress: true,
+			expectedState:    LedStateUpdateInProgress,
+		},
+		"App failure": {
+			ledCounter:         4,
+			usableAddressCount: 1,
+			appFailure:         true,
+			expectedState:      LedStateAppFailure,
+		},
+		"Online": {
+			ledCounter:         4,
+			usableAddressCount: 2,
+			expectedState:      LedStateOnline,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		counter := DeriveLedCounter(test.ledCounter, test.usableAddressCount)
+		state := DeriveLedState(counter, test.updateInProgress,
+			test.appFailure)
+		if state != test.expectedState {
+			t.Errorf("Test %s: expected %s got %s", testname,
+				test.expectedState, state)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+)
+
+// RemoteSessionType is what a remote session connects to
+type RemoteSessionType uint8
+
+const (
+	RemoteSessionUnspecified RemoteSessionType = iota
+	// RemoteSessionShell is an interactive shell on a pty
+	RemoteSessionShell
+	// RemoteSessionPortForward is a TCP connection to a port on localhost
+	RemoteSessionPortForward
+	// RemoteSessionFileTransfer is an SFTP server
+	RemoteSessionFileTransfer
+	// RemoteSessionAppConsole is the serial console of an app instance
+	RemoteSessionAppConsole
+)
+
+func (t RemoteSessionType) String() string {
+	switch t {
+	case RemoteSessionShell:
+		return "shell"
+	case RemoteSessionPortForward:
+		return "port-forward"
+	case RemoteSessionFileTransfer:
+		return "file-transfer"
+	case RemoteSessionAppConsole:
+		return "app-console"
+	default:
+		return "unspecified"
+	}
+}
+
+// RemoteSessionConfig is a session to the device itself which the
+// controller has authorized for a user. Published by zedagent and
+// handled by wstunnelclient.
+type RemoteSessionConfig struct {
+	ID       string
+	User     string
+	Type     RemoteSessionType
+	Port     uint16 // For RemoteSessionPortForward
+	Expires  time.Time
+	ReadOnly bool // For RemoteSessionFileTransfer
+	// UUID of the app instance for RemoteSessionAppConsole
+	AppInstance string
+}
+
+// Key is the session id
+func (config RemoteSessionConfig) Key() string {
+	return config.ID
+}
+
+// Expired returns true if the session may no longer be used
+func (config RemoteSessionConfig) Expired() bool {
+	return !time.Now().Before(config.Expires)
+}
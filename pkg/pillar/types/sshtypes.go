@@ -0,0 +1,57 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// SshRole determines what a user logged in using ssh can do
+type SshRole uint8
+
+const (
+	SshRoleNone SshRole = iota
+	// SshRoleDebug can run an allowlist of read-only commands
+	SshRoleDebug
+	// SshRoleRoot can run any command including interactive shells
+	SshRoleRoot
+)
+
+func (role SshRole) String() string {
+	switch role {
+	case SshRoleDebug:
+		return "debug"
+	case SshRoleRoot:
+		return "root"
+	default:
+		return "none"
+	}
+}
+
+// SshPrincipal maps a certificate principal to a role
+type SshPrincipal struct {
+	Name string
+	Role SshRole
+}
+
+// SshCAConfig is the SSH user CA configuration from the controller.
+// Published by zedagent and applied by nim.
+type SshCAConfig struct {
+	UserCAKeys        []string // authorized_keys format
+	Principals        []SshPrincipal
+	RevokedPrincipals []string
+	RevokedKeys       []string // Public keys or SHA256 fingerprints
+	RevokedSerials    []uint64 // Of certificates signed by a UserCAKey
+}
+
+// Key is always "global"
+func (config SshCAConfig) Key() string {
+	return "global"
+}
+
+// IsRevoked returns true if the principal must not be accepted
+func (config SshCAConfig) IsRevoked(name string) bool {
+	for _, revoked := range config.RevokedPrincipals {
+		if revoked == name {
+			return true
+		}
+	}
+	return false
+}
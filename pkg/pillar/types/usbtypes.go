@@ -0,0 +1,80 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Assignment of individual USB devices to app instances. Unlike the IoUSB
+// IoBundles, which pass the whole USB controller to the domU, the USB
+// controller stays in dom0 and each matching device is attached to the
+// domU using xl usbdev-attach when it is plugged in.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/satori/go.uuid"
+)
+
+// UsbDevice is a USB device found in /sys/bus/usb/devices
+type UsbDevice struct {
+	Busnum    int
+	Devnum    int
+	PortPath  string // E.g., "1-1.2"
+	VendorID  string // Four hex digits
+	ProductID string // Four hex digits
+	Serial    string
+	Product   string
+	// Only set in AssignableAdapters
+	UsedByUUID uuid.UUID
+}
+
+func (dev UsbDevice) String() string {
+	return fmt.Sprintf("%s:%s at %s (%d.%d)", dev.VendorID, dev.ProductID,
+		dev.PortPath, dev.Busnum, dev.Devnum)
+}
+
+// UsbDeviceMatch specifies which USB device to assign to an app instance.
+// All of the fields which are set must match.
+type UsbDeviceMatch struct {
+	Name      string // From the Adapter in the config
+	VendorID  string
+	ProductID string
+	Serial    string
+	PortPath  string
+}
+
+// IsEmpty is true if nothing is specified hence nothing would match
+func (m UsbDeviceMatch) IsEmpty() bool {
+	return m.VendorID == "" && m.ProductID == "" && m.Serial == "" &&
+		m.PortPath == ""
+}
+
+// Matches returns true if all of the specified fields match the device
+func (m UsbDeviceMatch) Matches(dev UsbDevice) bool {
+	if m.IsEmpty() {
+		return false
+	}
+	if m.VendorID != "" && !strings.EqualFold(m.VendorID, dev.VendorID) {
+		return false
+	}
+	if m.ProductID != "" && !strings.EqualFold(m.ProductID, dev.ProductID) {
+		return false
+	}
+	if m.Serial != "" && m.Serial != dev.Serial {
+		return false
+	}
+	if m.PortPath != "" && m.PortPath != dev.PortPath {
+		return false
+	}
+	return true
+}
+
+// UsbDeviceStatus is the state of one UsbDeviceMatch for a domain
+type UsbDeviceStatus struct {
+	Match    UsbDeviceMatch
+	Present  bool      // A matching device is plugged in
+	Attached bool      // Attached to the domU
+	Device   UsbDevice // The matching device if Present
+	Port     int       // Port on the domU USB controller; 1-based
+	Error    string    // Why it is not attached
+}
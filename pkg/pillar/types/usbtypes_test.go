@@ -0,0 +1,62 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+)
+
+type TestUsbDeviceMatchMatrix struct {
+	match    UsbDeviceMatch
+	expected bool
+}
+
+func TestUsbDeviceMatch(t *testing.T) {
+	dev := UsbDevice{
+		Busnum:    1,
+		Devnum:    5,
+		PortPath:  "1-1.2",
+		VendorID:  "0403",
+		ProductID: "600a",
+		Serial:    "A50285BI",
+	}
+	testMatrix := map[string]TestUsbDeviceMatchMatrix{
+		"Empty": {
+			match:    UsbDeviceMatch{},
+			expected: false,
+		},
+		"Vendor and product": {
+			match:    UsbDeviceMatch{VendorID: "0403", ProductID: "600a"},
+			expected: true,
+		},
+		"Upper case hex": {
+			match:    UsbDeviceMatch{VendorID: "0403", ProductID: "600A", Serial: "A50285BI"},
+			expected: true,
+		},
+		"Wrong product": {
+			match:    UsbDeviceMatch{VendorID: "0403", ProductID: "6010"},
+			expected: false,
+		},
+		"Port path": {
+			match:    UsbDeviceMatch{PortPath: "1-1.2"},
+			expected: true,
+		},
+		"Other port": {
+			match:    UsbDeviceMatch{VendorID: "0403", PortPath: "1-1.3"},
+			expected: false,
+		},
+		"Wrong serial": {
+			match:    UsbDeviceMatch{Serial: "a50285bi"},
+			expected: false,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		actual := test.match.Matches(dev)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, actual)
+		}
+	}
+}
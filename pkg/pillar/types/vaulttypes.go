@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// VaultState is the state of an encrypted directory
+type VaultState uint8
+
+const (
+	VaultStateUnknown VaultState = iota
+	// VaultStateUnlocked means the key was retrieved and the data is
+	// accessible
+	VaultStateUnlocked
+	// VaultStateLocked means the key could not be retrieved, e.g., since
+	// the PCRs changed, hence EVE runs with empty unencrypted directories
+	VaultStateLocked
+	// VaultStateRecovered means the controller returned the escrowed key
+	// and it was resealed; a reboot unlocks the vault
+	VaultStateRecovered
+	// VaultStateError means encryption could not be set up, e.g., since
+	// the kernel or filesystem lacks support, hence data is unencrypted
+	VaultStateError
+)
+
+func (state VaultState) String() string {
+	switch state {
+	case VaultStateUnknown:
+		return "Unknown"
+	case VaultStateUnlocked:
+		return "Unlocked"
+	case VaultStateLocked:
+		return "Locked"
+	case VaultStateRecovered:
+		return "Recovered"
+	case VaultStateError:
+		return "Error"
+	default:
+		return fmt.Sprintf("Unknown VaultState %d", state)
+	}
+}
+
+// VaultStatus is published by tpmmgr
+type VaultStatus struct {
+	Name          string // E.g., "vault"
+	State         VaultState
+	KeyProvider   string // "tpm" or "file"
+	PCRs          []int  // The PCRs the key is sealed to, if any
+	KeyDescriptor string // fscrypt key descriptor in hex
+	Escrowed      bool   // The key has been sent to the controller
+	Error         string
+	ErrorTime     time.Time
+}
+
+func (status VaultStatus) Key() string {
+	return status.Name
+}
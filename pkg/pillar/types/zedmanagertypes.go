@@ -59,10 +59,64 @@ type AppInstanceConfig struct {
 	OverlayNetworkList  []EIDOverlayConfig
 	UnderlayNetworkList []UnderlayNetworkConfig
 	IoAdapterList       []IoAdapter
+	UsbDeviceList       []UsbDeviceMatch // Individual USB devices
 	RestartCmd          AppInstanceOpsCmd
 	PurgeCmd            AppInstanceOpsCmd
 	CloudInitUserData   string // base64-encoded
 	RemoteConsole       bool
+	RestartPolicy       RestartPolicy
+	HealthProbe         HealthProbe
+	MetaDataType        MetaDataType
+	MetaData            map[string]string // For the metadata service
+	Dependencies        []AppDependency
+	BootGroup           uint32 // Zero means no boot group
+	BootDelay           uint32 // Seconds after the lower boot groups started
+}
+
+// DependencyCondition is what an app instance waits for in the app
+// instance it depends on
+type DependencyCondition uint8
+
+const (
+	DependencyRunning DependencyCondition = iota // Default
+	DependencyHealthy                            // Needs a health probe
+)
+
+func (cond DependencyCondition) String() string {
+	switch cond {
+	case DependencyRunning:
+		return "running"
+	case DependencyHealthy:
+		return "healthy"
+	default:
+		return "invalid"
+	}
+}
+
+// AppDependency from the AppInstanceConfig
+type AppDependency struct {
+	UUID      uuid.UUID
+	Condition DependencyCondition
+}
+
+// MetaDataType determines how the app instance gets the cloud-init user
+// data and the metadata
+type MetaDataType uint8
+
+const (
+	MetaDataDriveAndService MetaDataType = iota // Default
+	MetaDataService                             // On Local network instances
+	MetaDataDrive                               // The cidata disk
+)
+
+// WantDrive returns true if domainmgr should attach a cidata disk
+func (t MetaDataType) WantDrive() bool {
+	return t != MetaDataService
+}
+
+// WantService returns true if zedrouter should serve the metadata
+func (t MetaDataType) WantService() bool {
+	return t != MetaDataDrive
 }
 
 type AppInstanceOpsCmd struct {
@@ -106,10 +160,18 @@ type AppInstanceStatus struct {
 	UnderlayNetworkList []UnderlayNetworkConfig
 	BootTime            time.Time
 	IoAdapterList       []IoAdapter
+	UsbDeviceList       []UsbDeviceMatch
 	RestartCmd          AppInstanceOpsCmd
 	PurgeCmd            AppInstanceOpsCmd
 	RestartInprogress   Inprogress
+	Health              HealthStatus // From domainmgr
 	PurgeInprogress     Inprogress
+	// Copies of config to halt in order also once the config is deleted
+	Dependencies []AppDependency
+	BootGroup    uint32
+	// Why it is not started or halted yet. Empty if not waiting.
+	WaitingFor   string
+	WaitingSince time.Time
 	// Mininum state across all steps and all StorageStatus.
 	// Error* set implies error.
 	State            SwState
@@ -135,6 +197,17 @@ func (status AppInstanceStatus) Key() string {
 	return status.UUIDandVersion.UUID.String()
 }
 
+// DependsOn returns true if status has to be started after and halted
+// before other, that is it depends on other or is in a higher boot group
+func (status AppInstanceStatus) DependsOn(other AppInstanceStatus) bool {
+	for _, dep := range status.Dependencies {
+		if uuid.Equal(dep.UUID, other.UUIDandVersion.UUID) {
+			return true
+		}
+	}
+	return other.BootGroup != 0 && status.BootGroup > other.BootGroup
+}
+
 func (status AppInstanceStatus) VerifyFilename(fileName string) bool {
 	expect := status.Key() + ".json"
 	ret := expect == fileName
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type TestDependsOnMatrix struct {
+	status   AppInstanceStatus
+	other    AppInstanceStatus
+	expected bool
+}
+
+func TestDependsOn(t *testing.T) {
+	db := AppInstanceStatus{
+		UUIDandVersion: UUIDandVersion{UUID: uuid.FromStringOrNil("4a144db0-6b63-405a-b884-7760042023b1")},
+	}
+	api := AppInstanceStatus{
+		UUIDandVersion: UUIDandVersion{UUID: uuid.FromStringOrNil("8f3b3c1e-2a5d-4c1f-9e62-0d4b7a1c5e21")},
+		Dependencies: []AppDependency{
+			{UUID: db.UUIDandVersion.UUID, Condition: DependencyHealthy},
+		},
+	}
+	firewall := AppInstanceStatus{
+		UUIDandVersion: UUIDandVersion{UUID: uuid.FromStringOrNil("c2a1f5d4-7b3e-4e8a-a6f0-3d9c1b2e4f57")},
+		BootGroup:      1,
+	}
+	behindFirewall := AppInstanceStatus{
+		UUIDandVersion: UUIDandVersion{UUID: uuid.FromStringOrNil("e7d6c5b4-a3f2-4e1d-8c0b-9a8f7e6d5c43")},
+		BootGroup:      2,
+	}
+	testMatrix := map[string]TestDependsOnMatrix{
+		"Dependency": {
+			status:   api,
+			other:    db,
+			expected: true,
+		},
+		"Reverse dependency": {
+			status:   db,
+			other:    api,
+			expected: false,
+		},
+		"Higher boot group": {
+			status:   behindFirewall,
+			other:    firewall,
+			expected: true,
+		},
+		"Lower boot group": {
+			status:   firewall,
+			other:    behindFirewall,
+			expected: false,
+		},
+		"No boot group": {
+			status:   behindFirewall,
+			other:    db,
+			expected: false,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		actual := test.status.DependsOn(test.other)
+		if actual != test.expected {
+			t.Errorf("Test Failed: %s: Expected %v, Actual: %v\n",
+				testname, test.expected, actual)
+		}
+	}
+}
@@ -30,6 +30,11 @@ type AppNetworkConfig struct {
 	LegacyDataPlane     bool
 	OverlayNetworkList  []OverlayNetworkConfig
 	UnderlayNetworkList []UnderlayNetworkConfig
+
+	// For the metadata service on Local network instances
+	MetaDataType      MetaDataType
+	CloudInitUserData string // base64-encoded
+	MetaData          map[string]string
 }
 
 func (config AppNetworkConfig) Key() string {
@@ -159,6 +164,9 @@ type DevicePortConfig struct {
 	LastSucceeded time.Time
 	LastError     string // Set when LastFailed is updated
 
+	// Per port results from the last connectivity test
+	TestResults []PortTestResult
+
 	Ports []NetworkPortConfig
 }
 
@@ -171,6 +179,102 @@ const (
 	DPCIsMgmt                          // Require IsMgmt to be set for management ports
 )
 
+// ConnectivityStage is a step in testing that a port can reach the
+// controller. The stages are run in this order, skipping the Proxy
+// stage if there is no proxy.
+// Values match zmet.ZConnectivityStage
+type ConnectivityStage uint8
+
+const (
+	StageUnknown ConnectivityStage = iota
+	StageCarrier
+	StageAddress
+	StageDefaultRoute
+	StageProxy // PAC or proxy lookup
+	StageDNS   // Of the controller or the proxy
+	StageTCPConnect
+	StageTLS
+	StageHTTP
+)
+
+func (stage ConnectivityStage) String() string {
+	switch stage {
+	case StageCarrier:
+		return "carrier"
+	case StageAddress:
+		return "address"
+	case StageDefaultRoute:
+		return "default-route"
+	case StageProxy:
+		return "proxy"
+	case StageDNS:
+		return "dns"
+	case StageTCPConnect:
+		return "tcp-connect"
+	case StageTLS:
+		return "tls"
+	case StageHTTP:
+		return "http"
+	default:
+		return fmt.Sprintf("Unknown stage %d", stage)
+	}
+}
+
+// ConnectivityError categorizes why a stage failed.
+// Values match zmet.ZConnectivityError
+type ConnectivityError uint8
+
+const (
+	ConnErrNone ConnectivityError = iota
+	ConnErrNoCarrier
+	ConnErrNoAddress
+	ConnErrNoRoute
+	ConnErrProxy
+	ConnErrDNSNotFound
+	ConnErrDNSFailure
+	ConnErrRefused
+	ConnErrUnreachable
+	ConnErrTimeout
+	ConnErrCertUnknownAuthority
+	ConnErrCertHostname
+	ConnErrCertInvalid // E.g., expired
+	ConnErrTLS
+	ConnErrHTTPStatus
+	ConnErrOther ConnectivityError = 255
+)
+
+// StageResult has ErrorCode set to ConnErrNone if the stage succeeded
+type StageResult struct {
+	Stage     ConnectivityStage
+	Duration  time.Duration
+	ErrorCode ConnectivityError
+	Error     string
+}
+
+// PortTestResult has the stages run for a port up to and including the
+// first one which failed
+type PortTestResult struct {
+	IfName   string
+	TestTime time.Time
+	Stages   []StageResult
+}
+
+// Succeeded returns true if all stages passed
+func (result PortTestResult) Succeeded() bool {
+	n := len(result.Stages)
+	return n != 0 && result.Stages[n-1].Stage == StageHTTP &&
+		result.Stages[n-1].ErrorCode == ConnErrNone
+}
+
+// FailedStage returns the stage which failed, if any
+func (result PortTestResult) FailedStage() *StageResult {
+	n := len(result.Stages)
+	if n == 0 || result.Stages[n-1].ErrorCode == ConnErrNone {
+		return nil
+	}
+	return &result.Stages[n-1]
+}
+
 func (portConfig *DevicePortConfig) DoSanitize(
 	sanitizeTimePriority bool,
 	sanitizeKey bool, key string,
@@ -286,24 +390,152 @@ type DhcpConfig struct {
 }
 
 type NetworkPortConfig struct {
-	IfName string
-	Name   string // New logical name set by controller/model
-	IsMgmt bool   // Used to talk to controller
-	Free   bool   // Higher priority to talk to controller since no cost
+	IfName   string
+	Name     string // New logical name set by controller/model
+	IsMgmt   bool   // Used to talk to controller
+	Free     bool   // Higher priority to talk to controller since no cost
+	Vlan     VlanConfig
+	Wifi     []WifiConfig    // Set for a WiFi port
+	Cellular *CellularConfig // Set for a cellular modem port
 	DhcpConfig
 	ProxyConfig
 }
 
+// IsVlan returns true if the port is a VLAN sub-interface
+func (port NetworkPortConfig) IsVlan() bool {
+	return port.Vlan.VlanID != 0
+}
+
+// IsWifi returns true if the port is a WiFi port
+func (port NetworkPortConfig) IsWifi() bool {
+	return len(port.Wifi) != 0
+}
+
+// IsCellular returns true if the port is a cellular modem
+func (port NetworkPortConfig) IsCellular() bool {
+	return port.Cellular != nil
+}
+
+// VlanConfig is set for an 802.1Q VLAN sub-interface of a physical port.
+// The IfName of the port is the name of the sub-interface e.g., eth0.100
+type VlanConfig struct {
+	ParentIfName string
+	VlanID       uint16 // 1-4094; zero if not a VLAN
+}
+
 type NetworkPortStatus struct {
-	IfName string
-	Name   string // New logical name set by controller/model
-	IsMgmt bool   // Used to talk to controller
-	Free   bool
+	IfName   string
+	Name     string // New logical name set by controller/model
+	IsMgmt   bool   // Used to talk to controller
+	Free     bool
+	Vlan     VlanConfig
+	Wifi     *WifiStatus     // Set for a WiFi port
+	Cellular *CellularStatus // Set for a cellular modem port
 	NetworkXObjectConfig
 	AddrInfoList []AddrInfo
 	ProxyConfig
-	Error     string
-	ErrorTime time.Time
+	DelegatedPrefix net.IPNet // From DHCPv6 prefix delegation
+	Error           string
+	ErrorTime       time.Time
+}
+
+// WifiKeyScheme is the authentication used for an SSID.
+// Values match zconfig.WiFiKeyScheme
+type WifiKeyScheme uint8
+
+const (
+	WifiKeySchemeNone   WifiKeyScheme = iota // Open network
+	WifiKeySchemeWpaPsk                      // WPA2 with a passphrase
+	WifiKeySchemeWpaEap                      // WPA2-Enterprise with PEAP
+)
+
+// WifiConfig is one of the SSIDs a WiFi port can associate with
+type WifiConfig struct {
+	SSID      string
+	KeyScheme WifiKeyScheme
+	Identity  string // For WifiKeySchemeWpaEap
+	Password  string
+	Priority  int32 // Higher is preferred
+	// For WifiKeySchemeWpaEap the server certificate is verified using
+	// the CA certificate in PEM format and/or the suffix of its name.
+	// At least one is required.
+	CACert     string
+	ServerName string
+}
+
+// WifiStatus is what wpa_supplicant reports for a WiFi port.
+// The fields are empty if wpa_supplicant is not running.
+type WifiStatus struct {
+	WpaState  string // E.g., SCANNING, COMPLETED
+	SSID      string
+	BSSID     string
+	SignalDbm int32
+	Frequency uint32 // In MHz
+}
+
+// Associated returns true if the port is associated and authenticated
+func (ws WifiStatus) Associated() bool {
+	return ws.WpaState == "COMPLETED"
+}
+
+// CellularAuthProtocol values match zconfig.CellularAuthProtocol
+type CellularAuthProtocol uint8
+
+const (
+	CellularAuthNone CellularAuthProtocol = iota
+	CellularAuthPAP
+	CellularAuthCHAP
+	CellularAuthPAPandCHAP
+)
+
+// Radio access technologies using the names the wwan container uses
+const (
+	CellularRATGSM  = "gsm"
+	CellularRATUMTS = "umts"
+	CellularRATLTE  = "lte"
+)
+
+// CellularConfig is how the modem connects
+type CellularConfig struct {
+	APN            string // Empty to use the default of the modem
+	PIN            string // Set if the SIM is locked
+	AuthProtocol   CellularAuthProtocol
+	Username       string
+	Password       string
+	RoamingAllowed bool
+	PreferredRATs  []string // Empty for any
+}
+
+// CellularStatus is what the modem reports for a cellular port.
+// The signal strength is reported as a metric; see CellularSignal
+type CellularStatus struct {
+	Connected       bool   // Data connection is up
+	Registration    string // E.g., registered, searching
+	Operator        string
+	MCC             uint32
+	MNC             uint32
+	Roaming         bool
+	RAT             string // In use
+	VisibleNetworks []CellularNetwork
+}
+
+// CellularNetwork is one of the networks found in the last scan
+type CellularNetwork struct {
+	MCC         uint32
+	MNC         uint32
+	Description string
+	Status      []string // E.g., current_serving, roaming
+}
+
+// CellularSignal depends on the radio access technology hence not all
+// fields are set
+type CellularSignal struct {
+	RAT  string
+	RSSI int32   // In dBm
+	RSRQ int32   // In dB
+	RSRP int32   // In dBm
+	SNR  float32 // In dB
+	ECIO float32 // In dB
 }
 
 type AddrInfo struct {
@@ -754,18 +986,23 @@ func (portConfig *DevicePortConfig) IsAnyPortInPciBack(
 	log.Infof("IsAnyPortInPciBack: aa init %t, %d bundles, %d ports",
 		aa.Initialized, len(aa.IoBundleList), len(portConfig.Ports))
 	for _, port := range portConfig.Ports {
+		// A VLAN sub-interface needs its parent
+		ifname := port.IfName
+		if port.IsVlan() {
+			ifname = port.Vlan.ParentIfName
+		}
 		ioBundle := aa.LookupIoBundleForMember(
-			IoEth, port.IfName)
+			IoEth, ifname)
 		if ioBundle == nil {
 			// It is not guaranteed that all Ports are part of Assignable Adapters
 			// If not found, the adaptor is not capable of being assigned at
 			// PCI level. So it cannot be in PCI back.
 			log.Infof("IsAnyPortInPciBack: ifname %s not found",
-				port.IfName)
+				ifname)
 			continue
 		}
 		if ioBundle.IsPCIBack {
-			return true, port.IfName, ioBundle.UsedByUUID
+			return true, ifname, ioBundle.UsedByUUID
 		}
 	}
 	return false, "", uuid.UUID{}
@@ -867,6 +1104,41 @@ type UnderlayNetworkConfig struct {
 	Error   string
 	Network uuid.UUID // Points to a NetworkInstance.
 	ACLs    []ACE
+	Qos     AppQos
+	// VLANs of a Switch network instance with a VlanTrunk which are
+	// carried tagged on the VIF
+	VlanTrunk []uint16
+
+	// Set by zedmanager to the port of the TCP or HTTP health probe
+	// from domainmgr, to which zedrouter allows the replies
+	ProbePort uint16
+}
+
+// QosPriority of the traffic from an app relative to other apps
+// The values here should be same as the ones defined in zconfig.ZQosPriority
+type QosPriority int32
+
+const (
+	QosPriorityNormal QosPriority = 0
+	QosPriorityHigh   QosPriority = 1 // Minimize delay
+	QosPriorityLow    QosPriority = 2 // Bulk transfers
+)
+
+// AppQos has the bandwidth limits for an app interface. Rates are in
+// kbit/s and bursts in kbytes; zero means no limit.
+// Egress is traffic sent by the app and ingress is traffic sent to the app.
+type AppQos struct {
+	EgressRate   uint32
+	EgressBurst  uint32
+	IngressRate  uint32
+	IngressBurst uint32
+	Priority     QosPriority
+}
+
+// IsSet returns true if there is anything to apply
+func (qos AppQos) IsSet() bool {
+	return qos.EgressRate != 0 || qos.IngressRate != 0 ||
+		qos.Priority != QosPriorityNormal
 }
 
 type UnderlayNetworkStatus struct {
@@ -876,6 +1148,9 @@ type UnderlayNetworkStatus struct {
 	BridgeIPAddr   string // The address for DNS/DHCP service in zedrouter
 	AssignedIPAddr string // Assigned to domU
 	HostName       string
+	// Set if the network instance has IPv6 in addition to IPv4
+	BridgeIPv6Addr   string
+	AssignedIPv6Addr string
 }
 
 type NetworkType uint8
@@ -929,6 +1204,13 @@ type NetworkInstanceInfo struct {
 	// Collection of address assignments; from MAC address to IP address
 	IPAssignments map[string]net.IP
 
+	// IPv6 for a Local network instance with an IPv6Mode. The subnet
+	// is the configured Subnet6 or one derived by zedrouter, and the
+	// assignments are the SLAAC addresses from MAC address
+	BridgeIPv6Addr  string
+	IPv6Subnet      net.IPNet
+	IPv6Assignments map[string]net.IP
+
 	// Union of all ipsets fed to dnsmasq for the linux bridge
 	BridgeIPSets []string
 
@@ -949,6 +1231,22 @@ type NetworkInstanceInfo struct {
 	// to this bridge and their statistics.
 	// We add statistics from all vifs while reporting to cloud.
 	VifMetricMap map[string]NetworkMetric
+
+	// State of each port in IfNameList as used by the UplinkPolicy
+	Uplinks []UplinkStatus
+}
+
+// UplinkStatus is the state of one of the ports used by a Local network
+// instance
+type UplinkStatus struct {
+	IfName     string
+	Name       string // From DeviceNetworkStatus
+	Free       bool
+	Up         bool // Has a default route and passes the probe
+	Active     bool // Used for the traffic from the applications
+	Weight     uint32
+	Failures   uint32 // Consecutive probe failures
+	LastChange time.Time
 }
 
 func (instanceInfo *NetworkInstanceInfo) IsVifInBridge(
@@ -1014,6 +1312,8 @@ type NetworkInstanceMetrics struct {
 	NetworkMetrics NetworkMetrics
 	VpnMetrics     *VpnMetrics
 	LispMetrics    *LispMetrics
+	AppDnsMetrics  []AppDnsMetric
+	DnsQueries     []DnsQueryEntry // Most recent queries if LogQueries
 }
 
 func (metrics NetworkInstanceMetrics) Key() string {
@@ -1035,6 +1335,27 @@ func (nms *NetworkMetrics) LookupNetworkMetrics(ifName string) (NetworkMetric, b
 	return NetworkMetric{}, false
 }
 
+// AppDnsMetric has the DNS query counters for one application IP
+// address on a network instance
+type AppDnsMetric struct {
+	AppID   uuid.UUID
+	AppIP   net.IP
+	Queries uint64
+	Blocked uint64 // Answered NXDOMAIN due to DnsPolicy
+	Failed  uint64 // NXDOMAIN, SERVFAIL etc from upstream
+}
+
+// DnsQueryEntry is one query logged by dnsmasq
+type DnsQueryEntry struct {
+	Time     time.Time
+	AppID    uuid.UUID
+	ClientIP net.IP
+	Name     string
+	QType    string   // A, AAAA, PTR etc
+	Answers  []string // Addresses, CNAMEs or NXDOMAIN/NODATA
+	Blocked  bool
+}
+
 type NetworkMetric struct {
 	IfName              string
 	TxBytes             uint64
@@ -1045,10 +1366,39 @@ type NetworkMetric struct {
 	RxPkts              uint64
 	TxErrors            uint64
 	RxErrors            uint64
-	TxAclDrops          uint64 // For implicit deny/drop at end
-	RxAclDrops          uint64 // For implicit deny/drop at end
-	TxAclRateLimitDrops uint64 // For all rate limited rules
-	RxAclRateLimitDrops uint64 // For all rate limited rules
+	TxAclDrops          uint64       // For implicit deny/drop at end
+	RxAclDrops          uint64       // For implicit deny/drop at end
+	TxAclRateLimitDrops uint64       // For all rate limited rules
+	RxAclRateLimitDrops uint64       // For all rate limited rules
+	VlanMetrics         []VlanMetric // For VLANs on a trunk
+	QosMetric           *QosMetric   // For a vif with AppQos
+}
+
+// QosMetric has the tc counters for a vif with AppQos. Egress is traffic
+// sent by the app which is policed, and ingress is traffic sent to the
+// app which is shaped.
+type QosMetric struct {
+	EgressRate          uint32
+	IngressRate         uint32
+	EgressBytes         uint64
+	EgressPkts          uint64
+	EgressDrops         uint64
+	IngressBytes        uint64
+	IngressPkts         uint64
+	IngressDrops        uint64
+	IngressOverlimits   uint64
+	IngressBacklogBytes uint64
+	IngressBacklogPkts  uint64
+}
+
+// VlanMetric has the counters for one VLAN on a bridge port.
+// Taken from the bridge VLAN statistics of the kernel.
+type VlanMetric struct {
+	VlanID  uint16
+	TxBytes uint64
+	RxBytes uint64
+	TxPkts  uint64
+	RxPkts  uint64
 }
 
 // XXX this works but ugly as ...
@@ -1092,6 +1442,17 @@ const (
 	AddressTypeLast       AddressType = 255
 )
 
+// IPv6Mode determines how the applications on a Local network instance
+// reach IPv6 destinations.
+// The values here should be same as the ones defined in zconfig.ZNetworkIPv6Mode
+type IPv6Mode int32
+
+const (
+	IPv6ModeNone   IPv6Mode = 0
+	IPv6ModeNAT66  IPv6Mode = 1 // Masquerade behind the port
+	IPv6ModeRouted IPv6Mode = 2 // Subnet routed to us by the upstream router
+)
+
 // NetworkInstanceConfig
 //		Config Object for NetworkInstance
 // 		Extracted from the protobuf NetworkInstanceConfig
@@ -1116,6 +1477,22 @@ type NetworkInstanceConfig struct {
 	DnsServers      []net.IP // If not set we use Gateway as DNS server
 	DhcpRange       IpRange
 	DnsNameToIPList []DnsNameToIP // Used for DNS and ACL ipset
+	DnsPolicy       DnsPolicy     // Applied by dnsmasq
+
+	// IPv6 for the Application in addition to the IPv4 above.
+	// If not set zedrouter derives Subnet6 and Gateway6 from the
+	// IPv6Mode
+	IPv6Mode IPv6Mode
+	Subnet6  net.IPNet
+	Gateway6 net.IP
+
+	// VlanTrunk - For a Switch the VLAN IDs carried tagged on the port.
+	// The VIF of an app carries those in its UnderlayNetworkConfig.
+	VlanTrunk []uint16
+
+	// UplinkPolicy - For a Local network instance with a Port which
+	// resolves to multiple ports, such as "uplink"
+	UplinkPolicy UplinkPolicy
 
 	HasEncap bool // Lisp/Vpn, for adjusting pMTU
 	// For other network services - Proxy / Lisp /StrongSwan etc..
@@ -1127,6 +1504,41 @@ func (config *NetworkInstanceConfig) Key() string {
 	return config.UUID.String()
 }
 
+// DnsPolicy is the resolver policy for the dnsmasq serving a network
+// instance. A domain matches itself and all of its subdomains.
+// Extracted from the protobuf ZnetDnsPolicy
+type DnsPolicy struct {
+	LogQueries     bool     // Report individual queries in metrics
+	BlockedDomains []string // Answered with NXDOMAIN
+	AllowedDomains []string // If set, all other domains are NXDOMAIN
+}
+
+// UplinkMode determines how a Local network instance uses the ports
+// when its Port resolves to more than one port.
+// The values here should be same as the ones defined in zconfig.ZUplinkMode
+type UplinkMode int32
+
+const (
+	UplinkModeFailover UplinkMode = 0 // One port at a time
+	UplinkModeWeighted UplinkMode = 1 // Multipath route with weights
+	UplinkModeFlowHash UplinkMode = 2 // Multipath route hashed on L4 ports
+)
+
+// UplinkPolicy
+//	Extracted from the protobuf ZUplinkPolicy
+type UplinkPolicy struct {
+	Mode    UplinkMode
+	Weights map[string]uint32 // From port name or ifname; default 1
+	// If ProbeAddr is set we ping it out each port in addition to
+	// requiring a default route on the port
+	ProbeAddr     net.IP
+	ProbeInterval uint32 // In seconds
+	ProbeFailures uint32 // Consecutive failures before a port is down
+}
+
+// MaxUplinkWeight since the weight of a multipath nexthop is 8 bits
+const MaxUplinkWeight = 256
+
 func (config *NetworkInstanceConfig) IsIPv6() bool {
 	switch config.IpType {
 	case AddressTypeIPV6:
@@ -1244,6 +1656,32 @@ func (status *NetworkInstanceStatus) SetError(err error) {
 	return
 }
 
+// HasIPv6 returns true if the bridge has an IPv6 subnet for the
+// applications
+func (instanceInfo *NetworkInstanceInfo) HasIPv6() bool {
+	return instanceInfo.BridgeIPv6Addr != ""
+}
+
+// SlaacAddr returns the address an interface with the MAC address
+// configures using SLAAC with a modified EUI-64 interface identifier.
+// The subnet must be a /64.
+func SlaacAddr(subnet net.IPNet, mac net.HardwareAddr) net.IP {
+	if len(mac) != 6 || subnet.IP.To4() != nil || len(subnet.IP) != 16 {
+		return nil
+	}
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, subnet.IP.Mask(subnet.Mask))
+	ip[8] = mac[0] ^ 0x02
+	ip[9] = mac[1]
+	ip[10] = mac[2]
+	ip[11] = 0xff
+	ip[12] = 0xfe
+	ip[13] = mac[3]
+	ip[14] = mac[4]
+	ip[15] = mac[5]
+	return ip
+}
+
 // Returns true if found
 func (status *NetworkInstanceStatus) IsIpAssigned(ip net.IP) bool {
 	for _, a := range status.IPAssignments {
@@ -4,6 +4,7 @@
 package types
 
 import (
+	"net"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -43,3 +44,89 @@ func TestIsIPv6(t *testing.T) {
 	}
 	log.Infof("TestIsIPv6: DONE\n")
 }
+
+type TestSlaacAddrMatrixEntry struct {
+	subnet        string
+	mac           string
+	expectedValue string
+}
+
+func TestSlaacAddr(t *testing.T) {
+	log.Infof("TestSlaacAddr: START\n")
+
+	testMatrix := []TestSlaacAddrMatrixEntry{
+		{subnet: "2001:db8:1:2::/64", mac: "00:16:3e:00:01:05",
+			expectedValue: "2001:db8:1:2:216:3eff:fe00:105"},
+		{subnet: "fd12:3456:789a:1::/64", mac: "02:00:00:00:00:01",
+			expectedValue: "fd12:3456:789a:1:0:ff:fe00:1"},
+		{subnet: "10.1.0.0/24", mac: "00:16:3e:00:01:05",
+			expectedValue: "<nil>"},
+	}
+
+	for index := range testMatrix {
+		entry := &testMatrix[index]
+		_, subnet, _ := net.ParseCIDR(entry.subnet)
+		mac, _ := net.ParseMAC(entry.mac)
+		ip := SlaacAddr(*subnet, mac)
+		if ip.String() != entry.expectedValue {
+			t.Errorf("Test Entry Index %d Failed: Expected %s, Actual: %s\n",
+				index, entry.expectedValue, ip.String())
+		}
+	}
+	log.Infof("TestSlaacAddr: DONE\n")
+}
+
+type TestPortTestResultMatrixEntry struct {
+	stages            []StageResult
+	expectedSucceeded bool
+	expectedFailed    ConnectivityStage
+}
+
+func TestPortTestResult(t *testing.T) {
+	log.Infof("TestPortTestResult: START\n")
+
+	testMatrix := []TestPortTestResultMatrixEntry{
+		{stages: nil, expectedSucceeded: false,
+			expectedFailed: StageUnknown},
+		{stages: []StageResult{
+			{Stage: StageCarrier},
+			{Stage: StageAddress, ErrorCode: ConnErrNoAddress}},
+			expectedSucceeded: false,
+			expectedFailed:    StageAddress},
+		// Only the local stages were run
+		{stages: []StageResult{
+			{Stage: StageCarrier},
+			{Stage: StageAddress},
+			{Stage: StageDefaultRoute}},
+			expectedSucceeded: false,
+			expectedFailed:    StageUnknown},
+		{stages: []StageResult{
+			{Stage: StageCarrier},
+			{Stage: StageAddress},
+			{Stage: StageDefaultRoute},
+			{Stage: StageDNS},
+			{Stage: StageTCPConnect},
+			{Stage: StageTLS},
+			{Stage: StageHTTP}},
+			expectedSucceeded: true,
+			expectedFailed:    StageUnknown},
+	}
+
+	for index := range testMatrix {
+		entry := &testMatrix[index]
+		result := PortTestResult{IfName: "eth0", Stages: entry.stages}
+		if result.Succeeded() != entry.expectedSucceeded {
+			t.Errorf("Test Entry Index %d Failed: Expected succeeded %t\n",
+				index, entry.expectedSucceeded)
+		}
+		failed := StageUnknown
+		if f := result.FailedStage(); f != nil {
+			failed = f.Stage
+		}
+		if failed != entry.expectedFailed {
+			t.Errorf("Test Entry Index %d Failed: Expected %s, Actual: %s\n",
+				index, entry.expectedFailed, failed)
+		}
+	}
+	log.Infof("TestPortTestResult: DONE\n")
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Kernel interface for ext4 per-directory encryption (fscrypt v1
+// policies). The key is added to the session keyring which device-steps.sh
+// and all the agents in the pillar container share.
+
+package vault
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// KeySize is the size of the raw vault key
+const KeySize = unix.FS_MAX_KEY_SIZE
+
+// KeyDescriptor returns the fscrypt descriptor of key; the same
+// derivation as the fscrypt tool uses
+func KeyDescriptor(key []byte) []byte {
+	h1 := sha512.Sum512(key)
+	h2 := sha512.Sum512(h1[:])
+	return h2[:unix.FS_KEY_DESCRIPTOR_SIZE]
+}
+
+// addKey makes the key available to the kernel for encrypting and
+// decrypting the files in directories with its descriptor
+func addKey(key []byte) error {
+	if len(key) != KeySize {
+		errStr := fmt.Sprintf("addKey: key size %d instead of %d",
+			len(key), KeySize)
+		return errors.New(errStr)
+	}
+	fsKey := unix.FscryptKey{
+		Mode: unix.FS_ENCRYPTION_MODE_AES_256_XTS,
+		Size: uint32(len(key)),
+	}
+	copy(fsKey.Raw[:], key)
+	payload := (*[unsafe.Sizeof(fsKey)]byte)(unsafe.Pointer(&fsKey))[:]
+	desc := "fscrypt:" + hex.EncodeToString(KeyDescriptor(key))
+	if _, err := unix.AddKey("logon", desc, payload,
+		unix.KEY_SPEC_SESSION_KEYRING); err != nil {
+		errStr := fmt.Sprintf("addKey %s failed: %v", desc, err)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// setPolicy encrypts the empty directory dir with the key with the
+// descriptor
+func setPolicy(dir string, descriptor []byte) error {
+	policy := unix.FscryptPolicy{
+		Version:                   0,
+		Contents_encryption_mode:  unix.FS_ENCRYPTION_MODE_AES_256_XTS,
+		Filenames_encryption_mode: unix.FS_ENCRYPTION_MODE_AES_256_CTS,
+		Flags:                     unix.FS_POLICY_FLAGS_PAD_32,
+	}
+	copy(policy.Master_key_descriptor[:], descriptor)
+	if err := ioctl(dir, unix.FS_IOC_SET_ENCRYPTION_POLICY,
+		unsafe.Pointer(&policy)); err != nil {
+		errStr := fmt.Sprintf("setPolicy %s failed: %v", dir, err)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// getDescriptor returns the key descriptor of dir, or nil if dir is not
+// encrypted
+func getDescriptor(dir string) ([]byte, error) {
+	var policy unix.FscryptPolicy
+	err := ioctl(dir, unix.FS_IOC_GET_ENCRYPTION_POLICY,
+		unsafe.Pointer(&policy))
+	if err == unix.ENODATA {
+		return nil, nil
+	}
+	if os.IsNotExist(err) {
+		return nil, err
+	}
+	if err != nil {
+		errStr := fmt.Sprintf("getDescriptor %s failed: %v", dir, err)
+		return nil, errors.New(errStr)
+	}
+	return policy.Master_key_descriptor[:], nil
+}
+
+func ioctl(dir string, req uintptr, arg unsafe.Pointer) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), req, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,413 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// The vault is an encrypted directory on /persist which holds the app
+// disks, the downloaded images and the persisted pubsub state. Its
+// directories are bind mounted over the unencrypted ones at boot so that
+// the agents need not know about it. The key is stored by a KeyProvider;
+// sealed to the PCRs when there is a TPM and in a file otherwise.
+// If the key can not be retrieved the directories are replaced by empty
+// read-only ones, hence nothing is written unencrypted, until the key is
+// recovered from the controller and the device is rebooted.
+
+package vault
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"github.com/zededa/eve/pkg/pillar/wrap"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// TpmSealedKeyFile is outside of the vault since it is needed to
+	// unlock it
+	TpmSealedKeyFile = "/persist/vault.sealed"
+	// KeyFile is used when there is no TPM. This is no protection
+	// against someone with access to the disk.
+	KeyFile = "/config/vault.key"
+	// VaultName is the key of the VaultStatus
+	VaultName = "vault"
+)
+
+// Variables so that tests can use a temporary directory
+var (
+	persistDir  = "/persist"
+	vaultDir    = "/persist/vault"
+	escrowFile  = "/persist/vault.escrowed"
+	mountsFile  = "/proc/mounts"
+	vaultedDirs = []string{"img", "downloads", "status"}
+)
+
+// Variables so that tests can run without fscrypt and mount support
+var (
+	getDescriptorFunc = getDescriptor
+	addKeyFunc        = addKey
+	setPolicyFunc     = setPolicy
+	mountFunc         = unix.Mount
+)
+
+// KeyProvider stores the vault key
+type KeyProvider interface {
+	Name() string
+	HasKey() bool
+	StoreKey(key []byte) error
+	RetrieveKey() ([]byte, error)
+}
+
+// TpmKeyProvider seals the key to the TPM PCRs
+type TpmKeyProvider struct {
+	SealedFile string
+}
+
+// Name is part of KeyProvider
+func (p *TpmKeyProvider) Name() string {
+	return "tpm"
+}
+
+// HasKey is part of KeyProvider
+func (p *TpmKeyProvider) HasKey() bool {
+	_, err := os.Stat(p.SealedFile)
+	return err == nil
+}
+
+// StoreKey is part of KeyProvider and seals to the current PCR values
+func (p *TpmKeyProvider) StoreKey(key []byte) error {
+	blob, err := evetpm.SealKey(key)
+	if err != nil {
+		return err
+	}
+	return writeFileSync(p.SealedFile, blob)
+}
+
+// RetrieveKey is part of KeyProvider
+func (p *TpmKeyProvider) RetrieveKey() ([]byte, error) {
+	blob, err := ioutil.ReadFile(p.SealedFile)
+	if err != nil {
+		return nil, err
+	}
+	return evetpm.UnsealKey(blob)
+}
+
+// FileKeyProvider stores the key in a file. For devices without a TPM
+// and for testing.
+type FileKeyProvider struct {
+	KeyFile string
+}
+
+// Name is part of KeyProvider
+func (p *FileKeyProvider) Name() string {
+	return "file"
+}
+
+// HasKey is part of KeyProvider
+func (p *FileKeyProvider) HasKey() bool {
+	_, err := os.Stat(p.KeyFile)
+	return err == nil
+}
+
+// StoreKey is part of KeyProvider
+func (p *FileKeyProvider) StoreKey(key []byte) error {
+	return writeFileSync(p.KeyFile, []byte(hex.EncodeToString(key)))
+}
+
+// RetrieveKey is part of KeyProvider
+func (p *FileKeyProvider) RetrieveKey() ([]byte, error) {
+	b, err := ioutil.ReadFile(p.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		errStr := fmt.Sprintf("RetrieveKey %s: %v", p.KeyFile, err)
+		return nil, errors.New(errStr)
+	}
+	return key, nil
+}
+
+// GetKeyProvider returns the provider which has the key, and otherwise
+// the TPM if there is one
+func GetKeyProvider() KeyProvider {
+	tpmProvider := &TpmKeyProvider{SealedFile: TpmSealedKeyFile}
+	fileProvider := &FileKeyProvider{KeyFile: KeyFile}
+	switch {
+	case tpmProvider.HasKey():
+		return tpmProvider
+	case fileProvider.HasKey():
+		return fileProvider
+	case evetpm.IsTpmPresent():
+		return tpmProvider
+	default:
+		return fileProvider
+	}
+}
+
+// Setup creates or unlocks the vault and bind mounts its directories.
+// Called once per boot before the agents start.
+func Setup(provider KeyProvider) types.VaultStatus {
+	status := types.VaultStatus{
+		Name:        VaultName,
+		KeyProvider: provider.Name(),
+	}
+	if _, ok := provider.(*TpmKeyProvider); ok {
+		status.PCRs = evetpm.SealPCRs
+	}
+	key, created, state, err := unlock(provider)
+	if err != nil {
+		setError(&status, state, err)
+		// Needed to ask the controller for the key
+		descriptor, _ := getDescriptorFunc(vaultDir)
+		if descriptor == nil {
+			// No vault, e.g., no kernel support. Nothing to protect.
+			return status
+		}
+		status.KeyDescriptor = hex.EncodeToString(descriptor)
+		for _, name := range vaultedDirs {
+			if err := lockDir(name); err != nil {
+				log.Errorf("Setup: %s\n", err)
+			}
+		}
+		return status
+	}
+	descriptor := hex.EncodeToString(KeyDescriptor(key))
+	status.KeyDescriptor = descriptor
+	status.Escrowed = IsEscrowed(descriptor)
+	for _, name := range vaultedDirs {
+		if err := mountDir(name, created); err != nil {
+			setError(&status, types.VaultStateError, err)
+			return status
+		}
+	}
+	status.State = types.VaultStateUnlocked
+	log.Infof("Setup: %s unlocked using %s\n", vaultDir, provider.Name())
+	return status
+}
+
+// unlock returns the key once it has been added to the kernel, and
+// whether the vault was created. On failure the state tells whether the
+// key could not be retrieved or the vault could not be set up.
+func unlock(provider KeyProvider) ([]byte, bool, types.VaultState, error) {
+	descriptor, err := getDescriptorFunc(vaultDir)
+	if err != nil && !os.IsNotExist(err) {
+		// E.g., no kernel support
+		return nil, false, types.VaultStateError, err
+	}
+	var key []byte
+	if descriptor != nil {
+		key, err = provider.RetrieveKey()
+		if err != nil {
+			return nil, false, types.VaultStateLocked, err
+		}
+		if !bytes.Equal(KeyDescriptor(key), descriptor) {
+			errStr := fmt.Sprintf("Key from %s does not match %s",
+				provider.Name(), vaultDir)
+			return nil, false, types.VaultStateLocked, errors.New(errStr)
+		}
+		if err := addKeyFunc(key); err != nil {
+			return nil, false, types.VaultStateError, err
+		}
+		return key, false, types.VaultStateUnlocked, nil
+	}
+	// Create the vault. Reuse any key e.g., if /persist was recreated
+	if provider.HasKey() {
+		key, err = provider.RetrieveKey()
+		if err != nil {
+			log.Warnf("unlock: replacing key: %s\n", err)
+			key = nil
+		}
+	}
+	if key == nil {
+		key = make([]byte, KeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, false, types.VaultStateError, err
+		}
+		if err := provider.StoreKey(key); err != nil {
+			return nil, false, types.VaultStateError, err
+		}
+		log.Infof("unlock: created key using %s\n", provider.Name())
+	}
+	// Any leftover from a failed attempt must be empty
+	os.Remove(vaultDir)
+	if err := os.Mkdir(vaultDir, 0700); err != nil {
+		return nil, false, types.VaultStateError, err
+	}
+	if err := addKeyFunc(key); err != nil {
+		return nil, false, types.VaultStateError, err
+	}
+	if err := setPolicyFunc(vaultDir, KeyDescriptor(key)); err != nil {
+		os.Remove(vaultDir)
+		return nil, false, types.VaultStateError, err
+	}
+	log.Infof("unlock: created %s\n", vaultDir)
+	return key, true, types.VaultStateUnlocked, nil
+}
+
+// mountDir bind mounts the vault directory over the unencrypted one.
+// The existing content is moved into the vault only when it was just
+// created; anything found later was not written by this EVE and is left
+// alone rather than mixed with the vault content.
+func mountDir(name string, created bool) error {
+	dir := filepath.Join(persistDir, name)
+	vdir := filepath.Join(vaultDir, name)
+	if isMountPoint(dir) {
+		log.Infof("mountDir: %s already mounted\n", dir)
+		return nil
+	}
+	if err := os.MkdirAll(vdir, 0700); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if created {
+		if err := migrate(dir, vdir); err != nil {
+			return err
+		}
+	} else if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		log.Warnf("mountDir: ignoring %d unencrypted entries in %s\n",
+			len(files), dir)
+	}
+	if err := mountFunc(vdir, dir, "", unix.MS_BIND, ""); err != nil {
+		errStr := fmt.Sprintf("mountDir bind mount %s failed: %v",
+			dir, err)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// lockDir mounts an empty read-only tmpfs over the unencrypted directory
+// while the vault is locked, hence the agents fail to write there
+// instead of storing data in clear text
+func lockDir(name string) error {
+	dir := filepath.Join(persistDir, name)
+	if isMountPoint(dir) {
+		log.Infof("lockDir: %s already mounted\n", dir)
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err := mountFunc("tmpfs", dir, "tmpfs", unix.MS_RDONLY,
+		"mode=0500"); err != nil {
+		errStr := fmt.Sprintf("lockDir mount %s failed: %v", dir, err)
+		return errors.New(errStr)
+	}
+	log.Infof("lockDir: %s unavailable until the vault is unlocked\n",
+		dir)
+	return nil
+}
+
+// migrate copies since rename does not work into an encrypted directory
+func migrate(dir string, vdir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	log.Infof("migrate: moving %d entries from %s to %s\n",
+		len(files), dir, vdir)
+	cmd := wrap.Command("cp", "-a", dir+"/.", vdir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		errStr := fmt.Sprintf("migrate %s failed: %v: %s",
+			dir, err, out)
+		return errors.New(errStr)
+	}
+	for _, file := range files {
+		if err := os.RemoveAll(filepath.Join(dir, file.Name())); err != nil {
+			log.Errorf("migrate: %s\n", err)
+		}
+	}
+	return nil
+}
+
+func isMountPoint(dir string) bool {
+	b, err := ioutil.ReadFile(mountsFile)
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && fields[1] == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// Recover verifies a key recovered from the controller and stores it
+// using the provider, which for the TPM means sealing it to the
+// current PCR values. The vault is unlocked on the next boot.
+func Recover(provider KeyProvider, key []byte) error {
+	descriptor, err := getDescriptorFunc(vaultDir)
+	if err != nil {
+		return err
+	}
+	if descriptor == nil {
+		errStr := fmt.Sprintf("Recover: %s is not encrypted", vaultDir)
+		return errors.New(errStr)
+	}
+	if !bytes.Equal(KeyDescriptor(key), descriptor) {
+		errStr := fmt.Sprintf("Recover: key does not match %s", vaultDir)
+		return errors.New(errStr)
+	}
+	return provider.StoreKey(key)
+}
+
+// IsEscrowed returns true if the key with the descriptor has been sent
+// to the controller
+func IsEscrowed(descriptor string) bool {
+	b, err := ioutil.ReadFile(escrowFile)
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(string(b)) == descriptor
+}
+
+// SetEscrowed records that the key with the descriptor has been sent to
+// the controller
+func SetEscrowed(descriptor string) error {
+	return writeFileSync(escrowFile, []byte(descriptor))
+}
+
+func setError(status *types.VaultStatus, state types.VaultState, err error) {
+	log.Errorf("vault %s: %s\n", state, err)
+	status.State = state
+	status.Error = err.Error()
+	status.ErrorTime = time.Now()
+}
+
+// writeFileSync makes sure the key is on disk before it is used
+func writeFileSync(filename string, b []byte) error {
+	tmpfile := filename + ".tmp"
+	f, err := os.OpenFile(tmpfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+		0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpfile, filename)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/types"
+	"golang.org/x/sys/unix"
+)
+
+func TestFileKeyProvider(t *testing.T) {
+	log.Infof("TestFileKeyProvider: START\n")
+	dir, err := ioutil.TempDir("", "vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	provider := &FileKeyProvider{KeyFile: filepath.Join(dir, "vault.key")}
+	if provider.HasKey() {
+		t.Errorf("HasKey true before StoreKey")
+	}
+	if _, err := provider.RetrieveKey(); err == nil {
+		t.Errorf("RetrieveKey succeeded before StoreKey")
+	}
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.StoreKey(key); err != nil {
+		t.Fatalf("StoreKey: %s", err)
+	}
+	if !provider.HasKey() {
+		t.Errorf("HasKey false after StoreKey")
+	}
+	key2, err := provider.RetrieveKey()
+	if err != nil {
+		t.Fatalf("RetrieveKey: %s", err)
+	}
+	if !bytes.Equal(key, key2) {
+		t.Errorf("RetrieveKey returned a different key")
+	}
+	log.Infof("TestFileKeyProvider: DONE\n")
+}
+
+func TestEscrowed(t *testing.T) {
+	log.Infof("TestEscrowed: START\n")
+	dir, err := ioutil.TempDir("", "vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	savedEscrowFile := escrowFile
+	escrowFile = filepath.Join(dir, "vault.escrowed")
+	defer func() { escrowFile = savedEscrowFile }()
+
+	key := make([]byte, KeySize)
+	descriptor := hex.EncodeToString(KeyDescriptor(key))
+	if len(descriptor) != 16 {
+		t.Errorf("Unexpected descriptor length %d", len(descriptor))
+	}
+	if IsEscrowed(descriptor) {
+		t.Errorf("IsEscrowed true before SetEscrowed")
+	}
+	if err := SetEscrowed(descriptor); err != nil {
+		t.Fatalf("SetEscrowed: %s", err)
+	}
+	if !IsEscrowed(descriptor) {
+		t.Errorf("IsEscrowed false after SetEscrowed")
+	}
+	key[0] = 1
+	if IsEscrowed(hex.EncodeToString(KeyDescriptor(key))) {
+		t.Errorf("IsEscrowed true for a different key")
+	}
+	log.Infof("TestEscrowed: DONE\n")
+}
+
+// testVault replaces the fscrypt ioctls and mount with fakes which
+// record the policy and the mounts, and returns a function undoing that
+func testVault(dir string) func() {
+	saved := []string{persistDir, vaultDir, mountsFile}
+	persistDir = dir
+	vaultDir = filepath.Join(dir, "vault")
+	mountsFile = filepath.Join(dir, "mounts")
+	descriptors := make(map[string][]byte)
+	getDescriptorFunc = func(dir string) ([]byte, error) {
+		if _, err := os.Stat(dir); err != nil {
+			return nil, err
+		}
+		return descriptors[dir], nil
+	}
+	addKeyFunc = func(key []byte) error { return nil }
+	setPolicyFunc = func(dir string, descriptor []byte) error {
+		descriptors[dir] = descriptor
+		return nil
+	}
+	mountFunc = func(source string, target string, fstype string,
+		flags uintptr, data string) error {
+
+		f, err := os.OpenFile(mountsFile,
+			os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = fmt.Fprintf(f, "%s %s %s %d\n", source, target, fstype,
+			flags)
+		return err
+	}
+	return func() {
+		persistDir, vaultDir, mountsFile = saved[0], saved[1], saved[2]
+		getDescriptorFunc = getDescriptor
+		addKeyFunc = addKey
+		setPolicyFunc = setPolicy
+		mountFunc = unix.Mount
+	}
+}
+
+func readMounts(t *testing.T) string {
+	b, err := ioutil.ReadFile(mountsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Next boot
+	os.Remove(mountsFile)
+	return string(b)
+}
+
+func TestSetupLockedRecovered(t *testing.T) {
+	log.Infof("TestSetupLockedRecovered: START\n")
+	dir, err := ioutil.TempDir("", "vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer testVault(dir)()
+	img := filepath.Join(dir, "img")
+	vimg := filepath.Join(dir, "vault", "img")
+	if err := os.MkdirAll(img, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(img, "disk1"), nil,
+		0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// First boot creates the vault and moves the existing content
+	provider := &FileKeyProvider{KeyFile: filepath.Join(dir, "vault.key")}
+	status := Setup(provider)
+	if status.State != types.VaultStateUnlocked {
+		t.Fatalf("Expected unlocked, Actual: %s %s", status.State,
+			status.Error)
+	}
+	if _, err := os.Stat(filepath.Join(vimg, "disk1")); err != nil {
+		t.Errorf("Not moved into the vault: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(img, "disk1")); err == nil {
+		t.Errorf("Not removed after moving into the vault")
+	}
+	expected := fmt.Sprintf("%s %s  %d\n", vimg, img, unix.MS_BIND)
+	if mounts := readMounts(t); !bytes.Contains([]byte(mounts),
+		[]byte(expected)) {
+		t.Errorf("Expected %q in %q", expected, mounts)
+	}
+	key, err := provider.RetrieveKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The key is lost, e.g., sealed to PCRs which changed
+	os.Remove(provider.KeyFile)
+	status = Setup(provider)
+	if status.State != types.VaultStateLocked {
+		t.Fatalf("Expected locked, Actual: %s %s", status.State,
+			status.Error)
+	}
+	if status.KeyDescriptor != hex.EncodeToString(KeyDescriptor(key)) {
+		t.Errorf("Expected the descriptor of the vault, Actual: %s",
+			status.KeyDescriptor)
+	}
+	mounts := readMounts(t)
+	for _, name := range vaultedDirs {
+		expected := fmt.Sprintf("tmpfs %s tmpfs %d\n",
+			filepath.Join(dir, name), unix.MS_RDONLY)
+		if !bytes.Contains([]byte(mounts), []byte(expected)) {
+			t.Errorf("Expected %q in %q", expected, mounts)
+		}
+	}
+	// Left behind by something which wrote below the read-only mount
+	if err := ioutil.WriteFile(filepath.Join(img, "stale"), nil,
+		0600); err != nil {
+		t.Fatal(err)
+	}
+
+	wrongKey := make([]byte, KeySize)
+	if err := Recover(provider, wrongKey); err == nil {
+		t.Errorf("Recover accepted the wrong key")
+	}
+	if err := Recover(provider, key); err != nil {
+		t.Fatalf("Recover: %s", err)
+	}
+
+	// The next boot unlocks the existing vault and does not move
+	// anything into it
+	status = Setup(provider)
+	if status.State != types.VaultStateUnlocked {
+		t.Fatalf("Expected unlocked, Actual: %s %s", status.State,
+			status.Error)
+	}
+	if _, err := os.Stat(filepath.Join(vimg, "disk1")); err != nil {
+		t.Errorf("Vault content lost: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(vimg, "stale")); err == nil {
+		t.Errorf("Unencrypted content moved into the existing vault")
+	}
+	if mounts := readMounts(t); !bytes.Contains([]byte(mounts),
+		[]byte(expected)) {
+		t.Errorf("Expected %q in %q", expected, mounts)
+	}
+	log.Infof("TestSetupLockedRecovered: DONE\n")
+}
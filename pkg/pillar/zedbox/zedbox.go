@@ -18,6 +18,7 @@ import (
 	"github.com/zededa/eve/pkg/pillar/cmd/ledmanager"
 	"github.com/zededa/eve/pkg/pillar/cmd/logmanager"
 	"github.com/zededa/eve/pkg/pillar/cmd/nim"
+	"github.com/zededa/eve/pkg/pillar/cmd/sshsession"
 	"github.com/zededa/eve/pkg/pillar/cmd/tpmmgr"
 	"github.com/zededa/eve/pkg/pillar/cmd/verifier"
 	"github.com/zededa/eve/pkg/pillar/cmd/waitforaddr"
@@ -72,6 +73,8 @@ func main() {
 		conntrack.Run()
 	case "tpmmgr":
 		tpmmgr.Run()
+	case "sshsession":
+		sshsession.Run()
 	default:
 		fmt.Printf("Unknown package: %s\n", basename)
 	}
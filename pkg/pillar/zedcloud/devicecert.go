@@ -0,0 +1,246 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Renewal of the device certificate. A new key is created, in the TPM if
+// the current one is there, and a CSR for it is sent to the controller
+// together with a signature using the current key. The returned
+// certificate and the new key are installed such that a crash leaves
+// either the old or the new pair in place.
+
+package zedcloud
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
+	"github.com/zededa/eve/sdk/go/zmet"
+)
+
+const (
+	renewCertApi = "api/v1/edgedevice/register/renew"
+	// The new certificate and key are first written with this suffix
+	newSuffix = ".new"
+)
+
+// GetDeviceCert returns the parsed device certificate
+func GetDeviceCert() (*x509.Certificate, error) {
+	certPEM, err := ioutil.ReadFile(deviceCertName)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		errStr := fmt.Sprintf("GetDeviceCert: no certificate in %s",
+			deviceCertName)
+		return nil, errors.New(errStr)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// deviceKeyFileName returns the file which identifies the device key;
+// the key itself or the file with its TPM handle
+func deviceKeyFileName() string {
+	if evetpm.IsTpmEnabled() {
+		return evetpm.TpmInUseFile
+	}
+	return deviceKeyName
+}
+
+// CompleteDeviceCertInstall finishes or undoes an install which was
+// interrupted by a crash. The key file is renamed into place first hence
+// if its new file remains the old pair is still in place.
+func CompleteDeviceCertInstall() {
+	certNew := deviceCertName + newSuffix
+	if _, err := os.Stat(certNew); err != nil {
+		return
+	}
+	keyNew := deviceKeyFileName() + newSuffix
+	if _, err := os.Stat(keyNew); err == nil {
+		log.Warnf("CompleteDeviceCertInstall: discarding %s and %s\n",
+			certNew, keyNew)
+		os.Remove(certNew)
+		os.Remove(keyNew)
+		return
+	}
+	log.Warnf("CompleteDeviceCertInstall: installing %s\n", certNew)
+	if err := os.Rename(certNew, deviceCertName); err != nil {
+		log.Errorf("CompleteDeviceCertInstall: %s\n", err)
+	}
+}
+
+// RenewDeviceCert replaces the device key and certificate. The ctx must
+// use the current device certificate.
+func RenewDeviceCert(ctx ZedCloudContext, serverNameAndPort string,
+	iteration int) error {
+
+	CompleteDeviceCertInstall()
+	current, err := GetDeviceCert()
+	if err != nil {
+		return err
+	}
+	oldCert, err := GetClientCert()
+	if err != nil {
+		return err
+	}
+	oldSigner, ok := oldCert.PrivateKey.(crypto.Signer)
+	if !ok {
+		errStr := fmt.Sprintf("RenewDeviceCert: unsupported key %T",
+			oldCert.PrivateKey)
+		return errors.New(errStr)
+	}
+
+	// Create the new key
+	useTpm := evetpm.IsTpmEnabled()
+	var newSigner crypto.Signer
+	var keyFileContent []byte
+	if useTpm {
+		signer, err := evetpm.CreateNextDeviceKey()
+		if err != nil {
+			return err
+		}
+		newSigner = signer
+		keyFileContent = []byte(fmt.Sprintf("0x%x", signer.Handle()))
+	} else {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return err
+		}
+		newSigner = key
+		keyFileContent = pem.EncodeToMemory(&pem.Block{
+			Type: "EC PRIVATE KEY", Bytes: der})
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader,
+		&x509.CertificateRequest{Subject: current.Subject}, newSigner)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(csr)
+	oldKeySignature, err := oldSigner.Sign(rand.Reader, digest[:],
+		crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	req := &zmet.ZCertRenewReq{
+		PemCsr: pem.EncodeToMemory(&pem.Block{
+			Type: "CERTIFICATE REQUEST", Bytes: csr}),
+		OldKeySignature: oldKeySignature,
+	}
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	const return400 = false
+	resp, contents, err := SendOnAllIntf(ctx,
+		serverNameAndPort+"/"+renewCertApi, int64(len(b)),
+		bytes.NewBuffer(b), iteration, return400)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		errStr := fmt.Sprintf("RenewDeviceCert: statuscode %d %s",
+			resp.StatusCode, http.StatusText(resp.StatusCode))
+		return errors.New(errStr)
+	}
+	renewResp := &zmet.ZCertRenewResp{}
+	if err := proto.Unmarshal(contents, renewResp); err != nil {
+		errStr := fmt.Sprintf("RenewDeviceCert: Unmarshal failed: %s",
+			err)
+		return errors.New(errStr)
+	}
+	if err := checkNewCert(renewResp.PemCert, newSigner.Public()); err != nil {
+		return err
+	}
+	if err := installDeviceCert(renewResp.PemCert,
+		keyFileContent); err != nil {
+		return err
+	}
+	log.Infof("RenewDeviceCert: installed new device certificate\n")
+	return nil
+}
+
+// checkNewCert verifies that the certificate is for the new key and
+// currently valid
+func checkNewCert(certPEM []byte, pub crypto.PublicKey) error {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return errors.New("RenewDeviceCert: no certificate in response")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	certKey, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return err
+	}
+	newKey, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(certKey, newKey) {
+		return errors.New("RenewDeviceCert: certificate is for another key")
+	}
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		errStr := fmt.Sprintf("RenewDeviceCert: certificate valid from %v to %v",
+			cert.NotBefore, cert.NotAfter)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// installDeviceCert writes both new files before renaming the key file
+// and then the certificate into place. See CompleteDeviceCertInstall.
+func installDeviceCert(certPEM []byte, keyFileContent []byte) error {
+	keyFile := deviceKeyFileName()
+	if err := writeFileSync(deviceCertName+newSuffix, certPEM,
+		0644); err != nil {
+		return err
+	}
+	if err := writeFileSync(keyFile+newSuffix, keyFileContent,
+		0600); err != nil {
+		os.Remove(deviceCertName + newSuffix)
+		return err
+	}
+	if err := os.Rename(keyFile+newSuffix, keyFile); err != nil {
+		return err
+	}
+	return os.Rename(deviceCertName+newSuffix, deviceCertName)
+}
+
+func writeFileSync(filename string, b []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+		perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
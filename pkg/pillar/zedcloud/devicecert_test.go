@@ -0,0 +1,267 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedcloud
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestPair returns a self-signed certificate and the key file content
+func newTestPair(t *testing.T, notBefore time.Time,
+	notAfter time.Time) ([]byte, []byte, crypto.PublicKey) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "device"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template,
+		key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE",
+		Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY",
+		Bytes: keyDer})
+	return certPEM, keyPEM, key.Public()
+}
+
+// useTempIdentity points the device certificate and key at a temporary
+// directory with the given pair in place
+func useTempIdentity(t *testing.T, certPEM []byte,
+	keyPEM []byte) func() {
+
+	dir, err := ioutil.TempDir("", "devicecert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldCertName, oldKeyName := deviceCertName, deviceKeyName
+	deviceCertName = filepath.Join(dir, "device.cert.pem")
+	deviceKeyName = filepath.Join(dir, "device.key.pem")
+	if err := ioutil.WriteFile(deviceCertName, certPEM, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(deviceKeyName, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		deviceCertName, deviceKeyName = oldCertName, oldKeyName
+		os.RemoveAll(dir)
+	}
+}
+
+// checkInstalled verifies the content of the installed pair and that
+// no new files remain
+func checkInstalled(t *testing.T, testname string, certPEM []byte,
+	keyPEM []byte) {
+
+	for name, expected := range map[string][]byte{
+		deviceCertName: certPEM,
+		deviceKeyName:  keyPEM,
+	} {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Errorf("Test Failed: %s: %s\n", testname, err)
+			continue
+		}
+		if string(b) != string(expected) {
+			t.Errorf("Test Failed: %s: unexpected content of %s\n",
+				testname, name)
+		}
+		if _, err := os.Stat(name + newSuffix); !os.IsNotExist(err) {
+			t.Errorf("Test Failed: %s: %s%s remains\n", testname, name,
+				newSuffix)
+		}
+	}
+	if _, err := tls.LoadX509KeyPair(deviceCertName,
+		deviceKeyName); err != nil {
+		t.Errorf("Test Failed: %s: installed pair: %s\n", testname, err)
+	}
+}
+
+type TestCheckNewCertMatrix struct {
+	certPEM []byte
+	pub     crypto.PublicKey
+	ok      bool
+}
+
+func TestCheckNewCert(t *testing.T) {
+	now := time.Now()
+	certPEM, _, pub := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	_, _, otherPub := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	expiredPEM, _, expiredPub := newTestPair(t, now.Add(-2*time.Hour),
+		now.Add(-time.Hour))
+	futurePEM, _, futurePub := newTestPair(t, now.Add(time.Hour),
+		now.Add(2*time.Hour))
+	_, keyPEM, _ := newTestPair(t, now.Add(-time.Hour), now.Add(time.Hour))
+
+	testMatrix := map[string]TestCheckNewCertMatrix{
+		"Valid": {
+			certPEM: certPEM,
+			pub:     pub,
+			ok:      true,
+		},
+		"Key mismatch": {
+			certPEM: certPEM,
+			pub:     otherPub,
+		},
+		"Expired": {
+			certPEM: expiredPEM,
+			pub:     expiredPub,
+		},
+		"Not yet valid": {
+			certPEM: futurePEM,
+			pub:     futurePub,
+		},
+		"Key instead of certificate": {
+			certPEM: keyPEM,
+			pub:     pub,
+		},
+		"Not PEM": {
+			certPEM: []byte("garbage"),
+			pub:     pub,
+		},
+		"Bad certificate": {
+			certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE",
+				Bytes: []byte("garbage")}),
+			pub: pub,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		err := checkNewCert(test.certPEM, test.pub)
+		if (err == nil) != test.ok {
+			t.Errorf("Test Failed: %s: Expected ok %v, Actual: %v\n",
+				testname, test.ok, err)
+		}
+	}
+}
+
+func TestInstallDeviceCert(t *testing.T) {
+	now := time.Now()
+	oldCert, oldKey, _ := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	newCert, newKey, _ := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	cleanup := useTempIdentity(t, oldCert, oldKey)
+	defer cleanup()
+
+	if err := installDeviceCert(newCert, newKey); err != nil {
+		t.Fatalf("installDeviceCert: %s", err)
+	}
+	checkInstalled(t, "Install", newCert, newKey)
+	cert, err := GetDeviceCert()
+	if err != nil {
+		t.Fatalf("GetDeviceCert: %s", err)
+	}
+	if cert.Subject.CommonName != "device" {
+		t.Errorf("Expected CommonName device, Actual: %s\n",
+			cert.Subject.CommonName)
+	}
+}
+
+func TestInstallDeviceCertFailure(t *testing.T) {
+	now := time.Now()
+	oldCert, oldKey, _ := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	newCert, newKey, _ := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	cleanup := useTempIdentity(t, oldCert, oldKey)
+	defer cleanup()
+
+	// The new key can not be written hence the old pair must remain
+	if err := os.Mkdir(deviceKeyName+newSuffix, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := installDeviceCert(newCert, newKey); err == nil {
+		t.Fatalf("installDeviceCert succeeded without the key file")
+	}
+	os.Remove(deviceKeyName + newSuffix)
+	checkInstalled(t, "Failed install", oldCert, oldKey)
+}
+
+type TestCompleteDeviceCertInstallMatrix struct {
+	// Files left by the interrupted install
+	certNew bool
+	keyNew  bool
+	// The key was renamed into place before the crash
+	keyRenamed bool
+	// Expect the new pair to be installed
+	installed bool
+}
+
+func TestCompleteDeviceCertInstall(t *testing.T) {
+	now := time.Now()
+	oldCert, oldKey, _ := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+	newCert, newKey, _ := newTestPair(t, now.Add(-time.Hour),
+		now.Add(time.Hour))
+
+	testMatrix := map[string]TestCompleteDeviceCertInstallMatrix{
+		"Nothing to do": {},
+		"Crash after writing the new files": {
+			certNew: true,
+			keyNew:  true,
+		},
+		"Crash after renaming the key": {
+			certNew:    true,
+			keyRenamed: true,
+			installed:  true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		cleanup := useTempIdentity(t, oldCert, oldKey)
+		if test.certNew {
+			if err := ioutil.WriteFile(deviceCertName+newSuffix, newCert,
+				0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if test.keyNew {
+			if err := ioutil.WriteFile(deviceKeyName+newSuffix, newKey,
+				0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if test.keyRenamed {
+			if err := ioutil.WriteFile(deviceKeyName, newKey,
+				0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		CompleteDeviceCertInstall()
+		if test.installed {
+			checkInstalled(t, testname, newCert, newKey)
+		} else {
+			checkInstalled(t, testname, oldCert, oldKey)
+		}
+		cleanup()
+	}
+}
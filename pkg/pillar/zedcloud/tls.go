@@ -8,23 +8,34 @@ package zedcloud
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	log "github.com/sirupsen/logrus"
+	"github.com/zededa/eve/pkg/pillar/evetpm"
 	"golang.org/x/crypto/ocsp"
 	"io/ioutil"
+	"os"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	identityDirname = "/config"
 	serverFilename  = identityDirname + "/server"
-	deviceCertName  = identityDirname + "/device.cert.pem"
-	deviceKeyName   = identityDirname + "/device.key.pem"
 	rootCertName    = identityDirname + "/root-certificate.pem"
 )
 
+// Variables so that tests can use a temporary directory
+var (
+	deviceCertName = identityDirname + "/device.cert.pem"
+	deviceKeyName  = identityDirname + "/device.key.pem"
+)
+
 // If a server arg is specified it overrides the serverFilename content.
 // If a clientCert is specified it overrides the device*Name files.
+// Otherwise the device certificate is reloaded when it has been renewed.
 func GetTlsConfig(serverName string, clientCert *tls.Certificate) (*tls.Config, error) {
 	if serverName == "" {
 		// get the server name
@@ -35,13 +46,14 @@ func GetTlsConfig(serverName string, clientCert *tls.Certificate) (*tls.Config,
 		strTrim := strings.TrimSpace(string(bytes))
 		serverName = strings.Split(strTrim, ":")[0]
 	}
+	reload := false
 	if clientCert == nil {
-		deviceCert, err := tls.LoadX509KeyPair(deviceCertName,
-			deviceKeyName)
+		deviceCert, err := getCachedClientCert()
 		if err != nil {
 			return nil, err
 		}
-		clientCert = &deviceCert
+		clientCert = deviceCert
+		reload = true
 	}
 
 	// Load CA cert
@@ -62,10 +74,81 @@ func GetTlsConfig(serverName string, clientCert *tls.Certificate) (*tls.Config,
 		// TLS 1.2 because we can
 		MinVersion: tls.VersionTLS12,
 	}
+	if reload {
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return getCachedClientCert()
+		}
+	}
 	tlsConfig.BuildNameToCertificate()
 	return tlsConfig, nil
 }
 
+// The device certificate and key are cached and reloaded when the files
+// change, e.g., after RenewDeviceCert
+var clientCertCache struct {
+	sync.Mutex
+	cert     *tls.Certificate
+	certTime time.Time
+	keyTime  time.Time
+}
+
+func getCachedClientCert() (*tls.Certificate, error) {
+	certTime := modTime(deviceCertName)
+	keyTime := modTime(deviceKeyFileName())
+	clientCertCache.Lock()
+	defer clientCertCache.Unlock()
+	if clientCertCache.cert != nil &&
+		certTime.Equal(clientCertCache.certTime) &&
+		keyTime.Equal(clientCertCache.keyTime) {
+		return clientCertCache.cert, nil
+	}
+	cert, err := GetClientCert()
+	if err != nil {
+		return nil, err
+	}
+	if clientCertCache.cert != nil {
+		log.Infof("getCachedClientCert: reloaded %s\n", deviceCertName)
+	}
+	clientCertCache.cert = &cert
+	clientCertCache.certTime = certTime
+	clientCertCache.keyTime = keyTime
+	return clientCertCache.cert, nil
+}
+
+func modTime(filename string) time.Time {
+	st, err := os.Stat(filename)
+	if err != nil {
+		return time.Time{}
+	}
+	return st.ModTime()
+}
+
+// GetClientCert returns the device certificate with the private key
+// either from deviceKeyName or in the TPM
+func GetClientCert() (tls.Certificate, error) {
+	if !evetpm.IsTpmEnabled() {
+		return tls.LoadX509KeyPair(deviceCertName, deviceKeyName)
+	}
+	certPEM, err := ioutil.ReadFile(deviceCertName)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		errStr := fmt.Sprintf("GetClientCert: no certificate in %s",
+			deviceCertName)
+		return tls.Certificate{}, errors.New(errStr)
+	}
+	signer, err := evetpm.NewDeviceSigner()
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{block.Bytes},
+		PrivateKey:  signer,
+	}, nil
+}
+
 func stapledCheck(connState *tls.ConnectionState) bool {
 	if connState.VerifiedChains == nil {
 		log.Errorln("stapledCheck: No VerifiedChains")
@@ -193,6 +193,27 @@ func (t *WSTunnelClient) startSession() error {
 	return nil
 }
 
+// DialSession opens a websocket for the remote session with the id which
+// the tunnel server connects to the user. Requires a successful
+// TestConnection.
+func (t *WSTunnelClient) DialSession(id string) (*websocket.Conn, error) {
+	if t.Dialer == nil {
+		return nil, fmt.Errorf("No tested connection for session %s", id)
+	}
+	// The id is from the config hence escape it
+	sessionURL := fmt.Sprintf("%s/api/v1/edgedevice/connection/session/%s",
+		t.Tunnel, url.PathEscape(id))
+	ws, resp, err := t.Dialer.Dial(sessionURL, nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	ws.SetReadLimit(100 * 1024 * 1024)
+	return ws, nil
+}
+
 // Stop tunnel client
 func (t *WSTunnelClient) Stop() {
 	log.Info("Shutting down WS tunnel client and exiting.")
@@ -2,11 +2,39 @@
 # Note: Can't use wpa_supplicant without WPA; have to disable it then e.g.,
 # iwconfig wlan0 essid "ietf-hotel"
 
+# nim writes /run/wlan/<ifname>.conf for each WiFi port in the
+# DevicePortConfig, and uses the control interface in /run/wpa_supplicant
+# to have wpa_supplicant reload or terminate. We start wpa_supplicant for
+# each file which does not have one running.
+# If there is no /run/wlan/wlan0.conf we use /config/wpa_supplicant.conf
+# for wlan0 as before.
+WLANDIR=/run/wlan
+LEGACY=/config/wpa_supplicant.conf
+mkdir -p "$WLANDIR"
+
 # FIXME: workaround for mlan0 driver of Advantech
 (ip link set mlan0 down ; ip link set mlan0 name wlan0) || /bin/true
 
-ip link set wlan0 up
+running() {
+  [ -f "$1" ] && kill -0 "$(cat "$1")" 2>/dev/null
+}
+
 while true ; do
-  [ -f /config/wpa_supplicant.conf ] && wpa_supplicant -Dwext -iwlan0 -c /config/wpa_supplicant.conf -d
+  for conf in "$WLANDIR"/*.conf ; do
+    [ -f "$conf" ] || continue
+    ifname=$(basename "$conf" .conf)
+    pidfile="$WLANDIR/$ifname.pid"
+    running "$pidfile" && continue
+    if [ "$ifname" = wlan0 ] && running "$WLANDIR/legacy.pid" ; then
+      kill "$(cat "$WLANDIR/legacy.pid")"
+      sleep 1
+    fi
+    ip link set "$ifname" up
+    wpa_supplicant -B -Dnl80211,wext -i"$ifname" -c"$conf" -P"$pidfile"
+  done
+  if [ -f "$LEGACY" ] && [ ! -f "$WLANDIR/wlan0.conf" ] && ! running "$WLANDIR/legacy.pid" ; then
+    ip link set wlan0 up
+    wpa_supplicant -B -Dwext -iwlan0 -c"$LEGACY" -P"$WLANDIR/legacy.pid"
+  fi
   sleep 10
 done
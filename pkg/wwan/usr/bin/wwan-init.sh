@@ -12,15 +12,19 @@ set -x
 #
 # Some inspiration (but not the code!) taken from:
 #    https://github.com/openwrt-mirror/openwrt/blob/master/package/network/utils/uqmi/files/lib/netifd/proto/qmi.sh
+#
+# nim writes $CONFIG from the cellular port in the DevicePortConfig. We
+# connect using it, and disconnect when it is removed or changes.
 BBS=/run/wwan
+CONFIG=$BBS/config.json
 
-# FIXME: we really need to pick the following from some config
-APN=internetd.gdsp
 IFACE=wwan0
 QMI_DEV=/dev/cdc-wdm0
 
 WATCHDOG_TIMEOUT=300
+STATS_TIMEOUT=60
 LTESTAT_TIMEOUT=120
+POLL_TIMEOUT=10
 
 function mbus_publish() {
   [ -d "$BBS" ] || mkdir -p $BBS || exit 1
@@ -48,17 +52,68 @@ function mod_reload() {
   done
 }
 
+function config_sum() {
+  md5sum < $CONFIG 2>/dev/null
+}
+
+function config_changed() {
+  [ "`config_sum`" != "$CONFIG_SUM" ]
+}
+
+function config_get() {
+  jq -r ".$1 // empty" $CONFIG
+}
+
+function load_config() {
+  IFACE=`config_get IfName`
+  APN=`config_get APN`
+  PIN=`config_get PIN`
+  AUTH_TYPE=`config_get AuthType`
+  USERNAME=`config_get Username`
+  PASSWORD=`config_get Password`
+  ROAMING=`config_get Roaming`
+  NETWORK_MODES=`config_get NetworkModes`
+  QMI_DEV=/dev/`ls /sys/class/net/$IFACE/device/usbmisc 2>/dev/null | head -1`
+  [ "$QMI_DEV" = /dev/ ] && QMI_DEV=/dev/cdc-wdm0
+}
+
+function configure_modem() {
+  [ -n "$PIN" ] && uqmi -d $QMI_DEV --verify-pin1 "$PIN"
+  uqmi -d $QMI_DEV --set-network-modes "${NETWORK_MODES:-all}"
+  uqmi -d $QMI_DEV --set-network-roaming "${ROAMING:-off}"
+}
+
 function start_network() {
   ip link set $IFACE down
   echo Y > /sys/class/net/$IFACE/qmi/raw_ip
   ip link set $IFACE up
-  qmi --device $QMI_DEV --start-network --apn $APN --keep-client-id wds |\
+  set -- --start-network
+  [ -n "$APN" ] && set -- "$@" --apn "$APN"
+  if [ -n "$AUTH_TYPE" ] && [ "$AUTH_TYPE" != none ] ; then
+    set -- "$@" --auth-type "$AUTH_TYPE" --username "$USERNAME" --password "$PASSWORD"
+  fi
+  qmi "$@" --keep-client-id wds |\
     mbus_publish pdh_$IFACE
 }
 
+function stop_network() {
+  local PDH=`cat $BBS/pdh_$IFACE.json 2>/dev/null`
+
+  for i in $PDH 0xFFFFFFFF ; do
+    qmi --stop-network $i --autoconnect
+  done
+  ip route del default dev $IFACE 2>/dev/null
+  ip addr flush dev $IFACE
+  ip link set $IFACE down
+  rm -f $BBS/resolv.conf/${IFACE}.dhcp $BBS/pdh_$IFACE.json
+  echo '"disconnected"' | mbus_publish data-status
+}
+
+# The wait functions give up if the configuration changes
 function wait_for_wds() {
   local STATUS="null"
   while [ "$STATUS" != "connected" ] ; do
+    config_changed && return 1
     STATUS=`qmi --get-data-status | jq -r .`
     sleep 5
   done
@@ -67,6 +122,7 @@ function wait_for_wds() {
 function wait_for_register() {
   local STATUS="null"
   while [ "$STATUS" != "registered" ] ; do
+    config_changed && return 1
     STATUS=`qmi --get-serving-system | jq -r .registration`
     sleep 5
   done
@@ -75,11 +131,23 @@ function wait_for_register() {
 function wait_for_settings() {
   local MTU="null"
   while [ "$MTU" == "null" -o "$MTU" == ""  ] ; do
+    config_changed && return 1
     MTU=`qmi --get-current-settings | jq -r .mtu`
     sleep 5
   done
 }
 
+function connect() {
+  wait_for_register && start_network && wait_for_wds &&\
+    wait_for_settings && bringup_iface
+}
+
+function collect_stats() {
+  for i in serving-system signal-info current-settings data-status ; do
+    qmi --get-$i | mbus_publish $i
+  done
+}
+
 function bringup_iface() {
   JSON=`qmi --get-current-settings`
   ifconfig $IFACE `echo "$JSON" | jq -r .ipv4.ip` \
@@ -115,28 +183,44 @@ function reset_modem() {
 #        move this to the wwan's build.yml
 modprobe -a qcserial usb_wwan qmi_wwan cdc_wdm
 
-# poor man's watchdog
+CONFIG_SUM=""
+LAST_WATCHDOG=0
+LAST_STATS=0
 while true ; do
-  # ping is supposed to return 0 even if just a single packet out of 3 gets through
-  if ! ping -W 20 -w 20 -c 3 -I $IFACE 8.8.8.8 > /dev/null 2>&1 ; then
-    reset_modem 
- 
-    # lets see what networks are available still
-    qmi --network-scan |\
-      mbus_publish networks-info
-
-    # hopefully we can recover now
-    wait_for_register
-    start_network
-    wait_for_wds
-    wait_for_settings
-    bringup_iface
+  if config_changed ; then
+    [ -n "$CONFIG_SUM" ] && stop_network
+    CONFIG_SUM=`config_sum`
+    if [ -n "$CONFIG_SUM" ] ; then
+      load_config
+      configure_modem
+      connect
+      LAST_WATCHDOG=`date +%s`
+    fi
+    LAST_STATS=0
   fi
 
-  # collect current stats
-  for i in serving-system signal-info current-settings ; do
-    qmi --get-$i | mbus_publish $i
-  done
+  NOW=`date +%s`
+  if [ -n "$CONFIG_SUM" ] && [ $((NOW - LAST_WATCHDOG)) -ge $WATCHDOG_TIMEOUT ] ; then
+    LAST_WATCHDOG=$NOW
+    # poor man's watchdog
+    # ping is supposed to return 0 even if just a single packet out of 3 gets through
+    if ! ping -W 20 -w 20 -c 3 -I $IFACE 8.8.8.8 > /dev/null 2>&1 ; then
+      reset_modem
+
+      # lets see what networks are available still
+      qmi --network-scan |\
+        mbus_publish networks-info
+
+      # hopefully we can recover now
+      configure_modem
+      connect
+    fi
+  fi
+
+  if [ -n "$CONFIG_SUM" ] && [ $((NOW - LAST_STATS)) -ge $STATS_TIMEOUT ] ; then
+    LAST_STATS=$NOW
+    collect_stats
+  fi
 
-  sleep $WATCHDOG_TIMEOUT
+  sleep $POLL_TIMEOUT
 done
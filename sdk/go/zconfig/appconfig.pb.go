@@ -20,6 +20,118 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type DependencyCondition int32
+
+const (
+	DependencyCondition_DEPENDENCY_RUNNING DependencyCondition = 0
+	DependencyCondition_DEPENDENCY_HEALTHY DependencyCondition = 1
+)
+
+var DependencyCondition_name = map[int32]string{
+	0: "DEPENDENCY_RUNNING",
+	1: "DEPENDENCY_HEALTHY",
+}
+
+var DependencyCondition_value = map[string]int32{
+	"DEPENDENCY_RUNNING": 0,
+	"DEPENDENCY_HEALTHY": 1,
+}
+
+func (x DependencyCondition) String() string {
+	return proto.EnumName(DependencyCondition_name, int32(x))
+}
+
+func (DependencyCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{0}
+}
+
+type MetaDataType int32
+
+const (
+	MetaDataType_METADATA_DRIVE_AND_SERVICE MetaDataType = 0
+	MetaDataType_METADATA_SERVICE           MetaDataType = 1
+	MetaDataType_METADATA_DRIVE             MetaDataType = 2
+)
+
+var MetaDataType_name = map[int32]string{
+	0: "METADATA_DRIVE_AND_SERVICE",
+	1: "METADATA_SERVICE",
+	2: "METADATA_DRIVE",
+}
+
+var MetaDataType_value = map[string]int32{
+	"METADATA_DRIVE_AND_SERVICE": 0,
+	"METADATA_SERVICE":           1,
+	"METADATA_DRIVE":             2,
+}
+
+func (x MetaDataType) String() string {
+	return proto.EnumName(MetaDataType_name, int32(x))
+}
+
+func (MetaDataType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{1}
+}
+
+type RestartMode int32
+
+const (
+	RestartMode_RESTART_NEVER      RestartMode = 0
+	RestartMode_RESTART_ON_FAILURE RestartMode = 1
+	RestartMode_RESTART_ALWAYS     RestartMode = 2
+)
+
+var RestartMode_name = map[int32]string{
+	0: "RESTART_NEVER",
+	1: "RESTART_ON_FAILURE",
+	2: "RESTART_ALWAYS",
+}
+
+var RestartMode_value = map[string]int32{
+	"RESTART_NEVER":      0,
+	"RESTART_ON_FAILURE": 1,
+	"RESTART_ALWAYS":     2,
+}
+
+func (x RestartMode) String() string {
+	return proto.EnumName(RestartMode_name, int32(x))
+}
+
+func (RestartMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{2}
+}
+
+type HealthProbeType int32
+
+const (
+	HealthProbeType_PROBE_NONE      HealthProbeType = 0
+	HealthProbeType_PROBE_TCP       HealthProbeType = 1
+	HealthProbeType_PROBE_HTTP      HealthProbeType = 2
+	HealthProbeType_PROBE_HEARTBEAT HealthProbeType = 3
+)
+
+var HealthProbeType_name = map[int32]string{
+	0: "PROBE_NONE",
+	1: "PROBE_TCP",
+	2: "PROBE_HTTP",
+	3: "PROBE_HEARTBEAT",
+}
+
+var HealthProbeType_value = map[string]int32{
+	"PROBE_NONE":      0,
+	"PROBE_TCP":       1,
+	"PROBE_HTTP":      2,
+	"PROBE_HEARTBEAT": 3,
+}
+
+func (x HealthProbeType) String() string {
+	return proto.EnumName(HealthProbeType_name, int32(x))
+}
+
+func (HealthProbeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{3}
+}
+
 type InstanceOpsCmd struct {
 	Counter              uint32   `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
 	OpsTime              string   `protobuf:"bytes,4,opt,name=opsTime,proto3" json:"opsTime,omitempty"`
@@ -94,10 +206,17 @@ type AppInstanceConfig struct {
 	UserData string `protobuf:"bytes,11,opt,name=userData,proto3" json:"userData,omitempty"`
 	// Config flag if the app-instance should be made accessible
 	// through a remote console session established by the device.
-	RemoteConsole        bool     `protobuf:"varint,12,opt,name=remoteConsole,proto3" json:"remoteConsole,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RemoteConsole        bool             `protobuf:"varint,12,opt,name=remoteConsole,proto3" json:"remoteConsole,omitempty"`
+	RestartPolicy        *RestartPolicy   `protobuf:"bytes,13,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	HealthProbe          *HealthProbe     `protobuf:"bytes,14,opt,name=healthProbe,proto3" json:"healthProbe,omitempty"`
+	MetaDataType         MetaDataType     `protobuf:"varint,15,opt,name=metaDataType,proto3,enum=MetaDataType" json:"metaDataType,omitempty"`
+	MetaData             []*MetaDataItem  `protobuf:"bytes,16,rep,name=metaData,proto3" json:"metaData,omitempty"`
+	Dependencies         []*AppDependency `protobuf:"bytes,17,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	BootGroup            uint32           `protobuf:"varint,18,opt,name=bootGroup,proto3" json:"bootGroup,omitempty"`
+	BootDelay            uint32           `protobuf:"varint,19,opt,name=bootDelay,proto3" json:"bootDelay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *AppInstanceConfig) Reset()         { *m = AppInstanceConfig{} }
@@ -202,40 +321,365 @@ func (m *AppInstanceConfig) GetRemoteConsole() bool {
 	return false
 }
 
+func (m *AppInstanceConfig) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
+func (m *AppInstanceConfig) GetHealthProbe() *HealthProbe {
+	if m != nil {
+		return m.HealthProbe
+	}
+	return nil
+}
+
+func (m *AppInstanceConfig) GetMetaDataType() MetaDataType {
+	if m != nil {
+		return m.MetaDataType
+	}
+	return MetaDataType_METADATA_DRIVE_AND_SERVICE
+}
+
+func (m *AppInstanceConfig) GetMetaData() []*MetaDataItem {
+	if m != nil {
+		return m.MetaData
+	}
+	return nil
+}
+
+func (m *AppInstanceConfig) GetDependencies() []*AppDependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *AppInstanceConfig) GetBootGroup() uint32 {
+	if m != nil {
+		return m.BootGroup
+	}
+	return 0
+}
+
+func (m *AppInstanceConfig) GetBootDelay() uint32 {
+	if m != nil {
+		return m.BootDelay
+	}
+	return 0
+}
+
+type AppDependency struct {
+	Uuid                 string              `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Condition            DependencyCondition `protobuf:"varint,2,opt,name=condition,proto3,enum=DependencyCondition" json:"condition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AppDependency) Reset()         { *m = AppDependency{} }
+func (m *AppDependency) String() string { return proto.CompactTextString(m) }
+func (*AppDependency) ProtoMessage()    {}
+func (*AppDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{2}
+}
+
+func (m *AppDependency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppDependency.Unmarshal(m, b)
+}
+func (m *AppDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppDependency.Marshal(b, m, deterministic)
+}
+func (m *AppDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppDependency.Merge(m, src)
+}
+func (m *AppDependency) XXX_Size() int {
+	return xxx_messageInfo_AppDependency.Size(m)
+}
+func (m *AppDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppDependency proto.InternalMessageInfo
+
+func (m *AppDependency) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AppDependency) GetCondition() DependencyCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return DependencyCondition_DEPENDENCY_RUNNING
+}
+
+type MetaDataItem struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetaDataItem) Reset()         { *m = MetaDataItem{} }
+func (m *MetaDataItem) String() string { return proto.CompactTextString(m) }
+func (*MetaDataItem) ProtoMessage()    {}
+func (*MetaDataItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{3}
+}
+
+func (m *MetaDataItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetaDataItem.Unmarshal(m, b)
+}
+func (m *MetaDataItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetaDataItem.Marshal(b, m, deterministic)
+}
+func (m *MetaDataItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaDataItem.Merge(m, src)
+}
+func (m *MetaDataItem) XXX_Size() int {
+	return xxx_messageInfo_MetaDataItem.Size(m)
+}
+func (m *MetaDataItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaDataItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaDataItem proto.InternalMessageInfo
+
+func (m *MetaDataItem) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MetaDataItem) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type RestartPolicy struct {
+	Mode                 RestartMode `protobuf:"varint,1,opt,name=mode,proto3,enum=RestartMode" json:"mode,omitempty"`
+	MaxAttempts          uint32      `protobuf:"varint,2,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	Backoff              uint32      `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RestartPolicy) Reset()         { *m = RestartPolicy{} }
+func (m *RestartPolicy) String() string { return proto.CompactTextString(m) }
+func (*RestartPolicy) ProtoMessage()    {}
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{4}
+}
+
+func (m *RestartPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartPolicy.Unmarshal(m, b)
+}
+func (m *RestartPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartPolicy.Marshal(b, m, deterministic)
+}
+func (m *RestartPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartPolicy.Merge(m, src)
+}
+func (m *RestartPolicy) XXX_Size() int {
+	return xxx_messageInfo_RestartPolicy.Size(m)
+}
+func (m *RestartPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartPolicy proto.InternalMessageInfo
+
+func (m *RestartPolicy) GetMode() RestartMode {
+	if m != nil {
+		return m.Mode
+	}
+	return RestartMode_RESTART_NEVER
+}
+
+func (m *RestartPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+type HealthProbe struct {
+	Type                 HealthProbeType `protobuf:"varint,1,opt,name=type,proto3,enum=HealthProbeType" json:"type,omitempty"`
+	Port                 uint32          `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Path                 string          `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Interval             uint32          `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout              uint32          `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	FailureThreshold     uint32          `protobuf:"varint,6,opt,name=failureThreshold,proto3" json:"failureThreshold,omitempty"`
+	InitialDelay         uint32          `protobuf:"varint,7,opt,name=initialDelay,proto3" json:"initialDelay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HealthProbe) Reset()         { *m = HealthProbe{} }
+func (m *HealthProbe) String() string { return proto.CompactTextString(m) }
+func (*HealthProbe) ProtoMessage()    {}
+func (*HealthProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{5}
+}
+
+func (m *HealthProbe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthProbe.Unmarshal(m, b)
+}
+func (m *HealthProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthProbe.Marshal(b, m, deterministic)
+}
+func (m *HealthProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthProbe.Merge(m, src)
+}
+func (m *HealthProbe) XXX_Size() int {
+	return xxx_messageInfo_HealthProbe.Size(m)
+}
+func (m *HealthProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthProbe proto.InternalMessageInfo
+
+func (m *HealthProbe) GetType() HealthProbeType {
+	if m != nil {
+		return m.Type
+	}
+	return HealthProbeType_PROBE_NONE
+}
+
+func (m *HealthProbe) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *HealthProbe) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HealthProbe) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *HealthProbe) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *HealthProbe) GetFailureThreshold() uint32 {
+	if m != nil {
+		return m.FailureThreshold
+	}
+	return 0
+}
+
+func (m *HealthProbe) GetInitialDelay() uint32 {
+	if m != nil {
+		return m.InitialDelay
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("DependencyCondition", DependencyCondition_name, DependencyCondition_value)
+	proto.RegisterEnum("MetaDataType", MetaDataType_name, MetaDataType_value)
+	proto.RegisterEnum("RestartMode", RestartMode_name, RestartMode_value)
+	proto.RegisterEnum("HealthProbeType", HealthProbeType_name, HealthProbeType_value)
 	proto.RegisterType((*InstanceOpsCmd)(nil), "InstanceOpsCmd")
 	proto.RegisterType((*AppInstanceConfig)(nil), "AppInstanceConfig")
+	proto.RegisterType((*AppDependency)(nil), "AppDependency")
+	proto.RegisterType((*MetaDataItem)(nil), "MetaDataItem")
+	proto.RegisterType((*RestartPolicy)(nil), "RestartPolicy")
+	proto.RegisterType((*HealthProbe)(nil), "HealthProbe")
 }
 
 func init() { proto.RegisterFile("appconfig.proto", fileDescriptor_6183fdf07ef5608d) }
 
 var fileDescriptor_6183fdf07ef5608d = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0x13, 0x31,
-	0x10, 0xc5, 0xb5, 0xa4, 0x4d, 0x36, 0x0e, 0x49, 0x84, 0x4f, 0x56, 0x0f, 0xb0, 0xaa, 0x82, 0xb4,
-	0x5c, 0xbc, 0xa2, 0x1c, 0x38, 0x97, 0x46, 0x42, 0xbd, 0x80, 0xb4, 0xa2, 0x3d, 0x70, 0x73, 0xed,
-	0xc9, 0x62, 0x35, 0xf6, 0x58, 0xb6, 0x77, 0x81, 0x7e, 0x42, 0x3e, 0x16, 0xda, 0x7f, 0x51, 0x1a,
-	0xf5, 0xf8, 0xde, 0xfb, 0x79, 0xc6, 0x1e, 0x0f, 0x59, 0x0b, 0xe7, 0x24, 0xda, 0x9d, 0xae, 0xb8,
-	0xf3, 0x18, 0xf1, 0x62, 0xad, 0xa0, 0x91, 0x68, 0x0c, 0xda, 0xc1, 0x58, 0x86, 0x88, 0x5e, 0x54,
-	0x30, 0xc8, 0xb4, 0x31, 0x23, 0x69, 0x21, 0x1e, 0x1f, 0xbd, 0xdc, 0x92, 0xd5, 0xad, 0x0d, 0x51,
-	0x58, 0x09, 0xdf, 0x5d, 0xb8, 0x31, 0x8a, 0x32, 0x32, 0x93, 0x58, 0xdb, 0x08, 0x9e, 0xbd, 0xca,
-	0x92, 0x7c, 0x59, 0x8e, 0xb2, 0x4d, 0xd0, 0x85, 0x1f, 0xda, 0x00, 0x3b, 0xcb, 0x92, 0x7c, 0x5e,
-	0x8e, 0xf2, 0xf2, 0xdf, 0x84, 0xbc, 0xb9, 0x76, 0x6e, 0xac, 0x74, 0xd3, 0x75, 0xa0, 0x9f, 0xc9,
-	0xaa, 0xae, 0xb5, 0x12, 0x56, 0x35, 0xe0, 0x83, 0x46, 0xcb, 0x92, 0x2c, 0xc9, 0x17, 0x57, 0x6b,
-	0x7e, 0x77, 0x77, 0xbb, 0x15, 0x56, 0xdd, 0xf7, 0x76, 0x79, 0x82, 0xd1, 0x8c, 0x2c, 0x94, 0x0e,
-	0x6e, 0x2f, 0xfe, 0x5a, 0x61, 0xa0, 0xbb, 0xc6, 0xbc, 0x3c, 0xb6, 0xe8, 0x47, 0xb2, 0xda, 0xe9,
-	0x3f, 0xa0, 0x3c, 0x04, 0xac, 0xbd, 0x84, 0xc0, 0x26, 0x5d, 0xe9, 0x39, 0xbf, 0x37, 0x7d, 0xf7,
-	0xf2, 0x04, 0xa0, 0x6f, 0xc9, 0x54, 0x79, 0xdd, 0x40, 0x60, 0x67, 0xd9, 0x24, 0x5f, 0x5c, 0x4d,
-	0xf9, 0xb6, 0x95, 0xe5, 0xe0, 0xd2, 0x0b, 0x92, 0x0a, 0x19, 0x75, 0x23, 0x22, 0xb0, 0xf3, 0x2c,
-	0xc9, 0xd3, 0xf2, 0xa0, 0x69, 0x41, 0x88, 0x6e, 0x47, 0xb0, 0x13, 0x6d, 0xab, 0x69, 0x77, 0x7e,
-	0xcd, 0xbf, 0x41, 0xfc, 0x8d, 0xfe, 0xf1, 0x5a, 0x09, 0x17, 0xc1, 0x97, 0x47, 0x08, 0xdd, 0x90,
-	0x54, 0xf4, 0x76, 0x60, 0xb3, 0x0e, 0x4f, 0xf9, 0xc8, 0x1d, 0x12, 0xfa, 0x81, 0xcc, 0x3c, 0x84,
-	0x28, 0x7c, 0x64, 0xf3, 0x61, 0x32, 0xcf, 0x3f, 0xa3, 0x1c, 0x73, 0xfa, 0x9e, 0x9c, 0xbb, 0xda,
-	0x57, 0xc0, 0xc8, 0xcb, 0x60, 0x9f, 0xb6, 0x8f, 0xa8, 0x03, 0xf8, 0xad, 0x88, 0x82, 0x2d, 0xba,
-	0xb1, 0x1d, 0x34, 0xdd, 0x90, 0xa5, 0x07, 0x83, 0xb1, 0xfd, 0x9e, 0x80, 0x7b, 0x60, 0xaf, 0xbb,
-	0x57, 0x3e, 0x37, 0xbf, 0x7c, 0x25, 0xef, 0x24, 0x1a, 0xfe, 0x04, 0x0a, 0x94, 0xe0, 0x72, 0x8f,
-	0xb5, 0xe2, 0x6d, 0x89, 0x46, 0xcb, 0x61, 0x9d, 0x7e, 0x6e, 0x2a, 0x1d, 0x7f, 0xd5, 0x0f, 0x5c,
-	0xa2, 0x29, 0x7a, 0xae, 0x80, 0x06, 0x8a, 0xa0, 0x1e, 0x8b, 0x0a, 0x8b, 0xa7, 0x7e, 0xbf, 0x1e,
-	0xa6, 0x1d, 0xfc, 0xe9, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x2f, 0xb1, 0xd6, 0xae, 0x02,
-	0x00, 0x00,
+	// 936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x5f, 0x6f, 0xa3, 0x46,
+	0x10, 0x3f, 0xe7, 0xaf, 0x3d, 0x36, 0x36, 0xd9, 0x44, 0x15, 0x8a, 0xaa, 0xab, 0x65, 0xa5, 0x92,
+	0x2f, 0x0f, 0x58, 0xe7, 0x56, 0xed, 0x33, 0x31, 0x34, 0xb1, 0x94, 0x10, 0x6b, 0x43, 0x72, 0x4d,
+	0x5f, 0xac, 0x0d, 0x8c, 0x63, 0x14, 0x60, 0x11, 0x2c, 0xee, 0xf9, 0xbe, 0x6d, 0xbf, 0x47, 0x1f,
+	0x2a, 0x16, 0xb0, 0x71, 0x7a, 0x6f, 0xfb, 0xfb, 0x33, 0x3b, 0xbb, 0x3b, 0x33, 0x00, 0x3d, 0x16,
+	0xc7, 0x2e, 0x8f, 0x16, 0xfe, 0xab, 0x1e, 0x27, 0x5c, 0xf0, 0xf3, 0x9e, 0x87, 0x2b, 0x97, 0x87,
+	0x21, 0x8f, 0x4a, 0x42, 0x49, 0x05, 0x4f, 0xd8, 0x2b, 0x96, 0xb0, 0xb9, 0x0a, 0x2b, 0x67, 0x84,
+	0xa2, 0x1e, 0x3a, 0x30, 0xa1, 0x3b, 0x8d, 0x52, 0xc1, 0x22, 0x17, 0xef, 0xe3, 0x74, 0x12, 0x7a,
+	0x44, 0x83, 0x63, 0x97, 0x67, 0x91, 0xc0, 0x44, 0xdb, 0xeb, 0x37, 0x86, 0x0a, 0xad, 0x60, 0xae,
+	0xf0, 0x38, 0x75, 0xfc, 0x10, 0xb5, 0x83, 0x7e, 0x63, 0xd8, 0xa2, 0x15, 0x1c, 0xfc, 0x7b, 0x08,
+	0x27, 0x46, 0x1c, 0x57, 0x3b, 0x4d, 0x64, 0x06, 0xf2, 0x3b, 0x74, 0xb3, 0xcc, 0xf7, 0x58, 0xe4,
+	0xad, 0x30, 0x49, 0x7d, 0x1e, 0x69, 0x8d, 0x7e, 0x63, 0xd8, 0x1e, 0xf7, 0xf4, 0xc7, 0xc7, 0xa9,
+	0xc9, 0x22, 0xef, 0xa9, 0xa0, 0xe9, 0x3b, 0x1b, 0xe9, 0x43, 0xdb, 0xf3, 0xd3, 0x38, 0x60, 0xeb,
+	0x88, 0x85, 0x28, 0x8f, 0xd1, 0xa2, 0x75, 0x8a, 0x7c, 0x86, 0xee, 0xc2, 0xff, 0x8a, 0x5e, 0x82,
+	0x29, 0xcf, 0x12, 0x17, 0x53, 0x6d, 0x5f, 0x6e, 0xdd, 0xd2, 0x9f, 0xc2, 0x22, 0x3b, 0x7d, 0x67,
+	0x20, 0x1f, 0xe1, 0xc8, 0x4b, 0xfc, 0x15, 0xa6, 0xda, 0x41, 0x7f, 0x7f, 0xd8, 0x1e, 0x1f, 0xe9,
+	0x66, 0x0e, 0x69, 0xc9, 0x92, 0x73, 0x68, 0x32, 0x57, 0xf8, 0x2b, 0x26, 0x50, 0x3b, 0xec, 0x37,
+	0x86, 0x4d, 0xba, 0xc1, 0x64, 0x04, 0xe0, 0xe7, 0x4f, 0xb0, 0x60, 0x79, 0xaa, 0x23, 0x19, 0xdf,
+	0xd3, 0x6d, 0x14, 0x7f, 0xf3, 0xe4, 0xcd, 0xf0, 0x58, 0x2c, 0x30, 0xa1, 0x35, 0x0b, 0xb9, 0x80,
+	0x26, 0x2b, 0xe8, 0x54, 0x3b, 0x96, 0xf6, 0xa6, 0x5e, 0xf9, 0x36, 0x0a, 0xf9, 0x04, 0xc7, 0x09,
+	0xa6, 0x82, 0x25, 0x42, 0x6b, 0x95, 0x2f, 0xb3, 0x5b, 0x0c, 0x5a, 0xe9, 0xe4, 0x67, 0x38, 0x8c,
+	0xb3, 0xe4, 0x15, 0x35, 0xf8, 0xbe, 0xb1, 0x50, 0xf3, 0x4b, 0x64, 0x29, 0x26, 0x26, 0x13, 0x4c,
+	0x6b, 0xcb, 0x67, 0xdb, 0x60, 0x72, 0x01, 0x4a, 0x82, 0x21, 0x17, 0x79, 0x79, 0x52, 0x1e, 0xa0,
+	0xd6, 0x91, 0xb7, 0xdc, 0x25, 0xc9, 0xaf, 0xa0, 0x94, 0x39, 0x67, 0x3c, 0xf0, 0xdd, 0xb5, 0xa6,
+	0xc8, 0x84, 0x5d, 0x9d, 0xd6, 0x59, 0xba, 0x6b, 0x22, 0x3a, 0xb4, 0x97, 0xc8, 0x02, 0xb1, 0x9c,
+	0x25, 0xfc, 0x05, 0xb5, 0xae, 0x8c, 0xe9, 0xe8, 0x37, 0x5b, 0x8e, 0xd6, 0x0d, 0xe4, 0x33, 0x74,
+	0x42, 0x14, 0x2c, 0x3f, 0x97, 0xb3, 0x8e, 0x51, 0xeb, 0xf5, 0x1b, 0xc3, 0xee, 0x58, 0xd1, 0xef,
+	0x6a, 0x24, 0xdd, 0xb1, 0x90, 0x4f, 0xd0, 0xac, 0xb0, 0xa6, 0xca, 0x27, 0xdd, 0xda, 0xa7, 0x02,
+	0x43, 0xba, 0x91, 0xc9, 0x18, 0x3a, 0x1e, 0xc6, 0x18, 0x79, 0x18, 0xb9, 0x3e, 0xa6, 0xda, 0x89,
+	0xb4, 0x77, 0x75, 0x23, 0x8e, 0xcd, 0x8a, 0x5f, 0xd3, 0x1d, 0x0f, 0xf9, 0x11, 0x5a, 0x2f, 0x9c,
+	0x8b, 0xeb, 0x84, 0x67, 0xb1, 0x46, 0x64, 0xe3, 0x6f, 0x89, 0x4a, 0x35, 0x31, 0x60, 0x6b, 0xed,
+	0x74, 0xab, 0x4a, 0x62, 0xf0, 0x05, 0x94, 0x9d, 0xad, 0x09, 0x81, 0x83, 0xbc, 0xa5, 0x65, 0xbf,
+	0xb7, 0xa8, 0x5c, 0x93, 0x31, 0xb4, 0x5c, 0x1e, 0x79, 0xbe, 0xc8, 0x07, 0x61, 0x4f, 0xde, 0xf7,
+	0x4c, 0xdf, 0xc6, 0x4c, 0x2a, 0x8d, 0x6e, 0x6d, 0x83, 0xdf, 0xa0, 0x53, 0xbf, 0x22, 0x51, 0x61,
+	0xff, 0x0d, 0xd7, 0xe5, 0xb6, 0xf9, 0x92, 0x9c, 0xc1, 0xe1, 0x8a, 0x05, 0x59, 0x35, 0x24, 0x05,
+	0x18, 0x84, 0xa0, 0xec, 0x94, 0x8b, 0xf4, 0xe1, 0x20, 0xe4, 0x1e, 0xca, 0xc8, 0xee, 0xb8, 0x53,
+	0x15, 0xf3, 0x8e, 0x7b, 0x48, 0xa5, 0x92, 0xcf, 0x5c, 0xc8, 0xbe, 0x1a, 0x42, 0x60, 0x18, 0x8b,
+	0xb4, 0x1c, 0xfd, 0x3a, 0x95, 0x8f, 0xff, 0x0b, 0x73, 0xdf, 0xf8, 0x62, 0x21, 0x87, 0x4d, 0xa1,
+	0x15, 0x1c, 0xfc, 0xd3, 0x80, 0x76, 0xad, 0xd4, 0xe4, 0x02, 0x0e, 0xc4, 0x3a, 0xae, 0xb2, 0xa9,
+	0xf5, 0x36, 0x90, 0x85, 0x95, 0x6a, 0xfe, 0x48, 0x31, 0x4f, 0x44, 0x99, 0x4a, 0xae, 0x25, 0xc7,
+	0xc4, 0x52, 0x26, 0x68, 0x51, 0xb9, 0xce, 0x7b, 0x5a, 0x4e, 0xd6, 0x8a, 0x05, 0xf2, 0xbb, 0xa3,
+	0xd0, 0x0d, 0xce, 0xcf, 0x24, 0xfc, 0x10, 0x79, 0x26, 0xe4, 0xcc, 0x2a, 0xb4, 0x82, 0xe4, 0x12,
+	0xd4, 0x05, 0xf3, 0x83, 0x2c, 0x41, 0x67, 0x99, 0x60, 0xba, 0xe4, 0x81, 0xa7, 0x1d, 0x49, 0xcb,
+	0xff, 0x78, 0x32, 0x80, 0x8e, 0x1f, 0xf9, 0xc2, 0x67, 0x41, 0x51, 0xe0, 0x63, 0xe9, 0xdb, 0xe1,
+	0x2e, 0x2d, 0x38, 0xfd, 0x4e, 0xb1, 0xc8, 0x0f, 0x40, 0x4c, 0x6b, 0x66, 0xd9, 0xa6, 0x65, 0x4f,
+	0x9e, 0xe7, 0xf4, 0xd1, 0xb6, 0xa7, 0xf6, 0xb5, 0xfa, 0xe1, 0x1d, 0x7f, 0x63, 0x19, 0xb7, 0xce,
+	0xcd, 0xb3, 0xda, 0xb8, 0xfc, 0x73, 0x5b, 0x51, 0xd9, 0xd5, 0x1f, 0xe1, 0xfc, 0xce, 0x72, 0x0c,
+	0xd3, 0x70, 0x8c, 0xb9, 0x49, 0xa7, 0x4f, 0xd6, 0xdc, 0xb0, 0xcd, 0xf9, 0x83, 0x45, 0x9f, 0xa6,
+	0x13, 0x4b, 0xfd, 0x40, 0xce, 0x40, 0xdd, 0xe8, 0x15, 0xdb, 0x20, 0x04, 0xba, 0xbb, 0x51, 0xea,
+	0xde, 0xe5, 0x2d, 0xb4, 0x6b, 0x55, 0x25, 0x27, 0xa0, 0x50, 0xeb, 0xc1, 0x31, 0xa8, 0x33, 0xb7,
+	0xad, 0x27, 0x8b, 0x16, 0x67, 0xaa, 0xa8, 0x7b, 0x7b, 0xfe, 0x87, 0x31, 0xbd, 0x7d, 0xa4, 0xe5,
+	0x6e, 0x15, 0x6f, 0xdc, 0x7e, 0x31, 0x9e, 0x1f, 0xd4, 0xbd, 0xcb, 0x47, 0xe8, 0xbd, 0xab, 0x1a,
+	0xe9, 0x02, 0xcc, 0xe8, 0xfd, 0x95, 0x35, 0xb7, 0xef, 0xed, 0xfc, 0x68, 0x0a, 0xb4, 0x0a, 0xec,
+	0x4c, 0x66, 0x6a, 0x63, 0x2b, 0xdf, 0x38, 0xce, 0x4c, 0xdd, 0x23, 0xa7, 0xd0, 0x2b, 0xb1, 0x65,
+	0x50, 0xe7, 0xca, 0x32, 0x1c, 0x75, 0xff, 0xea, 0x1a, 0x7e, 0x72, 0x79, 0xa8, 0x7f, 0x43, 0x0f,
+	0x3d, 0xa6, 0xbb, 0x01, 0xcf, 0x3c, 0x3d, 0xff, 0x40, 0xad, 0x7c, 0xb7, 0xfc, 0x59, 0xfd, 0x75,
+	0xf1, 0xea, 0x8b, 0x65, 0xf6, 0xa2, 0xbb, 0x3c, 0x1c, 0x15, 0xbe, 0x11, 0xae, 0x70, 0x94, 0x7a,
+	0x6f, 0xa3, 0x57, 0x3e, 0xfa, 0x56, 0xfc, 0xbd, 0x5e, 0x8e, 0xa4, 0xf9, 0x97, 0xff, 0x06, 0x00,
+	0x3d, 0x1e, 0x47, 0x08, 0x0c, 0x07, 0x00, 0x00,
 }
@@ -25,12 +25,13 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ZCioType int32
 
 const (
-	ZCioType_ZCioNop   ZCioType = 0
-	ZCioType_ZCioEth   ZCioType = 1
-	ZCioType_ZCioUSB   ZCioType = 2
-	ZCioType_ZCioCOM   ZCioType = 3
-	ZCioType_ZCioHDMI  ZCioType = 4
-	ZCioType_ZCioOther ZCioType = 255
+	ZCioType_ZCioNop       ZCioType = 0
+	ZCioType_ZCioEth       ZCioType = 1
+	ZCioType_ZCioUSB       ZCioType = 2
+	ZCioType_ZCioCOM       ZCioType = 3
+	ZCioType_ZCioHDMI      ZCioType = 4
+	ZCioType_ZCioUSBDevice ZCioType = 5
+	ZCioType_ZCioOther     ZCioType = 255
 )
 
 var ZCioType_name = map[int32]string{
@@ -39,16 +40,18 @@ var ZCioType_name = map[int32]string{
 	2:   "ZCioUSB",
 	3:   "ZCioCOM",
 	4:   "ZCioHDMI",
+	5:   "ZCioUSBDevice",
 	255: "ZCioOther",
 }
 
 var ZCioType_value = map[string]int32{
-	"ZCioNop":   0,
-	"ZCioEth":   1,
-	"ZCioUSB":   2,
-	"ZCioCOM":   3,
-	"ZCioHDMI":  4,
-	"ZCioOther": 255,
+	"ZCioNop":       0,
+	"ZCioEth":       1,
+	"ZCioUSB":       2,
+	"ZCioCOM":       3,
+	"ZCioHDMI":      4,
+	"ZCioUSBDevice": 5,
+	"ZCioOther":     255,
 }
 
 func (x ZCioType) String() string {
@@ -137,20 +140,87 @@ func (m *UUIDandVersion) GetVersion() string {
 	return ""
 }
 
-// Adapter bundles corresponding to a subset of what is in ZioBundle
-type Adapter struct {
-	Type                 ZCioType `protobuf:"varint,1,opt,name=type,proto3,enum=ZCioType" json:"type,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+// Identifies a USB device for assignment to an app instance without
+// assigning the whole USB controller. The fields which are set must all
+// match.
+type UsbDeviceMatch struct {
+	VendorId             string   `protobuf:"bytes,1,opt,name=vendorId,proto3" json:"vendorId,omitempty"`
+	ProductId            string   `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Serial               string   `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	PortPath             string   `protobuf:"bytes,4,opt,name=portPath,proto3" json:"portPath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *UsbDeviceMatch) Reset()         { *m = UsbDeviceMatch{} }
+func (m *UsbDeviceMatch) String() string { return proto.CompactTextString(m) }
+func (*UsbDeviceMatch) ProtoMessage()    {}
+func (*UsbDeviceMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2bb9fc347232ae8, []int{1}
+}
+
+func (m *UsbDeviceMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsbDeviceMatch.Unmarshal(m, b)
+}
+func (m *UsbDeviceMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsbDeviceMatch.Marshal(b, m, deterministic)
+}
+func (m *UsbDeviceMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsbDeviceMatch.Merge(m, src)
+}
+func (m *UsbDeviceMatch) XXX_Size() int {
+	return xxx_messageInfo_UsbDeviceMatch.Size(m)
+}
+func (m *UsbDeviceMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsbDeviceMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsbDeviceMatch proto.InternalMessageInfo
+
+func (m *UsbDeviceMatch) GetVendorId() string {
+	if m != nil {
+		return m.VendorId
+	}
+	return ""
+}
+
+func (m *UsbDeviceMatch) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *UsbDeviceMatch) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *UsbDeviceMatch) GetPortPath() string {
+	if m != nil {
+		return m.PortPath
+	}
+	return ""
+}
+
+// Adapter bundles corresponding to a subset of what is in ZioBundle
+type Adapter struct {
+	Type                 ZCioType        `protobuf:"varint,1,opt,name=type,proto3,enum=ZCioType" json:"type,omitempty"`
+	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UsbDevice            *UsbDeviceMatch `protobuf:"bytes,3,opt,name=usbDevice,proto3" json:"usbDevice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
 func (m *Adapter) Reset()         { *m = Adapter{} }
 func (m *Adapter) String() string { return proto.CompactTextString(m) }
 func (*Adapter) ProtoMessage()    {}
 func (*Adapter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2bb9fc347232ae8, []int{1}
+	return fileDescriptor_c2bb9fc347232ae8, []int{2}
 }
 
 func (m *Adapter) XXX_Unmarshal(b []byte) error {
@@ -185,6 +255,13 @@ func (m *Adapter) GetName() string {
 	return ""
 }
 
+func (m *Adapter) GetUsbDevice() *UsbDeviceMatch {
+	if m != nil {
+		return m.UsbDevice
+	}
+	return nil
+}
+
 // This is way to tell the device if there is service in cloud somewhere,
 // what type it is how to access it
 type ZcServicePoint struct {
@@ -200,7 +277,7 @@ func (m *ZcServicePoint) Reset()         { *m = ZcServicePoint{} }
 func (m *ZcServicePoint) String() string { return proto.CompactTextString(m) }
 func (*ZcServicePoint) ProtoMessage()    {}
 func (*ZcServicePoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2bb9fc347232ae8, []int{2}
+	return fileDescriptor_c2bb9fc347232ae8, []int{3}
 }
 
 func (m *ZcServicePoint) XXX_Unmarshal(b []byte) error {
@@ -246,6 +323,7 @@ func init() {
 	proto.RegisterEnum("ZCioType", ZCioType_name, ZCioType_value)
 	proto.RegisterEnum("ZcServiceType", ZcServiceType_name, ZcServiceType_value)
 	proto.RegisterType((*UUIDandVersion)(nil), "UUIDandVersion")
+	proto.RegisterType((*UsbDeviceMatch)(nil), "UsbDeviceMatch")
 	proto.RegisterType((*Adapter)(nil), "Adapter")
 	proto.RegisterType((*ZcServicePoint)(nil), "ZcServicePoint")
 }
@@ -253,28 +331,33 @@ func init() {
 func init() { proto.RegisterFile("devcommon.proto", fileDescriptor_c2bb9fc347232ae8) }
 
 var fileDescriptor_c2bb9fc347232ae8 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xdf, 0xef, 0xd2, 0x30,
-	0x14, 0xc5, 0xbf, 0x03, 0x02, 0xec, 0x2a, 0xb3, 0x36, 0x3e, 0x2c, 0x26, 0xfe, 0x08, 0x31, 0xc6,
-	0xf0, 0xb0, 0x25, 0xfa, 0x6a, 0x4c, 0x04, 0x0c, 0xee, 0x01, 0x30, 0x20, 0x3e, 0x10, 0x5f, 0xca,
-	0x7a, 0x85, 0x46, 0xd6, 0x36, 0x5d, 0xb7, 0x84, 0xfd, 0xf3, 0x9a, 0x75, 0x1b, 0xd1, 0xb7, 0x7b,
-	0xce, 0x3d, 0xf7, 0xd3, 0x93, 0x14, 0x9e, 0x70, 0x2c, 0x53, 0x95, 0x65, 0x4a, 0x46, 0xda, 0x28,
-	0xab, 0xa6, 0x9f, 0x20, 0x38, 0x1c, 0x92, 0x25, 0x93, 0xfc, 0x07, 0x9a, 0x5c, 0x28, 0x49, 0x29,
-	0x0c, 0x8a, 0x42, 0xf0, 0xd0, 0x7b, 0xed, 0xbd, 0xf3, 0x77, 0x6e, 0xa6, 0x21, 0x8c, 0xca, 0x66,
-	0x1d, 0xf6, 0x9c, 0xdd, 0xc9, 0xe9, 0x47, 0x18, 0x7d, 0xe6, 0x4c, 0x5b, 0x34, 0xf4, 0x05, 0x0c,
-	0xec, 0x4d, 0xa3, 0x3b, 0x0c, 0xde, 0xfb, 0xd1, 0x71, 0x21, 0xd4, 0xf7, 0x9b, 0xc6, 0x9d, 0xb3,
-	0x6b, 0xae, 0x64, 0x19, 0xb6, 0x00, 0x37, 0x4f, 0x2d, 0x04, 0xc7, 0x74, 0x8f, 0xa6, 0x14, 0x29,
-	0x7e, 0x53, 0x42, 0x5a, 0xfa, 0x16, 0x86, 0x55, 0x5e, 0x5f, 0x85, 0x7d, 0x87, 0x09, 0xa2, 0x7b,
-	0xc0, 0xb1, 0xda, 0x2d, 0x7d, 0x0e, 0xe3, 0x0d, 0xcb, 0x70, 0x6b, 0x12, 0xdd, 0x36, 0xbd, 0x6b,
-	0xfa, 0x12, 0x60, 0x61, 0x90, 0xa3, 0xb4, 0x82, 0x5d, 0xdb, 0xf7, 0xfe, 0x71, 0x66, 0x3f, 0x61,
-	0xdc, 0x75, 0xa3, 0x8f, 0x60, 0x54, 0xcf, 0x1b, 0xa5, 0xc9, 0x43, 0x27, 0xbe, 0xd8, 0x0b, 0xf1,
-	0x3a, 0x71, 0xd8, 0xcf, 0x49, 0xaf, 0x13, 0x8b, 0xed, 0x9a, 0xf4, 0xe9, 0xe3, 0xe6, 0xfe, 0xeb,
-	0x72, 0x9d, 0x90, 0x01, 0x0d, 0xc0, 0xaf, 0xd5, 0xd6, 0x5e, 0xd0, 0x90, 0x3f, 0xde, 0x6c, 0x05,
-	0x93, 0xff, 0x2a, 0xd3, 0x67, 0x40, 0xaa, 0xf4, 0xaa, 0x0a, 0x9e, 0xc8, 0x92, 0x5d, 0x05, 0xdf,
-	0x9b, 0x92, 0x3c, 0xd0, 0x09, 0xf8, 0x19, 0xd3, 0x75, 0x0e, 0x0d, 0xf1, 0xe8, 0x53, 0x98, 0xe4,
-	0x85, 0xd6, 0xca, 0xd8, 0xd6, 0xea, 0xcd, 0x57, 0xf0, 0x2a, 0x55, 0x59, 0x54, 0x21, 0x47, 0xce,
-	0x22, 0x47, 0x88, 0x8a, 0xbc, 0x01, 0x37, 0xbf, 0x77, 0x7c, 0x73, 0x16, 0xf6, 0x52, 0x9c, 0xa2,
-	0x54, 0x65, 0x71, 0x93, 0x8b, 0xb1, 0xc4, 0x38, 0xe7, 0xbf, 0xe3, 0xb3, 0x8a, 0xab, 0x54, 0xc9,
-	0x5f, 0xe2, 0x7c, 0x1a, 0xba, 0xf0, 0x87, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x3d, 0x4d,
-	0x10, 0xfd, 0x01, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xcf, 0x6b, 0xdb, 0x30,
+	0x18, 0xad, 0xd3, 0x2c, 0xa9, 0xbf, 0x2e, 0xae, 0x26, 0xc6, 0x30, 0x63, 0xbf, 0x08, 0x63, 0x8c,
+	0xc2, 0x6c, 0xc8, 0xee, 0x83, 0x35, 0x19, 0x9d, 0x0f, 0x69, 0x4a, 0xb2, 0xec, 0x90, 0x9b, 0x62,
+	0x7d, 0x8b, 0x4d, 0x63, 0x4b, 0xc8, 0xb2, 0xa1, 0x39, 0xec, 0x5f, 0xdf, 0x90, 0x6c, 0xb5, 0xcd,
+	0xed, 0x7b, 0xef, 0x7b, 0x7a, 0x4f, 0xcf, 0x16, 0x5c, 0x70, 0x6c, 0x52, 0x51, 0x14, 0xa2, 0x8c,
+	0xa4, 0x12, 0x5a, 0x8c, 0xbf, 0x41, 0xb0, 0x5e, 0x27, 0x33, 0x56, 0xf2, 0xdf, 0xa8, 0xaa, 0x5c,
+	0x94, 0x94, 0x42, 0xbf, 0xae, 0x73, 0x1e, 0x7a, 0x1f, 0xbc, 0xcf, 0xfe, 0xd2, 0xce, 0x34, 0x84,
+	0x61, 0xd3, 0xae, 0xc3, 0x9e, 0xa5, 0x1d, 0x1c, 0xff, 0x85, 0x60, 0x5d, 0x6d, 0x67, 0xd8, 0xe4,
+	0x29, 0xce, 0x99, 0x4e, 0x33, 0xfa, 0x1a, 0xce, 0x1a, 0x2c, 0xb9, 0x50, 0x89, 0xf3, 0x78, 0xc0,
+	0xf4, 0x0d, 0xf8, 0x52, 0x09, 0x5e, 0xa7, 0x3a, 0xe1, 0x9d, 0xd3, 0x23, 0x41, 0x5f, 0xc1, 0xa0,
+	0x42, 0x95, 0xb3, 0x7d, 0x78, 0x6a, 0x57, 0x1d, 0x32, 0x8e, 0x52, 0x28, 0x7d, 0xcb, 0x74, 0x16,
+	0xf6, 0x5b, 0x47, 0x87, 0xc7, 0x77, 0x30, 0xfc, 0xce, 0x99, 0xd4, 0xa8, 0xe8, 0x5b, 0xe8, 0xeb,
+	0x7b, 0x89, 0x36, 0x34, 0x98, 0xf8, 0xd1, 0x66, 0x9a, 0x8b, 0x5f, 0xf7, 0x12, 0x97, 0x96, 0x36,
+	0xbd, 0x4a, 0x56, 0x60, 0x17, 0x6b, 0x67, 0xfa, 0x05, 0xfc, 0xda, 0xdd, 0xde, 0x86, 0x9e, 0x4f,
+	0x2e, 0xa2, 0xe3, 0x3e, 0xcb, 0x47, 0xc5, 0x58, 0x43, 0xb0, 0x49, 0x57, 0xa8, 0x0c, 0xb8, 0x15,
+	0x79, 0xa9, 0xe9, 0x27, 0x18, 0x1c, 0x2a, 0x13, 0x62, 0x4f, 0x07, 0x93, 0x20, 0x7a, 0x10, 0xd8,
+	0xe8, 0x6e, 0x6b, 0x2a, 0xdc, 0xb0, 0x02, 0x17, 0x2a, 0x91, 0xee, 0xa3, 0x38, 0x4c, 0xdf, 0x01,
+	0x4c, 0x15, 0x72, 0x2c, 0xb5, 0xa9, 0xde, 0x5e, 0xef, 0x09, 0x73, 0x29, 0xe0, 0xcc, 0x55, 0xa1,
+	0xe7, 0x30, 0x34, 0xf3, 0x8d, 0x90, 0xe4, 0xc4, 0x81, 0x1f, 0x3a, 0x23, 0x9e, 0x03, 0xeb, 0xd5,
+	0x15, 0xe9, 0x39, 0x30, 0x5d, 0xcc, 0xc9, 0x29, 0x7d, 0xde, 0x9e, 0xff, 0x39, 0x9b, 0x27, 0xa4,
+	0x4f, 0x5f, 0xc0, 0xa8, 0xd3, 0xb5, 0xa5, 0xc8, 0x33, 0x1a, 0x80, 0x6f, 0xa8, 0x85, 0xce, 0x50,
+	0x91, 0x7f, 0xde, 0xe5, 0x35, 0x8c, 0x8e, 0x5a, 0xd0, 0x97, 0x40, 0x0e, 0xe9, 0x5e, 0xd4, 0x3c,
+	0x29, 0x1b, 0xb6, 0xcf, 0xf9, 0x4a, 0x35, 0xe4, 0x84, 0x8e, 0xc0, 0x2f, 0x98, 0x34, 0x3a, 0x54,
+	0xc4, 0x33, 0xc6, 0x55, 0x2d, 0xcd, 0x8f, 0xe9, 0xa8, 0xde, 0xd5, 0x35, 0xbc, 0x4f, 0x45, 0x11,
+	0x1d, 0x90, 0x23, 0x67, 0x91, 0x75, 0x88, 0xea, 0xaa, 0x35, 0x6e, 0xdf, 0xdf, 0xe6, 0xe3, 0x2e,
+	0xd7, 0x59, 0xbd, 0x8d, 0x52, 0x51, 0xc4, 0xad, 0x2e, 0xc6, 0x06, 0xe3, 0x8a, 0xdf, 0xc5, 0x3b,
+	0x11, 0x1f, 0x52, 0x51, 0xfe, 0xc9, 0x77, 0xdb, 0x81, 0x15, 0x7f, 0xfd, 0x3f, 0x00, 0x00, 0xd8,
+	0x09, 0x73, 0xbf, 0x02, 0x00, 0x00,
 }
@@ -6,6 +6,7 @@ package zconfig
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	math "math"
 )
 
@@ -20,6 +21,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type SshRole int32
+
+const (
+	SshRole_SSH_ROLE_UNSPECIFIED SshRole = 0
+	SshRole_SSH_ROLE_DEBUG       SshRole = 1
+	SshRole_SSH_ROLE_ROOT        SshRole = 2
+)
+
+var SshRole_name = map[int32]string{
+	0: "SSH_ROLE_UNSPECIFIED",
+	1: "SSH_ROLE_DEBUG",
+	2: "SSH_ROLE_ROOT",
+}
+
+var SshRole_value = map[string]int32{
+	"SSH_ROLE_UNSPECIFIED": 0,
+	"SSH_ROLE_DEBUG":       1,
+	"SSH_ROLE_ROOT":        2,
+}
+
+func (x SshRole) String() string {
+	return proto.EnumName(SshRole_name, int32(x))
+}
+
+func (SshRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{0}
+}
+
+type RemoteSessionType int32
+
+const (
+	RemoteSessionType_REMOTE_SESSION_UNSPECIFIED   RemoteSessionType = 0
+	RemoteSessionType_REMOTE_SESSION_SHELL         RemoteSessionType = 1
+	RemoteSessionType_REMOTE_SESSION_PORT_FORWARD  RemoteSessionType = 2
+	RemoteSessionType_REMOTE_SESSION_FILE_TRANSFER RemoteSessionType = 3
+	RemoteSessionType_REMOTE_SESSION_APP_CONSOLE   RemoteSessionType = 4
+)
+
+var RemoteSessionType_name = map[int32]string{
+	0: "REMOTE_SESSION_UNSPECIFIED",
+	1: "REMOTE_SESSION_SHELL",
+	2: "REMOTE_SESSION_PORT_FORWARD",
+	3: "REMOTE_SESSION_FILE_TRANSFER",
+	4: "REMOTE_SESSION_APP_CONSOLE",
+}
+
+var RemoteSessionType_value = map[string]int32{
+	"REMOTE_SESSION_UNSPECIFIED":   0,
+	"REMOTE_SESSION_SHELL":         1,
+	"REMOTE_SESSION_PORT_FORWARD":  2,
+	"REMOTE_SESSION_FILE_TRANSFER": 3,
+	"REMOTE_SESSION_APP_CONSOLE":   4,
+}
+
+func (x RemoteSessionType) String() string {
+	return proto.EnumName(RemoteSessionType_name, int32(x))
+}
+
+func (RemoteSessionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{1}
+}
+
 type SWAdapterType int32
 
 const (
@@ -45,7 +108,126 @@ func (x SWAdapterType) String() string {
 }
 
 func (SWAdapterType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fc17241cd6d97458, []int{0}
+	return fileDescriptor_fc17241cd6d97458, []int{2}
+}
+
+type WirelessType int32
+
+const (
+	WirelessType_TypeNOOP WirelessType = 0
+	WirelessType_WiFi     WirelessType = 1
+	WirelessType_Cellular WirelessType = 2
+)
+
+var WirelessType_name = map[int32]string{
+	0: "TypeNOOP",
+	1: "WiFi",
+	2: "Cellular",
+}
+
+var WirelessType_value = map[string]int32{
+	"TypeNOOP": 0,
+	"WiFi":     1,
+	"Cellular": 2,
+}
+
+func (x WirelessType) String() string {
+	return proto.EnumName(WirelessType_name, int32(x))
+}
+
+func (WirelessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{3}
+}
+
+type WiFiKeyScheme int32
+
+const (
+	WiFiKeyScheme_SchemeNOOP WiFiKeyScheme = 0
+	WiFiKeyScheme_WPAPSK     WiFiKeyScheme = 1
+	WiFiKeyScheme_WPAEAP     WiFiKeyScheme = 2
+)
+
+var WiFiKeyScheme_name = map[int32]string{
+	0: "SchemeNOOP",
+	1: "WPAPSK",
+	2: "WPAEAP",
+}
+
+var WiFiKeyScheme_value = map[string]int32{
+	"SchemeNOOP": 0,
+	"WPAPSK":     1,
+	"WPAEAP":     2,
+}
+
+func (x WiFiKeyScheme) String() string {
+	return proto.EnumName(WiFiKeyScheme_name, int32(x))
+}
+
+func (WiFiKeyScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{4}
+}
+
+type CellularAuthProtocol int32
+
+const (
+	CellularAuthProtocol_CellularAuthNone       CellularAuthProtocol = 0
+	CellularAuthProtocol_CellularAuthPAP        CellularAuthProtocol = 1
+	CellularAuthProtocol_CellularAuthCHAP       CellularAuthProtocol = 2
+	CellularAuthProtocol_CellularAuthPAPandCHAP CellularAuthProtocol = 3
+)
+
+var CellularAuthProtocol_name = map[int32]string{
+	0: "CellularAuthNone",
+	1: "CellularAuthPAP",
+	2: "CellularAuthCHAP",
+	3: "CellularAuthPAPandCHAP",
+}
+
+var CellularAuthProtocol_value = map[string]int32{
+	"CellularAuthNone":       0,
+	"CellularAuthPAP":        1,
+	"CellularAuthCHAP":       2,
+	"CellularAuthPAPandCHAP": 3,
+}
+
+func (x CellularAuthProtocol) String() string {
+	return proto.EnumName(CellularAuthProtocol_name, int32(x))
+}
+
+func (CellularAuthProtocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{5}
+}
+
+// Radio access technologies
+type CellularRAT int32
+
+const (
+	CellularRAT_RATUnspecified CellularRAT = 0
+	CellularRAT_RATGSM         CellularRAT = 1
+	CellularRAT_RATUMTS        CellularRAT = 2
+	CellularRAT_RATLTE         CellularRAT = 3
+)
+
+var CellularRAT_name = map[int32]string{
+	0: "RATUnspecified",
+	1: "RATGSM",
+	2: "RATUMTS",
+	3: "RATLTE",
+}
+
+var CellularRAT_value = map[string]int32{
+	"RATUnspecified": 0,
+	"RATGSM":         1,
+	"RATUMTS":        2,
+	"RATLTE":         3,
+}
+
+func (x CellularRAT) String() string {
+	return proto.EnumName(CellularRAT_name, int32(x))
+}
+
+func (CellularRAT) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{6}
 }
 
 type MapServer struct {
@@ -387,10 +569,11 @@ type SystemAdapter struct {
 	Addr string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
 	// alias/logical name which will be reported to zedcloud
 	// and used for app instances
-	LogicalName          string   `protobuf:"bytes,6,opt,name=logicalName,proto3" json:"logicalName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LogicalName          string          `protobuf:"bytes,6,opt,name=logicalName,proto3" json:"logicalName,omitempty"`
+	WirelessCfg          *WirelessConfig `protobuf:"bytes,7,opt,name=wirelessCfg,proto3" json:"wirelessCfg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SystemAdapter) Reset()         { *m = SystemAdapter{} }
@@ -467,6 +650,13 @@ func (m *SystemAdapter) GetLogicalName() string {
 	return ""
 }
 
+func (m *SystemAdapter) GetWirelessCfg() *WirelessConfig {
+	if m != nil {
+		return m.WirelessCfg
+	}
+	return nil
+}
+
 type EdgeDevConfig struct {
 	Id                 *UUIDandVersion          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	DevConfigSha256    []byte                   `protobuf:"bytes,2,opt,name=devConfigSha256,proto3" json:"devConfigSha256,omitempty"`
@@ -486,11 +676,14 @@ type EdgeDevConfig struct {
 	ProductName      string                   `protobuf:"bytes,15,opt,name=productName,proto3" json:"productName,omitempty"`
 	NetworkInstances []*NetworkInstanceConfig `protobuf:"bytes,16,rep,name=networkInstances,proto3" json:"networkInstances,omitempty"`
 	// Information saved in /config to make it easier find a device in EV-C
-	Enterprise           string   `protobuf:"bytes,17,opt,name=enterprise,proto3" json:"enterprise,omitempty"`
-	Name                 string   `protobuf:"bytes,18,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Enterprise           string           `protobuf:"bytes,17,opt,name=enterprise,proto3" json:"enterprise,omitempty"`
+	Name                 string           `protobuf:"bytes,18,opt,name=name,proto3" json:"name,omitempty"`
+	RenewCert            *DeviceOpsCmd    `protobuf:"bytes,19,opt,name=renewCert,proto3" json:"renewCert,omitempty"`
+	RemoteSessions       []*RemoteSession `protobuf:"bytes,20,rep,name=remoteSessions,proto3" json:"remoteSessions,omitempty"`
+	SshCA                *SshCAConfig     `protobuf:"bytes,21,opt,name=sshCA,proto3" json:"sshCA,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *EdgeDevConfig) Reset()         { *m = EdgeDevConfig{} }
@@ -637,6 +830,13 @@ func (m *EdgeDevConfig) GetEnterprise() string {
 	return ""
 }
 
+func (m *EdgeDevConfig) GetRenewCert() *DeviceOpsCmd {
+	if m != nil {
+		return m.RenewCert
+	}
+	return nil
+}
+
 func (m *EdgeDevConfig) GetName() string {
 	if m != nil {
 		return m.Name
@@ -644,6 +844,225 @@ func (m *EdgeDevConfig) GetName() string {
 	return ""
 }
 
+func (m *EdgeDevConfig) GetRemoteSessions() []*RemoteSession {
+	if m != nil {
+		return m.RemoteSessions
+	}
+	return nil
+}
+
+func (m *EdgeDevConfig) GetSshCA() *SshCAConfig {
+	if m != nil {
+		return m.SshCA
+	}
+	return nil
+}
+
+type SshPrincipal struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 SshRole  `protobuf:"varint,2,opt,name=role,proto3,enum=SshRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SshPrincipal) Reset()         { *m = SshPrincipal{} }
+func (m *SshPrincipal) String() string { return proto.CompactTextString(m) }
+func (*SshPrincipal) ProtoMessage()    {}
+func (*SshPrincipal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{7}
+}
+
+func (m *SshPrincipal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SshPrincipal.Unmarshal(m, b)
+}
+func (m *SshPrincipal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SshPrincipal.Marshal(b, m, deterministic)
+}
+func (m *SshPrincipal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SshPrincipal.Merge(m, src)
+}
+func (m *SshPrincipal) XXX_Size() int {
+	return xxx_messageInfo_SshPrincipal.Size(m)
+}
+func (m *SshPrincipal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SshPrincipal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SshPrincipal proto.InternalMessageInfo
+
+func (m *SshPrincipal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SshPrincipal) GetRole() SshRole {
+	if m != nil {
+		return m.Role
+	}
+	return SshRole_SSH_ROLE_UNSPECIFIED
+}
+
+type SshCAConfig struct {
+	UserCaKeys           []string        `protobuf:"bytes,1,rep,name=userCaKeys,proto3" json:"userCaKeys,omitempty"`
+	Principals           []*SshPrincipal `protobuf:"bytes,2,rep,name=principals,proto3" json:"principals,omitempty"`
+	RevokedPrincipals    []string        `protobuf:"bytes,3,rep,name=revokedPrincipals,proto3" json:"revokedPrincipals,omitempty"`
+	RevokedKeys          []string        `protobuf:"bytes,4,rep,name=revokedKeys,proto3" json:"revokedKeys,omitempty"`
+	RevokedSerials       []uint64        `protobuf:"varint,5,rep,packed,name=revokedSerials,proto3" json:"revokedSerials,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SshCAConfig) Reset()         { *m = SshCAConfig{} }
+func (m *SshCAConfig) String() string { return proto.CompactTextString(m) }
+func (*SshCAConfig) ProtoMessage()    {}
+func (*SshCAConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{8}
+}
+
+func (m *SshCAConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SshCAConfig.Unmarshal(m, b)
+}
+func (m *SshCAConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SshCAConfig.Marshal(b, m, deterministic)
+}
+func (m *SshCAConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SshCAConfig.Merge(m, src)
+}
+func (m *SshCAConfig) XXX_Size() int {
+	return xxx_messageInfo_SshCAConfig.Size(m)
+}
+func (m *SshCAConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SshCAConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SshCAConfig proto.InternalMessageInfo
+
+func (m *SshCAConfig) GetUserCaKeys() []string {
+	if m != nil {
+		return m.UserCaKeys
+	}
+	return nil
+}
+
+func (m *SshCAConfig) GetPrincipals() []*SshPrincipal {
+	if m != nil {
+		return m.Principals
+	}
+	return nil
+}
+
+func (m *SshCAConfig) GetRevokedPrincipals() []string {
+	if m != nil {
+		return m.RevokedPrincipals
+	}
+	return nil
+}
+
+func (m *SshCAConfig) GetRevokedKeys() []string {
+	if m != nil {
+		return m.RevokedKeys
+	}
+	return nil
+}
+
+func (m *SshCAConfig) GetRevokedSerials() []uint64 {
+	if m != nil {
+		return m.RevokedSerials
+	}
+	return nil
+}
+
+type RemoteSession struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User                 string               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Type                 RemoteSessionType    `protobuf:"varint,3,opt,name=type,proto3,enum=RemoteSessionType" json:"type,omitempty"`
+	Port                 uint32               `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	ReadOnly             bool                 `protobuf:"varint,6,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	AppInstance          string               `protobuf:"bytes,7,opt,name=appInstance,proto3" json:"appInstance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RemoteSession) Reset()         { *m = RemoteSession{} }
+func (m *RemoteSession) String() string { return proto.CompactTextString(m) }
+func (*RemoteSession) ProtoMessage()    {}
+func (*RemoteSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{9}
+}
+
+func (m *RemoteSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteSession.Unmarshal(m, b)
+}
+func (m *RemoteSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteSession.Marshal(b, m, deterministic)
+}
+func (m *RemoteSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSession.Merge(m, src)
+}
+func (m *RemoteSession) XXX_Size() int {
+	return xxx_messageInfo_RemoteSession.Size(m)
+}
+func (m *RemoteSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSession proto.InternalMessageInfo
+
+func (m *RemoteSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RemoteSession) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *RemoteSession) GetType() RemoteSessionType {
+	if m != nil {
+		return m.Type
+	}
+	return RemoteSessionType_REMOTE_SESSION_UNSPECIFIED
+}
+
+func (m *RemoteSession) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *RemoteSession) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *RemoteSession) GetAppInstance() string {
+	if m != nil {
+		return m.AppInstance
+	}
+	return ""
+}
+
+func (m *RemoteSession) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
 // Timers and other per-device policy which relates to the interaction
 // with zedcloud. Note that the timers are randomized on the device
 // to avoid synchronization with other devices. Random range is between
@@ -670,7 +1089,7 @@ func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
 func (m *ConfigItem) String() string { return proto.CompactTextString(m) }
 func (*ConfigItem) ProtoMessage()    {}
 func (*ConfigItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc17241cd6d97458, []int{7}
+	return fileDescriptor_fc17241cd6d97458, []int{10}
 }
 
 func (m *ConfigItem) XXX_Unmarshal(b []byte) error {
@@ -803,7 +1222,7 @@ func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
 func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest) ProtoMessage()    {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc17241cd6d97458, []int{8}
+	return fileDescriptor_fc17241cd6d97458, []int{11}
 }
 
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
@@ -843,7 +1262,7 @@ func (m *ConfigResponse) Reset()         { *m = ConfigResponse{} }
 func (m *ConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfigResponse) ProtoMessage()    {}
 func (*ConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc17241cd6d97458, []int{9}
+	return fileDescriptor_fc17241cd6d97458, []int{12}
 }
 
 func (m *ConfigResponse) XXX_Unmarshal(b []byte) error {
@@ -878,8 +1297,243 @@ func (m *ConfigResponse) GetConfigHash() string {
 	return ""
 }
 
+type WifiConfig struct {
+	WifiSSID             string        `protobuf:"bytes,1,opt,name=wifiSSID,proto3" json:"wifiSSID,omitempty"`
+	KeyScheme            WiFiKeyScheme `protobuf:"varint,2,opt,name=keyScheme,proto3,enum=WiFiKeyScheme" json:"keyScheme,omitempty"`
+	Identity             string        `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Password             string        `protobuf:"bytes,10,opt,name=password,proto3" json:"password,omitempty"`
+	Priority             int32         `protobuf:"varint,20,opt,name=priority,proto3" json:"priority,omitempty"`
+	CaCert               string        `protobuf:"bytes,30,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	ServerName           string        `protobuf:"bytes,31,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *WifiConfig) Reset()         { *m = WifiConfig{} }
+func (m *WifiConfig) String() string { return proto.CompactTextString(m) }
+func (*WifiConfig) ProtoMessage()    {}
+func (*WifiConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{13}
+}
+
+func (m *WifiConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WifiConfig.Unmarshal(m, b)
+}
+func (m *WifiConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WifiConfig.Marshal(b, m, deterministic)
+}
+func (m *WifiConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WifiConfig.Merge(m, src)
+}
+func (m *WifiConfig) XXX_Size() int {
+	return xxx_messageInfo_WifiConfig.Size(m)
+}
+func (m *WifiConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_WifiConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WifiConfig proto.InternalMessageInfo
+
+func (m *WifiConfig) GetWifiSSID() string {
+	if m != nil {
+		return m.WifiSSID
+	}
+	return ""
+}
+
+func (m *WifiConfig) GetKeyScheme() WiFiKeyScheme {
+	if m != nil {
+		return m.KeyScheme
+	}
+	return WiFiKeyScheme_SchemeNOOP
+}
+
+func (m *WifiConfig) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WifiConfig) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *WifiConfig) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *WifiConfig) GetCaCert() string {
+	if m != nil {
+		return m.CaCert
+	}
+	return ""
+}
+
+func (m *WifiConfig) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+type WirelessConfig struct {
+	Type                 WirelessType      `protobuf:"varint,1,opt,name=type,proto3,enum=WirelessType" json:"type,omitempty"`
+	WifiCfg              []*WifiConfig     `protobuf:"bytes,2,rep,name=wifiCfg,proto3" json:"wifiCfg,omitempty"`
+	CellularCfg          []*CellularConfig `protobuf:"bytes,3,rep,name=cellularCfg,proto3" json:"cellularCfg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WirelessConfig) Reset()         { *m = WirelessConfig{} }
+func (m *WirelessConfig) String() string { return proto.CompactTextString(m) }
+func (*WirelessConfig) ProtoMessage()    {}
+func (*WirelessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{14}
+}
+
+func (m *WirelessConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WirelessConfig.Unmarshal(m, b)
+}
+func (m *WirelessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WirelessConfig.Marshal(b, m, deterministic)
+}
+func (m *WirelessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WirelessConfig.Merge(m, src)
+}
+func (m *WirelessConfig) XXX_Size() int {
+	return xxx_messageInfo_WirelessConfig.Size(m)
+}
+func (m *WirelessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_WirelessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WirelessConfig proto.InternalMessageInfo
+
+func (m *WirelessConfig) GetType() WirelessType {
+	if m != nil {
+		return m.Type
+	}
+	return WirelessType_TypeNOOP
+}
+
+func (m *WirelessConfig) GetWifiCfg() []*WifiConfig {
+	if m != nil {
+		return m.WifiCfg
+	}
+	return nil
+}
+
+func (m *WirelessConfig) GetCellularCfg() []*CellularConfig {
+	if m != nil {
+		return m.CellularCfg
+	}
+	return nil
+}
+
+type CellularConfig struct {
+	APN                  string               `protobuf:"bytes,1,opt,name=APN,proto3" json:"APN,omitempty"`
+	Pin                  string               `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	AuthProtocol         CellularAuthProtocol `protobuf:"varint,3,opt,name=authProtocol,proto3,enum=CellularAuthProtocol" json:"authProtocol,omitempty"`
+	Username             string               `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string               `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	RoamingAllowed       bool                 `protobuf:"varint,6,opt,name=roamingAllowed,proto3" json:"roamingAllowed,omitempty"`
+	PreferredRats        []CellularRAT        `protobuf:"varint,7,rep,packed,name=preferredRats,proto3,enum=CellularRAT" json:"preferredRats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CellularConfig) Reset()         { *m = CellularConfig{} }
+func (m *CellularConfig) String() string { return proto.CompactTextString(m) }
+func (*CellularConfig) ProtoMessage()    {}
+func (*CellularConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc17241cd6d97458, []int{15}
+}
+
+func (m *CellularConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CellularConfig.Unmarshal(m, b)
+}
+func (m *CellularConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CellularConfig.Marshal(b, m, deterministic)
+}
+func (m *CellularConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellularConfig.Merge(m, src)
+}
+func (m *CellularConfig) XXX_Size() int {
+	return xxx_messageInfo_CellularConfig.Size(m)
+}
+func (m *CellularConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellularConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellularConfig proto.InternalMessageInfo
+
+func (m *CellularConfig) GetAPN() string {
+	if m != nil {
+		return m.APN
+	}
+	return ""
+}
+
+func (m *CellularConfig) GetPin() string {
+	if m != nil {
+		return m.Pin
+	}
+	return ""
+}
+
+func (m *CellularConfig) GetAuthProtocol() CellularAuthProtocol {
+	if m != nil {
+		return m.AuthProtocol
+	}
+	return CellularAuthProtocol_CellularAuthNone
+}
+
+func (m *CellularConfig) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CellularConfig) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *CellularConfig) GetRoamingAllowed() bool {
+	if m != nil {
+		return m.RoamingAllowed
+	}
+	return false
+}
+
+func (m *CellularConfig) GetPreferredRats() []CellularRAT {
+	if m != nil {
+		return m.PreferredRats
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("SshRole", SshRole_name, SshRole_value)
+	proto.RegisterEnum("RemoteSessionType", RemoteSessionType_name, RemoteSessionType_value)
 	proto.RegisterEnum("SWAdapterType", SWAdapterType_name, SWAdapterType_value)
+	proto.RegisterEnum("WirelessType", WirelessType_name, WirelessType_value)
+	proto.RegisterEnum("WiFiKeyScheme", WiFiKeyScheme_name, WiFiKeyScheme_value)
+	proto.RegisterEnum("CellularAuthProtocol", CellularAuthProtocol_name, CellularAuthProtocol_value)
+	proto.RegisterEnum("CellularRAT", CellularRAT_name, CellularRAT_value)
 	proto.RegisterType((*MapServer)(nil), "MapServer")
 	proto.RegisterType((*ZedServer)(nil), "ZedServer")
 	proto.RegisterType((*DeviceLispDetails)(nil), "DeviceLispDetails")
@@ -887,84 +1541,142 @@ func init() {
 	proto.RegisterType((*SWAdapterParams)(nil), "sWAdapterParams")
 	proto.RegisterType((*SystemAdapter)(nil), "SystemAdapter")
 	proto.RegisterType((*EdgeDevConfig)(nil), "EdgeDevConfig")
+	proto.RegisterType((*SshPrincipal)(nil), "SshPrincipal")
+	proto.RegisterType((*SshCAConfig)(nil), "SshCAConfig")
+	proto.RegisterType((*RemoteSession)(nil), "RemoteSession")
 	proto.RegisterType((*ConfigItem)(nil), "ConfigItem")
 	proto.RegisterType((*ConfigRequest)(nil), "ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "ConfigResponse")
+	proto.RegisterType((*WifiConfig)(nil), "WifiConfig")
+	proto.RegisterType((*WirelessConfig)(nil), "WirelessConfig")
+	proto.RegisterType((*CellularConfig)(nil), "CellularConfig")
 }
 
 func init() { proto.RegisterFile("devconfig.proto", fileDescriptor_fc17241cd6d97458) }
 
 var fileDescriptor_fc17241cd6d97458 = []byte{
-	// 1126 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0x5f, 0x6f, 0xdb, 0x36,
-	0x10, 0x8f, 0x1d, 0xc7, 0xb5, 0xcf, 0x7f, 0xe2, 0x70, 0x45, 0x21, 0x14, 0x43, 0xeb, 0x19, 0xdd,
-	0x10, 0x14, 0x9b, 0x5c, 0xb8, 0x5d, 0x81, 0x01, 0x7b, 0x49, 0xe2, 0xa0, 0x31, 0x90, 0x25, 0x85,
-	0xd2, 0x76, 0x43, 0xdf, 0x18, 0xe9, 0xec, 0x12, 0x91, 0x49, 0x8d, 0xa4, 0xbc, 0xa6, 0x9f, 0x62,
-	0xef, 0xfb, 0x84, 0xfb, 0x00, 0xc3, 0x5e, 0x07, 0xfe, 0x91, 0x2c, 0x3b, 0xd9, 0x1b, 0xef, 0xf7,
-	0xfb, 0xf1, 0x74, 0x3c, 0xde, 0x1d, 0x05, 0xfb, 0x09, 0xae, 0x62, 0xc1, 0xe7, 0x6c, 0x11, 0x66,
-	0x52, 0x68, 0xf1, 0xd8, 0x01, 0xcb, 0xa5, 0xe0, 0x05, 0x40, 0xb3, 0x6c, 0x43, 0x41, 0xae, 0xa9,
-	0x42, 0xa1, 0x36, 0x77, 0x71, 0xd4, 0x1b, 0x40, 0x4f, 0x69, 0x21, 0xe9, 0x02, 0x4b, 0x13, 0xe5,
-	0x8a, 0xc5, 0xa5, 0xc9, 0x51, 0x33, 0xae, 0xb4, 0x33, 0x47, 0x6f, 0xa0, 0xfd, 0x0b, 0xcd, 0xae,
-	0x50, 0xae, 0x50, 0x92, 0xc7, 0xd0, 0xba, 0xa0, 0x4b, 0xbc, 0x94, 0xb3, 0x2c, 0xa8, 0x0d, 0x6b,
-	0x87, 0xed, 0xa8, 0xb4, 0xc9, 0x13, 0x80, 0x13, 0x89, 0x09, 0x72, 0xcd, 0x68, 0x1a, 0xd4, 0x2d,
-	0x5b, 0x41, 0x46, 0x3f, 0x41, 0xfb, 0x23, 0x26, 0x6b, 0x47, 0x67, 0x42, 0x69, 0xb3, 0xb9, 0x70,
-	0x54, 0xd8, 0x64, 0x00, 0xbb, 0xa7, 0xb3, 0x69, 0x50, 0x1f, 0xee, 0x1e, 0xb6, 0x23, 0xb3, 0x1c,
-	0xfd, 0x5b, 0x87, 0x83, 0x29, 0x9a, 0x18, 0xcf, 0x99, 0xca, 0xa6, 0xa8, 0x29, 0x4b, 0x15, 0x99,
-	0x40, 0xdf, 0x98, 0x65, 0x74, 0x2a, 0xa8, 0x0d, 0x77, 0x0f, 0x3b, 0x13, 0x08, 0x4b, 0x28, 0xda,
-	0x52, 0x90, 0x11, 0x74, 0x0d, 0x32, 0xe3, 0x4a, 0x53, 0x1e, 0xa3, 0x0d, 0xb3, 0x17, 0x6d, 0x60,
-	0xc5, 0xf7, 0x1b, 0x36, 0x2c, 0xb3, 0x34, 0x47, 0x3b, 0x9d, 0x4d, 0xcf, 0xa8, 0xfa, 0x74, 0x8e,
-	0x3c, 0xd8, 0xb3, 0x7b, 0x2a, 0x08, 0x79, 0x0e, 0x50, 0x1e, 0x4d, 0x05, 0x4d, 0x1f, 0x45, 0x09,
-	0x45, 0x15, 0x96, 0xbc, 0x80, 0xaf, 0x4e, 0x59, 0x72, 0x94, 0xa6, 0x22, 0xa6, 0x9a, 0x09, 0xfe,
-	0x56, 0xe2, 0x9c, 0x7d, 0x0e, 0x5a, 0xc3, 0xda, 0x61, 0x37, 0xba, 0x8f, 0x22, 0xaf, 0xe1, 0xd1,
-	0x3d, 0xb0, 0x89, 0xa4, 0x6d, 0x23, 0xf9, 0x1f, 0xd6, 0x5e, 0x48, 0xca, 0x90, 0xeb, 0xa3, 0x24,
-	0x91, 0x01, 0xf8, 0x0b, 0x29, 0x11, 0x93, 0x8b, 0xd3, 0xcf, 0x19, 0x4a, 0xb6, 0x44, 0xae, 0x69,
-	0x1a, 0x3c, 0x1c, 0xd6, 0x0e, 0x5b, 0xd1, 0x06, 0x36, 0x9a, 0x43, 0xd7, 0x25, 0xfe, 0x32, 0x53,
-	0x27, 0xcb, 0x84, 0x04, 0xf0, 0x20, 0x16, 0x39, 0xd7, 0x28, 0x7d, 0xea, 0x0a, 0xd3, 0x78, 0x4b,
-	0x50, 0x31, 0x89, 0xc9, 0x95, 0xa6, 0x1a, 0x83, 0x5d, 0xe7, 0xad, 0x8a, 0x99, 0xdd, 0x22, 0x53,
-	0xef, 0xd8, 0x12, 0x7d, 0x76, 0x0b, 0x73, 0xf4, 0x57, 0x0d, 0xf6, 0xd5, 0xaf, 0x47, 0x09, 0xcd,
-	0x34, 0xca, 0xb7, 0x54, 0xd2, 0xa5, 0x22, 0xcf, 0x60, 0x8f, 0xbe, 0xbb, 0xcd, 0x5c, 0x81, 0xf4,
-	0x27, 0xfd, 0xb0, 0x14, 0x18, 0x34, 0x72, 0x24, 0xf9, 0x1e, 0x0e, 0x72, 0x9e, 0xa0, 0x4c, 0xe9,
-	0xed, 0xcc, 0x04, 0x32, 0xa7, 0x31, 0xda, 0x6c, 0xb6, 0xa3, 0xbb, 0x04, 0x79, 0x04, 0xcd, 0x55,
-	0x4a, 0xf9, 0x2c, 0xf1, 0xb9, 0xf3, 0x16, 0xf9, 0x1a, 0xda, 0xd7, 0x82, 0x27, 0x0b, 0x29, 0xf2,
-	0x2c, 0x00, 0x5b, 0x79, 0x6b, 0x60, 0xf4, 0x77, 0x0d, 0x7a, 0x57, 0xb7, 0x4a, 0xe3, 0xd2, 0x07,
-	0x40, 0x08, 0x34, 0xf8, 0xba, 0x76, 0xed, 0x9a, 0xbc, 0x82, 0x2e, 0x35, 0xd7, 0xe0, 0xeb, 0xd3,
-	0xe6, 0xb3, 0x33, 0x19, 0x84, 0x5b, 0xe7, 0x8a, 0x36, 0x54, 0xe6, 0x96, 0xe6, 0x12, 0xf1, 0x7d,
-	0x96, 0x32, 0x7e, 0x63, 0x93, 0xda, 0x8a, 0x2a, 0x88, 0x89, 0x38, 0x77, 0x9c, 0xcb, 0xa8, 0xb7,
-	0xc8, 0x10, 0x3a, 0x1c, 0xf5, 0x1f, 0x42, 0xde, 0xbc, 0x7f, 0x5f, 0x56, 0x6b, 0x15, 0x32, 0x31,
-	0x52, 0x73, 0xf3, 0x7b, 0x2e, 0x46, 0xb3, 0x36, 0xbb, 0x52, 0xb1, 0x60, 0x31, 0x4d, 0x6d, 0xeb,
-	0x35, 0xdd, 0xae, 0x0a, 0x34, 0xfa, 0xb3, 0x09, 0xbd, 0xd3, 0x64, 0x81, 0x53, 0x5c, 0x9d, 0xd8,
-	0xa1, 0x41, 0x9e, 0x42, 0x9d, 0x25, 0xf6, 0xa4, 0x9d, 0xc9, 0x7e, 0x68, 0x5c, 0x53, 0x9e, 0x7c,
-	0x40, 0xa9, 0x98, 0xe0, 0x51, 0x9d, 0x25, 0xe4, 0xd0, 0x4e, 0x2a, 0xa7, 0xbe, 0xfa, 0x44, 0x27,
-	0x3f, 0xbe, 0xb6, 0xe7, 0xe8, 0x46, 0xdb, 0x30, 0x09, 0x81, 0xac, 0x21, 0xb6, 0xe0, 0x54, 0xe7,
-	0xd2, 0x95, 0x4a, 0x37, 0xba, 0x87, 0x21, 0xdf, 0x41, 0x83, 0x66, 0x99, 0x0a, 0x1a, 0xb6, 0xa5,
-	0x48, 0x78, 0x94, 0x95, 0x6d, 0xea, 0xa4, 0x91, 0xe5, 0xc9, 0x73, 0x68, 0xf9, 0x93, 0xab, 0x60,
-	0xcf, 0x6a, 0xfb, 0xe1, 0x85, 0x03, 0xbc, 0xae, 0xe4, 0xc9, 0x0b, 0x80, 0x84, 0x6a, 0x6a, 0x66,
-	0x20, 0x16, 0xcd, 0x3a, 0x08, 0xa7, 0x05, 0xe4, 0xf5, 0x15, 0x0d, 0x09, 0xa1, 0x95, 0xda, 0x01,
-	0x31, 0x17, 0xc1, 0x03, 0x9b, 0x06, 0x12, 0xde, 0x19, 0x47, 0x51, 0xa9, 0x21, 0xdf, 0x40, 0xc3,
-	0x8c, 0xe1, 0xa0, 0x65, 0x7d, 0xf7, 0xc2, 0x63, 0xaa, 0xf0, 0xf2, 0xaa, 0x08, 0xd8, 0x50, 0xe4,
-	0x5b, 0x68, 0x4a, 0xbc, 0x16, 0x42, 0xdb, 0x3a, 0x34, 0xa2, 0x6a, 0x9b, 0x45, 0x9e, 0x34, 0xb2,
-	0x6b, 0x1a, 0xdf, 0xd8, 0x9a, 0xbc, 0x4f, 0xe6, 0x48, 0xf2, 0x03, 0x74, 0xdc, 0x80, 0x9f, 0x69,
-	0x5c, 0xaa, 0xa0, 0x63, 0xbf, 0xdb, 0x09, 0x4f, 0x4a, 0x2c, 0xaa, 0xf2, 0xe4, 0x67, 0x38, 0x50,
-	0xd5, 0x6a, 0x3e, 0x67, 0x4a, 0x07, 0x5d, 0x9f, 0xb6, 0x8d, 0x3a, 0x8f, 0xee, 0x0a, 0xc9, 0x04,
-	0x5a, 0xfe, 0xc1, 0x50, 0x41, 0xcf, 0x6e, 0x7a, 0x14, 0x5e, 0x39, 0x60, 0xeb, 0x6e, 0x4a, 0x9d,
-	0x19, 0x0e, 0x4b, 0xca, 0xf3, 0x39, 0x8d, 0xcd, 0xb5, 0xca, 0xa0, 0x6f, 0xeb, 0x6e, 0x03, 0x33,
-	0xa5, 0x99, 0x49, 0x91, 0xe4, 0xb1, 0x7b, 0x15, 0xf6, 0x5d, 0x69, 0x56, 0x20, 0x72, 0x0c, 0x03,
-	0x7f, 0x8b, 0xc5, 0x87, 0x54, 0x30, 0xf0, 0x11, 0x5c, 0x6c, 0x12, 0x3e, 0x82, 0x3b, 0x7a, 0xd3,
-	0x6e, 0x68, 0xa6, 0x41, 0x26, 0x99, 0xc2, 0xe0, 0xc0, 0x0d, 0xc5, 0x35, 0x52, 0x36, 0x36, 0x59,
-	0x37, 0xf6, 0xe8, 0x9f, 0x1a, 0xc0, 0x3a, 0x97, 0xe6, 0x7d, 0xb8, 0xc1, 0x5b, 0xdf, 0xfa, 0x66,
-	0x49, 0x1e, 0xc2, 0xde, 0x8a, 0xa6, 0x39, 0xfa, 0x57, 0xcf, 0x19, 0xe4, 0x89, 0x99, 0x29, 0x22,
-	0xfd, 0x60, 0x19, 0xdb, 0xbc, 0x67, 0x3b, 0xd1, 0x1a, 0x22, 0x23, 0xe8, 0xe4, 0x8c, 0xeb, 0x97,
-	0x13, 0xa7, 0x30, 0x1d, 0xdc, 0x3b, 0xdb, 0x89, 0xaa, 0x60, 0xa1, 0x79, 0xfd, 0xca, 0x69, 0x4c,
-	0x2b, 0x37, 0x0a, 0x8d, 0x07, 0xc9, 0x10, 0x60, 0x9e, 0x0a, 0xaa, 0x9d, 0xc4, 0xb4, 0x74, 0xfd,
-	0x6c, 0x27, 0xaa, 0x60, 0xc6, 0x8b, 0xd2, 0x92, 0xf1, 0x85, 0x93, 0x98, 0x1a, 0x6e, 0x1b, 0x2f,
-	0x15, 0xf0, 0xf8, 0x00, 0xf6, 0xd7, 0x35, 0x62, 0xa1, 0xd1, 0x18, 0x7a, 0x3e, 0x8f, 0xf8, 0x7b,
-	0x8e, 0x4a, 0x9b, 0xe4, 0x39, 0x8d, 0x79, 0xf8, 0x7c, 0x02, 0x2a, 0xc8, 0xe8, 0x37, 0xe8, 0x17,
-	0x1b, 0x54, 0x26, 0xb8, 0x32, 0x0d, 0xdc, 0x74, 0xbc, 0x9f, 0x1f, 0xfd, 0x70, 0x63, 0xb6, 0x44,
-	0x9e, 0xdd, 0xf2, 0x5c, 0xdf, 0xf6, 0xfc, 0x7c, 0x0c, 0xbd, 0x8d, 0xe9, 0x4f, 0x00, 0x9a, 0xb3,
-	0x37, 0x17, 0x97, 0xd1, 0xe9, 0x60, 0x87, 0xb4, 0xa0, 0xf1, 0xe1, 0xfc, 0xe8, 0x62, 0x50, 0x33,
-	0xab, 0xe3, 0xcb, 0x8b, 0xe9, 0xa0, 0x7e, 0xfc, 0x06, 0x9e, 0xc6, 0x62, 0x19, 0x7e, 0xc1, 0x04,
-	0x13, 0x1a, 0xc6, 0xa9, 0xc8, 0x93, 0x30, 0xdf, 0xf8, 0xd1, 0xf9, 0xf8, 0x6c, 0xc1, 0xf4, 0xa7,
-	0xfc, 0x3a, 0x8c, 0xc5, 0x72, 0xec, 0x74, 0x63, 0x5c, 0xe1, 0x58, 0x25, 0x37, 0xe3, 0x85, 0x18,
-	0x7f, 0x71, 0x5f, 0xbf, 0x6e, 0x5a, 0xf1, 0xcb, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x02,
-	0x9f, 0x73, 0x8d, 0x09, 0x00, 0x00,
+	// 1961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x58, 0x5f, 0x53, 0xe3, 0xc8,
+	0x11, 0x47, 0xc6, 0x80, 0xdd, 0xfe, 0x83, 0x98, 0xe3, 0xb6, 0x54, 0x64, 0x6b, 0x97, 0xb8, 0xee,
+	0xae, 0x28, 0x72, 0x27, 0x2e, 0xbe, 0xcd, 0xa6, 0xae, 0x2a, 0x0f, 0x31, 0xb6, 0x01, 0x67, 0x59,
+	0xdb, 0x35, 0x32, 0x4b, 0xea, 0x5e, 0xa8, 0xc1, 0x1a, 0x9b, 0x29, 0x64, 0x49, 0x99, 0x91, 0x61,
+	0xb9, 0xd7, 0x3c, 0xe7, 0x03, 0xa4, 0x92, 0x0f, 0x91, 0x0f, 0x93, 0x4f, 0x90, 0x7c, 0x86, 0x54,
+	0x5e, 0x53, 0xf3, 0x47, 0xb2, 0x64, 0xc8, 0x13, 0xd3, 0xbf, 0xfe, 0xcd, 0xa8, 0xbb, 0xa7, 0xbb,
+	0xa7, 0x31, 0xec, 0xfa, 0xf4, 0x61, 0x1a, 0x85, 0x33, 0x36, 0x77, 0x63, 0x1e, 0x25, 0xd1, 0x81,
+	0x06, 0x16, 0x8b, 0x28, 0x4c, 0x01, 0x12, 0xc7, 0x05, 0x06, 0xba, 0x25, 0x82, 0x46, 0xa2, 0xb8,
+	0x2b, 0xa4, 0x49, 0x01, 0x68, 0x88, 0x24, 0xe2, 0x64, 0x4e, 0x33, 0x91, 0xf2, 0x07, 0x36, 0xcd,
+	0xc4, 0x90, 0x26, 0x2c, 0x14, 0x89, 0x11, 0xdf, 0xce, 0xa3, 0x68, 0x1e, 0xd0, 0x13, 0x25, 0xdd,
+	0x2e, 0x67, 0x27, 0x09, 0x5b, 0x50, 0x91, 0x90, 0x45, 0xac, 0x09, 0xad, 0x73, 0xa8, 0x7e, 0x24,
+	0xb1, 0x47, 0xf9, 0x03, 0xe5, 0xe8, 0x00, 0x2a, 0x43, 0xb2, 0xa0, 0x23, 0x3e, 0x88, 0x1d, 0xeb,
+	0xd0, 0x3a, 0xaa, 0xe2, 0x4c, 0x46, 0x6f, 0x00, 0xba, 0x9c, 0xfa, 0x34, 0x4c, 0x18, 0x09, 0x9c,
+	0x92, 0xd2, 0xe6, 0x90, 0xd6, 0x8f, 0x50, 0xfd, 0x89, 0xfa, 0xab, 0x83, 0x2e, 0x22, 0x91, 0xc8,
+	0xcd, 0xe9, 0x41, 0xa9, 0x8c, 0x6c, 0xd8, 0xec, 0x0f, 0x7a, 0x4e, 0xe9, 0x70, 0xf3, 0xa8, 0x8a,
+	0xe5, 0xb2, 0xf5, 0xdf, 0x12, 0xec, 0xf5, 0xa8, 0x74, 0xe2, 0x92, 0x89, 0xb8, 0x47, 0x13, 0xc2,
+	0x02, 0x81, 0xda, 0xd0, 0x94, 0x62, 0x66, 0x9d, 0x70, 0xac, 0xc3, 0xcd, 0xa3, 0x5a, 0x1b, 0xdc,
+	0x0c, 0xc2, 0x6b, 0x0c, 0xd4, 0x82, 0xba, 0x44, 0x06, 0xa1, 0x48, 0x48, 0x38, 0xa5, 0xca, 0xcc,
+	0x06, 0x2e, 0x60, 0xe9, 0xf7, 0xcb, 0xca, 0x2c, 0xb9, 0x94, 0xae, 0xf5, 0x07, 0xbd, 0x0b, 0x22,
+	0xee, 0x2e, 0x69, 0xe8, 0x6c, 0xa9, 0x3d, 0x39, 0x04, 0x1d, 0x03, 0x64, 0xae, 0x09, 0x67, 0xdb,
+	0x58, 0x91, 0x41, 0x38, 0xa7, 0x45, 0xdf, 0xc3, 0x17, 0x7d, 0xe6, 0x77, 0x82, 0x20, 0x9a, 0x92,
+	0x84, 0x45, 0xe1, 0x98, 0xd3, 0x19, 0xfb, 0xec, 0x54, 0x0e, 0xad, 0xa3, 0x3a, 0x7e, 0x49, 0x85,
+	0xde, 0xc3, 0xab, 0x17, 0x60, 0x69, 0x49, 0x55, 0x59, 0xf2, 0x7f, 0xb4, 0xea, 0x42, 0x02, 0x46,
+	0xc3, 0xa4, 0xe3, 0xfb, 0xdc, 0x01, 0x73, 0x21, 0x19, 0x22, 0x63, 0xd1, 0xff, 0x1c, 0x53, 0xce,
+	0x16, 0x34, 0x4c, 0x48, 0xe0, 0xec, 0x1f, 0x5a, 0x47, 0x15, 0x5c, 0xc0, 0x5a, 0x33, 0xa8, 0xeb,
+	0xc0, 0x8f, 0x62, 0xd1, 0x5d, 0xf8, 0xc8, 0x81, 0x9d, 0x69, 0xb4, 0x0c, 0x13, 0xca, 0x4d, 0xe8,
+	0x52, 0x51, 0x9e, 0xe6, 0x53, 0xc1, 0x38, 0xf5, 0xbd, 0x84, 0x24, 0xd4, 0xd9, 0xd4, 0xa7, 0xe5,
+	0x31, 0xb9, 0x3b, 0x8a, 0xc5, 0x84, 0x2d, 0xa8, 0x89, 0x6e, 0x2a, 0xb6, 0xfe, 0x66, 0xc1, 0xae,
+	0xb8, 0xee, 0xf8, 0x24, 0x4e, 0x28, 0x1f, 0x13, 0x4e, 0x16, 0x02, 0x7d, 0x05, 0x5b, 0x64, 0xf2,
+	0x14, 0xeb, 0x04, 0x69, 0xb6, 0x9b, 0x6e, 0x46, 0x90, 0x28, 0xd6, 0x4a, 0xf4, 0x2d, 0xec, 0x2d,
+	0x43, 0x9f, 0xf2, 0x80, 0x3c, 0x0d, 0xa4, 0x21, 0x33, 0x32, 0xa5, 0x2a, 0x9a, 0x55, 0xfc, 0x5c,
+	0x81, 0x5e, 0xc1, 0xf6, 0x43, 0x40, 0xc2, 0x81, 0x6f, 0x62, 0x67, 0x24, 0xf4, 0x1a, 0xaa, 0xb7,
+	0x51, 0xe8, 0xcf, 0x79, 0xb4, 0x8c, 0x1d, 0x50, 0x99, 0xb7, 0x02, 0x5a, 0x7f, 0x2f, 0x41, 0xc3,
+	0x7b, 0x12, 0x09, 0x5d, 0x18, 0x03, 0x10, 0x82, 0x72, 0xb8, 0xca, 0x5d, 0xb5, 0x46, 0xef, 0xa0,
+	0x4e, 0xe4, 0x35, 0x98, 0xfc, 0x54, 0xf1, 0xac, 0xb5, 0x6d, 0x77, 0xcd, 0x2f, 0x5c, 0x60, 0xc9,
+	0x5b, 0x9a, 0x71, 0x4a, 0xaf, 0xe2, 0x80, 0x85, 0xf7, 0x2a, 0xa8, 0x15, 0x9c, 0x43, 0xa4, 0xc5,
+	0x4b, 0xad, 0xd3, 0x11, 0x35, 0x12, 0x3a, 0x84, 0x5a, 0x48, 0x93, 0xc7, 0x88, 0xdf, 0x5f, 0x5d,
+	0x65, 0xd9, 0x9a, 0x87, 0xa4, 0x8d, 0x44, 0xde, 0xfc, 0x96, 0xb6, 0x51, 0xae, 0xe5, 0xae, 0x20,
+	0x9a, 0xb3, 0x29, 0x09, 0x54, 0xe9, 0x6d, 0xeb, 0x5d, 0x39, 0x08, 0xfd, 0x1a, 0x6a, 0x8f, 0x8c,
+	0xd3, 0x80, 0x0a, 0xd1, 0x9d, 0xcd, 0x9d, 0x1d, 0xe5, 0xc4, 0xae, 0x7b, 0x9d, 0x62, 0xaa, 0xd3,
+	0xe0, 0x3c, 0xa7, 0xf5, 0xd7, 0x1d, 0x68, 0xf4, 0xfd, 0x39, 0xed, 0xd1, 0x07, 0xad, 0x46, 0x6f,
+	0xa1, 0xc4, 0x7c, 0xc7, 0x32, 0x7b, 0xa5, 0x35, 0x24, 0xf4, 0x3f, 0x51, 0x2e, 0x58, 0x14, 0xe2,
+	0x12, 0xf3, 0xd1, 0x91, 0xea, 0x7e, 0x9a, 0xed, 0xdd, 0x91, 0xf6, 0x6f, 0xde, 0x2b, 0xd7, 0xeb,
+	0x78, 0x1d, 0x46, 0x2e, 0xa0, 0x15, 0xc4, 0xe6, 0x21, 0x49, 0x96, 0x5c, 0x67, 0x57, 0x1d, 0xbf,
+	0xa0, 0x41, 0xdf, 0x40, 0x99, 0xc4, 0xb1, 0x70, 0xca, 0xaa, 0x0a, 0x91, 0xdb, 0x89, 0xb3, 0xca,
+	0x36, 0xb6, 0x2b, 0x3d, 0x3a, 0x86, 0x8a, 0x09, 0x96, 0x70, 0xb6, 0x14, 0xb7, 0xe9, 0x0e, 0x35,
+	0x60, 0x78, 0x99, 0x1e, 0x7d, 0x0f, 0xe0, 0x93, 0x84, 0xc8, 0xbe, 0x4a, 0xd3, 0xfa, 0xb6, 0xdd,
+	0x5e, 0x0a, 0x19, 0x7e, 0x8e, 0x83, 0x5c, 0xa8, 0x04, 0xaa, 0xa7, 0xcc, 0x22, 0x13, 0x42, 0xe4,
+	0x3e, 0xeb, 0x60, 0x38, 0xe3, 0xa0, 0x5f, 0x42, 0x59, 0xb6, 0x76, 0xa7, 0xa2, 0xce, 0x6e, 0xb8,
+	0xa7, 0x44, 0xd0, 0x91, 0x97, 0x1a, 0x2c, 0x55, 0xe8, 0x6b, 0xd8, 0xe6, 0xf4, 0x36, 0x8a, 0x12,
+	0x95, 0xba, 0x92, 0x94, 0xaf, 0x4c, 0x6c, 0x94, 0x92, 0x76, 0x4b, 0xa6, 0xf7, 0x2a, 0x8d, 0x5f,
+	0xa2, 0x69, 0x25, 0xfa, 0x0e, 0x6a, 0xfa, 0xd1, 0x18, 0x24, 0x74, 0x21, 0x9c, 0x9a, 0xfa, 0x6e,
+	0xcd, 0xed, 0x66, 0x18, 0xce, 0xeb, 0xd1, 0xef, 0x60, 0x4f, 0xe4, 0x0b, 0xe0, 0x92, 0x89, 0xc4,
+	0xa9, 0x9b, 0xb0, 0x15, 0x4a, 0x03, 0x3f, 0x27, 0xa2, 0x36, 0x54, 0xcc, 0x23, 0x24, 0x9c, 0x86,
+	0xda, 0xf4, 0xca, 0xf5, 0x34, 0xb0, 0x76, 0x37, 0x19, 0x4f, 0xf6, 0x93, 0x05, 0x09, 0x97, 0x33,
+	0x32, 0x95, 0xd7, 0xca, 0x9d, 0xa6, 0x4a, 0xd5, 0x02, 0x26, 0xb3, 0x39, 0xe6, 0x91, 0xbf, 0x9c,
+	0xea, 0x87, 0x64, 0x57, 0x67, 0x73, 0x0e, 0x42, 0xa7, 0x60, 0x9b, 0x5b, 0x4c, 0x3f, 0x24, 0x1c,
+	0xdb, 0x58, 0x30, 0x2c, 0x2a, 0x8c, 0x05, 0xcf, 0xf8, 0xb2, 0x42, 0xa9, 0x6c, 0x20, 0x31, 0x67,
+	0x82, 0x3a, 0x7b, 0xba, 0x8f, 0xae, 0x90, 0xac, 0x17, 0xa0, 0x5c, 0x2f, 0xf8, 0x15, 0x54, 0x39,
+	0x0d, 0xe9, 0x63, 0x97, 0xf2, 0xc4, 0xf9, 0xe2, 0xa5, 0x8b, 0x58, 0xe9, 0xd1, 0x7b, 0x68, 0x72,
+	0xba, 0x88, 0x12, 0xea, 0x51, 0x21, 0x2b, 0x44, 0xb6, 0x0e, 0x1d, 0x59, 0x9c, 0x87, 0xf1, 0x1a,
+	0x0b, 0xb5, 0x60, 0x4b, 0x88, 0xbb, 0x6e, 0xc7, 0xf9, 0x52, 0x7d, 0xa0, 0xee, 0x7a, 0x52, 0x32,
+	0x7e, 0x68, 0x55, 0xeb, 0xf7, 0x50, 0xf7, 0xc4, 0xdd, 0x98, 0xb3, 0x70, 0xca, 0x62, 0x12, 0xbc,
+	0xd8, 0xb8, 0x5e, 0x43, 0x99, 0x47, 0x81, 0x7e, 0x0c, 0x9b, 0xed, 0x8a, 0x3c, 0x06, 0x47, 0x01,
+	0xc5, 0x0a, 0x6d, 0xfd, 0xd3, 0x82, 0x5a, 0xee, 0x60, 0x19, 0x8e, 0xa5, 0xa0, 0xbc, 0x4b, 0x3e,
+	0xd0, 0x27, 0xfd, 0xe4, 0x56, 0x71, 0x0e, 0x41, 0xdf, 0x01, 0xc4, 0xe9, 0xe7, 0x84, 0x7a, 0xc5,
+	0xa5, 0xef, 0x79, 0x23, 0x70, 0x8e, 0x20, 0xfb, 0x37, 0xa7, 0x0f, 0xd1, 0x3d, 0xf5, 0xc7, 0xab,
+	0x5d, 0x9b, 0xea, 0xd4, 0xe7, 0x0a, 0x79, 0xe3, 0x06, 0x54, 0x5f, 0x2f, 0x2b, 0x5e, 0x1e, 0x42,
+	0xdf, 0x40, 0xd3, 0x88, 0x1e, 0xe5, 0x8c, 0x04, 0xba, 0xba, 0xcb, 0x78, 0x0d, 0x6d, 0xfd, 0xdb,
+	0x82, 0x46, 0x21, 0xbc, 0xa8, 0x99, 0x35, 0xad, 0xaa, 0xea, 0x51, 0x08, 0xca, 0xd2, 0x2d, 0x33,
+	0xca, 0xa8, 0xb5, 0xec, 0x2e, 0xc9, 0x53, 0xac, 0xfb, 0x4f, 0xb3, 0x8d, 0x8a, 0x17, 0xa4, 0x9e,
+	0x25, 0xa5, 0x97, 0x7b, 0xe3, 0x88, 0x27, 0xaa, 0x2d, 0x37, 0xb0, 0x5a, 0xa3, 0x77, 0xb0, 0x43,
+	0x3f, 0xc7, 0x4c, 0xb6, 0x90, 0x2d, 0x75, 0x61, 0x07, 0xae, 0x1e, 0xbe, 0xdc, 0x74, 0xf8, 0x72,
+	0x27, 0xe9, 0xf0, 0x85, 0x53, 0xaa, 0x9c, 0x94, 0x38, 0x25, 0xfe, 0x28, 0x0c, 0x9e, 0x54, 0xbb,
+	0xae, 0xe0, 0x4c, 0x96, 0xd1, 0x20, 0xab, 0xf6, 0xa6, 0x1a, 0x4d, 0x15, 0xe7, 0xa1, 0xd6, 0x7f,
+	0x2c, 0x80, 0x55, 0x4d, 0xcb, 0xd1, 0xe6, 0x9e, 0x3e, 0x19, 0x1f, 0xe5, 0x12, 0xed, 0xc3, 0xd6,
+	0x03, 0x09, 0x96, 0xd4, 0x78, 0xa9, 0x05, 0xf4, 0x46, 0x3e, 0x87, 0x51, 0xf0, 0x49, 0x69, 0xd4,
+	0xbb, 0x73, 0xb1, 0x81, 0x57, 0x10, 0x6a, 0x41, 0x6d, 0xc9, 0xc2, 0xe4, 0x87, 0xb6, 0x66, 0x28,
+	0x2f, 0x2f, 0x36, 0x70, 0x1e, 0x4c, 0x39, 0xef, 0xdf, 0x69, 0x8e, 0x74, 0xb9, 0x9c, 0x72, 0x0c,
+	0x88, 0x0e, 0x01, 0x66, 0x41, 0x44, 0x12, 0x4d, 0x91, 0xee, 0x95, 0x2e, 0x36, 0x70, 0x0e, 0x93,
+	0xa7, 0x88, 0x84, 0xb3, 0x70, 0xae, 0x29, 0xca, 0x45, 0x79, 0x4a, 0x0e, 0x3c, 0xdd, 0x83, 0xdd,
+	0x55, 0xaf, 0x52, 0x50, 0xeb, 0x04, 0x1a, 0xa6, 0x0e, 0xe8, 0x9f, 0x96, 0x54, 0x24, 0x32, 0x6b,
+	0x35, 0x47, 0xce, 0x6c, 0x26, 0x00, 0x39, 0xa4, 0xf5, 0x47, 0x68, 0xa6, 0x1b, 0x44, 0x1c, 0x85,
+	0x42, 0x3e, 0x24, 0xdb, 0x5a, 0x6f, 0xde, 0xb1, 0xa6, 0x5b, 0x78, 0xe3, 0xb0, 0xd1, 0xae, 0x9d,
+	0x5c, 0x7a, 0x76, 0xf2, 0xbf, 0x2c, 0x80, 0x6b, 0x36, 0x63, 0xa6, 0x7c, 0x0e, 0xa0, 0xf2, 0xc8,
+	0x66, 0xcc, 0xf3, 0x06, 0xbd, 0x74, 0xf2, 0x4d, 0x65, 0xf4, 0x2d, 0x54, 0xef, 0xe9, 0x93, 0x37,
+	0xbd, 0xa3, 0x8b, 0xb4, 0x1a, 0x9b, 0xee, 0x35, 0x3b, 0x63, 0x1f, 0x52, 0x14, 0xaf, 0x08, 0xf2,
+	0x24, 0xa6, 0x86, 0xeb, 0xe4, 0xc9, 0xbc, 0xf1, 0x99, 0x2c, 0x75, 0x31, 0x11, 0xe2, 0x31, 0xe2,
+	0xbe, 0x99, 0xfc, 0x32, 0x59, 0xe9, 0x38, 0x8b, 0xb8, 0xdc, 0x27, 0x67, 0x94, 0x2d, 0x9c, 0xc9,
+	0x72, 0xda, 0x98, 0x12, 0xd5, 0xb4, 0xde, 0xa8, 0x5d, 0x46, 0x92, 0x4e, 0x0a, 0x35, 0xc0, 0xaa,
+	0x46, 0xfb, 0x56, 0x3b, 0xb9, 0x42, 0x5a, 0x7f, 0xb1, 0xa0, 0x59, 0x1c, 0x11, 0xe4, 0x93, 0x96,
+	0xac, 0xa6, 0xb7, 0x46, 0x36, 0x41, 0xe4, 0xaa, 0xe4, 0x6b, 0xd8, 0x91, 0xbe, 0xcb, 0x39, 0xa3,
+	0x64, 0x1e, 0xa0, 0x55, 0xa4, 0x70, 0xaa, 0x93, 0x23, 0xc9, 0x94, 0x06, 0xc1, 0x32, 0x20, 0x5c,
+	0x52, 0x37, 0x15, 0x75, 0xd7, 0xed, 0xa6, 0x98, 0xa6, 0xe7, 0x39, 0xad, 0x3f, 0x97, 0xa0, 0x59,
+	0xd4, 0xcb, 0xdc, 0xef, 0x8c, 0x87, 0x69, 0xee, 0x77, 0xc6, 0x43, 0x89, 0xc4, 0x2c, 0x34, 0x57,
+	0x26, 0x97, 0xe8, 0x47, 0xa8, 0x93, 0x65, 0x72, 0x37, 0x96, 0xf5, 0x38, 0x8d, 0x02, 0x53, 0xe6,
+	0x5f, 0x66, 0x9f, 0xea, 0xe4, 0x94, 0xb8, 0x40, 0x95, 0x51, 0x95, 0x1d, 0x42, 0x35, 0x57, 0x3d,
+	0x8c, 0x65, 0x72, 0xe1, 0x36, 0xb6, 0xd6, 0x6e, 0x43, 0xf6, 0xab, 0x88, 0x2c, 0x58, 0x38, 0x97,
+	0x33, 0xfc, 0x23, 0xf5, 0x4d, 0x95, 0xaf, 0xa1, 0xa8, 0x0d, 0x8d, 0x98, 0xd3, 0x19, 0xe5, 0x9c,
+	0xfa, 0x98, 0x24, 0xc2, 0xd9, 0x39, 0xdc, 0x3c, 0x6a, 0xb6, 0xeb, 0x99, 0x6d, 0xb8, 0x33, 0xc1,
+	0x45, 0xca, 0xf1, 0x1f, 0x60, 0xc7, 0xf4, 0x72, 0xe4, 0xc0, 0xbe, 0xe7, 0x5d, 0xdc, 0xe0, 0xd1,
+	0x65, 0xff, 0xe6, 0x6a, 0xe8, 0x8d, 0xfb, 0xdd, 0xc1, 0xd9, 0xa0, 0xdf, 0xb3, 0x37, 0x10, 0x82,
+	0x66, 0xa6, 0xe9, 0xf5, 0x4f, 0xaf, 0xce, 0x6d, 0x0b, 0xed, 0x41, 0x23, 0xc3, 0xf0, 0x68, 0x34,
+	0xb1, 0x4b, 0xc7, 0xff, 0xb0, 0x60, 0xef, 0x59, 0xb7, 0x43, 0x6f, 0xe0, 0x00, 0xf7, 0x3f, 0x8e,
+	0x26, 0xfd, 0x1b, 0xaf, 0xef, 0x79, 0x83, 0xd1, 0x70, 0xed, 0x70, 0x07, 0xf6, 0xd7, 0xf4, 0xde,
+	0x45, 0xff, 0xf2, 0xd2, 0xb6, 0xd0, 0x5b, 0xf8, 0xc5, 0x9a, 0x66, 0x3c, 0xc2, 0x93, 0x9b, 0xb3,
+	0x11, 0xbe, 0xee, 0xe0, 0x9e, 0x5d, 0x42, 0x87, 0xf0, 0x7a, 0x8d, 0x70, 0x36, 0xb8, 0xec, 0xdf,
+	0x4c, 0x70, 0x67, 0xe8, 0x9d, 0xf5, 0xb1, 0xbd, 0xf9, 0xc2, 0xc7, 0x3b, 0xe3, 0xf1, 0x4d, 0x77,
+	0x34, 0xf4, 0x46, 0x97, 0x7d, 0xbb, 0x7c, 0x7c, 0x02, 0x8d, 0xc2, 0xbf, 0x0c, 0x08, 0x60, 0x7b,
+	0x70, 0x3e, 0x1c, 0xe1, 0xbe, 0xbd, 0x81, 0x2a, 0x50, 0xfe, 0x74, 0xd9, 0x19, 0xda, 0x96, 0x5c,
+	0x9d, 0x8e, 0x86, 0x3d, 0xbb, 0x74, 0xfc, 0x0e, 0xea, 0xf9, 0x2c, 0x45, 0x75, 0xa8, 0xc8, 0xbf,
+	0xc3, 0xd1, 0x68, 0xac, 0x77, 0xc8, 0x5a, 0xb4, 0x2d, 0x89, 0xa7, 0x51, 0xb7, 0x4b, 0xc7, 0xbf,
+	0x85, 0x46, 0xa1, 0x46, 0x51, 0x13, 0x40, 0xaf, 0xcc, 0x46, 0x80, 0xed, 0xeb, 0x71, 0x67, 0xec,
+	0x7d, 0xb0, 0x2d, 0xb3, 0xee, 0x77, 0xc6, 0x76, 0xe9, 0x58, 0xc0, 0xfe, 0x4b, 0x89, 0x85, 0xf6,
+	0xc1, 0xce, 0xe3, 0xc3, 0x28, 0xa4, 0xf6, 0x06, 0xfa, 0x02, 0x76, 0x0b, 0xec, 0xce, 0xd8, 0xb6,
+	0xd6, 0xa9, 0xdd, 0x0b, 0x79, 0x30, 0x3a, 0x80, 0x57, 0x6b, 0x54, 0x12, 0xfa, 0x4a, 0xb7, 0x79,
+	0x7c, 0x06, 0xb5, 0x5c, 0xc6, 0xc8, 0xdb, 0xc7, 0x9d, 0xc9, 0x55, 0x28, 0x62, 0x3a, 0x65, 0x33,
+	0x46, 0x7d, 0x6d, 0x2f, 0xee, 0x4c, 0xce, 0xbd, 0x8f, 0xb6, 0x85, 0x6a, 0xb0, 0x23, 0xf5, 0x1f,
+	0x27, 0x9e, 0x5d, 0x32, 0x8a, 0xcb, 0x49, 0xdf, 0xde, 0x3c, 0x3d, 0x87, 0xb7, 0xd3, 0x68, 0xe1,
+	0xfe, 0x4c, 0x7d, 0xea, 0x13, 0x77, 0x1a, 0x44, 0x4b, 0xdf, 0x5d, 0x16, 0x7e, 0x6a, 0xf8, 0xe9,
+	0xab, 0x39, 0x4b, 0xee, 0x96, 0xb7, 0xee, 0x34, 0x5a, 0x9c, 0x68, 0xde, 0x09, 0x7d, 0xa0, 0x27,
+	0xc2, 0xbf, 0x3f, 0x99, 0x47, 0x27, 0x3f, 0xeb, 0x1e, 0x79, 0xbb, 0xad, 0xc8, 0x3f, 0xfc, 0x6f,
+	0x00, 0xaf, 0x4b, 0x8e, 0x75, 0x0f, 0x11, 0x00, 0x00,
 }
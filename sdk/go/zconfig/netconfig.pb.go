@@ -20,6 +20,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Priority of the traffic from an app relative to other apps on the
+// same network instance. Implemented by the priority of the app when
+// shaping, and by setting the IPv4 TOS field and the IPv6 traffic class.
+type ZQosPriority int32
+
+const (
+	ZQosPriority_QosPriorityNormal ZQosPriority = 0
+	ZQosPriority_QosPriorityHigh   ZQosPriority = 1
+	ZQosPriority_QosPriorityLow    ZQosPriority = 2
+)
+
+var ZQosPriority_name = map[int32]string{
+	0: "QosPriorityNormal",
+	1: "QosPriorityHigh",
+	2: "QosPriorityLow",
+}
+
+var ZQosPriority_value = map[string]int32{
+	"QosPriorityNormal": 0,
+	"QosPriorityHigh":   1,
+	"QosPriorityLow":    2,
+}
+
+func (x ZQosPriority) String() string {
+	return proto.EnumName(ZQosPriority_name, int32(x))
+}
+
+func (ZQosPriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5aa19e8dfa9a5274, []int{0}
+}
+
 type NetworkConfig struct {
 	Id   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type NetworkType `protobuf:"varint,5,opt,name=type,proto3,enum=NetworkType" json:"type,omitempty"`
@@ -109,6 +140,8 @@ type NetworkAdapter struct {
 	MacAddress string `protobuf:"bytes,9,opt,name=macAddress,proto3" json:"macAddress,omitempty"`
 	// firewall
 	Acls                 []*ACE   `protobuf:"bytes,40,rep,name=acls,proto3" json:"acls,omitempty"`
+	Qos                  *AppQos  `protobuf:"bytes,41,opt,name=qos,proto3" json:"qos,omitempty"`
+	VlanTrunk            []uint32 `protobuf:"varint,42,rep,packed,name=vlanTrunk,proto3" json:"vlanTrunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -216,39 +249,137 @@ func (m *NetworkAdapter) GetAcls() []*ACE {
 	return nil
 }
 
+func (m *NetworkAdapter) GetQos() *AppQos {
+	if m != nil {
+		return m.Qos
+	}
+	return nil
+}
+
+func (m *NetworkAdapter) GetVlanTrunk() []uint32 {
+	if m != nil {
+		return m.VlanTrunk
+	}
+	return nil
+}
+
+// Rates are in kbit/s and bursts in kbytes. Zero means no limit.
+// Egress is traffic sent by the app and ingress is traffic sent to the app.
+type AppQos struct {
+	EgressRate           uint32       `protobuf:"varint,1,opt,name=egressRate,proto3" json:"egressRate,omitempty"`
+	EgressBurst          uint32       `protobuf:"varint,2,opt,name=egressBurst,proto3" json:"egressBurst,omitempty"`
+	IngressRate          uint32       `protobuf:"varint,3,opt,name=ingressRate,proto3" json:"ingressRate,omitempty"`
+	IngressBurst         uint32       `protobuf:"varint,4,opt,name=ingressBurst,proto3" json:"ingressBurst,omitempty"`
+	Priority             ZQosPriority `protobuf:"varint,5,opt,name=priority,proto3,enum=ZQosPriority" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AppQos) Reset()         { *m = AppQos{} }
+func (m *AppQos) String() string { return proto.CompactTextString(m) }
+func (*AppQos) ProtoMessage()    {}
+func (*AppQos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa19e8dfa9a5274, []int{2}
+}
+
+func (m *AppQos) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppQos.Unmarshal(m, b)
+}
+func (m *AppQos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppQos.Marshal(b, m, deterministic)
+}
+func (m *AppQos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppQos.Merge(m, src)
+}
+func (m *AppQos) XXX_Size() int {
+	return xxx_messageInfo_AppQos.Size(m)
+}
+func (m *AppQos) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppQos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppQos proto.InternalMessageInfo
+
+func (m *AppQos) GetEgressRate() uint32 {
+	if m != nil {
+		return m.EgressRate
+	}
+	return 0
+}
+
+func (m *AppQos) GetEgressBurst() uint32 {
+	if m != nil {
+		return m.EgressBurst
+	}
+	return 0
+}
+
+func (m *AppQos) GetIngressRate() uint32 {
+	if m != nil {
+		return m.IngressRate
+	}
+	return 0
+}
+
+func (m *AppQos) GetIngressBurst() uint32 {
+	if m != nil {
+		return m.IngressBurst
+	}
+	return 0
+}
+
+func (m *AppQos) GetPriority() ZQosPriority {
+	if m != nil {
+		return m.Priority
+	}
+	return ZQosPriority_QosPriorityNormal
+}
+
 func init() {
+	proto.RegisterEnum("ZQosPriority", ZQosPriority_name, ZQosPriority_value)
 	proto.RegisterType((*NetworkConfig)(nil), "NetworkConfig")
 	proto.RegisterType((*NetworkAdapter)(nil), "NetworkAdapter")
+	proto.RegisterType((*AppQos)(nil), "AppQos")
 }
 
 func init() { proto.RegisterFile("netconfig.proto", fileDescriptor_5aa19e8dfa9a5274) }
 
 var fileDescriptor_5aa19e8dfa9a5274 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0x95, 0x34, 0x5b, 0xdb, 0xb3, 0xac, 0x48, 0xe6, 0x02, 0x6b, 0x42, 0x10, 0x4d, 0x43,
-	0xca, 0x95, 0x23, 0x95, 0x27, 0x28, 0xa3, 0x42, 0xdc, 0x4c, 0x28, 0xe3, 0x6a, 0x77, 0x9e, 0x7d,
-	0xd6, 0x59, 0x6d, 0x6c, 0xcb, 0x76, 0x3b, 0xb2, 0x57, 0xe2, 0x25, 0x78, 0x34, 0x14, 0x3b, 0x94,
-	0xed, 0xee, 0x9c, 0xef, 0xff, 0xfd, 0xdb, 0x3e, 0x3a, 0xf0, 0x46, 0x63, 0x10, 0x46, 0x3f, 0xa8,
-	0x0d, 0xb3, 0xce, 0x04, 0x73, 0x31, 0x7b, 0x78, 0x1a, 0xab, 0x72, 0x90, 0x3a, 0x9d, 0xba, 0xcb,
-	0xdf, 0x19, 0x9c, 0xdf, 0x60, 0x78, 0x32, 0x6e, 0x7b, 0x1d, 0xfd, 0x64, 0x01, 0xb9, 0x92, 0x34,
-	0xab, 0xb2, 0x7a, 0xde, 0xe6, 0x4a, 0x92, 0x0a, 0x8a, 0xd0, 0x5b, 0xa4, 0x27, 0x55, 0x56, 0x2f,
-	0x96, 0x25, 0x1b, 0xdd, 0x3f, 0x7b, 0x8b, 0x6d, 0x54, 0xc8, 0x3b, 0xc8, 0x95, 0xa5, 0xa7, 0x55,
-	0x56, 0x9f, 0x2d, 0xa7, 0x4c, 0x59, 0x6f, 0x51, 0xb4, 0xb9, 0xb2, 0xe4, 0x13, 0x4c, 0xa4, 0xf6,
-	0x74, 0x5a, 0x4d, 0xea, 0xb3, 0xe5, 0x5b, 0x76, 0xa7, 0x31, 0xdc, 0x06, 0x1e, 0x94, 0xf8, 0x7a,
-	0x73, 0xbb, 0xd6, 0xc1, 0xf5, 0xed, 0xa0, 0x93, 0x1a, 0x66, 0xa8, 0xc3, 0x0f, 0x67, 0x7e, 0xf5,
-	0x74, 0x16, 0x53, 0x4a, 0x16, 0xbb, 0xf4, 0xa2, 0xf6, 0xa8, 0x5e, 0xfe, 0xc9, 0x61, 0x31, 0xde,
-	0xbf, 0x92, 0xdc, 0x06, 0x74, 0x84, 0x40, 0xa1, 0x79, 0x87, 0xe3, 0x83, 0x63, 0x3d, 0x7e, 0x21,
-	0x3f, 0x7e, 0xe1, 0x3d, 0xcc, 0x75, 0x3a, 0xf5, 0x5d, 0xd2, 0x49, 0xc4, 0xff, 0xc1, 0x90, 0xc0,
-	0xa5, 0x74, 0xb4, 0x48, 0x09, 0x43, 0x4d, 0x2e, 0x60, 0xf6, 0x68, 0x7c, 0x88, 0xc9, 0x27, 0x91,
-	0x1f, 0xfb, 0x21, 0x4d, 0xb8, 0xde, 0x06, 0xb3, 0x56, 0x92, 0x42, 0x4a, 0x3b, 0x02, 0x72, 0x05,
-	0xe7, 0x3b, 0xe5, 0xad, 0x57, 0x1b, 0xcd, 0xc3, 0xde, 0x61, 0x9c, 0xcb, 0xbc, 0x7d, 0x0d, 0x09,
-	0x85, 0xa9, 0xc5, 0x4e, 0xa0, 0x0b, 0x74, 0x5a, 0x65, 0x75, 0xd9, 0xfe, 0x6b, 0x87, 0xf3, 0x16,
-	0x3b, 0xeb, 0xd4, 0x81, 0x07, 0xdc, 0x62, 0x9a, 0x48, 0xd9, 0xbe, 0x86, 0xe4, 0x03, 0x40, 0xc7,
-	0xc5, 0x4a, 0x4a, 0x87, 0xde, 0xd3, 0x79, 0xbc, 0xe2, 0x05, 0x21, 0x14, 0x0a, 0x2e, 0x76, 0x9e,
-	0xd6, 0x71, 0xf4, 0x05, 0x5b, 0x5d, 0xaf, 0xdb, 0x48, 0xbe, 0x7c, 0x83, 0x8f, 0xc2, 0x74, 0xec,
-	0x19, 0x25, 0x4a, 0xce, 0xc4, 0xce, 0xec, 0x25, 0xdb, 0x7b, 0x74, 0x07, 0x25, 0x30, 0xed, 0xc4,
-	0xdd, 0xd5, 0x46, 0x85, 0xc7, 0xfd, 0x3d, 0x13, 0xa6, 0x6b, 0x92, 0xaf, 0xc1, 0x03, 0x36, 0x5e,
-	0x6e, 0x9b, 0x8d, 0x69, 0x9e, 0xd3, 0x5e, 0xdd, 0x9f, 0x46, 0xf3, 0xe7, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x6e, 0x26, 0x78, 0xa6, 0x6b, 0x02, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x93, 0xd1, 0x4e, 0xdb, 0x30,
+	0x14, 0x86, 0x97, 0xb4, 0xd0, 0xf6, 0x90, 0x14, 0x66, 0x34, 0xcd, 0x43, 0xd3, 0x16, 0x55, 0x4c,
+	0x0a, 0x5c, 0xa4, 0x12, 0x7b, 0x82, 0xc2, 0xd0, 0x36, 0x69, 0xaa, 0xc0, 0x70, 0xc5, 0x9d, 0x89,
+	0x4d, 0xb1, 0xda, 0xd8, 0x9e, 0xed, 0x96, 0x95, 0x57, 0xda, 0x23, 0xec, 0xc9, 0x76, 0x37, 0xc5,
+	0xce, 0xda, 0x70, 0xe7, 0xf3, 0xfd, 0xbf, 0xff, 0x13, 0x1f, 0xc7, 0xb0, 0x2f, 0xb9, 0x2b, 0x95,
+	0x7c, 0x10, 0xb3, 0x42, 0x1b, 0xe5, 0xd4, 0x51, 0xff, 0xe1, 0xa9, 0x59, 0x25, 0xb5, 0x54, 0xc9,
+	0x50, 0x8d, 0x7e, 0x47, 0x90, 0x4e, 0xb9, 0x7b, 0x52, 0x66, 0x7e, 0xe1, 0xfd, 0x68, 0x08, 0xb1,
+	0x60, 0x38, 0xca, 0xa2, 0x7c, 0x40, 0x62, 0xc1, 0x50, 0x06, 0x5d, 0xb7, 0xd6, 0x1c, 0xef, 0x64,
+	0x51, 0x3e, 0x3c, 0x4b, 0x8a, 0xc6, 0x7d, 0xbb, 0xd6, 0x9c, 0x78, 0x05, 0xbd, 0x85, 0x58, 0x68,
+	0xbc, 0x9b, 0x45, 0xf9, 0xde, 0x59, 0xaf, 0x10, 0xda, 0x6a, 0x5e, 0x92, 0x58, 0x68, 0xf4, 0x09,
+	0x3a, 0x4c, 0x5a, 0xdc, 0xcb, 0x3a, 0xf9, 0xde, 0xd9, 0x61, 0x71, 0x27, 0xb9, 0xbb, 0x71, 0xd4,
+	0x89, 0xf2, 0xcb, 0xf4, 0xe6, 0x52, 0x3a, 0xb3, 0x26, 0xb5, 0x8e, 0x72, 0xe8, 0x73, 0xe9, 0xae,
+	0x8c, 0xfa, 0xb5, 0xc6, 0x7d, 0x9f, 0x92, 0x14, 0xbe, 0x0a, 0x5f, 0x44, 0x36, 0xea, 0xe8, 0x6f,
+	0x0c, 0xc3, 0xa6, 0xff, 0x84, 0x51, 0xed, 0xb8, 0x41, 0x08, 0xba, 0x92, 0x56, 0xbc, 0xf9, 0x60,
+	0xbf, 0x6e, 0x8e, 0x10, 0x6f, 0x8e, 0xf0, 0x1e, 0x06, 0x32, 0xec, 0xfa, 0xce, 0x70, 0xc7, 0xe3,
+	0x2d, 0xa8, 0x13, 0x28, 0x63, 0x06, 0x77, 0x43, 0x42, 0xbd, 0x46, 0x47, 0xd0, 0x7f, 0x54, 0xd6,
+	0xf9, 0xe4, 0x1d, 0xcf, 0x37, 0x75, 0x9d, 0x56, 0x9a, 0xb5, 0x76, 0xea, 0x52, 0x30, 0x0c, 0x21,
+	0x6d, 0x03, 0xd0, 0x31, 0xa4, 0x0b, 0x61, 0xb5, 0x15, 0x33, 0x49, 0xdd, 0xd2, 0x70, 0x3f, 0x97,
+	0x01, 0x79, 0x09, 0x11, 0x86, 0x9e, 0xe6, 0x55, 0xc9, 0x8d, 0xc3, 0xbd, 0x2c, 0xca, 0x13, 0xf2,
+	0xbf, 0xac, 0xf7, 0x6b, 0x5e, 0x69, 0x23, 0x56, 0xd4, 0xf1, 0x39, 0x0f, 0x13, 0x49, 0xc8, 0x4b,
+	0x88, 0x3e, 0x00, 0x54, 0xb4, 0x9c, 0x30, 0x66, 0xb8, 0xb5, 0x78, 0xe0, 0x5b, 0xb4, 0x08, 0xc2,
+	0xd0, 0xa5, 0xe5, 0xc2, 0xe2, 0xdc, 0x8f, 0xbe, 0x5b, 0x4c, 0x2e, 0x2e, 0x89, 0x27, 0xe8, 0x1d,
+	0x74, 0x7e, 0x2a, 0x8b, 0x4f, 0x9a, 0xdb, 0x9a, 0x68, 0x7d, 0xad, 0x2c, 0xa9, 0x59, 0x7d, 0xb0,
+	0xd5, 0x82, 0xca, 0x5b, 0xb3, 0x94, 0x73, 0x7c, 0x9a, 0x75, 0xf2, 0x94, 0x6c, 0xc1, 0xe8, 0x4f,
+	0x04, 0xbb, 0xc1, 0x5d, 0x77, 0xe7, 0xb3, 0xba, 0x0f, 0xa1, 0x2e, 0x4c, 0x3e, 0x25, 0x2d, 0x82,
+	0x32, 0xd8, 0x0b, 0xd5, 0xf9, 0xd2, 0x58, 0xe7, 0x2f, 0x22, 0x25, 0x6d, 0x54, 0x3b, 0x84, 0xdc,
+	0x46, 0x74, 0x82, 0xa3, 0x85, 0xd0, 0x08, 0x12, 0x21, 0xb7, 0x3b, 0xfc, 0xed, 0xa4, 0xe4, 0x05,
+	0x43, 0x27, 0xd0, 0xd7, 0x46, 0x28, 0x23, 0xdc, 0xba, 0xf9, 0x3d, 0xd3, 0xe2, 0xee, 0x5a, 0xd9,
+	0xab, 0x06, 0x92, 0x8d, 0x7c, 0x3a, 0x85, 0xa4, 0xad, 0xa0, 0x37, 0xf0, 0xba, 0x55, 0x4e, 0x95,
+	0xa9, 0xe8, 0xe2, 0xe0, 0x15, 0x3a, 0x84, 0xfd, 0x16, 0xfe, 0x26, 0x66, 0x8f, 0x07, 0x11, 0x42,
+	0x30, 0x6c, 0xc1, 0x1f, 0xea, 0xe9, 0x20, 0x3e, 0xff, 0x0a, 0x1f, 0x4b, 0x55, 0x15, 0xcf, 0x9c,
+	0x71, 0x46, 0x8b, 0x72, 0xa1, 0x96, 0xac, 0x58, 0x5a, 0x6e, 0x56, 0xa2, 0xe4, 0xe1, 0x69, 0xdd,
+	0x1d, 0xcf, 0x84, 0x7b, 0x5c, 0xde, 0x17, 0xa5, 0xaa, 0xc6, 0xc1, 0x37, 0xe6, 0x2b, 0x3e, 0xb6,
+	0x6c, 0x3e, 0x9e, 0xa9, 0xf1, 0x73, 0x78, 0x9e, 0xf7, 0xbb, 0xde, 0xfc, 0xf9, 0xdf, 0x00, 0x9f,
+	0x63, 0x7d, 0xd1, 0xb2, 0x03, 0x00, 0x00,
 }
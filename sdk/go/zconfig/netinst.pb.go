@@ -125,6 +125,62 @@ func (ZNetworkOpaqueConfigType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5d61ed8cf2f4078e, []int{2}
 }
 
+type ZNetworkIPv6Mode int32
+
+const (
+	ZNetworkIPv6Mode_IPv6ModeNone   ZNetworkIPv6Mode = 0
+	ZNetworkIPv6Mode_IPv6ModeNAT66  ZNetworkIPv6Mode = 1
+	ZNetworkIPv6Mode_IPv6ModeRouted ZNetworkIPv6Mode = 2
+)
+
+var ZNetworkIPv6Mode_name = map[int32]string{
+	0: "IPv6ModeNone",
+	1: "IPv6ModeNAT66",
+	2: "IPv6ModeRouted",
+}
+
+var ZNetworkIPv6Mode_value = map[string]int32{
+	"IPv6ModeNone":   0,
+	"IPv6ModeNAT66":  1,
+	"IPv6ModeRouted": 2,
+}
+
+func (x ZNetworkIPv6Mode) String() string {
+	return proto.EnumName(ZNetworkIPv6Mode_name, int32(x))
+}
+
+func (ZNetworkIPv6Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d61ed8cf2f4078e, []int{3}
+}
+
+type ZUplinkMode int32
+
+const (
+	ZUplinkMode_UplinkModeFailover ZUplinkMode = 0
+	ZUplinkMode_UplinkModeWeighted ZUplinkMode = 1
+	ZUplinkMode_UplinkModeFlowHash ZUplinkMode = 2
+)
+
+var ZUplinkMode_name = map[int32]string{
+	0: "UplinkModeFailover",
+	1: "UplinkModeWeighted",
+	2: "UplinkModeFlowHash",
+}
+
+var ZUplinkMode_value = map[string]int32{
+	"UplinkModeFailover": 0,
+	"UplinkModeWeighted": 1,
+	"UplinkModeFlowHash": 2,
+}
+
+func (x ZUplinkMode) String() string {
+	return proto.EnumName(ZUplinkMode_name, int32(x))
+}
+
+func (ZUplinkMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d61ed8cf2f4078e, []int{4}
+}
+
 // Network Instance Opaque config. In future we might add more fields here
 // but idea is here. This is service specific configuration.
 type NetworkInstanceOpaqueConfig struct {
@@ -296,10 +352,18 @@ type NetworkInstanceConfig struct {
 	// network ip specification
 	Ip *Ipspec `protobuf:"bytes,40,opt,name=ip,proto3" json:"ip,omitempty"`
 	// static DNS entry, if we are running DNS/DHCP service
-	Dns                  []*ZnetStaticDNSEntry `protobuf:"bytes,41,rep,name=dns,proto3" json:"dns,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Dns []*ZnetStaticDNSEntry `protobuf:"bytes,41,rep,name=dns,proto3" json:"dns,omitempty"`
+	// resolver policy, if we are running DNS/DHCP service
+	DnsPolicy *ZnetDnsPolicy `protobuf:"bytes,42,opt,name=dnsPolicy,proto3" json:"dnsPolicy,omitempty"`
+	// IPv6 for applications on a Local network instance
+	Ip6Mode ZNetworkIPv6Mode `protobuf:"varint,43,opt,name=ip6Mode,proto3,enum=ZNetworkIPv6Mode" json:"ip6Mode,omitempty"`
+	// IPv6 subnet and gateway; derived if not set
+	Ip6                  *Ipspec        `protobuf:"bytes,44,opt,name=ip6,proto3" json:"ip6,omitempty"`
+	VlanTrunk            []uint32       `protobuf:"varint,45,rep,packed,name=vlanTrunk,proto3" json:"vlanTrunk,omitempty"`
+	UplinkPolicy         *ZUplinkPolicy `protobuf:"bytes,46,opt,name=uplinkPolicy,proto3" json:"uplinkPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *NetworkInstanceConfig) Reset()         { *m = NetworkInstanceConfig{} }
@@ -390,62 +454,303 @@ func (m *NetworkInstanceConfig) GetDns() []*ZnetStaticDNSEntry {
 	return nil
 }
 
+func (m *NetworkInstanceConfig) GetDnsPolicy() *ZnetDnsPolicy {
+	if m != nil {
+		return m.DnsPolicy
+	}
+	return nil
+}
+
+func (m *NetworkInstanceConfig) GetIp6Mode() ZNetworkIPv6Mode {
+	if m != nil {
+		return m.Ip6Mode
+	}
+	return ZNetworkIPv6Mode_IPv6ModeNone
+}
+
+func (m *NetworkInstanceConfig) GetIp6() *Ipspec {
+	if m != nil {
+		return m.Ip6
+	}
+	return nil
+}
+
+func (m *NetworkInstanceConfig) GetVlanTrunk() []uint32 {
+	if m != nil {
+		return m.VlanTrunk
+	}
+	return nil
+}
+
+func (m *NetworkInstanceConfig) GetUplinkPolicy() *ZUplinkPolicy {
+	if m != nil {
+		return m.UplinkPolicy
+	}
+	return nil
+}
+
+// DNS resolver policy for the DNS service on a network instance.
+// Domain names match the name itself and all of its subdomains.
+type ZnetDnsPolicy struct {
+	// logQueries - report the individual names resolved by the
+	//    applications in the network instance metrics. The per-application
+	//    query counters are always reported.
+	LogQueries bool `protobuf:"varint,1,opt,name=logQueries,proto3" json:"logQueries,omitempty"`
+	// blockedDomains - names for which the resolver returns NXDOMAIN
+	BlockedDomains []string `protobuf:"bytes,2,rep,name=blockedDomains,proto3" json:"blockedDomains,omitempty"`
+	// allowedDomains - if set, only these names are resolved and all
+	//    other names return NXDOMAIN. blockedDomains still apply to
+	//    subdomains of an allowed domain.
+	AllowedDomains       []string `protobuf:"bytes,3,rep,name=allowedDomains,proto3" json:"allowedDomains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZnetDnsPolicy) Reset()         { *m = ZnetDnsPolicy{} }
+func (m *ZnetDnsPolicy) String() string { return proto.CompactTextString(m) }
+func (*ZnetDnsPolicy) ProtoMessage()    {}
+func (*ZnetDnsPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d61ed8cf2f4078e, []int{3}
+}
+
+func (m *ZnetDnsPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZnetDnsPolicy.Unmarshal(m, b)
+}
+func (m *ZnetDnsPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZnetDnsPolicy.Marshal(b, m, deterministic)
+}
+func (m *ZnetDnsPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZnetDnsPolicy.Merge(m, src)
+}
+func (m *ZnetDnsPolicy) XXX_Size() int {
+	return xxx_messageInfo_ZnetDnsPolicy.Size(m)
+}
+func (m *ZnetDnsPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZnetDnsPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZnetDnsPolicy proto.InternalMessageInfo
+
+func (m *ZnetDnsPolicy) GetLogQueries() bool {
+	if m != nil {
+		return m.LogQueries
+	}
+	return false
+}
+
+func (m *ZnetDnsPolicy) GetBlockedDomains() []string {
+	if m != nil {
+		return m.BlockedDomains
+	}
+	return nil
+}
+
+func (m *ZnetDnsPolicy) GetAllowedDomains() []string {
+	if m != nil {
+		return m.AllowedDomains
+	}
+	return nil
+}
+
+type ZUplinkWeight struct {
+	Port                 string   `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Weight               uint32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZUplinkWeight) Reset()         { *m = ZUplinkWeight{} }
+func (m *ZUplinkWeight) String() string { return proto.CompactTextString(m) }
+func (*ZUplinkWeight) ProtoMessage()    {}
+func (*ZUplinkWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d61ed8cf2f4078e, []int{4}
+}
+
+func (m *ZUplinkWeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZUplinkWeight.Unmarshal(m, b)
+}
+func (m *ZUplinkWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZUplinkWeight.Marshal(b, m, deterministic)
+}
+func (m *ZUplinkWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZUplinkWeight.Merge(m, src)
+}
+func (m *ZUplinkWeight) XXX_Size() int {
+	return xxx_messageInfo_ZUplinkWeight.Size(m)
+}
+func (m *ZUplinkWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZUplinkWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZUplinkWeight proto.InternalMessageInfo
+
+func (m *ZUplinkWeight) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *ZUplinkWeight) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type ZUplinkPolicy struct {
+	Mode                 ZUplinkMode      `protobuf:"varint,1,opt,name=mode,proto3,enum=ZUplinkMode" json:"mode,omitempty"`
+	Weights              []*ZUplinkWeight `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights,omitempty"`
+	ProbeAddr            string           `protobuf:"bytes,3,opt,name=probeAddr,proto3" json:"probeAddr,omitempty"`
+	ProbeInterval        uint32           `protobuf:"varint,4,opt,name=probeInterval,proto3" json:"probeInterval,omitempty"`
+	ProbeFailures        uint32           `protobuf:"varint,5,opt,name=probeFailures,proto3" json:"probeFailures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ZUplinkPolicy) Reset()         { *m = ZUplinkPolicy{} }
+func (m *ZUplinkPolicy) String() string { return proto.CompactTextString(m) }
+func (*ZUplinkPolicy) ProtoMessage()    {}
+func (*ZUplinkPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d61ed8cf2f4078e, []int{5}
+}
+
+func (m *ZUplinkPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZUplinkPolicy.Unmarshal(m, b)
+}
+func (m *ZUplinkPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZUplinkPolicy.Marshal(b, m, deterministic)
+}
+func (m *ZUplinkPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZUplinkPolicy.Merge(m, src)
+}
+func (m *ZUplinkPolicy) XXX_Size() int {
+	return xxx_messageInfo_ZUplinkPolicy.Size(m)
+}
+func (m *ZUplinkPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZUplinkPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZUplinkPolicy proto.InternalMessageInfo
+
+func (m *ZUplinkPolicy) GetMode() ZUplinkMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ZUplinkMode_UplinkModeFailover
+}
+
+func (m *ZUplinkPolicy) GetWeights() []*ZUplinkWeight {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *ZUplinkPolicy) GetProbeAddr() string {
+	if m != nil {
+		return m.ProbeAddr
+	}
+	return ""
+}
+
+func (m *ZUplinkPolicy) GetProbeInterval() uint32 {
+	if m != nil {
+		return m.ProbeInterval
+	}
+	return 0
+}
+
+func (m *ZUplinkPolicy) GetProbeFailures() uint32 {
+	if m != nil {
+		return m.ProbeFailures
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ZNetworkInstType", ZNetworkInstType_name, ZNetworkInstType_value)
 	proto.RegisterEnum("AddressType", AddressType_name, AddressType_value)
 	proto.RegisterEnum("ZNetworkOpaqueConfigType", ZNetworkOpaqueConfigType_name, ZNetworkOpaqueConfigType_value)
+	proto.RegisterEnum("ZNetworkIPv6Mode", ZNetworkIPv6Mode_name, ZNetworkIPv6Mode_value)
+	proto.RegisterEnum("ZUplinkMode", ZUplinkMode_name, ZUplinkMode_value)
 	proto.RegisterType((*NetworkInstanceOpaqueConfig)(nil), "NetworkInstanceOpaqueConfig")
 	proto.RegisterType((*NetworkInstanceLispConfig)(nil), "NetworkInstanceLispConfig")
 	proto.RegisterType((*NetworkInstanceConfig)(nil), "NetworkInstanceConfig")
+	proto.RegisterType((*ZnetDnsPolicy)(nil), "ZnetDnsPolicy")
+	proto.RegisterType((*ZUplinkWeight)(nil), "ZUplinkWeight")
+	proto.RegisterType((*ZUplinkPolicy)(nil), "ZUplinkPolicy")
 }
 
 func init() { proto.RegisterFile("netinst.proto", fileDescriptor_5d61ed8cf2f4078e) }
 
 var fileDescriptor_5d61ed8cf2f4078e = []byte{
-	// 711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xed, 0x4e, 0xc3, 0x36,
-	0x14, 0x86, 0x49, 0xbf, 0x68, 0x4f, 0x3f, 0x30, 0x2e, 0x13, 0x81, 0xa1, 0xad, 0xaa, 0xd8, 0x56,
-	0x90, 0x48, 0xa7, 0x6e, 0x62, 0xd2, 0xfe, 0xb1, 0xb2, 0x8f, 0x4a, 0x50, 0xaa, 0x14, 0x98, 0xd4,
-	0x7f, 0xc6, 0x31, 0xc5, 0x22, 0xb5, 0xbd, 0xd8, 0x2d, 0x94, 0xcb, 0xd9, 0x25, 0x6c, 0x57, 0xb6,
-	0x2b, 0xd8, 0x14, 0x27, 0xa9, 0x4a, 0x37, 0xf6, 0xcf, 0xe7, 0x39, 0x6f, 0x4e, 0xde, 0xbc, 0xb6,
-	0x03, 0x75, 0xc1, 0x0c, 0x17, 0xda, 0x78, 0x2a, 0x92, 0x46, 0x1e, 0xee, 0x04, 0x6c, 0x41, 0xe5,
-	0x6c, 0x26, 0x45, 0x0a, 0x6a, 0x82, 0x19, 0x3a, 0x4b, 0xab, 0xf6, 0xef, 0x0e, 0x7c, 0x3a, 0x64,
-	0xe6, 0x45, 0x46, 0xcf, 0x03, 0xa1, 0x0d, 0x11, 0x94, 0xdd, 0x28, 0xf2, 0xdb, 0x9c, 0xf5, 0xa5,
-	0x78, 0xe4, 0x53, 0xec, 0xc2, 0xb6, 0xa4, 0x76, 0xe9, 0x3a, 0x2d, 0xa7, 0x53, 0xf1, 0xb3, 0x12,
-	0x7f, 0x0f, 0x10, 0x72, 0xad, 0x12, 0x9d, 0x9b, 0x6b, 0x39, 0x9d, 0x6a, 0xef, 0xd0, 0xdb, 0x98,
-	0x75, 0xb5, 0x52, 0xf8, 0x6b, 0x6a, 0x7c, 0x06, 0x05, 0xb3, 0x54, 0xcc, 0xcd, 0xb7, 0x9c, 0x4e,
-	0xa3, 0x77, 0xe0, 0x4d, 0xd2, 0xc7, 0xd6, 0x5f, 0x7d, 0xbb, 0x54, 0xcc, 0xb7, 0xb2, 0xf6, 0x1f,
-	0x39, 0x38, 0xf8, 0x70, 0x30, 0x3e, 0x81, 0xed, 0xb8, 0xba, 0x1e, 0x6b, 0xd7, 0x69, 0xe5, 0x3b,
-	0xd5, 0xde, 0x8e, 0x37, 0xa1, 0x63, 0x16, 0x2d, 0x38, 0x65, 0x23, 0xc9, 0x85, 0xf1, 0xb3, 0x3e,
-	0xfe, 0x12, 0x1a, 0xf1, 0x32, 0x1b, 0x32, 0x08, 0xac, 0xef, 0xba, 0xbf, 0x41, 0xf1, 0x21, 0x94,
-	0x49, 0x18, 0x4a, 0x4a, 0x4c, 0xe2, 0xb1, 0xec, 0xaf, 0x6a, 0x7c, 0x0c, 0x75, 0xf6, 0xaa, 0x64,
-	0x64, 0x54, 0xc4, 0x17, 0xb1, 0xa0, 0x60, 0x05, 0xef, 0x21, 0x3e, 0x05, 0x94, 0x3e, 0xc1, 0xa5,
-	0x50, 0x11, 0x7b, 0xe4, 0xaf, 0x6e, 0xb1, 0xe5, 0x74, 0x6a, 0xfe, 0xbf, 0x38, 0xfe, 0x1a, 0x9a,
-	0x9b, 0x2c, 0x64, 0xc2, 0x2d, 0x59, 0x6b, 0xff, 0xd5, 0xc2, 0x6d, 0xa8, 0xb1, 0x57, 0xc5, 0x22,
-	0x3e, 0x63, 0xc2, 0x90, 0xd0, 0xdd, 0xb3, 0x16, 0xde, 0xb1, 0xf6, 0x5f, 0x39, 0xf8, 0x64, 0x23,
-	0xb4, 0x34, 0xb0, 0xef, 0xa0, 0x31, 0x9f, 0xf3, 0x80, 0x88, 0x60, 0xc1, 0x22, 0xcd, 0xa5, 0xb0,
-	0x5b, 0x1b, 0xe7, 0x76, 0x77, 0x37, 0xb8, 0x24, 0x22, 0xb8, 0x4f, 0xb0, 0xbf, 0x21, 0xc3, 0x2d,
-	0xa8, 0x06, 0x5c, 0xab, 0x90, 0x2c, 0x05, 0x99, 0x31, 0x9b, 0x5d, 0xc5, 0x5f, 0x47, 0xf8, 0x0c,
-	0xca, 0xf1, 0xd9, 0x8b, 0xf7, 0xce, 0xe6, 0xd2, 0xe8, 0xed, 0xae, 0x36, 0x77, 0x90, 0x36, 0xfc,
-	0x95, 0xc4, 0xe6, 0x4c, 0x4d, 0x12, 0x63, 0x31, 0xcd, 0x39, 0xad, 0xf1, 0x11, 0x14, 0xe2, 0x40,
-	0xed, 0xb7, 0x55, 0x7b, 0x65, 0xef, 0x22, 0x20, 0xca, 0xb0, 0xc8, 0xb7, 0x14, 0x7b, 0x90, 0xa7,
-	0x8f, 0x53, 0xf7, 0x33, 0xdb, 0x3c, 0xf2, 0xfe, 0xe7, 0x08, 0xfb, 0xb1, 0x10, 0x1f, 0x43, 0x89,
-	0x2b, 0x6b, 0xeb, 0x2b, 0x6b, 0xab, 0xe6, 0x5d, 0x04, 0x41, 0xc4, 0xb4, 0xb6, 0x8e, 0xd2, 0x1e,
-	0xde, 0x87, 0x1c, 0x57, 0x6e, 0xc7, 0x0e, 0xdd, 0xf6, 0xb8, 0xd2, 0x8a, 0x51, 0x3f, 0xc7, 0x15,
-	0xfe, 0x02, 0xf2, 0x81, 0xd0, 0xee, 0x89, 0x3d, 0x5f, 0x4d, 0x6f, 0x22, 0x98, 0x19, 0x1b, 0x62,
-	0x38, 0xbd, 0x1c, 0x8e, 0x7f, 0x14, 0x26, 0x5a, 0xfa, 0x71, 0xff, 0xf4, 0x4f, 0x07, 0xd0, 0xe6,
-	0xe7, 0xe2, 0x5d, 0xa8, 0xc7, 0x2c, 0xae, 0x7f, 0xe2, 0x91, 0x36, 0x68, 0x0b, 0x63, 0x68, 0xc4,
-	0x23, 0x62, 0x34, 0x7e, 0xe1, 0x86, 0x3e, 0x21, 0xc7, 0xca, 0x52, 0x76, 0x25, 0x29, 0x09, 0x51,
-	0x6e, 0x1d, 0xf5, 0x43, 0x39, 0x0f, 0x50, 0x1e, 0x23, 0xa8, 0x65, 0xe8, 0x9a, 0xe9, 0x27, 0x54,
-	0xc0, 0x7b, 0x80, 0x32, 0xf2, 0x8b, 0x14, 0x6c, 0x39, 0x92, 0x06, 0x15, 0xf1, 0x3e, 0x34, 0x33,
-	0x7a, 0x1b, 0x11, 0xa1, 0x15, 0x89, 0x98, 0x30, 0xa8, 0x84, 0x77, 0xa1, 0x96, 0xb9, 0xb9, 0x22,
-	0xda, 0xa0, 0xbf, 0x9d, 0xd3, 0x5f, 0xa1, 0xba, 0x16, 0x06, 0xae, 0x40, 0x31, 0xf3, 0x59, 0x86,
-	0xc2, 0x60, 0x74, 0xff, 0x2d, 0x72, 0xd2, 0xd5, 0x39, 0xca, 0xe1, 0x06, 0x40, 0x3f, 0x5a, 0x2a,
-	0x23, 0x6d, 0x27, 0xff, 0xae, 0x3e, 0x47, 0x05, 0x5c, 0x81, 0x42, 0x36, 0xb8, 0x0f, 0xee, 0x47,
-	0x37, 0xdb, 0x46, 0x30, 0x64, 0xe6, 0x26, 0x41, 0xf7, 0xa3, 0x21, 0xda, 0xc2, 0x4d, 0xd8, 0x59,
-	0x63, 0xf1, 0x9d, 0x44, 0xce, 0x0f, 0x3f, 0xc3, 0xe7, 0x54, 0xce, 0xbc, 0x37, 0x16, 0xb0, 0x80,
-	0x78, 0x34, 0xce, 0xc1, 0x9b, 0xeb, 0xe4, 0x7a, 0x27, 0x3f, 0xb1, 0xc9, 0xf1, 0x94, 0x9b, 0xa7,
-	0xf9, 0x83, 0x47, 0xe5, 0xac, 0x9b, 0xe8, 0xba, 0x6c, 0xc1, 0xba, 0x3a, 0x78, 0xee, 0x4e, 0x65,
-	0xf7, 0x2d, 0xf9, 0x61, 0x3d, 0x94, 0xac, 0xf8, 0x9b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x29,
-	0xfe, 0xf8, 0xb3, 0x21, 0x05, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdd, 0x72, 0xe3, 0xb4,
+	0x1b, 0xc6, 0xeb, 0x24, 0x6d, 0x93, 0x37, 0x1f, 0xab, 0xaa, 0xfb, 0xdf, 0x75, 0xfb, 0xef, 0x2c,
+	0x99, 0x4c, 0x81, 0x6c, 0x76, 0xeb, 0x32, 0x81, 0x09, 0x33, 0x70, 0x54, 0x5a, 0x96, 0xed, 0xd0,
+	0x76, 0x83, 0xfa, 0xb1, 0x33, 0x39, 0x73, 0x6d, 0x35, 0xd5, 0xd4, 0x91, 0x84, 0x25, 0xa7, 0xcd,
+	0x9e, 0x70, 0x2f, 0x5c, 0x02, 0x1c, 0x71, 0x01, 0x5c, 0x17, 0x8c, 0x64, 0x3b, 0x75, 0x02, 0xcb,
+	0x99, 0xde, 0xdf, 0xfb, 0x58, 0x79, 0xf2, 0xe8, 0x95, 0x0d, 0x4d, 0x4e, 0x35, 0xe3, 0x4a, 0x7b,
+	0x32, 0x16, 0x5a, 0x6c, 0x3f, 0x09, 0xe9, 0x34, 0x10, 0x93, 0x89, 0xe0, 0x19, 0x68, 0x70, 0xaa,
+	0x83, 0x49, 0x56, 0x75, 0x7e, 0x75, 0xe0, 0xff, 0x67, 0x54, 0xdf, 0x8b, 0xf8, 0xee, 0x98, 0x2b,
+	0xed, 0xf3, 0x80, 0xbe, 0x93, 0xfe, 0xcf, 0x09, 0x3d, 0x14, 0xfc, 0x86, 0x8d, 0xb1, 0x0b, 0xeb,
+	0x22, 0xb0, 0x4b, 0xd7, 0x69, 0x3b, 0xdd, 0x1a, 0xc9, 0x4b, 0xfc, 0x0d, 0x40, 0xc4, 0x94, 0x4c,
+	0x75, 0x6e, 0xa9, 0xed, 0x74, 0xeb, 0xfd, 0x6d, 0x6f, 0x69, 0xaf, 0x93, 0xb9, 0x82, 0x14, 0xd4,
+	0x78, 0x0f, 0x2a, 0x7a, 0x26, 0xa9, 0x5b, 0x6e, 0x3b, 0xdd, 0x56, 0x7f, 0xcb, 0x1b, 0x65, 0x8f,
+	0x15, 0x7f, 0xfa, 0x62, 0x26, 0x29, 0xb1, 0xb2, 0xce, 0x6f, 0x25, 0xd8, 0xfa, 0xe8, 0xc6, 0xf8,
+	0x25, 0xac, 0x9b, 0xea, 0xf4, 0x5c, 0xb9, 0x4e, 0xbb, 0xdc, 0xad, 0xf7, 0x9f, 0x78, 0xa3, 0xe0,
+	0x9c, 0xc6, 0x53, 0x16, 0xd0, 0xa1, 0x60, 0x5c, 0x93, 0xbc, 0x8f, 0x3f, 0x83, 0x96, 0x59, 0xe6,
+	0x9b, 0x1c, 0x87, 0xd6, 0x77, 0x93, 0x2c, 0x51, 0xbc, 0x0d, 0x55, 0x3f, 0x8a, 0x44, 0xe0, 0xeb,
+	0xd4, 0x63, 0x95, 0xcc, 0x6b, 0xbc, 0x0b, 0x4d, 0xfa, 0x20, 0x45, 0xac, 0x65, 0xcc, 0xa6, 0x46,
+	0x50, 0xb1, 0x82, 0x45, 0x88, 0x7b, 0x80, 0xb2, 0x27, 0x98, 0xe0, 0x32, 0xa6, 0x37, 0xec, 0xc1,
+	0x5d, 0x6d, 0x3b, 0xdd, 0x06, 0xf9, 0x07, 0xc7, 0x5f, 0xc0, 0xe6, 0x32, 0x8b, 0x28, 0x77, 0xd7,
+	0xac, 0xb5, 0x7f, 0x6b, 0xe1, 0x0e, 0x34, 0xe8, 0x83, 0xa4, 0x31, 0x9b, 0x50, 0xae, 0xfd, 0xc8,
+	0x7d, 0x6a, 0x2d, 0x2c, 0xb0, 0xce, 0x1f, 0x15, 0xf8, 0xdf, 0x52, 0x68, 0x59, 0x60, 0x5f, 0x43,
+	0x2b, 0x49, 0x58, 0xe8, 0xf3, 0x70, 0x4a, 0x63, 0xc5, 0x04, 0xb7, 0x47, 0x6b, 0x72, 0xbb, 0xbc,
+	0x3c, 0x3e, 0xf2, 0x79, 0x78, 0x95, 0x62, 0xb2, 0x24, 0xc3, 0x6d, 0xa8, 0x87, 0x4c, 0xc9, 0xc8,
+	0x9f, 0x71, 0x7f, 0x42, 0x6d, 0x76, 0x35, 0x52, 0x44, 0x78, 0x0f, 0xaa, 0x66, 0xf6, 0xcc, 0xd9,
+	0xd9, 0x5c, 0x5a, 0xfd, 0x0d, 0x6f, 0x54, 0x70, 0x61, 0x0f, 0x75, 0x2e, 0xb1, 0x39, 0x07, 0x3a,
+	0x8d, 0x71, 0x35, 0xcb, 0x39, 0xab, 0xf1, 0x0e, 0x54, 0x4c, 0xa0, 0xf6, 0xbf, 0xd5, 0xfb, 0x55,
+	0xef, 0x20, 0xf4, 0xa5, 0xa6, 0x31, 0xb1, 0x14, 0x7b, 0x50, 0x0e, 0x6e, 0xc6, 0xee, 0x0b, 0xdb,
+	0xdc, 0xf1, 0xfe, 0x63, 0x84, 0x89, 0x11, 0xe2, 0x5d, 0x58, 0x63, 0xd2, 0xda, 0xfa, 0xdc, 0xda,
+	0x6a, 0x78, 0x07, 0x61, 0x18, 0x53, 0xa5, 0xac, 0xa3, 0xac, 0x87, 0x9f, 0x43, 0x89, 0x49, 0xb7,
+	0x6b, 0x37, 0x5d, 0xf7, 0x98, 0x54, 0x92, 0x06, 0xa4, 0xc4, 0x24, 0xfe, 0x14, 0xca, 0x21, 0x57,
+	0xee, 0x4b, 0x3b, 0x5f, 0x9b, 0xde, 0x88, 0x53, 0x7d, 0xae, 0x7d, 0xcd, 0x82, 0xa3, 0xb3, 0xf3,
+	0xef, 0xb9, 0x8e, 0x67, 0xc4, 0xf4, 0xf1, 0x6b, 0xa8, 0x85, 0x5c, 0x0d, 0x45, 0xc4, 0x82, 0x99,
+	0xdb, 0xb3, 0xdb, 0xb4, 0xac, 0xf8, 0x28, 0xa7, 0xe4, 0x51, 0x80, 0x5f, 0xc1, 0x3a, 0x93, 0x83,
+	0x53, 0x11, 0x52, 0xf7, 0xd5, 0x72, 0x56, 0xc3, 0xa9, 0x6d, 0x90, 0x5c, 0x81, 0xb7, 0xa0, 0xcc,
+	0xe4, 0xc0, 0x7d, 0xbd, 0xe8, 0xcd, 0x30, 0xbc, 0x03, 0xb5, 0x69, 0xe4, 0xf3, 0x8b, 0x38, 0xe1,
+	0x77, 0xee, 0x5e, 0xbb, 0xdc, 0x6d, 0x92, 0x47, 0x80, 0xfb, 0xd0, 0x48, 0x64, 0xc4, 0xf8, 0x5d,
+	0x66, 0xcb, 0xcb, 0x6d, 0x5d, 0x16, 0x28, 0x59, 0xd0, 0x74, 0x7e, 0x81, 0xe6, 0x82, 0x6b, 0xfc,
+	0x02, 0x20, 0x12, 0xe3, 0x9f, 0x12, 0x1a, 0x33, 0xaa, 0xec, 0xb8, 0x54, 0x49, 0x81, 0x98, 0x8b,
+	0x75, 0x1d, 0x89, 0xe0, 0x8e, 0x86, 0x47, 0x62, 0xe2, 0x33, 0xae, 0xdc, 0x52, 0xbb, 0xdc, 0xad,
+	0x91, 0x25, 0x6a, 0x74, 0x66, 0x9e, 0xef, 0x1f, 0x75, 0xe5, 0x54, 0xb7, 0x48, 0x3b, 0xdf, 0x42,
+	0x33, 0xf3, 0xf7, 0x9e, 0xb2, 0xf1, 0xad, 0xc6, 0x38, 0x9b, 0x86, 0xf4, 0x25, 0x64, 0xd7, 0xf8,
+	0x19, 0xac, 0xdd, 0xdb, 0x6e, 0x76, 0x8b, 0xb3, 0xaa, 0xf3, 0xa7, 0x33, 0x7f, 0x3a, 0xb3, 0xdf,
+	0x86, 0xca, 0xc4, 0xc4, 0xec, 0x64, 0x67, 0x9f, 0x75, 0x6d, 0xc2, 0xb6, 0x83, 0xbb, 0xb0, 0x9e,
+	0x3e, 0x9d, 0x3a, 0x2f, 0x04, 0x94, 0x1a, 0x20, 0x79, 0xdb, 0xa4, 0x2d, 0x63, 0x71, 0x4d, 0xcd,
+	0xfc, 0xd8, 0x97, 0x43, 0x8d, 0x3c, 0x02, 0xf3, 0x76, 0xb0, 0xc5, 0x31, 0xd7, 0x34, 0x9e, 0xfa,
+	0x91, 0xbd, 0x05, 0x4d, 0xb2, 0x08, 0xe7, 0xaa, 0x37, 0x3e, 0x8b, 0x92, 0x98, 0x2a, 0x77, 0xb5,
+	0xa0, 0xca, 0x61, 0xef, 0x77, 0x07, 0xd0, 0xf2, 0xe5, 0xc1, 0x1b, 0xd0, 0x34, 0xcc, 0xd4, 0x6f,
+	0x58, 0xac, 0x34, 0x5a, 0xc1, 0x18, 0x5a, 0x23, 0x9e, 0xa2, 0xf3, 0x7b, 0xa6, 0x83, 0x5b, 0xe4,
+	0x58, 0x59, 0xc6, 0x4e, 0x44, 0xe0, 0x47, 0xa8, 0x54, 0x44, 0x87, 0x91, 0x48, 0x42, 0x54, 0xc6,
+	0x08, 0x1a, 0x39, 0x3a, 0xa5, 0xea, 0x16, 0x55, 0xf0, 0x53, 0x40, 0x39, 0x79, 0x2b, 0x38, 0x9d,
+	0x0d, 0x85, 0x46, 0xab, 0xf8, 0x39, 0x6c, 0xe6, 0xf4, 0x22, 0xf6, 0xb9, 0x92, 0x7e, 0x4c, 0xb9,
+	0x46, 0x6b, 0x78, 0x03, 0x1a, 0xb9, 0x9b, 0x13, 0x5f, 0x69, 0xf4, 0x97, 0xd3, 0x7b, 0x0f, 0xf5,
+	0xc2, 0xd5, 0xc2, 0x35, 0x58, 0xcd, 0x7d, 0x56, 0xa1, 0x72, 0x3c, 0xbc, 0xfa, 0x0a, 0x39, 0xd9,
+	0x6a, 0x80, 0x4a, 0xb8, 0x05, 0x70, 0x18, 0xcf, 0xa4, 0x16, 0xb6, 0x53, 0x5e, 0xa8, 0x07, 0xa8,
+	0x82, 0x6b, 0x50, 0xc9, 0x37, 0x3e, 0x04, 0xf7, 0x63, 0xdf, 0x09, 0x1b, 0xc1, 0x19, 0xd5, 0xef,
+	0x52, 0x74, 0x35, 0x3c, 0x43, 0x2b, 0x78, 0x13, 0x9e, 0x14, 0x98, 0x79, 0xc3, 0x23, 0xa7, 0xf7,
+	0x23, 0xa0, 0xe5, 0x3b, 0x66, 0x52, 0xc8, 0xd7, 0x67, 0x82, 0x53, 0xb4, 0x62, 0xa2, 0x9a, 0x93,
+	0x83, 0x8b, 0xc1, 0x00, 0x39, 0xe6, 0x17, 0xe6, 0x97, 0x52, 0x24, 0x9a, 0x86, 0xa8, 0xd4, 0xbb,
+	0x84, 0x7a, 0x61, 0x92, 0xf0, 0x33, 0xc0, 0x8f, 0x95, 0x39, 0x45, 0x31, 0xa5, 0x31, 0x5a, 0x59,
+	0xe4, 0xe9, 0x38, 0xd1, 0x10, 0x39, 0x4b, 0xfa, 0x48, 0xdc, 0xbf, 0xf5, 0xd5, 0x2d, 0x2a, 0x7d,
+	0xf7, 0x03, 0x7c, 0x12, 0x88, 0x89, 0xf7, 0x81, 0x86, 0x34, 0xf4, 0xbd, 0xc0, 0x9c, 0x95, 0x97,
+	0xa8, 0xf4, 0x83, 0x96, 0x7e, 0xb6, 0x47, 0xbb, 0x63, 0xa6, 0x6f, 0x93, 0x6b, 0x2f, 0x10, 0x93,
+	0xfd, 0x54, 0xb7, 0x4f, 0xa7, 0x74, 0x5f, 0x85, 0x77, 0xfb, 0x63, 0xb1, 0xff, 0x21, 0xfd, 0x44,
+	0x5f, 0xaf, 0x59, 0xf1, 0x97, 0x7f, 0x0f, 0x00, 0xa6, 0x23, 0xea, 0x37, 0x13, 0x08, 0x00, 0x00,
 }
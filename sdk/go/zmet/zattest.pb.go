@@ -0,0 +1,726 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: zattest.proto
+
+package zmet
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// The device first asks for a nonce and then sends a quote of its PCRs
+// which covers the nonce
+type ZAttestReqType int32
+
+const (
+	ZAttestReqType_ATTEST_REQ_NONE        ZAttestReqType = 0
+	ZAttestReqType_ATTEST_REQ_NONCE       ZAttestReqType = 1
+	ZAttestReqType_ATTEST_REQ_QUOTE       ZAttestReqType = 2
+	ZAttestReqType_ATTEST_REQ_ESCROW_KEY  ZAttestReqType = 3
+	ZAttestReqType_ATTEST_REQ_RECOVER_KEY ZAttestReqType = 4
+)
+
+var ZAttestReqType_name = map[int32]string{
+	0: "ATTEST_REQ_NONE",
+	1: "ATTEST_REQ_NONCE",
+	2: "ATTEST_REQ_QUOTE",
+	3: "ATTEST_REQ_ESCROW_KEY",
+	4: "ATTEST_REQ_RECOVER_KEY",
+}
+
+var ZAttestReqType_value = map[string]int32{
+	"ATTEST_REQ_NONE":        0,
+	"ATTEST_REQ_NONCE":       1,
+	"ATTEST_REQ_QUOTE":       2,
+	"ATTEST_REQ_ESCROW_KEY":  3,
+	"ATTEST_REQ_RECOVER_KEY": 4,
+}
+
+func (x ZAttestReqType) String() string {
+	return proto.EnumName(ZAttestReqType_name, int32(x))
+}
+
+func (ZAttestReqType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{0}
+}
+
+type ZAttestRespType int32
+
+const (
+	ZAttestRespType_ATTEST_RESP_NONE         ZAttestRespType = 0
+	ZAttestRespType_ATTEST_RESP_NONCE        ZAttestRespType = 1
+	ZAttestRespType_ATTEST_RESP_QUOTE_RESP   ZAttestRespType = 2
+	ZAttestRespType_ATTEST_RESP_ESCROW_RESP  ZAttestRespType = 3
+	ZAttestRespType_ATTEST_RESP_RECOVER_RESP ZAttestRespType = 4
+)
+
+var ZAttestRespType_name = map[int32]string{
+	0: "ATTEST_RESP_NONE",
+	1: "ATTEST_RESP_NONCE",
+	2: "ATTEST_RESP_QUOTE_RESP",
+	3: "ATTEST_RESP_ESCROW_RESP",
+	4: "ATTEST_RESP_RECOVER_RESP",
+}
+
+var ZAttestRespType_value = map[string]int32{
+	"ATTEST_RESP_NONE":         0,
+	"ATTEST_RESP_NONCE":        1,
+	"ATTEST_RESP_QUOTE_RESP":   2,
+	"ATTEST_RESP_ESCROW_RESP":  3,
+	"ATTEST_RESP_RECOVER_RESP": 4,
+}
+
+func (x ZAttestRespType) String() string {
+	return proto.EnumName(ZAttestRespType_name, int32(x))
+}
+
+func (ZAttestRespType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{1}
+}
+
+type ZAttestResponseCode int32
+
+const (
+	ZAttestResponseCode_ATTEST_RESPONSE_NONE    ZAttestResponseCode = 0
+	ZAttestResponseCode_ATTEST_RESPONSE_SUCCESS ZAttestResponseCode = 1
+	ZAttestResponseCode_ATTEST_RESPONSE_FAILURE ZAttestResponseCode = 2
+)
+
+var ZAttestResponseCode_name = map[int32]string{
+	0: "ATTEST_RESPONSE_NONE",
+	1: "ATTEST_RESPONSE_SUCCESS",
+	2: "ATTEST_RESPONSE_FAILURE",
+}
+
+var ZAttestResponseCode_value = map[string]int32{
+	"ATTEST_RESPONSE_NONE":    0,
+	"ATTEST_RESPONSE_SUCCESS": 1,
+	"ATTEST_RESPONSE_FAILURE": 2,
+}
+
+func (x ZAttestResponseCode) String() string {
+	return proto.EnumName(ZAttestResponseCode_name, int32(x))
+}
+
+func (ZAttestResponseCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{2}
+}
+
+type ZAttestReq struct {
+	ReqType              ZAttestReqType   `protobuf:"varint,1,opt,name=reqType,proto3,enum=ZAttestReqType" json:"reqType,omitempty"`
+	Quote                *ZAttestQuote    `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	VaultKey             *ZAttestVaultKey `protobuf:"bytes,3,opt,name=vaultKey,proto3" json:"vaultKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ZAttestReq) Reset()         { *m = ZAttestReq{} }
+func (m *ZAttestReq) String() string { return proto.CompactTextString(m) }
+func (*ZAttestReq) ProtoMessage()    {}
+func (*ZAttestReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{0}
+}
+
+func (m *ZAttestReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestReq.Unmarshal(m, b)
+}
+func (m *ZAttestReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestReq.Marshal(b, m, deterministic)
+}
+func (m *ZAttestReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestReq.Merge(m, src)
+}
+func (m *ZAttestReq) XXX_Size() int {
+	return xxx_messageInfo_ZAttestReq.Size(m)
+}
+func (m *ZAttestReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestReq proto.InternalMessageInfo
+
+func (m *ZAttestReq) GetReqType() ZAttestReqType {
+	if m != nil {
+		return m.ReqType
+	}
+	return ZAttestReqType_ATTEST_REQ_NONE
+}
+
+func (m *ZAttestReq) GetQuote() *ZAttestQuote {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *ZAttestReq) GetVaultKey() *ZAttestVaultKey {
+	if m != nil {
+		return m.VaultKey
+	}
+	return nil
+}
+
+type ZAttestQuote struct {
+	AttestData []byte         `protobuf:"bytes,1,opt,name=attestData,proto3" json:"attestData,omitempty"`
+	Signature  []byte         `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	PcrValues  []*TpmPCRValue `protobuf:"bytes,3,rep,name=pcrValues,proto3" json:"pcrValues,omitempty"`
+	// TPMT_PUBLIC of the attestation key, and an ASN.1 ECDSA signature of
+	// its SHA256 using the device key which binds it to the device certificate
+	AttestKeyPublic    []byte `protobuf:"bytes,4,opt,name=attestKeyPublic,proto3" json:"attestKeyPublic,omitempty"`
+	AttestKeySignature []byte `protobuf:"bytes,5,opt,name=attestKeySignature,proto3" json:"attestKeySignature,omitempty"`
+	// The TCG binary event log from the firmware, if any
+	FirmwareEventLog []byte `protobuf:"bytes,6,opt,name=firmwareEventLog,proto3" json:"firmwareEventLog,omitempty"`
+	// What EVE extended into its own PCRs
+	Measurements         []*TpmMeasurement `protobuf:"bytes,7,rep,name=measurements,proto3" json:"measurements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ZAttestQuote) Reset()         { *m = ZAttestQuote{} }
+func (m *ZAttestQuote) String() string { return proto.CompactTextString(m) }
+func (*ZAttestQuote) ProtoMessage()    {}
+func (*ZAttestQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{1}
+}
+
+func (m *ZAttestQuote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestQuote.Unmarshal(m, b)
+}
+func (m *ZAttestQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestQuote.Marshal(b, m, deterministic)
+}
+func (m *ZAttestQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestQuote.Merge(m, src)
+}
+func (m *ZAttestQuote) XXX_Size() int {
+	return xxx_messageInfo_ZAttestQuote.Size(m)
+}
+func (m *ZAttestQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestQuote proto.InternalMessageInfo
+
+func (m *ZAttestQuote) GetAttestData() []byte {
+	if m != nil {
+		return m.AttestData
+	}
+	return nil
+}
+
+func (m *ZAttestQuote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ZAttestQuote) GetPcrValues() []*TpmPCRValue {
+	if m != nil {
+		return m.PcrValues
+	}
+	return nil
+}
+
+func (m *ZAttestQuote) GetAttestKeyPublic() []byte {
+	if m != nil {
+		return m.AttestKeyPublic
+	}
+	return nil
+}
+
+func (m *ZAttestQuote) GetAttestKeySignature() []byte {
+	if m != nil {
+		return m.AttestKeySignature
+	}
+	return nil
+}
+
+func (m *ZAttestQuote) GetFirmwareEventLog() []byte {
+	if m != nil {
+		return m.FirmwareEventLog
+	}
+	return nil
+}
+
+func (m *ZAttestQuote) GetMeasurements() []*TpmMeasurement {
+	if m != nil {
+		return m.Measurements
+	}
+	return nil
+}
+
+type TpmPCRValue struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	HashAlgo             uint32   `protobuf:"varint,2,opt,name=hashAlgo,proto3" json:"hashAlgo,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TpmPCRValue) Reset()         { *m = TpmPCRValue{} }
+func (m *TpmPCRValue) String() string { return proto.CompactTextString(m) }
+func (*TpmPCRValue) ProtoMessage()    {}
+func (*TpmPCRValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{2}
+}
+
+func (m *TpmPCRValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TpmPCRValue.Unmarshal(m, b)
+}
+func (m *TpmPCRValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TpmPCRValue.Marshal(b, m, deterministic)
+}
+func (m *TpmPCRValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TpmPCRValue.Merge(m, src)
+}
+func (m *TpmPCRValue) XXX_Size() int {
+	return xxx_messageInfo_TpmPCRValue.Size(m)
+}
+func (m *TpmPCRValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TpmPCRValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TpmPCRValue proto.InternalMessageInfo
+
+func (m *TpmPCRValue) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TpmPCRValue) GetHashAlgo() uint32 {
+	if m != nil {
+		return m.HashAlgo
+	}
+	return 0
+}
+
+func (m *TpmPCRValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type TpmMeasurement struct {
+	PcrIndex             uint32   `protobuf:"varint,1,opt,name=pcrIndex,proto3" json:"pcrIndex,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Digest               []byte   `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TpmMeasurement) Reset()         { *m = TpmMeasurement{} }
+func (m *TpmMeasurement) String() string { return proto.CompactTextString(m) }
+func (*TpmMeasurement) ProtoMessage()    {}
+func (*TpmMeasurement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{3}
+}
+
+func (m *TpmMeasurement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TpmMeasurement.Unmarshal(m, b)
+}
+func (m *TpmMeasurement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TpmMeasurement.Marshal(b, m, deterministic)
+}
+func (m *TpmMeasurement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TpmMeasurement.Merge(m, src)
+}
+func (m *TpmMeasurement) XXX_Size() int {
+	return xxx_messageInfo_TpmMeasurement.Size(m)
+}
+func (m *TpmMeasurement) XXX_DiscardUnknown() {
+	xxx_messageInfo_TpmMeasurement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TpmMeasurement proto.InternalMessageInfo
+
+func (m *TpmMeasurement) GetPcrIndex() uint32 {
+	if m != nil {
+		return m.PcrIndex
+	}
+	return 0
+}
+
+func (m *TpmMeasurement) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TpmMeasurement) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+type ZAttestResponse struct {
+	RespType             ZAttestRespType     `protobuf:"varint,1,opt,name=respType,proto3,enum=ZAttestRespType" json:"respType,omitempty"`
+	Nonce                *ZAttestNonceResp   `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	QuoteResp            *ZAttestQuoteResp   `protobuf:"bytes,3,opt,name=quoteResp,proto3" json:"quoteResp,omitempty"`
+	EscrowResp           *ZAttestEscrowResp  `protobuf:"bytes,4,opt,name=escrowResp,proto3" json:"escrowResp,omitempty"`
+	RecoverResp          *ZAttestRecoverResp `protobuf:"bytes,5,opt,name=recoverResp,proto3" json:"recoverResp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ZAttestResponse) Reset()         { *m = ZAttestResponse{} }
+func (m *ZAttestResponse) String() string { return proto.CompactTextString(m) }
+func (*ZAttestResponse) ProtoMessage()    {}
+func (*ZAttestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{4}
+}
+
+func (m *ZAttestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestResponse.Unmarshal(m, b)
+}
+func (m *ZAttestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestResponse.Marshal(b, m, deterministic)
+}
+func (m *ZAttestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestResponse.Merge(m, src)
+}
+func (m *ZAttestResponse) XXX_Size() int {
+	return xxx_messageInfo_ZAttestResponse.Size(m)
+}
+func (m *ZAttestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestResponse proto.InternalMessageInfo
+
+func (m *ZAttestResponse) GetRespType() ZAttestRespType {
+	if m != nil {
+		return m.RespType
+	}
+	return ZAttestRespType_ATTEST_RESP_NONE
+}
+
+func (m *ZAttestResponse) GetNonce() *ZAttestNonceResp {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *ZAttestResponse) GetQuoteResp() *ZAttestQuoteResp {
+	if m != nil {
+		return m.QuoteResp
+	}
+	return nil
+}
+
+func (m *ZAttestResponse) GetEscrowResp() *ZAttestEscrowResp {
+	if m != nil {
+		return m.EscrowResp
+	}
+	return nil
+}
+
+func (m *ZAttestResponse) GetRecoverResp() *ZAttestRecoverResp {
+	if m != nil {
+		return m.RecoverResp
+	}
+	return nil
+}
+
+type ZAttestNonceResp struct {
+	Nonce                []byte   `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZAttestNonceResp) Reset()         { *m = ZAttestNonceResp{} }
+func (m *ZAttestNonceResp) String() string { return proto.CompactTextString(m) }
+func (*ZAttestNonceResp) ProtoMessage()    {}
+func (*ZAttestNonceResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{5}
+}
+
+func (m *ZAttestNonceResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestNonceResp.Unmarshal(m, b)
+}
+func (m *ZAttestNonceResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestNonceResp.Marshal(b, m, deterministic)
+}
+func (m *ZAttestNonceResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestNonceResp.Merge(m, src)
+}
+func (m *ZAttestNonceResp) XXX_Size() int {
+	return xxx_messageInfo_ZAttestNonceResp.Size(m)
+}
+func (m *ZAttestNonceResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestNonceResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestNonceResp proto.InternalMessageInfo
+
+func (m *ZAttestNonceResp) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+type ZAttestQuoteResp struct {
+	Response             ZAttestResponseCode `protobuf:"varint,1,opt,name=response,proto3,enum=ZAttestResponseCode" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ZAttestQuoteResp) Reset()         { *m = ZAttestQuoteResp{} }
+func (m *ZAttestQuoteResp) String() string { return proto.CompactTextString(m) }
+func (*ZAttestQuoteResp) ProtoMessage()    {}
+func (*ZAttestQuoteResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{6}
+}
+
+func (m *ZAttestQuoteResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestQuoteResp.Unmarshal(m, b)
+}
+func (m *ZAttestQuoteResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestQuoteResp.Marshal(b, m, deterministic)
+}
+func (m *ZAttestQuoteResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestQuoteResp.Merge(m, src)
+}
+func (m *ZAttestQuoteResp) XXX_Size() int {
+	return xxx_messageInfo_ZAttestQuoteResp.Size(m)
+}
+func (m *ZAttestQuoteResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestQuoteResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestQuoteResp proto.InternalMessageInfo
+
+func (m *ZAttestQuoteResp) GetResponse() ZAttestResponseCode {
+	if m != nil {
+		return m.Response
+	}
+	return ZAttestResponseCode_ATTEST_RESPONSE_NONE
+}
+
+type ZAttestVaultKey struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	KeyDescriptor        []byte   `protobuf:"bytes,2,opt,name=keyDescriptor,proto3" json:"keyDescriptor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZAttestVaultKey) Reset()         { *m = ZAttestVaultKey{} }
+func (m *ZAttestVaultKey) String() string { return proto.CompactTextString(m) }
+func (*ZAttestVaultKey) ProtoMessage()    {}
+func (*ZAttestVaultKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{7}
+}
+
+func (m *ZAttestVaultKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestVaultKey.Unmarshal(m, b)
+}
+func (m *ZAttestVaultKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestVaultKey.Marshal(b, m, deterministic)
+}
+func (m *ZAttestVaultKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestVaultKey.Merge(m, src)
+}
+func (m *ZAttestVaultKey) XXX_Size() int {
+	return xxx_messageInfo_ZAttestVaultKey.Size(m)
+}
+func (m *ZAttestVaultKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestVaultKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestVaultKey proto.InternalMessageInfo
+
+func (m *ZAttestVaultKey) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ZAttestVaultKey) GetKeyDescriptor() []byte {
+	if m != nil {
+		return m.KeyDescriptor
+	}
+	return nil
+}
+
+type ZAttestEscrowResp struct {
+	Response             ZAttestResponseCode `protobuf:"varint,1,opt,name=response,proto3,enum=ZAttestResponseCode" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ZAttestEscrowResp) Reset()         { *m = ZAttestEscrowResp{} }
+func (m *ZAttestEscrowResp) String() string { return proto.CompactTextString(m) }
+func (*ZAttestEscrowResp) ProtoMessage()    {}
+func (*ZAttestEscrowResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{8}
+}
+
+func (m *ZAttestEscrowResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestEscrowResp.Unmarshal(m, b)
+}
+func (m *ZAttestEscrowResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestEscrowResp.Marshal(b, m, deterministic)
+}
+func (m *ZAttestEscrowResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestEscrowResp.Merge(m, src)
+}
+func (m *ZAttestEscrowResp) XXX_Size() int {
+	return xxx_messageInfo_ZAttestEscrowResp.Size(m)
+}
+func (m *ZAttestEscrowResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestEscrowResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestEscrowResp proto.InternalMessageInfo
+
+func (m *ZAttestEscrowResp) GetResponse() ZAttestResponseCode {
+	if m != nil {
+		return m.Response
+	}
+	return ZAttestResponseCode_ATTEST_RESPONSE_NONE
+}
+
+type ZAttestRecoverResp struct {
+	Response             ZAttestResponseCode `protobuf:"varint,1,opt,name=response,proto3,enum=ZAttestResponseCode" json:"response,omitempty"`
+	VaultKey             *ZAttestVaultKey    `protobuf:"bytes,2,opt,name=vaultKey,proto3" json:"vaultKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ZAttestRecoverResp) Reset()         { *m = ZAttestRecoverResp{} }
+func (m *ZAttestRecoverResp) String() string { return proto.CompactTextString(m) }
+func (*ZAttestRecoverResp) ProtoMessage()    {}
+func (*ZAttestRecoverResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca38ee7465f4774, []int{9}
+}
+
+func (m *ZAttestRecoverResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZAttestRecoverResp.Unmarshal(m, b)
+}
+func (m *ZAttestRecoverResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZAttestRecoverResp.Marshal(b, m, deterministic)
+}
+func (m *ZAttestRecoverResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZAttestRecoverResp.Merge(m, src)
+}
+func (m *ZAttestRecoverResp) XXX_Size() int {
+	return xxx_messageInfo_ZAttestRecoverResp.Size(m)
+}
+func (m *ZAttestRecoverResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZAttestRecoverResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZAttestRecoverResp proto.InternalMessageInfo
+
+func (m *ZAttestRecoverResp) GetResponse() ZAttestResponseCode {
+	if m != nil {
+		return m.Response
+	}
+	return ZAttestResponseCode_ATTEST_RESPONSE_NONE
+}
+
+func (m *ZAttestRecoverResp) GetVaultKey() *ZAttestVaultKey {
+	if m != nil {
+		return m.VaultKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ZAttestReqType", ZAttestReqType_name, ZAttestReqType_value)
+	proto.RegisterEnum("ZAttestRespType", ZAttestRespType_name, ZAttestRespType_value)
+	proto.RegisterEnum("ZAttestResponseCode", ZAttestResponseCode_name, ZAttestResponseCode_value)
+	proto.RegisterType((*ZAttestReq)(nil), "ZAttestReq")
+	proto.RegisterType((*ZAttestQuote)(nil), "ZAttestQuote")
+	proto.RegisterType((*TpmPCRValue)(nil), "TpmPCRValue")
+	proto.RegisterType((*TpmMeasurement)(nil), "TpmMeasurement")
+	proto.RegisterType((*ZAttestResponse)(nil), "ZAttestResponse")
+	proto.RegisterType((*ZAttestNonceResp)(nil), "ZAttestNonceResp")
+	proto.RegisterType((*ZAttestQuoteResp)(nil), "ZAttestQuoteResp")
+	proto.RegisterType((*ZAttestVaultKey)(nil), "ZAttestVaultKey")
+	proto.RegisterType((*ZAttestEscrowResp)(nil), "ZAttestEscrowResp")
+	proto.RegisterType((*ZAttestRecoverResp)(nil), "ZAttestRecoverResp")
+}
+
+func init() { proto.RegisterFile("zattest.proto", fileDescriptor_0ca38ee7465f4774) }
+
+var fileDescriptor_0ca38ee7465f4774 = []byte{
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5d, 0x6f, 0xda, 0x4a,
+	0x10, 0xbd, 0xe6, 0x23, 0x09, 0x03, 0x04, 0x67, 0x42, 0x72, 0x7d, 0x73, 0xa3, 0x2b, 0xae, 0x53,
+	0xa9, 0x14, 0x55, 0x50, 0x11, 0xf5, 0x07, 0x50, 0xe2, 0x4a, 0x51, 0x52, 0x3e, 0xd6, 0x90, 0xaa,
+	0x79, 0x89, 0x1c, 0xd8, 0x10, 0x2b, 0x60, 0x3b, 0xb6, 0x21, 0x25, 0xcf, 0x7d, 0xa8, 0xfa, 0xde,
+	0x5f, 0xd2, 0x3f, 0x58, 0xed, 0xfa, 0x83, 0x35, 0x89, 0x54, 0xb5, 0x6f, 0xcc, 0x39, 0x67, 0x66,
+	0x8e, 0x67, 0x67, 0x17, 0x28, 0x3e, 0x1a, 0xbe, 0x4f, 0x3d, 0xbf, 0xee, 0xb8, 0xb6, 0x6f, 0xab,
+	0xdf, 0x24, 0x80, 0xcb, 0x16, 0x47, 0x08, 0xbd, 0xc7, 0x57, 0xb0, 0xe9, 0xd2, 0xfb, 0xc1, 0xd2,
+	0xa1, 0x8a, 0x54, 0x91, 0xaa, 0xdb, 0xcd, 0x52, 0x7d, 0xc5, 0x32, 0x98, 0x44, 0x3c, 0x1e, 0x41,
+	0xf6, 0x7e, 0x6e, 0xfb, 0x54, 0x49, 0x55, 0xa4, 0x6a, 0xbe, 0x59, 0x8c, 0x84, 0x7d, 0x06, 0x92,
+	0x80, 0xc3, 0xd7, 0xb0, 0xb5, 0x30, 0xe6, 0x53, 0xff, 0x8c, 0x2e, 0x95, 0x34, 0xd7, 0xc9, 0x91,
+	0xee, 0x22, 0xc4, 0x49, 0xac, 0x50, 0x7f, 0xa4, 0xa0, 0x20, 0x56, 0xc1, 0xff, 0x00, 0x02, 0xb7,
+	0x27, 0x86, 0x6f, 0x70, 0x47, 0x05, 0x22, 0x20, 0x78, 0x08, 0x39, 0xcf, 0x9c, 0x58, 0x86, 0x3f,
+	0x77, 0x03, 0x1f, 0x05, 0xb2, 0x02, 0xb0, 0x06, 0x39, 0x67, 0xe4, 0x5e, 0x18, 0xd3, 0x39, 0xf5,
+	0x94, 0x74, 0x25, 0x5d, 0xcd, 0x37, 0x0b, 0xf5, 0x81, 0x33, 0xeb, 0xb5, 0x09, 0x07, 0xc9, 0x8a,
+	0xc6, 0x2a, 0x94, 0x82, 0xba, 0x67, 0x74, 0xd9, 0x9b, 0x5f, 0x4f, 0xcd, 0x91, 0x92, 0xe1, 0xf5,
+	0xd6, 0x61, 0xac, 0x03, 0xc6, 0x90, 0x1e, 0x37, 0xcf, 0x72, 0xf1, 0x33, 0x0c, 0xd6, 0x40, 0xbe,
+	0x31, 0xdd, 0xd9, 0x83, 0xe1, 0x52, 0x6d, 0x41, 0x2d, 0xff, 0xdc, 0x9e, 0x28, 0x1b, 0x5c, 0xfd,
+	0x04, 0xc7, 0x63, 0x28, 0xcc, 0xa8, 0xe1, 0xcd, 0x5d, 0x3a, 0xa3, 0x96, 0xef, 0x29, 0x9b, 0xdc,
+	0x74, 0x89, 0x99, 0xfe, 0xb0, 0xc2, 0x49, 0x42, 0xa4, 0x0e, 0x21, 0x2f, 0x7c, 0x14, 0x96, 0x21,
+	0x6b, 0x5a, 0x63, 0xfa, 0x99, 0x8f, 0xab, 0x48, 0x82, 0x00, 0x0f, 0x60, 0xeb, 0xd6, 0xf0, 0x6e,
+	0x5b, 0xd3, 0x89, 0xcd, 0x07, 0x55, 0x24, 0x71, 0xcc, 0x32, 0x16, 0x2c, 0x95, 0x9f, 0x50, 0x81,
+	0x04, 0x81, 0x7a, 0x03, 0xdb, 0xc9, 0xb6, 0xac, 0x86, 0x33, 0x72, 0x4f, 0x85, 0xe2, 0x71, 0x8c,
+	0x15, 0xc8, 0x8f, 0xa9, 0x37, 0x72, 0x4d, 0xc7, 0x37, 0x6d, 0x8b, 0xb7, 0xc8, 0x11, 0x11, 0xc2,
+	0x7d, 0xd8, 0x18, 0x9b, 0x13, 0xea, 0xf9, 0x61, 0x9b, 0x30, 0x52, 0xbf, 0xa4, 0xa0, 0x14, 0xef,
+	0x98, 0xe7, 0xd8, 0x96, 0xc7, 0xd7, 0xc6, 0xa5, 0x9e, 0x23, 0xec, 0xa1, 0x5c, 0x17, 0x34, 0x0c,
+	0x27, 0xb1, 0x02, 0x5f, 0x42, 0xd6, 0xb2, 0xad, 0x51, 0xb4, 0x89, 0x3b, 0x91, 0xb4, 0xc3, 0x40,
+	0xa6, 0x27, 0x01, 0x8f, 0x0d, 0xc8, 0xf1, 0xb5, 0x64, 0x98, 0x92, 0x4e, 0x8a, 0xfb, 0x11, 0x41,
+	0x56, 0x1a, 0x6c, 0x02, 0xb0, 0x2f, 0xb0, 0x1f, 0x78, 0x46, 0x86, 0x67, 0x60, 0x94, 0xa1, 0xc5,
+	0x0c, 0x11, 0x54, 0xf8, 0x16, 0xf2, 0x2e, 0x1d, 0xd9, 0x0b, 0xea, 0xf2, 0xa4, 0x2c, 0x4f, 0xda,
+	0x5d, 0xd9, 0x8f, 0x29, 0x22, 0xea, 0xd4, 0x2a, 0xc8, 0xeb, 0xb6, 0xd9, 0xc1, 0x04, 0x1f, 0x16,
+	0x6c, 0x7e, 0x10, 0xa8, 0x27, 0x20, 0xaf, 0x7b, 0xc6, 0x37, 0xc1, 0xc0, 0xd8, 0xf0, 0xc2, 0x81,
+	0x95, 0xeb, 0x6b, 0x43, 0x6d, 0xdb, 0xe3, 0x70, 0x68, 0x2c, 0x52, 0x4f, 0xa1, 0xb4, 0x76, 0x11,
+	0x51, 0x86, 0xf4, 0x1d, 0x5d, 0x86, 0xcd, 0xd8, 0x4f, 0x7c, 0x01, 0xc5, 0x3b, 0xba, 0x3c, 0x09,
+	0x4f, 0xd1, 0x76, 0xc3, 0x3b, 0x96, 0x04, 0x55, 0x0d, 0x76, 0x9e, 0x8c, 0xe4, 0x0f, 0x1c, 0xf9,
+	0x80, 0x4f, 0x87, 0xf4, 0xfb, 0x75, 0x12, 0x6f, 0x4e, 0xea, 0x57, 0x6f, 0x4e, 0xed, 0xab, 0x04,
+	0xdb, 0xc9, 0x27, 0x0e, 0x77, 0xa1, 0xd4, 0x1a, 0x0c, 0x34, 0x7d, 0x70, 0x45, 0xb4, 0xfe, 0x55,
+	0xa7, 0xdb, 0xd1, 0xe4, 0xbf, 0xb0, 0x0c, 0x72, 0x12, 0x6c, 0x6b, 0xb2, 0xb4, 0x86, 0xf6, 0x87,
+	0xdd, 0x81, 0x26, 0xa7, 0xf0, 0x1f, 0xd8, 0x13, 0x50, 0x4d, 0x6f, 0x93, 0xee, 0xc7, 0xab, 0x33,
+	0xed, 0x93, 0x9c, 0xc6, 0x03, 0xd8, 0x17, 0x28, 0xa2, 0xb5, 0xbb, 0x17, 0x1a, 0xe1, 0x5c, 0xa6,
+	0xf6, 0x5d, 0x4a, 0xdc, 0x04, 0xee, 0x45, 0x6c, 0xa0, 0xf7, 0x22, 0x33, 0x7b, 0xb0, 0xb3, 0x86,
+	0x72, 0x37, 0x62, 0x71, 0xbd, 0x17, 0xd8, 0xe1, 0x3f, 0xe5, 0x14, 0xfe, 0x0b, 0x7f, 0x8b, 0x5c,
+	0x68, 0x8a, 0x93, 0x69, 0x3c, 0x04, 0x45, 0x24, 0x23, 0x5b, 0x9c, 0xcd, 0xd4, 0x4c, 0xd8, 0x7d,
+	0x66, 0xe2, 0xa8, 0x40, 0x59, 0x48, 0xea, 0x76, 0x74, 0x2d, 0xb2, 0x97, 0xec, 0xc5, 0x19, 0x7d,
+	0xd8, 0x6e, 0x6b, 0xba, 0x2e, 0x4b, 0xcf, 0x91, 0xef, 0x5b, 0xa7, 0xe7, 0x43, 0xa2, 0xc9, 0xa9,
+	0x77, 0x47, 0x97, 0xff, 0x4f, 0x4c, 0xff, 0x76, 0x7e, 0x5d, 0x1f, 0xd9, 0xb3, 0xc6, 0x23, 0x1d,
+	0xd3, 0xb1, 0xd1, 0xa0, 0x0b, 0xda, 0xf0, 0xc6, 0x77, 0x8d, 0x89, 0xdd, 0x78, 0x9c, 0x51, 0xff,
+	0x7a, 0x83, 0xff, 0x75, 0x1d, 0xff, 0x1c, 0x00, 0x21, 0xfd, 0x71, 0x6b, 0xcb, 0x06, 0x00, 0x00,
+}
@@ -21,7 +21,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//
+type DataSecAtRestState int32
+
+const (
+	DataSecAtRestState_DATASEC_AT_REST_UNKNOWN   DataSecAtRestState = 0
+	DataSecAtRestState_DATASEC_AT_REST_UNLOCKED  DataSecAtRestState = 1
+	DataSecAtRestState_DATASEC_AT_REST_LOCKED    DataSecAtRestState = 2
+	DataSecAtRestState_DATASEC_AT_REST_RECOVERED DataSecAtRestState = 3
+	DataSecAtRestState_DATASEC_AT_REST_ERROR     DataSecAtRestState = 4
+)
+
+var DataSecAtRestState_name = map[int32]string{
+	0: "DATASEC_AT_REST_UNKNOWN",
+	1: "DATASEC_AT_REST_UNLOCKED",
+	2: "DATASEC_AT_REST_LOCKED",
+	3: "DATASEC_AT_REST_RECOVERED",
+	4: "DATASEC_AT_REST_ERROR",
+}
+
+var DataSecAtRestState_value = map[string]int32{
+	"DATASEC_AT_REST_UNKNOWN":   0,
+	"DATASEC_AT_REST_UNLOCKED":  1,
+	"DATASEC_AT_REST_LOCKED":    2,
+	"DATASEC_AT_REST_RECOVERED": 3,
+	"DATASEC_AT_REST_ERROR":     4,
+}
+
+func (x DataSecAtRestState) String() string {
+	return proto.EnumName(DataSecAtRestState_name, int32(x))
+}
+
+func (DataSecAtRestState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{0}
+}
+
+type ZAppHealthState int32
+
+const (
+	ZAppHealthState_APP_HEALTH_UNKNOWN   ZAppHealthState = 0
+	ZAppHealthState_APP_HEALTH_HEALTHY   ZAppHealthState = 1
+	ZAppHealthState_APP_HEALTH_UNHEALTHY ZAppHealthState = 2
+)
+
+var ZAppHealthState_name = map[int32]string{
+	0: "APP_HEALTH_UNKNOWN",
+	1: "APP_HEALTH_HEALTHY",
+	2: "APP_HEALTH_UNHEALTHY",
+}
+
+var ZAppHealthState_value = map[string]int32{
+	"APP_HEALTH_UNKNOWN":   0,
+	"APP_HEALTH_HEALTHY":   1,
+	"APP_HEALTH_UNHEALTHY": 2,
+}
+
+func (x ZAppHealthState) String() string {
+	return proto.EnumName(ZAppHealthState_name, int32(x))
+}
+
+func (ZAppHealthState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{1}
+}
+
 // Broadly there are two types
 // Info : information that is discovered/rarely changes
 // Metrics: information that gets updated periodically
@@ -61,7 +122,7 @@ func (x ZInfoTypes) String() string {
 }
 
 func (ZInfoTypes) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{0}
+	return fileDescriptor_dd6f5fc136c65f52, []int{2}
 }
 
 // Deprecate since we can't determine it on the device
@@ -90,7 +151,7 @@ func (x ZPeripheralTypes) String() string {
 }
 
 func (ZPeripheralTypes) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{1}
+	return fileDescriptor_dd6f5fc136c65f52, []int{3}
 }
 
 // Enum names from OMA-TS-LWM2M_SwMgmt-V1_0-20151201-C
@@ -146,7 +207,7 @@ func (x ZSwState) String() string {
 }
 
 func (ZSwState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{2}
+	return fileDescriptor_dd6f5fc136c65f52, []int{4}
 }
 
 type BaseOsStatus int32
@@ -186,7 +247,7 @@ func (x BaseOsStatus) String() string {
 }
 
 func (BaseOsStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{3}
+	return fileDescriptor_dd6f5fc136c65f52, []int{5}
 }
 
 // ipSec state information
@@ -227,7 +288,7 @@ func (x ZInfoVpnState) String() string {
 }
 
 func (ZInfoVpnState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{4}
+	return fileDescriptor_dd6f5fc136c65f52, []int{6}
 }
 
 // XXX duplicate of definition in appconfig.proto
@@ -235,11 +296,12 @@ func (ZInfoVpnState) EnumDescriptor() ([]byte, []int) {
 type ZioType int32
 
 const (
-	ZioType_ZioNop   ZioType = 0
-	ZioType_ZioEth   ZioType = 1
-	ZioType_ZioUSB   ZioType = 2
-	ZioType_ZioCOM   ZioType = 3
-	ZioType_ZioOther ZioType = 255
+	ZioType_ZioNop       ZioType = 0
+	ZioType_ZioEth       ZioType = 1
+	ZioType_ZioUSB       ZioType = 2
+	ZioType_ZioCOM       ZioType = 3
+	ZioType_ZioUSBDevice ZioType = 4
+	ZioType_ZioOther     ZioType = 255
 )
 
 var ZioType_name = map[int32]string{
@@ -247,15 +309,17 @@ var ZioType_name = map[int32]string{
 	1:   "ZioEth",
 	2:   "ZioUSB",
 	3:   "ZioCOM",
+	4:   "ZioUSBDevice",
 	255: "ZioOther",
 }
 
 var ZioType_value = map[string]int32{
-	"ZioNop":   0,
-	"ZioEth":   1,
-	"ZioUSB":   2,
-	"ZioCOM":   3,
-	"ZioOther": 255,
+	"ZioNop":       0,
+	"ZioEth":       1,
+	"ZioUSB":       2,
+	"ZioCOM":       3,
+	"ZioUSBDevice": 4,
+	"ZioOther":     255,
 }
 
 func (x ZioType) String() string {
@@ -263,7 +327,7 @@ func (x ZioType) String() string {
 }
 
 func (ZioType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{5}
+	return fileDescriptor_dd6f5fc136c65f52, []int{7}
 }
 
 type ZmetricTypes int32
@@ -291,7 +355,7 @@ func (x ZmetricTypes) String() string {
 }
 
 func (ZmetricTypes) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{6}
+	return fileDescriptor_dd6f5fc136c65f52, []int{8}
 }
 
 type MetricItemType int32
@@ -322,7 +386,121 @@ func (x MetricItemType) String() string {
 }
 
 func (MetricItemType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{7}
+	return fileDescriptor_dd6f5fc136c65f52, []int{9}
+}
+
+// The stages of the connectivity test of a port in the order they are run
+type ZConnectivityStage int32
+
+const (
+	ZConnectivityStage_ZcStageUnknown      ZConnectivityStage = 0
+	ZConnectivityStage_ZcStageCarrier      ZConnectivityStage = 1
+	ZConnectivityStage_ZcStageAddress      ZConnectivityStage = 2
+	ZConnectivityStage_ZcStageDefaultRoute ZConnectivityStage = 3
+	ZConnectivityStage_ZcStageProxy        ZConnectivityStage = 4
+	ZConnectivityStage_ZcStageDNS          ZConnectivityStage = 5
+	ZConnectivityStage_ZcStageTCPConnect   ZConnectivityStage = 6
+	ZConnectivityStage_ZcStageTLS          ZConnectivityStage = 7
+	ZConnectivityStage_ZcStageHTTP         ZConnectivityStage = 8
+)
+
+var ZConnectivityStage_name = map[int32]string{
+	0: "ZcStageUnknown",
+	1: "ZcStageCarrier",
+	2: "ZcStageAddress",
+	3: "ZcStageDefaultRoute",
+	4: "ZcStageProxy",
+	5: "ZcStageDNS",
+	6: "ZcStageTCPConnect",
+	7: "ZcStageTLS",
+	8: "ZcStageHTTP",
+}
+
+var ZConnectivityStage_value = map[string]int32{
+	"ZcStageUnknown":      0,
+	"ZcStageCarrier":      1,
+	"ZcStageAddress":      2,
+	"ZcStageDefaultRoute": 3,
+	"ZcStageProxy":        4,
+	"ZcStageDNS":          5,
+	"ZcStageTCPConnect":   6,
+	"ZcStageTLS":          7,
+	"ZcStageHTTP":         8,
+}
+
+func (x ZConnectivityStage) String() string {
+	return proto.EnumName(ZConnectivityStage_name, int32(x))
+}
+
+func (ZConnectivityStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{10}
+}
+
+type ZConnectivityError int32
+
+const (
+	ZConnectivityError_ZcErrNone                 ZConnectivityError = 0
+	ZConnectivityError_ZcErrNoCarrier            ZConnectivityError = 1
+	ZConnectivityError_ZcErrNoAddress            ZConnectivityError = 2
+	ZConnectivityError_ZcErrNoRoute              ZConnectivityError = 3
+	ZConnectivityError_ZcErrProxy                ZConnectivityError = 4
+	ZConnectivityError_ZcErrDNSNotFound          ZConnectivityError = 5
+	ZConnectivityError_ZcErrDNSFailure           ZConnectivityError = 6
+	ZConnectivityError_ZcErrRefused              ZConnectivityError = 7
+	ZConnectivityError_ZcErrUnreachable          ZConnectivityError = 8
+	ZConnectivityError_ZcErrTimeout              ZConnectivityError = 9
+	ZConnectivityError_ZcErrCertUnknownAuthority ZConnectivityError = 10
+	ZConnectivityError_ZcErrCertHostname         ZConnectivityError = 11
+	ZConnectivityError_ZcErrCertInvalid          ZConnectivityError = 12
+	ZConnectivityError_ZcErrTLS                  ZConnectivityError = 13
+	ZConnectivityError_ZcErrHTTPStatus           ZConnectivityError = 14
+	ZConnectivityError_ZcErrOther                ZConnectivityError = 255
+)
+
+var ZConnectivityError_name = map[int32]string{
+	0:   "ZcErrNone",
+	1:   "ZcErrNoCarrier",
+	2:   "ZcErrNoAddress",
+	3:   "ZcErrNoRoute",
+	4:   "ZcErrProxy",
+	5:   "ZcErrDNSNotFound",
+	6:   "ZcErrDNSFailure",
+	7:   "ZcErrRefused",
+	8:   "ZcErrUnreachable",
+	9:   "ZcErrTimeout",
+	10:  "ZcErrCertUnknownAuthority",
+	11:  "ZcErrCertHostname",
+	12:  "ZcErrCertInvalid",
+	13:  "ZcErrTLS",
+	14:  "ZcErrHTTPStatus",
+	255: "ZcErrOther",
+}
+
+var ZConnectivityError_value = map[string]int32{
+	"ZcErrNone":                 0,
+	"ZcErrNoCarrier":            1,
+	"ZcErrNoAddress":            2,
+	"ZcErrNoRoute":              3,
+	"ZcErrProxy":                4,
+	"ZcErrDNSNotFound":          5,
+	"ZcErrDNSFailure":           6,
+	"ZcErrRefused":              7,
+	"ZcErrUnreachable":          8,
+	"ZcErrTimeout":              9,
+	"ZcErrCertUnknownAuthority": 10,
+	"ZcErrCertHostname":         11,
+	"ZcErrCertInvalid":          12,
+	"ZcErrTLS":                  13,
+	"ZcErrHTTPStatus":           14,
+	"ZcErrOther":                255,
+}
+
+func (x ZConnectivityError) String() string {
+	return proto.EnumName(ZConnectivityError_name, int32(x))
+}
+
+func (ZConnectivityError) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{11}
 }
 
 // Manufacturing info, product name, model, version etc.
@@ -920,6 +1098,9 @@ type ZInfoDevice struct {
 	LastRebootTime       *timestamp.Timestamp `protobuf:"bytes,23,opt,name=lastRebootTime,proto3" json:"lastRebootTime,omitempty"`
 	SystemAdapter        *SystemAdapterInfo   `protobuf:"bytes,24,opt,name=systemAdapter,proto3" json:"systemAdapter,omitempty"`
 	RestartCounter       uint32               `protobuf:"varint,25,opt,name=restartCounter,proto3" json:"restartCounter,omitempty"`
+	DataSecAtRest        *DataSecAtRest       `protobuf:"bytes,26,opt,name=dataSecAtRest,proto3" json:"dataSecAtRest,omitempty"`
+	DeviceCert           *ZInfoDeviceCert     `protobuf:"bytes,27,opt,name=deviceCert,proto3" json:"deviceCert,omitempty"`
+	Capacity             *ZInfoCapacity       `protobuf:"bytes,28,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1083,4526 +1264,6361 @@ func (m *ZInfoDevice) GetRestartCounter() uint32 {
 	return 0
 }
 
-// The current and fallback system adapter information
-type SystemAdapterInfo struct {
-	CurrentIndex         uint32              `protobuf:"varint,1,opt,name=currentIndex,proto3" json:"currentIndex,omitempty"`
-	Status               []*DevicePortStatus `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
-}
-
-func (m *SystemAdapterInfo) Reset()         { *m = SystemAdapterInfo{} }
-func (m *SystemAdapterInfo) String() string { return proto.CompactTextString(m) }
-func (*SystemAdapterInfo) ProtoMessage()    {}
-func (*SystemAdapterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{8}
-}
-
-func (m *SystemAdapterInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SystemAdapterInfo.Unmarshal(m, b)
-}
-func (m *SystemAdapterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SystemAdapterInfo.Marshal(b, m, deterministic)
-}
-func (m *SystemAdapterInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SystemAdapterInfo.Merge(m, src)
-}
-func (m *SystemAdapterInfo) XXX_Size() int {
-	return xxx_messageInfo_SystemAdapterInfo.Size(m)
-}
-func (m *SystemAdapterInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_SystemAdapterInfo.DiscardUnknown(m)
+func (m *ZInfoDevice) GetDataSecAtRest() *DataSecAtRest {
+	if m != nil {
+		return m.DataSecAtRest
+	}
+	return nil
 }
 
-var xxx_messageInfo_SystemAdapterInfo proto.InternalMessageInfo
-
-func (m *SystemAdapterInfo) GetCurrentIndex() uint32 {
+func (m *ZInfoDevice) GetDeviceCert() *ZInfoDeviceCert {
 	if m != nil {
-		return m.CurrentIndex
+		return m.DeviceCert
 	}
-	return 0
+	return nil
 }
 
-func (m *SystemAdapterInfo) GetStatus() []*DevicePortStatus {
+func (m *ZInfoDevice) GetCapacity() *ZInfoCapacity {
 	if m != nil {
-		return m.Status
+		return m.Capacity
 	}
 	return nil
 }
 
-type DevicePortStatus struct {
-	Version              uint32               `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Key                  string               `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	TimePriority         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timePriority,proto3" json:"timePriority,omitempty"`
-	LastFailed           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=lastFailed,proto3" json:"lastFailed,omitempty"`
-	LastSucceeded        *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastSucceeded,proto3" json:"lastSucceeded,omitempty"`
-	Ports                []*DevicePort        `protobuf:"bytes,6,rep,name=ports,proto3" json:"ports,omitempty"`
-	LastError            string               `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+// What the device has for app instances and what is allocated to each
+type ZInfoCapacity struct {
+	TotalMemory          uint64                `protobuf:"varint,1,opt,name=totalMemory,proto3" json:"totalMemory,omitempty"`
+	ReservedMemory       uint64                `protobuf:"varint,2,opt,name=reservedMemory,proto3" json:"reservedMemory,omitempty"`
+	AllocatedMemory      uint64                `protobuf:"varint,3,opt,name=allocatedMemory,proto3" json:"allocatedMemory,omitempty"`
+	Ncpu                 uint32                `protobuf:"varint,4,opt,name=ncpu,proto3" json:"ncpu,omitempty"`
+	PinnedCpus           string                `protobuf:"bytes,5,opt,name=pinnedCpus,proto3" json:"pinnedCpus,omitempty"`
+	ExclusivePinning     bool                  `protobuf:"varint,6,opt,name=exclusivePinning,proto3" json:"exclusivePinning,omitempty"`
+	Allocations          []*ZInfoAppAllocation `protobuf:"bytes,7,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DevicePortStatus) Reset()         { *m = DevicePortStatus{} }
-func (m *DevicePortStatus) String() string { return proto.CompactTextString(m) }
-func (*DevicePortStatus) ProtoMessage()    {}
-func (*DevicePortStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{9}
+func (m *ZInfoCapacity) Reset()         { *m = ZInfoCapacity{} }
+func (m *ZInfoCapacity) String() string { return proto.CompactTextString(m) }
+func (*ZInfoCapacity) ProtoMessage()    {}
+func (*ZInfoCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{8}
 }
 
-func (m *DevicePortStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DevicePortStatus.Unmarshal(m, b)
+func (m *ZInfoCapacity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoCapacity.Unmarshal(m, b)
 }
-func (m *DevicePortStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DevicePortStatus.Marshal(b, m, deterministic)
+func (m *ZInfoCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoCapacity.Marshal(b, m, deterministic)
 }
-func (m *DevicePortStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DevicePortStatus.Merge(m, src)
+func (m *ZInfoCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoCapacity.Merge(m, src)
 }
-func (m *DevicePortStatus) XXX_Size() int {
-	return xxx_messageInfo_DevicePortStatus.Size(m)
+func (m *ZInfoCapacity) XXX_Size() int {
+	return xxx_messageInfo_ZInfoCapacity.Size(m)
 }
-func (m *DevicePortStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_DevicePortStatus.DiscardUnknown(m)
+func (m *ZInfoCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoCapacity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DevicePortStatus proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoCapacity proto.InternalMessageInfo
 
-func (m *DevicePortStatus) GetVersion() uint32 {
+func (m *ZInfoCapacity) GetTotalMemory() uint64 {
 	if m != nil {
-		return m.Version
+		return m.TotalMemory
 	}
 	return 0
 }
 
-func (m *DevicePortStatus) GetKey() string {
+func (m *ZInfoCapacity) GetReservedMemory() uint64 {
 	if m != nil {
-		return m.Key
+		return m.ReservedMemory
 	}
-	return ""
+	return 0
 }
 
-func (m *DevicePortStatus) GetTimePriority() *timestamp.Timestamp {
+func (m *ZInfoCapacity) GetAllocatedMemory() uint64 {
 	if m != nil {
-		return m.TimePriority
+		return m.AllocatedMemory
 	}
-	return nil
+	return 0
 }
 
-func (m *DevicePortStatus) GetLastFailed() *timestamp.Timestamp {
+func (m *ZInfoCapacity) GetNcpu() uint32 {
 	if m != nil {
-		return m.LastFailed
+		return m.Ncpu
 	}
-	return nil
+	return 0
 }
 
-func (m *DevicePortStatus) GetLastSucceeded() *timestamp.Timestamp {
+func (m *ZInfoCapacity) GetPinnedCpus() string {
 	if m != nil {
-		return m.LastSucceeded
+		return m.PinnedCpus
 	}
-	return nil
+	return ""
 }
 
-func (m *DevicePortStatus) GetPorts() []*DevicePort {
+func (m *ZInfoCapacity) GetExclusivePinning() bool {
 	if m != nil {
-		return m.Ports
+		return m.ExclusivePinning
 	}
-	return nil
+	return false
 }
 
-func (m *DevicePortStatus) GetLastError() string {
+func (m *ZInfoCapacity) GetAllocations() []*ZInfoAppAllocation {
 	if m != nil {
-		return m.LastError
+		return m.Allocations
 	}
-	return ""
+	return nil
 }
 
-type DevicePort struct {
-	Ifname string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsMgmt bool   `protobuf:"varint,3,opt,name=isMgmt,proto3" json:"isMgmt,omitempty"`
-	Free   bool   `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
-	// DhcpConfig
-	DhcpType             uint32       `protobuf:"varint,11,opt,name=dhcpType,proto3" json:"dhcpType,omitempty"`
-	Subnet               string       `protobuf:"bytes,12,opt,name=subnet,proto3" json:"subnet,omitempty"`
-	Gateway              string       `protobuf:"bytes,13,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Domainname           string       `protobuf:"bytes,14,opt,name=domainname,proto3" json:"domainname,omitempty"`
-	NtpServer            string       `protobuf:"bytes,15,opt,name=ntpServer,proto3" json:"ntpServer,omitempty"`
-	DnsServers           []string     `protobuf:"bytes,16,rep,name=dnsServers,proto3" json:"dnsServers,omitempty"`
-	DhcpRangeLow         string       `protobuf:"bytes,17,opt,name=dhcpRangeLow,proto3" json:"dhcpRangeLow,omitempty"`
-	DhcpRangeHigh        string       `protobuf:"bytes,18,opt,name=dhcpRangeHigh,proto3" json:"dhcpRangeHigh,omitempty"`
-	Proxy                *ProxyStatus `protobuf:"bytes,21,opt,name=proxy,proto3" json:"proxy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type ZInfoAppAllocation struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Memory               uint64   `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Vcpus                uint32   `protobuf:"varint,4,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
+	Cpus                 string   `protobuf:"bytes,5,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	Admitted             bool     `protobuf:"varint,6,opt,name=admitted,proto3" json:"admitted,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DevicePort) Reset()         { *m = DevicePort{} }
-func (m *DevicePort) String() string { return proto.CompactTextString(m) }
-func (*DevicePort) ProtoMessage()    {}
-func (*DevicePort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{10}
+func (m *ZInfoAppAllocation) Reset()         { *m = ZInfoAppAllocation{} }
+func (m *ZInfoAppAllocation) String() string { return proto.CompactTextString(m) }
+func (*ZInfoAppAllocation) ProtoMessage()    {}
+func (*ZInfoAppAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{9}
 }
 
-func (m *DevicePort) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DevicePort.Unmarshal(m, b)
+func (m *ZInfoAppAllocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoAppAllocation.Unmarshal(m, b)
 }
-func (m *DevicePort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DevicePort.Marshal(b, m, deterministic)
+func (m *ZInfoAppAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoAppAllocation.Marshal(b, m, deterministic)
 }
-func (m *DevicePort) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DevicePort.Merge(m, src)
+func (m *ZInfoAppAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoAppAllocation.Merge(m, src)
 }
-func (m *DevicePort) XXX_Size() int {
-	return xxx_messageInfo_DevicePort.Size(m)
+func (m *ZInfoAppAllocation) XXX_Size() int {
+	return xxx_messageInfo_ZInfoAppAllocation.Size(m)
 }
-func (m *DevicePort) XXX_DiscardUnknown() {
-	xxx_messageInfo_DevicePort.DiscardUnknown(m)
+func (m *ZInfoAppAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoAppAllocation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DevicePort proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoAppAllocation proto.InternalMessageInfo
 
-func (m *DevicePort) GetIfname() string {
+func (m *ZInfoAppAllocation) GetUuid() string {
 	if m != nil {
-		return m.Ifname
+		return m.Uuid
 	}
 	return ""
 }
 
-func (m *DevicePort) GetName() string {
+func (m *ZInfoAppAllocation) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *DevicePort) GetIsMgmt() bool {
-	if m != nil {
-		return m.IsMgmt
-	}
-	return false
-}
-
-func (m *DevicePort) GetFree() bool {
+func (m *ZInfoAppAllocation) GetMemory() uint64 {
 	if m != nil {
-		return m.Free
+		return m.Memory
 	}
-	return false
+	return 0
 }
 
-func (m *DevicePort) GetDhcpType() uint32 {
+func (m *ZInfoAppAllocation) GetVcpus() uint32 {
 	if m != nil {
-		return m.DhcpType
+		return m.Vcpus
 	}
 	return 0
 }
 
-func (m *DevicePort) GetSubnet() string {
+func (m *ZInfoAppAllocation) GetCpus() string {
 	if m != nil {
-		return m.Subnet
+		return m.Cpus
 	}
 	return ""
 }
 
-func (m *DevicePort) GetGateway() string {
+func (m *ZInfoAppAllocation) GetAdmitted() bool {
 	if m != nil {
-		return m.Gateway
+		return m.Admitted
 	}
-	return ""
+	return false
 }
 
-func (m *DevicePort) GetDomainname() string {
+func (m *ZInfoAppAllocation) GetError() string {
 	if m != nil {
-		return m.Domainname
+		return m.Error
 	}
 	return ""
 }
 
-func (m *DevicePort) GetNtpServer() string {
+type ZInfoDeviceCert struct {
+	NotBefore            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
+	NotAfter             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	SerialNumber         string               `protobuf:"bytes,3,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+	LastRenewTime        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=lastRenewTime,proto3" json:"lastRenewTime,omitempty"`
+	LastRenewError       string               `protobuf:"bytes,5,opt,name=lastRenewError,proto3" json:"lastRenewError,omitempty"`
+	LastRenewErrorTime   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastRenewErrorTime,proto3" json:"lastRenewErrorTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ZInfoDeviceCert) Reset()         { *m = ZInfoDeviceCert{} }
+func (m *ZInfoDeviceCert) String() string { return proto.CompactTextString(m) }
+func (*ZInfoDeviceCert) ProtoMessage()    {}
+func (*ZInfoDeviceCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{10}
+}
+
+func (m *ZInfoDeviceCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoDeviceCert.Unmarshal(m, b)
+}
+func (m *ZInfoDeviceCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoDeviceCert.Marshal(b, m, deterministic)
+}
+func (m *ZInfoDeviceCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoDeviceCert.Merge(m, src)
+}
+func (m *ZInfoDeviceCert) XXX_Size() int {
+	return xxx_messageInfo_ZInfoDeviceCert.Size(m)
+}
+func (m *ZInfoDeviceCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoDeviceCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoDeviceCert proto.InternalMessageInfo
+
+func (m *ZInfoDeviceCert) GetNotBefore() *timestamp.Timestamp {
 	if m != nil {
-		return m.NtpServer
+		return m.NotBefore
 	}
-	return ""
+	return nil
 }
 
-func (m *DevicePort) GetDnsServers() []string {
+func (m *ZInfoDeviceCert) GetNotAfter() *timestamp.Timestamp {
 	if m != nil {
-		return m.DnsServers
+		return m.NotAfter
 	}
 	return nil
 }
 
-func (m *DevicePort) GetDhcpRangeLow() string {
+func (m *ZInfoDeviceCert) GetSerialNumber() string {
 	if m != nil {
-		return m.DhcpRangeLow
+		return m.SerialNumber
 	}
 	return ""
 }
 
-func (m *DevicePort) GetDhcpRangeHigh() string {
+func (m *ZInfoDeviceCert) GetLastRenewTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.DhcpRangeHigh
+		return m.LastRenewTime
+	}
+	return nil
+}
+
+func (m *ZInfoDeviceCert) GetLastRenewError() string {
+	if m != nil {
+		return m.LastRenewError
 	}
 	return ""
 }
 
-func (m *DevicePort) GetProxy() *ProxyStatus {
+func (m *ZInfoDeviceCert) GetLastRenewErrorTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Proxy
+		return m.LastRenewErrorTime
 	}
 	return nil
 }
 
-type ProxyStatus struct {
-	Proxies              []*ProxyEntry `protobuf:"bytes,1,rep,name=proxies,proto3" json:"proxies,omitempty"`
-	Exceptions           string        `protobuf:"bytes,2,opt,name=exceptions,proto3" json:"exceptions,omitempty"`
-	Pacfile              string        `protobuf:"bytes,3,opt,name=pacfile,proto3" json:"pacfile,omitempty"`
-	NetworkProxyEnable   bool          `protobuf:"varint,4,opt,name=networkProxyEnable,proto3" json:"networkProxyEnable,omitempty"`
-	NetworkProxyURL      string        `protobuf:"bytes,5,opt,name=networkProxyURL,proto3" json:"networkProxyURL,omitempty"`
-	WpadURL              string        `protobuf:"bytes,6,opt,name=wpadURL,proto3" json:"wpadURL,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type DataSecAtRest struct {
+	State                DataSecAtRestState   `protobuf:"varint,1,opt,name=state,proto3,enum=DataSecAtRestState" json:"state,omitempty"`
+	KeyProvider          string               `protobuf:"bytes,2,opt,name=keyProvider,proto3" json:"keyProvider,omitempty"`
+	Pcrs                 []uint32             `protobuf:"varint,3,rep,packed,name=pcrs,proto3" json:"pcrs,omitempty"`
+	KeyEscrowed          bool                 `protobuf:"varint,4,opt,name=keyEscrowed,proto3" json:"keyEscrowed,omitempty"`
+	LastError            string               `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	LastErrorTime        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastErrorTime,proto3" json:"lastErrorTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ProxyStatus) Reset()         { *m = ProxyStatus{} }
-func (m *ProxyStatus) String() string { return proto.CompactTextString(m) }
-func (*ProxyStatus) ProtoMessage()    {}
-func (*ProxyStatus) Descriptor() ([]byte, []int) {
+func (m *DataSecAtRest) Reset()         { *m = DataSecAtRest{} }
+func (m *DataSecAtRest) String() string { return proto.CompactTextString(m) }
+func (*DataSecAtRest) ProtoMessage()    {}
+func (*DataSecAtRest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{11}
 }
 
-func (m *ProxyStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProxyStatus.Unmarshal(m, b)
+func (m *DataSecAtRest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataSecAtRest.Unmarshal(m, b)
 }
-func (m *ProxyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProxyStatus.Marshal(b, m, deterministic)
+func (m *DataSecAtRest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataSecAtRest.Marshal(b, m, deterministic)
 }
-func (m *ProxyStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProxyStatus.Merge(m, src)
+func (m *DataSecAtRest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSecAtRest.Merge(m, src)
 }
-func (m *ProxyStatus) XXX_Size() int {
-	return xxx_messageInfo_ProxyStatus.Size(m)
+func (m *DataSecAtRest) XXX_Size() int {
+	return xxx_messageInfo_DataSecAtRest.Size(m)
 }
-func (m *ProxyStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProxyStatus.DiscardUnknown(m)
+func (m *DataSecAtRest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSecAtRest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProxyStatus proto.InternalMessageInfo
+var xxx_messageInfo_DataSecAtRest proto.InternalMessageInfo
 
-func (m *ProxyStatus) GetProxies() []*ProxyEntry {
+func (m *DataSecAtRest) GetState() DataSecAtRestState {
 	if m != nil {
-		return m.Proxies
+		return m.State
 	}
-	return nil
+	return DataSecAtRestState_DATASEC_AT_REST_UNKNOWN
 }
 
-func (m *ProxyStatus) GetExceptions() string {
+func (m *DataSecAtRest) GetKeyProvider() string {
 	if m != nil {
-		return m.Exceptions
+		return m.KeyProvider
 	}
 	return ""
 }
 
-func (m *ProxyStatus) GetPacfile() string {
+func (m *DataSecAtRest) GetPcrs() []uint32 {
 	if m != nil {
-		return m.Pacfile
+		return m.Pcrs
 	}
-	return ""
+	return nil
 }
 
-func (m *ProxyStatus) GetNetworkProxyEnable() bool {
+func (m *DataSecAtRest) GetKeyEscrowed() bool {
 	if m != nil {
-		return m.NetworkProxyEnable
+		return m.KeyEscrowed
 	}
 	return false
 }
 
-func (m *ProxyStatus) GetNetworkProxyURL() string {
+func (m *DataSecAtRest) GetLastError() string {
 	if m != nil {
-		return m.NetworkProxyURL
+		return m.LastError
 	}
 	return ""
 }
 
-func (m *ProxyStatus) GetWpadURL() string {
+func (m *DataSecAtRest) GetLastErrorTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.WpadURL
+		return m.LastErrorTime
 	}
-	return ""
+	return nil
 }
 
-type ProxyEntry struct {
-	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Server               string   `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
-	Port                 uint32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The current and fallback system adapter information
+type SystemAdapterInfo struct {
+	CurrentIndex         uint32              `protobuf:"varint,1,opt,name=currentIndex,proto3" json:"currentIndex,omitempty"`
+	Status               []*DevicePortStatus `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ProxyEntry) Reset()         { *m = ProxyEntry{} }
-func (m *ProxyEntry) String() string { return proto.CompactTextString(m) }
-func (*ProxyEntry) ProtoMessage()    {}
-func (*ProxyEntry) Descriptor() ([]byte, []int) {
+func (m *SystemAdapterInfo) Reset()         { *m = SystemAdapterInfo{} }
+func (m *SystemAdapterInfo) String() string { return proto.CompactTextString(m) }
+func (*SystemAdapterInfo) ProtoMessage()    {}
+func (*SystemAdapterInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{12}
 }
 
-func (m *ProxyEntry) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProxyEntry.Unmarshal(m, b)
+func (m *SystemAdapterInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemAdapterInfo.Unmarshal(m, b)
 }
-func (m *ProxyEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProxyEntry.Marshal(b, m, deterministic)
+func (m *SystemAdapterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemAdapterInfo.Marshal(b, m, deterministic)
 }
-func (m *ProxyEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProxyEntry.Merge(m, src)
+func (m *SystemAdapterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemAdapterInfo.Merge(m, src)
 }
-func (m *ProxyEntry) XXX_Size() int {
-	return xxx_messageInfo_ProxyEntry.Size(m)
+func (m *SystemAdapterInfo) XXX_Size() int {
+	return xxx_messageInfo_SystemAdapterInfo.Size(m)
 }
-func (m *ProxyEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProxyEntry.DiscardUnknown(m)
+func (m *SystemAdapterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemAdapterInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProxyEntry proto.InternalMessageInfo
+var xxx_messageInfo_SystemAdapterInfo proto.InternalMessageInfo
 
-func (m *ProxyEntry) GetType() uint32 {
+func (m *SystemAdapterInfo) GetCurrentIndex() uint32 {
 	if m != nil {
-		return m.Type
+		return m.CurrentIndex
 	}
 	return 0
 }
 
-func (m *ProxyEntry) GetServer() string {
-	if m != nil {
-		return m.Server
-	}
-	return ""
-}
-
-func (m *ProxyEntry) GetPort() uint32 {
+func (m *SystemAdapterInfo) GetStatus() []*DevicePortStatus {
 	if m != nil {
-		return m.Port
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-// SW Info for the device base OS
-// Many of these fields are for debug purposes. The ones intended
-// for the UI/cli are userStatus, subStatus, shortVersion, and swErr
-type ZInfoDevSW struct {
-	Activated            bool         `protobuf:"varint,2,opt,name=activated,proto3" json:"activated,omitempty"`
-	PartitionLabel       string       `protobuf:"bytes,3,opt,name=partitionLabel,proto3" json:"partitionLabel,omitempty"`
-	PartitionDevice      string       `protobuf:"bytes,4,opt,name=partitionDevice,proto3" json:"partitionDevice,omitempty"`
-	PartitionState       string       `protobuf:"bytes,5,opt,name=partitionState,proto3" json:"partitionState,omitempty"`
-	Status               ZSwState     `protobuf:"varint,6,opt,name=status,proto3,enum=ZSwState" json:"status,omitempty"`
-	ShortVersion         string       `protobuf:"bytes,7,opt,name=shortVersion,proto3" json:"shortVersion,omitempty"`
-	LongVersion          string       `protobuf:"bytes,8,opt,name=longVersion,proto3" json:"longVersion,omitempty"`
-	SwErr                *ErrorInfo   `protobuf:"bytes,9,opt,name=swErr,proto3" json:"swErr,omitempty"`
-	DownloadProgress     uint32       `protobuf:"varint,10,opt,name=downloadProgress,proto3" json:"downloadProgress,omitempty"`
-	UserStatus           BaseOsStatus `protobuf:"varint,11,opt,name=userStatus,proto3,enum=BaseOsStatus" json:"userStatus,omitempty"`
-	SubStatus            string       `protobuf:"bytes,12,opt,name=subStatus,proto3" json:"subStatus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type DevicePortStatus struct {
+	Version              uint32               `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Key                  string               `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	TimePriority         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timePriority,proto3" json:"timePriority,omitempty"`
+	LastFailed           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=lastFailed,proto3" json:"lastFailed,omitempty"`
+	LastSucceeded        *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastSucceeded,proto3" json:"lastSucceeded,omitempty"`
+	Ports                []*DevicePort        `protobuf:"bytes,6,rep,name=ports,proto3" json:"ports,omitempty"`
+	LastError            string               `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	TestResults          []*ZPortTestResult   `protobuf:"bytes,8,rep,name=testResults,proto3" json:"testResults,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ZInfoDevSW) Reset()         { *m = ZInfoDevSW{} }
-func (m *ZInfoDevSW) String() string { return proto.CompactTextString(m) }
-func (*ZInfoDevSW) ProtoMessage()    {}
-func (*ZInfoDevSW) Descriptor() ([]byte, []int) {
+func (m *DevicePortStatus) Reset()         { *m = DevicePortStatus{} }
+func (m *DevicePortStatus) String() string { return proto.CompactTextString(m) }
+func (*DevicePortStatus) ProtoMessage()    {}
+func (*DevicePortStatus) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{13}
 }
 
-func (m *ZInfoDevSW) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoDevSW.Unmarshal(m, b)
+func (m *DevicePortStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevicePortStatus.Unmarshal(m, b)
 }
-func (m *ZInfoDevSW) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoDevSW.Marshal(b, m, deterministic)
+func (m *DevicePortStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevicePortStatus.Marshal(b, m, deterministic)
 }
-func (m *ZInfoDevSW) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoDevSW.Merge(m, src)
+func (m *DevicePortStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevicePortStatus.Merge(m, src)
 }
-func (m *ZInfoDevSW) XXX_Size() int {
-	return xxx_messageInfo_ZInfoDevSW.Size(m)
+func (m *DevicePortStatus) XXX_Size() int {
+	return xxx_messageInfo_DevicePortStatus.Size(m)
 }
-func (m *ZInfoDevSW) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoDevSW.DiscardUnknown(m)
+func (m *DevicePortStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevicePortStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoDevSW proto.InternalMessageInfo
+var xxx_messageInfo_DevicePortStatus proto.InternalMessageInfo
 
-func (m *ZInfoDevSW) GetActivated() bool {
+func (m *DevicePortStatus) GetVersion() uint32 {
 	if m != nil {
-		return m.Activated
+		return m.Version
 	}
-	return false
+	return 0
 }
 
-func (m *ZInfoDevSW) GetPartitionLabel() string {
+func (m *DevicePortStatus) GetKey() string {
 	if m != nil {
-		return m.PartitionLabel
+		return m.Key
 	}
 	return ""
 }
 
-func (m *ZInfoDevSW) GetPartitionDevice() string {
+func (m *DevicePortStatus) GetTimePriority() *timestamp.Timestamp {
 	if m != nil {
-		return m.PartitionDevice
+		return m.TimePriority
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoDevSW) GetPartitionState() string {
+func (m *DevicePortStatus) GetLastFailed() *timestamp.Timestamp {
 	if m != nil {
-		return m.PartitionState
+		return m.LastFailed
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoDevSW) GetStatus() ZSwState {
+func (m *DevicePortStatus) GetLastSucceeded() *timestamp.Timestamp {
 	if m != nil {
-		return m.Status
+		return m.LastSucceeded
 	}
-	return ZSwState_INVALID
+	return nil
 }
 
-func (m *ZInfoDevSW) GetShortVersion() string {
+func (m *DevicePortStatus) GetPorts() []*DevicePort {
 	if m != nil {
-		return m.ShortVersion
+		return m.Ports
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoDevSW) GetLongVersion() string {
+func (m *DevicePortStatus) GetLastError() string {
 	if m != nil {
-		return m.LongVersion
+		return m.LastError
 	}
 	return ""
 }
 
-func (m *ZInfoDevSW) GetSwErr() *ErrorInfo {
+func (m *DevicePortStatus) GetTestResults() []*ZPortTestResult {
 	if m != nil {
-		return m.SwErr
+		return m.TestResults
 	}
 	return nil
 }
 
-func (m *ZInfoDevSW) GetDownloadProgress() uint32 {
+type DevicePort struct {
+	Ifname string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsMgmt bool   `protobuf:"varint,3,opt,name=isMgmt,proto3" json:"isMgmt,omitempty"`
+	Free   bool   `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
+	// DhcpConfig
+	DhcpType             uint32         `protobuf:"varint,11,opt,name=dhcpType,proto3" json:"dhcpType,omitempty"`
+	Subnet               string         `protobuf:"bytes,12,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway              string         `protobuf:"bytes,13,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Domainname           string         `protobuf:"bytes,14,opt,name=domainname,proto3" json:"domainname,omitempty"`
+	NtpServer            string         `protobuf:"bytes,15,opt,name=ntpServer,proto3" json:"ntpServer,omitempty"`
+	DnsServers           []string       `protobuf:"bytes,16,rep,name=dnsServers,proto3" json:"dnsServers,omitempty"`
+	DhcpRangeLow         string         `protobuf:"bytes,17,opt,name=dhcpRangeLow,proto3" json:"dhcpRangeLow,omitempty"`
+	DhcpRangeHigh        string         `protobuf:"bytes,18,opt,name=dhcpRangeHigh,proto3" json:"dhcpRangeHigh,omitempty"`
+	Proxy                *ProxyStatus   `protobuf:"bytes,21,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	VlanParent           string         `protobuf:"bytes,22,opt,name=vlanParent,proto3" json:"vlanParent,omitempty"`
+	VlanId               uint32         `protobuf:"varint,23,opt,name=vlanId,proto3" json:"vlanId,omitempty"`
+	Wifi                 *ZInfoWifi     `protobuf:"bytes,24,opt,name=wifi,proto3" json:"wifi,omitempty"`
+	Cellular             *ZInfoCellular `protobuf:"bytes,25,opt,name=cellular,proto3" json:"cellular,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DevicePort) Reset()         { *m = DevicePort{} }
+func (m *DevicePort) String() string { return proto.CompactTextString(m) }
+func (*DevicePort) ProtoMessage()    {}
+func (*DevicePort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{14}
+}
+
+func (m *DevicePort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevicePort.Unmarshal(m, b)
+}
+func (m *DevicePort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevicePort.Marshal(b, m, deterministic)
+}
+func (m *DevicePort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevicePort.Merge(m, src)
+}
+func (m *DevicePort) XXX_Size() int {
+	return xxx_messageInfo_DevicePort.Size(m)
+}
+func (m *DevicePort) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevicePort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevicePort proto.InternalMessageInfo
+
+func (m *DevicePort) GetIfname() string {
 	if m != nil {
-		return m.DownloadProgress
+		return m.Ifname
 	}
-	return 0
+	return ""
 }
 
-func (m *ZInfoDevSW) GetUserStatus() BaseOsStatus {
+func (m *DevicePort) GetName() string {
 	if m != nil {
-		return m.UserStatus
+		return m.Name
 	}
-	return BaseOsStatus_NONE
+	return ""
 }
 
-func (m *ZInfoDevSW) GetSubStatus() string {
+func (m *DevicePort) GetIsMgmt() bool {
 	if m != nil {
-		return m.SubStatus
+		return m.IsMgmt
 	}
-	return ""
+	return false
 }
 
-// Per filesystem/partition information
-type ZInfoStorage struct {
-	Device               string   `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-	MountPath            string   `protobuf:"bytes,2,opt,name=mountPath,proto3" json:"mountPath,omitempty"`
-	Total                uint64   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	StorageLocation      bool     `protobuf:"varint,4,opt,name=storageLocation,proto3" json:"storageLocation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DevicePort) GetFree() bool {
+	if m != nil {
+		return m.Free
+	}
+	return false
 }
 
-func (m *ZInfoStorage) Reset()         { *m = ZInfoStorage{} }
-func (m *ZInfoStorage) String() string { return proto.CompactTextString(m) }
-func (*ZInfoStorage) ProtoMessage()    {}
-func (*ZInfoStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{14}
+func (m *DevicePort) GetDhcpType() uint32 {
+	if m != nil {
+		return m.DhcpType
+	}
+	return 0
 }
 
-func (m *ZInfoStorage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoStorage.Unmarshal(m, b)
-}
-func (m *ZInfoStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoStorage.Marshal(b, m, deterministic)
-}
-func (m *ZInfoStorage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoStorage.Merge(m, src)
-}
-func (m *ZInfoStorage) XXX_Size() int {
-	return xxx_messageInfo_ZInfoStorage.Size(m)
-}
-func (m *ZInfoStorage) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoStorage.DiscardUnknown(m)
+func (m *DevicePort) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
 }
 
-var xxx_messageInfo_ZInfoStorage proto.InternalMessageInfo
-
-func (m *ZInfoStorage) GetDevice() string {
+func (m *DevicePort) GetGateway() string {
 	if m != nil {
-		return m.Device
+		return m.Gateway
 	}
 	return ""
 }
 
-func (m *ZInfoStorage) GetMountPath() string {
+func (m *DevicePort) GetDomainname() string {
 	if m != nil {
-		return m.MountPath
+		return m.Domainname
 	}
 	return ""
 }
 
-func (m *ZInfoStorage) GetTotal() uint64 {
+func (m *DevicePort) GetNtpServer() string {
 	if m != nil {
-		return m.Total
+		return m.NtpServer
 	}
-	return 0
+	return ""
 }
 
-func (m *ZInfoStorage) GetStorageLocation() bool {
+func (m *DevicePort) GetDnsServers() []string {
 	if m != nil {
-		return m.StorageLocation
+		return m.DnsServers
 	}
-	return false
+	return nil
 }
 
-type ZInfoApp struct {
-	AppID                string               `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
-	AppVersion           string               `protobuf:"bytes,2,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
-	SystemApp            bool                 `protobuf:"varint,6,opt,name=systemApp,proto3" json:"systemApp,omitempty"`
-	AppName              string               `protobuf:"bytes,7,opt,name=AppName,proto3" json:"AppName,omitempty"`
-	SoftwareList         []*ZInfoSW           `protobuf:"bytes,8,rep,name=softwareList,proto3" json:"softwareList,omitempty"`
-	BootTime             *timestamp.Timestamp `protobuf:"bytes,12,opt,name=bootTime,proto3" json:"bootTime,omitempty"`
-	AssignedAdapters     []*ZioBundle         `protobuf:"bytes,13,rep,name=assignedAdapters,proto3" json:"assignedAdapters,omitempty"`
-	AppErr               []*ErrorInfo         `protobuf:"bytes,14,rep,name=appErr,proto3" json:"appErr,omitempty"`
-	State                ZSwState             `protobuf:"varint,15,opt,name=state,proto3,enum=ZSwState" json:"state,omitempty"`
-	Network              []*ZInfoNetwork      `protobuf:"bytes,16,rep,name=network,proto3" json:"network,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *DevicePort) GetDhcpRangeLow() string {
+	if m != nil {
+		return m.DhcpRangeLow
+	}
+	return ""
 }
 
-func (m *ZInfoApp) Reset()         { *m = ZInfoApp{} }
-func (m *ZInfoApp) String() string { return proto.CompactTextString(m) }
-func (*ZInfoApp) ProtoMessage()    {}
-func (*ZInfoApp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{15}
+func (m *DevicePort) GetDhcpRangeHigh() string {
+	if m != nil {
+		return m.DhcpRangeHigh
+	}
+	return ""
 }
 
-func (m *ZInfoApp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoApp.Unmarshal(m, b)
-}
-func (m *ZInfoApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoApp.Marshal(b, m, deterministic)
-}
-func (m *ZInfoApp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoApp.Merge(m, src)
-}
-func (m *ZInfoApp) XXX_Size() int {
-	return xxx_messageInfo_ZInfoApp.Size(m)
-}
-func (m *ZInfoApp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoApp.DiscardUnknown(m)
+func (m *DevicePort) GetProxy() *ProxyStatus {
+	if m != nil {
+		return m.Proxy
+	}
+	return nil
 }
 
-var xxx_messageInfo_ZInfoApp proto.InternalMessageInfo
-
-func (m *ZInfoApp) GetAppID() string {
+func (m *DevicePort) GetVlanParent() string {
 	if m != nil {
-		return m.AppID
+		return m.VlanParent
 	}
 	return ""
 }
 
-func (m *ZInfoApp) GetAppVersion() string {
+func (m *DevicePort) GetVlanId() uint32 {
 	if m != nil {
-		return m.AppVersion
+		return m.VlanId
 	}
-	return ""
+	return 0
 }
 
-func (m *ZInfoApp) GetSystemApp() bool {
+func (m *DevicePort) GetWifi() *ZInfoWifi {
 	if m != nil {
-		return m.SystemApp
+		return m.Wifi
 	}
-	return false
+	return nil
 }
 
-func (m *ZInfoApp) GetAppName() string {
+func (m *DevicePort) GetCellular() *ZInfoCellular {
 	if m != nil {
-		return m.AppName
+		return m.Cellular
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoApp) GetSoftwareList() []*ZInfoSW {
+type ProxyStatus struct {
+	Proxies              []*ProxyEntry `protobuf:"bytes,1,rep,name=proxies,proto3" json:"proxies,omitempty"`
+	Exceptions           string        `protobuf:"bytes,2,opt,name=exceptions,proto3" json:"exceptions,omitempty"`
+	Pacfile              string        `protobuf:"bytes,3,opt,name=pacfile,proto3" json:"pacfile,omitempty"`
+	NetworkProxyEnable   bool          `protobuf:"varint,4,opt,name=networkProxyEnable,proto3" json:"networkProxyEnable,omitempty"`
+	NetworkProxyURL      string        `protobuf:"bytes,5,opt,name=networkProxyURL,proto3" json:"networkProxyURL,omitempty"`
+	WpadURL              string        `protobuf:"bytes,6,opt,name=wpadURL,proto3" json:"wpadURL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ProxyStatus) Reset()         { *m = ProxyStatus{} }
+func (m *ProxyStatus) String() string { return proto.CompactTextString(m) }
+func (*ProxyStatus) ProtoMessage()    {}
+func (*ProxyStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{15}
+}
+
+func (m *ProxyStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyStatus.Unmarshal(m, b)
+}
+func (m *ProxyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyStatus.Marshal(b, m, deterministic)
+}
+func (m *ProxyStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyStatus.Merge(m, src)
+}
+func (m *ProxyStatus) XXX_Size() int {
+	return xxx_messageInfo_ProxyStatus.Size(m)
+}
+func (m *ProxyStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyStatus proto.InternalMessageInfo
+
+func (m *ProxyStatus) GetProxies() []*ProxyEntry {
 	if m != nil {
-		return m.SoftwareList
+		return m.Proxies
 	}
 	return nil
 }
 
-func (m *ZInfoApp) GetBootTime() *timestamp.Timestamp {
+func (m *ProxyStatus) GetExceptions() string {
 	if m != nil {
-		return m.BootTime
+		return m.Exceptions
 	}
-	return nil
+	return ""
 }
 
-func (m *ZInfoApp) GetAssignedAdapters() []*ZioBundle {
+func (m *ProxyStatus) GetPacfile() string {
 	if m != nil {
-		return m.AssignedAdapters
+		return m.Pacfile
 	}
-	return nil
+	return ""
 }
 
-func (m *ZInfoApp) GetAppErr() []*ErrorInfo {
+func (m *ProxyStatus) GetNetworkProxyEnable() bool {
 	if m != nil {
-		return m.AppErr
+		return m.NetworkProxyEnable
 	}
-	return nil
+	return false
 }
 
-func (m *ZInfoApp) GetState() ZSwState {
+func (m *ProxyStatus) GetNetworkProxyURL() string {
 	if m != nil {
-		return m.State
+		return m.NetworkProxyURL
 	}
-	return ZSwState_INVALID
+	return ""
 }
 
-func (m *ZInfoApp) GetNetwork() []*ZInfoNetwork {
+func (m *ProxyStatus) GetWpadURL() string {
 	if m != nil {
-		return m.Network
+		return m.WpadURL
 	}
-	return nil
+	return ""
 }
 
-// tunnel link details
-type ZInfoVpnLinkInfo struct {
-	SpiId                string   `protobuf:"bytes,1,opt,name=spiId,proto3" json:"spiId,omitempty"`
-	SubNet               string   `protobuf:"bytes,2,opt,name=subNet,proto3" json:"subNet,omitempty"`
-	Direction            bool     `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+type ProxyEntry struct {
+	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Server               string   `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Port                 uint32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZInfoVpnLinkInfo) Reset()         { *m = ZInfoVpnLinkInfo{} }
-func (m *ZInfoVpnLinkInfo) String() string { return proto.CompactTextString(m) }
-func (*ZInfoVpnLinkInfo) ProtoMessage()    {}
-func (*ZInfoVpnLinkInfo) Descriptor() ([]byte, []int) {
+func (m *ProxyEntry) Reset()         { *m = ProxyEntry{} }
+func (m *ProxyEntry) String() string { return proto.CompactTextString(m) }
+func (*ProxyEntry) ProtoMessage()    {}
+func (*ProxyEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{16}
 }
 
-func (m *ZInfoVpnLinkInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoVpnLinkInfo.Unmarshal(m, b)
+func (m *ProxyEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyEntry.Unmarshal(m, b)
 }
-func (m *ZInfoVpnLinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoVpnLinkInfo.Marshal(b, m, deterministic)
+func (m *ProxyEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyEntry.Marshal(b, m, deterministic)
 }
-func (m *ZInfoVpnLinkInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoVpnLinkInfo.Merge(m, src)
+func (m *ProxyEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyEntry.Merge(m, src)
 }
-func (m *ZInfoVpnLinkInfo) XXX_Size() int {
-	return xxx_messageInfo_ZInfoVpnLinkInfo.Size(m)
+func (m *ProxyEntry) XXX_Size() int {
+	return xxx_messageInfo_ProxyEntry.Size(m)
 }
-func (m *ZInfoVpnLinkInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoVpnLinkInfo.DiscardUnknown(m)
+func (m *ProxyEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoVpnLinkInfo proto.InternalMessageInfo
+var xxx_messageInfo_ProxyEntry proto.InternalMessageInfo
 
-func (m *ZInfoVpnLinkInfo) GetSpiId() string {
+func (m *ProxyEntry) GetType() uint32 {
 	if m != nil {
-		return m.SpiId
+		return m.Type
 	}
-	return ""
+	return 0
 }
 
-func (m *ZInfoVpnLinkInfo) GetSubNet() string {
+func (m *ProxyEntry) GetServer() string {
 	if m != nil {
-		return m.SubNet
+		return m.Server
 	}
 	return ""
 }
 
-func (m *ZInfoVpnLinkInfo) GetDirection() bool {
+func (m *ProxyEntry) GetPort() uint32 {
 	if m != nil {
-		return m.Direction
+		return m.Port
 	}
-	return false
+	return 0
 }
 
-// Esp details
-type ZInfoVpnLink struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ReqId                string            `protobuf:"bytes,3,opt,name=reqId,proto3" json:"reqId,omitempty"`
-	InstTime             uint64            `protobuf:"varint,4,opt,name=instTime,proto3" json:"instTime,omitempty"`
-	EspInfo              string            `protobuf:"bytes,5,opt,name=espInfo,proto3" json:"espInfo,omitempty"`
-	State                ZInfoVpnState     `protobuf:"varint,6,opt,name=state,proto3,enum=ZInfoVpnState" json:"state,omitempty"`
-	LInfo                *ZInfoVpnLinkInfo `protobuf:"bytes,10,opt,name=lInfo,proto3" json:"lInfo,omitempty"`
-	RInfo                *ZInfoVpnLinkInfo `protobuf:"bytes,11,opt,name=rInfo,proto3" json:"rInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// SW Info for the device base OS
+// Many of these fields are for debug purposes. The ones intended
+// for the UI/cli are userStatus, subStatus, shortVersion, and swErr
+type ZInfoDevSW struct {
+	Activated            bool         `protobuf:"varint,2,opt,name=activated,proto3" json:"activated,omitempty"`
+	PartitionLabel       string       `protobuf:"bytes,3,opt,name=partitionLabel,proto3" json:"partitionLabel,omitempty"`
+	PartitionDevice      string       `protobuf:"bytes,4,opt,name=partitionDevice,proto3" json:"partitionDevice,omitempty"`
+	PartitionState       string       `protobuf:"bytes,5,opt,name=partitionState,proto3" json:"partitionState,omitempty"`
+	Status               ZSwState     `protobuf:"varint,6,opt,name=status,proto3,enum=ZSwState" json:"status,omitempty"`
+	ShortVersion         string       `protobuf:"bytes,7,opt,name=shortVersion,proto3" json:"shortVersion,omitempty"`
+	LongVersion          string       `protobuf:"bytes,8,opt,name=longVersion,proto3" json:"longVersion,omitempty"`
+	SwErr                *ErrorInfo   `protobuf:"bytes,9,opt,name=swErr,proto3" json:"swErr,omitempty"`
+	DownloadProgress     uint32       `protobuf:"varint,10,opt,name=downloadProgress,proto3" json:"downloadProgress,omitempty"`
+	UserStatus           BaseOsStatus `protobuf:"varint,11,opt,name=userStatus,proto3,enum=BaseOsStatus" json:"userStatus,omitempty"`
+	SubStatus            string       `protobuf:"bytes,12,opt,name=subStatus,proto3" json:"subStatus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ZInfoVpnLink) Reset()         { *m = ZInfoVpnLink{} }
-func (m *ZInfoVpnLink) String() string { return proto.CompactTextString(m) }
-func (*ZInfoVpnLink) ProtoMessage()    {}
-func (*ZInfoVpnLink) Descriptor() ([]byte, []int) {
+func (m *ZInfoDevSW) Reset()         { *m = ZInfoDevSW{} }
+func (m *ZInfoDevSW) String() string { return proto.CompactTextString(m) }
+func (*ZInfoDevSW) ProtoMessage()    {}
+func (*ZInfoDevSW) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{17}
 }
 
-func (m *ZInfoVpnLink) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoVpnLink.Unmarshal(m, b)
+func (m *ZInfoDevSW) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoDevSW.Unmarshal(m, b)
 }
-func (m *ZInfoVpnLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoVpnLink.Marshal(b, m, deterministic)
+func (m *ZInfoDevSW) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoDevSW.Marshal(b, m, deterministic)
 }
-func (m *ZInfoVpnLink) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoVpnLink.Merge(m, src)
+func (m *ZInfoDevSW) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoDevSW.Merge(m, src)
 }
-func (m *ZInfoVpnLink) XXX_Size() int {
-	return xxx_messageInfo_ZInfoVpnLink.Size(m)
+func (m *ZInfoDevSW) XXX_Size() int {
+	return xxx_messageInfo_ZInfoDevSW.Size(m)
 }
-func (m *ZInfoVpnLink) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoVpnLink.DiscardUnknown(m)
+func (m *ZInfoDevSW) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoDevSW.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoVpnLink proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoDevSW proto.InternalMessageInfo
 
-func (m *ZInfoVpnLink) GetId() string {
+func (m *ZInfoDevSW) GetActivated() bool {
 	if m != nil {
-		return m.Id
+		return m.Activated
+	}
+	return false
+}
+
+func (m *ZInfoDevSW) GetPartitionLabel() string {
+	if m != nil {
+		return m.PartitionLabel
 	}
 	return ""
 }
 
-func (m *ZInfoVpnLink) GetName() string {
+func (m *ZInfoDevSW) GetPartitionDevice() string {
 	if m != nil {
-		return m.Name
+		return m.PartitionDevice
 	}
 	return ""
 }
 
-func (m *ZInfoVpnLink) GetReqId() string {
+func (m *ZInfoDevSW) GetPartitionState() string {
 	if m != nil {
-		return m.ReqId
+		return m.PartitionState
 	}
 	return ""
 }
 
-func (m *ZInfoVpnLink) GetInstTime() uint64 {
+func (m *ZInfoDevSW) GetStatus() ZSwState {
 	if m != nil {
-		return m.InstTime
+		return m.Status
 	}
-	return 0
+	return ZSwState_INVALID
 }
 
-func (m *ZInfoVpnLink) GetEspInfo() string {
+func (m *ZInfoDevSW) GetShortVersion() string {
 	if m != nil {
-		return m.EspInfo
+		return m.ShortVersion
 	}
 	return ""
 }
 
-func (m *ZInfoVpnLink) GetState() ZInfoVpnState {
+func (m *ZInfoDevSW) GetLongVersion() string {
 	if m != nil {
-		return m.State
+		return m.LongVersion
 	}
-	return ZInfoVpnState_VPN_INVALID
+	return ""
 }
 
-func (m *ZInfoVpnLink) GetLInfo() *ZInfoVpnLinkInfo {
+func (m *ZInfoDevSW) GetSwErr() *ErrorInfo {
 	if m != nil {
-		return m.LInfo
+		return m.SwErr
 	}
 	return nil
 }
 
-func (m *ZInfoVpnLink) GetRInfo() *ZInfoVpnLinkInfo {
+func (m *ZInfoDevSW) GetDownloadProgress() uint32 {
 	if m != nil {
-		return m.RInfo
+		return m.DownloadProgress
 	}
-	return nil
+	return 0
 }
 
-// ipsec left/right end-point info
-type ZInfoVpnEndPoint struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IpAddr               string   `protobuf:"bytes,2,opt,name=ipAddr,proto3" json:"ipAddr,omitempty"`
-	Port                 uint32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+func (m *ZInfoDevSW) GetUserStatus() BaseOsStatus {
+	if m != nil {
+		return m.UserStatus
+	}
+	return BaseOsStatus_NONE
+}
+
+func (m *ZInfoDevSW) GetSubStatus() string {
+	if m != nil {
+		return m.SubStatus
+	}
+	return ""
+}
+
+// Per filesystem/partition information
+type ZInfoStorage struct {
+	Device               string   `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	MountPath            string   `protobuf:"bytes,2,opt,name=mountPath,proto3" json:"mountPath,omitempty"`
+	Total                uint64   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	StorageLocation      bool     `protobuf:"varint,4,opt,name=storageLocation,proto3" json:"storageLocation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZInfoVpnEndPoint) Reset()         { *m = ZInfoVpnEndPoint{} }
-func (m *ZInfoVpnEndPoint) String() string { return proto.CompactTextString(m) }
-func (*ZInfoVpnEndPoint) ProtoMessage()    {}
-func (*ZInfoVpnEndPoint) Descriptor() ([]byte, []int) {
+func (m *ZInfoStorage) Reset()         { *m = ZInfoStorage{} }
+func (m *ZInfoStorage) String() string { return proto.CompactTextString(m) }
+func (*ZInfoStorage) ProtoMessage()    {}
+func (*ZInfoStorage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{18}
 }
 
-func (m *ZInfoVpnEndPoint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoVpnEndPoint.Unmarshal(m, b)
+func (m *ZInfoStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoStorage.Unmarshal(m, b)
 }
-func (m *ZInfoVpnEndPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoVpnEndPoint.Marshal(b, m, deterministic)
+func (m *ZInfoStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoStorage.Marshal(b, m, deterministic)
 }
-func (m *ZInfoVpnEndPoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoVpnEndPoint.Merge(m, src)
+func (m *ZInfoStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoStorage.Merge(m, src)
 }
-func (m *ZInfoVpnEndPoint) XXX_Size() int {
-	return xxx_messageInfo_ZInfoVpnEndPoint.Size(m)
+func (m *ZInfoStorage) XXX_Size() int {
+	return xxx_messageInfo_ZInfoStorage.Size(m)
 }
-func (m *ZInfoVpnEndPoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoVpnEndPoint.DiscardUnknown(m)
+func (m *ZInfoStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoStorage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoVpnEndPoint proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoStorage proto.InternalMessageInfo
 
-func (m *ZInfoVpnEndPoint) GetId() string {
+func (m *ZInfoStorage) GetDevice() string {
 	if m != nil {
-		return m.Id
+		return m.Device
 	}
 	return ""
 }
 
-func (m *ZInfoVpnEndPoint) GetIpAddr() string {
+func (m *ZInfoStorage) GetMountPath() string {
 	if m != nil {
-		return m.IpAddr
+		return m.MountPath
 	}
 	return ""
 }
 
-func (m *ZInfoVpnEndPoint) GetPort() uint32 {
+func (m *ZInfoStorage) GetTotal() uint64 {
 	if m != nil {
-		return m.Port
+		return m.Total
 	}
 	return 0
 }
 
-// Ike details
-type ZInfoVpnConn struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Version              string            `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	EstTime              uint64            `protobuf:"varint,4,opt,name=estTime,proto3" json:"estTime,omitempty"`
-	Ikes                 string            `protobuf:"bytes,5,opt,name=ikes,proto3" json:"ikes,omitempty"`
-	State                ZInfoVpnState     `protobuf:"varint,6,opt,name=state,proto3,enum=ZInfoVpnState" json:"state,omitempty"`
-	LInfo                *ZInfoVpnEndPoint `protobuf:"bytes,7,opt,name=lInfo,proto3" json:"lInfo,omitempty"`
-	RInfo                *ZInfoVpnEndPoint `protobuf:"bytes,8,opt,name=rInfo,proto3" json:"rInfo,omitempty"`
-	Links                []*ZInfoVpnLink   `protobuf:"bytes,10,rep,name=links,proto3" json:"links,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ZInfoStorage) GetStorageLocation() bool {
+	if m != nil {
+		return m.StorageLocation
+	}
+	return false
 }
 
-func (m *ZInfoVpnConn) Reset()         { *m = ZInfoVpnConn{} }
-func (m *ZInfoVpnConn) String() string { return proto.CompactTextString(m) }
-func (*ZInfoVpnConn) ProtoMessage()    {}
-func (*ZInfoVpnConn) Descriptor() ([]byte, []int) {
+type ZInfoApp struct {
+	AppID                string               `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	AppVersion           string               `protobuf:"bytes,2,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	SystemApp            bool                 `protobuf:"varint,6,opt,name=systemApp,proto3" json:"systemApp,omitempty"`
+	AppName              string               `protobuf:"bytes,7,opt,name=AppName,proto3" json:"AppName,omitempty"`
+	SoftwareList         []*ZInfoSW           `protobuf:"bytes,8,rep,name=softwareList,proto3" json:"softwareList,omitempty"`
+	BootTime             *timestamp.Timestamp `protobuf:"bytes,12,opt,name=bootTime,proto3" json:"bootTime,omitempty"`
+	AssignedAdapters     []*ZioBundle         `protobuf:"bytes,13,rep,name=assignedAdapters,proto3" json:"assignedAdapters,omitempty"`
+	AppErr               []*ErrorInfo         `protobuf:"bytes,14,rep,name=appErr,proto3" json:"appErr,omitempty"`
+	State                ZSwState             `protobuf:"varint,15,opt,name=state,proto3,enum=ZSwState" json:"state,omitempty"`
+	Network              []*ZInfoNetwork      `protobuf:"bytes,16,rep,name=network,proto3" json:"network,omitempty"`
+	Health               *ZInfoAppHealth      `protobuf:"bytes,17,opt,name=health,proto3" json:"health,omitempty"`
+	WaitingFor           string               `protobuf:"bytes,18,opt,name=waitingFor,proto3" json:"waitingFor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ZInfoApp) Reset()         { *m = ZInfoApp{} }
+func (m *ZInfoApp) String() string { return proto.CompactTextString(m) }
+func (*ZInfoApp) ProtoMessage()    {}
+func (*ZInfoApp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{19}
 }
 
-func (m *ZInfoVpnConn) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoVpnConn.Unmarshal(m, b)
+func (m *ZInfoApp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoApp.Unmarshal(m, b)
 }
-func (m *ZInfoVpnConn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoVpnConn.Marshal(b, m, deterministic)
+func (m *ZInfoApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoApp.Marshal(b, m, deterministic)
 }
-func (m *ZInfoVpnConn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoVpnConn.Merge(m, src)
+func (m *ZInfoApp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoApp.Merge(m, src)
 }
-func (m *ZInfoVpnConn) XXX_Size() int {
-	return xxx_messageInfo_ZInfoVpnConn.Size(m)
+func (m *ZInfoApp) XXX_Size() int {
+	return xxx_messageInfo_ZInfoApp.Size(m)
 }
-func (m *ZInfoVpnConn) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoVpnConn.DiscardUnknown(m)
+func (m *ZInfoApp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoApp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoVpnConn proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoApp proto.InternalMessageInfo
 
-func (m *ZInfoVpnConn) GetId() string {
+func (m *ZInfoApp) GetAppID() string {
 	if m != nil {
-		return m.Id
+		return m.AppID
 	}
 	return ""
 }
 
-func (m *ZInfoVpnConn) GetName() string {
+func (m *ZInfoApp) GetAppVersion() string {
 	if m != nil {
-		return m.Name
+		return m.AppVersion
 	}
 	return ""
 }
 
-func (m *ZInfoVpnConn) GetVersion() string {
+func (m *ZInfoApp) GetSystemApp() bool {
 	if m != nil {
-		return m.Version
+		return m.SystemApp
 	}
-	return ""
+	return false
 }
 
-func (m *ZInfoVpnConn) GetEstTime() uint64 {
+func (m *ZInfoApp) GetAppName() string {
 	if m != nil {
-		return m.EstTime
+		return m.AppName
 	}
-	return 0
+	return ""
 }
 
-func (m *ZInfoVpnConn) GetIkes() string {
+func (m *ZInfoApp) GetSoftwareList() []*ZInfoSW {
 	if m != nil {
-		return m.Ikes
+		return m.SoftwareList
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoVpnConn) GetState() ZInfoVpnState {
+func (m *ZInfoApp) GetBootTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.State
+		return m.BootTime
 	}
-	return ZInfoVpnState_VPN_INVALID
+	return nil
 }
 
-func (m *ZInfoVpnConn) GetLInfo() *ZInfoVpnEndPoint {
+func (m *ZInfoApp) GetAssignedAdapters() []*ZioBundle {
 	if m != nil {
-		return m.LInfo
+		return m.AssignedAdapters
 	}
 	return nil
 }
 
-func (m *ZInfoVpnConn) GetRInfo() *ZInfoVpnEndPoint {
+func (m *ZInfoApp) GetAppErr() []*ErrorInfo {
 	if m != nil {
-		return m.RInfo
+		return m.AppErr
 	}
 	return nil
 }
 
-func (m *ZInfoVpnConn) GetLinks() []*ZInfoVpnLink {
+func (m *ZInfoApp) GetState() ZSwState {
 	if m != nil {
-		return m.Links
+		return m.State
+	}
+	return ZSwState_INVALID
+}
+
+func (m *ZInfoApp) GetNetwork() []*ZInfoNetwork {
+	if m != nil {
+		return m.Network
 	}
 	return nil
 }
 
-// ipsec level information
-type ZInfoVpn struct {
-	UpTime               uint64          `protobuf:"varint,1,opt,name=upTime,proto3" json:"upTime,omitempty"`
-	PolicyBased          bool            `protobuf:"varint,2,opt,name=policyBased,proto3" json:"policyBased,omitempty"`
-	ListeningIpAddrs     []string        `protobuf:"bytes,3,rep,name=listeningIpAddrs,proto3" json:"listeningIpAddrs,omitempty"`
-	Conn                 []*ZInfoVpnConn `protobuf:"bytes,10,rep,name=conn,proto3" json:"conn,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ZInfoApp) GetHealth() *ZInfoAppHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
 }
 
-func (m *ZInfoVpn) Reset()         { *m = ZInfoVpn{} }
-func (m *ZInfoVpn) String() string { return proto.CompactTextString(m) }
-func (*ZInfoVpn) ProtoMessage()    {}
-func (*ZInfoVpn) Descriptor() ([]byte, []int) {
+func (m *ZInfoApp) GetWaitingFor() string {
+	if m != nil {
+		return m.WaitingFor
+	}
+	return ""
+}
+
+// Health probe results and restarts based on the restart policy
+type ZInfoAppHealth struct {
+	State                ZAppHealthState      `protobuf:"varint,1,opt,name=state,proto3,enum=ZAppHealthState" json:"state,omitempty"`
+	StateTime            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=stateTime,proto3" json:"stateTime,omitempty"`
+	LastProbeError       string               `protobuf:"bytes,3,opt,name=lastProbeError,proto3" json:"lastProbeError,omitempty"`
+	ConsecutiveFailures  uint32               `protobuf:"varint,4,opt,name=consecutiveFailures,proto3" json:"consecutiveFailures,omitempty"`
+	RestartCount         uint32               `protobuf:"varint,5,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	LastRestartTime      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastRestartTime,proto3" json:"lastRestartTime,omitempty"`
+	LastRestartReason    string               `protobuf:"bytes,7,opt,name=lastRestartReason,proto3" json:"lastRestartReason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ZInfoAppHealth) Reset()         { *m = ZInfoAppHealth{} }
+func (m *ZInfoAppHealth) String() string { return proto.CompactTextString(m) }
+func (*ZInfoAppHealth) ProtoMessage()    {}
+func (*ZInfoAppHealth) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{20}
 }
 
-func (m *ZInfoVpn) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoVpn.Unmarshal(m, b)
+func (m *ZInfoAppHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoAppHealth.Unmarshal(m, b)
 }
-func (m *ZInfoVpn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoVpn.Marshal(b, m, deterministic)
+func (m *ZInfoAppHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoAppHealth.Marshal(b, m, deterministic)
 }
-func (m *ZInfoVpn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoVpn.Merge(m, src)
+func (m *ZInfoAppHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoAppHealth.Merge(m, src)
 }
-func (m *ZInfoVpn) XXX_Size() int {
-	return xxx_messageInfo_ZInfoVpn.Size(m)
+func (m *ZInfoAppHealth) XXX_Size() int {
+	return xxx_messageInfo_ZInfoAppHealth.Size(m)
 }
-func (m *ZInfoVpn) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoVpn.DiscardUnknown(m)
+func (m *ZInfoAppHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoAppHealth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoVpn proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoAppHealth proto.InternalMessageInfo
 
-func (m *ZInfoVpn) GetUpTime() uint64 {
+func (m *ZInfoAppHealth) GetState() ZAppHealthState {
 	if m != nil {
-		return m.UpTime
+		return m.State
+	}
+	return ZAppHealthState_APP_HEALTH_UNKNOWN
+}
+
+func (m *ZInfoAppHealth) GetStateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StateTime
+	}
+	return nil
+}
+
+func (m *ZInfoAppHealth) GetLastProbeError() string {
+	if m != nil {
+		return m.LastProbeError
+	}
+	return ""
+}
+
+func (m *ZInfoAppHealth) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
 	}
 	return 0
 }
 
-func (m *ZInfoVpn) GetPolicyBased() bool {
+func (m *ZInfoAppHealth) GetRestartCount() uint32 {
 	if m != nil {
-		return m.PolicyBased
+		return m.RestartCount
 	}
-	return false
+	return 0
 }
 
-func (m *ZInfoVpn) GetListeningIpAddrs() []string {
+func (m *ZInfoAppHealth) GetLastRestartTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.ListeningIpAddrs
+		return m.LastRestartTime
 	}
 	return nil
 }
 
-func (m *ZInfoVpn) GetConn() []*ZInfoVpnConn {
+func (m *ZInfoAppHealth) GetLastRestartReason() string {
 	if m != nil {
-		return m.Conn
+		return m.LastRestartReason
 	}
-	return nil
+	return ""
 }
 
-// Lisp state information
-type RlocState struct {
-	Rloc                 string   `protobuf:"bytes,1,opt,name=Rloc,proto3" json:"Rloc,omitempty"`
-	Reachable            bool     `protobuf:"varint,2,opt,name=Reachable,proto3" json:"Reachable,omitempty"`
+// tunnel link details
+type ZInfoVpnLinkInfo struct {
+	SpiId                string   `protobuf:"bytes,1,opt,name=spiId,proto3" json:"spiId,omitempty"`
+	SubNet               string   `protobuf:"bytes,2,opt,name=subNet,proto3" json:"subNet,omitempty"`
+	Direction            bool     `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RlocState) Reset()         { *m = RlocState{} }
-func (m *RlocState) String() string { return proto.CompactTextString(m) }
-func (*RlocState) ProtoMessage()    {}
-func (*RlocState) Descriptor() ([]byte, []int) {
+func (m *ZInfoVpnLinkInfo) Reset()         { *m = ZInfoVpnLinkInfo{} }
+func (m *ZInfoVpnLinkInfo) String() string { return proto.CompactTextString(m) }
+func (*ZInfoVpnLinkInfo) ProtoMessage()    {}
+func (*ZInfoVpnLinkInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{21}
 }
 
-func (m *RlocState) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RlocState.Unmarshal(m, b)
+func (m *ZInfoVpnLinkInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoVpnLinkInfo.Unmarshal(m, b)
 }
-func (m *RlocState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RlocState.Marshal(b, m, deterministic)
+func (m *ZInfoVpnLinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoVpnLinkInfo.Marshal(b, m, deterministic)
 }
-func (m *RlocState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RlocState.Merge(m, src)
+func (m *ZInfoVpnLinkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoVpnLinkInfo.Merge(m, src)
 }
-func (m *RlocState) XXX_Size() int {
-	return xxx_messageInfo_RlocState.Size(m)
+func (m *ZInfoVpnLinkInfo) XXX_Size() int {
+	return xxx_messageInfo_ZInfoVpnLinkInfo.Size(m)
 }
-func (m *RlocState) XXX_DiscardUnknown() {
-	xxx_messageInfo_RlocState.DiscardUnknown(m)
+func (m *ZInfoVpnLinkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoVpnLinkInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RlocState proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoVpnLinkInfo proto.InternalMessageInfo
 
-func (m *RlocState) GetRloc() string {
+func (m *ZInfoVpnLinkInfo) GetSpiId() string {
 	if m != nil {
-		return m.Rloc
+		return m.SpiId
 	}
 	return ""
 }
 
-func (m *RlocState) GetReachable() bool {
+func (m *ZInfoVpnLinkInfo) GetSubNet() string {
 	if m != nil {
-		return m.Reachable
+		return m.SubNet
+	}
+	return ""
+}
+
+func (m *ZInfoVpnLinkInfo) GetDirection() bool {
+	if m != nil {
+		return m.Direction
 	}
 	return false
 }
 
-type MapCacheEntry struct {
-	EID                  string       `protobuf:"bytes,1,opt,name=EID,proto3" json:"EID,omitempty"`
-	Rlocs                []*RlocState `protobuf:"bytes,2,rep,name=Rlocs,proto3" json:"Rlocs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+// Esp details
+type ZInfoVpnLink struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ReqId                string            `protobuf:"bytes,3,opt,name=reqId,proto3" json:"reqId,omitempty"`
+	InstTime             uint64            `protobuf:"varint,4,opt,name=instTime,proto3" json:"instTime,omitempty"`
+	EspInfo              string            `protobuf:"bytes,5,opt,name=espInfo,proto3" json:"espInfo,omitempty"`
+	State                ZInfoVpnState     `protobuf:"varint,6,opt,name=state,proto3,enum=ZInfoVpnState" json:"state,omitempty"`
+	LInfo                *ZInfoVpnLinkInfo `protobuf:"bytes,10,opt,name=lInfo,proto3" json:"lInfo,omitempty"`
+	RInfo                *ZInfoVpnLinkInfo `protobuf:"bytes,11,opt,name=rInfo,proto3" json:"rInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *MapCacheEntry) Reset()         { *m = MapCacheEntry{} }
-func (m *MapCacheEntry) String() string { return proto.CompactTextString(m) }
-func (*MapCacheEntry) ProtoMessage()    {}
-func (*MapCacheEntry) Descriptor() ([]byte, []int) {
+func (m *ZInfoVpnLink) Reset()         { *m = ZInfoVpnLink{} }
+func (m *ZInfoVpnLink) String() string { return proto.CompactTextString(m) }
+func (*ZInfoVpnLink) ProtoMessage()    {}
+func (*ZInfoVpnLink) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd6f5fc136c65f52, []int{22}
 }
 
-func (m *MapCacheEntry) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MapCacheEntry.Unmarshal(m, b)
+func (m *ZInfoVpnLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoVpnLink.Unmarshal(m, b)
 }
-func (m *MapCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MapCacheEntry.Marshal(b, m, deterministic)
+func (m *ZInfoVpnLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoVpnLink.Marshal(b, m, deterministic)
 }
-func (m *MapCacheEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapCacheEntry.Merge(m, src)
+func (m *ZInfoVpnLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoVpnLink.Merge(m, src)
 }
-func (m *MapCacheEntry) XXX_Size() int {
-	return xxx_messageInfo_MapCacheEntry.Size(m)
+func (m *ZInfoVpnLink) XXX_Size() int {
+	return xxx_messageInfo_ZInfoVpnLink.Size(m)
 }
-func (m *MapCacheEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapCacheEntry.DiscardUnknown(m)
+func (m *ZInfoVpnLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoVpnLink.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapCacheEntry proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoVpnLink proto.InternalMessageInfo
 
-func (m *MapCacheEntry) GetEID() string {
+func (m *ZInfoVpnLink) GetId() string {
 	if m != nil {
-		return m.EID
+		return m.Id
 	}
 	return ""
 }
 
-func (m *MapCacheEntry) GetRlocs() []*RlocState {
+func (m *ZInfoVpnLink) GetName() string {
 	if m != nil {
-		return m.Rlocs
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type DatabaseMap struct {
-	IID                  uint64           `protobuf:"varint,1,opt,name=IID,proto3" json:"IID,omitempty"`
-	MapCacheEntries      []*MapCacheEntry `protobuf:"bytes,2,rep,name=MapCacheEntries,proto3" json:"MapCacheEntries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ZInfoVpnLink) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
 }
 
-func (m *DatabaseMap) Reset()         { *m = DatabaseMap{} }
-func (m *DatabaseMap) String() string { return proto.CompactTextString(m) }
-func (*DatabaseMap) ProtoMessage()    {}
-func (*DatabaseMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{23}
+func (m *ZInfoVpnLink) GetInstTime() uint64 {
+	if m != nil {
+		return m.InstTime
+	}
+	return 0
 }
 
-func (m *DatabaseMap) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DatabaseMap.Unmarshal(m, b)
-}
-func (m *DatabaseMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DatabaseMap.Marshal(b, m, deterministic)
-}
-func (m *DatabaseMap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DatabaseMap.Merge(m, src)
-}
-func (m *DatabaseMap) XXX_Size() int {
-	return xxx_messageInfo_DatabaseMap.Size(m)
-}
-func (m *DatabaseMap) XXX_DiscardUnknown() {
-	xxx_messageInfo_DatabaseMap.DiscardUnknown(m)
+func (m *ZInfoVpnLink) GetEspInfo() string {
+	if m != nil {
+		return m.EspInfo
+	}
+	return ""
 }
 
-var xxx_messageInfo_DatabaseMap proto.InternalMessageInfo
-
-func (m *DatabaseMap) GetIID() uint64 {
+func (m *ZInfoVpnLink) GetState() ZInfoVpnState {
 	if m != nil {
-		return m.IID
+		return m.State
 	}
-	return 0
+	return ZInfoVpnState_VPN_INVALID
 }
 
-func (m *DatabaseMap) GetMapCacheEntries() []*MapCacheEntry {
+func (m *ZInfoVpnLink) GetLInfo() *ZInfoVpnLinkInfo {
 	if m != nil {
-		return m.MapCacheEntries
+		return m.LInfo
 	}
 	return nil
 }
 
-type DecapKey struct {
-	Rloc                 string   `protobuf:"bytes,1,opt,name=Rloc,proto3" json:"Rloc,omitempty"`
-	Port                 uint64   `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
-	KeyCount             uint64   `protobuf:"varint,3,opt,name=KeyCount,proto3" json:"KeyCount,omitempty"`
+func (m *ZInfoVpnLink) GetRInfo() *ZInfoVpnLinkInfo {
+	if m != nil {
+		return m.RInfo
+	}
+	return nil
+}
+
+// ipsec left/right end-point info
+type ZInfoVpnEndPoint struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IpAddr               string   `protobuf:"bytes,2,opt,name=ipAddr,proto3" json:"ipAddr,omitempty"`
+	Port                 uint32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DecapKey) Reset()         { *m = DecapKey{} }
-func (m *DecapKey) String() string { return proto.CompactTextString(m) }
-func (*DecapKey) ProtoMessage()    {}
-func (*DecapKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{24}
+func (m *ZInfoVpnEndPoint) Reset()         { *m = ZInfoVpnEndPoint{} }
+func (m *ZInfoVpnEndPoint) String() string { return proto.CompactTextString(m) }
+func (*ZInfoVpnEndPoint) ProtoMessage()    {}
+func (*ZInfoVpnEndPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{23}
 }
 
-func (m *DecapKey) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DecapKey.Unmarshal(m, b)
+func (m *ZInfoVpnEndPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoVpnEndPoint.Unmarshal(m, b)
 }
-func (m *DecapKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DecapKey.Marshal(b, m, deterministic)
+func (m *ZInfoVpnEndPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoVpnEndPoint.Marshal(b, m, deterministic)
 }
-func (m *DecapKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecapKey.Merge(m, src)
+func (m *ZInfoVpnEndPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoVpnEndPoint.Merge(m, src)
 }
-func (m *DecapKey) XXX_Size() int {
-	return xxx_messageInfo_DecapKey.Size(m)
+func (m *ZInfoVpnEndPoint) XXX_Size() int {
+	return xxx_messageInfo_ZInfoVpnEndPoint.Size(m)
 }
-func (m *DecapKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_DecapKey.DiscardUnknown(m)
+func (m *ZInfoVpnEndPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoVpnEndPoint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DecapKey proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoVpnEndPoint proto.InternalMessageInfo
 
-func (m *DecapKey) GetRloc() string {
+func (m *ZInfoVpnEndPoint) GetId() string {
 	if m != nil {
-		return m.Rloc
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DecapKey) GetPort() uint64 {
+func (m *ZInfoVpnEndPoint) GetIpAddr() string {
 	if m != nil {
-		return m.Port
+		return m.IpAddr
 	}
-	return 0
+	return ""
 }
 
-func (m *DecapKey) GetKeyCount() uint64 {
+func (m *ZInfoVpnEndPoint) GetPort() uint32 {
 	if m != nil {
-		return m.KeyCount
+		return m.Port
 	}
 	return 0
 }
 
-type ZInfoLisp struct {
-	ItrCryptoPort        uint64         `protobuf:"varint,1,opt,name=ItrCryptoPort,proto3" json:"ItrCryptoPort,omitempty"`
-	EtrNatPort           uint64         `protobuf:"varint,2,opt,name=EtrNatPort,proto3" json:"EtrNatPort,omitempty"`
-	Interfaces           []string       `protobuf:"bytes,3,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
-	DatabaseMaps         []*DatabaseMap `protobuf:"bytes,4,rep,name=DatabaseMaps,proto3" json:"DatabaseMaps,omitempty"`
-	DecapKeys            []*DecapKey    `protobuf:"bytes,5,rep,name=DecapKeys,proto3" json:"DecapKeys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// Ike details
+type ZInfoVpnConn struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string            `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	EstTime              uint64            `protobuf:"varint,4,opt,name=estTime,proto3" json:"estTime,omitempty"`
+	Ikes                 string            `protobuf:"bytes,5,opt,name=ikes,proto3" json:"ikes,omitempty"`
+	State                ZInfoVpnState     `protobuf:"varint,6,opt,name=state,proto3,enum=ZInfoVpnState" json:"state,omitempty"`
+	LInfo                *ZInfoVpnEndPoint `protobuf:"bytes,7,opt,name=lInfo,proto3" json:"lInfo,omitempty"`
+	RInfo                *ZInfoVpnEndPoint `protobuf:"bytes,8,opt,name=rInfo,proto3" json:"rInfo,omitempty"`
+	Links                []*ZInfoVpnLink   `protobuf:"bytes,10,rep,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ZInfoLisp) Reset()         { *m = ZInfoLisp{} }
-func (m *ZInfoLisp) String() string { return proto.CompactTextString(m) }
-func (*ZInfoLisp) ProtoMessage()    {}
-func (*ZInfoLisp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{25}
+func (m *ZInfoVpnConn) Reset()         { *m = ZInfoVpnConn{} }
+func (m *ZInfoVpnConn) String() string { return proto.CompactTextString(m) }
+func (*ZInfoVpnConn) ProtoMessage()    {}
+func (*ZInfoVpnConn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{24}
 }
 
-func (m *ZInfoLisp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoLisp.Unmarshal(m, b)
+func (m *ZInfoVpnConn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoVpnConn.Unmarshal(m, b)
 }
-func (m *ZInfoLisp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoLisp.Marshal(b, m, deterministic)
+func (m *ZInfoVpnConn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoVpnConn.Marshal(b, m, deterministic)
 }
-func (m *ZInfoLisp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoLisp.Merge(m, src)
+func (m *ZInfoVpnConn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoVpnConn.Merge(m, src)
 }
-func (m *ZInfoLisp) XXX_Size() int {
-	return xxx_messageInfo_ZInfoLisp.Size(m)
+func (m *ZInfoVpnConn) XXX_Size() int {
+	return xxx_messageInfo_ZInfoVpnConn.Size(m)
 }
-func (m *ZInfoLisp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoLisp.DiscardUnknown(m)
+func (m *ZInfoVpnConn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoVpnConn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoLisp proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoVpnConn proto.InternalMessageInfo
 
-func (m *ZInfoLisp) GetItrCryptoPort() uint64 {
+func (m *ZInfoVpnConn) GetId() string {
 	if m != nil {
-		return m.ItrCryptoPort
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *ZInfoLisp) GetEtrNatPort() uint64 {
+func (m *ZInfoVpnConn) GetName() string {
 	if m != nil {
-		return m.EtrNatPort
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ZInfoVpnConn) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ZInfoVpnConn) GetEstTime() uint64 {
+	if m != nil {
+		return m.EstTime
 	}
 	return 0
 }
 
-func (m *ZInfoLisp) GetInterfaces() []string {
+func (m *ZInfoVpnConn) GetIkes() string {
 	if m != nil {
-		return m.Interfaces
+		return m.Ikes
+	}
+	return ""
+}
+
+func (m *ZInfoVpnConn) GetState() ZInfoVpnState {
+	if m != nil {
+		return m.State
+	}
+	return ZInfoVpnState_VPN_INVALID
+}
+
+func (m *ZInfoVpnConn) GetLInfo() *ZInfoVpnEndPoint {
+	if m != nil {
+		return m.LInfo
 	}
 	return nil
 }
 
-func (m *ZInfoLisp) GetDatabaseMaps() []*DatabaseMap {
+func (m *ZInfoVpnConn) GetRInfo() *ZInfoVpnEndPoint {
 	if m != nil {
-		return m.DatabaseMaps
+		return m.RInfo
 	}
 	return nil
 }
 
-func (m *ZInfoLisp) GetDecapKeys() []*DecapKey {
+func (m *ZInfoVpnConn) GetLinks() []*ZInfoVpnLink {
 	if m != nil {
-		return m.DecapKeys
+		return m.Links
 	}
 	return nil
 }
 
-// Service instance state information XXX to be deprecated
-type ZInfoService struct {
-	ServiceID        string               `protobuf:"bytes,1,opt,name=serviceID,proto3" json:"serviceID,omitempty"`
-	ServiceName      string               `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	ServiceType      uint32               `protobuf:"varint,3,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
-	SoftwareList     *ZInfoSW             `protobuf:"bytes,4,opt,name=softwareList,proto3" json:"softwareList,omitempty"`
-	Activated        bool                 `protobuf:"varint,5,opt,name=activated,proto3" json:"activated,omitempty"`
-	UpTimeStamp      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=upTimeStamp,proto3" json:"upTimeStamp,omitempty"`
-	AssignedAdapters []*ZioBundle         `protobuf:"bytes,7,rep,name=assignedAdapters,proto3" json:"assignedAdapters,omitempty"`
-	SvcErr           []*ErrorInfo         `protobuf:"bytes,8,rep,name=svcErr,proto3" json:"svcErr,omitempty"`
-	Network          []*ZInfoNetwork      `protobuf:"bytes,9,rep,name=network,proto3" json:"network,omitempty"`
-	// Types that are valid to be assigned to InfoContent:
-	//	*ZInfoService_Vinfo
-	//	*ZInfoService_Linfo
-	InfoContent          isZInfoService_InfoContent `protobuf_oneof:"InfoContent"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+// ipsec level information
+type ZInfoVpn struct {
+	UpTime               uint64          `protobuf:"varint,1,opt,name=upTime,proto3" json:"upTime,omitempty"`
+	PolicyBased          bool            `protobuf:"varint,2,opt,name=policyBased,proto3" json:"policyBased,omitempty"`
+	ListeningIpAddrs     []string        `protobuf:"bytes,3,rep,name=listeningIpAddrs,proto3" json:"listeningIpAddrs,omitempty"`
+	Conn                 []*ZInfoVpnConn `protobuf:"bytes,10,rep,name=conn,proto3" json:"conn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ZInfoService) Reset()         { *m = ZInfoService{} }
-func (m *ZInfoService) String() string { return proto.CompactTextString(m) }
-func (*ZInfoService) ProtoMessage()    {}
-func (*ZInfoService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{26}
+func (m *ZInfoVpn) Reset()         { *m = ZInfoVpn{} }
+func (m *ZInfoVpn) String() string { return proto.CompactTextString(m) }
+func (*ZInfoVpn) ProtoMessage()    {}
+func (*ZInfoVpn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{25}
 }
 
-func (m *ZInfoService) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoService.Unmarshal(m, b)
+func (m *ZInfoVpn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoVpn.Unmarshal(m, b)
 }
-func (m *ZInfoService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoService.Marshal(b, m, deterministic)
+func (m *ZInfoVpn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoVpn.Marshal(b, m, deterministic)
 }
-func (m *ZInfoService) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoService.Merge(m, src)
+func (m *ZInfoVpn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoVpn.Merge(m, src)
 }
-func (m *ZInfoService) XXX_Size() int {
-	return xxx_messageInfo_ZInfoService.Size(m)
+func (m *ZInfoVpn) XXX_Size() int {
+	return xxx_messageInfo_ZInfoVpn.Size(m)
 }
-func (m *ZInfoService) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoService.DiscardUnknown(m)
+func (m *ZInfoVpn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoVpn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoService proto.InternalMessageInfo
-
-func (m *ZInfoService) GetServiceID() string {
-	if m != nil {
-		return m.ServiceID
-	}
-	return ""
-}
+var xxx_messageInfo_ZInfoVpn proto.InternalMessageInfo
 
-func (m *ZInfoService) GetServiceName() string {
+func (m *ZInfoVpn) GetUpTime() uint64 {
 	if m != nil {
-		return m.ServiceName
+		return m.UpTime
 	}
-	return ""
+	return 0
 }
 
-func (m *ZInfoService) GetServiceType() uint32 {
+func (m *ZInfoVpn) GetPolicyBased() bool {
 	if m != nil {
-		return m.ServiceType
+		return m.PolicyBased
 	}
-	return 0
+	return false
 }
 
-func (m *ZInfoService) GetSoftwareList() *ZInfoSW {
+func (m *ZInfoVpn) GetListeningIpAddrs() []string {
 	if m != nil {
-		return m.SoftwareList
+		return m.ListeningIpAddrs
 	}
 	return nil
 }
 
-func (m *ZInfoService) GetActivated() bool {
+func (m *ZInfoVpn) GetConn() []*ZInfoVpnConn {
 	if m != nil {
-		return m.Activated
+		return m.Conn
 	}
-	return false
+	return nil
 }
 
-func (m *ZInfoService) GetUpTimeStamp() *timestamp.Timestamp {
+// Lisp state information
+type RlocState struct {
+	Rloc                 string   `protobuf:"bytes,1,opt,name=Rloc,proto3" json:"Rloc,omitempty"`
+	Reachable            bool     `protobuf:"varint,2,opt,name=Reachable,proto3" json:"Reachable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RlocState) Reset()         { *m = RlocState{} }
+func (m *RlocState) String() string { return proto.CompactTextString(m) }
+func (*RlocState) ProtoMessage()    {}
+func (*RlocState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{26}
+}
+
+func (m *RlocState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RlocState.Unmarshal(m, b)
+}
+func (m *RlocState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RlocState.Marshal(b, m, deterministic)
+}
+func (m *RlocState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RlocState.Merge(m, src)
+}
+func (m *RlocState) XXX_Size() int {
+	return xxx_messageInfo_RlocState.Size(m)
+}
+func (m *RlocState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RlocState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RlocState proto.InternalMessageInfo
+
+func (m *RlocState) GetRloc() string {
+	if m != nil {
+		return m.Rloc
+	}
+	return ""
+}
+
+func (m *RlocState) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+type MapCacheEntry struct {
+	EID                  string       `protobuf:"bytes,1,opt,name=EID,proto3" json:"EID,omitempty"`
+	Rlocs                []*RlocState `protobuf:"bytes,2,rep,name=Rlocs,proto3" json:"Rlocs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MapCacheEntry) Reset()         { *m = MapCacheEntry{} }
+func (m *MapCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*MapCacheEntry) ProtoMessage()    {}
+func (*MapCacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{27}
+}
+
+func (m *MapCacheEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapCacheEntry.Unmarshal(m, b)
+}
+func (m *MapCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapCacheEntry.Marshal(b, m, deterministic)
+}
+func (m *MapCacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapCacheEntry.Merge(m, src)
+}
+func (m *MapCacheEntry) XXX_Size() int {
+	return xxx_messageInfo_MapCacheEntry.Size(m)
+}
+func (m *MapCacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapCacheEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapCacheEntry proto.InternalMessageInfo
+
+func (m *MapCacheEntry) GetEID() string {
+	if m != nil {
+		return m.EID
+	}
+	return ""
+}
+
+func (m *MapCacheEntry) GetRlocs() []*RlocState {
+	if m != nil {
+		return m.Rlocs
+	}
+	return nil
+}
+
+type DatabaseMap struct {
+	IID                  uint64           `protobuf:"varint,1,opt,name=IID,proto3" json:"IID,omitempty"`
+	MapCacheEntries      []*MapCacheEntry `protobuf:"bytes,2,rep,name=MapCacheEntries,proto3" json:"MapCacheEntries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DatabaseMap) Reset()         { *m = DatabaseMap{} }
+func (m *DatabaseMap) String() string { return proto.CompactTextString(m) }
+func (*DatabaseMap) ProtoMessage()    {}
+func (*DatabaseMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{28}
+}
+
+func (m *DatabaseMap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseMap.Unmarshal(m, b)
+}
+func (m *DatabaseMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseMap.Marshal(b, m, deterministic)
+}
+func (m *DatabaseMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseMap.Merge(m, src)
+}
+func (m *DatabaseMap) XXX_Size() int {
+	return xxx_messageInfo_DatabaseMap.Size(m)
+}
+func (m *DatabaseMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseMap proto.InternalMessageInfo
+
+func (m *DatabaseMap) GetIID() uint64 {
+	if m != nil {
+		return m.IID
+	}
+	return 0
+}
+
+func (m *DatabaseMap) GetMapCacheEntries() []*MapCacheEntry {
+	if m != nil {
+		return m.MapCacheEntries
+	}
+	return nil
+}
+
+type DecapKey struct {
+	Rloc                 string   `protobuf:"bytes,1,opt,name=Rloc,proto3" json:"Rloc,omitempty"`
+	Port                 uint64   `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	KeyCount             uint64   `protobuf:"varint,3,opt,name=KeyCount,proto3" json:"KeyCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecapKey) Reset()         { *m = DecapKey{} }
+func (m *DecapKey) String() string { return proto.CompactTextString(m) }
+func (*DecapKey) ProtoMessage()    {}
+func (*DecapKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{29}
+}
+
+func (m *DecapKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecapKey.Unmarshal(m, b)
+}
+func (m *DecapKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecapKey.Marshal(b, m, deterministic)
+}
+func (m *DecapKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecapKey.Merge(m, src)
+}
+func (m *DecapKey) XXX_Size() int {
+	return xxx_messageInfo_DecapKey.Size(m)
+}
+func (m *DecapKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecapKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecapKey proto.InternalMessageInfo
+
+func (m *DecapKey) GetRloc() string {
+	if m != nil {
+		return m.Rloc
+	}
+	return ""
+}
+
+func (m *DecapKey) GetPort() uint64 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *DecapKey) GetKeyCount() uint64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+type ZInfoLisp struct {
+	ItrCryptoPort        uint64         `protobuf:"varint,1,opt,name=ItrCryptoPort,proto3" json:"ItrCryptoPort,omitempty"`
+	EtrNatPort           uint64         `protobuf:"varint,2,opt,name=EtrNatPort,proto3" json:"EtrNatPort,omitempty"`
+	Interfaces           []string       `protobuf:"bytes,3,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
+	DatabaseMaps         []*DatabaseMap `protobuf:"bytes,4,rep,name=DatabaseMaps,proto3" json:"DatabaseMaps,omitempty"`
+	DecapKeys            []*DecapKey    `protobuf:"bytes,5,rep,name=DecapKeys,proto3" json:"DecapKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ZInfoLisp) Reset()         { *m = ZInfoLisp{} }
+func (m *ZInfoLisp) String() string { return proto.CompactTextString(m) }
+func (*ZInfoLisp) ProtoMessage()    {}
+func (*ZInfoLisp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{30}
+}
+
+func (m *ZInfoLisp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoLisp.Unmarshal(m, b)
+}
+func (m *ZInfoLisp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoLisp.Marshal(b, m, deterministic)
+}
+func (m *ZInfoLisp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoLisp.Merge(m, src)
+}
+func (m *ZInfoLisp) XXX_Size() int {
+	return xxx_messageInfo_ZInfoLisp.Size(m)
+}
+func (m *ZInfoLisp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoLisp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoLisp proto.InternalMessageInfo
+
+func (m *ZInfoLisp) GetItrCryptoPort() uint64 {
+	if m != nil {
+		return m.ItrCryptoPort
+	}
+	return 0
+}
+
+func (m *ZInfoLisp) GetEtrNatPort() uint64 {
+	if m != nil {
+		return m.EtrNatPort
+	}
+	return 0
+}
+
+func (m *ZInfoLisp) GetInterfaces() []string {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+func (m *ZInfoLisp) GetDatabaseMaps() []*DatabaseMap {
+	if m != nil {
+		return m.DatabaseMaps
+	}
+	return nil
+}
+
+func (m *ZInfoLisp) GetDecapKeys() []*DecapKey {
+	if m != nil {
+		return m.DecapKeys
+	}
+	return nil
+}
+
+// Service instance state information XXX to be deprecated
+type ZInfoService struct {
+	ServiceID        string               `protobuf:"bytes,1,opt,name=serviceID,proto3" json:"serviceID,omitempty"`
+	ServiceName      string               `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ServiceType      uint32               `protobuf:"varint,3,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
+	SoftwareList     *ZInfoSW             `protobuf:"bytes,4,opt,name=softwareList,proto3" json:"softwareList,omitempty"`
+	Activated        bool                 `protobuf:"varint,5,opt,name=activated,proto3" json:"activated,omitempty"`
+	UpTimeStamp      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=upTimeStamp,proto3" json:"upTimeStamp,omitempty"`
+	AssignedAdapters []*ZioBundle         `protobuf:"bytes,7,rep,name=assignedAdapters,proto3" json:"assignedAdapters,omitempty"`
+	SvcErr           []*ErrorInfo         `protobuf:"bytes,8,rep,name=svcErr,proto3" json:"svcErr,omitempty"`
+	Network          []*ZInfoNetwork      `protobuf:"bytes,9,rep,name=network,proto3" json:"network,omitempty"`
+	// Types that are valid to be assigned to InfoContent:
+	//	*ZInfoService_Vinfo
+	//	*ZInfoService_Linfo
+	InfoContent          isZInfoService_InfoContent `protobuf_oneof:"InfoContent"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ZInfoService) Reset()         { *m = ZInfoService{} }
+func (m *ZInfoService) String() string { return proto.CompactTextString(m) }
+func (*ZInfoService) ProtoMessage()    {}
+func (*ZInfoService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{31}
+}
+
+func (m *ZInfoService) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoService.Unmarshal(m, b)
+}
+func (m *ZInfoService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoService.Marshal(b, m, deterministic)
+}
+func (m *ZInfoService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoService.Merge(m, src)
+}
+func (m *ZInfoService) XXX_Size() int {
+	return xxx_messageInfo_ZInfoService.Size(m)
+}
+func (m *ZInfoService) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoService.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoService proto.InternalMessageInfo
+
+func (m *ZInfoService) GetServiceID() string {
+	if m != nil {
+		return m.ServiceID
+	}
+	return ""
+}
+
+func (m *ZInfoService) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *ZInfoService) GetServiceType() uint32 {
+	if m != nil {
+		return m.ServiceType
+	}
+	return 0
+}
+
+func (m *ZInfoService) GetSoftwareList() *ZInfoSW {
+	if m != nil {
+		return m.SoftwareList
+	}
+	return nil
+}
+
+func (m *ZInfoService) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *ZInfoService) GetUpTimeStamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpTimeStamp
+	}
+	return nil
+}
+
+func (m *ZInfoService) GetAssignedAdapters() []*ZioBundle {
+	if m != nil {
+		return m.AssignedAdapters
+	}
+	return nil
+}
+
+func (m *ZInfoService) GetSvcErr() []*ErrorInfo {
+	if m != nil {
+		return m.SvcErr
+	}
+	return nil
+}
+
+func (m *ZInfoService) GetNetwork() []*ZInfoNetwork {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+type isZInfoService_InfoContent interface {
+	isZInfoService_InfoContent()
+}
+
+type ZInfoService_Vinfo struct {
+	Vinfo *ZInfoVpn `protobuf:"bytes,20,opt,name=vinfo,proto3,oneof"`
+}
+
+type ZInfoService_Linfo struct {
+	Linfo *ZInfoLisp `protobuf:"bytes,21,opt,name=linfo,proto3,oneof"`
+}
+
+func (*ZInfoService_Vinfo) isZInfoService_InfoContent() {}
+
+func (*ZInfoService_Linfo) isZInfoService_InfoContent() {}
+
+func (m *ZInfoService) GetInfoContent() isZInfoService_InfoContent {
+	if m != nil {
+		return m.InfoContent
+	}
+	return nil
+}
+
+func (m *ZInfoService) GetVinfo() *ZInfoVpn {
+	if x, ok := m.GetInfoContent().(*ZInfoService_Vinfo); ok {
+		return x.Vinfo
+	}
+	return nil
+}
+
+func (m *ZInfoService) GetLinfo() *ZInfoLisp {
+	if x, ok := m.GetInfoContent().(*ZInfoService_Linfo); ok {
+		return x.Linfo
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZInfoService) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZInfoService_Vinfo)(nil),
+		(*ZInfoService_Linfo)(nil),
+	}
+}
+
+// Network Object information XXX to be deprecated
+type ZInfoNetworkObject struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// From NetworkObjectConfig; do we need to include all of these?
+	NetworkType   uint32   `protobuf:"varint,2,opt,name=networkType,proto3" json:"networkType,omitempty"`
+	DhcpType      uint32   `protobuf:"varint,3,opt,name=dhcpType,proto3" json:"dhcpType,omitempty"`
+	Subnet        string   `protobuf:"bytes,4,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway       string   `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Domainname    string   `protobuf:"bytes,6,opt,name=domainname,proto3" json:"domainname,omitempty"`
+	NtpServer     string   `protobuf:"bytes,7,opt,name=ntpServer,proto3" json:"ntpServer,omitempty"`
+	DnsServers    []string `protobuf:"bytes,8,rep,name=dnsServers,proto3" json:"dnsServers,omitempty"`
+	DhcpRangeLow  string   `protobuf:"bytes,9,opt,name=dhcpRangeLow,proto3" json:"dhcpRangeLow,omitempty"`
+	DhcpRangeHigh string   `protobuf:"bytes,10,opt,name=dhcpRangeHigh,proto3" json:"dhcpRangeHigh,omitempty"`
+	// From NetworkObjectStatus
+	BridgeNum            uint32                   `protobuf:"varint,12,opt,name=bridgeNum,proto3" json:"bridgeNum,omitempty"`
+	BridgeName           string                   `protobuf:"bytes,13,opt,name=bridgeName,proto3" json:"bridgeName,omitempty"`
+	BridgeIPAddr         string                   `protobuf:"bytes,14,opt,name=bridgeIPAddr,proto3" json:"bridgeIPAddr,omitempty"`
+	IpAssignments        []*ZmetIPAssignmentEntry `protobuf:"bytes,15,rep,name=ipAssignments,proto3" json:"ipAssignments,omitempty"`
+	BridgeIPSets         []string                 `protobuf:"bytes,16,rep,name=bridgeIPSets,proto3" json:"bridgeIPSets,omitempty"`
+	VifNames             []string                 `protobuf:"bytes,17,rep,name=vifNames,proto3" json:"vifNames,omitempty"`
+	Ipv4Eid              bool                     `protobuf:"varint,18,opt,name=ipv4Eid,proto3" json:"ipv4Eid,omitempty"`
+	NetworkErr           []*ErrorInfo             `protobuf:"bytes,19,rep,name=networkErr,proto3" json:"networkErr,omitempty"`
+	Vifs                 []*ZmetVifInfo           `protobuf:"bytes,25,rep,name=vifs,proto3" json:"vifs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ZInfoNetworkObject) Reset()         { *m = ZInfoNetworkObject{} }
+func (m *ZInfoNetworkObject) String() string { return proto.CompactTextString(m) }
+func (*ZInfoNetworkObject) ProtoMessage()    {}
+func (*ZInfoNetworkObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{32}
+}
+
+func (m *ZInfoNetworkObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoNetworkObject.Unmarshal(m, b)
+}
+func (m *ZInfoNetworkObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoNetworkObject.Marshal(b, m, deterministic)
+}
+func (m *ZInfoNetworkObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoNetworkObject.Merge(m, src)
+}
+func (m *ZInfoNetworkObject) XXX_Size() int {
+	return xxx_messageInfo_ZInfoNetworkObject.Size(m)
+}
+func (m *ZInfoNetworkObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoNetworkObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoNetworkObject proto.InternalMessageInfo
+
+func (m *ZInfoNetworkObject) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetNetworkType() uint32 {
+	if m != nil {
+		return m.NetworkType
+	}
+	return 0
+}
+
+func (m *ZInfoNetworkObject) GetDhcpType() uint32 {
+	if m != nil {
+		return m.DhcpType
+	}
+	return 0
+}
+
+func (m *ZInfoNetworkObject) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetDomainname() string {
+	if m != nil {
+		return m.Domainname
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetNtpServer() string {
+	if m != nil {
+		return m.NtpServer
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetDnsServers() []string {
+	if m != nil {
+		return m.DnsServers
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkObject) GetDhcpRangeLow() string {
+	if m != nil {
+		return m.DhcpRangeLow
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetDhcpRangeHigh() string {
+	if m != nil {
+		return m.DhcpRangeHigh
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetBridgeNum() uint32 {
+	if m != nil {
+		return m.BridgeNum
+	}
+	return 0
+}
+
+func (m *ZInfoNetworkObject) GetBridgeName() string {
+	if m != nil {
+		return m.BridgeName
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetBridgeIPAddr() string {
+	if m != nil {
+		return m.BridgeIPAddr
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkObject) GetIpAssignments() []*ZmetIPAssignmentEntry {
+	if m != nil {
+		return m.IpAssignments
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkObject) GetBridgeIPSets() []string {
+	if m != nil {
+		return m.BridgeIPSets
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkObject) GetVifNames() []string {
+	if m != nil {
+		return m.VifNames
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkObject) GetIpv4Eid() bool {
+	if m != nil {
+		return m.Ipv4Eid
+	}
+	return false
+}
+
+func (m *ZInfoNetworkObject) GetNetworkErr() []*ErrorInfo {
+	if m != nil {
+		return m.NetworkErr
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkObject) GetVifs() []*ZmetVifInfo {
+	if m != nil {
+		return m.Vifs
+	}
+	return nil
+}
+
+// Network Instance information
+type ZInfoNetworkInstance struct {
+	NetworkID      string                   `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	NetworkVersion string                   `protobuf:"bytes,3,opt,name=networkVersion,proto3" json:"networkVersion,omitempty"`
+	InstType       uint32                   `protobuf:"varint,5,opt,name=instType,proto3" json:"instType,omitempty"`
+	Displayname    string                   `protobuf:"bytes,6,opt,name=displayname,proto3" json:"displayname,omitempty"`
+	Activated      bool                     `protobuf:"varint,7,opt,name=activated,proto3" json:"activated,omitempty"`
+	UpTimeStamp    *timestamp.Timestamp     `protobuf:"bytes,8,opt,name=upTimeStamp,proto3" json:"upTimeStamp,omitempty"`
+	SoftwareList   *ZInfoSW                 `protobuf:"bytes,9,opt,name=softwareList,proto3" json:"softwareList,omitempty"`
+	BridgeNum      uint32                   `protobuf:"varint,20,opt,name=bridgeNum,proto3" json:"bridgeNum,omitempty"`
+	BridgeName     string                   `protobuf:"bytes,21,opt,name=bridgeName,proto3" json:"bridgeName,omitempty"`
+	BridgeIPAddr   string                   `protobuf:"bytes,22,opt,name=bridgeIPAddr,proto3" json:"bridgeIPAddr,omitempty"`
+	IpAssignments  []*ZmetIPAssignmentEntry `protobuf:"bytes,23,rep,name=ipAssignments,proto3" json:"ipAssignments,omitempty"`
+	BridgeIPSets   []string                 `protobuf:"bytes,24,rep,name=bridgeIPSets,proto3" json:"bridgeIPSets,omitempty"`
+	Vifs           []*ZmetVifInfo           `protobuf:"bytes,25,rep,name=vifs,proto3" json:"vifs,omitempty"`
+	Ipv4Eid        bool                     `protobuf:"varint,26,opt,name=ipv4Eid,proto3" json:"ipv4Eid,omitempty"`
+	// If the network instance has IPv6
+	BridgeIPv6Addr string `protobuf:"bytes,27,opt,name=bridgeIPv6Addr,proto3" json:"bridgeIPv6Addr,omitempty"`
+	// The /64 used for the applications
+	Ipv6Subnet string `protobuf:"bytes,28,opt,name=ipv6Subnet,proto3" json:"ipv6Subnet,omitempty"`
+	// Ports used by a Local instance
+	Uplinks          []*ZInfoUplink `protobuf:"bytes,29,rep,name=uplinks,proto3" json:"uplinks,omitempty"`
+	AssignedAdapters []*ZioBundle   `protobuf:"bytes,30,rep,name=assignedAdapters,proto3" json:"assignedAdapters,omitempty"`
+	// Types that are valid to be assigned to InfoContent:
+	//	*ZInfoNetworkInstance_Vinfo
+	//	*ZInfoNetworkInstance_Linfo
+	InfoContent          isZInfoNetworkInstance_InfoContent `protobuf_oneof:"InfoContent"`
+	NetworkErr           []*ErrorInfo                       `protobuf:"bytes,40,rep,name=networkErr,proto3" json:"networkErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ZInfoNetworkInstance) Reset()         { *m = ZInfoNetworkInstance{} }
+func (m *ZInfoNetworkInstance) String() string { return proto.CompactTextString(m) }
+func (*ZInfoNetworkInstance) ProtoMessage()    {}
+func (*ZInfoNetworkInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{33}
+}
+
+func (m *ZInfoNetworkInstance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoNetworkInstance.Unmarshal(m, b)
+}
+func (m *ZInfoNetworkInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoNetworkInstance.Marshal(b, m, deterministic)
+}
+func (m *ZInfoNetworkInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoNetworkInstance.Merge(m, src)
+}
+func (m *ZInfoNetworkInstance) XXX_Size() int {
+	return xxx_messageInfo_ZInfoNetworkInstance.Size(m)
+}
+func (m *ZInfoNetworkInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoNetworkInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoNetworkInstance proto.InternalMessageInfo
+
+func (m *ZInfoNetworkInstance) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetNetworkVersion() string {
+	if m != nil {
+		return m.NetworkVersion
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetInstType() uint32 {
+	if m != nil {
+		return m.InstType
+	}
+	return 0
+}
+
+func (m *ZInfoNetworkInstance) GetDisplayname() string {
+	if m != nil {
+		return m.Displayname
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *ZInfoNetworkInstance) GetUpTimeStamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpTimeStamp
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetSoftwareList() *ZInfoSW {
+	if m != nil {
+		return m.SoftwareList
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetBridgeNum() uint32 {
+	if m != nil {
+		return m.BridgeNum
+	}
+	return 0
+}
+
+func (m *ZInfoNetworkInstance) GetBridgeName() string {
+	if m != nil {
+		return m.BridgeName
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetBridgeIPAddr() string {
+	if m != nil {
+		return m.BridgeIPAddr
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetIpAssignments() []*ZmetIPAssignmentEntry {
+	if m != nil {
+		return m.IpAssignments
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetBridgeIPSets() []string {
+	if m != nil {
+		return m.BridgeIPSets
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetVifs() []*ZmetVifInfo {
+	if m != nil {
+		return m.Vifs
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetIpv4Eid() bool {
+	if m != nil {
+		return m.Ipv4Eid
+	}
+	return false
+}
+
+func (m *ZInfoNetworkInstance) GetBridgeIPv6Addr() string {
+	if m != nil {
+		return m.BridgeIPv6Addr
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetIpv6Subnet() string {
+	if m != nil {
+		return m.Ipv6Subnet
+	}
+	return ""
+}
+
+func (m *ZInfoNetworkInstance) GetUplinks() []*ZInfoUplink {
+	if m != nil {
+		return m.Uplinks
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetAssignedAdapters() []*ZioBundle {
+	if m != nil {
+		return m.AssignedAdapters
+	}
+	return nil
+}
+
+type isZInfoNetworkInstance_InfoContent interface {
+	isZInfoNetworkInstance_InfoContent()
+}
+
+type ZInfoNetworkInstance_Vinfo struct {
+	Vinfo *ZInfoVpn `protobuf:"bytes,31,opt,name=vinfo,proto3,oneof"`
+}
+
+type ZInfoNetworkInstance_Linfo struct {
+	Linfo *ZInfoLisp `protobuf:"bytes,32,opt,name=linfo,proto3,oneof"`
+}
+
+func (*ZInfoNetworkInstance_Vinfo) isZInfoNetworkInstance_InfoContent() {}
+
+func (*ZInfoNetworkInstance_Linfo) isZInfoNetworkInstance_InfoContent() {}
+
+func (m *ZInfoNetworkInstance) GetInfoContent() isZInfoNetworkInstance_InfoContent {
+	if m != nil {
+		return m.InfoContent
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetVinfo() *ZInfoVpn {
+	if x, ok := m.GetInfoContent().(*ZInfoNetworkInstance_Vinfo); ok {
+		return x.Vinfo
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetLinfo() *ZInfoLisp {
+	if x, ok := m.GetInfoContent().(*ZInfoNetworkInstance_Linfo); ok {
+		return x.Linfo
+	}
+	return nil
+}
+
+func (m *ZInfoNetworkInstance) GetNetworkErr() []*ErrorInfo {
+	if m != nil {
+		return m.NetworkErr
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZInfoNetworkInstance) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZInfoNetworkInstance_Vinfo)(nil),
+		(*ZInfoNetworkInstance_Linfo)(nil),
+	}
+}
+
+// Map from MAC to IP addresses
+type ZmetIPAssignmentEntry struct {
+	MacAddress           string   `protobuf:"bytes,1,opt,name=macAddress,proto3" json:"macAddress,omitempty"`
+	IpAddress            []string `protobuf:"bytes,2,rep,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZmetIPAssignmentEntry) Reset()         { *m = ZmetIPAssignmentEntry{} }
+func (m *ZmetIPAssignmentEntry) String() string { return proto.CompactTextString(m) }
+func (*ZmetIPAssignmentEntry) ProtoMessage()    {}
+func (*ZmetIPAssignmentEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{34}
+}
+
+func (m *ZmetIPAssignmentEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZmetIPAssignmentEntry.Unmarshal(m, b)
+}
+func (m *ZmetIPAssignmentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZmetIPAssignmentEntry.Marshal(b, m, deterministic)
+}
+func (m *ZmetIPAssignmentEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZmetIPAssignmentEntry.Merge(m, src)
+}
+func (m *ZmetIPAssignmentEntry) XXX_Size() int {
+	return xxx_messageInfo_ZmetIPAssignmentEntry.Size(m)
+}
+func (m *ZmetIPAssignmentEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZmetIPAssignmentEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZmetIPAssignmentEntry proto.InternalMessageInfo
+
+func (m *ZmetIPAssignmentEntry) GetMacAddress() string {
+	if m != nil {
+		return m.MacAddress
+	}
+	return ""
+}
+
+func (m *ZmetIPAssignmentEntry) GetIpAddress() []string {
+	if m != nil {
+		return m.IpAddress
+	}
+	return nil
+}
+
+// Map from vifname to other info
+type ZmetVifInfo struct {
+	VifName              string   `protobuf:"bytes,1,opt,name=vifName,proto3" json:"vifName,omitempty"`
+	MacAddress           string   `protobuf:"bytes,2,opt,name=macAddress,proto3" json:"macAddress,omitempty"`
+	AppID                string   `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZmetVifInfo) Reset()         { *m = ZmetVifInfo{} }
+func (m *ZmetVifInfo) String() string { return proto.CompactTextString(m) }
+func (*ZmetVifInfo) ProtoMessage()    {}
+func (*ZmetVifInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{35}
+}
+
+func (m *ZmetVifInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZmetVifInfo.Unmarshal(m, b)
+}
+func (m *ZmetVifInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZmetVifInfo.Marshal(b, m, deterministic)
+}
+func (m *ZmetVifInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZmetVifInfo.Merge(m, src)
+}
+func (m *ZmetVifInfo) XXX_Size() int {
+	return xxx_messageInfo_ZmetVifInfo.Size(m)
+}
+func (m *ZmetVifInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZmetVifInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZmetVifInfo proto.InternalMessageInfo
+
+func (m *ZmetVifInfo) GetVifName() string {
+	if m != nil {
+		return m.VifName
+	}
+	return ""
+}
+
+func (m *ZmetVifInfo) GetMacAddress() string {
+	if m != nil {
+		return m.MacAddress
+	}
+	return ""
+}
+
+func (m *ZmetVifInfo) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+type ZInfoMsg struct {
+	Ztype ZInfoTypes `protobuf:"varint,1,opt,name=ztype,proto3,enum=ZInfoTypes" json:"ztype,omitempty"`
+	DevId string     `protobuf:"bytes,2,opt,name=devId,proto3" json:"devId,omitempty"`
+	// Types that are valid to be assigned to InfoContent:
+	//	*ZInfoMsg_Dinfo
+	//	*ZInfoMsg_Ainfo
+	//	*ZInfoMsg_Sinfo
+	//	*ZInfoMsg_Ninfo
+	//	*ZInfoMsg_Niinfo
+	InfoContent          isZInfoMsg_InfoContent `protobuf_oneof:"InfoContent"`
+	AtTimeStamp          *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=atTimeStamp,proto3" json:"atTimeStamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ZInfoMsg) Reset()         { *m = ZInfoMsg{} }
+func (m *ZInfoMsg) String() string { return proto.CompactTextString(m) }
+func (*ZInfoMsg) ProtoMessage()    {}
+func (*ZInfoMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{36}
+}
+
+func (m *ZInfoMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoMsg.Unmarshal(m, b)
+}
+func (m *ZInfoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoMsg.Marshal(b, m, deterministic)
+}
+func (m *ZInfoMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoMsg.Merge(m, src)
+}
+func (m *ZInfoMsg) XXX_Size() int {
+	return xxx_messageInfo_ZInfoMsg.Size(m)
+}
+func (m *ZInfoMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoMsg proto.InternalMessageInfo
+
+func (m *ZInfoMsg) GetZtype() ZInfoTypes {
+	if m != nil {
+		return m.Ztype
+	}
+	return ZInfoTypes_ZiNop
+}
+
+func (m *ZInfoMsg) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+type isZInfoMsg_InfoContent interface {
+	isZInfoMsg_InfoContent()
+}
+
+type ZInfoMsg_Dinfo struct {
+	Dinfo *ZInfoDevice `protobuf:"bytes,3,opt,name=dinfo,proto3,oneof"`
+}
+
+type ZInfoMsg_Ainfo struct {
+	Ainfo *ZInfoApp `protobuf:"bytes,5,opt,name=ainfo,proto3,oneof"`
+}
+
+type ZInfoMsg_Sinfo struct {
+	Sinfo *ZInfoService `protobuf:"bytes,10,opt,name=sinfo,proto3,oneof"`
+}
+
+type ZInfoMsg_Ninfo struct {
+	Ninfo *ZInfoNetworkObject `protobuf:"bytes,11,opt,name=ninfo,proto3,oneof"`
+}
+
+type ZInfoMsg_Niinfo struct {
+	Niinfo *ZInfoNetworkInstance `protobuf:"bytes,12,opt,name=niinfo,proto3,oneof"`
+}
+
+func (*ZInfoMsg_Dinfo) isZInfoMsg_InfoContent() {}
+
+func (*ZInfoMsg_Ainfo) isZInfoMsg_InfoContent() {}
+
+func (*ZInfoMsg_Sinfo) isZInfoMsg_InfoContent() {}
+
+func (*ZInfoMsg_Ninfo) isZInfoMsg_InfoContent() {}
+
+func (*ZInfoMsg_Niinfo) isZInfoMsg_InfoContent() {}
+
+func (m *ZInfoMsg) GetInfoContent() isZInfoMsg_InfoContent {
+	if m != nil {
+		return m.InfoContent
+	}
+	return nil
+}
+
+func (m *ZInfoMsg) GetDinfo() *ZInfoDevice {
+	if x, ok := m.GetInfoContent().(*ZInfoMsg_Dinfo); ok {
+		return x.Dinfo
+	}
+	return nil
+}
+
+func (m *ZInfoMsg) GetAinfo() *ZInfoApp {
+	if x, ok := m.GetInfoContent().(*ZInfoMsg_Ainfo); ok {
+		return x.Ainfo
+	}
+	return nil
+}
+
+func (m *ZInfoMsg) GetSinfo() *ZInfoService {
+	if x, ok := m.GetInfoContent().(*ZInfoMsg_Sinfo); ok {
+		return x.Sinfo
+	}
+	return nil
+}
+
+func (m *ZInfoMsg) GetNinfo() *ZInfoNetworkObject {
+	if x, ok := m.GetInfoContent().(*ZInfoMsg_Ninfo); ok {
+		return x.Ninfo
+	}
+	return nil
+}
+
+func (m *ZInfoMsg) GetNiinfo() *ZInfoNetworkInstance {
+	if x, ok := m.GetInfoContent().(*ZInfoMsg_Niinfo); ok {
+		return x.Niinfo
+	}
+	return nil
+}
+
+func (m *ZInfoMsg) GetAtTimeStamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.AtTimeStamp
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZInfoMsg) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZInfoMsg_Dinfo)(nil),
+		(*ZInfoMsg_Ainfo)(nil),
+		(*ZInfoMsg_Sinfo)(nil),
+		(*ZInfoMsg_Ninfo)(nil),
+		(*ZInfoMsg_Niinfo)(nil),
+	}
+}
+
+// Information about assignable I/O adapter bundles
+type ZioBundle struct {
+	Type                 ZioType       `protobuf:"varint,1,opt,name=type,proto3,enum=ZioType" json:"type,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Members              []string      `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	UsedByAppUUID        string        `protobuf:"bytes,4,opt,name=usedByAppUUID,proto3" json:"usedByAppUUID,omitempty"`
+	UsedByBaseOS         bool          `protobuf:"varint,5,opt,name=usedByBaseOS,proto3" json:"usedByBaseOS,omitempty"`
+	UsbDevice            *ZioUsbDevice `protobuf:"bytes,6,opt,name=usbDevice,proto3" json:"usbDevice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ZioBundle) Reset()         { *m = ZioBundle{} }
+func (m *ZioBundle) String() string { return proto.CompactTextString(m) }
+func (*ZioBundle) ProtoMessage()    {}
+func (*ZioBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{37}
+}
+
+func (m *ZioBundle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZioBundle.Unmarshal(m, b)
+}
+func (m *ZioBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZioBundle.Marshal(b, m, deterministic)
+}
+func (m *ZioBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZioBundle.Merge(m, src)
+}
+func (m *ZioBundle) XXX_Size() int {
+	return xxx_messageInfo_ZioBundle.Size(m)
+}
+func (m *ZioBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZioBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZioBundle proto.InternalMessageInfo
+
+func (m *ZioBundle) GetType() ZioType {
+	if m != nil {
+		return m.Type
+	}
+	return ZioType_ZioNop
+}
+
+func (m *ZioBundle) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ZioBundle) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *ZioBundle) GetUsedByAppUUID() string {
+	if m != nil {
+		return m.UsedByAppUUID
+	}
+	return ""
+}
+
+func (m *ZioBundle) GetUsedByBaseOS() bool {
+	if m != nil {
+		return m.UsedByBaseOS
+	}
+	return false
+}
+
+func (m *ZioBundle) GetUsbDevice() *ZioUsbDevice {
+	if m != nil {
+		return m.UsbDevice
+	}
+	return nil
+}
+
+// A USB device which is present or assigned to an app instance
+type ZioUsbDevice struct {
+	VendorId             string   `protobuf:"bytes,1,opt,name=vendorId,proto3" json:"vendorId,omitempty"`
+	ProductId            string   `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Serial               string   `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	PortPath             string   `protobuf:"bytes,4,opt,name=portPath,proto3" json:"portPath,omitempty"`
+	Product              string   `protobuf:"bytes,5,opt,name=product,proto3" json:"product,omitempty"`
+	Present              bool     `protobuf:"varint,6,opt,name=present,proto3" json:"present,omitempty"`
+	Attached             bool     `protobuf:"varint,7,opt,name=attached,proto3" json:"attached,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZioUsbDevice) Reset()         { *m = ZioUsbDevice{} }
+func (m *ZioUsbDevice) String() string { return proto.CompactTextString(m) }
+func (*ZioUsbDevice) ProtoMessage()    {}
+func (*ZioUsbDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{38}
+}
+
+func (m *ZioUsbDevice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZioUsbDevice.Unmarshal(m, b)
+}
+func (m *ZioUsbDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZioUsbDevice.Marshal(b, m, deterministic)
+}
+func (m *ZioUsbDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZioUsbDevice.Merge(m, src)
+}
+func (m *ZioUsbDevice) XXX_Size() int {
+	return xxx_messageInfo_ZioUsbDevice.Size(m)
+}
+func (m *ZioUsbDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZioUsbDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZioUsbDevice proto.InternalMessageInfo
+
+func (m *ZioUsbDevice) GetVendorId() string {
+	if m != nil {
+		return m.VendorId
+	}
+	return ""
+}
+
+func (m *ZioUsbDevice) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *ZioUsbDevice) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *ZioUsbDevice) GetPortPath() string {
+	if m != nil {
+		return m.PortPath
+	}
+	return ""
+}
+
+func (m *ZioUsbDevice) GetProduct() string {
+	if m != nil {
+		return m.Product
+	}
+	return ""
+}
+
+func (m *ZioUsbDevice) GetPresent() bool {
+	if m != nil {
+		return m.Present
+	}
+	return false
+}
+
+func (m *ZioUsbDevice) GetAttached() bool {
+	if m != nil {
+		return m.Attached
+	}
+	return false
+}
+
+func (m *ZioUsbDevice) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type MemoryMetric struct {
+	UsedMem              uint32   `protobuf:"varint,2,opt,name=usedMem,proto3" json:"usedMem,omitempty"`
+	AvailMem             uint32   `protobuf:"varint,3,opt,name=availMem,proto3" json:"availMem,omitempty"`
+	UsedPercentage       float64  `protobuf:"fixed64,4,opt,name=usedPercentage,proto3" json:"usedPercentage,omitempty"`
+	AvailPercentage      float64  `protobuf:"fixed64,5,opt,name=availPercentage,proto3" json:"availPercentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemoryMetric) Reset()         { *m = MemoryMetric{} }
+func (m *MemoryMetric) String() string { return proto.CompactTextString(m) }
+func (*MemoryMetric) ProtoMessage()    {}
+func (*MemoryMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{39}
+}
+
+func (m *MemoryMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemoryMetric.Unmarshal(m, b)
+}
+func (m *MemoryMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemoryMetric.Marshal(b, m, deterministic)
+}
+func (m *MemoryMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoryMetric.Merge(m, src)
+}
+func (m *MemoryMetric) XXX_Size() int {
+	return xxx_messageInfo_MemoryMetric.Size(m)
+}
+func (m *MemoryMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoryMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoryMetric proto.InternalMessageInfo
+
+func (m *MemoryMetric) GetUsedMem() uint32 {
+	if m != nil {
+		return m.UsedMem
+	}
+	return 0
+}
+
+func (m *MemoryMetric) GetAvailMem() uint32 {
+	if m != nil {
+		return m.AvailMem
+	}
+	return 0
+}
+
+func (m *MemoryMetric) GetUsedPercentage() float64 {
+	if m != nil {
+		return m.UsedPercentage
+	}
+	return 0
+}
+
+func (m *MemoryMetric) GetAvailPercentage() float64 {
+	if m != nil {
+		return m.AvailPercentage
+	}
+	return 0
+}
+
+type NetworkMetric struct {
+	IName                string        `protobuf:"bytes,1,opt,name=iName,proto3" json:"iName,omitempty"`
+	TxBytes              uint64        `protobuf:"varint,2,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+	RxBytes              uint64        `protobuf:"varint,3,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	TxDrops              uint64        `protobuf:"varint,4,opt,name=txDrops,proto3" json:"txDrops,omitempty"`
+	RxDrops              uint64        `protobuf:"varint,5,opt,name=rxDrops,proto3" json:"rxDrops,omitempty"`
+	TxRate               uint64        `protobuf:"varint,6,opt,name=txRate,proto3" json:"txRate,omitempty"`
+	RxRate               uint64        `protobuf:"varint,7,opt,name=rxRate,proto3" json:"rxRate,omitempty"`
+	TxPkts               uint64        `protobuf:"varint,8,opt,name=txPkts,proto3" json:"txPkts,omitempty"`
+	RxPkts               uint64        `protobuf:"varint,9,opt,name=rxPkts,proto3" json:"rxPkts,omitempty"`
+	TxErrors             uint64        `protobuf:"varint,10,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
+	RxErrors             uint64        `protobuf:"varint,11,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
+	TxAclDrops           uint64        `protobuf:"varint,12,opt,name=txAclDrops,proto3" json:"txAclDrops,omitempty"`
+	RxAclDrops           uint64        `protobuf:"varint,13,opt,name=rxAclDrops,proto3" json:"rxAclDrops,omitempty"`
+	TxAclRateLimitDrops  uint64        `protobuf:"varint,14,opt,name=txAclRateLimitDrops,proto3" json:"txAclRateLimitDrops,omitempty"`
+	RxAclRateLimitDrops  uint64        `protobuf:"varint,15,opt,name=rxAclRateLimitDrops,proto3" json:"rxAclRateLimitDrops,omitempty"`
+	LocalName            string        `protobuf:"bytes,16,opt,name=localName,proto3" json:"localName,omitempty"`
+	VlanId               uint32        `protobuf:"varint,17,opt,name=vlanId,proto3" json:"vlanId,omitempty"`
+	Vlans                []*VlanMetric `protobuf:"bytes,18,rep,name=vlans,proto3" json:"vlans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NetworkMetric) Reset()         { *m = NetworkMetric{} }
+func (m *NetworkMetric) String() string { return proto.CompactTextString(m) }
+func (*NetworkMetric) ProtoMessage()    {}
+func (*NetworkMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{40}
+}
+
+func (m *NetworkMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkMetric.Unmarshal(m, b)
+}
+func (m *NetworkMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkMetric.Marshal(b, m, deterministic)
+}
+func (m *NetworkMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkMetric.Merge(m, src)
+}
+func (m *NetworkMetric) XXX_Size() int {
+	return xxx_messageInfo_NetworkMetric.Size(m)
+}
+func (m *NetworkMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkMetric proto.InternalMessageInfo
+
+func (m *NetworkMetric) GetIName() string {
+	if m != nil {
+		return m.IName
+	}
+	return ""
+}
+
+func (m *NetworkMetric) GetTxBytes() uint64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetRxBytes() uint64 {
+	if m != nil {
+		return m.RxBytes
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetTxDrops() uint64 {
+	if m != nil {
+		return m.TxDrops
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetRxDrops() uint64 {
+	if m != nil {
+		return m.RxDrops
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetTxRate() uint64 {
+	if m != nil {
+		return m.TxRate
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetRxRate() uint64 {
+	if m != nil {
+		return m.RxRate
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetTxPkts() uint64 {
+	if m != nil {
+		return m.TxPkts
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetRxPkts() uint64 {
+	if m != nil {
+		return m.RxPkts
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetTxErrors() uint64 {
+	if m != nil {
+		return m.TxErrors
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetRxErrors() uint64 {
+	if m != nil {
+		return m.RxErrors
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetTxAclDrops() uint64 {
 	if m != nil {
-		return m.UpTimeStamp
+		return m.TxAclDrops
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoService) GetAssignedAdapters() []*ZioBundle {
+func (m *NetworkMetric) GetRxAclDrops() uint64 {
 	if m != nil {
-		return m.AssignedAdapters
+		return m.RxAclDrops
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoService) GetSvcErr() []*ErrorInfo {
+func (m *NetworkMetric) GetTxAclRateLimitDrops() uint64 {
 	if m != nil {
-		return m.SvcErr
+		return m.TxAclRateLimitDrops
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoService) GetNetwork() []*ZInfoNetwork {
+func (m *NetworkMetric) GetRxAclRateLimitDrops() uint64 {
 	if m != nil {
-		return m.Network
+		return m.RxAclRateLimitDrops
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetLocalName() string {
+	if m != nil {
+		return m.LocalName
+	}
+	return ""
+}
+
+func (m *NetworkMetric) GetVlanId() uint32 {
+	if m != nil {
+		return m.VlanId
+	}
+	return 0
+}
+
+func (m *NetworkMetric) GetVlans() []*VlanMetric {
+	if m != nil {
+		return m.Vlans
 	}
 	return nil
 }
 
-type isZInfoService_InfoContent interface {
-	isZInfoService_InfoContent()
+// Failures and successes for commuication to zedcloud
+// for each port
+type ZedcloudMetric struct {
+	IfName               string               `protobuf:"bytes,1,opt,name=ifName,proto3" json:"ifName,omitempty"`
+	Failures             uint64               `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	Success              uint64               `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	LastFailure          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=lastFailure,proto3" json:"lastFailure,omitempty"`
+	LastSuccess          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastSuccess,proto3" json:"lastSuccess,omitempty"`
+	UrlMetrics           []*UrlcloudMetric    `protobuf:"bytes,6,rep,name=urlMetrics,proto3" json:"urlMetrics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-type ZInfoService_Vinfo struct {
-	Vinfo *ZInfoVpn `protobuf:"bytes,20,opt,name=vinfo,proto3,oneof"`
+func (m *ZedcloudMetric) Reset()         { *m = ZedcloudMetric{} }
+func (m *ZedcloudMetric) String() string { return proto.CompactTextString(m) }
+func (*ZedcloudMetric) ProtoMessage()    {}
+func (*ZedcloudMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{41}
 }
 
-type ZInfoService_Linfo struct {
-	Linfo *ZInfoLisp `protobuf:"bytes,21,opt,name=linfo,proto3,oneof"`
+func (m *ZedcloudMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZedcloudMetric.Unmarshal(m, b)
+}
+func (m *ZedcloudMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZedcloudMetric.Marshal(b, m, deterministic)
+}
+func (m *ZedcloudMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZedcloudMetric.Merge(m, src)
+}
+func (m *ZedcloudMetric) XXX_Size() int {
+	return xxx_messageInfo_ZedcloudMetric.Size(m)
+}
+func (m *ZedcloudMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZedcloudMetric.DiscardUnknown(m)
 }
 
-func (*ZInfoService_Vinfo) isZInfoService_InfoContent() {}
+var xxx_messageInfo_ZedcloudMetric proto.InternalMessageInfo
 
-func (*ZInfoService_Linfo) isZInfoService_InfoContent() {}
+func (m *ZedcloudMetric) GetIfName() string {
+	if m != nil {
+		return m.IfName
+	}
+	return ""
+}
 
-func (m *ZInfoService) GetInfoContent() isZInfoService_InfoContent {
+func (m *ZedcloudMetric) GetFailures() uint64 {
 	if m != nil {
-		return m.InfoContent
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *ZedcloudMetric) GetSuccess() uint64 {
+	if m != nil {
+		return m.Success
+	}
+	return 0
+}
+
+func (m *ZedcloudMetric) GetLastFailure() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastFailure
 	}
 	return nil
 }
 
-func (m *ZInfoService) GetVinfo() *ZInfoVpn {
-	if x, ok := m.GetInfoContent().(*ZInfoService_Vinfo); ok {
-		return x.Vinfo
+func (m *ZedcloudMetric) GetLastSuccess() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSuccess
 	}
 	return nil
 }
 
-func (m *ZInfoService) GetLinfo() *ZInfoLisp {
-	if x, ok := m.GetInfoContent().(*ZInfoService_Linfo); ok {
-		return x.Linfo
+func (m *ZedcloudMetric) GetUrlMetrics() []*UrlcloudMetric {
+	if m != nil {
+		return m.UrlMetrics
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZInfoService) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZInfoService_Vinfo)(nil),
-		(*ZInfoService_Linfo)(nil),
+type UrlcloudMetric struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	TryMsgCount          int64    `protobuf:"varint,2,opt,name=tryMsgCount,proto3" json:"tryMsgCount,omitempty"`
+	TryByteCount         int64    `protobuf:"varint,3,opt,name=tryByteCount,proto3" json:"tryByteCount,omitempty"`
+	SentMsgCount         int64    `protobuf:"varint,4,opt,name=sentMsgCount,proto3" json:"sentMsgCount,omitempty"`
+	SentByteCount        int64    `protobuf:"varint,5,opt,name=sentByteCount,proto3" json:"sentByteCount,omitempty"`
+	RecvMsgCount         int64    `protobuf:"varint,6,opt,name=recvMsgCount,proto3" json:"recvMsgCount,omitempty"`
+	RecvByteCount        int64    `protobuf:"varint,7,opt,name=recvByteCount,proto3" json:"recvByteCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UrlcloudMetric) Reset()         { *m = UrlcloudMetric{} }
+func (m *UrlcloudMetric) String() string { return proto.CompactTextString(m) }
+func (*UrlcloudMetric) ProtoMessage()    {}
+func (*UrlcloudMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{42}
+}
+
+func (m *UrlcloudMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UrlcloudMetric.Unmarshal(m, b)
+}
+func (m *UrlcloudMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UrlcloudMetric.Marshal(b, m, deterministic)
+}
+func (m *UrlcloudMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UrlcloudMetric.Merge(m, src)
+}
+func (m *UrlcloudMetric) XXX_Size() int {
+	return xxx_messageInfo_UrlcloudMetric.Size(m)
+}
+func (m *UrlcloudMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_UrlcloudMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UrlcloudMetric proto.InternalMessageInfo
+
+func (m *UrlcloudMetric) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
+	return ""
 }
 
-// Network Object information XXX to be deprecated
-type ZInfoNetworkObject struct {
-	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	// From NetworkObjectConfig; do we need to include all of these?
-	NetworkType   uint32   `protobuf:"varint,2,opt,name=networkType,proto3" json:"networkType,omitempty"`
-	DhcpType      uint32   `protobuf:"varint,3,opt,name=dhcpType,proto3" json:"dhcpType,omitempty"`
-	Subnet        string   `protobuf:"bytes,4,opt,name=subnet,proto3" json:"subnet,omitempty"`
-	Gateway       string   `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Domainname    string   `protobuf:"bytes,6,opt,name=domainname,proto3" json:"domainname,omitempty"`
-	NtpServer     string   `protobuf:"bytes,7,opt,name=ntpServer,proto3" json:"ntpServer,omitempty"`
-	DnsServers    []string `protobuf:"bytes,8,rep,name=dnsServers,proto3" json:"dnsServers,omitempty"`
-	DhcpRangeLow  string   `protobuf:"bytes,9,opt,name=dhcpRangeLow,proto3" json:"dhcpRangeLow,omitempty"`
-	DhcpRangeHigh string   `protobuf:"bytes,10,opt,name=dhcpRangeHigh,proto3" json:"dhcpRangeHigh,omitempty"`
-	// From NetworkObjectStatus
-	BridgeNum            uint32                   `protobuf:"varint,12,opt,name=bridgeNum,proto3" json:"bridgeNum,omitempty"`
-	BridgeName           string                   `protobuf:"bytes,13,opt,name=bridgeName,proto3" json:"bridgeName,omitempty"`
-	BridgeIPAddr         string                   `protobuf:"bytes,14,opt,name=bridgeIPAddr,proto3" json:"bridgeIPAddr,omitempty"`
-	IpAssignments        []*ZmetIPAssignmentEntry `protobuf:"bytes,15,rep,name=ipAssignments,proto3" json:"ipAssignments,omitempty"`
-	BridgeIPSets         []string                 `protobuf:"bytes,16,rep,name=bridgeIPSets,proto3" json:"bridgeIPSets,omitempty"`
-	VifNames             []string                 `protobuf:"bytes,17,rep,name=vifNames,proto3" json:"vifNames,omitempty"`
-	Ipv4Eid              bool                     `protobuf:"varint,18,opt,name=ipv4Eid,proto3" json:"ipv4Eid,omitempty"`
-	NetworkErr           []*ErrorInfo             `protobuf:"bytes,19,rep,name=networkErr,proto3" json:"networkErr,omitempty"`
-	Vifs                 []*ZmetVifInfo           `protobuf:"bytes,25,rep,name=vifs,proto3" json:"vifs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *UrlcloudMetric) GetTryMsgCount() int64 {
+	if m != nil {
+		return m.TryMsgCount
+	}
+	return 0
 }
 
-func (m *ZInfoNetworkObject) Reset()         { *m = ZInfoNetworkObject{} }
-func (m *ZInfoNetworkObject) String() string { return proto.CompactTextString(m) }
-func (*ZInfoNetworkObject) ProtoMessage()    {}
-func (*ZInfoNetworkObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{27}
+func (m *UrlcloudMetric) GetTryByteCount() int64 {
+	if m != nil {
+		return m.TryByteCount
+	}
+	return 0
 }
 
-func (m *ZInfoNetworkObject) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoNetworkObject.Unmarshal(m, b)
+func (m *UrlcloudMetric) GetSentMsgCount() int64 {
+	if m != nil {
+		return m.SentMsgCount
+	}
+	return 0
 }
-func (m *ZInfoNetworkObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoNetworkObject.Marshal(b, m, deterministic)
+
+func (m *UrlcloudMetric) GetSentByteCount() int64 {
+	if m != nil {
+		return m.SentByteCount
+	}
+	return 0
+}
+
+func (m *UrlcloudMetric) GetRecvMsgCount() int64 {
+	if m != nil {
+		return m.RecvMsgCount
+	}
+	return 0
+}
+
+func (m *UrlcloudMetric) GetRecvByteCount() int64 {
+	if m != nil {
+		return m.RecvByteCount
+	}
+	return 0
+}
+
+type AppCpuMetric struct {
+	// uint32 cpuTotal = 2;		// deprecated
+	// double cpuPercentage = 3;		// deprecated
+	UpTime               *timestamp.Timestamp `protobuf:"bytes,4,opt,name=upTime,proto3" json:"upTime,omitempty"`
+	Total                uint64               `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AppCpuMetric) Reset()         { *m = AppCpuMetric{} }
+func (m *AppCpuMetric) String() string { return proto.CompactTextString(m) }
+func (*AppCpuMetric) ProtoMessage()    {}
+func (*AppCpuMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{43}
+}
+
+func (m *AppCpuMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppCpuMetric.Unmarshal(m, b)
 }
-func (m *ZInfoNetworkObject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoNetworkObject.Merge(m, src)
+func (m *AppCpuMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppCpuMetric.Marshal(b, m, deterministic)
 }
-func (m *ZInfoNetworkObject) XXX_Size() int {
-	return xxx_messageInfo_ZInfoNetworkObject.Size(m)
+func (m *AppCpuMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppCpuMetric.Merge(m, src)
 }
-func (m *ZInfoNetworkObject) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoNetworkObject.DiscardUnknown(m)
+func (m *AppCpuMetric) XXX_Size() int {
+	return xxx_messageInfo_AppCpuMetric.Size(m)
+}
+func (m *AppCpuMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppCpuMetric.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoNetworkObject proto.InternalMessageInfo
+var xxx_messageInfo_AppCpuMetric proto.InternalMessageInfo
 
-func (m *ZInfoNetworkObject) GetNetworkID() string {
+func (m *AppCpuMetric) GetUpTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.NetworkID
+		return m.UpTime
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetNetworkType() uint32 {
+func (m *AppCpuMetric) GetTotal() uint64 {
 	if m != nil {
-		return m.NetworkType
+		return m.Total
 	}
 	return 0
 }
 
-func (m *ZInfoNetworkObject) GetDhcpType() uint32 {
-	if m != nil {
-		return m.DhcpType
-	}
-	return 0
+type DeviceMetric struct {
+	// cpuMetric cpu = 1;	// deprecated
+	Memory   *MemoryMetric     `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Network  []*NetworkMetric  `protobuf:"bytes,3,rep,name=network,proto3" json:"network,omitempty"`
+	Zedcloud []*ZedcloudMetric `protobuf:"bytes,4,rep,name=zedcloud,proto3" json:"zedcloud,omitempty"`
+	// devCpuMetric compute = 5; // deprecated
+	Disk                     []*DiskMetric     `protobuf:"bytes,6,rep,name=disk,proto3" json:"disk,omitempty"`
+	CpuMetric                *AppCpuMetric     `protobuf:"bytes,7,opt,name=cpuMetric,proto3" json:"cpuMetric,omitempty"`
+	MetricItems              []*MetricItem     `protobuf:"bytes,8,rep,name=metricItems,proto3" json:"metricItems,omitempty"`
+	RuntimeStorageOverheadMB uint64            `protobuf:"varint,9,opt,name=runtimeStorageOverheadMB,proto3" json:"runtimeStorageOverheadMB,omitempty"`
+	AppRunTimeStorageMB      uint64            `protobuf:"varint,10,opt,name=appRunTimeStorageMB,proto3" json:"appRunTimeStorageMB,omitempty"`
+	SystemServicesMemoryMB   *MemoryMetric     `protobuf:"bytes,11,opt,name=systemServicesMemoryMB,proto3" json:"systemServicesMemoryMB,omitempty"`
+	Cellular                 []*CellularMetric `protobuf:"bytes,12,rep,name=cellular,proto3" json:"cellular,omitempty"`
+	CpuPercent               *MetricAggregate  `protobuf:"bytes,13,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryUsedMB             *MetricAggregate  `protobuf:"bytes,14,opt,name=memoryUsedMB,proto3" json:"memoryUsedMB,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
+	XXX_unrecognized         []byte            `json:"-"`
+	XXX_sizecache            int32             `json:"-"`
 }
 
-func (m *ZInfoNetworkObject) GetSubnet() string {
+func (m *DeviceMetric) Reset()         { *m = DeviceMetric{} }
+func (m *DeviceMetric) String() string { return proto.CompactTextString(m) }
+func (*DeviceMetric) ProtoMessage()    {}
+func (*DeviceMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{44}
+}
+
+func (m *DeviceMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceMetric.Unmarshal(m, b)
+}
+func (m *DeviceMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceMetric.Marshal(b, m, deterministic)
+}
+func (m *DeviceMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceMetric.Merge(m, src)
+}
+func (m *DeviceMetric) XXX_Size() int {
+	return xxx_messageInfo_DeviceMetric.Size(m)
+}
+func (m *DeviceMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceMetric proto.InternalMessageInfo
+
+func (m *DeviceMetric) GetMemory() *MemoryMetric {
 	if m != nil {
-		return m.Subnet
+		return m.Memory
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetGateway() string {
+func (m *DeviceMetric) GetNetwork() []*NetworkMetric {
 	if m != nil {
-		return m.Gateway
+		return m.Network
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetDomainname() string {
+func (m *DeviceMetric) GetZedcloud() []*ZedcloudMetric {
 	if m != nil {
-		return m.Domainname
+		return m.Zedcloud
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetNtpServer() string {
+func (m *DeviceMetric) GetDisk() []*DiskMetric {
 	if m != nil {
-		return m.NtpServer
+		return m.Disk
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetDnsServers() []string {
+func (m *DeviceMetric) GetCpuMetric() *AppCpuMetric {
 	if m != nil {
-		return m.DnsServers
+		return m.CpuMetric
 	}
 	return nil
 }
 
-func (m *ZInfoNetworkObject) GetDhcpRangeLow() string {
+func (m *DeviceMetric) GetMetricItems() []*MetricItem {
 	if m != nil {
-		return m.DhcpRangeLow
+		return m.MetricItems
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetDhcpRangeHigh() string {
+func (m *DeviceMetric) GetRuntimeStorageOverheadMB() uint64 {
 	if m != nil {
-		return m.DhcpRangeHigh
+		return m.RuntimeStorageOverheadMB
 	}
-	return ""
+	return 0
 }
 
-func (m *ZInfoNetworkObject) GetBridgeNum() uint32 {
+func (m *DeviceMetric) GetAppRunTimeStorageMB() uint64 {
 	if m != nil {
-		return m.BridgeNum
+		return m.AppRunTimeStorageMB
 	}
 	return 0
 }
 
-func (m *ZInfoNetworkObject) GetBridgeName() string {
+func (m *DeviceMetric) GetSystemServicesMemoryMB() *MemoryMetric {
 	if m != nil {
-		return m.BridgeName
+		return m.SystemServicesMemoryMB
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetBridgeIPAddr() string {
+func (m *DeviceMetric) GetCellular() []*CellularMetric {
 	if m != nil {
-		return m.BridgeIPAddr
+		return m.Cellular
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkObject) GetIpAssignments() []*ZmetIPAssignmentEntry {
+func (m *DeviceMetric) GetCpuPercent() *MetricAggregate {
 	if m != nil {
-		return m.IpAssignments
+		return m.CpuPercent
 	}
 	return nil
 }
 
-func (m *ZInfoNetworkObject) GetBridgeIPSets() []string {
+func (m *DeviceMetric) GetMemoryUsedMB() *MetricAggregate {
 	if m != nil {
-		return m.BridgeIPSets
+		return m.MemoryUsedMB
 	}
 	return nil
 }
 
-func (m *ZInfoNetworkObject) GetVifNames() []string {
+// Values sampled more often than metrics are sent, over the interval
+// since the previous metrics
+type MetricAggregate struct {
+	Min                  float64  `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float64  `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Avg                  float64  `protobuf:"fixed64,3,opt,name=avg,proto3" json:"avg,omitempty"`
+	Samples              uint32   `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetricAggregate) Reset()         { *m = MetricAggregate{} }
+func (m *MetricAggregate) String() string { return proto.CompactTextString(m) }
+func (*MetricAggregate) ProtoMessage()    {}
+func (*MetricAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{45}
+}
+
+func (m *MetricAggregate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetricAggregate.Unmarshal(m, b)
+}
+func (m *MetricAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetricAggregate.Marshal(b, m, deterministic)
+}
+func (m *MetricAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricAggregate.Merge(m, src)
+}
+func (m *MetricAggregate) XXX_Size() int {
+	return xxx_messageInfo_MetricAggregate.Size(m)
+}
+func (m *MetricAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricAggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricAggregate proto.InternalMessageInfo
+
+func (m *MetricAggregate) GetMin() float64 {
 	if m != nil {
-		return m.VifNames
+		return m.Min
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoNetworkObject) GetIpv4Eid() bool {
+func (m *MetricAggregate) GetMax() float64 {
 	if m != nil {
-		return m.Ipv4Eid
+		return m.Max
 	}
-	return false
+	return 0
 }
 
-func (m *ZInfoNetworkObject) GetNetworkErr() []*ErrorInfo {
+func (m *MetricAggregate) GetAvg() float64 {
 	if m != nil {
-		return m.NetworkErr
+		return m.Avg
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoNetworkObject) GetVifs() []*ZmetVifInfo {
+func (m *MetricAggregate) GetSamples() uint32 {
 	if m != nil {
-		return m.Vifs
+		return m.Samples
 	}
-	return nil
+	return 0
 }
 
-// Network Instance information
-type ZInfoNetworkInstance struct {
-	NetworkID        string                   `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	NetworkVersion   string                   `protobuf:"bytes,3,opt,name=networkVersion,proto3" json:"networkVersion,omitempty"`
-	InstType         uint32                   `protobuf:"varint,5,opt,name=instType,proto3" json:"instType,omitempty"`
-	Displayname      string                   `protobuf:"bytes,6,opt,name=displayname,proto3" json:"displayname,omitempty"`
-	Activated        bool                     `protobuf:"varint,7,opt,name=activated,proto3" json:"activated,omitempty"`
-	UpTimeStamp      *timestamp.Timestamp     `protobuf:"bytes,8,opt,name=upTimeStamp,proto3" json:"upTimeStamp,omitempty"`
-	SoftwareList     *ZInfoSW                 `protobuf:"bytes,9,opt,name=softwareList,proto3" json:"softwareList,omitempty"`
-	BridgeNum        uint32                   `protobuf:"varint,20,opt,name=bridgeNum,proto3" json:"bridgeNum,omitempty"`
-	BridgeName       string                   `protobuf:"bytes,21,opt,name=bridgeName,proto3" json:"bridgeName,omitempty"`
-	BridgeIPAddr     string                   `protobuf:"bytes,22,opt,name=bridgeIPAddr,proto3" json:"bridgeIPAddr,omitempty"`
-	IpAssignments    []*ZmetIPAssignmentEntry `protobuf:"bytes,23,rep,name=ipAssignments,proto3" json:"ipAssignments,omitempty"`
-	BridgeIPSets     []string                 `protobuf:"bytes,24,rep,name=bridgeIPSets,proto3" json:"bridgeIPSets,omitempty"`
-	Vifs             []*ZmetVifInfo           `protobuf:"bytes,25,rep,name=vifs,proto3" json:"vifs,omitempty"`
-	Ipv4Eid          bool                     `protobuf:"varint,26,opt,name=ipv4Eid,proto3" json:"ipv4Eid,omitempty"`
-	AssignedAdapters []*ZioBundle             `protobuf:"bytes,30,rep,name=assignedAdapters,proto3" json:"assignedAdapters,omitempty"`
-	// Types that are valid to be assigned to InfoContent:
-	//	*ZInfoNetworkInstance_Vinfo
-	//	*ZInfoNetworkInstance_Linfo
-	InfoContent          isZInfoNetworkInstance_InfoContent `protobuf_oneof:"InfoContent"`
-	NetworkErr           []*ErrorInfo                       `protobuf:"bytes,40,rep,name=networkErr,proto3" json:"networkErr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+// Open-ended metrics from different part of the device such as LTE modem
+// metrics.
+type MetricItem struct {
+	Key  string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type MetricItemType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricItemType" json:"type,omitempty"`
+	// Types that are valid to be assigned to MetricItemValue:
+	//	*MetricItem_BoolValue
+	//	*MetricItem_Uint32Value
+	//	*MetricItem_Uint64Value
+	//	*MetricItem_FloatValue
+	//	*MetricItem_StringValue
+	MetricItemValue      isMetricItem_MetricItemValue `protobuf_oneof:"metricItemValue"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (m *ZInfoNetworkInstance) Reset()         { *m = ZInfoNetworkInstance{} }
-func (m *ZInfoNetworkInstance) String() string { return proto.CompactTextString(m) }
-func (*ZInfoNetworkInstance) ProtoMessage()    {}
-func (*ZInfoNetworkInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{28}
+func (m *MetricItem) Reset()         { *m = MetricItem{} }
+func (m *MetricItem) String() string { return proto.CompactTextString(m) }
+func (*MetricItem) ProtoMessage()    {}
+func (*MetricItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{46}
 }
 
-func (m *ZInfoNetworkInstance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoNetworkInstance.Unmarshal(m, b)
+func (m *MetricItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetricItem.Unmarshal(m, b)
 }
-func (m *ZInfoNetworkInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoNetworkInstance.Marshal(b, m, deterministic)
+func (m *MetricItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetricItem.Marshal(b, m, deterministic)
 }
-func (m *ZInfoNetworkInstance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoNetworkInstance.Merge(m, src)
+func (m *MetricItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricItem.Merge(m, src)
 }
-func (m *ZInfoNetworkInstance) XXX_Size() int {
-	return xxx_messageInfo_ZInfoNetworkInstance.Size(m)
+func (m *MetricItem) XXX_Size() int {
+	return xxx_messageInfo_MetricItem.Size(m)
 }
-func (m *ZInfoNetworkInstance) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoNetworkInstance.DiscardUnknown(m)
+func (m *MetricItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoNetworkInstance proto.InternalMessageInfo
+var xxx_messageInfo_MetricItem proto.InternalMessageInfo
 
-func (m *ZInfoNetworkInstance) GetNetworkID() string {
+func (m *MetricItem) GetKey() string {
 	if m != nil {
-		return m.NetworkID
+		return m.Key
 	}
 	return ""
 }
 
-func (m *ZInfoNetworkInstance) GetNetworkVersion() string {
+func (m *MetricItem) GetType() MetricItemType {
 	if m != nil {
-		return m.NetworkVersion
+		return m.Type
 	}
-	return ""
+	return MetricItemType_MetricItemOther
 }
 
-func (m *ZInfoNetworkInstance) GetInstType() uint32 {
-	if m != nil {
-		return m.InstType
-	}
-	return 0
+type isMetricItem_MetricItemValue interface {
+	isMetricItem_MetricItemValue()
+}
+
+type MetricItem_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=boolValue,proto3,oneof"`
+}
+
+type MetricItem_Uint32Value struct {
+	Uint32Value uint32 `protobuf:"varint,4,opt,name=uint32Value,proto3,oneof"`
+}
+
+type MetricItem_Uint64Value struct {
+	Uint64Value uint64 `protobuf:"varint,5,opt,name=uint64Value,proto3,oneof"`
+}
+
+type MetricItem_FloatValue struct {
+	FloatValue float32 `protobuf:"fixed32,6,opt,name=floatValue,proto3,oneof"`
+}
+
+type MetricItem_StringValue struct {
+	StringValue string `protobuf:"bytes,7,opt,name=stringValue,proto3,oneof"`
 }
 
-func (m *ZInfoNetworkInstance) GetDisplayname() string {
+func (*MetricItem_BoolValue) isMetricItem_MetricItemValue() {}
+
+func (*MetricItem_Uint32Value) isMetricItem_MetricItemValue() {}
+
+func (*MetricItem_Uint64Value) isMetricItem_MetricItemValue() {}
+
+func (*MetricItem_FloatValue) isMetricItem_MetricItemValue() {}
+
+func (*MetricItem_StringValue) isMetricItem_MetricItemValue() {}
+
+func (m *MetricItem) GetMetricItemValue() isMetricItem_MetricItemValue {
 	if m != nil {
-		return m.Displayname
+		return m.MetricItemValue
 	}
-	return ""
+	return nil
 }
 
-func (m *ZInfoNetworkInstance) GetActivated() bool {
-	if m != nil {
-		return m.Activated
+func (m *MetricItem) GetBoolValue() bool {
+	if x, ok := m.GetMetricItemValue().(*MetricItem_BoolValue); ok {
+		return x.BoolValue
 	}
 	return false
 }
 
-func (m *ZInfoNetworkInstance) GetUpTimeStamp() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpTimeStamp
-	}
-	return nil
-}
-
-func (m *ZInfoNetworkInstance) GetSoftwareList() *ZInfoSW {
-	if m != nil {
-		return m.SoftwareList
+func (m *MetricItem) GetUint32Value() uint32 {
+	if x, ok := m.GetMetricItemValue().(*MetricItem_Uint32Value); ok {
+		return x.Uint32Value
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoNetworkInstance) GetBridgeNum() uint32 {
-	if m != nil {
-		return m.BridgeNum
+func (m *MetricItem) GetUint64Value() uint64 {
+	if x, ok := m.GetMetricItemValue().(*MetricItem_Uint64Value); ok {
+		return x.Uint64Value
 	}
 	return 0
 }
 
-func (m *ZInfoNetworkInstance) GetBridgeName() string {
-	if m != nil {
-		return m.BridgeName
+func (m *MetricItem) GetFloatValue() float32 {
+	if x, ok := m.GetMetricItemValue().(*MetricItem_FloatValue); ok {
+		return x.FloatValue
 	}
-	return ""
+	return 0
 }
 
-func (m *ZInfoNetworkInstance) GetBridgeIPAddr() string {
-	if m != nil {
-		return m.BridgeIPAddr
+func (m *MetricItem) GetStringValue() string {
+	if x, ok := m.GetMetricItemValue().(*MetricItem_StringValue); ok {
+		return x.StringValue
 	}
 	return ""
 }
 
-func (m *ZInfoNetworkInstance) GetIpAssignments() []*ZmetIPAssignmentEntry {
-	if m != nil {
-		return m.IpAssignments
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MetricItem) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MetricItem_BoolValue)(nil),
+		(*MetricItem_Uint32Value)(nil),
+		(*MetricItem_Uint64Value)(nil),
+		(*MetricItem_FloatValue)(nil),
+		(*MetricItem_StringValue)(nil),
 	}
-	return nil
 }
 
-func (m *ZInfoNetworkInstance) GetBridgeIPSets() []string {
-	if m != nil {
-		return m.BridgeIPSets
-	}
-	return nil
+// For each partition; counts since boot
+type DiskMetric struct {
+	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	MountPath            string   `protobuf:"bytes,2,opt,name=mountPath,proto3" json:"mountPath,omitempty"`
+	ReadBytes            uint64   `protobuf:"varint,3,opt,name=readBytes,proto3" json:"readBytes,omitempty"`
+	WriteBytes           uint64   `protobuf:"varint,4,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	ReadCount            uint64   `protobuf:"varint,5,opt,name=readCount,proto3" json:"readCount,omitempty"`
+	WriteCount           uint64   `protobuf:"varint,6,opt,name=writeCount,proto3" json:"writeCount,omitempty"`
+	Total                uint64   `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Used                 uint64   `protobuf:"varint,8,opt,name=used,proto3" json:"used,omitempty"`
+	Free                 uint64   `protobuf:"varint,9,opt,name=free,proto3" json:"free,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZInfoNetworkInstance) GetVifs() []*ZmetVifInfo {
-	if m != nil {
-		return m.Vifs
-	}
-	return nil
+func (m *DiskMetric) Reset()         { *m = DiskMetric{} }
+func (m *DiskMetric) String() string { return proto.CompactTextString(m) }
+func (*DiskMetric) ProtoMessage()    {}
+func (*DiskMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{47}
 }
 
-func (m *ZInfoNetworkInstance) GetIpv4Eid() bool {
-	if m != nil {
-		return m.Ipv4Eid
-	}
-	return false
+func (m *DiskMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskMetric.Unmarshal(m, b)
 }
-
-func (m *ZInfoNetworkInstance) GetAssignedAdapters() []*ZioBundle {
-	if m != nil {
-		return m.AssignedAdapters
-	}
-	return nil
+func (m *DiskMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskMetric.Marshal(b, m, deterministic)
 }
-
-type isZInfoNetworkInstance_InfoContent interface {
-	isZInfoNetworkInstance_InfoContent()
+func (m *DiskMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskMetric.Merge(m, src)
 }
-
-type ZInfoNetworkInstance_Vinfo struct {
-	Vinfo *ZInfoVpn `protobuf:"bytes,31,opt,name=vinfo,proto3,oneof"`
+func (m *DiskMetric) XXX_Size() int {
+	return xxx_messageInfo_DiskMetric.Size(m)
 }
-
-type ZInfoNetworkInstance_Linfo struct {
-	Linfo *ZInfoLisp `protobuf:"bytes,32,opt,name=linfo,proto3,oneof"`
+func (m *DiskMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskMetric.DiscardUnknown(m)
 }
 
-func (*ZInfoNetworkInstance_Vinfo) isZInfoNetworkInstance_InfoContent() {}
-
-func (*ZInfoNetworkInstance_Linfo) isZInfoNetworkInstance_InfoContent() {}
+var xxx_messageInfo_DiskMetric proto.InternalMessageInfo
 
-func (m *ZInfoNetworkInstance) GetInfoContent() isZInfoNetworkInstance_InfoContent {
+func (m *DiskMetric) GetDisk() string {
 	if m != nil {
-		return m.InfoContent
+		return m.Disk
 	}
-	return nil
+	return ""
 }
 
-func (m *ZInfoNetworkInstance) GetVinfo() *ZInfoVpn {
-	if x, ok := m.GetInfoContent().(*ZInfoNetworkInstance_Vinfo); ok {
-		return x.Vinfo
+func (m *DiskMetric) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
 	}
-	return nil
+	return ""
 }
 
-func (m *ZInfoNetworkInstance) GetLinfo() *ZInfoLisp {
-	if x, ok := m.GetInfoContent().(*ZInfoNetworkInstance_Linfo); ok {
-		return x.Linfo
+func (m *DiskMetric) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *ZInfoNetworkInstance) GetNetworkErr() []*ErrorInfo {
+func (m *DiskMetric) GetWriteBytes() uint64 {
 	if m != nil {
-		return m.NetworkErr
+		return m.WriteBytes
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZInfoNetworkInstance) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZInfoNetworkInstance_Vinfo)(nil),
-		(*ZInfoNetworkInstance_Linfo)(nil),
+func (m *DiskMetric) GetReadCount() uint64 {
+	if m != nil {
+		return m.ReadCount
 	}
+	return 0
 }
 
-// Map from MAC to IP addresses
-type ZmetIPAssignmentEntry struct {
-	MacAddress           string   `protobuf:"bytes,1,opt,name=macAddress,proto3" json:"macAddress,omitempty"`
-	IpAddress            []string `protobuf:"bytes,2,rep,name=ipAddress,proto3" json:"ipAddress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ZmetIPAssignmentEntry) Reset()         { *m = ZmetIPAssignmentEntry{} }
-func (m *ZmetIPAssignmentEntry) String() string { return proto.CompactTextString(m) }
-func (*ZmetIPAssignmentEntry) ProtoMessage()    {}
-func (*ZmetIPAssignmentEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{29}
+func (m *DiskMetric) GetWriteCount() uint64 {
+	if m != nil {
+		return m.WriteCount
+	}
+	return 0
 }
 
-func (m *ZmetIPAssignmentEntry) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZmetIPAssignmentEntry.Unmarshal(m, b)
-}
-func (m *ZmetIPAssignmentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZmetIPAssignmentEntry.Marshal(b, m, deterministic)
-}
-func (m *ZmetIPAssignmentEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZmetIPAssignmentEntry.Merge(m, src)
-}
-func (m *ZmetIPAssignmentEntry) XXX_Size() int {
-	return xxx_messageInfo_ZmetIPAssignmentEntry.Size(m)
-}
-func (m *ZmetIPAssignmentEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZmetIPAssignmentEntry.DiscardUnknown(m)
+func (m *DiskMetric) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-var xxx_messageInfo_ZmetIPAssignmentEntry proto.InternalMessageInfo
-
-func (m *ZmetIPAssignmentEntry) GetMacAddress() string {
+func (m *DiskMetric) GetUsed() uint64 {
 	if m != nil {
-		return m.MacAddress
+		return m.Used
 	}
-	return ""
+	return 0
 }
 
-func (m *ZmetIPAssignmentEntry) GetIpAddress() []string {
+func (m *DiskMetric) GetFree() uint64 {
 	if m != nil {
-		return m.IpAddress
+		return m.Free
 	}
-	return nil
+	return 0
 }
 
-// Map from vifname to other info
-type ZmetVifInfo struct {
-	VifName              string   `protobuf:"bytes,1,opt,name=vifName,proto3" json:"vifName,omitempty"`
-	MacAddress           string   `protobuf:"bytes,2,opt,name=macAddress,proto3" json:"macAddress,omitempty"`
-	AppID                string   `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID,omitempty"`
+type AppDiskMetric struct {
+	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Provisioned          uint64   `protobuf:"varint,2,opt,name=provisioned,proto3" json:"provisioned,omitempty"`
+	Used                 uint64   `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	DiskType             string   `protobuf:"bytes,4,opt,name=diskType,proto3" json:"diskType,omitempty"`
+	Dirty                bool     `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZmetVifInfo) Reset()         { *m = ZmetVifInfo{} }
-func (m *ZmetVifInfo) String() string { return proto.CompactTextString(m) }
-func (*ZmetVifInfo) ProtoMessage()    {}
-func (*ZmetVifInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{30}
+func (m *AppDiskMetric) Reset()         { *m = AppDiskMetric{} }
+func (m *AppDiskMetric) String() string { return proto.CompactTextString(m) }
+func (*AppDiskMetric) ProtoMessage()    {}
+func (*AppDiskMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{48}
 }
 
-func (m *ZmetVifInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZmetVifInfo.Unmarshal(m, b)
+func (m *AppDiskMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppDiskMetric.Unmarshal(m, b)
 }
-func (m *ZmetVifInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZmetVifInfo.Marshal(b, m, deterministic)
+func (m *AppDiskMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppDiskMetric.Marshal(b, m, deterministic)
 }
-func (m *ZmetVifInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZmetVifInfo.Merge(m, src)
+func (m *AppDiskMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppDiskMetric.Merge(m, src)
 }
-func (m *ZmetVifInfo) XXX_Size() int {
-	return xxx_messageInfo_ZmetVifInfo.Size(m)
+func (m *AppDiskMetric) XXX_Size() int {
+	return xxx_messageInfo_AppDiskMetric.Size(m)
 }
-func (m *ZmetVifInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZmetVifInfo.DiscardUnknown(m)
+func (m *AppDiskMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppDiskMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppDiskMetric proto.InternalMessageInfo
+
+func (m *AppDiskMetric) GetDisk() string {
+	if m != nil {
+		return m.Disk
+	}
+	return ""
 }
 
-var xxx_messageInfo_ZmetVifInfo proto.InternalMessageInfo
+func (m *AppDiskMetric) GetProvisioned() uint64 {
+	if m != nil {
+		return m.Provisioned
+	}
+	return 0
+}
 
-func (m *ZmetVifInfo) GetVifName() string {
+func (m *AppDiskMetric) GetUsed() uint64 {
 	if m != nil {
-		return m.VifName
+		return m.Used
 	}
-	return ""
+	return 0
 }
 
-func (m *ZmetVifInfo) GetMacAddress() string {
+func (m *AppDiskMetric) GetDiskType() string {
 	if m != nil {
-		return m.MacAddress
+		return m.DiskType
 	}
 	return ""
 }
 
-func (m *ZmetVifInfo) GetAppID() string {
+func (m *AppDiskMetric) GetDirty() bool {
 	if m != nil {
-		return m.AppID
+		return m.Dirty
 	}
-	return ""
+	return false
 }
 
-type ZInfoMsg struct {
-	Ztype ZInfoTypes `protobuf:"varint,1,opt,name=ztype,proto3,enum=ZInfoTypes" json:"ztype,omitempty"`
-	DevId string     `protobuf:"bytes,2,opt,name=devId,proto3" json:"devId,omitempty"`
-	// Types that are valid to be assigned to InfoContent:
-	//	*ZInfoMsg_Dinfo
-	//	*ZInfoMsg_Ainfo
-	//	*ZInfoMsg_Sinfo
-	//	*ZInfoMsg_Ninfo
-	//	*ZInfoMsg_Niinfo
-	InfoContent          isZInfoMsg_InfoContent `protobuf_oneof:"InfoContent"`
-	AtTimeStamp          *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=atTimeStamp,proto3" json:"atTimeStamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type AppMetric struct {
+	AppID                string            `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	AppVersion           string            `protobuf:"bytes,10,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	AppName              string            `protobuf:"bytes,2,opt,name=AppName,proto3" json:"AppName,omitempty"`
+	Cpu                  *AppCpuMetric     `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory               *MemoryMetric     `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Network              []*NetworkMetric  `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
+	Disk                 []*AppDiskMetric  `protobuf:"bytes,6,rep,name=disk,proto3" json:"disk,omitempty"`
+	Qos                  []*AppQosMetric   `protobuf:"bytes,7,rep,name=qos,proto3" json:"qos,omitempty"`
+	Balloon              *AppBalloonMetric `protobuf:"bytes,8,opt,name=balloon,proto3" json:"balloon,omitempty"`
+	CpuPercent           *MetricAggregate  `protobuf:"bytes,11,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryUsedMB         *MetricAggregate  `protobuf:"bytes,12,opt,name=memoryUsedMB,proto3" json:"memoryUsedMB,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ZInfoMsg) Reset()         { *m = ZInfoMsg{} }
-func (m *ZInfoMsg) String() string { return proto.CompactTextString(m) }
-func (*ZInfoMsg) ProtoMessage()    {}
-func (*ZInfoMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{31}
+func (m *AppMetric) Reset()         { *m = AppMetric{} }
+func (m *AppMetric) String() string { return proto.CompactTextString(m) }
+func (*AppMetric) ProtoMessage()    {}
+func (*AppMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{49}
 }
 
-func (m *ZInfoMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZInfoMsg.Unmarshal(m, b)
+func (m *AppMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppMetric.Unmarshal(m, b)
 }
-func (m *ZInfoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZInfoMsg.Marshal(b, m, deterministic)
+func (m *AppMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppMetric.Marshal(b, m, deterministic)
 }
-func (m *ZInfoMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZInfoMsg.Merge(m, src)
+func (m *AppMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMetric.Merge(m, src)
 }
-func (m *ZInfoMsg) XXX_Size() int {
-	return xxx_messageInfo_ZInfoMsg.Size(m)
+func (m *AppMetric) XXX_Size() int {
+	return xxx_messageInfo_AppMetric.Size(m)
 }
-func (m *ZInfoMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZInfoMsg.DiscardUnknown(m)
+func (m *AppMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMetric.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZInfoMsg proto.InternalMessageInfo
+var xxx_messageInfo_AppMetric proto.InternalMessageInfo
 
-func (m *ZInfoMsg) GetZtype() ZInfoTypes {
+func (m *AppMetric) GetAppID() string {
 	if m != nil {
-		return m.Ztype
+		return m.AppID
 	}
-	return ZInfoTypes_ZiNop
+	return ""
 }
 
-func (m *ZInfoMsg) GetDevId() string {
+func (m *AppMetric) GetAppVersion() string {
 	if m != nil {
-		return m.DevId
+		return m.AppVersion
 	}
 	return ""
 }
 
-type isZInfoMsg_InfoContent interface {
-	isZInfoMsg_InfoContent()
-}
-
-type ZInfoMsg_Dinfo struct {
-	Dinfo *ZInfoDevice `protobuf:"bytes,3,opt,name=dinfo,proto3,oneof"`
-}
-
-type ZInfoMsg_Ainfo struct {
-	Ainfo *ZInfoApp `protobuf:"bytes,5,opt,name=ainfo,proto3,oneof"`
-}
-
-type ZInfoMsg_Sinfo struct {
-	Sinfo *ZInfoService `protobuf:"bytes,10,opt,name=sinfo,proto3,oneof"`
-}
-
-type ZInfoMsg_Ninfo struct {
-	Ninfo *ZInfoNetworkObject `protobuf:"bytes,11,opt,name=ninfo,proto3,oneof"`
-}
-
-type ZInfoMsg_Niinfo struct {
-	Niinfo *ZInfoNetworkInstance `protobuf:"bytes,12,opt,name=niinfo,proto3,oneof"`
+func (m *AppMetric) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
 }
 
-func (*ZInfoMsg_Dinfo) isZInfoMsg_InfoContent() {}
-
-func (*ZInfoMsg_Ainfo) isZInfoMsg_InfoContent() {}
-
-func (*ZInfoMsg_Sinfo) isZInfoMsg_InfoContent() {}
-
-func (*ZInfoMsg_Ninfo) isZInfoMsg_InfoContent() {}
-
-func (*ZInfoMsg_Niinfo) isZInfoMsg_InfoContent() {}
-
-func (m *ZInfoMsg) GetInfoContent() isZInfoMsg_InfoContent {
+func (m *AppMetric) GetCpu() *AppCpuMetric {
 	if m != nil {
-		return m.InfoContent
+		return m.Cpu
 	}
 	return nil
 }
 
-func (m *ZInfoMsg) GetDinfo() *ZInfoDevice {
-	if x, ok := m.GetInfoContent().(*ZInfoMsg_Dinfo); ok {
-		return x.Dinfo
+func (m *AppMetric) GetMemory() *MemoryMetric {
+	if m != nil {
+		return m.Memory
 	}
 	return nil
 }
 
-func (m *ZInfoMsg) GetAinfo() *ZInfoApp {
-	if x, ok := m.GetInfoContent().(*ZInfoMsg_Ainfo); ok {
-		return x.Ainfo
+func (m *AppMetric) GetNetwork() []*NetworkMetric {
+	if m != nil {
+		return m.Network
 	}
 	return nil
 }
 
-func (m *ZInfoMsg) GetSinfo() *ZInfoService {
-	if x, ok := m.GetInfoContent().(*ZInfoMsg_Sinfo); ok {
-		return x.Sinfo
+func (m *AppMetric) GetDisk() []*AppDiskMetric {
+	if m != nil {
+		return m.Disk
 	}
 	return nil
 }
 
-func (m *ZInfoMsg) GetNinfo() *ZInfoNetworkObject {
-	if x, ok := m.GetInfoContent().(*ZInfoMsg_Ninfo); ok {
-		return x.Ninfo
+func (m *AppMetric) GetQos() []*AppQosMetric {
+	if m != nil {
+		return m.Qos
 	}
 	return nil
 }
 
-func (m *ZInfoMsg) GetNiinfo() *ZInfoNetworkInstance {
-	if x, ok := m.GetInfoContent().(*ZInfoMsg_Niinfo); ok {
-		return x.Niinfo
+func (m *AppMetric) GetBalloon() *AppBalloonMetric {
+	if m != nil {
+		return m.Balloon
 	}
 	return nil
 }
 
-func (m *ZInfoMsg) GetAtTimeStamp() *timestamp.Timestamp {
+func (m *AppMetric) GetCpuPercent() *MetricAggregate {
 	if m != nil {
-		return m.AtTimeStamp
+		return m.CpuPercent
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZInfoMsg) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZInfoMsg_Dinfo)(nil),
-		(*ZInfoMsg_Ainfo)(nil),
-		(*ZInfoMsg_Sinfo)(nil),
-		(*ZInfoMsg_Ninfo)(nil),
-		(*ZInfoMsg_Niinfo)(nil),
+func (m *AppMetric) GetMemoryUsedMB() *MetricAggregate {
+	if m != nil {
+		return m.MemoryUsedMB
 	}
+	return nil
 }
 
-// Information about assignable I/O adapter bundles
-type ZioBundle struct {
-	Type                 ZioType  `protobuf:"varint,1,opt,name=type,proto3,enum=ZioType" json:"type,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Members              []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
-	UsedByAppUUID        string   `protobuf:"bytes,4,opt,name=usedByAppUUID,proto3" json:"usedByAppUUID,omitempty"`
-	UsedByBaseOS         bool     `protobuf:"varint,5,opt,name=usedByBaseOS,proto3" json:"usedByBaseOS,omitempty"`
+// Memory and vCPU allocation of a running app which can be changed
+// without a restart.
+type AppBalloonMetric struct {
+	TargetMem            uint32   `protobuf:"varint,1,opt,name=targetMem,proto3" json:"targetMem,omitempty"`
+	ActualMem            uint32   `protobuf:"varint,2,opt,name=actualMem,proto3" json:"actualMem,omitempty"`
+	MaxMem               uint32   `protobuf:"varint,3,opt,name=maxMem,proto3" json:"maxMem,omitempty"`
+	Vcpus                uint32   `protobuf:"varint,4,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
+	MaxVcpus             uint32   `protobuf:"varint,5,opt,name=maxVcpus,proto3" json:"maxVcpus,omitempty"`
+	AutoBallooned        bool     `protobuf:"varint,6,opt,name=autoBallooned,proto3" json:"autoBallooned,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZioBundle) Reset()         { *m = ZioBundle{} }
-func (m *ZioBundle) String() string { return proto.CompactTextString(m) }
-func (*ZioBundle) ProtoMessage()    {}
-func (*ZioBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{32}
+func (m *AppBalloonMetric) Reset()         { *m = AppBalloonMetric{} }
+func (m *AppBalloonMetric) String() string { return proto.CompactTextString(m) }
+func (*AppBalloonMetric) ProtoMessage()    {}
+func (*AppBalloonMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{50}
 }
 
-func (m *ZioBundle) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZioBundle.Unmarshal(m, b)
+func (m *AppBalloonMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppBalloonMetric.Unmarshal(m, b)
 }
-func (m *ZioBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZioBundle.Marshal(b, m, deterministic)
+func (m *AppBalloonMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppBalloonMetric.Marshal(b, m, deterministic)
 }
-func (m *ZioBundle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZioBundle.Merge(m, src)
+func (m *AppBalloonMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppBalloonMetric.Merge(m, src)
 }
-func (m *ZioBundle) XXX_Size() int {
-	return xxx_messageInfo_ZioBundle.Size(m)
+func (m *AppBalloonMetric) XXX_Size() int {
+	return xxx_messageInfo_AppBalloonMetric.Size(m)
 }
-func (m *ZioBundle) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZioBundle.DiscardUnknown(m)
+func (m *AppBalloonMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppBalloonMetric.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZioBundle proto.InternalMessageInfo
+var xxx_messageInfo_AppBalloonMetric proto.InternalMessageInfo
 
-func (m *ZioBundle) GetType() ZioType {
+func (m *AppBalloonMetric) GetTargetMem() uint32 {
 	if m != nil {
-		return m.Type
+		return m.TargetMem
 	}
-	return ZioType_ZioNop
+	return 0
 }
 
-func (m *ZioBundle) GetName() string {
+func (m *AppBalloonMetric) GetActualMem() uint32 {
 	if m != nil {
-		return m.Name
+		return m.ActualMem
 	}
-	return ""
+	return 0
 }
 
-func (m *ZioBundle) GetMembers() []string {
+func (m *AppBalloonMetric) GetMaxMem() uint32 {
 	if m != nil {
-		return m.Members
+		return m.MaxMem
 	}
-	return nil
+	return 0
 }
 
-func (m *ZioBundle) GetUsedByAppUUID() string {
+func (m *AppBalloonMetric) GetVcpus() uint32 {
 	if m != nil {
-		return m.UsedByAppUUID
+		return m.Vcpus
 	}
-	return ""
+	return 0
 }
 
-func (m *ZioBundle) GetUsedByBaseOS() bool {
+func (m *AppBalloonMetric) GetMaxVcpus() uint32 {
 	if m != nil {
-		return m.UsedByBaseOS
+		return m.MaxVcpus
+	}
+	return 0
+}
+
+func (m *AppBalloonMetric) GetAutoBallooned() bool {
+	if m != nil {
+		return m.AutoBallooned
 	}
 	return false
 }
 
-type MemoryMetric struct {
-	UsedMem              uint32   `protobuf:"varint,2,opt,name=usedMem,proto3" json:"usedMem,omitempty"`
-	AvailMem             uint32   `protobuf:"varint,3,opt,name=availMem,proto3" json:"availMem,omitempty"`
-	UsedPercentage       float64  `protobuf:"fixed64,4,opt,name=usedPercentage,proto3" json:"usedPercentage,omitempty"`
-	AvailPercentage      float64  `protobuf:"fixed64,5,opt,name=availPercentage,proto3" json:"availPercentage,omitempty"`
+// Lisp stats
+type PktStat struct {
+	Packets              uint64   `protobuf:"varint,1,opt,name=Packets,proto3" json:"Packets,omitempty"`
+	Bytes                uint64   `protobuf:"varint,2,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MemoryMetric) Reset()         { *m = MemoryMetric{} }
-func (m *MemoryMetric) String() string { return proto.CompactTextString(m) }
-func (*MemoryMetric) ProtoMessage()    {}
-func (*MemoryMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{33}
+func (m *PktStat) Reset()         { *m = PktStat{} }
+func (m *PktStat) String() string { return proto.CompactTextString(m) }
+func (*PktStat) ProtoMessage()    {}
+func (*PktStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{51}
 }
 
-func (m *MemoryMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MemoryMetric.Unmarshal(m, b)
-}
-func (m *MemoryMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MemoryMetric.Marshal(b, m, deterministic)
-}
-func (m *MemoryMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MemoryMetric.Merge(m, src)
-}
-func (m *MemoryMetric) XXX_Size() int {
-	return xxx_messageInfo_MemoryMetric.Size(m)
+func (m *PktStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PktStat.Unmarshal(m, b)
 }
-func (m *MemoryMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_MemoryMetric.DiscardUnknown(m)
+func (m *PktStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PktStat.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_MemoryMetric proto.InternalMessageInfo
-
-func (m *MemoryMetric) GetUsedMem() uint32 {
-	if m != nil {
-		return m.UsedMem
-	}
-	return 0
+func (m *PktStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PktStat.Merge(m, src)
 }
-
-func (m *MemoryMetric) GetAvailMem() uint32 {
-	if m != nil {
-		return m.AvailMem
-	}
-	return 0
+func (m *PktStat) XXX_Size() int {
+	return xxx_messageInfo_PktStat.Size(m)
+}
+func (m *PktStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_PktStat.DiscardUnknown(m)
 }
 
-func (m *MemoryMetric) GetUsedPercentage() float64 {
+var xxx_messageInfo_PktStat proto.InternalMessageInfo
+
+func (m *PktStat) GetPackets() uint64 {
 	if m != nil {
-		return m.UsedPercentage
+		return m.Packets
 	}
 	return 0
 }
 
-func (m *MemoryMetric) GetAvailPercentage() float64 {
+func (m *PktStat) GetBytes() uint64 {
 	if m != nil {
-		return m.AvailPercentage
+		return m.Bytes
 	}
 	return 0
 }
 
-type NetworkMetric struct {
-	IName                string   `protobuf:"bytes,1,opt,name=iName,proto3" json:"iName,omitempty"`
-	TxBytes              uint64   `protobuf:"varint,2,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
-	RxBytes              uint64   `protobuf:"varint,3,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
-	TxDrops              uint64   `protobuf:"varint,4,opt,name=txDrops,proto3" json:"txDrops,omitempty"`
-	RxDrops              uint64   `protobuf:"varint,5,opt,name=rxDrops,proto3" json:"rxDrops,omitempty"`
-	TxRate               uint64   `protobuf:"varint,6,opt,name=txRate,proto3" json:"txRate,omitempty"`
-	RxRate               uint64   `protobuf:"varint,7,opt,name=rxRate,proto3" json:"rxRate,omitempty"`
-	TxPkts               uint64   `protobuf:"varint,8,opt,name=txPkts,proto3" json:"txPkts,omitempty"`
-	RxPkts               uint64   `protobuf:"varint,9,opt,name=rxPkts,proto3" json:"rxPkts,omitempty"`
-	TxErrors             uint64   `protobuf:"varint,10,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
-	RxErrors             uint64   `protobuf:"varint,11,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
-	TxAclDrops           uint64   `protobuf:"varint,12,opt,name=txAclDrops,proto3" json:"txAclDrops,omitempty"`
-	RxAclDrops           uint64   `protobuf:"varint,13,opt,name=rxAclDrops,proto3" json:"rxAclDrops,omitempty"`
-	TxAclRateLimitDrops  uint64   `protobuf:"varint,14,opt,name=txAclRateLimitDrops,proto3" json:"txAclRateLimitDrops,omitempty"`
-	RxAclRateLimitDrops  uint64   `protobuf:"varint,15,opt,name=rxAclRateLimitDrops,proto3" json:"rxAclRateLimitDrops,omitempty"`
-	LocalName            string   `protobuf:"bytes,16,opt,name=localName,proto3" json:"localName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RlocStats struct {
+	Rloc                   string   `protobuf:"bytes,1,opt,name=Rloc,proto3" json:"Rloc,omitempty"`
+	Stats                  *PktStat `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats,omitempty"`
+	SecondsSinceLastPacket uint64   `protobuf:"varint,3,opt,name=SecondsSinceLastPacket,proto3" json:"SecondsSinceLastPacket,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *NetworkMetric) Reset()         { *m = NetworkMetric{} }
-func (m *NetworkMetric) String() string { return proto.CompactTextString(m) }
-func (*NetworkMetric) ProtoMessage()    {}
-func (*NetworkMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{34}
+func (m *RlocStats) Reset()         { *m = RlocStats{} }
+func (m *RlocStats) String() string { return proto.CompactTextString(m) }
+func (*RlocStats) ProtoMessage()    {}
+func (*RlocStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{52}
 }
 
-func (m *NetworkMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkMetric.Unmarshal(m, b)
+func (m *RlocStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RlocStats.Unmarshal(m, b)
 }
-func (m *NetworkMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkMetric.Marshal(b, m, deterministic)
+func (m *RlocStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RlocStats.Marshal(b, m, deterministic)
 }
-func (m *NetworkMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkMetric.Merge(m, src)
+func (m *RlocStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RlocStats.Merge(m, src)
 }
-func (m *NetworkMetric) XXX_Size() int {
-	return xxx_messageInfo_NetworkMetric.Size(m)
+func (m *RlocStats) XXX_Size() int {
+	return xxx_messageInfo_RlocStats.Size(m)
 }
-func (m *NetworkMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkMetric.DiscardUnknown(m)
+func (m *RlocStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RlocStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NetworkMetric proto.InternalMessageInfo
+var xxx_messageInfo_RlocStats proto.InternalMessageInfo
 
-func (m *NetworkMetric) GetIName() string {
+func (m *RlocStats) GetRloc() string {
 	if m != nil {
-		return m.IName
+		return m.Rloc
 	}
 	return ""
 }
 
-func (m *NetworkMetric) GetTxBytes() uint64 {
+func (m *RlocStats) GetStats() *PktStat {
 	if m != nil {
-		return m.TxBytes
+		return m.Stats
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkMetric) GetRxBytes() uint64 {
+func (m *RlocStats) GetSecondsSinceLastPacket() uint64 {
 	if m != nil {
-		return m.RxBytes
+		return m.SecondsSinceLastPacket
 	}
 	return 0
 }
 
-func (m *NetworkMetric) GetTxDrops() uint64 {
-	if m != nil {
-		return m.TxDrops
-	}
-	return 0
+type EidStats struct {
+	IID                  uint64       `protobuf:"varint,1,opt,name=IID,proto3" json:"IID,omitempty"`
+	EID                  string       `protobuf:"bytes,2,opt,name=EID,proto3" json:"EID,omitempty"`
+	RlocStatsEntries     []*RlocStats `protobuf:"bytes,3,rep,name=RlocStatsEntries,proto3" json:"RlocStatsEntries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *NetworkMetric) GetRxDrops() uint64 {
-	if m != nil {
-		return m.RxDrops
-	}
-	return 0
+func (m *EidStats) Reset()         { *m = EidStats{} }
+func (m *EidStats) String() string { return proto.CompactTextString(m) }
+func (*EidStats) ProtoMessage()    {}
+func (*EidStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{53}
 }
 
-func (m *NetworkMetric) GetTxRate() uint64 {
-	if m != nil {
-		return m.TxRate
-	}
-	return 0
+func (m *EidStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EidStats.Unmarshal(m, b)
+}
+func (m *EidStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EidStats.Marshal(b, m, deterministic)
+}
+func (m *EidStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EidStats.Merge(m, src)
+}
+func (m *EidStats) XXX_Size() int {
+	return xxx_messageInfo_EidStats.Size(m)
+}
+func (m *EidStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EidStats.DiscardUnknown(m)
 }
 
-func (m *NetworkMetric) GetRxRate() uint64 {
+var xxx_messageInfo_EidStats proto.InternalMessageInfo
+
+func (m *EidStats) GetIID() uint64 {
 	if m != nil {
-		return m.RxRate
+		return m.IID
 	}
 	return 0
 }
 
-func (m *NetworkMetric) GetTxPkts() uint64 {
+func (m *EidStats) GetEID() string {
 	if m != nil {
-		return m.TxPkts
+		return m.EID
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkMetric) GetRxPkts() uint64 {
+func (m *EidStats) GetRlocStatsEntries() []*RlocStats {
 	if m != nil {
-		return m.RxPkts
+		return m.RlocStatsEntries
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkMetric) GetTxErrors() uint64 {
-	if m != nil {
-		return m.TxErrors
-	}
-	return 0
+type ZMetricLisp struct {
+	// Encap Statistics
+	EidStatsEntries    []*EidStats `protobuf:"bytes,1,rep,name=EidStatsEntries,proto3" json:"EidStatsEntries,omitempty"`
+	ItrPacketSendError *PktStat    `protobuf:"bytes,2,opt,name=ItrPacketSendError,proto3" json:"ItrPacketSendError,omitempty"`
+	InvalidEidError    *PktStat    `protobuf:"bytes,3,opt,name=InvalidEidError,proto3" json:"InvalidEidError,omitempty"`
+	// Decap Statistics
+	NoDecryptKey         *PktStat `protobuf:"bytes,4,opt,name=NoDecryptKey,proto3" json:"NoDecryptKey,omitempty"`
+	OuterHeaderError     *PktStat `protobuf:"bytes,5,opt,name=OuterHeaderError,proto3" json:"OuterHeaderError,omitempty"`
+	BadInnerVersion      *PktStat `protobuf:"bytes,6,opt,name=BadInnerVersion,proto3" json:"BadInnerVersion,omitempty"`
+	GoodPackets          *PktStat `protobuf:"bytes,7,opt,name=GoodPackets,proto3" json:"GoodPackets,omitempty"`
+	ICVError             *PktStat `protobuf:"bytes,8,opt,name=ICVError,proto3" json:"ICVError,omitempty"`
+	LispHeaderError      *PktStat `protobuf:"bytes,9,opt,name=LispHeaderError,proto3" json:"LispHeaderError,omitempty"`
+	CheckSumError        *PktStat `protobuf:"bytes,10,opt,name=CheckSumError,proto3" json:"CheckSumError,omitempty"`
+	DecapReInjectError   *PktStat `protobuf:"bytes,11,opt,name=DecapReInjectError,proto3" json:"DecapReInjectError,omitempty"`
+	DecryptError         *PktStat `protobuf:"bytes,12,opt,name=DecryptError,proto3" json:"DecryptError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NetworkMetric) GetRxErrors() uint64 {
-	if m != nil {
-		return m.RxErrors
-	}
-	return 0
+func (m *ZMetricLisp) Reset()         { *m = ZMetricLisp{} }
+func (m *ZMetricLisp) String() string { return proto.CompactTextString(m) }
+func (*ZMetricLisp) ProtoMessage()    {}
+func (*ZMetricLisp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{54}
 }
 
-func (m *NetworkMetric) GetTxAclDrops() uint64 {
-	if m != nil {
-		return m.TxAclDrops
-	}
-	return 0
+func (m *ZMetricLisp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricLisp.Unmarshal(m, b)
+}
+func (m *ZMetricLisp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricLisp.Marshal(b, m, deterministic)
+}
+func (m *ZMetricLisp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricLisp.Merge(m, src)
+}
+func (m *ZMetricLisp) XXX_Size() int {
+	return xxx_messageInfo_ZMetricLisp.Size(m)
+}
+func (m *ZMetricLisp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricLisp.DiscardUnknown(m)
 }
 
-func (m *NetworkMetric) GetRxAclDrops() uint64 {
+var xxx_messageInfo_ZMetricLisp proto.InternalMessageInfo
+
+func (m *ZMetricLisp) GetEidStatsEntries() []*EidStats {
 	if m != nil {
-		return m.RxAclDrops
+		return m.EidStatsEntries
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkMetric) GetTxAclRateLimitDrops() uint64 {
+func (m *ZMetricLisp) GetItrPacketSendError() *PktStat {
 	if m != nil {
-		return m.TxAclRateLimitDrops
+		return m.ItrPacketSendError
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkMetric) GetRxAclRateLimitDrops() uint64 {
+func (m *ZMetricLisp) GetInvalidEidError() *PktStat {
 	if m != nil {
-		return m.RxAclRateLimitDrops
+		return m.InvalidEidError
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkMetric) GetLocalName() string {
+func (m *ZMetricLisp) GetNoDecryptKey() *PktStat {
 	if m != nil {
-		return m.LocalName
+		return m.NoDecryptKey
 	}
-	return ""
-}
-
-// Failures and successes for commuication to zedcloud
-// for each port
-type ZedcloudMetric struct {
-	IfName               string               `protobuf:"bytes,1,opt,name=ifName,proto3" json:"ifName,omitempty"`
-	Failures             uint64               `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
-	Success              uint64               `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	LastFailure          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=lastFailure,proto3" json:"lastFailure,omitempty"`
-	LastSuccess          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastSuccess,proto3" json:"lastSuccess,omitempty"`
-	UrlMetrics           []*UrlcloudMetric    `protobuf:"bytes,6,rep,name=urlMetrics,proto3" json:"urlMetrics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *ZedcloudMetric) Reset()         { *m = ZedcloudMetric{} }
-func (m *ZedcloudMetric) String() string { return proto.CompactTextString(m) }
-func (*ZedcloudMetric) ProtoMessage()    {}
-func (*ZedcloudMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{35}
+	return nil
 }
 
-func (m *ZedcloudMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZedcloudMetric.Unmarshal(m, b)
-}
-func (m *ZedcloudMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZedcloudMetric.Marshal(b, m, deterministic)
-}
-func (m *ZedcloudMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZedcloudMetric.Merge(m, src)
-}
-func (m *ZedcloudMetric) XXX_Size() int {
-	return xxx_messageInfo_ZedcloudMetric.Size(m)
-}
-func (m *ZedcloudMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZedcloudMetric.DiscardUnknown(m)
+func (m *ZMetricLisp) GetOuterHeaderError() *PktStat {
+	if m != nil {
+		return m.OuterHeaderError
+	}
+	return nil
 }
 
-var xxx_messageInfo_ZedcloudMetric proto.InternalMessageInfo
+func (m *ZMetricLisp) GetBadInnerVersion() *PktStat {
+	if m != nil {
+		return m.BadInnerVersion
+	}
+	return nil
+}
 
-func (m *ZedcloudMetric) GetIfName() string {
+func (m *ZMetricLisp) GetGoodPackets() *PktStat {
 	if m != nil {
-		return m.IfName
+		return m.GoodPackets
 	}
-	return ""
+	return nil
 }
 
-func (m *ZedcloudMetric) GetFailures() uint64 {
+func (m *ZMetricLisp) GetICVError() *PktStat {
 	if m != nil {
-		return m.Failures
+		return m.ICVError
 	}
-	return 0
+	return nil
 }
 
-func (m *ZedcloudMetric) GetSuccess() uint64 {
+func (m *ZMetricLisp) GetLispHeaderError() *PktStat {
 	if m != nil {
-		return m.Success
+		return m.LispHeaderError
 	}
-	return 0
+	return nil
 }
 
-func (m *ZedcloudMetric) GetLastFailure() *timestamp.Timestamp {
+func (m *ZMetricLisp) GetCheckSumError() *PktStat {
 	if m != nil {
-		return m.LastFailure
+		return m.CheckSumError
 	}
 	return nil
 }
 
-func (m *ZedcloudMetric) GetLastSuccess() *timestamp.Timestamp {
+func (m *ZMetricLisp) GetDecapReInjectError() *PktStat {
 	if m != nil {
-		return m.LastSuccess
+		return m.DecapReInjectError
 	}
 	return nil
 }
 
-func (m *ZedcloudMetric) GetUrlMetrics() []*UrlcloudMetric {
+func (m *ZMetricLisp) GetDecryptError() *PktStat {
 	if m != nil {
-		return m.UrlMetrics
+		return m.DecryptError
 	}
 	return nil
 }
 
-type UrlcloudMetric struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	TryMsgCount          int64    `protobuf:"varint,2,opt,name=tryMsgCount,proto3" json:"tryMsgCount,omitempty"`
-	TryByteCount         int64    `protobuf:"varint,3,opt,name=tryByteCount,proto3" json:"tryByteCount,omitempty"`
-	SentMsgCount         int64    `protobuf:"varint,4,opt,name=sentMsgCount,proto3" json:"sentMsgCount,omitempty"`
-	SentByteCount        int64    `protobuf:"varint,5,opt,name=sentByteCount,proto3" json:"sentByteCount,omitempty"`
-	RecvMsgCount         int64    `protobuf:"varint,6,opt,name=recvMsgCount,proto3" json:"recvMsgCount,omitempty"`
-	RecvByteCount        int64    `protobuf:"varint,7,opt,name=recvByteCount,proto3" json:"recvByteCount,omitempty"`
+// Vpn Stats
+type ZMetricConn struct {
+	InPkts               *PktStat `protobuf:"bytes,1,opt,name=InPkts,proto3" json:"InPkts,omitempty"`
+	OutPkts              *PktStat `protobuf:"bytes,2,opt,name=OutPkts,proto3" json:"OutPkts,omitempty"`
+	ErrPkts              *PktStat `protobuf:"bytes,3,opt,name=ErrPkts,proto3" json:"ErrPkts,omitempty"`
+	CarierErrPkts        *PktStat `protobuf:"bytes,4,opt,name=CarierErrPkts,proto3" json:"CarierErrPkts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UrlcloudMetric) Reset()         { *m = UrlcloudMetric{} }
-func (m *UrlcloudMetric) String() string { return proto.CompactTextString(m) }
-func (*UrlcloudMetric) ProtoMessage()    {}
-func (*UrlcloudMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{36}
+func (m *ZMetricConn) Reset()         { *m = ZMetricConn{} }
+func (m *ZMetricConn) String() string { return proto.CompactTextString(m) }
+func (*ZMetricConn) ProtoMessage()    {}
+func (*ZMetricConn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{55}
 }
 
-func (m *UrlcloudMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UrlcloudMetric.Unmarshal(m, b)
+func (m *ZMetricConn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricConn.Unmarshal(m, b)
 }
-func (m *UrlcloudMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UrlcloudMetric.Marshal(b, m, deterministic)
+func (m *ZMetricConn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricConn.Marshal(b, m, deterministic)
 }
-func (m *UrlcloudMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UrlcloudMetric.Merge(m, src)
+func (m *ZMetricConn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricConn.Merge(m, src)
 }
-func (m *UrlcloudMetric) XXX_Size() int {
-	return xxx_messageInfo_UrlcloudMetric.Size(m)
+func (m *ZMetricConn) XXX_Size() int {
+	return xxx_messageInfo_ZMetricConn.Size(m)
 }
-func (m *UrlcloudMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_UrlcloudMetric.DiscardUnknown(m)
+func (m *ZMetricConn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricConn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UrlcloudMetric proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricConn proto.InternalMessageInfo
 
-func (m *UrlcloudMetric) GetUrl() string {
+func (m *ZMetricConn) GetInPkts() *PktStat {
 	if m != nil {
-		return m.Url
+		return m.InPkts
 	}
-	return ""
+	return nil
 }
 
-func (m *UrlcloudMetric) GetTryMsgCount() int64 {
+func (m *ZMetricConn) GetOutPkts() *PktStat {
 	if m != nil {
-		return m.TryMsgCount
+		return m.OutPkts
 	}
-	return 0
+	return nil
 }
 
-func (m *UrlcloudMetric) GetTryByteCount() int64 {
+func (m *ZMetricConn) GetErrPkts() *PktStat {
 	if m != nil {
-		return m.TryByteCount
+		return m.ErrPkts
 	}
-	return 0
+	return nil
 }
 
-func (m *UrlcloudMetric) GetSentMsgCount() int64 {
+func (m *ZMetricConn) GetCarierErrPkts() *PktStat {
 	if m != nil {
-		return m.SentMsgCount
+		return m.CarierErrPkts
 	}
-	return 0
+	return nil
 }
 
-func (m *UrlcloudMetric) GetSentByteCount() int64 {
+type ZMetricVpn struct {
+	ConnStat             *ZMetricConn `protobuf:"bytes,1,opt,name=ConnStat,proto3" json:"ConnStat,omitempty"`
+	IkeStat              *ZMetricConn `protobuf:"bytes,2,opt,name=IkeStat,proto3" json:"IkeStat,omitempty"`
+	NatTStat             *ZMetricConn `protobuf:"bytes,3,opt,name=NatTStat,proto3" json:"NatTStat,omitempty"`
+	EspStat              *ZMetricConn `protobuf:"bytes,4,opt,name=EspStat,proto3" json:"EspStat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ZMetricVpn) Reset()         { *m = ZMetricVpn{} }
+func (m *ZMetricVpn) String() string { return proto.CompactTextString(m) }
+func (*ZMetricVpn) ProtoMessage()    {}
+func (*ZMetricVpn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{56}
+}
+
+func (m *ZMetricVpn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricVpn.Unmarshal(m, b)
+}
+func (m *ZMetricVpn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricVpn.Marshal(b, m, deterministic)
+}
+func (m *ZMetricVpn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricVpn.Merge(m, src)
+}
+func (m *ZMetricVpn) XXX_Size() int {
+	return xxx_messageInfo_ZMetricVpn.Size(m)
+}
+func (m *ZMetricVpn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricVpn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZMetricVpn proto.InternalMessageInfo
+
+func (m *ZMetricVpn) GetConnStat() *ZMetricConn {
 	if m != nil {
-		return m.SentByteCount
+		return m.ConnStat
 	}
-	return 0
+	return nil
 }
 
-func (m *UrlcloudMetric) GetRecvMsgCount() int64 {
+func (m *ZMetricVpn) GetIkeStat() *ZMetricConn {
 	if m != nil {
-		return m.RecvMsgCount
+		return m.IkeStat
 	}
-	return 0
+	return nil
 }
 
-func (m *UrlcloudMetric) GetRecvByteCount() int64 {
+func (m *ZMetricVpn) GetNatTStat() *ZMetricConn {
 	if m != nil {
-		return m.RecvByteCount
+		return m.NatTStat
 	}
-	return 0
+	return nil
 }
 
-type AppCpuMetric struct {
-	// uint32 cpuTotal = 2;		// deprecated
-	// double cpuPercentage = 3;		// deprecated
-	UpTime               *timestamp.Timestamp `protobuf:"bytes,4,opt,name=upTime,proto3" json:"upTime,omitempty"`
-	Total                uint64               `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *ZMetricVpn) GetEspStat() *ZMetricConn {
+	if m != nil {
+		return m.EspStat
+	}
+	return nil
 }
 
-func (m *AppCpuMetric) Reset()         { *m = AppCpuMetric{} }
-func (m *AppCpuMetric) String() string { return proto.CompactTextString(m) }
-func (*AppCpuMetric) ProtoMessage()    {}
-func (*AppCpuMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{37}
+// For other services with no specific metrics
+type ZMetricNone struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AppCpuMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppCpuMetric.Unmarshal(m, b)
+func (m *ZMetricNone) Reset()         { *m = ZMetricNone{} }
+func (m *ZMetricNone) String() string { return proto.CompactTextString(m) }
+func (*ZMetricNone) ProtoMessage()    {}
+func (*ZMetricNone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{57}
 }
-func (m *AppCpuMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppCpuMetric.Marshal(b, m, deterministic)
+
+func (m *ZMetricNone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricNone.Unmarshal(m, b)
 }
-func (m *AppCpuMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppCpuMetric.Merge(m, src)
+func (m *ZMetricNone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricNone.Marshal(b, m, deterministic)
 }
-func (m *AppCpuMetric) XXX_Size() int {
-	return xxx_messageInfo_AppCpuMetric.Size(m)
+func (m *ZMetricNone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricNone.Merge(m, src)
 }
-func (m *AppCpuMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppCpuMetric.DiscardUnknown(m)
+func (m *ZMetricNone) XXX_Size() int {
+	return xxx_messageInfo_ZMetricNone.Size(m)
 }
-
-var xxx_messageInfo_AppCpuMetric proto.InternalMessageInfo
-
-func (m *AppCpuMetric) GetUpTime() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpTime
-	}
-	return nil
+func (m *ZMetricNone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricNone.DiscardUnknown(m)
 }
 
-func (m *AppCpuMetric) GetTotal() uint64 {
-	if m != nil {
-		return m.Total
-	}
-	return 0
-}
+var xxx_messageInfo_ZMetricNone proto.InternalMessageInfo
 
-type DeviceMetric struct {
-	// cpuMetric cpu = 1;	// deprecated
-	Memory   *MemoryMetric     `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	Network  []*NetworkMetric  `protobuf:"bytes,3,rep,name=network,proto3" json:"network,omitempty"`
-	Zedcloud []*ZedcloudMetric `protobuf:"bytes,4,rep,name=zedcloud,proto3" json:"zedcloud,omitempty"`
-	// devCpuMetric compute = 5; // deprecated
-	Disk                     []*DiskMetric `protobuf:"bytes,6,rep,name=disk,proto3" json:"disk,omitempty"`
-	CpuMetric                *AppCpuMetric `protobuf:"bytes,7,opt,name=cpuMetric,proto3" json:"cpuMetric,omitempty"`
-	MetricItems              []*MetricItem `protobuf:"bytes,8,rep,name=metricItems,proto3" json:"metricItems,omitempty"`
-	RuntimeStorageOverheadMB uint64        `protobuf:"varint,9,opt,name=runtimeStorageOverheadMB,proto3" json:"runtimeStorageOverheadMB,omitempty"`
-	AppRunTimeStorageMB      uint64        `protobuf:"varint,10,opt,name=appRunTimeStorageMB,proto3" json:"appRunTimeStorageMB,omitempty"`
-	SystemServicesMemoryMB   *MemoryMetric `protobuf:"bytes,11,opt,name=systemServicesMemoryMB,proto3" json:"systemServicesMemoryMB,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}      `json:"-"`
-	XXX_unrecognized         []byte        `json:"-"`
-	XXX_sizecache            int32         `json:"-"`
+// flow stats
+type ZMetricFlowLink struct {
+	// Types that are valid to be assigned to Link:
+	//	*ZMetricFlowLink_SubNet
+	//	*ZMetricFlowLink_Eid
+	Link                 isZMetricFlowLink_Link `protobuf_oneof:"Link"`
+	SpiId                string                 `protobuf:"bytes,3,opt,name=spiId,proto3" json:"spiId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *DeviceMetric) Reset()         { *m = DeviceMetric{} }
-func (m *DeviceMetric) String() string { return proto.CompactTextString(m) }
-func (*DeviceMetric) ProtoMessage()    {}
-func (*DeviceMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{38}
+func (m *ZMetricFlowLink) Reset()         { *m = ZMetricFlowLink{} }
+func (m *ZMetricFlowLink) String() string { return proto.CompactTextString(m) }
+func (*ZMetricFlowLink) ProtoMessage()    {}
+func (*ZMetricFlowLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{58}
 }
 
-func (m *DeviceMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeviceMetric.Unmarshal(m, b)
+func (m *ZMetricFlowLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricFlowLink.Unmarshal(m, b)
 }
-func (m *DeviceMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeviceMetric.Marshal(b, m, deterministic)
+func (m *ZMetricFlowLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricFlowLink.Marshal(b, m, deterministic)
 }
-func (m *DeviceMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeviceMetric.Merge(m, src)
+func (m *ZMetricFlowLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricFlowLink.Merge(m, src)
 }
-func (m *DeviceMetric) XXX_Size() int {
-	return xxx_messageInfo_DeviceMetric.Size(m)
+func (m *ZMetricFlowLink) XXX_Size() int {
+	return xxx_messageInfo_ZMetricFlowLink.Size(m)
 }
-func (m *DeviceMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeviceMetric.DiscardUnknown(m)
+func (m *ZMetricFlowLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricFlowLink.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeviceMetric proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricFlowLink proto.InternalMessageInfo
 
-func (m *DeviceMetric) GetMemory() *MemoryMetric {
-	if m != nil {
-		return m.Memory
-	}
-	return nil
+type isZMetricFlowLink_Link interface {
+	isZMetricFlowLink_Link()
 }
 
-func (m *DeviceMetric) GetNetwork() []*NetworkMetric {
-	if m != nil {
-		return m.Network
-	}
-	return nil
+type ZMetricFlowLink_SubNet struct {
+	SubNet string `protobuf:"bytes,1,opt,name=subNet,proto3,oneof"`
 }
 
-func (m *DeviceMetric) GetZedcloud() []*ZedcloudMetric {
-	if m != nil {
-		return m.Zedcloud
-	}
-	return nil
+type ZMetricFlowLink_Eid struct {
+	Eid string `protobuf:"bytes,2,opt,name=eid,proto3,oneof"`
 }
 
-func (m *DeviceMetric) GetDisk() []*DiskMetric {
-	if m != nil {
-		return m.Disk
-	}
-	return nil
-}
+func (*ZMetricFlowLink_SubNet) isZMetricFlowLink_Link() {}
 
-func (m *DeviceMetric) GetCpuMetric() *AppCpuMetric {
+func (*ZMetricFlowLink_Eid) isZMetricFlowLink_Link() {}
+
+func (m *ZMetricFlowLink) GetLink() isZMetricFlowLink_Link {
 	if m != nil {
-		return m.CpuMetric
+		return m.Link
 	}
 	return nil
 }
 
-func (m *DeviceMetric) GetMetricItems() []*MetricItem {
-	if m != nil {
-		return m.MetricItems
+func (m *ZMetricFlowLink) GetSubNet() string {
+	if x, ok := m.GetLink().(*ZMetricFlowLink_SubNet); ok {
+		return x.SubNet
 	}
-	return nil
+	return ""
 }
 
-func (m *DeviceMetric) GetRuntimeStorageOverheadMB() uint64 {
-	if m != nil {
-		return m.RuntimeStorageOverheadMB
+func (m *ZMetricFlowLink) GetEid() string {
+	if x, ok := m.GetLink().(*ZMetricFlowLink_Eid); ok {
+		return x.Eid
 	}
-	return 0
+	return ""
 }
 
-func (m *DeviceMetric) GetAppRunTimeStorageMB() uint64 {
+func (m *ZMetricFlowLink) GetSpiId() string {
 	if m != nil {
-		return m.AppRunTimeStorageMB
+		return m.SpiId
 	}
-	return 0
+	return ""
 }
 
-func (m *DeviceMetric) GetSystemServicesMemoryMB() *MemoryMetric {
-	if m != nil {
-		return m.SystemServicesMemoryMB
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZMetricFlowLink) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZMetricFlowLink_SubNet)(nil),
+		(*ZMetricFlowLink_Eid)(nil),
 	}
-	return nil
 }
 
-// Open-ended metrics from different part of the device such as LTE modem
-// metrics.
-type MetricItem struct {
-	Key  string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Type MetricItemType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricItemType" json:"type,omitempty"`
-	// Types that are valid to be assigned to MetricItemValue:
-	//	*MetricItem_BoolValue
-	//	*MetricItem_Uint32Value
-	//	*MetricItem_Uint64Value
-	//	*MetricItem_FloatValue
-	//	*MetricItem_StringValue
-	MetricItemValue      isMetricItem_MetricItemValue `protobuf_oneof:"metricItemValue"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type ZMetricFlowEndPoint struct {
+	// Types that are valid to be assigned to Endpoint:
+	//	*ZMetricFlowEndPoint_IpAddr
+	//	*ZMetricFlowEndPoint_Rloc
+	Endpoint             isZMetricFlowEndPoint_Endpoint `protobuf_oneof:"Endpoint"`
+	Link                 []*ZMetricFlowLink             `protobuf:"bytes,5,rep,name=link,proto3" json:"link,omitempty"`
+	Stats                *PktStat                       `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *MetricItem) Reset()         { *m = MetricItem{} }
-func (m *MetricItem) String() string { return proto.CompactTextString(m) }
-func (*MetricItem) ProtoMessage()    {}
-func (*MetricItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{39}
+func (m *ZMetricFlowEndPoint) Reset()         { *m = ZMetricFlowEndPoint{} }
+func (m *ZMetricFlowEndPoint) String() string { return proto.CompactTextString(m) }
+func (*ZMetricFlowEndPoint) ProtoMessage()    {}
+func (*ZMetricFlowEndPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{59}
 }
 
-func (m *MetricItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MetricItem.Unmarshal(m, b)
-}
-func (m *MetricItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MetricItem.Marshal(b, m, deterministic)
-}
-func (m *MetricItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MetricItem.Merge(m, src)
-}
-func (m *MetricItem) XXX_Size() int {
-	return xxx_messageInfo_MetricItem.Size(m)
-}
-func (m *MetricItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_MetricItem.DiscardUnknown(m)
+func (m *ZMetricFlowEndPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricFlowEndPoint.Unmarshal(m, b)
 }
-
-var xxx_messageInfo_MetricItem proto.InternalMessageInfo
-
-func (m *MetricItem) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+func (m *ZMetricFlowEndPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricFlowEndPoint.Marshal(b, m, deterministic)
 }
-
-func (m *MetricItem) GetType() MetricItemType {
-	if m != nil {
-		return m.Type
-	}
-	return MetricItemType_MetricItemOther
+func (m *ZMetricFlowEndPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricFlowEndPoint.Merge(m, src)
 }
-
-type isMetricItem_MetricItemValue interface {
-	isMetricItem_MetricItemValue()
+func (m *ZMetricFlowEndPoint) XXX_Size() int {
+	return xxx_messageInfo_ZMetricFlowEndPoint.Size(m)
 }
-
-type MetricItem_BoolValue struct {
-	BoolValue bool `protobuf:"varint,3,opt,name=boolValue,proto3,oneof"`
+func (m *ZMetricFlowEndPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricFlowEndPoint.DiscardUnknown(m)
 }
 
-type MetricItem_Uint32Value struct {
-	Uint32Value uint32 `protobuf:"varint,4,opt,name=uint32Value,proto3,oneof"`
-}
+var xxx_messageInfo_ZMetricFlowEndPoint proto.InternalMessageInfo
 
-type MetricItem_Uint64Value struct {
-	Uint64Value uint64 `protobuf:"varint,5,opt,name=uint64Value,proto3,oneof"`
+type isZMetricFlowEndPoint_Endpoint interface {
+	isZMetricFlowEndPoint_Endpoint()
 }
 
-type MetricItem_FloatValue struct {
-	FloatValue float32 `protobuf:"fixed32,6,opt,name=floatValue,proto3,oneof"`
+type ZMetricFlowEndPoint_IpAddr struct {
+	IpAddr string `protobuf:"bytes,1,opt,name=ipAddr,proto3,oneof"`
 }
 
-type MetricItem_StringValue struct {
-	StringValue string `protobuf:"bytes,7,opt,name=stringValue,proto3,oneof"`
+type ZMetricFlowEndPoint_Rloc struct {
+	Rloc string `protobuf:"bytes,2,opt,name=rloc,proto3,oneof"`
 }
 
-func (*MetricItem_BoolValue) isMetricItem_MetricItemValue() {}
-
-func (*MetricItem_Uint32Value) isMetricItem_MetricItemValue() {}
-
-func (*MetricItem_Uint64Value) isMetricItem_MetricItemValue() {}
-
-func (*MetricItem_FloatValue) isMetricItem_MetricItemValue() {}
+func (*ZMetricFlowEndPoint_IpAddr) isZMetricFlowEndPoint_Endpoint() {}
 
-func (*MetricItem_StringValue) isMetricItem_MetricItemValue() {}
+func (*ZMetricFlowEndPoint_Rloc) isZMetricFlowEndPoint_Endpoint() {}
 
-func (m *MetricItem) GetMetricItemValue() isMetricItem_MetricItemValue {
+func (m *ZMetricFlowEndPoint) GetEndpoint() isZMetricFlowEndPoint_Endpoint {
 	if m != nil {
-		return m.MetricItemValue
+		return m.Endpoint
 	}
 	return nil
 }
 
-func (m *MetricItem) GetBoolValue() bool {
-	if x, ok := m.GetMetricItemValue().(*MetricItem_BoolValue); ok {
-		return x.BoolValue
-	}
-	return false
-}
-
-func (m *MetricItem) GetUint32Value() uint32 {
-	if x, ok := m.GetMetricItemValue().(*MetricItem_Uint32Value); ok {
-		return x.Uint32Value
-	}
-	return 0
-}
-
-func (m *MetricItem) GetUint64Value() uint64 {
-	if x, ok := m.GetMetricItemValue().(*MetricItem_Uint64Value); ok {
-		return x.Uint64Value
+func (m *ZMetricFlowEndPoint) GetIpAddr() string {
+	if x, ok := m.GetEndpoint().(*ZMetricFlowEndPoint_IpAddr); ok {
+		return x.IpAddr
 	}
-	return 0
+	return ""
 }
 
-func (m *MetricItem) GetFloatValue() float32 {
-	if x, ok := m.GetMetricItemValue().(*MetricItem_FloatValue); ok {
-		return x.FloatValue
+func (m *ZMetricFlowEndPoint) GetRloc() string {
+	if x, ok := m.GetEndpoint().(*ZMetricFlowEndPoint_Rloc); ok {
+		return x.Rloc
 	}
-	return 0
+	return ""
 }
 
-func (m *MetricItem) GetStringValue() string {
-	if x, ok := m.GetMetricItemValue().(*MetricItem_StringValue); ok {
-		return x.StringValue
+func (m *ZMetricFlowEndPoint) GetLink() []*ZMetricFlowLink {
+	if m != nil {
+		return m.Link
 	}
-	return ""
+	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*MetricItem) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*MetricItem_BoolValue)(nil),
-		(*MetricItem_Uint32Value)(nil),
-		(*MetricItem_Uint64Value)(nil),
-		(*MetricItem_FloatValue)(nil),
-		(*MetricItem_StringValue)(nil),
+func (m *ZMetricFlowEndPoint) GetStats() *PktStat {
+	if m != nil {
+		return m.Stats
 	}
+	return nil
 }
-
-// For each partition; counts since boot
-type DiskMetric struct {
-	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
-	MountPath            string   `protobuf:"bytes,2,opt,name=mountPath,proto3" json:"mountPath,omitempty"`
-	ReadBytes            uint64   `protobuf:"varint,3,opt,name=readBytes,proto3" json:"readBytes,omitempty"`
-	WriteBytes           uint64   `protobuf:"varint,4,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
-	ReadCount            uint64   `protobuf:"varint,5,opt,name=readCount,proto3" json:"readCount,omitempty"`
-	WriteCount           uint64   `protobuf:"varint,6,opt,name=writeCount,proto3" json:"writeCount,omitempty"`
-	Total                uint64   `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
-	Used                 uint64   `protobuf:"varint,8,opt,name=used,proto3" json:"used,omitempty"`
-	Free                 uint64   `protobuf:"varint,9,opt,name=free,proto3" json:"free,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZMetricFlowEndPoint) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZMetricFlowEndPoint_IpAddr)(nil),
+		(*ZMetricFlowEndPoint_Rloc)(nil),
+	}
 }
 
-func (m *DiskMetric) Reset()         { *m = DiskMetric{} }
-func (m *DiskMetric) String() string { return proto.CompactTextString(m) }
-func (*DiskMetric) ProtoMessage()    {}
-func (*DiskMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{40}
+type ZMetricFlow struct {
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 uint32                 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	Iid                  uint64                 `protobuf:"varint,4,opt,name=iid,proto3" json:"iid,omitempty"`
+	EstTime              uint64                 `protobuf:"varint,5,opt,name=estTime,proto3" json:"estTime,omitempty"`
+	LEndPoint            *ZMetricFlowEndPoint   `protobuf:"bytes,10,opt,name=lEndPoint,proto3" json:"lEndPoint,omitempty"`
+	REndPoint            []*ZMetricFlowEndPoint `protobuf:"bytes,11,rep,name=rEndPoint,proto3" json:"rEndPoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *DiskMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DiskMetric.Unmarshal(m, b)
+func (m *ZMetricFlow) Reset()         { *m = ZMetricFlow{} }
+func (m *ZMetricFlow) String() string { return proto.CompactTextString(m) }
+func (*ZMetricFlow) ProtoMessage()    {}
+func (*ZMetricFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{60}
 }
-func (m *DiskMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DiskMetric.Marshal(b, m, deterministic)
+
+func (m *ZMetricFlow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricFlow.Unmarshal(m, b)
 }
-func (m *DiskMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiskMetric.Merge(m, src)
+func (m *ZMetricFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricFlow.Marshal(b, m, deterministic)
 }
-func (m *DiskMetric) XXX_Size() int {
-	return xxx_messageInfo_DiskMetric.Size(m)
+func (m *ZMetricFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricFlow.Merge(m, src)
 }
-func (m *DiskMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiskMetric.DiscardUnknown(m)
+func (m *ZMetricFlow) XXX_Size() int {
+	return xxx_messageInfo_ZMetricFlow.Size(m)
+}
+func (m *ZMetricFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricFlow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiskMetric proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricFlow proto.InternalMessageInfo
 
-func (m *DiskMetric) GetDisk() string {
+func (m *ZMetricFlow) GetId() string {
 	if m != nil {
-		return m.Disk
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DiskMetric) GetMountPath() string {
+func (m *ZMetricFlow) GetName() string {
 	if m != nil {
-		return m.MountPath
+		return m.Name
 	}
 	return ""
 }
 
-func (m *DiskMetric) GetReadBytes() uint64 {
+func (m *ZMetricFlow) GetType() uint32 {
 	if m != nil {
-		return m.ReadBytes
+		return m.Type
 	}
 	return 0
 }
 
-func (m *DiskMetric) GetWriteBytes() uint64 {
+func (m *ZMetricFlow) GetIid() uint64 {
 	if m != nil {
-		return m.WriteBytes
+		return m.Iid
 	}
 	return 0
 }
 
-func (m *DiskMetric) GetReadCount() uint64 {
+func (m *ZMetricFlow) GetEstTime() uint64 {
 	if m != nil {
-		return m.ReadCount
+		return m.EstTime
 	}
 	return 0
 }
 
-func (m *DiskMetric) GetWriteCount() uint64 {
+func (m *ZMetricFlow) GetLEndPoint() *ZMetricFlowEndPoint {
 	if m != nil {
-		return m.WriteCount
+		return m.LEndPoint
 	}
-	return 0
+	return nil
 }
 
-func (m *DiskMetric) GetTotal() uint64 {
+func (m *ZMetricFlow) GetREndPoint() []*ZMetricFlowEndPoint {
 	if m != nil {
-		return m.Total
+		return m.REndPoint
 	}
-	return 0
+	return nil
 }
 
-func (m *DiskMetric) GetUsed() uint64 {
+type ZMetricLispGlobal struct {
+	// Encap/ITR Statistics
+	ItrPacketSendError *PktStat `protobuf:"bytes,2,opt,name=ItrPacketSendError,proto3" json:"ItrPacketSendError,omitempty"`
+	InvalidEidError    *PktStat `protobuf:"bytes,3,opt,name=InvalidEidError,proto3" json:"InvalidEidError,omitempty"`
+	// Decap/ETR Statistics
+	NoDecryptKey         *PktStat `protobuf:"bytes,4,opt,name=NoDecryptKey,proto3" json:"NoDecryptKey,omitempty"`
+	OuterHeaderError     *PktStat `protobuf:"bytes,5,opt,name=OuterHeaderError,proto3" json:"OuterHeaderError,omitempty"`
+	BadInnerVersion      *PktStat `protobuf:"bytes,6,opt,name=BadInnerVersion,proto3" json:"BadInnerVersion,omitempty"`
+	GoodPackets          *PktStat `protobuf:"bytes,7,opt,name=GoodPackets,proto3" json:"GoodPackets,omitempty"`
+	ICVError             *PktStat `protobuf:"bytes,8,opt,name=ICVError,proto3" json:"ICVError,omitempty"`
+	LispHeaderError      *PktStat `protobuf:"bytes,9,opt,name=LispHeaderError,proto3" json:"LispHeaderError,omitempty"`
+	CheckSumError        *PktStat `protobuf:"bytes,10,opt,name=CheckSumError,proto3" json:"CheckSumError,omitempty"`
+	DecapReInjectError   *PktStat `protobuf:"bytes,11,opt,name=DecapReInjectError,proto3" json:"DecapReInjectError,omitempty"`
+	DecryptError         *PktStat `protobuf:"bytes,12,opt,name=DecryptError,proto3" json:"DecryptError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZMetricLispGlobal) Reset()         { *m = ZMetricLispGlobal{} }
+func (m *ZMetricLispGlobal) String() string { return proto.CompactTextString(m) }
+func (*ZMetricLispGlobal) ProtoMessage()    {}
+func (*ZMetricLispGlobal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{61}
+}
+
+func (m *ZMetricLispGlobal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricLispGlobal.Unmarshal(m, b)
+}
+func (m *ZMetricLispGlobal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricLispGlobal.Marshal(b, m, deterministic)
+}
+func (m *ZMetricLispGlobal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricLispGlobal.Merge(m, src)
+}
+func (m *ZMetricLispGlobal) XXX_Size() int {
+	return xxx_messageInfo_ZMetricLispGlobal.Size(m)
+}
+func (m *ZMetricLispGlobal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricLispGlobal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZMetricLispGlobal proto.InternalMessageInfo
+
+func (m *ZMetricLispGlobal) GetItrPacketSendError() *PktStat {
 	if m != nil {
-		return m.Used
+		return m.ItrPacketSendError
 	}
-	return 0
+	return nil
 }
 
-func (m *DiskMetric) GetFree() uint64 {
+func (m *ZMetricLispGlobal) GetInvalidEidError() *PktStat {
 	if m != nil {
-		return m.Free
+		return m.InvalidEidError
 	}
-	return 0
+	return nil
 }
 
-type AppDiskMetric struct {
-	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
-	Provisioned          uint64   `protobuf:"varint,2,opt,name=provisioned,proto3" json:"provisioned,omitempty"`
-	Used                 uint64   `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
-	DiskType             string   `protobuf:"bytes,4,opt,name=diskType,proto3" json:"diskType,omitempty"`
-	Dirty                bool     `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ZMetricLispGlobal) GetNoDecryptKey() *PktStat {
+	if m != nil {
+		return m.NoDecryptKey
+	}
+	return nil
 }
 
-func (m *AppDiskMetric) Reset()         { *m = AppDiskMetric{} }
-func (m *AppDiskMetric) String() string { return proto.CompactTextString(m) }
-func (*AppDiskMetric) ProtoMessage()    {}
-func (*AppDiskMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{41}
+func (m *ZMetricLispGlobal) GetOuterHeaderError() *PktStat {
+	if m != nil {
+		return m.OuterHeaderError
+	}
+	return nil
 }
 
-func (m *AppDiskMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppDiskMetric.Unmarshal(m, b)
-}
-func (m *AppDiskMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppDiskMetric.Marshal(b, m, deterministic)
-}
-func (m *AppDiskMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppDiskMetric.Merge(m, src)
-}
-func (m *AppDiskMetric) XXX_Size() int {
-	return xxx_messageInfo_AppDiskMetric.Size(m)
-}
-func (m *AppDiskMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppDiskMetric.DiscardUnknown(m)
+func (m *ZMetricLispGlobal) GetBadInnerVersion() *PktStat {
+	if m != nil {
+		return m.BadInnerVersion
+	}
+	return nil
 }
 
-var xxx_messageInfo_AppDiskMetric proto.InternalMessageInfo
+func (m *ZMetricLispGlobal) GetGoodPackets() *PktStat {
+	if m != nil {
+		return m.GoodPackets
+	}
+	return nil
+}
 
-func (m *AppDiskMetric) GetDisk() string {
+func (m *ZMetricLispGlobal) GetICVError() *PktStat {
 	if m != nil {
-		return m.Disk
+		return m.ICVError
 	}
-	return ""
+	return nil
 }
 
-func (m *AppDiskMetric) GetProvisioned() uint64 {
+func (m *ZMetricLispGlobal) GetLispHeaderError() *PktStat {
 	if m != nil {
-		return m.Provisioned
+		return m.LispHeaderError
 	}
-	return 0
+	return nil
 }
 
-func (m *AppDiskMetric) GetUsed() uint64 {
+func (m *ZMetricLispGlobal) GetCheckSumError() *PktStat {
 	if m != nil {
-		return m.Used
+		return m.CheckSumError
 	}
-	return 0
+	return nil
 }
 
-func (m *AppDiskMetric) GetDiskType() string {
+func (m *ZMetricLispGlobal) GetDecapReInjectError() *PktStat {
 	if m != nil {
-		return m.DiskType
+		return m.DecapReInjectError
 	}
-	return ""
+	return nil
 }
 
-func (m *AppDiskMetric) GetDirty() bool {
+func (m *ZMetricLispGlobal) GetDecryptError() *PktStat {
 	if m != nil {
-		return m.Dirty
+		return m.DecryptError
 	}
-	return false
+	return nil
 }
 
-type AppMetric struct {
-	AppID                string           `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
-	AppVersion           string           `protobuf:"bytes,10,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
-	AppName              string           `protobuf:"bytes,2,opt,name=AppName,proto3" json:"AppName,omitempty"`
-	Cpu                  *AppCpuMetric    `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Memory               *MemoryMetric    `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
-	Network              []*NetworkMetric `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
-	Disk                 []*AppDiskMetric `protobuf:"bytes,6,rep,name=disk,proto3" json:"disk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// XXX to be deprecated
+type ZMetricService struct {
+	ServiceID   string           `protobuf:"bytes,1,opt,name=serviceID,proto3" json:"serviceID,omitempty"`
+	ServiceName string           `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ServiceType uint32           `protobuf:"varint,3,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
+	Network     []*NetworkMetric `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
+	// Types that are valid to be assigned to ServiceContent:
+	//	*ZMetricService_Vpnm
+	//	*ZMetricService_Lispm
+	ServiceContent       isZMetricService_ServiceContent `protobuf_oneof:"ServiceContent"`
+	FlowStats            []*ZMetricFlow                  `protobuf:"bytes,10,rep,name=flowStats,proto3" json:"flowStats,omitempty"`
+	LispGlobalStats      *ZMetricLispGlobal              `protobuf:"bytes,11,opt,name=lispGlobalStats,proto3" json:"lispGlobalStats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *AppMetric) Reset()         { *m = AppMetric{} }
-func (m *AppMetric) String() string { return proto.CompactTextString(m) }
-func (*AppMetric) ProtoMessage()    {}
-func (*AppMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{42}
+func (m *ZMetricService) Reset()         { *m = ZMetricService{} }
+func (m *ZMetricService) String() string { return proto.CompactTextString(m) }
+func (*ZMetricService) ProtoMessage()    {}
+func (*ZMetricService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{62}
 }
 
-func (m *AppMetric) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppMetric.Unmarshal(m, b)
+func (m *ZMetricService) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricService.Unmarshal(m, b)
 }
-func (m *AppMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppMetric.Marshal(b, m, deterministic)
+func (m *ZMetricService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricService.Marshal(b, m, deterministic)
 }
-func (m *AppMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMetric.Merge(m, src)
+func (m *ZMetricService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricService.Merge(m, src)
 }
-func (m *AppMetric) XXX_Size() int {
-	return xxx_messageInfo_AppMetric.Size(m)
+func (m *ZMetricService) XXX_Size() int {
+	return xxx_messageInfo_ZMetricService.Size(m)
 }
-func (m *AppMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMetric.DiscardUnknown(m)
+func (m *ZMetricService) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricService.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppMetric proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricService proto.InternalMessageInfo
 
-func (m *AppMetric) GetAppID() string {
+func (m *ZMetricService) GetServiceID() string {
 	if m != nil {
-		return m.AppID
+		return m.ServiceID
 	}
 	return ""
 }
 
-func (m *AppMetric) GetAppVersion() string {
+func (m *ZMetricService) GetServiceName() string {
 	if m != nil {
-		return m.AppVersion
+		return m.ServiceName
 	}
 	return ""
 }
 
-func (m *AppMetric) GetAppName() string {
+func (m *ZMetricService) GetServiceType() uint32 {
 	if m != nil {
-		return m.AppName
+		return m.ServiceType
 	}
-	return ""
+	return 0
 }
 
-func (m *AppMetric) GetCpu() *AppCpuMetric {
+func (m *ZMetricService) GetNetwork() []*NetworkMetric {
 	if m != nil {
-		return m.Cpu
+		return m.Network
 	}
 	return nil
 }
 
-func (m *AppMetric) GetMemory() *MemoryMetric {
+type isZMetricService_ServiceContent interface {
+	isZMetricService_ServiceContent()
+}
+
+type ZMetricService_Vpnm struct {
+	Vpnm *ZMetricVpn `protobuf:"bytes,20,opt,name=vpnm,proto3,oneof"`
+}
+
+type ZMetricService_Lispm struct {
+	Lispm *ZMetricLisp `protobuf:"bytes,21,opt,name=lispm,proto3,oneof"`
+}
+
+func (*ZMetricService_Vpnm) isZMetricService_ServiceContent() {}
+
+func (*ZMetricService_Lispm) isZMetricService_ServiceContent() {}
+
+func (m *ZMetricService) GetServiceContent() isZMetricService_ServiceContent {
 	if m != nil {
-		return m.Memory
+		return m.ServiceContent
 	}
 	return nil
 }
 
-func (m *AppMetric) GetNetwork() []*NetworkMetric {
+func (m *ZMetricService) GetVpnm() *ZMetricVpn {
+	if x, ok := m.GetServiceContent().(*ZMetricService_Vpnm); ok {
+		return x.Vpnm
+	}
+	return nil
+}
+
+func (m *ZMetricService) GetLispm() *ZMetricLisp {
+	if x, ok := m.GetServiceContent().(*ZMetricService_Lispm); ok {
+		return x.Lispm
+	}
+	return nil
+}
+
+func (m *ZMetricService) GetFlowStats() []*ZMetricFlow {
 	if m != nil {
-		return m.Network
+		return m.FlowStats
 	}
 	return nil
 }
 
-func (m *AppMetric) GetDisk() []*AppDiskMetric {
+func (m *ZMetricService) GetLispGlobalStats() *ZMetricLispGlobal {
 	if m != nil {
-		return m.Disk
+		return m.LispGlobalStats
 	}
 	return nil
 }
 
-// Lisp stats
-type PktStat struct {
-	Packets              uint64   `protobuf:"varint,1,opt,name=Packets,proto3" json:"Packets,omitempty"`
-	Bytes                uint64   `protobuf:"varint,2,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZMetricService) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZMetricService_Vpnm)(nil),
+		(*ZMetricService_Lispm)(nil),
+	}
+}
+
+type NetworkStats struct {
+	TotalPackets         uint64   `protobuf:"varint,1,opt,name=totalPackets,proto3" json:"totalPackets,omitempty"`
+	Errors               uint64   `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"`
+	Drops                uint64   `protobuf:"varint,3,opt,name=drops,proto3" json:"drops,omitempty"`
+	TotalBytes           uint64   `protobuf:"varint,4,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PktStat) Reset()         { *m = PktStat{} }
-func (m *PktStat) String() string { return proto.CompactTextString(m) }
-func (*PktStat) ProtoMessage()    {}
-func (*PktStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{43}
+func (m *NetworkStats) Reset()         { *m = NetworkStats{} }
+func (m *NetworkStats) String() string { return proto.CompactTextString(m) }
+func (*NetworkStats) ProtoMessage()    {}
+func (*NetworkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{63}
 }
 
-func (m *PktStat) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PktStat.Unmarshal(m, b)
+func (m *NetworkStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkStats.Unmarshal(m, b)
 }
-func (m *PktStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PktStat.Marshal(b, m, deterministic)
+func (m *NetworkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkStats.Marshal(b, m, deterministic)
 }
-func (m *PktStat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PktStat.Merge(m, src)
+func (m *NetworkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkStats.Merge(m, src)
 }
-func (m *PktStat) XXX_Size() int {
-	return xxx_messageInfo_PktStat.Size(m)
+func (m *NetworkStats) XXX_Size() int {
+	return xxx_messageInfo_NetworkStats.Size(m)
 }
-func (m *PktStat) XXX_DiscardUnknown() {
-	xxx_messageInfo_PktStat.DiscardUnknown(m)
+func (m *NetworkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PktStat proto.InternalMessageInfo
+var xxx_messageInfo_NetworkStats proto.InternalMessageInfo
 
-func (m *PktStat) GetPackets() uint64 {
+func (m *NetworkStats) GetTotalPackets() uint64 {
 	if m != nil {
-		return m.Packets
+		return m.TotalPackets
 	}
 	return 0
 }
 
-func (m *PktStat) GetBytes() uint64 {
+func (m *NetworkStats) GetErrors() uint64 {
 	if m != nil {
-		return m.Bytes
+		return m.Errors
 	}
 	return 0
 }
 
-type RlocStats struct {
-	Rloc                   string   `protobuf:"bytes,1,opt,name=Rloc,proto3" json:"Rloc,omitempty"`
-	Stats                  *PktStat `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats,omitempty"`
-	SecondsSinceLastPacket uint64   `protobuf:"varint,3,opt,name=SecondsSinceLastPacket,proto3" json:"SecondsSinceLastPacket,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+func (m *NetworkStats) GetDrops() uint64 {
+	if m != nil {
+		return m.Drops
+	}
+	return 0
 }
 
-func (m *RlocStats) Reset()         { *m = RlocStats{} }
-func (m *RlocStats) String() string { return proto.CompactTextString(m) }
-func (*RlocStats) ProtoMessage()    {}
-func (*RlocStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{44}
+func (m *NetworkStats) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
 }
 
-func (m *RlocStats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RlocStats.Unmarshal(m, b)
+type ZMetricNetworkStats struct {
+	Rx                   *NetworkStats `protobuf:"bytes,1,opt,name=rx,proto3" json:"rx,omitempty"`
+	Tx                   *NetworkStats `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *RlocStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RlocStats.Marshal(b, m, deterministic)
+
+func (m *ZMetricNetworkStats) Reset()         { *m = ZMetricNetworkStats{} }
+func (m *ZMetricNetworkStats) String() string { return proto.CompactTextString(m) }
+func (*ZMetricNetworkStats) ProtoMessage()    {}
+func (*ZMetricNetworkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{64}
 }
-func (m *RlocStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RlocStats.Merge(m, src)
+
+func (m *ZMetricNetworkStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricNetworkStats.Unmarshal(m, b)
 }
-func (m *RlocStats) XXX_Size() int {
-	return xxx_messageInfo_RlocStats.Size(m)
+func (m *ZMetricNetworkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricNetworkStats.Marshal(b, m, deterministic)
 }
-func (m *RlocStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_RlocStats.DiscardUnknown(m)
+func (m *ZMetricNetworkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricNetworkStats.Merge(m, src)
 }
-
-var xxx_messageInfo_RlocStats proto.InternalMessageInfo
-
-func (m *RlocStats) GetRloc() string {
-	if m != nil {
-		return m.Rloc
-	}
-	return ""
+func (m *ZMetricNetworkStats) XXX_Size() int {
+	return xxx_messageInfo_ZMetricNetworkStats.Size(m)
+}
+func (m *ZMetricNetworkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricNetworkStats.DiscardUnknown(m)
 }
 
-func (m *RlocStats) GetStats() *PktStat {
+var xxx_messageInfo_ZMetricNetworkStats proto.InternalMessageInfo
+
+func (m *ZMetricNetworkStats) GetRx() *NetworkStats {
 	if m != nil {
-		return m.Stats
+		return m.Rx
 	}
 	return nil
 }
 
-func (m *RlocStats) GetSecondsSinceLastPacket() uint64 {
+func (m *ZMetricNetworkStats) GetTx() *NetworkStats {
 	if m != nil {
-		return m.SecondsSinceLastPacket
+		return m.Tx
 	}
-	return 0
+	return nil
 }
 
-type EidStats struct {
-	IID                  uint64       `protobuf:"varint,1,opt,name=IID,proto3" json:"IID,omitempty"`
-	EID                  string       `protobuf:"bytes,2,opt,name=EID,proto3" json:"EID,omitempty"`
-	RlocStatsEntries     []*RlocStats `protobuf:"bytes,3,rep,name=RlocStatsEntries,proto3" json:"RlocStatsEntries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type ZMetricNetworkInstance struct {
+	NetworkID      string           `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	NetworkVersion string           `protobuf:"bytes,3,opt,name=networkVersion,proto3" json:"networkVersion,omitempty"`
+	InstType       uint32           `protobuf:"varint,5,opt,name=instType,proto3" json:"instType,omitempty"`
+	Displayname    string           `protobuf:"bytes,6,opt,name=displayname,proto3" json:"displayname,omitempty"`
+	Activated      bool             `protobuf:"varint,7,opt,name=activated,proto3" json:"activated,omitempty"`
+	Network        []*NetworkMetric `protobuf:"bytes,10,rep,name=network,proto3" json:"network,omitempty"`
+	// Types that are valid to be assigned to InstanceContent:
+	//	*ZMetricNetworkInstance_Vpnm
+	//	*ZMetricNetworkInstance_Lispm
+	//	*ZMetricNetworkInstance_Nonem
+	InstanceContent      isZMetricNetworkInstance_InstanceContent `protobuf_oneof:"InstanceContent"`
+	FlowStats            []*ZMetricFlow                           `protobuf:"bytes,30,rep,name=flowStats,proto3" json:"flowStats,omitempty"`
+	LispGlobalStats      *ZMetricLispGlobal                       `protobuf:"bytes,31,opt,name=lispGlobalStats,proto3" json:"lispGlobalStats,omitempty"`
+	NetworkStats         *ZMetricNetworkStats                     `protobuf:"bytes,40,opt,name=networkStats,proto3" json:"networkStats,omitempty"`
+	AppDnsStats          []*ZMetricAppDns                         `protobuf:"bytes,41,rep,name=appDnsStats,proto3" json:"appDnsStats,omitempty"`
+	DnsQueries           []*ZMetricDnsQuery                       `protobuf:"bytes,42,rep,name=dnsQueries,proto3" json:"dnsQueries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *EidStats) Reset()         { *m = EidStats{} }
-func (m *EidStats) String() string { return proto.CompactTextString(m) }
-func (*EidStats) ProtoMessage()    {}
-func (*EidStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{45}
+func (m *ZMetricNetworkInstance) Reset()         { *m = ZMetricNetworkInstance{} }
+func (m *ZMetricNetworkInstance) String() string { return proto.CompactTextString(m) }
+func (*ZMetricNetworkInstance) ProtoMessage()    {}
+func (*ZMetricNetworkInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{65}
 }
 
-func (m *EidStats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EidStats.Unmarshal(m, b)
+func (m *ZMetricNetworkInstance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricNetworkInstance.Unmarshal(m, b)
 }
-func (m *EidStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EidStats.Marshal(b, m, deterministic)
+func (m *ZMetricNetworkInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricNetworkInstance.Marshal(b, m, deterministic)
 }
-func (m *EidStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EidStats.Merge(m, src)
+func (m *ZMetricNetworkInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricNetworkInstance.Merge(m, src)
 }
-func (m *EidStats) XXX_Size() int {
-	return xxx_messageInfo_EidStats.Size(m)
+func (m *ZMetricNetworkInstance) XXX_Size() int {
+	return xxx_messageInfo_ZMetricNetworkInstance.Size(m)
 }
-func (m *EidStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_EidStats.DiscardUnknown(m)
+func (m *ZMetricNetworkInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricNetworkInstance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EidStats proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricNetworkInstance proto.InternalMessageInfo
 
-func (m *EidStats) GetIID() uint64 {
+func (m *ZMetricNetworkInstance) GetNetworkID() string {
 	if m != nil {
-		return m.IID
+		return m.NetworkID
 	}
-	return 0
+	return ""
 }
 
-func (m *EidStats) GetEID() string {
+func (m *ZMetricNetworkInstance) GetNetworkVersion() string {
 	if m != nil {
-		return m.EID
+		return m.NetworkVersion
 	}
 	return ""
 }
 
-func (m *EidStats) GetRlocStatsEntries() []*RlocStats {
+func (m *ZMetricNetworkInstance) GetInstType() uint32 {
 	if m != nil {
-		return m.RlocStatsEntries
+		return m.InstType
 	}
-	return nil
+	return 0
 }
 
-type ZMetricLisp struct {
-	// Encap Statistics
-	EidStatsEntries    []*EidStats `protobuf:"bytes,1,rep,name=EidStatsEntries,proto3" json:"EidStatsEntries,omitempty"`
-	ItrPacketSendError *PktStat    `protobuf:"bytes,2,opt,name=ItrPacketSendError,proto3" json:"ItrPacketSendError,omitempty"`
-	InvalidEidError    *PktStat    `protobuf:"bytes,3,opt,name=InvalidEidError,proto3" json:"InvalidEidError,omitempty"`
-	// Decap Statistics
-	NoDecryptKey         *PktStat `protobuf:"bytes,4,opt,name=NoDecryptKey,proto3" json:"NoDecryptKey,omitempty"`
-	OuterHeaderError     *PktStat `protobuf:"bytes,5,opt,name=OuterHeaderError,proto3" json:"OuterHeaderError,omitempty"`
-	BadInnerVersion      *PktStat `protobuf:"bytes,6,opt,name=BadInnerVersion,proto3" json:"BadInnerVersion,omitempty"`
-	GoodPackets          *PktStat `protobuf:"bytes,7,opt,name=GoodPackets,proto3" json:"GoodPackets,omitempty"`
-	ICVError             *PktStat `protobuf:"bytes,8,opt,name=ICVError,proto3" json:"ICVError,omitempty"`
-	LispHeaderError      *PktStat `protobuf:"bytes,9,opt,name=LispHeaderError,proto3" json:"LispHeaderError,omitempty"`
-	CheckSumError        *PktStat `protobuf:"bytes,10,opt,name=CheckSumError,proto3" json:"CheckSumError,omitempty"`
-	DecapReInjectError   *PktStat `protobuf:"bytes,11,opt,name=DecapReInjectError,proto3" json:"DecapReInjectError,omitempty"`
-	DecryptError         *PktStat `protobuf:"bytes,12,opt,name=DecryptError,proto3" json:"DecryptError,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ZMetricNetworkInstance) GetDisplayname() string {
+	if m != nil {
+		return m.Displayname
+	}
+	return ""
 }
 
-func (m *ZMetricLisp) Reset()         { *m = ZMetricLisp{} }
-func (m *ZMetricLisp) String() string { return proto.CompactTextString(m) }
-func (*ZMetricLisp) ProtoMessage()    {}
-func (*ZMetricLisp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{46}
+func (m *ZMetricNetworkInstance) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
 }
 
-func (m *ZMetricLisp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricLisp.Unmarshal(m, b)
+func (m *ZMetricNetworkInstance) GetNetwork() []*NetworkMetric {
+	if m != nil {
+		return m.Network
+	}
+	return nil
 }
-func (m *ZMetricLisp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricLisp.Marshal(b, m, deterministic)
+
+type isZMetricNetworkInstance_InstanceContent interface {
+	isZMetricNetworkInstance_InstanceContent()
 }
-func (m *ZMetricLisp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricLisp.Merge(m, src)
+
+type ZMetricNetworkInstance_Vpnm struct {
+	Vpnm *ZMetricVpn `protobuf:"bytes,20,opt,name=vpnm,proto3,oneof"`
 }
-func (m *ZMetricLisp) XXX_Size() int {
-	return xxx_messageInfo_ZMetricLisp.Size(m)
+
+type ZMetricNetworkInstance_Lispm struct {
+	Lispm *ZMetricLisp `protobuf:"bytes,21,opt,name=lispm,proto3,oneof"`
 }
-func (m *ZMetricLisp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricLisp.DiscardUnknown(m)
+
+type ZMetricNetworkInstance_Nonem struct {
+	Nonem *ZMetricNone `protobuf:"bytes,22,opt,name=nonem,proto3,oneof"`
 }
 
-var xxx_messageInfo_ZMetricLisp proto.InternalMessageInfo
+func (*ZMetricNetworkInstance_Vpnm) isZMetricNetworkInstance_InstanceContent() {}
 
-func (m *ZMetricLisp) GetEidStatsEntries() []*EidStats {
-	if m != nil {
-		return m.EidStatsEntries
-	}
-	return nil
-}
+func (*ZMetricNetworkInstance_Lispm) isZMetricNetworkInstance_InstanceContent() {}
 
-func (m *ZMetricLisp) GetItrPacketSendError() *PktStat {
-	if m != nil {
-		return m.ItrPacketSendError
-	}
-	return nil
-}
+func (*ZMetricNetworkInstance_Nonem) isZMetricNetworkInstance_InstanceContent() {}
 
-func (m *ZMetricLisp) GetInvalidEidError() *PktStat {
+func (m *ZMetricNetworkInstance) GetInstanceContent() isZMetricNetworkInstance_InstanceContent {
 	if m != nil {
-		return m.InvalidEidError
+		return m.InstanceContent
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetNoDecryptKey() *PktStat {
-	if m != nil {
-		return m.NoDecryptKey
+func (m *ZMetricNetworkInstance) GetVpnm() *ZMetricVpn {
+	if x, ok := m.GetInstanceContent().(*ZMetricNetworkInstance_Vpnm); ok {
+		return x.Vpnm
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetOuterHeaderError() *PktStat {
-	if m != nil {
-		return m.OuterHeaderError
+func (m *ZMetricNetworkInstance) GetLispm() *ZMetricLisp {
+	if x, ok := m.GetInstanceContent().(*ZMetricNetworkInstance_Lispm); ok {
+		return x.Lispm
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetBadInnerVersion() *PktStat {
-	if m != nil {
-		return m.BadInnerVersion
+func (m *ZMetricNetworkInstance) GetNonem() *ZMetricNone {
+	if x, ok := m.GetInstanceContent().(*ZMetricNetworkInstance_Nonem); ok {
+		return x.Nonem
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetGoodPackets() *PktStat {
+func (m *ZMetricNetworkInstance) GetFlowStats() []*ZMetricFlow {
 	if m != nil {
-		return m.GoodPackets
+		return m.FlowStats
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetICVError() *PktStat {
+func (m *ZMetricNetworkInstance) GetLispGlobalStats() *ZMetricLispGlobal {
 	if m != nil {
-		return m.ICVError
+		return m.LispGlobalStats
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetLispHeaderError() *PktStat {
+func (m *ZMetricNetworkInstance) GetNetworkStats() *ZMetricNetworkStats {
 	if m != nil {
-		return m.LispHeaderError
+		return m.NetworkStats
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetCheckSumError() *PktStat {
+func (m *ZMetricNetworkInstance) GetAppDnsStats() []*ZMetricAppDns {
 	if m != nil {
-		return m.CheckSumError
+		return m.AppDnsStats
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetDecapReInjectError() *PktStat {
+func (m *ZMetricNetworkInstance) GetDnsQueries() []*ZMetricDnsQuery {
 	if m != nil {
-		return m.DecapReInjectError
+		return m.DnsQueries
 	}
 	return nil
 }
 
-func (m *ZMetricLisp) GetDecryptError() *PktStat {
-	if m != nil {
-		return m.DecryptError
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZMetricNetworkInstance) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZMetricNetworkInstance_Vpnm)(nil),
+		(*ZMetricNetworkInstance_Lispm)(nil),
+		(*ZMetricNetworkInstance_Nonem)(nil),
 	}
-	return nil
 }
 
-// Vpn Stats
-type ZMetricConn struct {
-	InPkts               *PktStat `protobuf:"bytes,1,opt,name=InPkts,proto3" json:"InPkts,omitempty"`
-	OutPkts              *PktStat `protobuf:"bytes,2,opt,name=OutPkts,proto3" json:"OutPkts,omitempty"`
-	ErrPkts              *PktStat `protobuf:"bytes,3,opt,name=ErrPkts,proto3" json:"ErrPkts,omitempty"`
-	CarierErrPkts        *PktStat `protobuf:"bytes,4,opt,name=CarierErrPkts,proto3" json:"CarierErrPkts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ZMetricMsg struct {
+	DevID string `protobuf:"bytes,1,opt,name=devID,proto3" json:"devID,omitempty"`
+	// ZmetricTypes ztype = 2;  // deprecated
+	AtTimeStamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=atTimeStamp,proto3" json:"atTimeStamp,omitempty"`
+	// Types that are valid to be assigned to MetricContent:
+	//	*ZMetricMsg_Dm
+	MetricContent        isZMetricMsg_MetricContent `protobuf_oneof:"MetricContent"`
+	Am                   []*AppMetric               `protobuf:"bytes,5,rep,name=am,proto3" json:"am,omitempty"`
+	Sm                   []*ZMetricService          `protobuf:"bytes,6,rep,name=sm,proto3" json:"sm,omitempty"`
+	Nm                   []*ZMetricNetworkInstance  `protobuf:"bytes,7,rep,name=nm,proto3" json:"nm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *ZMetricConn) Reset()         { *m = ZMetricConn{} }
-func (m *ZMetricConn) String() string { return proto.CompactTextString(m) }
-func (*ZMetricConn) ProtoMessage()    {}
-func (*ZMetricConn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{47}
+func (m *ZMetricMsg) Reset()         { *m = ZMetricMsg{} }
+func (m *ZMetricMsg) String() string { return proto.CompactTextString(m) }
+func (*ZMetricMsg) ProtoMessage()    {}
+func (*ZMetricMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{66}
 }
 
-func (m *ZMetricConn) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricConn.Unmarshal(m, b)
+func (m *ZMetricMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricMsg.Unmarshal(m, b)
 }
-func (m *ZMetricConn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricConn.Marshal(b, m, deterministic)
+func (m *ZMetricMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricMsg.Marshal(b, m, deterministic)
 }
-func (m *ZMetricConn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricConn.Merge(m, src)
+func (m *ZMetricMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricMsg.Merge(m, src)
 }
-func (m *ZMetricConn) XXX_Size() int {
-	return xxx_messageInfo_ZMetricConn.Size(m)
+func (m *ZMetricMsg) XXX_Size() int {
+	return xxx_messageInfo_ZMetricMsg.Size(m)
 }
-func (m *ZMetricConn) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricConn.DiscardUnknown(m)
+func (m *ZMetricMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricConn proto.InternalMessageInfo
-
-func (m *ZMetricConn) GetInPkts() *PktStat {
-	if m != nil {
-		return m.InPkts
-	}
-	return nil
-}
+var xxx_messageInfo_ZMetricMsg proto.InternalMessageInfo
 
-func (m *ZMetricConn) GetOutPkts() *PktStat {
+func (m *ZMetricMsg) GetDevID() string {
 	if m != nil {
-		return m.OutPkts
+		return m.DevID
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricConn) GetErrPkts() *PktStat {
+func (m *ZMetricMsg) GetAtTimeStamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.ErrPkts
+		return m.AtTimeStamp
 	}
 	return nil
 }
 
-func (m *ZMetricConn) GetCarierErrPkts() *PktStat {
-	if m != nil {
-		return m.CarierErrPkts
-	}
-	return nil
+type isZMetricMsg_MetricContent interface {
+	isZMetricMsg_MetricContent()
 }
 
-type ZMetricVpn struct {
-	ConnStat             *ZMetricConn `protobuf:"bytes,1,opt,name=ConnStat,proto3" json:"ConnStat,omitempty"`
-	IkeStat              *ZMetricConn `protobuf:"bytes,2,opt,name=IkeStat,proto3" json:"IkeStat,omitempty"`
-	NatTStat             *ZMetricConn `protobuf:"bytes,3,opt,name=NatTStat,proto3" json:"NatTStat,omitempty"`
-	EspStat              *ZMetricConn `protobuf:"bytes,4,opt,name=EspStat,proto3" json:"EspStat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type ZMetricMsg_Dm struct {
+	Dm *DeviceMetric `protobuf:"bytes,4,opt,name=dm,proto3,oneof"`
 }
 
-func (m *ZMetricVpn) Reset()         { *m = ZMetricVpn{} }
-func (m *ZMetricVpn) String() string { return proto.CompactTextString(m) }
-func (*ZMetricVpn) ProtoMessage()    {}
-func (*ZMetricVpn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{48}
-}
+func (*ZMetricMsg_Dm) isZMetricMsg_MetricContent() {}
 
-func (m *ZMetricVpn) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricVpn.Unmarshal(m, b)
-}
-func (m *ZMetricVpn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricVpn.Marshal(b, m, deterministic)
-}
-func (m *ZMetricVpn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricVpn.Merge(m, src)
-}
-func (m *ZMetricVpn) XXX_Size() int {
-	return xxx_messageInfo_ZMetricVpn.Size(m)
-}
-func (m *ZMetricVpn) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricVpn.DiscardUnknown(m)
+func (m *ZMetricMsg) GetMetricContent() isZMetricMsg_MetricContent {
+	if m != nil {
+		return m.MetricContent
+	}
+	return nil
 }
 
-var xxx_messageInfo_ZMetricVpn proto.InternalMessageInfo
-
-func (m *ZMetricVpn) GetConnStat() *ZMetricConn {
-	if m != nil {
-		return m.ConnStat
+func (m *ZMetricMsg) GetDm() *DeviceMetric {
+	if x, ok := m.GetMetricContent().(*ZMetricMsg_Dm); ok {
+		return x.Dm
 	}
 	return nil
 }
 
-func (m *ZMetricVpn) GetIkeStat() *ZMetricConn {
+func (m *ZMetricMsg) GetAm() []*AppMetric {
 	if m != nil {
-		return m.IkeStat
+		return m.Am
 	}
 	return nil
 }
 
-func (m *ZMetricVpn) GetNatTStat() *ZMetricConn {
+func (m *ZMetricMsg) GetSm() []*ZMetricService {
 	if m != nil {
-		return m.NatTStat
+		return m.Sm
 	}
 	return nil
 }
 
-func (m *ZMetricVpn) GetEspStat() *ZMetricConn {
+func (m *ZMetricMsg) GetNm() []*ZMetricNetworkInstance {
 	if m != nil {
-		return m.EspStat
+		return m.Nm
 	}
 	return nil
 }
 
-// For other services with no specific metrics
-type ZMetricNone struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZMetricMsg) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZMetricMsg_Dm)(nil),
+	}
 }
 
-func (m *ZMetricNone) Reset()         { *m = ZMetricNone{} }
-func (m *ZMetricNone) String() string { return proto.CompactTextString(m) }
-func (*ZMetricNone) ProtoMessage()    {}
-func (*ZMetricNone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{49}
+// Metrics which could not be sent when they were collected, oldest first.
+// Each has its own atTimeStamp.
+type ZMetricMsgBatch struct {
+	DevID                string        `protobuf:"bytes,1,opt,name=devID,proto3" json:"devID,omitempty"`
+	Metrics              []*ZMetricMsg `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ZMetricNone) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricNone.Unmarshal(m, b)
+func (m *ZMetricMsgBatch) Reset()         { *m = ZMetricMsgBatch{} }
+func (m *ZMetricMsgBatch) String() string { return proto.CompactTextString(m) }
+func (*ZMetricMsgBatch) ProtoMessage()    {}
+func (*ZMetricMsgBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{67}
 }
-func (m *ZMetricNone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricNone.Marshal(b, m, deterministic)
+
+func (m *ZMetricMsgBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricMsgBatch.Unmarshal(m, b)
 }
-func (m *ZMetricNone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricNone.Merge(m, src)
+func (m *ZMetricMsgBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricMsgBatch.Marshal(b, m, deterministic)
 }
-func (m *ZMetricNone) XXX_Size() int {
-	return xxx_messageInfo_ZMetricNone.Size(m)
+func (m *ZMetricMsgBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricMsgBatch.Merge(m, src)
 }
-func (m *ZMetricNone) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricNone.DiscardUnknown(m)
+func (m *ZMetricMsgBatch) XXX_Size() int {
+	return xxx_messageInfo_ZMetricMsgBatch.Size(m)
+}
+func (m *ZMetricMsgBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricMsgBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricNone proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricMsgBatch proto.InternalMessageInfo
 
-// flow stats
-type ZMetricFlowLink struct {
-	// Types that are valid to be assigned to Link:
-	//	*ZMetricFlowLink_SubNet
-	//	*ZMetricFlowLink_Eid
-	Link                 isZMetricFlowLink_Link `protobuf_oneof:"Link"`
-	SpiId                string                 `protobuf:"bytes,3,opt,name=spiId,proto3" json:"spiId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *ZMetricMsgBatch) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
 }
 
-func (m *ZMetricFlowLink) Reset()         { *m = ZMetricFlowLink{} }
-func (m *ZMetricFlowLink) String() string { return proto.CompactTextString(m) }
-func (*ZMetricFlowLink) ProtoMessage()    {}
-func (*ZMetricFlowLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{50}
+func (m *ZMetricMsgBatch) GetMetrics() []*ZMetricMsg {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
 }
 
-func (m *ZMetricFlowLink) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricFlowLink.Unmarshal(m, b)
-}
-func (m *ZMetricFlowLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricFlowLink.Marshal(b, m, deterministic)
+// DNS query counters for one application on a network instance
+type ZMetricAppDns struct {
+	AppID                string   `protobuf:"bytes,1,opt,name=appID,proto3" json:"appID,omitempty"`
+	AppIP                string   `protobuf:"bytes,2,opt,name=appIP,proto3" json:"appIP,omitempty"`
+	Queries              uint64   `protobuf:"varint,3,opt,name=queries,proto3" json:"queries,omitempty"`
+	Blocked              uint64   `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Failed               uint64   `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ZMetricFlowLink) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricFlowLink.Merge(m, src)
+
+func (m *ZMetricAppDns) Reset()         { *m = ZMetricAppDns{} }
+func (m *ZMetricAppDns) String() string { return proto.CompactTextString(m) }
+func (*ZMetricAppDns) ProtoMessage()    {}
+func (*ZMetricAppDns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{68}
 }
-func (m *ZMetricFlowLink) XXX_Size() int {
-	return xxx_messageInfo_ZMetricFlowLink.Size(m)
+
+func (m *ZMetricAppDns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricAppDns.Unmarshal(m, b)
 }
-func (m *ZMetricFlowLink) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricFlowLink.DiscardUnknown(m)
+func (m *ZMetricAppDns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricAppDns.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_ZMetricFlowLink proto.InternalMessageInfo
-
-type isZMetricFlowLink_Link interface {
-	isZMetricFlowLink_Link()
+func (m *ZMetricAppDns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricAppDns.Merge(m, src)
 }
-
-type ZMetricFlowLink_SubNet struct {
-	SubNet string `protobuf:"bytes,1,opt,name=subNet,proto3,oneof"`
+func (m *ZMetricAppDns) XXX_Size() int {
+	return xxx_messageInfo_ZMetricAppDns.Size(m)
 }
-
-type ZMetricFlowLink_Eid struct {
-	Eid string `protobuf:"bytes,2,opt,name=eid,proto3,oneof"`
+func (m *ZMetricAppDns) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricAppDns.DiscardUnknown(m)
 }
 
-func (*ZMetricFlowLink_SubNet) isZMetricFlowLink_Link() {}
-
-func (*ZMetricFlowLink_Eid) isZMetricFlowLink_Link() {}
+var xxx_messageInfo_ZMetricAppDns proto.InternalMessageInfo
 
-func (m *ZMetricFlowLink) GetLink() isZMetricFlowLink_Link {
+func (m *ZMetricAppDns) GetAppID() string {
 	if m != nil {
-		return m.Link
+		return m.AppID
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricFlowLink) GetSubNet() string {
-	if x, ok := m.GetLink().(*ZMetricFlowLink_SubNet); ok {
-		return x.SubNet
+func (m *ZMetricAppDns) GetAppIP() string {
+	if m != nil {
+		return m.AppIP
 	}
 	return ""
 }
 
-func (m *ZMetricFlowLink) GetEid() string {
-	if x, ok := m.GetLink().(*ZMetricFlowLink_Eid); ok {
-		return x.Eid
+func (m *ZMetricAppDns) GetQueries() uint64 {
+	if m != nil {
+		return m.Queries
 	}
-	return ""
+	return 0
 }
 
-func (m *ZMetricFlowLink) GetSpiId() string {
+func (m *ZMetricAppDns) GetBlocked() uint64 {
 	if m != nil {
-		return m.SpiId
+		return m.Blocked
 	}
-	return ""
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZMetricFlowLink) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZMetricFlowLink_SubNet)(nil),
-		(*ZMetricFlowLink_Eid)(nil),
+func (m *ZMetricAppDns) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
 	}
+	return 0
 }
 
-type ZMetricFlowEndPoint struct {
-	// Types that are valid to be assigned to Endpoint:
-	//	*ZMetricFlowEndPoint_IpAddr
-	//	*ZMetricFlowEndPoint_Rloc
-	Endpoint             isZMetricFlowEndPoint_Endpoint `protobuf_oneof:"Endpoint"`
-	Link                 []*ZMetricFlowLink             `protobuf:"bytes,5,rep,name=link,proto3" json:"link,omitempty"`
-	Stats                *PktStat                       `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+// One DNS query made by an application
+type ZMetricDnsQuery struct {
+	QueryTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=queryTime,proto3" json:"queryTime,omitempty"`
+	AppID                string               `protobuf:"bytes,2,opt,name=appID,proto3" json:"appID,omitempty"`
+	ClientIP             string               `protobuf:"bytes,3,opt,name=clientIP,proto3" json:"clientIP,omitempty"`
+	Name                 string               `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	QType                string               `protobuf:"bytes,5,opt,name=qType,proto3" json:"qType,omitempty"`
+	Answers              []string             `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty"`
+	Blocked              bool                 `protobuf:"varint,7,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ZMetricFlowEndPoint) Reset()         { *m = ZMetricFlowEndPoint{} }
-func (m *ZMetricFlowEndPoint) String() string { return proto.CompactTextString(m) }
-func (*ZMetricFlowEndPoint) ProtoMessage()    {}
-func (*ZMetricFlowEndPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{51}
+func (m *ZMetricDnsQuery) Reset()         { *m = ZMetricDnsQuery{} }
+func (m *ZMetricDnsQuery) String() string { return proto.CompactTextString(m) }
+func (*ZMetricDnsQuery) ProtoMessage()    {}
+func (*ZMetricDnsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{69}
 }
 
-func (m *ZMetricFlowEndPoint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricFlowEndPoint.Unmarshal(m, b)
+func (m *ZMetricDnsQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZMetricDnsQuery.Unmarshal(m, b)
 }
-func (m *ZMetricFlowEndPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricFlowEndPoint.Marshal(b, m, deterministic)
+func (m *ZMetricDnsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZMetricDnsQuery.Marshal(b, m, deterministic)
 }
-func (m *ZMetricFlowEndPoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricFlowEndPoint.Merge(m, src)
+func (m *ZMetricDnsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZMetricDnsQuery.Merge(m, src)
 }
-func (m *ZMetricFlowEndPoint) XXX_Size() int {
-	return xxx_messageInfo_ZMetricFlowEndPoint.Size(m)
+func (m *ZMetricDnsQuery) XXX_Size() int {
+	return xxx_messageInfo_ZMetricDnsQuery.Size(m)
 }
-func (m *ZMetricFlowEndPoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricFlowEndPoint.DiscardUnknown(m)
+func (m *ZMetricDnsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZMetricDnsQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricFlowEndPoint proto.InternalMessageInfo
+var xxx_messageInfo_ZMetricDnsQuery proto.InternalMessageInfo
 
-type isZMetricFlowEndPoint_Endpoint interface {
-	isZMetricFlowEndPoint_Endpoint()
+func (m *ZMetricDnsQuery) GetQueryTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.QueryTime
+	}
+	return nil
 }
 
-type ZMetricFlowEndPoint_IpAddr struct {
-	IpAddr string `protobuf:"bytes,1,opt,name=ipAddr,proto3,oneof"`
+func (m *ZMetricDnsQuery) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
 }
 
-type ZMetricFlowEndPoint_Rloc struct {
-	Rloc string `protobuf:"bytes,2,opt,name=rloc,proto3,oneof"`
+func (m *ZMetricDnsQuery) GetClientIP() string {
+	if m != nil {
+		return m.ClientIP
+	}
+	return ""
 }
 
-func (*ZMetricFlowEndPoint_IpAddr) isZMetricFlowEndPoint_Endpoint() {}
+func (m *ZMetricDnsQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-func (*ZMetricFlowEndPoint_Rloc) isZMetricFlowEndPoint_Endpoint() {}
+func (m *ZMetricDnsQuery) GetQType() string {
+	if m != nil {
+		return m.QType
+	}
+	return ""
+}
 
-func (m *ZMetricFlowEndPoint) GetEndpoint() isZMetricFlowEndPoint_Endpoint {
+func (m *ZMetricDnsQuery) GetAnswers() []string {
 	if m != nil {
-		return m.Endpoint
+		return m.Answers
 	}
 	return nil
 }
 
-func (m *ZMetricFlowEndPoint) GetIpAddr() string {
-	if x, ok := m.GetEndpoint().(*ZMetricFlowEndPoint_IpAddr); ok {
-		return x.IpAddr
+func (m *ZMetricDnsQuery) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
 	}
-	return ""
+	return false
 }
 
-func (m *ZMetricFlowEndPoint) GetRloc() string {
-	if x, ok := m.GetEndpoint().(*ZMetricFlowEndPoint_Rloc); ok {
-		return x.Rloc
+type VlanMetric struct {
+	VlanId               uint32   `protobuf:"varint,1,opt,name=vlanId,proto3" json:"vlanId,omitempty"`
+	TxBytes              uint64   `protobuf:"varint,2,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+	RxBytes              uint64   `protobuf:"varint,3,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	TxPkts               uint64   `protobuf:"varint,4,opt,name=txPkts,proto3" json:"txPkts,omitempty"`
+	RxPkts               uint64   `protobuf:"varint,5,opt,name=rxPkts,proto3" json:"rxPkts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanMetric) Reset()         { *m = VlanMetric{} }
+func (m *VlanMetric) String() string { return proto.CompactTextString(m) }
+func (*VlanMetric) ProtoMessage()    {}
+func (*VlanMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{70}
+}
+
+func (m *VlanMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanMetric.Unmarshal(m, b)
+}
+func (m *VlanMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanMetric.Marshal(b, m, deterministic)
+}
+func (m *VlanMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanMetric.Merge(m, src)
+}
+func (m *VlanMetric) XXX_Size() int {
+	return xxx_messageInfo_VlanMetric.Size(m)
+}
+func (m *VlanMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanMetric proto.InternalMessageInfo
+
+func (m *VlanMetric) GetVlanId() uint32 {
+	if m != nil {
+		return m.VlanId
 	}
-	return ""
+	return 0
 }
 
-func (m *ZMetricFlowEndPoint) GetLink() []*ZMetricFlowLink {
+func (m *VlanMetric) GetTxBytes() uint64 {
 	if m != nil {
-		return m.Link
+		return m.TxBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricFlowEndPoint) GetStats() *PktStat {
+func (m *VlanMetric) GetRxBytes() uint64 {
 	if m != nil {
-		return m.Stats
+		return m.RxBytes
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZMetricFlowEndPoint) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZMetricFlowEndPoint_IpAddr)(nil),
-		(*ZMetricFlowEndPoint_Rloc)(nil),
+func (m *VlanMetric) GetTxPkts() uint64 {
+	if m != nil {
+		return m.TxPkts
 	}
+	return 0
 }
 
-type ZMetricFlow struct {
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type                 uint32                 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	Iid                  uint64                 `protobuf:"varint,4,opt,name=iid,proto3" json:"iid,omitempty"`
-	EstTime              uint64                 `protobuf:"varint,5,opt,name=estTime,proto3" json:"estTime,omitempty"`
-	LEndPoint            *ZMetricFlowEndPoint   `protobuf:"bytes,10,opt,name=lEndPoint,proto3" json:"lEndPoint,omitempty"`
-	REndPoint            []*ZMetricFlowEndPoint `protobuf:"bytes,11,rep,name=rEndPoint,proto3" json:"rEndPoint,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *VlanMetric) GetRxPkts() uint64 {
+	if m != nil {
+		return m.RxPkts
+	}
+	return 0
 }
 
-func (m *ZMetricFlow) Reset()         { *m = ZMetricFlow{} }
-func (m *ZMetricFlow) String() string { return proto.CompactTextString(m) }
-func (*ZMetricFlow) ProtoMessage()    {}
-func (*ZMetricFlow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{52}
+// Health of one of the ports used by a Local network instance
+type ZInfoUplink struct {
+	IfName               string               `protobuf:"bytes,1,opt,name=ifName,proto3" json:"ifName,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Up                   bool                 `protobuf:"varint,3,opt,name=up,proto3" json:"up,omitempty"`
+	Active               bool                 `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Weight               uint32               `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Failures             uint32               `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastChange           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=lastChange,proto3" json:"lastChange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ZMetricFlow) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricFlow.Unmarshal(m, b)
+func (m *ZInfoUplink) Reset()         { *m = ZInfoUplink{} }
+func (m *ZInfoUplink) String() string { return proto.CompactTextString(m) }
+func (*ZInfoUplink) ProtoMessage()    {}
+func (*ZInfoUplink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{71}
 }
-func (m *ZMetricFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricFlow.Marshal(b, m, deterministic)
+
+func (m *ZInfoUplink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoUplink.Unmarshal(m, b)
 }
-func (m *ZMetricFlow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricFlow.Merge(m, src)
+func (m *ZInfoUplink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoUplink.Marshal(b, m, deterministic)
 }
-func (m *ZMetricFlow) XXX_Size() int {
-	return xxx_messageInfo_ZMetricFlow.Size(m)
+func (m *ZInfoUplink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoUplink.Merge(m, src)
 }
-func (m *ZMetricFlow) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricFlow.DiscardUnknown(m)
+func (m *ZInfoUplink) XXX_Size() int {
+	return xxx_messageInfo_ZInfoUplink.Size(m)
+}
+func (m *ZInfoUplink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoUplink.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricFlow proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoUplink proto.InternalMessageInfo
 
-func (m *ZMetricFlow) GetId() string {
+func (m *ZInfoUplink) GetIfName() string {
 	if m != nil {
-		return m.Id
+		return m.IfName
 	}
 	return ""
 }
 
-func (m *ZMetricFlow) GetName() string {
+func (m *ZInfoUplink) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ZMetricFlow) GetType() uint32 {
+func (m *ZInfoUplink) GetUp() bool {
 	if m != nil {
-		return m.Type
+		return m.Up
 	}
-	return 0
+	return false
 }
 
-func (m *ZMetricFlow) GetIid() uint64 {
+func (m *ZInfoUplink) GetActive() bool {
 	if m != nil {
-		return m.Iid
+		return m.Active
 	}
-	return 0
+	return false
 }
 
-func (m *ZMetricFlow) GetEstTime() uint64 {
+func (m *ZInfoUplink) GetWeight() uint32 {
 	if m != nil {
-		return m.EstTime
+		return m.Weight
 	}
 	return 0
 }
 
-func (m *ZMetricFlow) GetLEndPoint() *ZMetricFlowEndPoint {
+func (m *ZInfoUplink) GetFailures() uint32 {
 	if m != nil {
-		return m.LEndPoint
+		return m.Failures
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricFlow) GetREndPoint() []*ZMetricFlowEndPoint {
+func (m *ZInfoUplink) GetLastChange() *timestamp.Timestamp {
 	if m != nil {
-		return m.REndPoint
+		return m.LastChange
 	}
 	return nil
 }
 
-type ZMetricLispGlobal struct {
-	// Encap/ITR Statistics
-	ItrPacketSendError *PktStat `protobuf:"bytes,2,opt,name=ItrPacketSendError,proto3" json:"ItrPacketSendError,omitempty"`
-	InvalidEidError    *PktStat `protobuf:"bytes,3,opt,name=InvalidEidError,proto3" json:"InvalidEidError,omitempty"`
-	// Decap/ETR Statistics
-	NoDecryptKey         *PktStat `protobuf:"bytes,4,opt,name=NoDecryptKey,proto3" json:"NoDecryptKey,omitempty"`
-	OuterHeaderError     *PktStat `protobuf:"bytes,5,opt,name=OuterHeaderError,proto3" json:"OuterHeaderError,omitempty"`
-	BadInnerVersion      *PktStat `protobuf:"bytes,6,opt,name=BadInnerVersion,proto3" json:"BadInnerVersion,omitempty"`
-	GoodPackets          *PktStat `protobuf:"bytes,7,opt,name=GoodPackets,proto3" json:"GoodPackets,omitempty"`
-	ICVError             *PktStat `protobuf:"bytes,8,opt,name=ICVError,proto3" json:"ICVError,omitempty"`
-	LispHeaderError      *PktStat `protobuf:"bytes,9,opt,name=LispHeaderError,proto3" json:"LispHeaderError,omitempty"`
-	CheckSumError        *PktStat `protobuf:"bytes,10,opt,name=CheckSumError,proto3" json:"CheckSumError,omitempty"`
-	DecapReInjectError   *PktStat `protobuf:"bytes,11,opt,name=DecapReInjectError,proto3" json:"DecapReInjectError,omitempty"`
-	DecryptError         *PktStat `protobuf:"bytes,12,opt,name=DecryptError,proto3" json:"DecryptError,omitempty"`
+// Traffic shaping counters for one app interface. Egress is traffic sent
+// by the app and ingress is traffic sent to the app.
+type AppQosMetric struct {
+	IName                string   `protobuf:"bytes,1,opt,name=iName,proto3" json:"iName,omitempty"`
+	LocalName            string   `protobuf:"bytes,2,opt,name=localName,proto3" json:"localName,omitempty"`
+	EgressRate           uint32   `protobuf:"varint,3,opt,name=egressRate,proto3" json:"egressRate,omitempty"`
+	IngressRate          uint32   `protobuf:"varint,4,opt,name=ingressRate,proto3" json:"ingressRate,omitempty"`
+	EgressBytes          uint64   `protobuf:"varint,5,opt,name=egressBytes,proto3" json:"egressBytes,omitempty"`
+	EgressPkts           uint64   `protobuf:"varint,6,opt,name=egressPkts,proto3" json:"egressPkts,omitempty"`
+	EgressDrops          uint64   `protobuf:"varint,7,opt,name=egressDrops,proto3" json:"egressDrops,omitempty"`
+	IngressBytes         uint64   `protobuf:"varint,8,opt,name=ingressBytes,proto3" json:"ingressBytes,omitempty"`
+	IngressPkts          uint64   `protobuf:"varint,9,opt,name=ingressPkts,proto3" json:"ingressPkts,omitempty"`
+	IngressDrops         uint64   `protobuf:"varint,10,opt,name=ingressDrops,proto3" json:"ingressDrops,omitempty"`
+	IngressOverlimits    uint64   `protobuf:"varint,11,opt,name=ingressOverlimits,proto3" json:"ingressOverlimits,omitempty"`
+	IngressBacklogBytes  uint64   `protobuf:"varint,12,opt,name=ingressBacklogBytes,proto3" json:"ingressBacklogBytes,omitempty"`
+	IngressBacklogPkts   uint64   `protobuf:"varint,13,opt,name=ingressBacklogPkts,proto3" json:"ingressBacklogPkts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZMetricLispGlobal) Reset()         { *m = ZMetricLispGlobal{} }
-func (m *ZMetricLispGlobal) String() string { return proto.CompactTextString(m) }
-func (*ZMetricLispGlobal) ProtoMessage()    {}
-func (*ZMetricLispGlobal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{53}
+func (m *AppQosMetric) Reset()         { *m = AppQosMetric{} }
+func (m *AppQosMetric) String() string { return proto.CompactTextString(m) }
+func (*AppQosMetric) ProtoMessage()    {}
+func (*AppQosMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{72}
 }
 
-func (m *ZMetricLispGlobal) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricLispGlobal.Unmarshal(m, b)
+func (m *AppQosMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppQosMetric.Unmarshal(m, b)
 }
-func (m *ZMetricLispGlobal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricLispGlobal.Marshal(b, m, deterministic)
+func (m *AppQosMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppQosMetric.Marshal(b, m, deterministic)
 }
-func (m *ZMetricLispGlobal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricLispGlobal.Merge(m, src)
+func (m *AppQosMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppQosMetric.Merge(m, src)
 }
-func (m *ZMetricLispGlobal) XXX_Size() int {
-	return xxx_messageInfo_ZMetricLispGlobal.Size(m)
+func (m *AppQosMetric) XXX_Size() int {
+	return xxx_messageInfo_AppQosMetric.Size(m)
 }
-func (m *ZMetricLispGlobal) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricLispGlobal.DiscardUnknown(m)
+func (m *AppQosMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppQosMetric.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricLispGlobal proto.InternalMessageInfo
-
-func (m *ZMetricLispGlobal) GetItrPacketSendError() *PktStat {
-	if m != nil {
-		return m.ItrPacketSendError
-	}
-	return nil
-}
+var xxx_messageInfo_AppQosMetric proto.InternalMessageInfo
 
-func (m *ZMetricLispGlobal) GetInvalidEidError() *PktStat {
+func (m *AppQosMetric) GetIName() string {
 	if m != nil {
-		return m.InvalidEidError
+		return m.IName
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricLispGlobal) GetNoDecryptKey() *PktStat {
+func (m *AppQosMetric) GetLocalName() string {
 	if m != nil {
-		return m.NoDecryptKey
+		return m.LocalName
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricLispGlobal) GetOuterHeaderError() *PktStat {
+func (m *AppQosMetric) GetEgressRate() uint32 {
 	if m != nil {
-		return m.OuterHeaderError
+		return m.EgressRate
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetBadInnerVersion() *PktStat {
+func (m *AppQosMetric) GetIngressRate() uint32 {
 	if m != nil {
-		return m.BadInnerVersion
+		return m.IngressRate
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetGoodPackets() *PktStat {
+func (m *AppQosMetric) GetEgressBytes() uint64 {
 	if m != nil {
-		return m.GoodPackets
+		return m.EgressBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetICVError() *PktStat {
+func (m *AppQosMetric) GetEgressPkts() uint64 {
 	if m != nil {
-		return m.ICVError
+		return m.EgressPkts
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetLispHeaderError() *PktStat {
+func (m *AppQosMetric) GetEgressDrops() uint64 {
 	if m != nil {
-		return m.LispHeaderError
+		return m.EgressDrops
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetCheckSumError() *PktStat {
+func (m *AppQosMetric) GetIngressBytes() uint64 {
 	if m != nil {
-		return m.CheckSumError
+		return m.IngressBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetDecapReInjectError() *PktStat {
+func (m *AppQosMetric) GetIngressPkts() uint64 {
 	if m != nil {
-		return m.DecapReInjectError
+		return m.IngressPkts
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricLispGlobal) GetDecryptError() *PktStat {
+func (m *AppQosMetric) GetIngressDrops() uint64 {
 	if m != nil {
-		return m.DecryptError
+		return m.IngressDrops
 	}
-	return nil
-}
-
-// XXX to be deprecated
-type ZMetricService struct {
-	ServiceID   string           `protobuf:"bytes,1,opt,name=serviceID,proto3" json:"serviceID,omitempty"`
-	ServiceName string           `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	ServiceType uint32           `protobuf:"varint,3,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
-	Network     []*NetworkMetric `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
-	// Types that are valid to be assigned to ServiceContent:
-	//	*ZMetricService_Vpnm
-	//	*ZMetricService_Lispm
-	ServiceContent       isZMetricService_ServiceContent `protobuf_oneof:"ServiceContent"`
-	FlowStats            []*ZMetricFlow                  `protobuf:"bytes,10,rep,name=flowStats,proto3" json:"flowStats,omitempty"`
-	LispGlobalStats      *ZMetricLispGlobal              `protobuf:"bytes,11,opt,name=lispGlobalStats,proto3" json:"lispGlobalStats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
-}
-
-func (m *ZMetricService) Reset()         { *m = ZMetricService{} }
-func (m *ZMetricService) String() string { return proto.CompactTextString(m) }
-func (*ZMetricService) ProtoMessage()    {}
-func (*ZMetricService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{54}
-}
-
-func (m *ZMetricService) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricService.Unmarshal(m, b)
-}
-func (m *ZMetricService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricService.Marshal(b, m, deterministic)
-}
-func (m *ZMetricService) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricService.Merge(m, src)
-}
-func (m *ZMetricService) XXX_Size() int {
-	return xxx_messageInfo_ZMetricService.Size(m)
-}
-func (m *ZMetricService) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricService.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_ZMetricService proto.InternalMessageInfo
-
-func (m *ZMetricService) GetServiceID() string {
+func (m *AppQosMetric) GetIngressOverlimits() uint64 {
 	if m != nil {
-		return m.ServiceID
+		return m.IngressOverlimits
 	}
-	return ""
+	return 0
 }
 
-func (m *ZMetricService) GetServiceName() string {
+func (m *AppQosMetric) GetIngressBacklogBytes() uint64 {
 	if m != nil {
-		return m.ServiceName
+		return m.IngressBacklogBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *ZMetricService) GetServiceType() uint32 {
+func (m *AppQosMetric) GetIngressBacklogPkts() uint64 {
 	if m != nil {
-		return m.ServiceType
+		return m.IngressBacklogPkts
 	}
 	return 0
 }
 
-func (m *ZMetricService) GetNetwork() []*NetworkMetric {
-	if m != nil {
-		return m.Network
-	}
-	return nil
+// Association state of a WiFi port as reported by wpa_supplicant
+type ZInfoWifi struct {
+	Ssid                 string   `protobuf:"bytes,1,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	Bssid                string   `protobuf:"bytes,2,opt,name=bssid,proto3" json:"bssid,omitempty"`
+	Associated           bool     `protobuf:"varint,3,opt,name=associated,proto3" json:"associated,omitempty"`
+	WpaState             string   `protobuf:"bytes,4,opt,name=wpaState,proto3" json:"wpaState,omitempty"`
+	SignalDbm            int32    `protobuf:"varint,5,opt,name=signalDbm,proto3" json:"signalDbm,omitempty"`
+	FrequencyMhz         uint32   `protobuf:"varint,6,opt,name=frequencyMhz,proto3" json:"frequencyMhz,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type isZMetricService_ServiceContent interface {
-	isZMetricService_ServiceContent()
+func (m *ZInfoWifi) Reset()         { *m = ZInfoWifi{} }
+func (m *ZInfoWifi) String() string { return proto.CompactTextString(m) }
+func (*ZInfoWifi) ProtoMessage()    {}
+func (*ZInfoWifi) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{73}
 }
 
-type ZMetricService_Vpnm struct {
-	Vpnm *ZMetricVpn `protobuf:"bytes,20,opt,name=vpnm,proto3,oneof"`
+func (m *ZInfoWifi) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoWifi.Unmarshal(m, b)
 }
-
-type ZMetricService_Lispm struct {
-	Lispm *ZMetricLisp `protobuf:"bytes,21,opt,name=lispm,proto3,oneof"`
+func (m *ZInfoWifi) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoWifi.Marshal(b, m, deterministic)
+}
+func (m *ZInfoWifi) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoWifi.Merge(m, src)
+}
+func (m *ZInfoWifi) XXX_Size() int {
+	return xxx_messageInfo_ZInfoWifi.Size(m)
+}
+func (m *ZInfoWifi) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoWifi.DiscardUnknown(m)
 }
 
-func (*ZMetricService_Vpnm) isZMetricService_ServiceContent() {}
-
-func (*ZMetricService_Lispm) isZMetricService_ServiceContent() {}
+var xxx_messageInfo_ZInfoWifi proto.InternalMessageInfo
 
-func (m *ZMetricService) GetServiceContent() isZMetricService_ServiceContent {
+func (m *ZInfoWifi) GetSsid() string {
 	if m != nil {
-		return m.ServiceContent
+		return m.Ssid
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricService) GetVpnm() *ZMetricVpn {
-	if x, ok := m.GetServiceContent().(*ZMetricService_Vpnm); ok {
-		return x.Vpnm
+func (m *ZInfoWifi) GetBssid() string {
+	if m != nil {
+		return m.Bssid
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricService) GetLispm() *ZMetricLisp {
-	if x, ok := m.GetServiceContent().(*ZMetricService_Lispm); ok {
-		return x.Lispm
+func (m *ZInfoWifi) GetAssociated() bool {
+	if m != nil {
+		return m.Associated
 	}
-	return nil
+	return false
 }
 
-func (m *ZMetricService) GetFlowStats() []*ZMetricFlow {
+func (m *ZInfoWifi) GetWpaState() string {
 	if m != nil {
-		return m.FlowStats
+		return m.WpaState
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricService) GetLispGlobalStats() *ZMetricLispGlobal {
+func (m *ZInfoWifi) GetSignalDbm() int32 {
 	if m != nil {
-		return m.LispGlobalStats
+		return m.SignalDbm
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZMetricService) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZMetricService_Vpnm)(nil),
-		(*ZMetricService_Lispm)(nil),
+func (m *ZInfoWifi) GetFrequencyMhz() uint32 {
+	if m != nil {
+		return m.FrequencyMhz
 	}
+	return 0
 }
 
-type NetworkStats struct {
-	TotalPackets         uint64   `protobuf:"varint,1,opt,name=totalPackets,proto3" json:"totalPackets,omitempty"`
-	Errors               uint64   `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"`
-	Drops                uint64   `protobuf:"varint,3,opt,name=drops,proto3" json:"drops,omitempty"`
-	TotalBytes           uint64   `protobuf:"varint,4,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// State of a cellular modem port as reported by the modem.
+// The signal strength is in the cellularMetric
+type ZInfoCellular struct {
+	Connected            bool                `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	Registration         string              `protobuf:"bytes,2,opt,name=registration,proto3" json:"registration,omitempty"`
+	Operator             string              `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Mcc                  uint32              `protobuf:"varint,4,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Mnc                  uint32              `protobuf:"varint,5,opt,name=mnc,proto3" json:"mnc,omitempty"`
+	Roaming              bool                `protobuf:"varint,6,opt,name=roaming,proto3" json:"roaming,omitempty"`
+	Rat                  string              `protobuf:"bytes,7,opt,name=rat,proto3" json:"rat,omitempty"`
+	VisibleNetworks      []*ZCellularNetwork `protobuf:"bytes,8,rep,name=visibleNetworks,proto3" json:"visibleNetworks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *NetworkStats) Reset()         { *m = NetworkStats{} }
-func (m *NetworkStats) String() string { return proto.CompactTextString(m) }
-func (*NetworkStats) ProtoMessage()    {}
-func (*NetworkStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{55}
+func (m *ZInfoCellular) Reset()         { *m = ZInfoCellular{} }
+func (m *ZInfoCellular) String() string { return proto.CompactTextString(m) }
+func (*ZInfoCellular) ProtoMessage()    {}
+func (*ZInfoCellular) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{74}
 }
 
-func (m *NetworkStats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkStats.Unmarshal(m, b)
+func (m *ZInfoCellular) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoCellular.Unmarshal(m, b)
 }
-func (m *NetworkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkStats.Marshal(b, m, deterministic)
+func (m *ZInfoCellular) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoCellular.Marshal(b, m, deterministic)
 }
-func (m *NetworkStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkStats.Merge(m, src)
+func (m *ZInfoCellular) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoCellular.Merge(m, src)
 }
-func (m *NetworkStats) XXX_Size() int {
-	return xxx_messageInfo_NetworkStats.Size(m)
+func (m *ZInfoCellular) XXX_Size() int {
+	return xxx_messageInfo_ZInfoCellular.Size(m)
 }
-func (m *NetworkStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkStats.DiscardUnknown(m)
+func (m *ZInfoCellular) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoCellular.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NetworkStats proto.InternalMessageInfo
+var xxx_messageInfo_ZInfoCellular proto.InternalMessageInfo
 
-func (m *NetworkStats) GetTotalPackets() uint64 {
+func (m *ZInfoCellular) GetConnected() bool {
 	if m != nil {
-		return m.TotalPackets
+		return m.Connected
 	}
-	return 0
+	return false
+}
+
+func (m *ZInfoCellular) GetRegistration() string {
+	if m != nil {
+		return m.Registration
+	}
+	return ""
 }
 
-func (m *NetworkStats) GetErrors() uint64 {
+func (m *ZInfoCellular) GetOperator() string {
 	if m != nil {
-		return m.Errors
+		return m.Operator
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkStats) GetDrops() uint64 {
+func (m *ZInfoCellular) GetMcc() uint32 {
 	if m != nil {
-		return m.Drops
+		return m.Mcc
 	}
 	return 0
 }
 
-func (m *NetworkStats) GetTotalBytes() uint64 {
+func (m *ZInfoCellular) GetMnc() uint32 {
 	if m != nil {
-		return m.TotalBytes
+		return m.Mnc
 	}
 	return 0
 }
 
-type ZMetricNetworkStats struct {
-	Rx                   *NetworkStats `protobuf:"bytes,1,opt,name=rx,proto3" json:"rx,omitempty"`
-	Tx                   *NetworkStats `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *ZMetricNetworkStats) Reset()         { *m = ZMetricNetworkStats{} }
-func (m *ZMetricNetworkStats) String() string { return proto.CompactTextString(m) }
-func (*ZMetricNetworkStats) ProtoMessage()    {}
-func (*ZMetricNetworkStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{56}
-}
-
-func (m *ZMetricNetworkStats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricNetworkStats.Unmarshal(m, b)
-}
-func (m *ZMetricNetworkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricNetworkStats.Marshal(b, m, deterministic)
-}
-func (m *ZMetricNetworkStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricNetworkStats.Merge(m, src)
-}
-func (m *ZMetricNetworkStats) XXX_Size() int {
-	return xxx_messageInfo_ZMetricNetworkStats.Size(m)
-}
-func (m *ZMetricNetworkStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricNetworkStats.DiscardUnknown(m)
+func (m *ZInfoCellular) GetRoaming() bool {
+	if m != nil {
+		return m.Roaming
+	}
+	return false
 }
 
-var xxx_messageInfo_ZMetricNetworkStats proto.InternalMessageInfo
-
-func (m *ZMetricNetworkStats) GetRx() *NetworkStats {
+func (m *ZInfoCellular) GetRat() string {
 	if m != nil {
-		return m.Rx
+		return m.Rat
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricNetworkStats) GetTx() *NetworkStats {
+func (m *ZInfoCellular) GetVisibleNetworks() []*ZCellularNetwork {
 	if m != nil {
-		return m.Tx
+		return m.VisibleNetworks
 	}
 	return nil
 }
 
-type ZMetricNetworkInstance struct {
-	NetworkID      string           `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	NetworkVersion string           `protobuf:"bytes,3,opt,name=networkVersion,proto3" json:"networkVersion,omitempty"`
-	InstType       uint32           `protobuf:"varint,5,opt,name=instType,proto3" json:"instType,omitempty"`
-	Displayname    string           `protobuf:"bytes,6,opt,name=displayname,proto3" json:"displayname,omitempty"`
-	Activated      bool             `protobuf:"varint,7,opt,name=activated,proto3" json:"activated,omitempty"`
-	Network        []*NetworkMetric `protobuf:"bytes,10,rep,name=network,proto3" json:"network,omitempty"`
-	// Types that are valid to be assigned to InstanceContent:
-	//	*ZMetricNetworkInstance_Vpnm
-	//	*ZMetricNetworkInstance_Lispm
-	//	*ZMetricNetworkInstance_Nonem
-	InstanceContent      isZMetricNetworkInstance_InstanceContent `protobuf_oneof:"InstanceContent"`
-	FlowStats            []*ZMetricFlow                           `protobuf:"bytes,30,rep,name=flowStats,proto3" json:"flowStats,omitempty"`
-	LispGlobalStats      *ZMetricLispGlobal                       `protobuf:"bytes,31,opt,name=lispGlobalStats,proto3" json:"lispGlobalStats,omitempty"`
-	NetworkStats         *ZMetricNetworkStats                     `protobuf:"bytes,40,opt,name=networkStats,proto3" json:"networkStats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
-	XXX_unrecognized     []byte                                   `json:"-"`
-	XXX_sizecache        int32                                    `json:"-"`
+type ZCellularNetwork struct {
+	Mcc                  uint32   `protobuf:"varint,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Mnc                  uint32   `protobuf:"varint,2,opt,name=mnc,proto3" json:"mnc,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status               []string `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ZMetricNetworkInstance) Reset()         { *m = ZMetricNetworkInstance{} }
-func (m *ZMetricNetworkInstance) String() string { return proto.CompactTextString(m) }
-func (*ZMetricNetworkInstance) ProtoMessage()    {}
-func (*ZMetricNetworkInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{57}
+func (m *ZCellularNetwork) Reset()         { *m = ZCellularNetwork{} }
+func (m *ZCellularNetwork) String() string { return proto.CompactTextString(m) }
+func (*ZCellularNetwork) ProtoMessage()    {}
+func (*ZCellularNetwork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{75}
 }
 
-func (m *ZMetricNetworkInstance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricNetworkInstance.Unmarshal(m, b)
+func (m *ZCellularNetwork) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZCellularNetwork.Unmarshal(m, b)
 }
-func (m *ZMetricNetworkInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricNetworkInstance.Marshal(b, m, deterministic)
+func (m *ZCellularNetwork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZCellularNetwork.Marshal(b, m, deterministic)
 }
-func (m *ZMetricNetworkInstance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricNetworkInstance.Merge(m, src)
+func (m *ZCellularNetwork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZCellularNetwork.Merge(m, src)
 }
-func (m *ZMetricNetworkInstance) XXX_Size() int {
-	return xxx_messageInfo_ZMetricNetworkInstance.Size(m)
+func (m *ZCellularNetwork) XXX_Size() int {
+	return xxx_messageInfo_ZCellularNetwork.Size(m)
 }
-func (m *ZMetricNetworkInstance) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricNetworkInstance.DiscardUnknown(m)
+func (m *ZCellularNetwork) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZCellularNetwork.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricNetworkInstance proto.InternalMessageInfo
-
-func (m *ZMetricNetworkInstance) GetNetworkID() string {
-	if m != nil {
-		return m.NetworkID
-	}
-	return ""
-}
+var xxx_messageInfo_ZCellularNetwork proto.InternalMessageInfo
 
-func (m *ZMetricNetworkInstance) GetNetworkVersion() string {
+func (m *ZCellularNetwork) GetMcc() uint32 {
 	if m != nil {
-		return m.NetworkVersion
+		return m.Mcc
 	}
-	return ""
+	return 0
 }
 
-func (m *ZMetricNetworkInstance) GetInstType() uint32 {
+func (m *ZCellularNetwork) GetMnc() uint32 {
 	if m != nil {
-		return m.InstType
+		return m.Mnc
 	}
 	return 0
 }
 
-func (m *ZMetricNetworkInstance) GetDisplayname() string {
+func (m *ZCellularNetwork) GetDescription() string {
 	if m != nil {
-		return m.Displayname
+		return m.Description
 	}
 	return ""
 }
 
-func (m *ZMetricNetworkInstance) GetActivated() bool {
-	if m != nil {
-		return m.Activated
-	}
-	return false
-}
-
-func (m *ZMetricNetworkInstance) GetNetwork() []*NetworkMetric {
+func (m *ZCellularNetwork) GetStatus() []string {
 	if m != nil {
-		return m.Network
+		return m.Status
 	}
 	return nil
 }
 
-type isZMetricNetworkInstance_InstanceContent interface {
-	isZMetricNetworkInstance_InstanceContent()
+// Signal of a cellular modem port. Which fields are set depends on
+// the radio access technology
+type CellularMetric struct {
+	IName                string   `protobuf:"bytes,1,opt,name=iName,proto3" json:"iName,omitempty"`
+	IfName               string   `protobuf:"bytes,2,opt,name=ifName,proto3" json:"ifName,omitempty"`
+	Rat                  string   `protobuf:"bytes,3,opt,name=rat,proto3" json:"rat,omitempty"`
+	Rssi                 int32    `protobuf:"varint,4,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	Rsrq                 int32    `protobuf:"varint,5,opt,name=rsrq,proto3" json:"rsrq,omitempty"`
+	Rsrp                 int32    `protobuf:"varint,6,opt,name=rsrp,proto3" json:"rsrp,omitempty"`
+	Snr                  float32  `protobuf:"fixed32,7,opt,name=snr,proto3" json:"snr,omitempty"`
+	Ecio                 float32  `protobuf:"fixed32,8,opt,name=ecio,proto3" json:"ecio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type ZMetricNetworkInstance_Vpnm struct {
-	Vpnm *ZMetricVpn `protobuf:"bytes,20,opt,name=vpnm,proto3,oneof"`
+func (m *CellularMetric) Reset()         { *m = CellularMetric{} }
+func (m *CellularMetric) String() string { return proto.CompactTextString(m) }
+func (*CellularMetric) ProtoMessage()    {}
+func (*CellularMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{76}
 }
 
-type ZMetricNetworkInstance_Lispm struct {
-	Lispm *ZMetricLisp `protobuf:"bytes,21,opt,name=lispm,proto3,oneof"`
+func (m *CellularMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CellularMetric.Unmarshal(m, b)
 }
-
-type ZMetricNetworkInstance_Nonem struct {
-	Nonem *ZMetricNone `protobuf:"bytes,22,opt,name=nonem,proto3,oneof"`
+func (m *CellularMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CellularMetric.Marshal(b, m, deterministic)
+}
+func (m *CellularMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellularMetric.Merge(m, src)
+}
+func (m *CellularMetric) XXX_Size() int {
+	return xxx_messageInfo_CellularMetric.Size(m)
+}
+func (m *CellularMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellularMetric.DiscardUnknown(m)
 }
 
-func (*ZMetricNetworkInstance_Vpnm) isZMetricNetworkInstance_InstanceContent() {}
-
-func (*ZMetricNetworkInstance_Lispm) isZMetricNetworkInstance_InstanceContent() {}
-
-func (*ZMetricNetworkInstance_Nonem) isZMetricNetworkInstance_InstanceContent() {}
+var xxx_messageInfo_CellularMetric proto.InternalMessageInfo
 
-func (m *ZMetricNetworkInstance) GetInstanceContent() isZMetricNetworkInstance_InstanceContent {
+func (m *CellularMetric) GetIName() string {
 	if m != nil {
-		return m.InstanceContent
+		return m.IName
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricNetworkInstance) GetVpnm() *ZMetricVpn {
-	if x, ok := m.GetInstanceContent().(*ZMetricNetworkInstance_Vpnm); ok {
-		return x.Vpnm
+func (m *CellularMetric) GetIfName() string {
+	if m != nil {
+		return m.IfName
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricNetworkInstance) GetLispm() *ZMetricLisp {
-	if x, ok := m.GetInstanceContent().(*ZMetricNetworkInstance_Lispm); ok {
-		return x.Lispm
+func (m *CellularMetric) GetRat() string {
+	if m != nil {
+		return m.Rat
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricNetworkInstance) GetNonem() *ZMetricNone {
-	if x, ok := m.GetInstanceContent().(*ZMetricNetworkInstance_Nonem); ok {
-		return x.Nonem
+func (m *CellularMetric) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricNetworkInstance) GetFlowStats() []*ZMetricFlow {
+func (m *CellularMetric) GetRsrq() int32 {
 	if m != nil {
-		return m.FlowStats
+		return m.Rsrq
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricNetworkInstance) GetLispGlobalStats() *ZMetricLispGlobal {
+func (m *CellularMetric) GetRsrp() int32 {
 	if m != nil {
-		return m.LispGlobalStats
+		return m.Rsrp
 	}
-	return nil
+	return 0
 }
 
-func (m *ZMetricNetworkInstance) GetNetworkStats() *ZMetricNetworkStats {
+func (m *CellularMetric) GetSnr() float32 {
 	if m != nil {
-		return m.NetworkStats
+		return m.Snr
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZMetricNetworkInstance) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZMetricNetworkInstance_Vpnm)(nil),
-		(*ZMetricNetworkInstance_Lispm)(nil),
-		(*ZMetricNetworkInstance_Nonem)(nil),
+func (m *CellularMetric) GetEcio() float32 {
+	if m != nil {
+		return m.Ecio
 	}
+	return 0
 }
 
-type ZMetricMsg struct {
-	DevID string `protobuf:"bytes,1,opt,name=devID,proto3" json:"devID,omitempty"`
-	// ZmetricTypes ztype = 2;  // deprecated
-	AtTimeStamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=atTimeStamp,proto3" json:"atTimeStamp,omitempty"`
-	// Types that are valid to be assigned to MetricContent:
-	//	*ZMetricMsg_Dm
-	MetricContent        isZMetricMsg_MetricContent `protobuf_oneof:"MetricContent"`
-	Am                   []*AppMetric               `protobuf:"bytes,5,rep,name=am,proto3" json:"am,omitempty"`
-	Sm                   []*ZMetricService          `protobuf:"bytes,6,rep,name=sm,proto3" json:"sm,omitempty"`
-	Nm                   []*ZMetricNetworkInstance  `protobuf:"bytes,7,rep,name=nm,proto3" json:"nm,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+type ZConnectivityStageResult struct {
+	Stage                ZConnectivityStage `protobuf:"varint,1,opt,name=stage,proto3,enum=ZConnectivityStage" json:"stage,omitempty"`
+	DurationMs           uint32             `protobuf:"varint,2,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	ErrorCode            ZConnectivityError `protobuf:"varint,3,opt,name=errorCode,proto3,enum=ZConnectivityError" json:"errorCode,omitempty"`
+	Error                string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *ZMetricMsg) Reset()         { *m = ZMetricMsg{} }
-func (m *ZMetricMsg) String() string { return proto.CompactTextString(m) }
-func (*ZMetricMsg) ProtoMessage()    {}
-func (*ZMetricMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd6f5fc136c65f52, []int{58}
+func (m *ZConnectivityStageResult) Reset()         { *m = ZConnectivityStageResult{} }
+func (m *ZConnectivityStageResult) String() string { return proto.CompactTextString(m) }
+func (*ZConnectivityStageResult) ProtoMessage()    {}
+func (*ZConnectivityStageResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{77}
 }
 
-func (m *ZMetricMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ZMetricMsg.Unmarshal(m, b)
+func (m *ZConnectivityStageResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZConnectivityStageResult.Unmarshal(m, b)
 }
-func (m *ZMetricMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ZMetricMsg.Marshal(b, m, deterministic)
+func (m *ZConnectivityStageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZConnectivityStageResult.Marshal(b, m, deterministic)
 }
-func (m *ZMetricMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ZMetricMsg.Merge(m, src)
+func (m *ZConnectivityStageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZConnectivityStageResult.Merge(m, src)
 }
-func (m *ZMetricMsg) XXX_Size() int {
-	return xxx_messageInfo_ZMetricMsg.Size(m)
+func (m *ZConnectivityStageResult) XXX_Size() int {
+	return xxx_messageInfo_ZConnectivityStageResult.Size(m)
 }
-func (m *ZMetricMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ZMetricMsg.DiscardUnknown(m)
+func (m *ZConnectivityStageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZConnectivityStageResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ZMetricMsg proto.InternalMessageInfo
+var xxx_messageInfo_ZConnectivityStageResult proto.InternalMessageInfo
 
-func (m *ZMetricMsg) GetDevID() string {
+func (m *ZConnectivityStageResult) GetStage() ZConnectivityStage {
 	if m != nil {
-		return m.DevID
+		return m.Stage
 	}
-	return ""
+	return ZConnectivityStage_ZcStageUnknown
 }
 
-func (m *ZMetricMsg) GetAtTimeStamp() *timestamp.Timestamp {
+func (m *ZConnectivityStageResult) GetDurationMs() uint32 {
 	if m != nil {
-		return m.AtTimeStamp
+		return m.DurationMs
 	}
-	return nil
+	return 0
 }
 
-type isZMetricMsg_MetricContent interface {
-	isZMetricMsg_MetricContent()
+func (m *ZConnectivityStageResult) GetErrorCode() ZConnectivityError {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ZConnectivityError_ZcErrNone
 }
 
-type ZMetricMsg_Dm struct {
-	Dm *DeviceMetric `protobuf:"bytes,4,opt,name=dm,proto3,oneof"`
+func (m *ZConnectivityStageResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (*ZMetricMsg_Dm) isZMetricMsg_MetricContent() {}
+// The stages which were run for a port; the test stops at the first
+// stage which fails
+type ZPortTestResult struct {
+	Ifname               string                      `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	TestTime             *timestamp.Timestamp        `protobuf:"bytes,2,opt,name=testTime,proto3" json:"testTime,omitempty"`
+	Stages               []*ZConnectivityStageResult `protobuf:"bytes,3,rep,name=stages,proto3" json:"stages,omitempty"`
+	Succeeded            bool                        `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
 
-func (m *ZMetricMsg) GetMetricContent() isZMetricMsg_MetricContent {
-	if m != nil {
-		return m.MetricContent
-	}
-	return nil
+func (m *ZPortTestResult) Reset()         { *m = ZPortTestResult{} }
+func (m *ZPortTestResult) String() string { return proto.CompactTextString(m) }
+func (*ZPortTestResult) ProtoMessage()    {}
+func (*ZPortTestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6f5fc136c65f52, []int{78}
 }
 
-func (m *ZMetricMsg) GetDm() *DeviceMetric {
-	if x, ok := m.GetMetricContent().(*ZMetricMsg_Dm); ok {
-		return x.Dm
-	}
-	return nil
+func (m *ZPortTestResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZPortTestResult.Unmarshal(m, b)
+}
+func (m *ZPortTestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZPortTestResult.Marshal(b, m, deterministic)
+}
+func (m *ZPortTestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZPortTestResult.Merge(m, src)
+}
+func (m *ZPortTestResult) XXX_Size() int {
+	return xxx_messageInfo_ZPortTestResult.Size(m)
+}
+func (m *ZPortTestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZPortTestResult.DiscardUnknown(m)
 }
 
-func (m *ZMetricMsg) GetAm() []*AppMetric {
+var xxx_messageInfo_ZPortTestResult proto.InternalMessageInfo
+
+func (m *ZPortTestResult) GetIfname() string {
 	if m != nil {
-		return m.Am
+		return m.Ifname
 	}
-	return nil
+	return ""
 }
 
-func (m *ZMetricMsg) GetSm() []*ZMetricService {
+func (m *ZPortTestResult) GetTestTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Sm
+		return m.TestTime
 	}
 	return nil
 }
 
-func (m *ZMetricMsg) GetNm() []*ZMetricNetworkInstance {
+func (m *ZPortTestResult) GetStages() []*ZConnectivityStageResult {
 	if m != nil {
-		return m.Nm
+		return m.Stages
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ZMetricMsg) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ZMetricMsg_Dm)(nil),
+func (m *ZPortTestResult) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
 	}
+	return false
 }
 
 func init() {
+	proto.RegisterEnum("DataSecAtRestState", DataSecAtRestState_name, DataSecAtRestState_value)
+	proto.RegisterEnum("ZAppHealthState", ZAppHealthState_name, ZAppHealthState_value)
 	proto.RegisterEnum("ZInfoTypes", ZInfoTypes_name, ZInfoTypes_value)
 	proto.RegisterEnum("ZPeripheralTypes", ZPeripheralTypes_name, ZPeripheralTypes_value)
 	proto.RegisterEnum("ZSwState", ZSwState_name, ZSwState_value)
@@ -5611,6 +7627,8 @@ func init() {
 	proto.RegisterEnum("ZioType", ZioType_name, ZioType_value)
 	proto.RegisterEnum("ZmetricTypes", ZmetricTypes_name, ZmetricTypes_value)
 	proto.RegisterEnum("MetricItemType", MetricItemType_name, MetricItemType_value)
+	proto.RegisterEnum("ZConnectivityStage", ZConnectivityStage_name, ZConnectivityStage_value)
+	proto.RegisterEnum("ZConnectivityError", ZConnectivityError_name, ZConnectivityError_value)
 	proto.RegisterType((*ZInfoManufacturer)(nil), "ZInfoManufacturer")
 	proto.RegisterType((*ZInfoNetwork)(nil), "ZInfoNetwork")
 	proto.RegisterType((*GeoLoc)(nil), "GeoLoc")
@@ -5619,6 +7637,10 @@ func init() {
 	proto.RegisterType((*ZInfoSW)(nil), "ZInfoSW")
 	proto.RegisterType((*ErrorInfo)(nil), "ErrorInfo")
 	proto.RegisterType((*ZInfoDevice)(nil), "ZInfoDevice")
+	proto.RegisterType((*ZInfoCapacity)(nil), "ZInfoCapacity")
+	proto.RegisterType((*ZInfoAppAllocation)(nil), "ZInfoAppAllocation")
+	proto.RegisterType((*ZInfoDeviceCert)(nil), "ZInfoDeviceCert")
+	proto.RegisterType((*DataSecAtRest)(nil), "DataSecAtRest")
 	proto.RegisterType((*SystemAdapterInfo)(nil), "SystemAdapterInfo")
 	proto.RegisterType((*DevicePortStatus)(nil), "DevicePortStatus")
 	proto.RegisterType((*DevicePort)(nil), "DevicePort")
@@ -5627,6 +7649,7 @@ func init() {
 	proto.RegisterType((*ZInfoDevSW)(nil), "ZInfoDevSW")
 	proto.RegisterType((*ZInfoStorage)(nil), "ZInfoStorage")
 	proto.RegisterType((*ZInfoApp)(nil), "ZInfoApp")
+	proto.RegisterType((*ZInfoAppHealth)(nil), "ZInfoAppHealth")
 	proto.RegisterType((*ZInfoVpnLinkInfo)(nil), "ZInfoVpnLinkInfo")
 	proto.RegisterType((*ZInfoVpnLink)(nil), "ZInfoVpnLink")
 	proto.RegisterType((*ZInfoVpnEndPoint)(nil), "ZInfoVpnEndPoint")
@@ -5644,16 +7667,19 @@ func init() {
 	proto.RegisterType((*ZmetVifInfo)(nil), "ZmetVifInfo")
 	proto.RegisterType((*ZInfoMsg)(nil), "ZInfoMsg")
 	proto.RegisterType((*ZioBundle)(nil), "ZioBundle")
+	proto.RegisterType((*ZioUsbDevice)(nil), "ZioUsbDevice")
 	proto.RegisterType((*MemoryMetric)(nil), "memoryMetric")
 	proto.RegisterType((*NetworkMetric)(nil), "networkMetric")
 	proto.RegisterType((*ZedcloudMetric)(nil), "zedcloudMetric")
 	proto.RegisterType((*UrlcloudMetric)(nil), "urlcloudMetric")
 	proto.RegisterType((*AppCpuMetric)(nil), "appCpuMetric")
 	proto.RegisterType((*DeviceMetric)(nil), "deviceMetric")
+	proto.RegisterType((*MetricAggregate)(nil), "metricAggregate")
 	proto.RegisterType((*MetricItem)(nil), "MetricItem")
 	proto.RegisterType((*DiskMetric)(nil), "diskMetric")
 	proto.RegisterType((*AppDiskMetric)(nil), "appDiskMetric")
 	proto.RegisterType((*AppMetric)(nil), "appMetric")
+	proto.RegisterType((*AppBalloonMetric)(nil), "appBalloonMetric")
 	proto.RegisterType((*PktStat)(nil), "PktStat")
 	proto.RegisterType((*RlocStats)(nil), "RlocStats")
 	proto.RegisterType((*EidStats)(nil), "EidStats")
@@ -5670,332 +7696,473 @@ func init() {
 	proto.RegisterType((*ZMetricNetworkStats)(nil), "ZMetricNetworkStats")
 	proto.RegisterType((*ZMetricNetworkInstance)(nil), "ZMetricNetworkInstance")
 	proto.RegisterType((*ZMetricMsg)(nil), "ZMetricMsg")
+	proto.RegisterType((*ZMetricMsgBatch)(nil), "ZMetricMsgBatch")
+	proto.RegisterType((*ZMetricAppDns)(nil), "ZMetricAppDns")
+	proto.RegisterType((*ZMetricDnsQuery)(nil), "ZMetricDnsQuery")
+	proto.RegisterType((*VlanMetric)(nil), "vlanMetric")
+	proto.RegisterType((*ZInfoUplink)(nil), "ZInfoUplink")
+	proto.RegisterType((*AppQosMetric)(nil), "appQosMetric")
+	proto.RegisterType((*ZInfoWifi)(nil), "ZInfoWifi")
+	proto.RegisterType((*ZInfoCellular)(nil), "ZInfoCellular")
+	proto.RegisterType((*ZCellularNetwork)(nil), "ZCellularNetwork")
+	proto.RegisterType((*CellularMetric)(nil), "cellularMetric")
+	proto.RegisterType((*ZConnectivityStageResult)(nil), "ZConnectivityStageResult")
+	proto.RegisterType((*ZPortTestResult)(nil), "ZPortTestResult")
 }
 
 func init() { proto.RegisterFile("zmet.proto", fileDescriptor_dd6f5fc136c65f52) }
 
 var fileDescriptor_dd6f5fc136c65f52 = []byte{
-	// 5141 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x24, 0x59,
-	0x52, 0xae, 0x9f, 0x5d, 0x15, 0xe5, 0xb2, 0xcb, 0xaf, 0xdd, 0xbd, 0xb5, 0xcd, 0x30, 0xdd, 0x9d,
-	0xd3, 0x3b, 0xd3, 0x78, 0x76, 0xab, 0x57, 0x3d, 0xa3, 0xd1, 0x30, 0x1a, 0x10, 0xb6, 0xab, 0xa6,
-	0x5d, 0x1a, 0xbb, 0x6c, 0x65, 0x75, 0x7b, 0x58, 0x4b, 0xcb, 0x2a, 0x5d, 0xf9, 0x5c, 0x4e, 0x5c,
-	0xf9, 0x21, 0xf3, 0x95, 0x3f, 0x73, 0x42, 0xab, 0x39, 0xb1, 0x07, 0x24, 0x90, 0xd8, 0x33, 0x27,
-	0x38, 0x72, 0x5b, 0x2e, 0x70, 0xe4, 0xc4, 0x15, 0x24, 0x24, 0xb4, 0x12, 0x1c, 0xe0, 0xcc, 0x05,
-	0xed, 0x01, 0x01, 0x8a, 0x78, 0xef, 0x65, 0xbe, 0x4c, 0x97, 0xed, 0x6e, 0x21, 0xad, 0x84, 0xb4,
-	0xb7, 0x8c, 0xcf, 0xfb, 0x45, 0xc4, 0x8b, 0x88, 0x17, 0x51, 0x05, 0xf0, 0xb5, 0xcf, 0x45, 0x37,
-	0x8a, 0x43, 0x11, 0x3e, 0x7c, 0x34, 0x09, 0xc3, 0xc9, 0x94, 0x3f, 0x27, 0xe8, 0x78, 0x76, 0xf2,
-	0x5c, 0x78, 0x3e, 0x4f, 0x84, 0xe3, 0x47, 0x92, 0xc1, 0xfa, 0x59, 0x19, 0xd6, 0x8e, 0x06, 0xc1,
-	0x49, 0xb8, 0xe7, 0x04, 0xb3, 0x13, 0x67, 0x2c, 0x66, 0x31, 0x8f, 0x99, 0x05, 0xcb, 0xbe, 0x01,
-	0x77, 0x4a, 0x8f, 0x4b, 0xcf, 0x1a, 0x76, 0x0e, 0xc7, 0x1e, 0x43, 0x33, 0x8a, 0x43, 0x77, 0x36,
-	0x16, 0x43, 0xc7, 0xe7, 0x9d, 0x32, 0xb1, 0x98, 0x28, 0xd6, 0x81, 0xa5, 0x73, 0x1e, 0x27, 0x5e,
-	0x18, 0x74, 0x2a, 0x44, 0xd5, 0x20, 0xce, 0x9f, 0xf0, 0xd8, 0x73, 0xa6, 0xc3, 0x99, 0x7f, 0xcc,
-	0xe3, 0x4e, 0x55, 0xce, 0x6f, 0xe2, 0x18, 0x83, 0xea, 0xeb, 0xd7, 0x83, 0x5e, 0xa7, 0x46, 0x34,
-	0xfa, 0x66, 0xef, 0x02, 0x8c, 0x43, 0x3f, 0x72, 0x84, 0x77, 0x3c, 0xe5, 0x9d, 0x45, 0xa2, 0x18,
-	0x18, 0xa4, 0x1f, 0x7b, 0x61, 0x72, 0xc8, 0x03, 0x37, 0x8c, 0x3b, 0x4b, 0x92, 0x9e, 0x61, 0x70,
-	0xcf, 0x12, 0x92, 0xbb, 0xaa, 0xcb, 0x3d, 0x1b, 0x28, 0xf6, 0x0c, 0x56, 0x11, 0xb4, 0xf9, 0x94,
-	0x3b, 0x09, 0xef, 0x39, 0x82, 0x77, 0x1a, 0xc4, 0x55, 0x44, 0x5b, 0xff, 0x5c, 0x86, 0x65, 0x92,
-	0xdc, 0x90, 0x8b, 0x8b, 0x30, 0x3e, 0xc3, 0xe3, 0xfa, 0xce, 0x78, 0xd3, 0x75, 0x63, 0x7d, 0x5c,
-	0x05, 0x22, 0xc5, 0xe5, 0xe7, 0x24, 0x26, 0x79, 0x52, 0x0d, 0x22, 0x65, 0x70, 0x80, 0x3c, 0x49,
-	0xa7, 0xf6, 0xb8, 0x82, 0x14, 0x05, 0xb2, 0xf7, 0x61, 0xc5, 0xe5, 0x27, 0xce, 0x6c, 0x2a, 0xec,
-	0x70, 0x26, 0x78, 0x9c, 0x74, 0x16, 0x89, 0xa1, 0x80, 0x65, 0xbf, 0x06, 0x15, 0x37, 0x48, 0xe8,
-	0xac, 0xcd, 0x17, 0x8d, 0x2e, 0xed, 0xa8, 0x37, 0x1c, 0xd9, 0x88, 0x65, 0x2b, 0x50, 0x9e, 0x45,
-	0x74, 0xcc, 0xba, 0x5d, 0x9e, 0x45, 0xec, 0x3d, 0xa8, 0x4f, 0xc3, 0xb1, 0x23, 0xf0, 0xf0, 0x0d,
-	0x1a, 0xb1, 0xd4, 0x7d, 0xc9, 0xc3, 0xdd, 0x70, 0x6c, 0xa7, 0x04, 0xf6, 0x00, 0x16, 0x67, 0xd1,
-	0xd4, 0x0b, 0xce, 0x3a, 0x40, 0x03, 0x15, 0xc4, 0x36, 0x00, 0x02, 0x79, 0xd4, 0x7e, 0x1c, 0x77,
-	0x9a, 0x34, 0x1c, 0xba, 0xfd, 0x38, 0x0e, 0x63, 0x5c, 0xd4, 0x36, 0xa8, 0xec, 0x1d, 0x68, 0xe0,
-	0x7c, 0x53, 0x3a, 0xf3, 0x32, 0x9d, 0x39, 0x43, 0x30, 0x0b, 0x6a, 0x51, 0x1c, 0x5e, 0x5e, 0x75,
-	0x5a, 0x34, 0xc9, 0x72, 0xf7, 0x00, 0xa1, 0x91, 0x70, 0xc4, 0x2c, 0xb1, 0x25, 0xc9, 0xfa, 0xbb,
-	0x12, 0x2c, 0xca, 0xad, 0xa1, 0x56, 0x5f, 0x07, 0x2e, 0x8f, 0xa7, 0xce, 0xd5, 0xe0, 0x40, 0xd9,
-	0xa2, 0x81, 0x61, 0x0f, 0xa1, 0xbe, 0x13, 0x26, 0x22, 0xc8, 0xcc, 0x30, 0x85, 0xd1, 0x8a, 0xb6,
-	0x3d, 0x71, 0xa5, 0x34, 0x42, 0xdf, 0x78, 0x40, 0x9b, 0x4f, 0x50, 0x06, 0x52, 0x1b, 0x0a, 0x42,
-	0x65, 0x6c, 0x87, 0xb3, 0x40, 0xc4, 0x57, 0xca, 0xe8, 0x34, 0xc8, 0xda, 0x50, 0xd9, 0x0d, 0xc7,
-	0xca, 0xe0, 0xf0, 0x13, 0x31, 0xfb, 0xf1, 0x44, 0x99, 0x18, 0x7e, 0xe2, 0xac, 0x07, 0x61, 0x22,
-	0x9c, 0xa9, 0x32, 0x2b, 0x05, 0x59, 0x27, 0x50, 0xd7, 0x4a, 0xc1, 0x93, 0xf4, 0x86, 0xa3, 0x84,
-	0xc7, 0x78, 0x11, 0x3a, 0x25, 0x52, 0xa8, 0x81, 0x41, 0xb1, 0xf5, 0x86, 0x23, 0x37, 0xf4, 0x1d,
-	0x2f, 0x50, 0x47, 0xc9, 0x10, 0x8a, 0x9a, 0x70, 0x27, 0x1e, 0x9f, 0x76, 0x2a, 0x34, 0x38, 0x43,
-	0x58, 0x3f, 0x2e, 0xc1, 0xea, 0x91, 0x17, 0x9c, 0x84, 0x07, 0x3c, 0xf6, 0xa2, 0x53, 0x1e, 0x3b,
-	0x53, 0xf6, 0x01, 0xd4, 0xbe, 0x16, 0x57, 0x11, 0x27, 0xa1, 0xad, 0xbc, 0x58, 0xeb, 0x1e, 0x65,
-	0xc4, 0x57, 0x57, 0x11, 0x4f, 0x6c, 0x49, 0xc7, 0xa9, 0xa3, 0xe9, 0x6c, 0x32, 0x71, 0xf0, 0x5e,
-	0x95, 0x49, 0xed, 0x19, 0x82, 0x3d, 0x83, 0x9a, 0x8f, 0x33, 0x93, 0x14, 0x9b, 0x2f, 0x58, 0xf7,
-	0x9a, 0xc7, 0xb0, 0x25, 0x83, 0xf5, 0x8f, 0x25, 0x58, 0x22, 0xe2, 0xe8, 0x2b, 0x9c, 0x33, 0xb9,
-	0xd0, 0x57, 0x4d, 0x1d, 0x26, 0x45, 0xa0, 0xb8, 0x92, 0x8b, 0x1d, 0x27, 0x39, 0x55, 0xaa, 0x51,
-	0x10, 0x7b, 0x04, 0xb5, 0x44, 0xe0, 0xb5, 0xab, 0xd2, 0x96, 0x1b, 0xdd, 0xa3, 0xd1, 0x05, 0x5a,
-	0x06, 0xb7, 0x25, 0x1e, 0x07, 0x0a, 0x27, 0x9e, 0x70, 0xa1, 0xd4, 0xa1, 0x20, 0xd4, 0xf4, 0xb9,
-	0xcb, 0xcf, 0x95, 0x4a, 0xe8, 0x9b, 0x6d, 0x40, 0xdb, 0x0d, 0x2f, 0x82, 0x69, 0xe8, 0xb8, 0x07,
-	0x71, 0x38, 0x89, 0x79, 0x92, 0x90, 0x76, 0x5a, 0xf6, 0x35, 0x3c, 0x6e, 0xd7, 0xf3, 0x9d, 0x09,
-	0x27, 0x93, 0x95, 0x77, 0x3e, 0x43, 0x58, 0x13, 0x68, 0xa4, 0x96, 0x8e, 0x6e, 0xc4, 0xe5, 0xc9,
-	0x38, 0xf6, 0x22, 0xba, 0x49, 0xd2, 0x22, 0x4d, 0x14, 0xfb, 0x14, 0x1a, 0xa9, 0xa7, 0xa5, 0xb3,
-	0x37, 0x5f, 0x3c, 0xec, 0x4a, 0x5f, 0xdc, 0xd5, 0xbe, 0xb8, 0xfb, 0x4a, 0x73, 0xd8, 0x19, 0xb3,
-	0xf5, 0xe3, 0x45, 0x68, 0x4a, 0x7b, 0xe1, 0xe7, 0xde, 0x98, 0xe3, 0x5a, 0xbe, 0x33, 0x3e, 0xf5,
-	0x02, 0xbe, 0x89, 0x6a, 0x97, 0x16, 0x6b, 0xa2, 0xd0, 0x6c, 0xc7, 0xd1, 0x8c, 0xa8, 0xca, 0x6c,
-	0x15, 0x88, 0x17, 0x23, 0x9a, 0x3a, 0xe2, 0x24, 0x8c, 0x7d, 0x25, 0xac, 0x14, 0x46, 0x71, 0x05,
-	0xe3, 0x68, 0x46, 0xe2, 0x6a, 0xd9, 0xf4, 0x8d, 0xa2, 0xf5, 0xb9, 0x1f, 0xc6, 0x57, 0x24, 0xa4,
-	0xaa, 0xad, 0x20, 0x5c, 0x21, 0x11, 0x61, 0xec, 0x4c, 0xa4, 0x60, 0xaa, 0xb6, 0x06, 0x33, 0xcb,
-	0x68, 0xde, 0x61, 0x19, 0xec, 0x03, 0x58, 0x52, 0xfe, 0xa1, 0xd3, 0x7a, 0x5c, 0x79, 0xd6, 0x7c,
-	0xd1, 0xea, 0x9a, 0xde, 0xd3, 0xd6, 0x54, 0xf6, 0x19, 0x30, 0x27, 0x49, 0xbc, 0x49, 0x80, 0xa6,
-	0xb7, 0xe9, 0x3a, 0x11, 0x39, 0xbf, 0x55, 0x1a, 0x03, 0xdd, 0x23, 0x2f, 0xdc, 0x9a, 0x05, 0xee,
-	0x94, 0xdb, 0x73, 0xb8, 0xb4, 0x33, 0x6c, 0xcf, 0x75, 0x86, 0xcf, 0xa1, 0xa9, 0xb6, 0xbd, 0xeb,
-	0x25, 0xa2, 0xb3, 0x66, 0xee, 0x62, 0x24, 0x09, 0xb6, 0xc9, 0xc1, 0x3e, 0x81, 0xfa, 0x71, 0x18,
-	0x0a, 0x54, 0x53, 0x87, 0xdd, 0xa9, 0xc3, 0x94, 0x97, 0xbd, 0x87, 0xa6, 0x4d, 0x6b, 0xdc, 0xa3,
-	0x35, 0x9a, 0x5d, 0xad, 0xd0, 0xd1, 0x57, 0xb6, 0x22, 0x69, 0xa7, 0x45, 0xd6, 0xb6, 0x9e, 0x39,
-	0x2d, 0xf2, 0x8f, 0xdf, 0x83, 0xa6, 0xcf, 0x45, 0xec, 0x8d, 0x07, 0x82, 0xfb, 0x49, 0xe7, 0xbe,
-	0x9a, 0x65, 0x2f, 0xc5, 0xd9, 0x26, 0x1d, 0xad, 0x7c, 0xea, 0x24, 0xc2, 0xe6, 0xb8, 0x03, 0x9b,
-	0x3b, 0x49, 0x18, 0x74, 0x1e, 0xd0, 0x94, 0xd7, 0xf0, 0x6c, 0x0b, 0x56, 0x32, 0x1c, 0x9d, 0xec,
-	0x5b, 0x77, 0x9e, 0xac, 0x30, 0x82, 0x7d, 0x0a, 0xad, 0xe4, 0x2a, 0x11, 0xdc, 0x57, 0x72, 0xef,
-	0x74, 0x94, 0xf2, 0x47, 0x26, 0x96, 0x62, 0x42, 0x9e, 0x11, 0x83, 0x5a, 0x8c, 0x93, 0xc6, 0x82,
-	0x3c, 0x2b, 0x8f, 0x3b, 0xdf, 0x26, 0xf3, 0x2b, 0x60, 0xad, 0x63, 0x58, 0xbb, 0x36, 0x17, 0x26,
-	0x0d, 0xe3, 0x59, 0x1c, 0xf3, 0x40, 0x0c, 0x02, 0x97, 0x5f, 0xd2, 0xb5, 0x6b, 0xd9, 0x39, 0x1c,
-	0xfb, 0x0d, 0x58, 0x4c, 0x28, 0x8c, 0x74, 0xca, 0x24, 0xb4, 0xb5, 0xae, 0xbc, 0x46, 0x07, 0x61,
-	0x2c, 0x54, 0x7c, 0x51, 0x0c, 0xd6, 0xdf, 0x94, 0xa1, 0x5d, 0x24, 0x9a, 0x29, 0x8b, 0x9c, 0x3e,
-	0x4d, 0x59, 0xda, 0x50, 0x39, 0xe3, 0x57, 0xca, 0x8f, 0xe1, 0x27, 0xfb, 0x6d, 0x58, 0xc6, 0x6b,
-	0x7b, 0x10, 0x7b, 0x61, 0xac, 0x43, 0xcc, 0xed, 0x82, 0xcc, 0xf1, 0xb3, 0xcf, 0x00, 0x50, 0xb0,
-	0x5f, 0x38, 0xde, 0x94, 0xbb, 0x74, 0xb1, 0x6f, 0x1f, 0x6d, 0x70, 0xb3, 0xdf, 0x81, 0x16, 0x42,
-	0xa3, 0xd9, 0x78, 0xcc, 0xb9, 0xcb, 0x5d, 0xba, 0xf9, 0xb7, 0x0f, 0xcf, 0x0f, 0x60, 0x4f, 0xa0,
-	0x16, 0x85, 0xb1, 0x90, 0x69, 0x05, 0x5a, 0x57, 0x26, 0x0b, 0x5b, 0x52, 0x28, 0x88, 0x3b, 0x89,
-	0x20, 0xbf, 0xa7, 0xdc, 0x6a, 0x86, 0xb0, 0xfe, 0xbb, 0x0c, 0x90, 0x8d, 0x41, 0xdf, 0xe1, 0x9d,
-	0x50, 0x08, 0x96, 0xee, 0x50, 0x41, 0xe4, 0x67, 0xb2, 0xc0, 0x4c, 0xdf, 0xc4, 0x9b, 0xec, 0x4d,
-	0x7c, 0x41, 0x32, 0xab, 0xdb, 0x0a, 0x42, 0xde, 0x93, 0x98, 0x4b, 0xd7, 0x5f, 0xb7, 0xe9, 0x1b,
-	0xef, 0x89, 0x7b, 0x3a, 0x8e, 0x30, 0x5a, 0x91, 0x93, 0x69, 0xd9, 0x29, 0x4c, 0x31, 0x64, 0x76,
-	0x1c, 0x70, 0xa1, 0x52, 0x0c, 0x05, 0xa1, 0x16, 0x27, 0x8e, 0xe0, 0x17, 0x8e, 0xcc, 0x30, 0x1a,
-	0xb6, 0x06, 0x31, 0x00, 0xcb, 0x60, 0x4a, 0x7b, 0x5a, 0x91, 0xa9, 0x44, 0x86, 0xc1, 0x23, 0x07,
-	0x22, 0x1a, 0x51, 0x38, 0xee, 0xac, 0xca, 0x23, 0xa7, 0x08, 0x1a, 0x1d, 0x24, 0x23, 0x15, 0xbe,
-	0xdb, 0x32, 0x7c, 0x67, 0x18, 0xb4, 0x50, 0xdc, 0x9b, 0xed, 0x04, 0x13, 0xbe, 0x1b, 0x5e, 0x74,
-	0xd6, 0x64, 0x5a, 0x6b, 0xe2, 0xd8, 0x53, 0x68, 0xa5, 0xf0, 0x8e, 0x37, 0x39, 0x25, 0xcf, 0xd2,
-	0xb0, 0xf3, 0xc8, 0x2c, 0x43, 0xba, 0x7f, 0x73, 0x86, 0xf4, 0xaf, 0x25, 0x68, 0x1a, 0x68, 0xf6,
-	0x1d, 0x58, 0x42, 0x82, 0xc7, 0x65, 0x66, 0x81, 0x3a, 0x25, 0x72, 0x1f, 0x53, 0x18, 0x5b, 0xd3,
-	0xf0, 0x10, 0xfc, 0x72, 0xcc, 0x29, 0x4e, 0x25, 0x4a, 0x2d, 0x06, 0x06, 0x85, 0x17, 0x39, 0xe3,
-	0x13, 0x6f, 0xca, 0x75, 0x1a, 0xab, 0x40, 0xd6, 0x05, 0xa6, 0x9c, 0xb4, 0x9a, 0x97, 0xb2, 0x05,
-	0xa9, 0xac, 0x39, 0x14, 0xcc, 0xa5, 0x4d, 0xec, 0x6b, 0x7b, 0x57, 0x05, 0xa8, 0x22, 0x1a, 0xd7,
-	0xbc, 0x88, 0x1c, 0x17, 0x39, 0x64, 0x9c, 0xd2, 0xa0, 0xb5, 0x0b, 0x90, 0x1d, 0x02, 0x0d, 0x24,
-	0x4d, 0x67, 0x5a, 0x36, 0x7d, 0x93, 0x11, 0x48, 0x7d, 0x95, 0x95, 0x11, 0x48, 0x65, 0x31, 0xa8,
-	0xa2, 0x19, 0xd3, 0x21, 0x5a, 0x36, 0x7d, 0x5b, 0x7f, 0x59, 0x01, 0xc8, 0x7c, 0x31, 0x6a, 0xdb,
-	0x19, 0x0b, 0xef, 0xdc, 0x11, 0xdc, 0xd5, 0x59, 0x4f, 0x8a, 0x40, 0x67, 0x15, 0x39, 0xb1, 0xf0,
-	0x50, 0x2c, 0xbb, 0xce, 0x31, 0x9f, 0x2a, 0x79, 0x14, 0xb0, 0x78, 0xcc, 0x14, 0x23, 0x2f, 0x84,
-	0x8a, 0xd2, 0x45, 0x74, 0x6e, 0x46, 0xca, 0x69, 0x94, 0x3c, 0x0a, 0x58, 0xf6, 0x24, 0xf5, 0x62,
-	0x8b, 0xc5, 0x24, 0x48, 0x11, 0xe8, 0x05, 0x75, 0x1a, 0xc6, 0x42, 0xe7, 0x57, 0x4b, 0xea, 0x05,
-	0x65, 0xe0, 0x30, 0x75, 0x98, 0x86, 0xc1, 0xa4, 0xf0, 0xda, 0x31, 0x50, 0xec, 0x31, 0xd4, 0x92,
-	0x0b, 0xcc, 0xe6, 0x1b, 0xd7, 0xb2, 0x79, 0x49, 0x98, 0x9b, 0x41, 0xc1, 0x0d, 0x19, 0xd4, 0xf7,
-	0x00, 0x66, 0x09, 0x8f, 0xa5, 0x39, 0xd2, 0x65, 0x5d, 0x79, 0xd1, 0xea, 0x6e, 0x39, 0x09, 0xdf,
-	0x4f, 0x94, 0xe9, 0x1a, 0x0c, 0x94, 0x1f, 0xce, 0x8e, 0x15, 0xb7, 0x7a, 0x23, 0xa4, 0x08, 0xeb,
-	0x9b, 0x92, 0x7a, 0x5e, 0xa9, 0xd0, 0x8c, 0x7a, 0x76, 0xa5, 0x74, 0x95, 0x83, 0x91, 0x10, 0x4e,
-	0xe3, 0x63, 0xd8, 0x38, 0x70, 0xc4, 0xa9, 0x4e, 0x33, 0x53, 0x04, 0x5b, 0x87, 0x9a, 0x08, 0x31,
-	0x29, 0xaf, 0x50, 0xe2, 0x22, 0x01, 0x54, 0x99, 0x0e, 0xf4, 0xfa, 0x39, 0x24, 0xcd, 0xb8, 0x88,
-	0xb6, 0xbe, 0xa9, 0xa8, 0xf4, 0x7d, 0x33, 0x8a, 0x70, 0xb2, 0xcd, 0x28, 0x1a, 0xf4, 0xd4, 0x0e,
-	0x24, 0x80, 0x17, 0xca, 0x89, 0xa2, 0x7c, 0xa2, 0x6b, 0x60, 0xe8, 0x9c, 0x32, 0x98, 0x45, 0x11,
-	0x29, 0xb4, 0x6e, 0x67, 0x08, 0x34, 0xfd, 0xcd, 0x28, 0xa2, 0x34, 0x40, 0xea, 0x50, 0x83, 0xec,
-	0xbb, 0xb0, 0x9c, 0x84, 0x27, 0xe2, 0xc2, 0x89, 0x65, 0xc2, 0x52, 0xa7, 0x4b, 0x5d, 0x57, 0x09,
-	0xcb, 0x57, 0x76, 0x8e, 0x9a, 0x4b, 0x56, 0x96, 0xdf, 0x22, 0x59, 0xf9, 0x04, 0xda, 0x32, 0x91,
-	0xe2, 0x6e, 0x9a, 0x6c, 0xb5, 0xae, 0x25, 0x5b, 0xd7, 0x78, 0x98, 0x05, 0x8b, 0x4e, 0x14, 0xa1,
-	0xed, 0xac, 0x28, 0xee, 0xcc, 0x76, 0x14, 0x25, 0xcb, 0xe5, 0x57, 0x6f, 0xc8, 0xe5, 0x8d, 0xa4,
-	0xb0, 0x7d, 0x5b, 0x52, 0x68, 0xfd, 0x1e, 0xb4, 0x89, 0x70, 0x18, 0x05, 0xbb, 0x5e, 0x70, 0x46,
-	0xf9, 0xc0, 0x3a, 0xd4, 0x92, 0xc8, 0x1b, 0xb8, 0x5a, 0x1b, 0x04, 0xa8, 0x98, 0x30, 0xe4, 0x22,
-	0x75, 0x07, 0x04, 0xa1, 0x16, 0x5c, 0x2f, 0xe6, 0x63, 0xa1, 0xcb, 0x11, 0x75, 0x3b, 0x43, 0x58,
-	0xff, 0xa9, 0xad, 0x4d, 0x2d, 0x80, 0x2f, 0x67, 0x4f, 0xcf, 0x5c, 0xf6, 0xdc, 0xb9, 0x61, 0x6c,
-	0x1d, 0x6a, 0x31, 0xff, 0x83, 0x81, 0xab, 0xfc, 0x82, 0x04, 0x30, 0x60, 0x79, 0x41, 0x22, 0x15,
-	0x51, 0x25, 0xa3, 0x4b, 0x61, 0x54, 0x36, 0x4f, 0x22, 0x5c, 0x47, 0xa7, 0xea, 0x0a, 0x64, 0x4f,
-	0xb5, 0xa8, 0xe4, 0x8d, 0x5f, 0xe9, 0xea, 0xdd, 0x14, 0xe4, 0x55, 0x9b, 0xd2, 0x68, 0x20, 0x0d,
-	0xaf, 0x75, 0x8b, 0x42, 0xb1, 0x25, 0x1d, 0x19, 0x49, 0x15, 0x2a, 0x2f, 0x9f, 0xc7, 0x48, 0x74,
-	0x6b, 0x98, 0x09, 0xb6, 0x1f, 0xb8, 0x07, 0xa1, 0x17, 0x88, 0x6b, 0x67, 0xc7, 0x70, 0x1d, 0x51,
-	0x5d, 0x43, 0x89, 0x54, 0x42, 0x73, 0x3d, 0xec, 0x4f, 0xcb, 0x99, 0x20, 0xb7, 0xc3, 0x20, 0x78,
-	0x23, 0x41, 0xde, 0x5c, 0x28, 0x22, 0x81, 0x99, 0xb2, 0xd4, 0x20, 0xce, 0xe3, 0x9d, 0xf1, 0x44,
-	0x97, 0x87, 0xf0, 0xfb, 0x6d, 0x85, 0xb8, 0x54, 0x90, 0x8d, 0x16, 0xc0, 0x35, 0x21, 0xd6, 0x6f,
-	0x64, 0x94, 0x99, 0xe9, 0x7b, 0x50, 0x9b, 0x7a, 0xc1, 0x19, 0x7a, 0x46, 0xc3, 0x88, 0x95, 0xb4,
-	0x6d, 0x49, 0xb3, 0xfe, 0xb4, 0xa4, 0x3c, 0xc9, 0x61, 0xa4, 0x6a, 0x2c, 0x74, 0xac, 0x92, 0x7c,
-	0x69, 0x49, 0x88, 0x8a, 0x6a, 0xe1, 0xd4, 0x1b, 0x5f, 0xa1, 0xd7, 0xd4, 0x31, 0xc9, 0x44, 0x51,
-	0xb2, 0xef, 0x25, 0x82, 0x07, 0x5e, 0x30, 0x19, 0x44, 0xb2, 0x74, 0x24, 0x6b, 0x01, 0xd7, 0xf0,
-	0xec, 0x09, 0x54, 0xc7, 0x61, 0x10, 0x5c, 0xdb, 0x16, 0x2a, 0xc6, 0x26, 0x92, 0xf5, 0x5b, 0xd0,
-	0xb0, 0xa7, 0xe1, 0x58, 0xc6, 0x1d, 0x06, 0x55, 0x04, 0x94, 0xb6, 0xe8, 0x1b, 0xef, 0x8d, 0xcd,
-	0x9d, 0xf1, 0xa9, 0x59, 0x19, 0x48, 0x11, 0xd6, 0x36, 0xb4, 0xf6, 0x9c, 0x68, 0xdb, 0x19, 0x9f,
-	0xf2, 0xbe, 0xae, 0x94, 0xf4, 0x53, 0x07, 0x89, 0x9f, 0x18, 0x63, 0x70, 0x22, 0x9d, 0x91, 0x43,
-	0x37, 0x5d, 0xcf, 0x96, 0x04, 0xeb, 0x07, 0xd0, 0xec, 0x39, 0xc2, 0x39, 0x76, 0x12, 0xbe, 0xe7,
-	0x44, 0x38, 0xc5, 0x40, 0x4d, 0x51, 0xb5, 0xf1, 0x93, 0x7d, 0x0a, 0xab, 0xe6, 0x2a, 0x98, 0xe1,
-	0xc8, 0xc9, 0x56, 0xba, 0xb9, 0xd5, 0xed, 0x22, 0x9b, 0x35, 0x84, 0x7a, 0x8f, 0x8f, 0x9d, 0xe8,
-	0x4b, 0x7e, 0x35, 0xf7, 0x74, 0x0c, 0xaa, 0x98, 0xbd, 0xd2, 0xc1, 0xaa, 0x36, 0x7d, 0xe3, 0x05,
-	0xfe, 0x92, 0x5f, 0xd1, 0x53, 0x44, 0x45, 0x8d, 0x14, 0xb6, 0xfe, 0xbe, 0x04, 0x0d, 0x92, 0xe2,
-	0xae, 0x97, 0x44, 0x98, 0xcb, 0x0d, 0x44, 0xbc, 0x1d, 0x5f, 0x45, 0x22, 0xa4, 0x69, 0xe4, 0x9e,
-	0xf3, 0x48, 0x8c, 0x0f, 0x7d, 0x11, 0x0f, 0x1d, 0x61, 0xac, 0x64, 0x60, 0x90, 0x3e, 0xc0, 0x57,
-	0xcf, 0x89, 0x33, 0xe6, 0x5a, 0x97, 0x06, 0x86, 0x7d, 0x1f, 0x96, 0x0d, 0xf1, 0x24, 0x9d, 0x2a,
-	0x1d, 0x7d, 0xb9, 0x6b, 0x20, 0xed, 0x1c, 0x07, 0xfb, 0x00, 0x1a, 0xfa, 0xd4, 0xb2, 0xae, 0x88,
-	0x8f, 0x61, 0x8d, 0xb1, 0x33, 0x9a, 0xf5, 0x0f, 0x15, 0x1d, 0x64, 0x79, 0xac, 0x83, 0x69, 0x22,
-	0x3f, 0x53, 0x25, 0x66, 0x08, 0xb4, 0x4e, 0x05, 0x98, 0x25, 0x5f, 0x03, 0x65, 0x70, 0x50, 0xc2,
-	0x2e, 0x3d, 0x83, 0x89, 0xba, 0x16, 0xd5, 0xe4, 0xbb, 0xe7, 0xa6, 0xa8, 0x96, 0xcb, 0xd0, 0x6a,
-	0xc5, 0x0c, 0xed, 0x73, 0x68, 0xca, 0x7b, 0x33, 0xa2, 0x3a, 0xcb, 0xe2, 0x9d, 0x61, 0xcf, 0x64,
-	0x9f, 0x1b, 0xf9, 0x96, 0xde, 0x2c, 0xf2, 0x25, 0xe7, 0x63, 0x8c, 0x7c, 0xf5, 0xeb, 0x91, 0x4f,
-	0x52, 0xcc, 0xc0, 0xd6, 0xb8, 0xb5, 0xda, 0xf1, 0x04, 0x6a, 0xe7, 0x54, 0x40, 0x59, 0x37, 0x6b,
-	0x16, 0x87, 0x51, 0xb0, 0xb3, 0x60, 0x4b, 0x0a, 0xbe, 0x05, 0xa6, 0xc4, 0x72, 0x5f, 0x25, 0x69,
-	0xa9, 0x01, 0x22, 0x0f, 0x91, 0xb6, 0x5a, 0xd0, 0x44, 0xe4, 0x76, 0x18, 0x08, 0x1e, 0x08, 0xeb,
-	0x4f, 0x6a, 0xc0, 0xcc, 0xf5, 0xf6, 0x8f, 0x7f, 0x9f, 0x8f, 0x49, 0x9a, 0x6a, 0xdd, 0x4c, 0xbb,
-	0x29, 0x02, 0x75, 0xa7, 0x00, 0xd2, 0x5d, 0x59, 0xea, 0xce, 0x40, 0xe5, 0xde, 0x62, 0x95, 0x1b,
-	0xdf, 0x62, 0xd5, 0x9b, 0xde, 0x62, 0xb5, 0xdb, 0xde, 0x62, 0x8b, 0xb7, 0xbf, 0xc5, 0x96, 0x6e,
-	0x7f, 0x8b, 0xd5, 0xef, 0x7c, 0x8b, 0x35, 0xde, 0xe4, 0x2d, 0x06, 0xf3, 0xde, 0x62, 0xef, 0x40,
-	0xe3, 0x38, 0xf6, 0xdc, 0x09, 0x1f, 0xce, 0x7c, 0x4a, 0xad, 0x5a, 0x76, 0x86, 0xa0, 0x96, 0x83,
-	0x04, 0xf0, 0x14, 0x2d, 0xd5, 0x72, 0x48, 0x31, 0xb8, 0x0f, 0x09, 0xc9, 0xc2, 0xbe, 0x7a, 0x73,
-	0xe6, 0x70, 0xec, 0x73, 0x68, 0x79, 0xd1, 0x26, 0xd9, 0x99, 0xcf, 0x03, 0xa1, 0xab, 0x5d, 0x0f,
-	0xba, 0x47, 0x3e, 0x17, 0x83, 0x83, 0x8c, 0x22, 0xbd, 0x5c, 0x9e, 0xd9, 0x5c, 0x61, 0xc4, 0x85,
-	0x7e, 0x97, 0xe6, 0x70, 0xa8, 0xb9, 0x73, 0xef, 0x04, 0x37, 0x94, 0x50, 0xe1, 0xab, 0x61, 0xa7,
-	0x30, 0x6a, 0xc8, 0x8b, 0xce, 0x3f, 0xee, 0x7b, 0x2e, 0xbd, 0x45, 0xeb, 0xb6, 0x06, 0x0b, 0x15,
-	0xff, 0x7b, 0xd7, 0xac, 0xdd, 0xac, 0xf8, 0x3f, 0x86, 0xea, 0xb9, 0x77, 0x92, 0x74, 0xbe, 0xad,
-	0xbc, 0x13, 0x6e, 0xfd, 0xd0, 0x3b, 0x21, 0x3e, 0xa2, 0x58, 0x3f, 0xaf, 0xc1, 0xba, 0x69, 0x94,
-	0x83, 0x20, 0x11, 0x4e, 0x20, 0x9d, 0x4e, 0x66, 0x96, 0xe5, 0xa2, 0x59, 0xbe, 0x0f, 0x2b, 0x0a,
-	0x38, 0xcc, 0xe5, 0x08, 0x05, 0x6c, 0x9a, 0x77, 0xa1, 0x71, 0xd6, 0xa4, 0x71, 0x6a, 0x98, 0x0a,
-	0xb6, 0x5e, 0x12, 0x4d, 0x9d, 0x2b, 0xc3, 0xd6, 0x4c, 0x54, 0xde, 0xd1, 0x2c, 0xdd, 0xe1, 0x68,
-	0xea, 0x6f, 0xe7, 0x68, 0x8a, 0x2e, 0xaf, 0x71, 0x97, 0xcb, 0xcb, 0xcc, 0x6d, 0xfd, 0x76, 0x73,
-	0xbb, 0x7f, 0xa7, 0xb9, 0x3d, 0x78, 0x13, 0x73, 0xfb, 0xd6, 0xff, 0xc5, 0xdc, 0x3a, 0x73, 0xcc,
-	0xed, 0x4e, 0x63, 0x30, 0x8d, 0xee, 0x61, 0xde, 0xe8, 0xe6, 0xb9, 0xe5, 0x77, 0xdf, 0xc0, 0x2d,
-	0xa7, 0x9e, 0xf4, 0xd1, 0xdd, 0x9e, 0xf4, 0xf1, 0x8d, 0x9e, 0xb4, 0x60, 0xf3, 0xcf, 0x6e, 0xb3,
-	0xf9, 0xa2, 0xd7, 0x7d, 0x0d, 0xf7, 0xe7, 0x4a, 0x10, 0x95, 0xa6, 0x5a, 0x81, 0xf8, 0x7c, 0x56,
-	0x0d, 0xac, 0x0c, 0x43, 0xad, 0x87, 0x48, 0x93, 0xcb, 0xb2, 0xb1, 0x93, 0x22, 0xac, 0x1f, 0x42,
-	0xd3, 0x90, 0x1f, 0x25, 0xcb, 0xf2, 0xea, 0xaa, 0x99, 0x34, 0x58, 0x58, 0xa6, 0x7c, 0x6d, 0x99,
-	0x75, 0xa8, 0x39, 0xf4, 0x7c, 0x55, 0xef, 0x15, 0x02, 0xac, 0x9f, 0x97, 0x55, 0x5e, 0xba, 0x97,
-	0x4c, 0x50, 0x88, 0x66, 0xc3, 0x48, 0x55, 0xae, 0x73, 0xad, 0xa2, 0x75, 0xa8, 0xb9, 0xfc, 0x7c,
-	0xe0, 0xaa, 0x05, 0x24, 0x80, 0xa9, 0xb7, 0x6b, 0xb4, 0x88, 0x96, 0xbb, 0x46, 0x0f, 0x03, 0x85,
-	0x4b, 0x44, 0x9c, 0xde, 0xf1, 0xf4, 0xeb, 0x27, 0xd5, 0xd1, 0x66, 0x44, 0xf2, 0x27, 0x0a, 0xfb,
-	0x0e, 0xd4, 0x12, 0x2f, 0x7b, 0xe2, 0xe8, 0xfa, 0xbc, 0xcc, 0x20, 0x90, 0x8d, 0xa8, 0xec, 0x43,
-	0xa8, 0x05, 0x46, 0xe3, 0xe1, 0x5e, 0xf7, 0x7a, 0xb8, 0x43, 0x66, 0xe2, 0x61, 0xcf, 0x61, 0x31,
-	0xf0, 0x88, 0x5b, 0xbe, 0x8c, 0xef, 0x77, 0xe7, 0xf9, 0xa1, 0x9d, 0x05, 0x5b, 0xb1, 0xe1, 0x7d,
-	0x77, 0xc4, 0x5b, 0x25, 0x16, 0x06, 0x7b, 0xd1, 0x2c, 0xfe, 0x1c, 0x73, 0x46, 0x6d, 0xb8, 0xec,
-	0x1d, 0xa3, 0x84, 0xb5, 0x82, 0x4e, 0xc0, 0x23, 0xf1, 0xaa, 0x62, 0xd6, 0x0d, 0xaf, 0x23, 0x9f,
-	0xfb, 0xc7, 0x3c, 0x4d, 0xf4, 0x35, 0x88, 0xf1, 0x6b, 0x96, 0x70, 0x77, 0xeb, 0x6a, 0x33, 0x8a,
-	0xa8, 0x57, 0x2e, 0x43, 0x6f, 0x1e, 0x89, 0x17, 0x56, 0x22, 0xa8, 0x12, 0x33, 0x52, 0x69, 0x54,
-	0x0e, 0x67, 0xfd, 0x59, 0x09, 0x96, 0x65, 0xb3, 0x47, 0x36, 0x19, 0x70, 0x51, 0x64, 0xd8, 0xe3,
-	0xbe, 0x4a, 0x04, 0x34, 0x88, 0x7e, 0xd6, 0x39, 0x77, 0xbc, 0x29, 0x92, 0x54, 0x12, 0xa0, 0x61,
-	0xf4, 0xd5, 0xc8, 0x76, 0xc0, 0xe3, 0x31, 0x0f, 0x84, 0x33, 0x91, 0xaf, 0xb6, 0x92, 0x5d, 0xc0,
-	0xb2, 0x67, 0xb0, 0x4a, 0x63, 0x0c, 0xc6, 0x1a, 0x31, 0x16, 0xd1, 0xd6, 0xbf, 0x57, 0xa0, 0xa5,
-	0x6e, 0x9c, 0xda, 0xd9, 0x3a, 0xd4, 0x3c, 0xc3, 0xfa, 0x25, 0x80, 0xfb, 0x15, 0x97, 0x5b, 0x57,
-	0x82, 0x27, 0x2a, 0xc3, 0xd6, 0x20, 0x52, 0x62, 0x45, 0x91, 0xd9, 0xbc, 0x06, 0xe5, 0x98, 0x5e,
-	0x1c, 0x52, 0x4e, 0xad, 0xc6, 0x10, 0x28, 0xc7, 0x48, 0x4a, 0x4d, 0x8f, 0x91, 0x94, 0x07, 0xb0,
-	0x28, 0x2e, 0x6d, 0xfd, 0xc6, 0xac, 0xda, 0x0a, 0x42, 0x7c, 0x2c, 0xf1, 0x4b, 0x12, 0x1f, 0xa7,
-	0x78, 0x71, 0x79, 0x70, 0x26, 0x12, 0xdd, 0x52, 0x93, 0x90, 0xe4, 0x27, 0x7c, 0x43, 0xf3, 0x13,
-	0xfe, 0x21, 0xd4, 0xc5, 0x25, 0x79, 0x1b, 0x59, 0x67, 0xab, 0xda, 0x29, 0x8c, 0xb4, 0x58, 0xd3,
-	0x9a, 0x92, 0xa6, 0x61, 0xbc, 0xfb, 0xe2, 0x72, 0x73, 0x3c, 0x95, 0x9b, 0x5e, 0x96, 0x8f, 0x8c,
-	0x0c, 0x83, 0xf4, 0x38, 0xa3, 0xb7, 0x24, 0x3d, 0xc3, 0xb0, 0xef, 0xc3, 0x3d, 0xe2, 0xc6, 0x4d,
-	0xef, 0x7a, 0xbe, 0x27, 0x24, 0xe3, 0x0a, 0x31, 0xce, 0x23, 0xe1, 0x88, 0x78, 0xce, 0x88, 0x55,
-	0x39, 0x62, 0x0e, 0x29, 0xff, 0xa3, 0x80, 0x76, 0xe1, 0x47, 0x01, 0xd6, 0x4f, 0xca, 0xb0, 0xf2,
-	0x35, 0x77, 0xc7, 0xd3, 0x70, 0xe6, 0x2a, 0x55, 0x53, 0x4f, 0x61, 0x98, 0xeb, 0x29, 0x90, 0xb2,
-	0x1f, 0x42, 0xfd, 0xc4, 0xf1, 0xa6, 0xb3, 0x38, 0xd5, 0x76, 0x0a, 0x53, 0xaf, 0x72, 0x36, 0x1e,
-	0xa3, 0x07, 0x54, 0xea, 0x56, 0x20, 0x5e, 0x6a, 0xdd, 0x41, 0x99, 0xc5, 0xfc, 0x0d, 0x1a, 0x2e,
-	0x26, 0xbb, 0x1e, 0x3d, 0x52, 0x73, 0xd7, 0xde, 0x6c, 0xb4, 0x62, 0x67, 0xcf, 0x01, 0x66, 0xf1,
-	0x54, 0x1e, 0x4b, 0xb7, 0x5c, 0x56, 0xbb, 0xb3, 0x78, 0x6a, 0x1c, 0xd7, 0x36, 0x58, 0xac, 0xff,
-	0x2a, 0xc1, 0x4a, 0x9e, 0x8c, 0xef, 0xe2, 0x59, 0x3c, 0xd5, 0x4f, 0xeb, 0x59, 0x3c, 0xc5, 0xb4,
-	0x46, 0xc4, 0x57, 0x7b, 0xc9, 0x44, 0x3e, 0x56, 0x51, 0x14, 0x15, 0xdb, 0x44, 0xe1, 0xdd, 0x17,
-	0xf1, 0x15, 0x9a, 0x7b, 0xf6, 0x9e, 0xad, 0xd8, 0x39, 0x9c, 0xfc, 0x31, 0x4e, 0x20, 0xd2, 0x69,
-	0xaa, 0x92, 0xc7, 0xc4, 0xa1, 0xa7, 0x41, 0x38, 0x9b, 0xa8, 0x46, 0x4c, 0x79, 0x24, 0xce, 0x14,
-	0xf3, 0xf1, 0x79, 0x3a, 0xd3, 0xa2, 0x9c, 0xc9, 0xc4, 0xe1, 0x4c, 0x08, 0x67, 0x33, 0x2d, 0xc9,
-	0x99, 0x72, 0x48, 0xeb, 0x77, 0x61, 0xd9, 0x89, 0xa2, 0xed, 0x68, 0xa6, 0xce, 0xfe, 0x22, 0xad,
-	0x97, 0xdc, 0xad, 0x36, 0x5d, 0x4b, 0x49, 0x4b, 0xbf, 0x35, 0xa3, 0xf4, 0x6b, 0xfd, 0x6d, 0x05,
-	0x96, 0x65, 0xe5, 0x58, 0x4d, 0xfd, 0x9d, 0xb4, 0xe9, 0x5d, 0x56, 0x11, 0xc7, 0x74, 0x84, 0x69,
-	0x0f, 0xfc, 0x59, 0xf6, 0xa2, 0xab, 0xa8, 0xda, 0x43, 0xce, 0x2f, 0x65, 0x4f, 0xba, 0x0f, 0xa1,
-	0xae, 0xed, 0x58, 0xbd, 0xd5, 0x57, 0xbb, 0x79, 0xc3, 0xb6, 0x53, 0x06, 0xf6, 0x08, 0xaa, 0xae,
-	0x97, 0x9c, 0xa5, 0x5d, 0x38, 0x04, 0x14, 0x13, 0x11, 0xd8, 0x87, 0xd0, 0x18, 0x6b, 0x31, 0xa8,
-	0x8a, 0x55, 0xab, 0x6b, 0xca, 0xc6, 0xce, 0xe8, 0xc5, 0xc6, 0x71, 0xfd, 0x8e, 0xc6, 0xf1, 0x67,
-	0xd0, 0x89, 0x67, 0x81, 0xa0, 0xc0, 0x45, 0x65, 0xef, 0xfd, 0x73, 0x1e, 0x9f, 0x72, 0xc7, 0xdd,
-	0xdb, 0x52, 0x6e, 0xe9, 0x46, 0x3a, 0x5e, 0x7f, 0x27, 0x8a, 0xec, 0x59, 0xf0, 0x2a, 0x23, 0xef,
-	0x6d, 0x29, 0x9f, 0x35, 0x8f, 0xc4, 0xfa, 0xf0, 0x40, 0x96, 0xbd, 0x55, 0x30, 0x4f, 0xf6, 0xa4,
-	0x9c, 0xb7, 0x54, 0x0c, 0x2f, 0x08, 0xfe, 0x06, 0x66, 0xeb, 0x9b, 0x32, 0x40, 0x76, 0x20, 0xdd,
-	0x97, 0x2d, 0x65, 0x7d, 0xd9, 0xf7, 0x54, 0x84, 0x2d, 0x53, 0x84, 0x5d, 0x35, 0x4e, 0x6f, 0x04,
-	0xda, 0x77, 0xa1, 0x71, 0x1c, 0x86, 0xd3, 0x43, 0x67, 0x3a, 0x93, 0x6f, 0xd9, 0xfa, 0xce, 0x82,
-	0x9d, 0xa1, 0x98, 0x05, 0xcd, 0x99, 0x17, 0x88, 0x8f, 0x5e, 0x48, 0x0e, 0xb4, 0xba, 0xd6, 0xce,
-	0x82, 0x6d, 0x22, 0x35, 0xcf, 0x27, 0x1f, 0x4b, 0x1e, 0x32, 0x33, 0xcd, 0xa3, 0x90, 0xec, 0x31,
-	0xc0, 0xc9, 0x34, 0x74, 0x84, 0x64, 0xc1, 0x0b, 0x51, 0xde, 0x59, 0xb0, 0x0d, 0x1c, 0xce, 0x92,
-	0x88, 0xd8, 0x0b, 0x26, 0x92, 0x85, 0x1e, 0xba, 0x38, 0x8b, 0x81, 0xdc, 0x5a, 0x83, 0xd5, 0x4c,
-	0x6f, 0x84, 0xb2, 0x7e, 0x51, 0x02, 0xc8, 0x8c, 0x05, 0x13, 0x07, 0xb2, 0x23, 0x55, 0xdc, 0x22,
-	0xd3, 0xb9, 0xbd, 0x33, 0xf2, 0x0e, 0x34, 0x62, 0xee, 0xb8, 0x66, 0x64, 0xcc, 0x10, 0x18, 0x2f,
-	0x2e, 0x62, 0x4f, 0x70, 0x49, 0x96, 0xe1, 0xd1, 0xc0, 0xe8, 0xd1, 0x99, 0x33, 0x50, 0xa3, 0xe5,
-	0x25, 0xd7, 0xa3, 0x33, 0x37, 0xa0, 0x47, 0x4b, 0x7a, 0x7a, 0x35, 0x97, 0xcc, 0xae, 0x0c, 0x83,
-	0x2a, 0xe6, 0x09, 0x2a, 0x52, 0xd2, 0x77, 0xda, 0x12, 0x96, 0xe6, 0x48, 0xdf, 0xd6, 0x4f, 0x4a,
-	0xd0, 0x72, 0xa2, 0xa8, 0x77, 0xfb, 0xe9, 0xe5, 0xef, 0x13, 0xcf, 0x3d, 0x7c, 0x1c, 0xaa, 0x52,
-	0x6a, 0xd5, 0x36, 0x51, 0xe9, 0x7a, 0x15, 0x63, 0xbd, 0x87, 0x50, 0xc7, 0xd1, 0xf4, 0x8a, 0x94,
-	0xd9, 0x54, 0x0a, 0x53, 0xe6, 0xeb, 0xc5, 0xe2, 0x4a, 0x65, 0x50, 0x12, 0xb0, 0xfe, 0xa3, 0x04,
-	0x0d, 0x27, 0x8a, 0xb2, 0xec, 0xe4, 0xce, 0x16, 0x11, 0x5c, 0x6b, 0x11, 0x19, 0x4d, 0xa0, 0x72,
-	0xbe, 0x09, 0xf4, 0x08, 0x2a, 0xe3, 0x68, 0xa6, 0xb2, 0xea, 0xc2, 0xc5, 0x47, 0x8a, 0xe1, 0xbe,
-	0xaa, 0x6f, 0xe8, 0xbe, 0x6a, 0xb7, 0xbb, 0x2f, 0x2b, 0xe7, 0x91, 0x56, 0xba, 0x39, 0x49, 0x4b,
-	0xd9, 0x5a, 0xbf, 0x09, 0x4b, 0x07, 0x67, 0xf4, 0x9b, 0x09, 0xdc, 0xfa, 0x81, 0x33, 0x3e, 0xc3,
-	0x97, 0xa0, 0xac, 0x7e, 0x6a, 0x10, 0x45, 0x61, 0x26, 0x64, 0x12, 0xb0, 0x2e, 0xb2, 0x82, 0x73,
-	0x32, 0xb7, 0x24, 0xfb, 0x2e, 0xd4, 0x88, 0xa8, 0xdc, 0x71, 0xbd, 0xab, 0x56, 0xb2, 0x25, 0x9a,
-	0x7d, 0x02, 0x0f, 0x46, 0x7c, 0x1c, 0x06, 0x6e, 0x32, 0xf2, 0x82, 0x31, 0xdf, 0x75, 0x12, 0x21,
-	0x57, 0x54, 0x7a, 0xbc, 0x81, 0x6a, 0x9d, 0x40, 0xbd, 0xef, 0xb9, 0x72, 0x8e, 0xeb, 0x25, 0x66,
-	0x55, 0xb7, 0x2e, 0x67, 0x75, 0xeb, 0x4f, 0xa0, 0x9d, 0x6e, 0x54, 0x57, 0x9d, 0x2b, 0x85, 0x12,
-	0x76, 0x62, 0x5f, 0xe3, 0xb1, 0xfe, 0xad, 0x0a, 0xcd, 0x23, 0x29, 0x2d, 0x2a, 0x12, 0x7f, 0x04,
-	0xab, 0x7a, 0x5d, 0x3d, 0x4d, 0x49, 0x95, 0x64, 0x35, 0xde, 0x2e, 0x72, 0xb0, 0x4f, 0x81, 0x0d,
-	0x44, 0x2c, 0x77, 0x3e, 0xe2, 0x81, 0x2b, 0x7f, 0x83, 0x51, 0x94, 0xc8, 0x1c, 0x1e, 0xf6, 0x02,
-	0x56, 0x07, 0xc1, 0xb9, 0x33, 0xf5, 0xdc, 0xbe, 0xa7, 0x86, 0x55, 0x0a, 0xc3, 0x8a, 0x0c, 0xec,
-	0xbb, 0xb0, 0x3c, 0x0c, 0x7b, 0x7c, 0x1c, 0x5f, 0x45, 0xe2, 0x4b, 0x7e, 0x95, 0xd6, 0x64, 0xf5,
-	0x80, 0x1c, 0x95, 0x7d, 0x0c, 0xed, 0xfd, 0x99, 0xe0, 0xf1, 0x0e, 0x77, 0x5c, 0x1e, 0xcb, 0x25,
-	0x6a, 0x85, 0x11, 0xd7, 0x38, 0x70, 0x5f, 0x5b, 0x8e, 0x3b, 0x08, 0x02, 0x1e, 0xeb, 0x7b, 0xb0,
-	0x58, 0xdc, 0x57, 0x81, 0x81, 0x6d, 0x40, 0xf3, 0x65, 0x18, 0xba, 0xda, 0xbe, 0x96, 0x0a, 0xfc,
-	0x26, 0x91, 0x3d, 0x85, 0xfa, 0x60, 0xfb, 0x50, 0xee, 0xa6, 0x5e, 0x60, 0x4c, 0x29, 0xb8, 0x0b,
-	0x7a, 0xee, 0x1b, 0x5b, 0x6f, 0x14, 0x77, 0x51, 0x60, 0x60, 0x5d, 0x68, 0x6d, 0x9f, 0xf2, 0xf1,
-	0xd9, 0x68, 0xe6, 0xcb, 0x11, 0x50, 0x18, 0x91, 0x27, 0xa3, 0xee, 0xa8, 0xc2, 0x6e, 0xf3, 0x41,
-	0x80, 0xef, 0x50, 0x39, 0xa8, 0x59, 0xd4, 0xdd, 0x75, 0x1e, 0xd4, 0x83, 0x92, 0xb3, 0x1c, 0xb3,
-	0x5c, 0xd4, 0x83, 0x49, 0xb5, 0xfe, 0xa2, 0x94, 0x1a, 0x1a, 0x75, 0xda, 0x1e, 0xc3, 0xe2, 0x20,
-	0xa0, 0x27, 0x45, 0xa9, 0x30, 0x4e, 0xe1, 0x99, 0x05, 0x4b, 0xfb, 0x33, 0x41, 0x2c, 0x45, 0x53,
-	0xd2, 0x04, 0xe4, 0xe9, 0xc7, 0x31, 0xf1, 0x14, 0xed, 0x46, 0x13, 0x48, 0x22, 0x4e, 0xec, 0x91,
-	0x80, 0x88, 0xb3, 0x7a, 0x4d, 0x22, 0x26, 0xd9, 0xfa, 0xab, 0x12, 0x80, 0xda, 0xe9, 0x61, 0x14,
-	0xb0, 0x67, 0x50, 0xc7, 0x0d, 0x23, 0xa7, 0xda, 0xea, 0x72, 0xd7, 0x38, 0x88, 0x9d, 0x52, 0xd9,
-	0xfb, 0xb0, 0x34, 0x38, 0xe3, 0xc4, 0x58, 0x9e, 0xc3, 0xa8, 0x89, 0x38, 0xe3, 0xd0, 0x11, 0xaf,
-	0x88, 0xb1, 0x32, 0x6f, 0x46, 0x4d, 0xc5, 0x19, 0xfb, 0x49, 0x44, 0x8c, 0xd5, 0x79, 0x33, 0x2a,
-	0xa2, 0xd5, 0x4a, 0x65, 0x3b, 0x0c, 0x03, 0x6e, 0xfd, 0x10, 0x56, 0x15, 0xf8, 0xc5, 0x34, 0xbc,
-	0xa0, 0x0e, 0x71, 0x27, 0x6d, 0x34, 0x97, 0x54, 0xc8, 0xd6, 0xad, 0x66, 0x06, 0x15, 0xee, 0xa9,
-	0xfa, 0xc8, 0xce, 0x82, 0x8d, 0x40, 0xd6, 0xac, 0xae, 0x18, 0xcd, 0xea, 0xad, 0x45, 0xa8, 0xe2,
-	0x5c, 0xd6, 0x4f, 0x4b, 0x70, 0xcf, 0x98, 0x3f, 0xed, 0xc4, 0x76, 0xd2, 0xce, 0x6b, 0xba, 0x86,
-	0xea, 0xbd, 0xae, 0x43, 0x35, 0x46, 0xcf, 0xa9, 0x17, 0x21, 0x88, 0x3d, 0x85, 0x2a, 0xfd, 0x70,
-	0x5b, 0xba, 0xf8, 0x76, 0xb7, 0xb0, 0x67, 0x9b, 0xa8, 0xe8, 0x61, 0x13, 0xf2, 0xb0, 0x45, 0x43,
-	0x96, 0xe8, 0x2d, 0x80, 0x7a, 0x3f, 0x70, 0x23, 0xdc, 0x81, 0xf5, 0x4f, 0x99, 0x91, 0xe1, 0x2c,
-	0x6f, 0xd4, 0xce, 0xd5, 0xbf, 0xd2, 0xa9, 0x18, 0xbf, 0xd2, 0x69, 0x43, 0xc5, 0xf3, 0x5c, 0x95,
-	0x48, 0xe0, 0xa7, 0xd9, 0xda, 0xad, 0xe5, 0x5b, 0xbb, 0x2f, 0xa0, 0x31, 0xd5, 0x22, 0x50, 0x7b,
-	0x5c, 0xef, 0xce, 0x11, 0x8f, 0x9d, 0xb1, 0xe1, 0x98, 0x38, 0x1d, 0xd3, 0xa4, 0xe3, 0xdf, 0x30,
-	0x26, 0x65, 0xb3, 0x7e, 0x56, 0x85, 0x35, 0xc3, 0x53, 0xbf, 0x9c, 0x86, 0xc7, 0xce, 0xf4, 0x57,
-	0xae, 0xf7, 0x57, 0xae, 0xf7, 0x4e, 0xd7, 0xfb, 0x2f, 0x65, 0x58, 0x51, 0x96, 0xf3, 0xcb, 0xeb,
-	0x9c, 0x1a, 0x29, 0x5c, 0xf5, 0xf6, 0x14, 0xee, 0x09, 0x54, 0xcf, 0xa3, 0xc0, 0x57, 0x3d, 0xc5,
-	0x66, 0x37, 0xf3, 0xbd, 0xe8, 0x29, 0x90, 0xc4, 0x9e, 0x42, 0x6d, 0xea, 0x25, 0x91, 0x9f, 0xfe,
-	0xc0, 0xd0, 0xb8, 0x08, 0xb2, 0x18, 0x9e, 0x44, 0x3e, 0xdb, 0x80, 0xc6, 0xc9, 0x34, 0xbc, 0x18,
-	0x29, 0x6f, 0x51, 0x31, 0x39, 0xf1, 0x56, 0xd9, 0x19, 0x99, 0x7d, 0x0e, 0xab, 0xd3, 0xf4, 0x16,
-	0xc9, 0x11, 0xe9, 0x8f, 0xc2, 0x8b, 0x97, 0xcc, 0x2e, 0xb2, 0x6e, 0xb5, 0x61, 0x45, 0x49, 0x52,
-	0x97, 0x4d, 0xff, 0xb0, 0x04, 0xcb, 0xaa, 0x42, 0x2b, 0x17, 0xb0, 0x60, 0x99, 0xde, 0x09, 0xf9,
-	0x74, 0x33, 0x87, 0x63, 0x0f, 0x60, 0x91, 0xcb, 0x02, 0x99, 0x4c, 0x3a, 0x15, 0x44, 0xa9, 0x3b,
-	0x95, 0xa7, 0xd4, 0xcf, 0xc0, 0x5c, 0x5d, 0x14, 0xa3, 0xd1, 0xb9, 0x47, 0x4e, 0x86, 0xb1, 0x46,
-	0xa9, 0x57, 0xce, 0x6d, 0xe4, 0xd7, 0xa1, 0x1c, 0x5f, 0xaa, 0xc8, 0xd5, 0xea, 0x9a, 0x24, 0xbb,
-	0x1c, 0x5f, 0x22, 0x59, 0x5c, 0xa6, 0xc5, 0x84, 0x3c, 0x59, 0x5c, 0x5a, 0x7f, 0x54, 0x85, 0x07,
-	0xf9, 0x59, 0xff, 0x1f, 0x35, 0xc2, 0x0c, 0x1b, 0x84, 0x5f, 0x92, 0x0d, 0x3e, 0x85, 0x5a, 0x10,
-	0x06, 0xdc, 0xa7, 0x56, 0x96, 0xc1, 0x85, 0x71, 0x99, 0x4a, 0xfc, 0x48, 0xcc, 0x5b, 0xea, 0xbb,
-	0x6f, 0x6d, 0xa9, 0x8f, 0xde, 0xd8, 0x52, 0xd9, 0xa7, 0xb0, 0x1c, 0x18, 0x3a, 0xed, 0x3c, 0xcb,
-	0x07, 0xa8, 0x9c, 0xbe, 0x73, 0x9c, 0xf8, 0x8a, 0xd7, 0xaa, 0xd6, 0x46, 0xfe, 0x8b, 0x2c, 0x33,
-	0xda, 0x4b, 0x26, 0xba, 0xb7, 0x92, 0xbe, 0x1e, 0x09, 0x28, 0x76, 0x23, 0x2a, 0x6f, 0xd5, 0x8d,
-	0x60, 0x8f, 0xa0, 0xec, 0xfa, 0xe9, 0xe3, 0xd0, 0x2c, 0x7d, 0xed, 0x2c, 0xd8, 0x65, 0xd7, 0x67,
-	0x0f, 0xa1, 0xec, 0xf8, 0x2a, 0x65, 0x80, 0x6e, 0xfa, 0x94, 0xb5, 0xcb, 0x8e, 0x8f, 0x83, 0x13,
-	0x3f, 0xad, 0x57, 0xe6, 0x5d, 0x9e, 0x5d, 0x4e, 0x7c, 0xf6, 0x01, 0x94, 0x03, 0x5f, 0xfd, 0x6c,
-	0xe2, 0x5b, 0xdd, 0xf9, 0x76, 0x6d, 0x97, 0x03, 0x7f, 0x6b, 0x15, 0x5a, 0x69, 0x9e, 0x85, 0x47,
-	0xdf, 0x38, 0x53, 0x3f, 0xc5, 0xa5, 0xe6, 0x12, 0x6b, 0x40, 0xed, 0xc8, 0x1b, 0x86, 0x51, 0x7b,
-	0x81, 0x2d, 0x43, 0xfd, 0xc8, 0x93, 0x9d, 0xa3, 0x76, 0x49, 0x12, 0x36, 0xa3, 0xa8, 0x5d, 0x61,
-	0x2d, 0x68, 0x1c, 0x79, 0x6a, 0xf1, 0x76, 0x95, 0xdd, 0x83, 0xd5, 0x23, 0x2f, 0xd7, 0xf1, 0x69,
-	0xd7, 0xd8, 0x7d, 0x58, 0x4b, 0x91, 0x7a, 0xfd, 0xf6, 0xe2, 0xc6, 0xe7, 0xd0, 0x2e, 0xfe, 0xf5,
-	0x89, 0x01, 0x2c, 0x1e, 0x45, 0x68, 0x45, 0xed, 0x05, 0x9a, 0x3a, 0x52, 0xa5, 0xaa, 0x76, 0x49,
-	0x82, 0x6a, 0x96, 0x76, 0x79, 0xe3, 0xaf, 0x4b, 0x50, 0xd7, 0x3f, 0x5d, 0x64, 0x4d, 0x58, 0x1a,
-	0x0c, 0x0f, 0x37, 0x77, 0x07, 0xbd, 0xf6, 0x82, 0x04, 0x06, 0xaf, 0x06, 0x9b, 0xbb, 0xed, 0x12,
-	0x5b, 0x87, 0x76, 0x6f, 0xff, 0xab, 0xe1, 0xee, 0xfe, 0x66, 0xef, 0x47, 0xa3, 0x57, 0x9b, 0xf6,
-	0xab, 0x7e, 0xaf, 0x5d, 0x66, 0x2b, 0x00, 0x1a, 0xdb, 0xef, 0xc9, 0x53, 0xf4, 0xfa, 0xbb, 0x83,
-	0xc3, 0xbe, 0xdd, 0xef, 0xb5, 0xab, 0x08, 0x0e, 0x86, 0xa3, 0x57, 0x9b, 0xbb, 0xbb, 0xfd, 0x5e,
-	0xbb, 0x86, 0x13, 0x6e, 0xed, 0xef, 0xbf, 0x1a, 0x0c, 0x5f, 0xb6, 0x17, 0x11, 0xb0, 0x5f, 0x0f,
-	0x87, 0x08, 0x2c, 0x21, 0xb0, 0xb3, 0xb9, 0x4b, 0x94, 0x3a, 0xee, 0x1d, 0x81, 0x7e, 0xaf, 0xdd,
-	0xc0, 0x05, 0xec, 0x3e, 0xad, 0x87, 0x34, 0x40, 0xc6, 0x83, 0xd7, 0xf6, 0x4b, 0x04, 0x9a, 0x1b,
-	0xa7, 0xb0, 0x6c, 0xfe, 0x00, 0x97, 0xd5, 0xa1, 0x3a, 0xdc, 0x1f, 0xf6, 0xdb, 0x0b, 0x38, 0xc5,
-	0xe6, 0xf6, 0xab, 0xc1, 0x61, 0xbf, 0x5d, 0x42, 0x91, 0xbf, 0x3e, 0xe8, 0x6d, 0xd2, 0x04, 0x65,
-	0xdc, 0x92, 0xdd, 0xd7, 0xbb, 0xa8, 0xe0, 0x7c, 0xaf, 0xfa, 0x23, 0x02, 0xaa, 0xc8, 0xf9, 0xc5,
-	0xe6, 0xee, 0xee, 0xd6, 0xe6, 0xf6, 0x97, 0xed, 0x1a, 0xce, 0xf1, 0xc5, 0xe6, 0x00, 0x77, 0xbe,
-	0xb8, 0xf1, 0xc7, 0x25, 0x68, 0xe5, 0x7e, 0x6f, 0xc7, 0x56, 0xa1, 0x79, 0x78, 0x30, 0xfc, 0x51,
-	0x26, 0xad, 0x14, 0xa1, 0x25, 0xc6, 0x60, 0x05, 0x11, 0xdb, 0xfb, 0xc3, 0x61, 0x7f, 0x5b, 0xad,
-	0x7e, 0x0f, 0x56, 0x11, 0x87, 0x27, 0xda, 0xda, 0x1d, 0x8c, 0x76, 0x48, 0x68, 0x6b, 0xd0, 0x92,
-	0x23, 0xb5, 0xa4, 0xaa, 0x7a, 0x32, 0xbb, 0xff, 0x65, 0xff, 0x07, 0x24, 0x3a, 0x85, 0xe8, 0xf5,
-	0x77, 0xfb, 0x28, 0x18, 0xd8, 0xd8, 0x81, 0x25, 0xd5, 0x5d, 0x23, 0x5d, 0x7b, 0xa1, 0xb4, 0x2f,
-	0xf9, 0xdd, 0x17, 0xa7, 0xed, 0x92, 0xfa, 0x7e, 0x3d, 0xda, 0x6a, 0x97, 0xd5, 0xf7, 0xf6, 0xfe,
-	0x1e, 0x29, 0xa9, 0x7e, 0xe4, 0x85, 0xfb, 0xe2, 0x94, 0xc7, 0xed, 0xff, 0x29, 0x6d, 0xbc, 0x80,
-	0xe5, 0x23, 0x59, 0x80, 0xcb, 0xac, 0xd5, 0xcf, 0xac, 0xd5, 0xcf, 0x59, 0xab, 0x4f, 0xd6, 0xba,
-	0x71, 0x02, 0x2b, 0xf9, 0xca, 0x23, 0x9e, 0x2c, 0xc3, 0xc8, 0xb9, 0x17, 0xf2, 0xc8, 0x97, 0xce,
-	0x8c, 0xec, 0xef, 0x3e, 0xac, 0x65, 0x48, 0xf5, 0xa7, 0x18, 0x29, 0x9a, 0x0c, 0x4d, 0x32, 0x6e,
-	0x57, 0xb6, 0x7a, 0xf0, 0x68, 0x1c, 0xfa, 0xdd, 0xaf, 0xb9, 0xcb, 0x5d, 0xa7, 0x4b, 0x55, 0xe5,
-	0xee, 0x4c, 0xe5, 0x0d, 0xd2, 0x39, 0x1c, 0x3d, 0x99, 0x78, 0xe2, 0x74, 0x76, 0xdc, 0x1d, 0x87,
-	0xfe, 0x73, 0xc9, 0xf7, 0x9c, 0x9f, 0xf3, 0xe7, 0x89, 0x7b, 0xf6, 0x7c, 0x12, 0x3e, 0xff, 0xda,
-	0xe7, 0xe2, 0x78, 0x91, 0x38, 0x3f, 0xfa, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x6e, 0x7f,
-	0x3b, 0x3d, 0x3c, 0x00, 0x00,
+	// 7207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x8c, 0x24, 0xc9,
+	0xd5, 0xd0, 0xd4, 0x5f, 0x77, 0xd5, 0xab, 0xae, 0xee, 0xec, 0x98, 0x9f, 0xad, 0x9d, 0x1d, 0xef,
+	0xcc, 0xe6, 0xfe, 0x8d, 0x7b, 0xed, 0x9a, 0xf5, 0x78, 0x19, 0x2d, 0x66, 0x41, 0x54, 0x77, 0xd7,
+	0x6e, 0x17, 0xdb, 0x5d, 0xdd, 0x8e, 0xea, 0x99, 0xc5, 0x8d, 0xcc, 0x2a, 0x3b, 0x2b, 0xba, 0x3a,
+	0xe9, 0xaa, 0xcc, 0xdc, 0xcc, 0xac, 0xfe, 0xd9, 0x13, 0xb2, 0x2c, 0x81, 0xe4, 0x03, 0x12, 0x48,
+	0xb6, 0xc4, 0x11, 0x09, 0x99, 0x13, 0x42, 0xe6, 0x60, 0x4e, 0x5c, 0x90, 0x38, 0x81, 0x25, 0x40,
+	0x20, 0x21, 0x7e, 0x24, 0x2c, 0xc4, 0x11, 0x09, 0x0e, 0xc8, 0x42, 0x48, 0x7c, 0x7a, 0x2f, 0x22,
+	0x32, 0x23, 0xb3, 0xaa, 0xa7, 0x67, 0xfd, 0x49, 0x96, 0x3e, 0xc9, 0xa7, 0xca, 0xf7, 0x13, 0x91,
+	0x11, 0x2f, 0x5e, 0xbc, 0x78, 0x2f, 0xde, 0xcb, 0x02, 0xf8, 0x7a, 0x2a, 0x92, 0x4e, 0x18, 0x05,
+	0x49, 0x70, 0xff, 0xe1, 0x38, 0x08, 0xc6, 0x13, 0xf1, 0x84, 0xa0, 0xe3, 0xd9, 0xc9, 0x93, 0xc4,
+	0x9b, 0x8a, 0x38, 0x71, 0xa6, 0xa1, 0x64, 0xb0, 0x7f, 0x5d, 0x86, 0xf5, 0xa3, 0xbe, 0x7f, 0x12,
+	0xec, 0x39, 0xfe, 0xec, 0xc4, 0x71, 0x93, 0x59, 0x24, 0x22, 0x66, 0xc3, 0xca, 0xd4, 0x80, 0xdb,
+	0xa5, 0x47, 0xa5, 0xc7, 0x0d, 0x9e, 0xc3, 0xb1, 0x47, 0xd0, 0x0c, 0xa3, 0x60, 0x34, 0x73, 0x93,
+	0x81, 0x33, 0x15, 0xed, 0x32, 0xb1, 0x98, 0x28, 0xd6, 0x86, 0xe5, 0x73, 0x11, 0xc5, 0x5e, 0xe0,
+	0xb7, 0x2b, 0x44, 0xd5, 0x20, 0xf6, 0x1f, 0x8b, 0xc8, 0x73, 0x26, 0x83, 0xd9, 0xf4, 0x58, 0x44,
+	0xed, 0xaa, 0xec, 0xdf, 0xc4, 0x31, 0x06, 0xd5, 0xe7, 0xcf, 0xfb, 0xdb, 0xed, 0x1a, 0xd1, 0xe8,
+	0x99, 0xbd, 0x09, 0xe0, 0x06, 0xd3, 0xd0, 0x49, 0xbc, 0xe3, 0x89, 0x68, 0x2f, 0x11, 0xc5, 0xc0,
+	0x20, 0xfd, 0xd8, 0x0b, 0xe2, 0x17, 0xc2, 0x1f, 0x05, 0x51, 0x7b, 0x59, 0xd2, 0x33, 0x0c, 0x8e,
+	0x59, 0x42, 0x72, 0x54, 0x75, 0x39, 0x66, 0x03, 0xc5, 0x1e, 0xc3, 0x1a, 0x82, 0x5c, 0x4c, 0x84,
+	0x13, 0x8b, 0x6d, 0x27, 0x11, 0xed, 0x06, 0x71, 0x15, 0xd1, 0xf6, 0x7f, 0x2e, 0xc3, 0x0a, 0x49,
+	0x6e, 0x20, 0x92, 0x8b, 0x20, 0x3a, 0xc3, 0xe9, 0x4e, 0x1d, 0xb7, 0x3b, 0x1a, 0x45, 0x7a, 0xba,
+	0x0a, 0x44, 0xca, 0x48, 0x9c, 0x93, 0x98, 0xe4, 0x4c, 0x35, 0x88, 0x94, 0xfe, 0x01, 0xf2, 0xc4,
+	0xed, 0xda, 0xa3, 0x0a, 0x52, 0x14, 0xc8, 0xde, 0x83, 0xd5, 0x91, 0x38, 0x71, 0x66, 0x93, 0x84,
+	0x07, 0xb3, 0x44, 0x44, 0x71, 0x7b, 0x89, 0x18, 0x0a, 0x58, 0xf6, 0x06, 0x54, 0x46, 0x7e, 0x4c,
+	0x73, 0x6d, 0x3e, 0x6d, 0x74, 0x68, 0x44, 0xdb, 0x83, 0x21, 0x47, 0x2c, 0x5b, 0x85, 0xf2, 0x2c,
+	0xa4, 0x69, 0xd6, 0x79, 0x79, 0x16, 0xb2, 0xb7, 0xa1, 0x3e, 0x09, 0x5c, 0x27, 0xc1, 0xc9, 0x37,
+	0xa8, 0xc5, 0x72, 0xe7, 0x33, 0x11, 0xec, 0x06, 0x2e, 0x4f, 0x09, 0xec, 0x1e, 0x2c, 0xcd, 0xc2,
+	0x89, 0xe7, 0x9f, 0xb5, 0x81, 0x1a, 0x2a, 0x88, 0x6d, 0x00, 0xf8, 0x72, 0xaa, 0xbd, 0x28, 0x6a,
+	0x37, 0xa9, 0x39, 0x74, 0x7a, 0x51, 0x14, 0x44, 0xf8, 0x52, 0x6e, 0x50, 0xd9, 0x03, 0x68, 0x60,
+	0x7f, 0x13, 0x9a, 0xf3, 0x0a, 0xcd, 0x39, 0x43, 0x30, 0x1b, 0x6a, 0x61, 0x14, 0x5c, 0x5e, 0xb5,
+	0x5b, 0xd4, 0xc9, 0x4a, 0xe7, 0x00, 0xa1, 0x61, 0xe2, 0x24, 0xb3, 0x98, 0x4b, 0x92, 0xfd, 0x2f,
+	0x4b, 0xb0, 0x24, 0x87, 0x86, 0xab, 0xfa, 0xdc, 0x1f, 0x89, 0x68, 0xe2, 0x5c, 0xf5, 0x0f, 0x94,
+	0x2e, 0x1a, 0x18, 0x76, 0x1f, 0xea, 0x3b, 0x41, 0x9c, 0xf8, 0x99, 0x1a, 0xa6, 0x30, 0x6a, 0xd1,
+	0x96, 0x97, 0x5c, 0xa9, 0x15, 0xa1, 0x67, 0x9c, 0x20, 0x17, 0x63, 0x94, 0x81, 0x5c, 0x0d, 0x05,
+	0xe1, 0x62, 0x6c, 0x05, 0x33, 0x3f, 0x89, 0xae, 0x94, 0xd2, 0x69, 0x90, 0x59, 0x50, 0xd9, 0x0d,
+	0x5c, 0xa5, 0x70, 0xf8, 0x88, 0x98, 0xfd, 0x68, 0xac, 0x54, 0x0c, 0x1f, 0xb1, 0xd7, 0x83, 0x20,
+	0x4e, 0x9c, 0x89, 0x52, 0x2b, 0x05, 0xd9, 0x27, 0x50, 0xd7, 0x8b, 0x82, 0x33, 0xd9, 0x1e, 0x0c,
+	0x63, 0x11, 0xe1, 0x46, 0x68, 0x97, 0x68, 0x41, 0x0d, 0x0c, 0x8a, 0x6d, 0x7b, 0x30, 0x1c, 0x05,
+	0x53, 0xc7, 0xf3, 0xd5, 0x54, 0x32, 0x84, 0xa2, 0xc6, 0xc2, 0x89, 0xdc, 0xd3, 0x76, 0x85, 0x1a,
+	0x67, 0x08, 0xfb, 0x27, 0x25, 0x58, 0x3b, 0xf2, 0xfc, 0x93, 0xe0, 0x40, 0x44, 0x5e, 0x78, 0x2a,
+	0x22, 0x67, 0xc2, 0xde, 0x87, 0xda, 0xd7, 0xc9, 0x55, 0x28, 0x48, 0x68, 0xab, 0x4f, 0xd7, 0x3b,
+	0x47, 0x19, 0xf1, 0xf0, 0x2a, 0x14, 0x31, 0x97, 0x74, 0xec, 0x3a, 0x9c, 0xcc, 0xc6, 0x63, 0x07,
+	0xf7, 0x55, 0x99, 0x96, 0x3d, 0x43, 0xb0, 0xc7, 0x50, 0x9b, 0x62, 0xcf, 0x24, 0xc5, 0xe6, 0x53,
+	0xd6, 0x99, 0xb3, 0x18, 0x5c, 0x32, 0xd8, 0xff, 0xa1, 0x04, 0xcb, 0x44, 0x1c, 0x7e, 0x81, 0x7d,
+	0xc6, 0x17, 0x7a, 0xab, 0xa9, 0xc9, 0xa4, 0x08, 0x14, 0x57, 0x7c, 0xb1, 0xe3, 0xc4, 0xa7, 0x6a,
+	0x69, 0x14, 0xc4, 0x1e, 0x42, 0x2d, 0x4e, 0x70, 0xdb, 0x55, 0x69, 0xc8, 0x8d, 0xce, 0xd1, 0xf0,
+	0x02, 0x35, 0x43, 0x70, 0x89, 0xc7, 0x86, 0x89, 0x13, 0x8d, 0x45, 0xa2, 0x96, 0x43, 0x41, 0xb8,
+	0xd2, 0xe7, 0x23, 0x71, 0xae, 0x96, 0x84, 0x9e, 0xd9, 0x06, 0x58, 0xa3, 0xe0, 0xc2, 0x9f, 0x04,
+	0xce, 0xe8, 0x20, 0x0a, 0xc6, 0x91, 0x88, 0x63, 0x5a, 0x9d, 0x16, 0x9f, 0xc3, 0xe3, 0x70, 0xbd,
+	0xa9, 0x33, 0x16, 0xa4, 0xb2, 0x72, 0xcf, 0x67, 0x08, 0x7b, 0x0c, 0x8d, 0x54, 0xd3, 0xd1, 0x8c,
+	0x8c, 0x44, 0xec, 0x46, 0x5e, 0x48, 0x3b, 0x49, 0x6a, 0xa4, 0x89, 0x62, 0x1f, 0x43, 0x23, 0xb5,
+	0xb4, 0x34, 0xf7, 0xe6, 0xd3, 0xfb, 0x1d, 0x69, 0x8b, 0x3b, 0xda, 0x16, 0x77, 0x0e, 0x35, 0x07,
+	0xcf, 0x98, 0xed, 0x9f, 0x2f, 0x43, 0x53, 0xea, 0x8b, 0x38, 0xf7, 0x5c, 0x81, 0xef, 0x9a, 0x3a,
+	0xee, 0xa9, 0xe7, 0x8b, 0x2e, 0x2e, 0xbb, 0xd4, 0x58, 0x13, 0x85, 0x6a, 0xeb, 0x86, 0x33, 0xa2,
+	0x2a, 0xb5, 0x55, 0x20, 0x6e, 0x8c, 0x70, 0xe2, 0x24, 0x27, 0x41, 0x34, 0x55, 0xc2, 0x4a, 0x61,
+	0x14, 0x97, 0xef, 0x86, 0x33, 0x12, 0x57, 0x8b, 0xd3, 0x33, 0x8a, 0x76, 0x2a, 0xa6, 0x41, 0x74,
+	0x45, 0x42, 0xaa, 0x72, 0x05, 0xe1, 0x1b, 0xe2, 0x24, 0x88, 0x9c, 0xb1, 0x14, 0x4c, 0x95, 0x6b,
+	0x30, 0xd3, 0x8c, 0xe6, 0x0d, 0x9a, 0xc1, 0xde, 0x87, 0x65, 0x65, 0x1f, 0xda, 0xad, 0x47, 0x95,
+	0xc7, 0xcd, 0xa7, 0xad, 0x8e, 0x69, 0x3d, 0xb9, 0xa6, 0xb2, 0x1f, 0x00, 0x73, 0xe2, 0xd8, 0x1b,
+	0xfb, 0xa8, 0x7a, 0xdd, 0x91, 0x13, 0x92, 0xf1, 0x5b, 0xa3, 0x36, 0xd0, 0x39, 0xf2, 0x82, 0xcd,
+	0x99, 0x3f, 0x9a, 0x08, 0xbe, 0x80, 0x4b, 0x1b, 0x43, 0x6b, 0xa1, 0x31, 0x7c, 0x02, 0x4d, 0x35,
+	0xec, 0x5d, 0x2f, 0x4e, 0xda, 0xeb, 0xe6, 0x28, 0x86, 0x92, 0xc0, 0x4d, 0x0e, 0xf6, 0x0c, 0xea,
+	0xc7, 0x41, 0x90, 0xe0, 0x32, 0xb5, 0xd9, 0x8d, 0x6b, 0x98, 0xf2, 0xb2, 0xb7, 0x51, 0xb5, 0xe9,
+	0x1d, 0xb7, 0xe9, 0x1d, 0xcd, 0x8e, 0x5e, 0xd0, 0xe1, 0x17, 0x5c, 0x91, 0xb4, 0xd1, 0x22, 0x6d,
+	0xbb, 0x93, 0x19, 0x2d, 0x84, 0xd9, 0x77, 0xa1, 0x39, 0x15, 0x49, 0xe4, 0xb9, 0xfd, 0x44, 0x4c,
+	0xe3, 0xf6, 0x5d, 0xd5, 0xcb, 0x5e, 0x8a, 0xe3, 0x26, 0x1d, 0xb5, 0x7c, 0xe2, 0xc4, 0x09, 0x17,
+	0x38, 0x02, 0x2e, 0x9c, 0x38, 0xf0, 0xdb, 0xf7, 0xa8, 0xcb, 0x39, 0x3c, 0xdb, 0x84, 0xd5, 0x0c,
+	0x47, 0x33, 0x7b, 0xed, 0xc6, 0x99, 0x15, 0x5a, 0xb0, 0x8f, 0xa1, 0x15, 0x5f, 0xc5, 0x89, 0x98,
+	0x2a, 0xb9, 0xb7, 0xdb, 0x6a, 0xf1, 0x87, 0x26, 0x96, 0xce, 0x84, 0x3c, 0x23, 0x1e, 0x6a, 0x11,
+	0x76, 0x1a, 0x25, 0x64, 0x59, 0x45, 0xd4, 0x7e, 0x9d, 0xd4, 0xaf, 0x80, 0x65, 0x1f, 0x41, 0x6b,
+	0xe4, 0x24, 0xce, 0x50, 0xb8, 0xdd, 0x84, 0x8b, 0x38, 0x69, 0xdf, 0xa7, 0x37, 0xac, 0x76, 0xb6,
+	0x4d, 0x2c, 0xcf, 0x33, 0xb1, 0x0f, 0x01, 0x46, 0xb4, 0x69, 0xb6, 0x44, 0x94, 0xb4, 0xdf, 0xa0,
+	0x26, 0x56, 0xc7, 0xd8, 0x4c, 0x88, 0xe7, 0x06, 0x0f, 0xdb, 0x80, 0xba, 0xeb, 0x84, 0x8e, 0x8b,
+	0x27, 0xc4, 0x03, 0xf5, 0x0a, 0xe2, 0xdf, 0x52, 0x58, 0x9e, 0xd2, 0xed, 0xbf, 0x5f, 0x86, 0x56,
+	0x8e, 0x86, 0x5b, 0x33, 0x09, 0x12, 0x67, 0xb2, 0x27, 0xf7, 0x4c, 0x89, 0xb6, 0x86, 0x89, 0x52,
+	0xf3, 0x45, 0xe3, 0x3e, 0x52, 0x4c, 0x65, 0x62, 0x2a, 0x60, 0xd1, 0xeb, 0x70, 0x26, 0x74, 0x00,
+	0xa7, 0x8c, 0x15, 0x62, 0x2c, 0xa2, 0xd3, 0x6d, 0x5b, 0x35, 0xb6, 0xed, 0x9b, 0x00, 0xa1, 0xe7,
+	0xfb, 0x62, 0xb4, 0x15, 0xce, 0x62, 0x65, 0x03, 0x0c, 0x0c, 0xea, 0x87, 0xb8, 0x74, 0x27, 0xb3,
+	0xd8, 0x3b, 0x17, 0x07, 0x9e, 0xef, 0x7b, 0xfe, 0x98, 0xcc, 0x41, 0x9d, 0xcf, 0xe1, 0xd9, 0x9f,
+	0x83, 0xa6, 0x7a, 0xa5, 0x17, 0x90, 0x5b, 0x81, 0xaa, 0x77, 0x5b, 0x0a, 0xa5, 0x1b, 0x86, 0xdd,
+	0x94, 0xc6, 0x4d, 0x3e, 0xfb, 0x9f, 0x94, 0x80, 0xcd, 0xf3, 0xe0, 0x68, 0x67, 0x33, 0x6f, 0xa4,
+	0x2c, 0x24, 0x3d, 0xd3, 0x0c, 0xb2, 0x93, 0x9a, 0x9e, 0x0d, 0xc3, 0x53, 0xc9, 0x19, 0x9e, 0x3b,
+	0x50, 0x3b, 0x77, 0x71, 0x52, 0x72, 0xba, 0x12, 0xc0, 0x1e, 0xdc, 0x6c, 0xa6, 0xf4, 0x8c, 0xdb,
+	0xc9, 0x19, 0x4d, 0xbd, 0x24, 0x11, 0x23, 0x35, 0xb7, 0x14, 0xc6, 0x5e, 0x04, 0xda, 0x6e, 0x75,
+	0x34, 0x48, 0xc0, 0xfe, 0x2f, 0x65, 0x58, 0x2b, 0xe8, 0x06, 0x9a, 0x6d, 0x3f, 0x48, 0x36, 0xc5,
+	0x49, 0x10, 0xc9, 0x33, 0xf3, 0x06, 0xb3, 0x9d, 0x32, 0xa3, 0xad, 0xf0, 0x83, 0xa4, 0x7b, 0x82,
+	0x3a, 0x7d, 0xb3, 0xbd, 0x4f, 0x79, 0xe7, 0x3c, 0xe1, 0xca, 0x02, 0x4f, 0xf8, 0x2f, 0x43, 0x4b,
+	0xee, 0x40, 0x5f, 0x5c, 0xd0, 0x96, 0xad, 0xde, 0xf8, 0x82, 0x7c, 0x03, 0xd4, 0xc3, 0x14, 0x41,
+	0xc7, 0x98, 0x92, 0x5d, 0x01, 0xcb, 0xfe, 0x0a, 0xb0, 0x3c, 0x86, 0x5e, 0xb7, 0x74, 0xe3, 0xeb,
+	0x16, 0xb4, 0xb2, 0xff, 0x77, 0x09, 0x5a, 0xb9, 0xed, 0xca, 0xbe, 0xad, 0x8f, 0x76, 0xe9, 0x8d,
+	0xdc, 0xce, 0xef, 0xe6, 0xdc, 0x21, 0xff, 0x08, 0x9a, 0x67, 0xe2, 0xea, 0x20, 0x0a, 0xce, 0xbd,
+	0x91, 0x92, 0x68, 0x83, 0x9b, 0x28, 0x54, 0x82, 0xd0, 0x8d, 0x62, 0xf2, 0x83, 0x5a, 0x9c, 0x9e,
+	0x55, 0xab, 0x5e, 0xec, 0x46, 0xc1, 0x85, 0x18, 0x91, 0x98, 0xea, 0xdc, 0x44, 0x91, 0x5f, 0xea,
+	0xc4, 0x89, 0x29, 0x83, 0x0c, 0xa1, 0x05, 0xfd, 0x4d, 0x66, 0x9e, 0x6f, 0x60, 0x1f, 0xc3, 0xfa,
+	0x9c, 0x11, 0xc4, 0x35, 0x76, 0x67, 0x51, 0x24, 0xfc, 0xa4, 0xef, 0x8f, 0xc4, 0x25, 0x4d, 0xbf,
+	0xc5, 0x73, 0x38, 0xf6, 0x6d, 0x58, 0x8a, 0xc9, 0xff, 0x6d, 0x97, 0x69, 0xcb, 0xad, 0x77, 0xa4,
+	0x5a, 0x1e, 0x04, 0x51, 0xa2, 0x1c, 0x63, 0xc5, 0x60, 0xff, 0xaf, 0x32, 0x58, 0x45, 0xa2, 0x19,
+	0x6b, 0xc9, 0xee, 0x35, 0x88, 0x9e, 0xea, 0x99, 0xb8, 0x52, 0x22, 0xc4, 0x47, 0xf6, 0x97, 0x60,
+	0x05, 0xfd, 0x8d, 0x83, 0xc8, 0x0b, 0x22, 0xed, 0x1b, 0xbf, 0x7c, 0x96, 0x39, 0x7e, 0xf6, 0x03,
+	0x00, 0x9c, 0xf5, 0xa7, 0x8e, 0x37, 0x51, 0x52, 0x7e, 0x79, 0x6b, 0x83, 0x5b, 0x8b, 0x78, 0x38,
+	0x73, 0x5d, 0x21, 0x46, 0x62, 0xd4, 0xae, 0xdd, 0xd8, 0x3c, 0xdf, 0x80, 0xbd, 0x05, 0xb5, 0x30,
+	0x88, 0x12, 0x19, 0x0f, 0xe1, 0xb1, 0x98, 0xc9, 0x82, 0x4b, 0x4a, 0x7e, 0x95, 0x97, 0x8b, 0xab,
+	0xfc, 0x14, 0x9a, 0x09, 0x9e, 0x1e, 0x22, 0x9e, 0x4d, 0x12, 0xf4, 0x07, 0x2b, 0xf2, 0x9c, 0xc0,
+	0x1e, 0x0e, 0x53, 0x02, 0x37, 0x99, 0xec, 0x9f, 0x54, 0x01, 0xb2, 0xf7, 0xa0, 0xbd, 0xf2, 0x4e,
+	0xc8, 0x8a, 0x49, 0xcb, 0xa6, 0xa0, 0xeb, 0x6c, 0x9b, 0x17, 0xef, 0x8d, 0xa7, 0x09, 0xc9, 0xb9,
+	0xce, 0x15, 0x84, 0xbc, 0x27, 0x91, 0x10, 0x4a, 0x4b, 0xe9, 0x19, 0xad, 0xd8, 0xe8, 0xd4, 0x0d,
+	0xd1, 0x35, 0x27, 0x8f, 0xaa, 0xc5, 0x53, 0x18, 0xfb, 0x89, 0x67, 0xc7, 0xbe, 0x48, 0x54, 0x3c,
+	0xa5, 0x20, 0x5c, 0xf9, 0xb1, 0x93, 0x88, 0x0b, 0x47, 0x86, 0x53, 0x0d, 0xae, 0x41, 0x3c, 0x17,
+	0x64, 0xe4, 0x40, 0x63, 0x5a, 0x25, 0xa2, 0x81, 0x41, 0x31, 0xf9, 0x49, 0x38, 0xa4, 0xd8, 0xa3,
+	0xbd, 0x26, 0xc5, 0x94, 0x22, 0xa8, 0xb5, 0x1f, 0x0f, 0x55, 0xac, 0x62, 0xc9, 0x58, 0x25, 0xc3,
+	0xa0, 0x56, 0xe3, 0xd8, 0xb8, 0xe3, 0x8f, 0xc5, 0x6e, 0x70, 0xd1, 0x5e, 0x97, 0x96, 0xcb, 0xc4,
+	0xb1, 0x77, 0xa0, 0x95, 0xc2, 0x3b, 0xde, 0xf8, 0x94, 0xdc, 0xa8, 0x06, 0xcf, 0x23, 0xb3, 0x70,
+	0xf0, 0xee, 0xb5, 0xe1, 0x20, 0x8e, 0xe6, 0x7c, 0xe2, 0xf8, 0x07, 0x0e, 0x6e, 0x19, 0xe5, 0xdd,
+	0x18, 0x18, 0x94, 0x0e, 0x42, 0xfd, 0x11, 0xf9, 0x33, 0x2d, 0xae, 0x20, 0xf6, 0x26, 0x54, 0x2f,
+	0xbc, 0x13, 0x4f, 0xb9, 0x28, 0x20, 0x0f, 0xb2, 0x2f, 0xbc, 0x13, 0x8f, 0x13, 0x9e, 0x3c, 0x00,
+	0x31, 0x99, 0xcc, 0x26, 0x8e, 0xf4, 0x45, 0x32, 0x0f, 0x40, 0x61, 0x79, 0x4a, 0xb7, 0x7f, 0x5b,
+	0x82, 0xa6, 0x31, 0x34, 0xf6, 0x2e, 0x2c, 0xe3, 0xe0, 0x3c, 0x21, 0x43, 0x39, 0xd4, 0x45, 0x22,
+	0xf7, 0x30, 0x66, 0xe4, 0x9a, 0x86, 0x43, 0x17, 0x97, 0xae, 0x08, 0xe5, 0x89, 0x2a, 0x55, 0xc3,
+	0xc0, 0xe0, 0x02, 0x86, 0x8e, 0x7b, 0xe2, 0x4d, 0x84, 0xbe, 0x37, 0x50, 0x20, 0xeb, 0x00, 0x53,
+	0x5e, 0xb1, 0xea, 0x97, 0xc2, 0x33, 0xa9, 0x30, 0x0b, 0x28, 0xe8, 0x46, 0x98, 0xd8, 0xe7, 0x7c,
+	0x57, 0xd9, 0xb8, 0x22, 0x1a, 0xdf, 0x79, 0x11, 0x3a, 0x23, 0xe4, 0x90, 0x81, 0x81, 0x06, 0xed,
+	0x5d, 0x80, 0x6c, 0x12, 0xa8, 0xa4, 0x69, 0xfc, 0xd8, 0xe2, 0xf4, 0x4c, 0x8a, 0x28, 0x75, 0xa6,
+	0xac, 0x14, 0x91, 0x20, 0xb2, 0xc8, 0x41, 0x24, 0xd5, 0x1c, 0x2d, 0x72, 0x10, 0x25, 0xf6, 0x3f,
+	0xaa, 0x00, 0x64, 0xce, 0x2f, 0x6a, 0x9c, 0xe3, 0x26, 0xde, 0x39, 0x3a, 0x34, 0x3a, 0xcc, 0x4c,
+	0x11, 0x78, 0x4a, 0x85, 0x4e, 0x94, 0x78, 0x28, 0x96, 0x5d, 0xe7, 0x58, 0x4c, 0x94, 0x3c, 0x0a,
+	0x58, 0x9c, 0x66, 0x8a, 0x91, 0x9b, 0x52, 0x85, 0x45, 0x45, 0x74, 0xae, 0x47, 0x3a, 0x5f, 0xf4,
+	0xb9, 0x97, 0xc7, 0xb2, 0xb7, 0x52, 0xeb, 0xbb, 0x54, 0x8c, 0x3a, 0x15, 0x81, 0x0e, 0xea, 0xd3,
+	0x20, 0x4a, 0x74, 0x40, 0xbb, 0xac, 0x0e, 0x6a, 0x03, 0x87, 0xe7, 0xcf, 0x24, 0xf0, 0xc7, 0x85,
+	0xeb, 0x25, 0x03, 0xc5, 0x1e, 0x41, 0x2d, 0xc6, 0x33, 0xb2, 0xdd, 0x98, 0xbb, 0x3e, 0x91, 0x84,
+	0x85, 0x21, 0x2b, 0x5c, 0x13, 0xb2, 0x7e, 0x17, 0x60, 0x16, 0x8b, 0x48, 0xaa, 0x23, 0x19, 0x8c,
+	0xd5, 0xa7, 0xad, 0xce, 0xa6, 0x13, 0x8b, 0xfd, 0x58, 0x22, 0xb9, 0xc1, 0x40, 0x01, 0xf9, 0xec,
+	0x58, 0x71, 0xab, 0x4b, 0x99, 0x14, 0x61, 0xff, 0xb4, 0x04, 0x2b, 0x66, 0x2c, 0x84, 0xeb, 0x2c,
+	0x5d, 0x65, 0x6d, 0xe4, 0x24, 0x84, 0xdd, 0x4c, 0xd1, 0x4f, 0x3f, 0x70, 0x92, 0x53, 0x1d, 0xd7,
+	0xa7, 0x08, 0x74, 0xb6, 0xc8, 0x03, 0x56, 0x9e, 0x9c, 0x04, 0x70, 0xc9, 0x74, 0x64, 0xa5, 0xef,
+	0x9f, 0xa4, 0x1a, 0x17, 0xd1, 0xf6, 0xbf, 0xae, 0xa8, 0xfb, 0x92, 0x6e, 0x18, 0x62, 0x67, 0xdd,
+	0x30, 0xec, 0x6f, 0xab, 0x11, 0x48, 0x00, 0x37, 0x94, 0x13, 0x86, 0xf9, 0x9b, 0x05, 0x03, 0x43,
+	0xf3, 0x94, 0x87, 0x70, 0x18, 0x2a, 0x67, 0x30, 0x43, 0xa0, 0xea, 0x77, 0xc3, 0x90, 0xe2, 0x2e,
+	0xb9, 0x86, 0x1a, 0x64, 0xdf, 0x81, 0x95, 0x38, 0x38, 0x49, 0x2e, 0x9c, 0x48, 0x46, 0x88, 0xf2,
+	0x64, 0xa8, 0xab, 0x08, 0xf1, 0x0b, 0x9e, 0xa3, 0xe6, 0xa2, 0xc3, 0x95, 0x6f, 0x10, 0x1d, 0x3e,
+	0x03, 0x4b, 0x46, 0xae, 0x62, 0x94, 0x46, 0xb7, 0xad, 0xb9, 0xe8, 0x76, 0x8e, 0x87, 0xd9, 0xb0,
+	0xe4, 0x84, 0x21, 0xea, 0xce, 0xea, 0xa3, 0x4a, 0x41, 0x77, 0x14, 0x25, 0xbb, 0x3c, 0x59, 0xbb,
+	0xe6, 0xf2, 0xc4, 0x88, 0xc2, 0xad, 0x97, 0x46, 0xe1, 0xef, 0xc3, 0xd2, 0xa9, 0x70, 0x26, 0xc9,
+	0x29, 0xd9, 0xf5, 0xe6, 0xd3, 0xb5, 0x34, 0x04, 0xd8, 0x21, 0x34, 0x57, 0x64, 0x5c, 0x8c, 0x0b,
+	0xc7, 0x4b, 0x3c, 0x7f, 0xfc, 0x69, 0x10, 0x29, 0xfb, 0x6e, 0x60, 0xec, 0xff, 0x5e, 0x86, 0xd5,
+	0x7c, 0x53, 0xf6, 0x5e, 0xde, 0x0f, 0xb4, 0x3a, 0x47, 0x29, 0x2d, 0x37, 0xd8, 0x8f, 0xa1, 0x41,
+	0x0f, 0x24, 0xe2, 0x57, 0xb8, 0x44, 0x49, 0x99, 0xb5, 0xbf, 0x7b, 0x10, 0x05, 0xc7, 0x42, 0x7a,
+	0x01, 0x95, 0xcc, 0xdf, 0xcd, 0xb0, 0xec, 0x43, 0xb8, 0xed, 0x06, 0x7e, 0x2c, 0xdc, 0x59, 0xe2,
+	0x9d, 0x0b, 0x74, 0x51, 0x66, 0x91, 0xd0, 0xd1, 0xc6, 0x22, 0x12, 0x9a, 0x01, 0x33, 0x56, 0x25,
+	0x7b, 0xd2, 0xe2, 0x39, 0x1c, 0xdb, 0x86, 0x35, 0xe9, 0x0f, 0x13, 0xee, 0x15, 0x1d, 0xc9, 0x62,
+	0x13, 0xf6, 0x1d, 0x58, 0x37, 0x50, 0x2a, 0xac, 0x97, 0x1a, 0x3b, 0x4f, 0xb0, 0xff, 0x3a, 0x58,
+	0x24, 0xe5, 0x17, 0xa1, 0xbf, 0xeb, 0xf9, 0x67, 0xf8, 0x88, 0xbb, 0x27, 0x0e, 0xbd, 0xbe, 0x0e,
+	0xbf, 0x24, 0xa0, 0xfc, 0x88, 0x81, 0x48, 0x52, 0xf3, 0x4d, 0x10, 0xee, 0x9a, 0x91, 0x17, 0x09,
+	0x37, 0xd1, 0xf7, 0xf5, 0x75, 0x9e, 0x21, 0xec, 0xff, 0xa3, 0xad, 0x83, 0x7a, 0x01, 0x5e, 0x2d,
+	0xa7, 0x81, 0x5d, 0xf9, 0x9a, 0xb0, 0xee, 0x0e, 0xd4, 0x22, 0xf1, 0x55, 0x7f, 0xa4, 0xa4, 0x2f,
+	0x01, 0x74, 0x72, 0x3c, 0x3f, 0x4e, 0xd2, 0x48, 0xa6, 0xca, 0x53, 0x18, 0x37, 0xa7, 0x88, 0x43,
+	0x7c, 0x8f, 0xbe, 0xcb, 0x52, 0x20, 0x7b, 0x47, 0x2b, 0x8d, 0xb4, 0xd0, 0xea, 0x94, 0x7e, 0x11,
+	0xfa, 0x05, 0xfd, 0xae, 0x4d, 0xa8, 0x35, 0x90, 0xc0, 0xd7, 0x3b, 0x45, 0xa1, 0x70, 0x49, 0x47,
+	0x46, 0xda, 0x3a, 0xed, 0xe6, 0xb5, 0x8c, 0x44, 0xb7, 0x07, 0x99, 0x60, 0x7b, 0xfe, 0xe8, 0x20,
+	0xf0, 0xfc, 0x64, 0x6e, 0xee, 0xe8, 0xe2, 0x85, 0x74, 0xf1, 0xaf, 0x44, 0x2a, 0xa1, 0x85, 0x27,
+	0xe2, 0x2f, 0xca, 0x99, 0x20, 0xb7, 0x02, 0xdf, 0x7f, 0x25, 0x41, 0x5e, 0x9f, 0x49, 0x21, 0x81,
+	0x99, 0xb2, 0xd4, 0x20, 0xf6, 0xe3, 0x9d, 0x89, 0x34, 0x4a, 0xc6, 0xe7, 0x6f, 0x2a, 0xc4, 0xe5,
+	0x82, 0x6c, 0xb4, 0x00, 0xe6, 0x84, 0x58, 0xbf, 0x96, 0x91, 0xe8, 0xec, 0x6d, 0xa8, 0x61, 0x0a,
+	0x01, 0x4f, 0x32, 0xc3, 0xe8, 0x28, 0x69, 0x73, 0x49, 0xb3, 0xff, 0x5e, 0x49, 0x59, 0xfe, 0x17,
+	0xa1, 0x4a, 0x42, 0xd0, 0xb4, 0xe4, 0xb5, 0x8a, 0x82, 0x28, 0xeb, 0x14, 0x4c, 0x3c, 0xf7, 0x0a,
+	0x4f, 0x39, 0xed, 0x43, 0x98, 0x28, 0xba, 0x0d, 0xf3, 0xe2, 0x44, 0xe0, 0x75, 0x46, 0x3f, 0x94,
+	0xb9, 0x15, 0x79, 0x59, 0x3e, 0x87, 0x67, 0x6f, 0x41, 0xd5, 0x0d, 0x7c, 0x7f, 0x6e, 0x58, 0xb8,
+	0x30, 0x9c, 0x48, 0xf6, 0x5f, 0x84, 0x06, 0x9f, 0x04, 0xae, 0xf4, 0x13, 0x18, 0x54, 0x11, 0xd0,
+	0xf7, 0x19, 0xf8, 0x8c, 0xfb, 0x86, 0x0b, 0xc7, 0x3d, 0x35, 0xaf, 0xce, 0x53, 0x84, 0xbd, 0x05,
+	0xad, 0x3d, 0x27, 0xdc, 0x72, 0xdc, 0x53, 0xd1, 0xd3, 0xa9, 0x84, 0x5e, 0x7a, 0xa0, 0xe1, 0x23,
+	0xfa, 0x04, 0xd8, 0x91, 0x8e, 0xfc, 0xa0, 0x93, 0xbe, 0x8f, 0x4b, 0x82, 0xfd, 0x23, 0x68, 0x62,
+	0xa8, 0x7c, 0xec, 0xc4, 0x62, 0xcf, 0x09, 0xb1, 0x8b, 0xbe, 0xea, 0xa2, 0xca, 0xf1, 0x91, 0x7d,
+	0x0c, 0x6b, 0xe6, 0x5b, 0x3c, 0xa1, 0x3b, 0x5b, 0xed, 0xe4, 0xde, 0xce, 0x8b, 0x6c, 0xf6, 0x00,
+	0xea, 0xdb, 0xc2, 0x75, 0xc2, 0xcf, 0xc5, 0xd5, 0xc2, 0xd9, 0x31, 0xa8, 0x62, 0xc4, 0xa3, 0xee,
+	0xad, 0xe8, 0x19, 0x37, 0xf0, 0xe7, 0xe2, 0x4a, 0xda, 0x3f, 0x79, 0xca, 0xa7, 0xb0, 0xfd, 0xaf,
+	0x4a, 0xd0, 0x20, 0x29, 0xee, 0x7a, 0x71, 0x88, 0xfe, 0x7f, 0x3f, 0x89, 0xb6, 0xa2, 0xab, 0x30,
+	0x09, 0xa8, 0x1b, 0x39, 0xe6, 0x3c, 0x12, 0x8f, 0x90, 0x5e, 0x12, 0x0d, 0x9c, 0xc4, 0x78, 0x93,
+	0x81, 0x41, 0x7a, 0xdf, 0x4f, 0x44, 0x74, 0xe2, 0xb8, 0x42, 0xaf, 0xa5, 0x81, 0x61, 0x1f, 0xc2,
+	0x8a, 0x21, 0x1e, 0x34, 0xdf, 0x15, 0x0a, 0x23, 0x0c, 0x24, 0xcf, 0x71, 0xb0, 0xf7, 0xa1, 0xa1,
+	0x67, 0x2d, 0x13, 0x6f, 0x78, 0x5b, 0xac, 0x31, 0x3c, 0xa3, 0xd9, 0xff, 0xbe, 0xa2, 0x9d, 0x22,
+	0x11, 0x69, 0xe7, 0x27, 0x96, 0x8f, 0xe9, 0x22, 0x66, 0x08, 0xd4, 0x4e, 0x05, 0x98, 0x39, 0x51,
+	0x03, 0x65, 0x70, 0x50, 0x90, 0x27, 0x2d, 0x83, 0x89, 0x9a, 0xf3, 0x42, 0x64, 0x7c, 0x7d, 0x9d,
+	0x17, 0x92, 0xf3, 0xa8, 0x6b, 0x45, 0x8f, 0xfa, 0x13, 0x68, 0xca, 0x7d, 0x33, 0xa4, 0x44, 0xc4,
+	0xcd, 0xa7, 0x90, 0xc9, 0xbe, 0xd0, 0x53, 0x59, 0x7e, 0x35, 0x4f, 0x25, 0x3e, 0x77, 0xd1, 0x53,
+	0xa9, 0xcf, 0x7b, 0x2a, 0x92, 0x62, 0x3a, 0x22, 0x8d, 0x97, 0x3a, 0x22, 0x6f, 0x41, 0xed, 0x9c,
+	0x32, 0x0c, 0x77, 0xcc, 0x4b, 0xfd, 0x17, 0xa1, 0xbf, 0x73, 0x8b, 0x4b, 0x0a, 0xc6, 0x8f, 0x13,
+	0x62, 0xb9, 0x6b, 0x06, 0x79, 0xa8, 0x80, 0xc8, 0x43, 0xa4, 0xcd, 0x16, 0x34, 0x29, 0xaa, 0x0b,
+	0xfc, 0x44, 0xf8, 0x89, 0xfd, 0x77, 0x6b, 0xc0, 0xcc, 0xf7, 0xed, 0x1f, 0xff, 0x0d, 0xe1, 0x92,
+	0x34, 0xd5, 0x7b, 0xb3, 0xd5, 0x4d, 0x11, 0xb8, 0x76, 0x0a, 0xa0, 0xb5, 0x2b, 0xcb, 0xb5, 0x33,
+	0x50, 0xb9, 0xf8, 0xbd, 0x72, 0x6d, 0xfc, 0x5e, 0xbd, 0x2e, 0x7e, 0xaf, 0xbd, 0x2c, 0x7e, 0x5f,
+	0x7a, 0x79, 0xfc, 0xbe, 0xfc, 0xf2, 0xf8, 0xbd, 0x7e, 0x63, 0xfc, 0xde, 0x78, 0x95, 0xf8, 0x1d,
+	0x16, 0xc5, 0xef, 0x0f, 0xa0, 0x71, 0x1c, 0x79, 0xa3, 0xb1, 0x18, 0xcc, 0xa6, 0xe4, 0x0a, 0xb7,
+	0x78, 0x86, 0xa0, 0x9c, 0xbc, 0x04, 0x70, 0x16, 0x2d, 0x95, 0x93, 0x4f, 0x31, 0x38, 0x0e, 0x09,
+	0xc9, 0xcc, 0xb7, 0xba, 0xa7, 0xc8, 0xe1, 0xd8, 0x27, 0xd0, 0xf2, 0xc2, 0x2e, 0xe9, 0xd9, 0x54,
+	0xf8, 0x89, 0x4e, 0x07, 0xdd, 0xeb, 0x1c, 0x4d, 0x45, 0xd2, 0x3f, 0xc8, 0x28, 0xd2, 0xca, 0xe5,
+	0x99, 0xcd, 0x37, 0x0c, 0x45, 0xa2, 0xef, 0x32, 0x72, 0x38, 0x5c, 0xb9, 0x73, 0xef, 0x04, 0x07,
+	0x14, 0x53, 0x66, 0xa8, 0xc1, 0x53, 0x18, 0x57, 0xc8, 0x0b, 0xcf, 0x3f, 0xea, 0x79, 0x23, 0xf2,
+	0x6f, 0xeb, 0x5c, 0x83, 0x85, 0x94, 0xf8, 0xed, 0x39, 0x6d, 0x37, 0xa8, 0xec, 0x11, 0x54, 0xcf,
+	0xbd, 0x93, 0xb8, 0xfd, 0xba, 0xb2, 0x4e, 0x38, 0xf4, 0x17, 0xde, 0x09, 0xf1, 0x11, 0xc5, 0xfe,
+	0xcd, 0x12, 0xdc, 0x31, 0x95, 0xb2, 0xef, 0xc7, 0x89, 0xe3, 0x4b, 0xa3, 0x93, 0xa9, 0x65, 0xb9,
+	0xa8, 0x96, 0xef, 0xc1, 0xaa, 0x02, 0x5e, 0xe4, 0x7c, 0x84, 0x02, 0x36, 0xf5, 0xbb, 0x50, 0x39,
+	0xa5, 0xdb, 0x9a, 0xc2, 0x94, 0xd1, 0xf4, 0xe2, 0x70, 0xe2, 0x5c, 0x19, 0xba, 0x66, 0xa2, 0xf2,
+	0x86, 0x66, 0xf9, 0x06, 0x43, 0x53, 0xff, 0x66, 0x86, 0xa6, 0x68, 0xf2, 0x1a, 0x37, 0x99, 0xbc,
+	0x4c, 0xdd, 0xee, 0xbc, 0x5c, 0xdd, 0xee, 0xde, 0xa8, 0x6e, 0xf7, 0x5e, 0x45, 0xdd, 0x5e, 0xfb,
+	0xd3, 0xa8, 0x5b, 0x7b, 0x81, 0xba, 0xdd, 0xa8, 0x0c, 0xa6, 0xd2, 0xdd, 0xcf, 0x2b, 0xdd, 0x7b,
+	0xb0, 0xaa, 0xfb, 0x3a, 0x7f, 0x46, 0x73, 0x78, 0x43, 0xae, 0x77, 0x1e, 0x8b, 0x92, 0xf0, 0xc2,
+	0xf3, 0x67, 0x43, 0x69, 0x74, 0x1e, 0x48, 0x49, 0x64, 0x18, 0xf6, 0x1e, 0x2c, 0xcb, 0xca, 0x8e,
+	0xb8, 0xfd, 0x2d, 0x3d, 0x0c, 0x1c, 0xc0, 0x73, 0x42, 0x72, 0x4d, 0x5c, 0x78, 0x0c, 0xbc, 0xf9,
+	0x0a, 0xc7, 0x40, 0x6a, 0xb9, 0x1f, 0xde, 0x6c, 0xb9, 0x1f, 0x5d, 0x6b, 0xb9, 0x0b, 0x7b, 0xec,
+	0xf1, 0xcb, 0xf6, 0x58, 0xd1, 0xca, 0x3f, 0x87, 0xbb, 0x0b, 0x57, 0x0c, 0x45, 0xa3, 0x6a, 0x73,
+	0xf0, 0x7a, 0x45, 0x55, 0x94, 0x64, 0x18, 0xaa, 0x05, 0x08, 0x35, 0xb9, 0x2c, 0x2b, 0x2d, 0x52,
+	0x84, 0xfd, 0x63, 0x68, 0x1a, 0xeb, 0x45, 0xce, 0xb9, 0x34, 0x15, 0xaa, 0x27, 0x0d, 0x16, 0x5e,
+	0x53, 0x9e, 0x7b, 0xcd, 0x1d, 0xa8, 0x39, 0x74, 0xbd, 0xa1, 0xe2, 0x23, 0x02, 0xec, 0xff, 0x5a,
+	0x56, 0x7e, 0xf0, 0x5e, 0x3c, 0x46, 0x21, 0x9a, 0x15, 0x1c, 0x2a, 0x95, 0x9c, 0xab, 0xdd, 0xb8,
+	0x03, 0xb5, 0x91, 0x38, 0xef, 0x8f, 0xd4, 0x0b, 0x24, 0x80, 0xae, 0xfe, 0xc8, 0xa8, 0xd9, 0x58,
+	0x31, 0xf3, 0xa0, 0x28, 0x5c, 0x22, 0x62, 0xf7, 0x8e, 0xa7, 0xa3, 0xad, 0x74, 0x8d, 0x30, 0x1c,
+	0xbf, 0xc5, 0x25, 0x85, 0xbd, 0x0b, 0xb5, 0xd8, 0xcb, 0x42, 0x2a, 0x9d, 0x30, 0x97, 0x1e, 0x0b,
+	0xb2, 0x11, 0x95, 0x7d, 0x00, 0x35, 0xdf, 0xa8, 0x04, 0xb8, 0xdd, 0x99, 0x3f, 0x5e, 0x91, 0x99,
+	0x78, 0xd8, 0x13, 0x58, 0xf2, 0x3d, 0xe2, 0x96, 0x37, 0x27, 0x77, 0x3b, 0x8b, 0xec, 0xde, 0xce,
+	0x2d, 0xae, 0xd8, 0xd0, 0xbe, 0x38, 0xc9, 0x37, 0x72, 0x64, 0x0c, 0xf6, 0xa2, 0x5a, 0xfc, 0x06,
+	0x7d, 0x54, 0xad, 0xb8, 0xec, 0x81, 0x71, 0xc5, 0xb9, 0x8a, 0x46, 0xc7, 0x23, 0xf1, 0xaa, 0xcb,
+	0xce, 0x6b, 0xa2, 0xb1, 0xa9, 0xc0, 0xcc, 0x9c, 0x76, 0x46, 0x35, 0x88, 0xe7, 0xe5, 0x2c, 0x16,
+	0xa3, 0xcd, 0xab, 0x6e, 0x18, 0x52, 0xf1, 0x9a, 0x3c, 0xea, 0xf3, 0x48, 0x34, 0x10, 0x12, 0x41,
+	0x37, 0x75, 0x43, 0xe5, 0xb6, 0xe5, 0x70, 0xec, 0x03, 0x68, 0xcc, 0xe2, 0x63, 0x75, 0xbb, 0xb9,
+	0xa4, 0x25, 0xef, 0x05, 0xcf, 0x35, 0x92, 0x67, 0x74, 0xbc, 0x98, 0x5e, 0x31, 0x69, 0x74, 0x9a,
+	0x51, 0xc5, 0x5b, 0x1a, 0xfc, 0xa7, 0x30, 0x95, 0xfa, 0xc8, 0x22, 0xbd, 0x54, 0x65, 0x32, 0x84,
+	0xba, 0xdc, 0xf5, 0x9c, 0x49, 0x5a, 0x96, 0x43, 0x10, 0xf6, 0x88, 0xe1, 0x2b, 0xdd, 0xf9, 0xc9,
+	0x49, 0xa5, 0x30, 0x5d, 0x60, 0xcb, 0x0e, 0xb4, 0x07, 0xa3, 0x40, 0x49, 0x11, 0xb1, 0xf0, 0x13,
+	0x75, 0x0f, 0xa7, 0x41, 0xec, 0xcf, 0x49, 0x12, 0x8c, 0x44, 0xf4, 0x69, 0x92, 0xc2, 0x59, 0xbe,
+	0xb6, 0x6e, 0xe6, 0x6b, 0x7f, 0x5e, 0x82, 0x15, 0x99, 0x16, 0x96, 0x75, 0x10, 0xd8, 0x39, 0x8a,
+	0x6c, 0x4f, 0x4c, 0x95, 0x2b, 0xa6, 0x41, 0xea, 0xfc, 0xdc, 0xf1, 0x30, 0x0b, 0xaf, 0xdd, 0x30,
+	0x0d, 0xa3, 0xf5, 0x44, 0xb6, 0x03, 0x11, 0xb9, 0xc2, 0x4f, 0xb0, 0xa4, 0x05, 0xa7, 0x53, 0xe2,
+	0x05, 0x2c, 0xa5, 0xe4, 0xb1, 0x8d, 0xc1, 0x58, 0x23, 0xc6, 0x22, 0xda, 0xfe, 0x07, 0x55, 0x68,
+	0x29, 0x1b, 0xa4, 0x46, 0x76, 0x07, 0x6a, 0x9e, 0x61, 0x0f, 0x24, 0x80, 0xe3, 0x4d, 0x2e, 0x37,
+	0xaf, 0x12, 0x11, 0xab, 0x18, 0x47, 0x83, 0x48, 0x89, 0x14, 0x45, 0xc6, 0x53, 0xcb, 0x51, 0x46,
+	0x49, 0x2e, 0xb7, 0xa3, 0x20, 0x8c, 0x75, 0x78, 0xaf, 0x40, 0xd9, 0x46, 0x52, 0x6a, 0xba, 0x8d,
+	0xa4, 0x60, 0x81, 0xd4, 0x25, 0xd7, 0x51, 0x7e, 0x95, 0x2b, 0x08, 0xf1, 0x91, 0xc4, 0x2f, 0x4b,
+	0x7c, 0x94, 0xe2, 0x93, 0xcb, 0x83, 0xb3, 0x24, 0xd6, 0x55, 0x3f, 0x12, 0x92, 0xfc, 0x84, 0x6f,
+	0x68, 0x7e, 0xc2, 0xdf, 0x87, 0x7a, 0x72, 0x49, 0xf6, 0x57, 0xde, 0x4c, 0x57, 0x79, 0x0a, 0x23,
+	0x2d, 0xd2, 0xb4, 0xa6, 0xa4, 0x69, 0x18, 0xad, 0x61, 0x72, 0xd9, 0x75, 0x27, 0x72, 0xd0, 0x2b,
+	0x44, 0x35, 0x30, 0x48, 0x8f, 0x32, 0x7a, 0x4b, 0xd2, 0x33, 0x0c, 0x5e, 0xd6, 0x11, 0x37, 0x0e,
+	0x7a, 0xd7, 0x9b, 0x7a, 0x89, 0x64, 0x5c, 0x25, 0xc6, 0x45, 0x24, 0x6c, 0x11, 0x2d, 0x68, 0xb1,
+	0x26, 0x5b, 0x2c, 0x20, 0xe5, 0xeb, 0x16, 0xad, 0x62, 0xdd, 0x62, 0x96, 0x64, 0x5a, 0xcf, 0x25,
+	0x99, 0xf0, 0xa4, 0x9b, 0x38, 0x7e, 0xdc, 0x66, 0x2a, 0x0d, 0x84, 0x90, 0xd4, 0x05, 0x2e, 0x29,
+	0xf6, 0xcf, 0xca, 0xb0, 0xfa, 0xb5, 0x18, 0xb9, 0x93, 0x60, 0x36, 0x92, 0x14, 0x99, 0x44, 0x1c,
+	0xe4, 0x92, 0x88, 0xf4, 0x96, 0xfb, 0x50, 0x3f, 0xd1, 0x37, 0x91, 0x52, 0x51, 0x52, 0x18, 0x57,
+	0x3d, 0xc6, 0x4c, 0x68, 0x9c, 0x6a, 0x8a, 0x02, 0xd1, 0x42, 0xea, 0x34, 0xeb, 0x2c, 0x7a, 0x95,
+	0x12, 0x01, 0x93, 0x5d, 0xb7, 0x1e, 0xaa, 0xbe, 0x6b, 0xaf, 0xd6, 0x5a, 0xb1, 0xb3, 0x27, 0x00,
+	0xb3, 0x68, 0x22, 0xa7, 0xa5, 0xf3, 0xb2, 0x6b, 0x9d, 0x59, 0x34, 0x31, 0xa6, 0xcb, 0x0d, 0x16,
+	0xfb, 0xff, 0x95, 0x60, 0x35, 0x4f, 0xc6, 0x4b, 0x8d, 0x59, 0x34, 0xd1, 0xf7, 0x22, 0xb3, 0x68,
+	0x42, 0xe5, 0x35, 0xd1, 0xd5, 0x5e, 0x3c, 0x96, 0x37, 0x0d, 0x28, 0x8a, 0x0a, 0x37, 0x51, 0x68,
+	0x48, 0x93, 0xe8, 0x0a, 0x77, 0x4a, 0x76, 0x19, 0x51, 0xe1, 0x39, 0x9c, 0x2c, 0xb0, 0xf0, 0x93,
+	0xb4, 0x9b, 0xaa, 0xe4, 0x31, 0x71, 0x68, 0xb6, 0x11, 0xce, 0x3a, 0xaa, 0x11, 0x53, 0x1e, 0x29,
+	0xaf, 0x7e, 0xdd, 0xf3, 0xb4, 0xa7, 0x25, 0xd9, 0x93, 0x89, 0xc3, 0x9e, 0x10, 0xce, 0x7a, 0x5a,
+	0x96, 0x3d, 0xe5, 0x90, 0xf6, 0x5f, 0x85, 0x15, 0x27, 0x0c, 0xb7, 0xc2, 0x99, 0x9a, 0xfb, 0xd3,
+	0xf4, 0xb2, 0xeb, 0xe6, 0x65, 0x53, 0x9c, 0x59, 0x9e, 0xa5, 0x66, 0xe4, 0x59, 0xec, 0x7f, 0x57,
+	0x85, 0x15, 0x99, 0xa6, 0x51, 0x5d, 0xbf, 0x9b, 0x56, 0xd6, 0x94, 0xd5, 0x21, 0x62, 0xda, 0xd0,
+	0xb4, 0xd0, 0xe6, 0x71, 0x16, 0x8e, 0x57, 0xd4, 0xc5, 0x51, 0xce, 0xa4, 0x65, 0xf1, 0xf8, 0x07,
+	0x50, 0xd7, 0x7a, 0xac, 0x2e, 0x5a, 0xd6, 0x3a, 0x79, 0xc5, 0xe6, 0x29, 0x03, 0x7b, 0x08, 0xd5,
+	0x91, 0x17, 0x9f, 0xa5, 0xa9, 0x7a, 0x04, 0x14, 0x13, 0x11, 0xf0, 0x98, 0x73, 0xb5, 0x18, 0xd4,
+	0x75, 0x63, 0xab, 0x63, 0xca, 0x86, 0x67, 0xf4, 0x62, 0x59, 0x5c, 0xfd, 0x86, 0xb2, 0xb8, 0x1f,
+	0x40, 0x3b, 0x9a, 0xf9, 0x09, 0x79, 0x01, 0x94, 0x63, 0xda, 0x3f, 0x17, 0xd1, 0xa9, 0x70, 0x46,
+	0x7b, 0x9b, 0xca, 0xa2, 0x5d, 0x4b, 0x47, 0xcb, 0xe1, 0x84, 0x21, 0x9f, 0xf9, 0x87, 0x19, 0x79,
+	0x6f, 0x53, 0x99, 0xbb, 0x45, 0x24, 0xd6, 0x83, 0x7b, 0x32, 0xc7, 0xa4, 0x3c, 0xa3, 0x58, 0x16,
+	0x6c, 0xed, 0x6d, 0xb6, 0x9b, 0x8b, 0x04, 0x7f, 0x0d, 0x33, 0x8a, 0x37, 0xcd, 0x47, 0xaf, 0x28,
+	0xf1, 0x6a, 0x84, 0x16, 0xaf, 0x86, 0xb1, 0xe0, 0xcd, 0x0d, 0x67, 0xea, 0x28, 0x52, 0xc5, 0xd4,
+	0x56, 0x47, 0xca, 0xa0, 0x3b, 0x1e, 0x47, 0x02, 0x6f, 0x12, 0xb8, 0xc1, 0xc3, 0x3e, 0xd2, 0x67,
+	0xe8, 0x73, 0x3c, 0x2a, 0x37, 0xdb, 0xab, 0xd7, 0xb4, 0xc9, 0x71, 0xd9, 0x0e, 0xac, 0x15, 0x18,
+	0x70, 0xbb, 0x4e, 0x3d, 0x59, 0x6b, 0x52, 0xe2, 0xf8, 0x48, 0x18, 0xe7, 0xb2, 0x5d, 0x56, 0x18,
+	0xe7, 0x12, 0x31, 0xce, 0xf9, 0x98, 0x76, 0x65, 0x89, 0xe3, 0x23, 0x99, 0x2f, 0x67, 0x1a, 0x4e,
+	0xd2, 0x1c, 0x8b, 0x06, 0xed, 0x9f, 0x96, 0x01, 0xb2, 0x85, 0xd4, 0x45, 0x2b, 0xa5, 0xac, 0x68,
+	0xe5, 0x6d, 0xe5, 0xa6, 0x95, 0xc9, 0x4d, 0x5b, 0x33, 0x56, 0xdd, 0xf0, 0xd6, 0xde, 0x84, 0xc6,
+	0x71, 0x10, 0x4c, 0x5e, 0x38, 0x93, 0x99, 0xbc, 0x80, 0xa9, 0xef, 0xdc, 0xe2, 0x19, 0x8a, 0xd9,
+	0xd0, 0x9c, 0x79, 0x7e, 0xf2, 0xfd, 0xa7, 0x92, 0x83, 0xc6, 0xb0, 0x73, 0x8b, 0x9b, 0x48, 0xcd,
+	0xf3, 0xec, 0x23, 0xc9, 0x43, 0xdb, 0x4b, 0xf3, 0x28, 0x24, 0x7b, 0x04, 0x70, 0x32, 0x09, 0x9c,
+	0x44, 0xb2, 0xa0, 0x21, 0x28, 0xef, 0xdc, 0xe2, 0x06, 0x0e, 0x7b, 0x89, 0x93, 0xc8, 0xf3, 0xc7,
+	0x92, 0x85, 0x6e, 0x67, 0xb0, 0x17, 0x03, 0xb9, 0xb9, 0xae, 0xc5, 0x8a, 0xb3, 0x20, 0x94, 0xfd,
+	0xbb, 0x12, 0x40, 0xb6, 0x49, 0xd0, 0xfb, 0x44, 0x48, 0xdf, 0xc8, 0xe2, 0xf3, 0x0d, 0xe9, 0xd7,
+	0x07, 0xd0, 0x88, 0x84, 0x33, 0x32, 0x9d, 0x89, 0x0c, 0x41, 0xc9, 0xba, 0xc8, 0x4b, 0x84, 0x24,
+	0x4b, 0x8f, 0xc2, 0xc0, 0xe8, 0xd6, 0x99, 0x11, 0xac, 0xf2, 0x0c, 0x91, 0xb6, 0xce, 0xcc, 0x5f,
+	0x95, 0x1b, 0x98, 0xcc, 0x24, 0x2d, 0x9b, 0xa9, 0x5f, 0xac, 0x01, 0xc4, 0xab, 0x7a, 0xe9, 0x5c,
+	0xd0, 0x73, 0x5a, 0xfb, 0x22, 0xb7, 0x21, 0x3d, 0xdb, 0x3f, 0x2b, 0x41, 0xcb, 0x09, 0xc3, 0xed,
+	0x97, 0xcf, 0x5e, 0x7e, 0x75, 0x72, 0xee, 0xe1, 0x8d, 0x86, 0xba, 0xff, 0xaf, 0x72, 0x13, 0x95,
+	0xbe, 0xaf, 0x62, 0xbc, 0x0f, 0xef, 0xe5, 0xbc, 0x58, 0x5e, 0xdb, 0x29, 0xef, 0x55, 0xc3, 0x14,
+	0x3e, 0x79, 0x51, 0x72, 0xa5, 0xdc, 0x70, 0x09, 0xd8, 0xbf, 0xac, 0x40, 0xc3, 0x09, 0xc3, 0xcc,
+	0xa1, 0xbb, 0x31, 0x0f, 0x0d, 0x73, 0x79, 0x68, 0x23, 0xd3, 0x5c, 0xce, 0x67, 0x9a, 0x1f, 0x42,
+	0x05, 0x8b, 0x38, 0x2b, 0x8b, 0x0c, 0x1e, 0x52, 0x0c, 0xb3, 0x5d, 0x7d, 0x45, 0xb3, 0x5d, 0x7b,
+	0xb9, 0xd9, 0xb6, 0x73, 0x96, 0x78, 0xb5, 0x93, 0x93, 0xb4, 0x92, 0xed, 0x43, 0xa8, 0x7c, 0x15,
+	0xe8, 0x2b, 0x5e, 0x1a, 0xd5, 0x0f, 0x83, 0x58, 0x8f, 0xea, 0xab, 0x20, 0x66, 0x1f, 0xc0, 0xf2,
+	0x31, 0x56, 0x7d, 0x06, 0x7e, 0x9a, 0xf1, 0x71, 0xc2, 0x70, 0x53, 0xa2, 0xf4, 0x1b, 0x15, 0x47,
+	0xc1, 0x38, 0x35, 0x7f, 0x0f, 0xe3, 0xb4, 0xf2, 0x4a, 0xc6, 0xe9, 0x9f, 0x97, 0xc0, 0x2a, 0x8e,
+	0x02, 0x15, 0x59, 0x7e, 0x16, 0x80, 0x21, 0x80, 0x2c, 0x5e, 0xc9, 0x10, 0xea, 0x2e, 0x6b, 0x46,
+	0x65, 0xba, 0x2a, 0x76, 0xc8, 0x10, 0x54, 0x8c, 0xea, 0x5c, 0x66, 0xb1, 0x83, 0x82, 0xae, 0x29,
+	0x46, 0xbd, 0x0f, 0xf5, 0xa9, 0x73, 0xf9, 0x22, 0x2d, 0x48, 0x6d, 0xf1, 0x14, 0x46, 0x6f, 0xc0,
+	0x99, 0x25, 0x81, 0x1a, 0x5a, 0x5a, 0x99, 0x9a, 0x47, 0xda, 0x7f, 0x1e, 0x96, 0x0f, 0xce, 0xa8,
+	0x8e, 0x0f, 0x35, 0xe6, 0xc0, 0x71, 0xcf, 0x44, 0x12, 0xab, 0x4c, 0x89, 0x06, 0xf1, 0xe5, 0x66,
+	0xe8, 0x20, 0x01, 0xfb, 0x22, 0x4b, 0x4e, 0xc5, 0x0b, 0xd3, 0x37, 0x6f, 0x42, 0x8d, 0x88, 0xea,
+	0xf4, 0xaf, 0x77, 0xd4, 0x9b, 0xb8, 0x44, 0xb3, 0x67, 0x70, 0x6f, 0x28, 0xdc, 0xc0, 0x1f, 0xc5,
+	0x43, 0xcf, 0x77, 0xc5, 0x2e, 0xa6, 0xc7, 0xe9, 0x8d, 0x6a, 0xfb, 0x5c, 0x43, 0xc5, 0x8f, 0x5a,
+	0x7a, 0xde, 0x48, 0xf6, 0x31, 0x9f, 0x8e, 0x52, 0x39, 0xae, 0x72, 0x96, 0xe3, 0x7a, 0x06, 0x56,
+	0x3a, 0x50, 0x9d, 0xa1, 0xaa, 0x14, 0xd2, 0x5d, 0x31, 0x9f, 0xe3, 0xb1, 0xff, 0x47, 0x15, 0x9a,
+	0x47, 0x72, 0x4d, 0x29, 0xa1, 0xf4, 0x7d, 0x58, 0xd3, 0xef, 0xd5, 0xdd, 0x94, 0x54, 0xfa, 0x46,
+	0xe3, 0x79, 0x91, 0x83, 0x7d, 0x0c, 0xac, 0x9f, 0x44, 0x72, 0xe4, 0x43, 0xe1, 0x8f, 0x64, 0x45,
+	0x40, 0x51, 0x22, 0x0b, 0x78, 0xd8, 0x53, 0x58, 0xeb, 0xfb, 0xe7, 0xce, 0xc4, 0x1b, 0xf5, 0xbc,
+	0x51, 0x56, 0x48, 0x60, 0x36, 0x2b, 0x32, 0xe0, 0x65, 0xe6, 0x20, 0xd8, 0x16, 0x2e, 0xe6, 0xb7,
+	0x3e, 0x17, 0x57, 0xed, 0x6a, 0xa1, 0x41, 0x8e, 0xca, 0x3e, 0x02, 0x6b, 0x7f, 0x96, 0x88, 0x68,
+	0x47, 0x38, 0x23, 0x11, 0x65, 0x75, 0xa9, 0x66, 0x8b, 0x39, 0x0e, 0x1c, 0xd7, 0xa6, 0x33, 0xea,
+	0xfb, 0xbe, 0x88, 0xb4, 0xf9, 0x59, 0x2a, 0x8e, 0xab, 0xc0, 0xc0, 0x36, 0xa0, 0xf9, 0x59, 0x10,
+	0x8c, 0xb4, 0x7e, 0x2d, 0x17, 0xf8, 0x4d, 0x22, 0x7b, 0x07, 0xea, 0xfd, 0xad, 0x17, 0xbd, 0x34,
+	0x08, 0x37, 0x19, 0x53, 0x0a, 0x8e, 0x82, 0xae, 0xea, 0x8c, 0xa1, 0x37, 0x8a, 0xa3, 0x28, 0x30,
+	0xb0, 0x0e, 0xb4, 0xb6, 0x4e, 0x85, 0x7b, 0x36, 0x9c, 0x4d, 0x65, 0x0b, 0x28, 0xb4, 0xc8, 0x93,
+	0x71, 0xed, 0x28, 0x1b, 0xc7, 0x45, 0xdf, 0xc7, 0x3b, 0x24, 0xd9, 0xa8, 0x59, 0x5c, 0xbb, 0x79,
+	0x1e, 0x5c, 0x07, 0x25, 0x67, 0xd9, 0x66, 0xa5, 0xb8, 0x0e, 0x26, 0xd5, 0xfe, 0x65, 0x29, 0x55,
+	0x34, 0xca, 0xca, 0x3f, 0x82, 0xa5, 0xbe, 0x4f, 0xc1, 0x6f, 0xa9, 0xd0, 0x4e, 0xe1, 0x99, 0x0d,
+	0xcb, 0xfb, 0xb3, 0x84, 0x58, 0x8a, 0xaa, 0xa4, 0x09, 0xc8, 0xd3, 0x8b, 0x22, 0xe2, 0x29, 0xea,
+	0x8d, 0x26, 0x90, 0x44, 0x9c, 0xc8, 0x13, 0x91, 0x42, 0xcc, 0x29, 0x4c, 0x9e, 0x8c, 0xa5, 0xf6,
+	0xa0, 0x46, 0x8a, 0x89, 0xf2, 0xc7, 0x50, 0xc7, 0x01, 0x23, 0xa7, 0x1a, 0xea, 0x4a, 0xc7, 0x98,
+	0x08, 0x4f, 0xa9, 0x78, 0xdf, 0xdb, 0x3f, 0x13, 0xc4, 0x58, 0x5e, 0xc0, 0xa8, 0x89, 0xd8, 0xe3,
+	0xc0, 0x49, 0x0e, 0x89, 0xb1, 0xb2, 0xa8, 0x47, 0x4d, 0xc5, 0x1e, 0x7b, 0x71, 0x48, 0x8c, 0xd5,
+	0x45, 0x3d, 0x2a, 0xa2, 0xdd, 0x4a, 0x65, 0x3b, 0x08, 0x7c, 0x61, 0xff, 0x18, 0xd6, 0x14, 0xf8,
+	0xe9, 0x24, 0xb8, 0xa0, 0x6a, 0x92, 0x76, 0x5a, 0x94, 0x52, 0x52, 0x9e, 0x92, 0x82, 0x19, 0x83,
+	0x8a, 0xf0, 0xd4, 0x45, 0xd5, 0xce, 0x2d, 0x8e, 0x40, 0x56, 0xd8, 0x52, 0x31, 0x0a, 0x5b, 0x36,
+	0x97, 0xa0, 0x8a, 0x7d, 0xd9, 0xbf, 0x28, 0xc1, 0x6d, 0xa3, 0xff, 0xb4, 0x6a, 0xa3, 0x9d, 0x56,
+	0x69, 0xa4, 0xef, 0x90, 0x30, 0xbb, 0x03, 0xd5, 0x08, 0x2d, 0xa7, 0x7e, 0x09, 0x41, 0xec, 0x1d,
+	0xa8, 0xd2, 0x57, 0x90, 0x35, 0x5d, 0x20, 0x9c, 0x1f, 0x33, 0x27, 0x2a, 0x5a, 0xd8, 0x98, 0x2c,
+	0x6c, 0x51, 0x91, 0x25, 0x7a, 0x13, 0xa0, 0xde, 0xf3, 0x47, 0x21, 0x8e, 0xc0, 0xfe, 0x8f, 0x99,
+	0x92, 0x61, 0x2f, 0xaf, 0x54, 0xfa, 0xa1, 0x2b, 0x30, 0x2b, 0x46, 0x05, 0xa6, 0x05, 0x15, 0xcf,
+	0x1b, 0x29, 0xff, 0x0d, 0x1f, 0xcd, 0x32, 0x90, 0x5a, 0xbe, 0x0c, 0xe4, 0x29, 0x34, 0x26, 0x5a,
+	0x04, 0x6a, 0x8c, 0x77, 0x3a, 0x0b, 0xc4, 0xc3, 0x33, 0x36, 0x6c, 0x13, 0xa5, 0x6d, 0x9a, 0x8f,
+	0x2a, 0xd7, 0xb7, 0x49, 0xd9, 0xec, 0x5f, 0x57, 0x61, 0xdd, 0xb0, 0xd4, 0x9f, 0x4d, 0x82, 0x63,
+	0x67, 0xf2, 0x47, 0xd3, 0xfb, 0x47, 0xd3, 0x7b, 0xa3, 0xe9, 0xfd, 0x6f, 0x58, 0x21, 0x28, 0x35,
+	0xe7, 0x0f, 0x57, 0x65, 0x61, 0x78, 0xce, 0xd5, 0x97, 0x7b, 0xce, 0x6f, 0x41, 0xf5, 0x3c, 0xf4,
+	0xa7, 0xaa, 0xfe, 0xa0, 0xd9, 0xc9, 0x6c, 0x2f, 0x5a, 0x0a, 0x24, 0x61, 0xae, 0x65, 0xe2, 0xc5,
+	0xe1, 0x34, 0x2d, 0x60, 0x37, 0x36, 0x82, 0x4c, 0x64, 0xc5, 0xe1, 0x94, 0x6d, 0x40, 0xe3, 0x64,
+	0x12, 0x5c, 0x0c, 0x95, 0xb5, 0xa8, 0x98, 0x9c, 0xb8, 0xab, 0x78, 0x46, 0x66, 0x9f, 0xc0, 0xda,
+	0x24, 0xdd, 0x45, 0xb2, 0x45, 0xfa, 0x85, 0x65, 0x71, 0x93, 0xf1, 0x22, 0xeb, 0xa6, 0x05, 0xab,
+	0x4a, 0x92, 0x3a, 0xe5, 0xf1, 0x37, 0x4b, 0xb0, 0xa2, 0xb2, 0x2b, 0xf2, 0x05, 0x78, 0x75, 0x86,
+	0xe1, 0x59, 0xde, 0xdd, 0xcc, 0xe1, 0xd0, 0x11, 0x16, 0xf2, 0x2a, 0x57, 0x3a, 0x9d, 0x0a, 0xa2,
+	0x88, 0x89, 0x2e, 0x52, 0x55, 0x89, 0xef, 0x48, 0x5f, 0xdf, 0x52, 0xeb, 0x5c, 0x6c, 0x99, 0x61,
+	0xec, 0x61, 0x6a, 0x95, 0x73, 0x03, 0xf9, 0x16, 0x94, 0xa3, 0x4b, 0x75, 0x72, 0xb5, 0x3a, 0x26,
+	0x89, 0x97, 0xa3, 0x4b, 0x24, 0x27, 0x97, 0xed, 0xf2, 0x42, 0x72, 0x72, 0x69, 0xff, 0xcf, 0x2a,
+	0xdc, 0xcb, 0xf7, 0xfa, 0x67, 0x28, 0x69, 0x6e, 0xe8, 0x20, 0xfc, 0x81, 0x74, 0xf0, 0x1d, 0xa8,
+	0xf9, 0x81, 0x2f, 0xa6, 0xed, 0x7b, 0x79, 0x2e, 0x3c, 0x97, 0x91, 0x8b, 0x88, 0x79, 0x4d, 0x7d,
+	0xf3, 0x1b, 0x6b, 0xea, 0xc3, 0x57, 0xd6, 0x54, 0xf6, 0x31, 0xac, 0xf8, 0xc6, 0x9a, 0xb6, 0x1f,
+	0xe7, 0x0f, 0xa8, 0xdc, 0x7a, 0xe7, 0x38, 0xd9, 0x87, 0xd0, 0xc4, 0x18, 0xd6, 0x8f, 0x65, 0xc3,
+	0x6f, 0x2b, 0x01, 0xaa, 0x86, 0x5d, 0x22, 0x71, 0x93, 0x85, 0x3e, 0x0f, 0xf5, 0xe3, 0x1f, 0xce,
+	0x04, 0x85, 0x0d, 0x1b, 0xf9, 0x53, 0x7d, 0x5b, 0x52, 0xae, 0xb8, 0xc1, 0x83, 0x17, 0x34, 0x5a,
+	0x9d, 0xf4, 0x46, 0xfa, 0x5d, 0xe6, 0x7d, 0x61, 0x7a, 0x56, 0xe5, 0x5e, 0xd3, 0x8b, 0x01, 0x02,
+	0x8a, 0xd9, 0xca, 0xca, 0x37, 0xca, 0x56, 0xb2, 0x87, 0x50, 0x1e, 0x4d, 0xd3, 0xb8, 0xdf, 0xbc,
+	0xcd, 0xdd, 0xb9, 0xc5, 0xcb, 0x23, 0x4c, 0x6f, 0x95, 0x9d, 0xa9, 0x72, 0x4b, 0xa0, 0x93, 0xde,
+	0x52, 0xf0, 0xb2, 0x33, 0xc5, 0xc6, 0xf1, 0x34, 0xbd, 0x82, 0xcf, 0x9b, 0x55, 0x5e, 0x8e, 0xa7,
+	0xec, 0x7d, 0x28, 0xfb, 0x53, 0x15, 0xe3, 0xbf, 0xd6, 0x59, 0xbc, 0x77, 0x78, 0xd9, 0x9f, 0x6e,
+	0xae, 0x41, 0x2b, 0xf5, 0xe5, 0x68, 0xea, 0x83, 0xd4, 0x6d, 0xdb, 0x8b, 0xc7, 0x9b, 0x4e, 0xe2,
+	0x9e, 0x5e, 0x33, 0xfd, 0x77, 0x31, 0x3f, 0x2a, 0x73, 0x01, 0x65, 0xfd, 0x01, 0x74, 0xda, 0x90,
+	0x6b, 0x9a, 0xfd, 0xb7, 0x4a, 0xd0, 0xca, 0x2d, 0x57, 0x96, 0x0f, 0x2f, 0x19, 0xf9, 0x70, 0x8d,
+	0x3d, 0xd0, 0xf9, 0x6d, 0x02, 0xd0, 0xe3, 0xf9, 0x4a, 0x2d, 0xa5, 0xca, 0x84, 0x28, 0x10, 0x29,
+	0xc7, 0x93, 0xc0, 0x3d, 0x13, 0xda, 0x43, 0xd2, 0x20, 0x1a, 0xb4, 0x13, 0xf9, 0xd1, 0x9a, 0x74,
+	0x92, 0x14, 0x64, 0xff, 0xa7, 0x12, 0xac, 0x15, 0xf4, 0x00, 0x8b, 0xcf, 0xb1, 0xc3, 0xab, 0xb4,
+	0x06, 0xf5, 0x86, 0xe2, 0xf3, 0x94, 0x39, 0x9b, 0x45, 0xd9, 0x9c, 0xc5, 0x7d, 0xa8, 0xbb, 0x13,
+	0x4f, 0xf8, 0x49, 0xff, 0x40, 0x99, 0x9a, 0x14, 0x4e, 0xfd, 0xbe, 0x6a, 0xbe, 0x76, 0xfa, 0xab,
+	0xd4, 0xea, 0x34, 0xb8, 0x04, 0x70, 0x6e, 0x8e, 0x1f, 0x5f, 0x64, 0x7f, 0x07, 0xa2, 0x41, 0x73,
+	0xd6, 0xd2, 0xd0, 0x68, 0xd0, 0xfe, 0xdb, 0x25, 0xf9, 0xed, 0x54, 0x96, 0x76, 0x52, 0x49, 0xac,
+	0x52, 0x2e, 0x89, 0xf5, 0xfb, 0xa4, 0x27, 0xb3, 0xd4, 0x61, 0xf5, 0x9a, 0xd4, 0x61, 0xcd, 0x4c,
+	0x1d, 0xda, 0xff, 0xa6, 0x04, 0x4d, 0xa3, 0xc6, 0xe4, 0xda, 0x14, 0xd8, 0x22, 0x47, 0x58, 0xfe,
+	0x97, 0x49, 0x25, 0xfd, 0x2f, 0x93, 0x7b, 0xb0, 0x44, 0xa6, 0x54, 0x7f, 0x10, 0xa5, 0x20, 0xc4,
+	0x5f, 0x08, 0x6f, 0x7c, 0xaa, 0x6b, 0xf3, 0x15, 0x94, 0x4b, 0xab, 0x2d, 0x49, 0x4b, 0xae, 0x61,
+	0xfd, 0x45, 0xe3, 0xd6, 0x29, 0xd6, 0xb4, 0xb5, 0x97, 0x6f, 0x5c, 0x6d, 0x83, 0xdb, 0xfe, 0x6d,
+	0x05, 0x56, 0xcc, 0xab, 0xb2, 0x6b, 0xb2, 0xbf, 0xb9, 0xcc, 0x62, 0xb9, 0x98, 0x59, 0xc4, 0x6f,
+	0xc4, 0xe8, 0x9b, 0x1e, 0xca, 0xcf, 0x4a, 0x7f, 0xc5, 0xc0, 0xe0, 0x51, 0xe3, 0xf9, 0x19, 0x83,
+	0xbc, 0x81, 0x32, 0x51, 0xc8, 0x21, 0xf9, 0xe5, 0x42, 0x49, 0xb9, 0x9b, 0xa8, 0xec, 0x1d, 0xb4,
+	0x30, 0xea, 0xfa, 0x36, 0xc3, 0x64, 0x3d, 0xc8, 0x2c, 0xe9, 0xb2, 0xd9, 0x03, 0xa1, 0xd0, 0x69,
+	0xf0, 0xfc, 0xac, 0x47, 0x75, 0xa5, 0x9b, 0xc3, 0x19, 0x23, 0x35, 0x52, 0xc7, 0x26, 0xca, 0xe8,
+	0x45, 0xbe, 0x08, 0x72, 0xbd, 0xc8, 0x37, 0x7d, 0x07, 0xd6, 0x15, 0x8c, 0x49, 0x99, 0x09, 0x26,
+	0x68, 0x75, 0x42, 0x79, 0x9e, 0x80, 0xd9, 0x1a, 0x3d, 0x06, 0xc7, 0x3d, 0x9b, 0x04, 0x63, 0x39,
+	0x3c, 0x99, 0x62, 0x5e, 0x44, 0xc2, 0x2f, 0xeb, 0xf2, 0x68, 0x1a, 0xac, 0xcc, 0x39, 0x2f, 0xa0,
+	0xd8, 0xff, 0x54, 0x97, 0x35, 0xe3, 0xa7, 0x83, 0xa8, 0x9e, 0x71, 0x9c, 0x7d, 0xd6, 0x8e, 0xcf,
+	0xb8, 0xea, 0xc7, 0x84, 0x54, 0xbb, 0x9e, 0x00, 0xba, 0x22, 0x8e, 0xe3, 0xc0, 0xf5, 0xc8, 0x03,
+	0x90, 0xca, 0x6b, 0x60, 0x50, 0x29, 0x2f, 0x42, 0x67, 0x98, 0xfe, 0xe1, 0x49, 0x83, 0xa7, 0x30,
+	0x39, 0xc1, 0xf8, 0x07, 0x17, 0x93, 0xed, 0xe3, 0x29, 0xad, 0x67, 0x8d, 0x67, 0x08, 0x94, 0xe2,
+	0x49, 0x24, 0xbe, 0x9a, 0x09, 0xdf, 0xbd, 0xda, 0x3b, 0xfd, 0x5a, 0xa9, 0x74, 0x0e, 0x67, 0xff,
+	0xdf, 0x92, 0xfe, 0xcb, 0x02, 0x9d, 0x31, 0x7a, 0x00, 0x0d, 0xac, 0x6a, 0x17, 0x6e, 0x22, 0xe4,
+	0xf0, 0xeb, 0x3c, 0x43, 0xc8, 0x0c, 0xe7, 0xd8, 0x8b, 0x93, 0x48, 0x7e, 0xa2, 0x25, 0xa7, 0x92,
+	0xc3, 0xe1, 0x88, 0x83, 0x50, 0x44, 0x4e, 0x92, 0x7e, 0x54, 0x93, 0xc2, 0x94, 0x02, 0x72, 0x5d,
+	0xa5, 0x9d, 0xf8, 0x48, 0x18, 0xdf, 0x55, 0x3b, 0x11, 0x1f, 0xc9, 0x98, 0x04, 0xce, 0x34, 0xfb,
+	0x0f, 0x02, 0x0d, 0x22, 0x6f, 0xe4, 0x24, 0xfa, 0x2f, 0x75, 0x22, 0x27, 0x61, 0x7f, 0x01, 0xd6,
+	0xf0, 0x5a, 0xff, 0x78, 0x22, 0xd4, 0x01, 0xa5, 0x93, 0x7e, 0xeb, 0x9d, 0x23, 0x3d, 0x25, 0x45,
+	0xe1, 0x45, 0x4e, 0x3b, 0x04, 0xab, 0xc8, 0xa4, 0x07, 0x58, 0x9a, 0x1b, 0x60, 0x39, 0x1b, 0x60,
+	0xe1, 0xcf, 0x5d, 0x2a, 0xf3, 0x7f, 0xee, 0x72, 0x2f, 0xfd, 0x5a, 0xb0, 0x4a, 0x36, 0x58, 0x41,
+	0xf6, 0xaf, 0x4a, 0xb0, 0x9a, 0xcf, 0xd5, 0x5d, 0x63, 0x0b, 0x32, 0xb3, 0x57, 0xce, 0x99, 0x3d,
+	0x25, 0x81, 0x4a, 0x26, 0x01, 0x06, 0xd5, 0x28, 0x8e, 0x3d, 0x12, 0x69, 0x8d, 0xd3, 0xb3, 0xc4,
+	0x45, 0x5f, 0x29, 0x95, 0xa0, 0x67, 0x85, 0x93, 0x85, 0x51, 0x12, 0x47, 0x9f, 0x09, 0xc4, 0xbe,
+	0x2c, 0x0c, 0x2e, 0x73, 0x7c, 0x44, 0x2e, 0xe1, 0x7a, 0xf2, 0x73, 0x8d, 0x32, 0xa7, 0x67, 0xfb,
+	0x1f, 0x97, 0xa0, 0x7d, 0xb4, 0x25, 0x55, 0xc0, 0x3b, 0xf7, 0x12, 0xfc, 0xb8, 0x75, 0x2c, 0xe4,
+	0x77, 0xcf, 0xea, 0x8b, 0xfd, 0x71, 0xf6, 0xc5, 0xfe, 0x02, 0x4e, 0xc9, 0x41, 0xe5, 0xc6, 0x33,
+	0xa9, 0x23, 0x7b, 0xb1, 0x92, 0xa7, 0x81, 0x61, 0xdf, 0x83, 0x06, 0x85, 0x0f, 0x5b, 0xc1, 0x48,
+	0x1a, 0xb8, 0xb9, 0xee, 0x28, 0x1a, 0xe4, 0x19, 0x57, 0x56, 0x07, 0x54, 0x35, 0xeb, 0x80, 0x7e,
+	0x85, 0x87, 0x75, 0xfe, 0x5b, 0xed, 0x6b, 0xbf, 0xc7, 0x7e, 0x06, 0xf5, 0x44, 0xdf, 0x8b, 0xbc,
+	0xc2, 0xbf, 0x32, 0x68, 0x5e, 0xf6, 0x3d, 0x5a, 0xe1, 0x71, 0x7a, 0x49, 0xfd, 0x7a, 0xe7, 0x3a,
+	0x11, 0x71, 0xc5, 0x28, 0x3f, 0xae, 0xd4, 0x1f, 0xb5, 0x57, 0xd5, 0x47, 0x87, 0x1a, 0xb1, 0xf1,
+	0x0f, 0x4b, 0xc0, 0xe6, 0xff, 0xed, 0x80, 0xbd, 0x01, 0xaf, 0x6d, 0x77, 0x0f, 0xbb, 0xc3, 0xde,
+	0xd6, 0x97, 0xdd, 0xc3, 0x2f, 0x79, 0x6f, 0x78, 0xf8, 0xe5, 0xf3, 0xc1, 0xe7, 0x83, 0xfd, 0x2f,
+	0x06, 0xd6, 0x2d, 0xf6, 0x00, 0xda, 0xf3, 0xc4, 0xdd, 0xfd, 0xad, 0xcf, 0x7b, 0xdb, 0x56, 0x89,
+	0xdd, 0x87, 0x7b, 0x45, 0xaa, 0xa2, 0x95, 0xd9, 0xb7, 0xe0, 0xf5, 0x22, 0x8d, 0xf7, 0xb6, 0xf6,
+	0x5f, 0xf4, 0x78, 0x6f, 0xdb, 0xaa, 0xb0, 0xd7, 0xe1, 0x6e, 0x91, 0xdc, 0xe3, 0x7c, 0x9f, 0x5b,
+	0xd5, 0x8d, 0xbf, 0x06, 0x6b, 0x85, 0x8f, 0xf1, 0xd8, 0x3d, 0x60, 0xdd, 0x83, 0x83, 0x2f, 0x77,
+	0x7a, 0xdd, 0xdd, 0xc3, 0x1d, 0x63, 0x78, 0x79, 0xbc, 0xfc, 0xf9, 0x91, 0x55, 0x62, 0x6d, 0xb8,
+	0x93, 0xe3, 0xd7, 0x94, 0xf2, 0xc6, 0x99, 0xfa, 0x16, 0x98, 0xaa, 0x17, 0x59, 0x03, 0x6a, 0x47,
+	0xde, 0x20, 0x08, 0xad, 0x5b, 0x6c, 0x05, 0xea, 0x47, 0x9e, 0x2c, 0x5d, 0xb3, 0x4a, 0x92, 0xd0,
+	0x0d, 0x43, 0xab, 0xc2, 0x5a, 0x58, 0xa8, 0xa7, 0xbc, 0x57, 0xab, 0xca, 0x6e, 0xe3, 0x3f, 0x5c,
+	0xe5, 0x4a, 0x0a, 0xad, 0x1a, 0xbb, 0x0b, 0xeb, 0x47, 0x5e, 0xc1, 0x81, 0xb5, 0x96, 0x36, 0x3e,
+	0x01, 0xab, 0xf8, 0x67, 0x57, 0x0c, 0x60, 0xe9, 0x28, 0xc4, 0x50, 0xc7, 0xba, 0x45, 0x5d, 0x87,
+	0x2a, 0x7d, 0x6f, 0x95, 0x24, 0xa8, 0x7a, 0xb1, 0xca, 0x1b, 0xff, 0x0c, 0xbf, 0x45, 0x52, 0xdf,
+	0x4e, 0xb2, 0x26, 0x2c, 0xf7, 0x07, 0x2f, 0xba, 0xbb, 0xfd, 0x6d, 0xeb, 0x96, 0x04, 0xfa, 0x87,
+	0xfd, 0xee, 0xae, 0x55, 0x62, 0x77, 0xc0, 0xda, 0xde, 0xff, 0x62, 0xb0, 0xbb, 0xdf, 0xdd, 0xfe,
+	0x72, 0x78, 0xd8, 0xe5, 0x87, 0x24, 0xfe, 0x55, 0x00, 0x8d, 0x25, 0x79, 0xb7, 0xa0, 0xb1, 0xdd,
+	0xdb, 0xed, 0x4b, 0xf1, 0x57, 0x11, 0xec, 0x0f, 0x86, 0x87, 0xdd, 0xdd, 0xdd, 0xde, 0xb6, 0x55,
+	0xc3, 0x0e, 0x37, 0xf7, 0xf7, 0x0f, 0xfb, 0x83, 0xcf, 0xac, 0x25, 0x04, 0xf8, 0xf3, 0xc1, 0x00,
+	0x81, 0x65, 0x04, 0x76, 0xba, 0xbb, 0x44, 0xa9, 0xe3, 0xd8, 0x11, 0xe8, 0x6d, 0x5b, 0x0d, 0x7c,
+	0x01, 0xae, 0x5a, 0x97, 0x13, 0x0d, 0x90, 0xf1, 0xe0, 0x39, 0xff, 0x0c, 0x81, 0xe6, 0xc6, 0x29,
+	0xac, 0x98, 0x5f, 0x00, 0xb3, 0x3a, 0x54, 0x07, 0xfb, 0x83, 0x9e, 0x75, 0x0b, 0xbb, 0xe8, 0x6e,
+	0x1d, 0xf6, 0x5f, 0xf4, 0xac, 0x12, 0x8a, 0xfc, 0xf9, 0xc1, 0x76, 0x97, 0x3a, 0x28, 0xe3, 0x90,
+	0x78, 0x4f, 0x8f, 0xa2, 0x82, 0xfd, 0x1d, 0xf6, 0x86, 0x04, 0x54, 0x91, 0xf3, 0xd3, 0xee, 0xee,
+	0xee, 0x66, 0x77, 0xeb, 0x73, 0xab, 0x86, 0x7d, 0x7c, 0xda, 0xed, 0xe3, 0xc8, 0x97, 0x36, 0xfe,
+	0x8e, 0x3e, 0x5e, 0xf4, 0x07, 0x64, 0x6c, 0x0d, 0x9a, 0x2f, 0x0e, 0x06, 0x5f, 0x66, 0xd2, 0x4a,
+	0x11, 0x5a, 0x62, 0x0c, 0x56, 0x11, 0xb1, 0xb5, 0x3f, 0x18, 0xf4, 0xb6, 0xd4, 0xdb, 0x6f, 0xc3,
+	0x1a, 0xe2, 0x70, 0x46, 0x9b, 0xbb, 0xfd, 0xe1, 0x0e, 0x09, 0x6d, 0x1d, 0x5a, 0xb2, 0xa5, 0x96,
+	0x54, 0x55, 0x77, 0xc6, 0x7b, 0x9f, 0xf7, 0x7e, 0x44, 0xa2, 0x53, 0x88, 0xed, 0xde, 0x6e, 0x0f,
+	0x05, 0x03, 0x1b, 0x47, 0xb0, 0xac, 0xca, 0x37, 0x69, 0xad, 0xbd, 0x40, 0xea, 0x97, 0x7c, 0xee,
+	0x25, 0xa7, 0x56, 0x49, 0x3d, 0x3f, 0x1f, 0x6e, 0x5a, 0x65, 0xf5, 0xbc, 0xb5, 0xbf, 0x67, 0x55,
+	0x98, 0x25, 0x4b, 0x28, 0x87, 0x9b, 0x4a, 0x0f, 0x71, 0x9d, 0xea, 0x47, 0x5e, 0xb0, 0x9f, 0x9c,
+	0x8a, 0xc8, 0xfa, 0xff, 0xa5, 0x8d, 0xa7, 0xb0, 0x72, 0x24, 0x43, 0x97, 0x4c, 0x7f, 0xa7, 0x99,
+	0xfe, 0x4e, 0x73, 0xfa, 0x3b, 0x25, 0xfd, 0xdd, 0x38, 0x81, 0xd5, 0x7c, 0x9d, 0x02, 0xce, 0x35,
+	0xc3, 0xc8, 0xbe, 0x6f, 0xe5, 0x91, 0x9f, 0x39, 0x33, 0xd2, 0xc8, 0xbb, 0xb0, 0x9e, 0x21, 0xd5,
+	0x1f, 0x23, 0x49, 0x61, 0x65, 0x68, 0x92, 0xba, 0x55, 0xd9, 0xf8, 0x17, 0xf8, 0xf7, 0x3b, 0x73,
+	0x16, 0x0a, 0x85, 0x7d, 0xe4, 0xd2, 0xe3, 0x73, 0xff, 0xcc, 0x0f, 0x2e, 0x7c, 0xeb, 0x96, 0x81,
+	0xdb, 0x72, 0xa2, 0xc8, 0x13, 0x91, 0x55, 0x32, 0x70, 0xaa, 0x32, 0xd9, 0x2a, 0xb3, 0xd7, 0xe0,
+	0xb6, 0xc2, 0x6d, 0x1b, 0x7f, 0x38, 0xa8, 0x04, 0x25, 0x09, 0xf4, 0x3f, 0x01, 0x56, 0x15, 0xd5,
+	0x51, 0xb3, 0x0e, 0x86, 0x6a, 0x47, 0x4a, 0xf8, 0x70, 0xeb, 0x40, 0x8d, 0xca, 0x5a, 0x32, 0xd8,
+	0x0e, 0x77, 0x87, 0xd6, 0x32, 0xae, 0x9e, 0x82, 0x77, 0x0e, 0x0f, 0x0f, 0xac, 0xfa, 0xc6, 0xbf,
+	0x2d, 0x03, 0x9b, 0x3f, 0x11, 0x68, 0x6b, 0xe2, 0x37, 0x43, 0x6a, 0xe3, 0xd2, 0x60, 0x09, 0x2c,
+	0x4c, 0x80, 0x70, 0xd9, 0x04, 0x68, 0x9c, 0x84, 0xd3, 0x23, 0xa7, 0x01, 0x60, 0x1a, 0x45, 0x8d,
+	0xfb, 0x0e, 0x58, 0x04, 0x6f, 0x0f, 0x86, 0x83, 0x20, 0xf9, 0x34, 0x98, 0xf9, 0x23, 0xab, 0x46,
+	0x46, 0x46, 0x61, 0x55, 0x75, 0x9c, 0xb5, 0x94, 0x76, 0xc6, 0xc5, 0x09, 0x16, 0x14, 0x58, 0xcb,
+	0x69, 0xe3, 0xe7, 0x7e, 0xa4, 0x3f, 0xf6, 0xb3, 0xea, 0x29, 0x1f, 0x9e, 0x22, 0xc1, 0x2c, 0xb1,
+	0x1a, 0x68, 0x8b, 0x09, 0xb3, 0x25, 0xa2, 0x44, 0xad, 0x42, 0x77, 0x96, 0x9c, 0xd2, 0x3f, 0xa9,
+	0x58, 0x20, 0x65, 0xa5, 0xc8, 0xfa, 0x4f, 0x0b, 0xad, 0x66, 0xda, 0x3b, 0xa2, 0xd5, 0x2d, 0xb7,
+	0xb5, 0x42, 0x7a, 0x46, 0xbd, 0xef, 0x0e, 0xad, 0x56, 0x3a, 0x50, 0x94, 0x9e, 0xdc, 0xeb, 0xd6,
+	0x2a, 0x5b, 0x53, 0x73, 0xd4, 0x6a, 0xbb, 0xb9, 0x0d, 0x0f, 0xdd, 0x60, 0x8a, 0x25, 0x5a, 0x62,
+	0xe4, 0x74, 0xa8, 0x2c, 0xab, 0x33, 0x53, 0x37, 0xa1, 0xf2, 0x10, 0x3c, 0x7a, 0x6b, 0xec, 0x25,
+	0xa7, 0xb3, 0xe3, 0x8e, 0x1b, 0x4c, 0x9f, 0x48, 0xbe, 0x27, 0xe2, 0x5c, 0x3c, 0x89, 0x47, 0x67,
+	0x4f, 0xc6, 0xc1, 0x13, 0xfc, 0x37, 0xd1, 0xe3, 0x25, 0xe2, 0xfc, 0xfe, 0x9f, 0x0c, 0x00, 0x81,
+	0x1e, 0x20, 0xba, 0x5c, 0x54, 0x00, 0x00,
 }
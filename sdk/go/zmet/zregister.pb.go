@@ -153,31 +153,122 @@ func (m *ZRegisterMsg) GetSerial() string {
 	return ""
 }
 
+type ZCertRenewReq struct {
+	PemCsr               []byte   `protobuf:"bytes,1,opt,name=pemCsr,proto3" json:"pemCsr,omitempty"`
+	OldKeySignature      []byte   `protobuf:"bytes,2,opt,name=oldKeySignature,proto3" json:"oldKeySignature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZCertRenewReq) Reset()         { *m = ZCertRenewReq{} }
+func (m *ZCertRenewReq) String() string { return proto.CompactTextString(m) }
+func (*ZCertRenewReq) ProtoMessage()    {}
+func (*ZCertRenewReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bca56f0871250a2, []int{2}
+}
+
+func (m *ZCertRenewReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZCertRenewReq.Unmarshal(m, b)
+}
+func (m *ZCertRenewReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZCertRenewReq.Marshal(b, m, deterministic)
+}
+func (m *ZCertRenewReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZCertRenewReq.Merge(m, src)
+}
+func (m *ZCertRenewReq) XXX_Size() int {
+	return xxx_messageInfo_ZCertRenewReq.Size(m)
+}
+func (m *ZCertRenewReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZCertRenewReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZCertRenewReq proto.InternalMessageInfo
+
+func (m *ZCertRenewReq) GetPemCsr() []byte {
+	if m != nil {
+		return m.PemCsr
+	}
+	return nil
+}
+
+func (m *ZCertRenewReq) GetOldKeySignature() []byte {
+	if m != nil {
+		return m.OldKeySignature
+	}
+	return nil
+}
+
+type ZCertRenewResp struct {
+	PemCert              []byte   `protobuf:"bytes,1,opt,name=pemCert,proto3" json:"pemCert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZCertRenewResp) Reset()         { *m = ZCertRenewResp{} }
+func (m *ZCertRenewResp) String() string { return proto.CompactTextString(m) }
+func (*ZCertRenewResp) ProtoMessage()    {}
+func (*ZCertRenewResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bca56f0871250a2, []int{3}
+}
+
+func (m *ZCertRenewResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZCertRenewResp.Unmarshal(m, b)
+}
+func (m *ZCertRenewResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZCertRenewResp.Marshal(b, m, deterministic)
+}
+func (m *ZCertRenewResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZCertRenewResp.Merge(m, src)
+}
+func (m *ZCertRenewResp) XXX_Size() int {
+	return xxx_messageInfo_ZCertRenewResp.Size(m)
+}
+func (m *ZCertRenewResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZCertRenewResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZCertRenewResp proto.InternalMessageInfo
+
+func (m *ZCertRenewResp) GetPemCert() []byte {
+	if m != nil {
+		return m.PemCert
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ZRegisterResult", ZRegisterResult_name, ZRegisterResult_value)
 	proto.RegisterType((*ZRegisterResp)(nil), "ZRegisterResp")
 	proto.RegisterType((*ZRegisterMsg)(nil), "ZRegisterMsg")
+	proto.RegisterType((*ZCertRenewReq)(nil), "ZCertRenewReq")
+	proto.RegisterType((*ZCertRenewResp)(nil), "ZCertRenewResp")
 }
 
 func init() { proto.RegisterFile("zregister.proto", fileDescriptor_7bca56f0871250a2) }
 
 var fileDescriptor_7bca56f0871250a2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x4f, 0x4f, 0x83, 0x40,
-	0x10, 0xc5, 0xa5, 0x55, 0xd4, 0x11, 0xcb, 0x3a, 0x26, 0x86, 0x93, 0xa9, 0xf5, 0x42, 0x3c, 0x40,
-	0xa2, 0x27, 0x8f, 0xd4, 0xc6, 0x8b, 0x91, 0x03, 0xde, 0x7a, 0x92, 0xb2, 0x13, 0xdc, 0xb8, 0x74,
-	0xc9, 0xee, 0x42, 0x52, 0x3e, 0xbd, 0xa1, 0xa0, 0x69, 0x3c, 0xbe, 0xdf, 0xcb, 0x7b, 0xf3, 0x07,
-	0xfc, 0x4e, 0x53, 0x29, 0x8c, 0x25, 0x1d, 0xd5, 0x5a, 0x59, 0xb5, 0x78, 0x86, 0xcb, 0x75, 0x36,
-	0xa2, 0x8c, 0x4c, 0x8d, 0x21, 0xb8, 0x9a, 0x4c, 0x23, 0x6d, 0x30, 0x99, 0x3b, 0xe1, 0xec, 0x91,
-	0x45, 0x87, 0x7e, 0x23, 0x6d, 0x36, 0xfa, 0x8b, 0x4f, 0xf0, 0xfe, 0xac, 0x77, 0x53, 0xe2, 0x2d,
-	0x80, 0xda, 0x2e, 0x55, 0xae, 0xf9, 0x1b, 0xed, 0x02, 0x67, 0xee, 0x84, 0xe7, 0xd9, 0x01, 0xc1,
-	0x00, 0x4e, 0x6b, 0xaa, 0x5e, 0x48, 0x0f, 0xd5, 0x5e, 0xf6, 0x2b, 0xf1, 0x06, 0x5c, 0x43, 0x5a,
-	0xe4, 0x32, 0x98, 0xee, 0x53, 0xa3, 0x7a, 0xe8, 0xc0, 0xff, 0x37, 0x1c, 0x3d, 0x38, 0xeb, 0x51,
-	0xaa, 0xb6, 0xc4, 0x8e, 0xd0, 0x87, 0x8b, 0x5e, 0x7d, 0x34, 0x45, 0x41, 0xc6, 0x30, 0x07, 0xaf,
-	0x86, 0x73, 0x52, 0x65, 0x93, 0xc2, 0x8a, 0x96, 0xd8, 0x04, 0xaf, 0x87, 0x92, 0x44, 0x6a, 0xca,
-	0xf9, 0x6e, 0xd5, 0x07, 0xa7, 0xc8, 0x86, 0xdd, 0x57, 0xd4, 0x8a, 0x82, 0xd2, 0x84, 0x1d, 0xe3,
-	0x0c, 0xa0, 0x27, 0xaf, 0xb9, 0x90, 0xc4, 0xd9, 0xc9, 0xf2, 0x7e, 0x7d, 0x57, 0x0a, 0xfb, 0xd5,
-	0x6c, 0xa2, 0x42, 0x55, 0x71, 0x47, 0x9c, 0x78, 0x1e, 0x53, 0x4b, 0xb1, 0xe1, 0xdf, 0x71, 0xa9,
-	0xe2, 0xae, 0x22, 0xbb, 0x71, 0xf7, 0x4f, 0x7c, 0xfa, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x44,
-	0x4a, 0x5e, 0x57, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x4f, 0xc3, 0x30,
+	0x10, 0x85, 0x49, 0x0b, 0x05, 0x8e, 0xb4, 0x31, 0x46, 0x42, 0x99, 0x50, 0x09, 0x4b, 0xd4, 0xa1,
+	0x91, 0x60, 0x62, 0x6c, 0xa9, 0x58, 0x10, 0x95, 0x70, 0xb7, 0x4e, 0xa4, 0xc9, 0x29, 0x58, 0xb8,
+	0x71, 0xb0, 0x9d, 0xa2, 0xe6, 0xd7, 0x23, 0x27, 0x01, 0x85, 0x8e, 0xef, 0xbb, 0x7b, 0xef, 0xce,
+	0x67, 0xf0, 0x2a, 0x85, 0x19, 0xd7, 0x06, 0xd5, 0xb4, 0x50, 0xd2, 0xc8, 0xe0, 0x11, 0x86, 0x6b,
+	0xd6, 0x22, 0x86, 0xba, 0xa0, 0x21, 0x0c, 0x14, 0xea, 0x52, 0x18, 0xbf, 0x37, 0x76, 0xc2, 0xd1,
+	0x3d, 0x99, 0x76, 0xeb, 0xa5, 0x30, 0xac, 0xad, 0x07, 0xef, 0xe0, 0xfe, 0x95, 0x5e, 0x75, 0x46,
+	0x6f, 0x00, 0x64, 0x3e, 0x97, 0xb1, 0x4a, 0x5f, 0x70, 0xef, 0x3b, 0x63, 0x27, 0x3c, 0x67, 0x1d,
+	0x42, 0x7d, 0x38, 0x2d, 0x70, 0xfb, 0x84, 0xaa, 0x89, 0x76, 0xd9, 0xaf, 0xa4, 0xd7, 0x30, 0xd0,
+	0xa8, 0x78, 0x2c, 0xfc, 0x7e, 0xed, 0x6a, 0x55, 0xf0, 0x06, 0xc3, 0xb5, 0x6d, 0x60, 0x98, 0xe3,
+	0x37, 0xc3, 0x2f, 0xdb, 0x68, 0x3d, 0x5a, 0xd5, 0xf1, 0x2e, 0x6b, 0x15, 0x0d, 0xc1, 0x93, 0xc2,
+	0x0e, 0x59, 0xf1, 0x2c, 0x8f, 0x4d, 0xa9, 0xb0, 0x1d, 0x71, 0x88, 0x83, 0x09, 0x8c, 0xba, 0x91,
+	0xba, 0xe8, 0xae, 0xe5, 0xfc, 0x5b, 0x6b, 0x52, 0x81, 0x77, 0xf0, 0x76, 0xea, 0xc2, 0x99, 0x45,
+	0x4b, 0x99, 0x23, 0x39, 0xa2, 0x1e, 0x5c, 0x58, 0xb5, 0x2a, 0x93, 0x04, 0xb5, 0x26, 0x0e, 0xbd,
+	0x6c, 0xae, 0xb9, 0x94, 0x66, 0x96, 0x18, 0xbe, 0x43, 0xd2, 0xa3, 0x57, 0x4d, 0xc8, 0x4c, 0x28,
+	0x8c, 0xd3, 0xfd, 0xc2, 0x1a, 0xfb, 0x94, 0x34, 0xa7, 0x5b, 0xe0, 0x8e, 0x27, 0xb8, 0x9c, 0x91,
+	0x63, 0x3a, 0x02, 0xb0, 0xe4, 0x39, 0xe6, 0x02, 0x53, 0x72, 0x32, 0xbf, 0x5b, 0xdf, 0x66, 0xdc,
+	0x7c, 0x94, 0x9b, 0x69, 0x22, 0xb7, 0x51, 0x85, 0x29, 0xa6, 0x71, 0x84, 0x3b, 0x8c, 0x74, 0xfa,
+	0x19, 0x65, 0x32, 0xaa, 0xb6, 0x68, 0x36, 0x83, 0xfa, 0x0f, 0x1f, 0x7e, 0x06, 0x00, 0xf0, 0xa6,
+	0x3d, 0x52, 0xd6, 0x01, 0x00, 0x00,
 }